@@ -13,15 +13,20 @@ import (
 	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
 	"github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/http"
+	frameworkevent "github.com/goravel/framework/event"
+	"github.com/goravel/framework/session"
 	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/color"
 	"github.com/goravel/framework/support/database"
 )
 
 const ctxKey = "GoravelAuth"
 
 type Claims struct {
-	Key string `json:"key"`
+	Key       string   `json:"key"`
+	Abilities []string `json:"abilities"`
 	jwt.RegisteredClaims
 }
 
@@ -33,11 +38,13 @@ type Guard struct {
 type Guards map[string]*Guard
 
 type Auth struct {
-	cache  cache.Cache
-	config config.Config
-	ctx    http.Context
-	guard  string
-	orm    orm.Orm
+	abilities []string
+	cache     cache.Cache
+	config    config.Config
+	ctx       http.Context
+	events    func() event.Instance
+	guard     string
+	orm       orm.Orm
 }
 
 func NewAuth(guard string, cache cache.Cache, config config.Config, ctx http.Context, orm orm.Orm) *Auth {
@@ -51,7 +58,10 @@ func NewAuth(guard string, cache cache.Cache, config config.Config, ctx http.Con
 }
 
 func (a *Auth) Guard(name string) contractsauth.Auth {
-	return NewAuth(name, a.cache, a.config, a.ctx, a.orm)
+	auth := NewAuth(name, a.cache, a.config, a.ctx, a.orm)
+	auth.events = a.events
+
+	return auth
 }
 
 // User need parse token first.
@@ -89,6 +99,15 @@ func (a *Auth) Id() (string, error) {
 }
 
 func (a *Auth) Parse(token string) (*contractsauth.Payload, error) {
+	payload, err := a.parse(token)
+	if errors.Is(err, ErrorTokenDisabled) || errors.Is(err, ErrorInvalidToken) || errors.Is(err, ErrorInvalidClaims) {
+		a.dispatch(&Failed{}, event.Arg{Type: "string", Value: err.Error()})
+	}
+
+	return payload, err
+}
+
+func (a *Auth) parse(token string) (*contractsauth.Payload, error) {
 	token = strings.ReplaceAll(token, "Bearer ", "")
 	if a.cache == nil {
 		return nil, errors.New("cache support is required")
@@ -113,10 +132,11 @@ func (a *Auth) Parse(token string) (*contractsauth.Payload, error) {
 			a.makeAuthContext(claims, "")
 
 			return &contractsauth.Payload{
-				Guard:    claims.Subject,
-				Key:      claims.Key,
-				ExpireAt: claims.ExpiresAt.Local(),
-				IssuedAt: claims.IssuedAt.Local(),
+				Guard:     claims.Subject,
+				Key:       claims.Key,
+				Abilities: claims.Abilities,
+				ExpireAt:  claims.ExpiresAt.Local(),
+				IssuedAt:  claims.IssuedAt.Local(),
 			}, ErrorTokenExpired
 		}
 
@@ -134,16 +154,19 @@ func (a *Auth) Parse(token string) (*contractsauth.Payload, error) {
 	a.makeAuthContext(claims, token)
 
 	return &contractsauth.Payload{
-		Guard:    claims.Subject,
-		Key:      claims.Key,
-		ExpireAt: claims.ExpiresAt.Time,
-		IssuedAt: claims.IssuedAt.Time,
+		Guard:     claims.Subject,
+		Key:       claims.Key,
+		Abilities: claims.Abilities,
+		ExpireAt:  claims.ExpiresAt.Time,
+		IssuedAt:  claims.IssuedAt.Time,
 	}, nil
 }
 
 func (a *Auth) Login(user any) (token string, err error) {
 	id := database.GetID(user)
 	if id == nil {
+		a.dispatch(&Failed{}, event.Arg{Type: "string", Value: ErrorNoPrimaryKeyField.Error()})
+
 		return "", ErrorNoPrimaryKeyField
 	}
 
@@ -151,6 +174,35 @@ func (a *Auth) Login(user any) (token string, err error) {
 }
 
 func (a *Auth) LoginUsingID(id any) (token string, err error) {
+	abilities := a.abilities
+	if abilities == nil {
+		abilities = []string{"*"}
+	}
+
+	token, err = a.loginUsingID(id, abilities)
+	if err != nil {
+		a.dispatch(&Failed{}, event.Arg{Type: "string", Value: err.Error()})
+
+		return "", err
+	}
+
+	// The session and the CSRF token are regenerated, so a session fixed before the login can't be used,
+	// and their cookies are added again.
+	if a.ctx != nil {
+		if request := a.ctx.Request(); request != nil && request.HasSession() {
+			if err := request.Session().Regenerate(); err != nil {
+				return "", err
+			}
+			session.AddCookies(a.ctx)
+		}
+	}
+
+	a.dispatch(&Login{}, event.Arg{Type: "string", Value: cast.ToString(id)})
+
+	return token, nil
+}
+
+func (a *Auth) loginUsingID(id any, abilities []string) (token string, err error) {
 	jwtSecret := a.config.GetString("jwt.secret")
 	if jwtSecret == "" {
 		return "", ErrorEmptySecret
@@ -168,8 +220,9 @@ func (a *Auth) LoginUsingID(id any) (token string, err error) {
 		return "", ErrorInvalidKey
 	}
 	claims := Claims{
-		key,
-		jwt.RegisteredClaims{
+		Key:       key,
+		Abilities: abilities,
+		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expireTime),
 			IssuedAt:  jwt.NewNumericDate(nowTime.StdTime()),
 			Subject:   a.guard,
@@ -209,7 +262,7 @@ func (a *Auth) Refresh() (token string, err error) {
 		return "", ErrorRefreshTimeExceeded
 	}
 
-	return a.LoginUsingID(auth[a.guard].Claims.Key)
+	return a.loginUsingID(auth[a.guard].Claims.Key, auth[a.guard].Claims.Abilities)
 }
 
 func (a *Auth) Logout() error {
@@ -236,12 +289,74 @@ func (a *Auth) Logout() error {
 		}
 	}
 
+	var key string
+	if auth[a.guard].Claims != nil {
+		key = auth[a.guard].Claims.Key
+	}
+
 	delete(auth, a.guard)
 	a.ctx.WithValue(ctxKey, auth)
 
+	a.dispatch(&Logout{}, event.Arg{Type: "string", Value: key})
+
 	return nil
 }
 
+// Can The tokens issued before the abilities are supported don't have the abilities claim, they have all the
+// abilities.
+func (a *Auth) Can(ability string) bool {
+	auth, ok := a.ctx.Value(ctxKey).(Guards)
+	if !ok || auth[a.guard] == nil || auth[a.guard].Claims == nil || auth[a.guard].Token == "" {
+		return false
+	}
+
+	abilities := auth[a.guard].Claims.Abilities
+	if abilities == nil {
+		return true
+	}
+
+	for _, pattern := range abilities {
+		if matchAbility(pattern, ability) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Auth) WithAbilities(abilities ...string) contractsauth.Auth {
+	auth := NewAuth(a.guard, a.cache, a.config, a.ctx, a.orm)
+	auth.abilities = append([]string{}, abilities...)
+	auth.events = a.events
+
+	return auth
+}
+
+// dispatch Dispatch an auth event if the application has registered listeners for it, the guard is prepended to
+// the args, and the IP and the user agent of the request are appended.
+func (a *Auth) dispatch(e event.Event, args ...event.Arg) {
+	if a.events == nil {
+		return
+	}
+	events := a.events()
+	if events == nil {
+		return
+	}
+
+	var ip, userAgent string
+	if request := a.ctx.Request(); request != nil {
+		ip = request.Ip()
+		userAgent = request.Header("User-Agent")
+	}
+
+	args = append([]event.Arg{{Type: "string", Value: a.guard}}, args...)
+	args = append(args, event.Arg{Type: "string", Value: ip}, event.Arg{Type: "string", Value: userAgent})
+
+	if err := frameworkevent.DispatchIfListened(events, e, args); err != nil {
+		color.Red().Println(err)
+	}
+}
+
 func (a *Auth) makeAuthContext(claims *Claims, token string) {
 	guards, ok := a.ctx.Value(ctxKey).(Guards)
 	if !ok {
@@ -255,6 +370,16 @@ func (a *Auth) tokenIsDisabled(token string) bool {
 	return a.cache.GetBool(getDisabledCacheKey(token), false)
 }
 
+// matchAbility Determine if an ability of a token matches the given ability, "*" matches all the abilities and
+// "posts.*" matches the abilities starting with "posts.".
+func matchAbility(pattern, ability string) bool {
+	if pattern == "*" || pattern == ability {
+		return true
+	}
+
+	return strings.HasSuffix(pattern, ".*") && strings.HasPrefix(ability, strings.TrimSuffix(pattern, "*"))
+}
+
 func getDisabledCacheKey(token string) string {
 	return "jwt:disabled:" + token
 }
@@ -13,11 +13,16 @@ import (
 	"gorm.io/gorm/clause"
 
 	authcontract "github.com/goravel/framework/contracts/auth"
+	eventcontract "github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/http"
 	"github.com/goravel/framework/database/orm"
 	cachemock "github.com/goravel/framework/mocks/cache"
 	configmock "github.com/goravel/framework/mocks/config"
 	ormmock "github.com/goravel/framework/mocks/database/orm"
+	eventmock "github.com/goravel/framework/mocks/event"
+	httpmock "github.com/goravel/framework/mocks/http"
+	sessionmock "github.com/goravel/framework/mocks/session"
+	"github.com/goravel/framework/session"
 	"github.com/goravel/framework/support/carbon"
 )
 
@@ -112,6 +117,44 @@ func (s *AuthTestSuite) SetupTest() {
 	s.auth = NewAuth(testUserGuard, s.mockCache, s.mockConfig, s.mockContext, s.mockOrm)
 }
 
+func (s *AuthTestSuite) TestLoginUsingID_RegenerateSession() {
+	mockRequest := &httpmock.ContextRequest{}
+	mockResponse := &httpmock.ContextResponse{}
+	mockSession := &sessionmock.Session{}
+	s.mockContext.(*Context).request = mockRequest
+	s.mockContext.(*Context).response = mockResponse
+	s.mockConfig.On("GetString", "jwt.secret").Return("Goravel").Once()
+	s.mockConfig.On("GetInt", "jwt.ttl").Return(2).Once()
+	mockRequest.On("HasSession").Return(true).Once()
+	mockRequest.On("Session").Return(mockSession).Twice()
+	mockSession.On("Regenerate").Return(nil).Once()
+
+	// The cookie of the regenerated session is added again.
+	mockSessionConfig := &configmock.Config{}
+	mockSessionConfig.On("GetInt", "session.lifetime").Return(120).Once()
+	mockSessionConfig.On("GetString", "session.path").Return("/").Once()
+	mockSessionConfig.On("GetString", "session.domain").Return("").Once()
+	mockSessionConfig.On("GetBool", "session.secure").Return(false).Once()
+	mockSessionConfig.On("GetBool", "session.http_only").Return(true).Once()
+	mockSessionConfig.On("GetString", "session.same_site").Return("lax").Once()
+	session.ConfigFacade = mockSessionConfig
+	mockSession.On("GetName").Return("goravel_session").Once()
+	mockSession.On("GetID").Return("regenerated").Once()
+	mockResponse.On("Cookie", testifymock.MatchedBy(func(cookie http.Cookie) bool {
+		return cookie.Name == "goravel_session" && cookie.Value == "regenerated"
+	})).Return(mockResponse).Once()
+
+	token, err := s.auth.LoginUsingID(1)
+	s.NotEmpty(token)
+	s.Nil(err)
+
+	s.mockConfig.AssertExpectations(s.T())
+	mockSessionConfig.AssertExpectations(s.T())
+	mockRequest.AssertExpectations(s.T())
+	mockResponse.AssertExpectations(s.T())
+	mockSession.AssertExpectations(s.T())
+}
+
 func (s *AuthTestSuite) TestLoginUsingID_EmptySecret() {
 	s.mockConfig.On("GetString", "jwt.secret").Return("").Once()
 
@@ -255,10 +298,11 @@ func (s *AuthTestSuite) TestParse_TokenExpired() {
 
 	payload, err := s.auth.Parse(token)
 	s.Equal(&authcontract.Payload{
-		Guard:    testUserGuard,
-		Key:      "1",
-		ExpireAt: jwt.NewNumericDate(expireAt).Local(),
-		IssuedAt: jwt.NewNumericDate(issuedAt).Local(),
+		Guard:     testUserGuard,
+		Key:       "1",
+		Abilities: []string{"*"},
+		ExpireAt:  jwt.NewNumericDate(expireAt).Local(),
+		IssuedAt:  jwt.NewNumericDate(issuedAt).Local(),
 	}, payload)
 	s.ErrorIs(err, ErrorTokenExpired)
 
@@ -285,10 +329,11 @@ func (s *AuthTestSuite) TestParse_Success() {
 
 	payload, err := s.auth.Parse(token)
 	s.Equal(&authcontract.Payload{
-		Guard:    testUserGuard,
-		Key:      "1",
-		ExpireAt: jwt.NewNumericDate(carbon.Now().AddMinutes(2).StdTime()).Local(),
-		IssuedAt: jwt.NewNumericDate(carbon.Now().StdTime()).Local(),
+		Guard:     testUserGuard,
+		Key:       "1",
+		Abilities: []string{"*"},
+		ExpireAt:  jwt.NewNumericDate(carbon.Now().AddMinutes(2).StdTime()).Local(),
+		IssuedAt:  jwt.NewNumericDate(carbon.Now().StdTime()).Local(),
 	}, payload)
 	s.Nil(err)
 
@@ -307,10 +352,11 @@ func (s *AuthTestSuite) TestParse_SuccessWithPrefix() {
 
 	payload, err := s.auth.Parse("Bearer " + token)
 	s.Equal(&authcontract.Payload{
-		Guard:    testUserGuard,
-		Key:      "1",
-		ExpireAt: jwt.NewNumericDate(carbon.Now().AddMinutes(2).StdTime()).Local(),
-		IssuedAt: jwt.NewNumericDate(carbon.Now().StdTime()).Local(),
+		Guard:     testUserGuard,
+		Key:       "1",
+		Abilities: []string{"*"},
+		ExpireAt:  jwt.NewNumericDate(carbon.Now().AddMinutes(2).StdTime()).Local(),
+		IssuedAt:  jwt.NewNumericDate(carbon.Now().StdTime()).Local(),
 	}, payload)
 	s.Nil(err)
 
@@ -745,6 +791,128 @@ func (s *AuthTestSuite) TestLogout_Error_TTL_Is_0() {
 	s.mockConfig.AssertExpectations(s.T())
 }
 
+func (s *AuthTestSuite) TestEvents() {
+	mockEvent := &eventmock.Instance{}
+	mockTask := &eventmock.Task{}
+	mockRequest := &httpmock.ContextRequest{}
+	s.mockContext.(*Context).request = mockRequest
+	s.auth.events = func() eventcontract.Instance {
+		return mockEvent
+	}
+
+	login := &Login{}
+	logout := &Logout{}
+	failed := &Failed{}
+	mockEvent.On("GetEvents").Return(map[eventcontract.Event][]eventcontract.Listener{
+		login:  nil,
+		logout: nil,
+		failed: nil,
+	}).Times(5)
+	mockRequest.On("Ip").Return("127.0.0.1").Times(5)
+	mockRequest.On("Header", "User-Agent").Return("Goravel").Times(5)
+	mockRequest.On("HasSession").Return(false).Once()
+
+	s.mockConfig.On("GetString", "jwt.secret").Return("Goravel").Times(3)
+	s.mockConfig.On("GetInt", "jwt.ttl").Return(2).Times(3)
+
+	mockEvent.On("Job", login, []eventcontract.Arg{
+		{Type: "string", Value: testUserGuard},
+		{Type: "string", Value: "1"},
+		{Type: "string", Value: "127.0.0.1"},
+		{Type: "string", Value: "Goravel"},
+	}).Return(mockTask).Once()
+	mockTask.On("Dispatch").Return(nil).Times(5)
+
+	token, err := s.auth.LoginUsingID(1)
+	s.NotEmpty(token)
+	s.Nil(err)
+
+	s.mockCache.On("GetBool", "jwt:disabled:"+token, false).Return(true).Once()
+	mockEvent.On("Job", failed, []eventcontract.Arg{
+		{Type: "string", Value: testUserGuard},
+		{Type: "string", Value: ErrorTokenDisabled.Error()},
+		{Type: "string", Value: "127.0.0.1"},
+		{Type: "string", Value: "Goravel"},
+	}).Return(mockTask).Once()
+
+	payload, err := s.auth.Parse(token)
+	s.Nil(payload)
+	s.ErrorIs(err, ErrorTokenDisabled)
+
+	s.mockCache.On("GetBool", "jwt:disabled:1", false).Return(false).Once()
+	mockEvent.On("Job", failed, []eventcontract.Arg{
+		{Type: "string", Value: testUserGuard},
+		{Type: "string", Value: ErrorInvalidToken.Error()},
+		{Type: "string", Value: "127.0.0.1"},
+		{Type: "string", Value: "Goravel"},
+	}).Return(mockTask).Once()
+
+	payload, err = s.auth.Parse("1")
+	s.Nil(payload)
+	s.ErrorIs(err, ErrorInvalidToken)
+
+	// The failed login is dispatched.
+	mockEvent.On("Job", failed, []eventcontract.Arg{
+		{Type: "string", Value: testUserGuard},
+		{Type: "string", Value: ErrorInvalidKey.Error()},
+		{Type: "string", Value: "127.0.0.1"},
+		{Type: "string", Value: "Goravel"},
+	}).Return(mockTask).Once()
+
+	token, err = s.auth.LoginUsingID("")
+	s.Empty(token)
+	s.ErrorIs(err, ErrorInvalidKey)
+
+	s.mockCache.On("Put", testifymock.Anything, true, 2*time.Minute).Return(nil).Once()
+	mockEvent.On("Job", logout, []eventcontract.Arg{
+		{Type: "string", Value: testUserGuard},
+		{Type: "string", Value: "1"},
+		{Type: "string", Value: "127.0.0.1"},
+		{Type: "string", Value: "Goravel"},
+	}).Return(mockTask).Once()
+
+	s.Nil(s.auth.Logout())
+
+	s.mockConfig.AssertExpectations(s.T())
+	mockEvent.AssertExpectations(s.T())
+	mockTask.AssertExpectations(s.T())
+	mockRequest.AssertExpectations(s.T())
+}
+
+func (s *AuthTestSuite) TestCan() {
+	s.mockConfig.On("GetString", "jwt.secret").Return("Goravel").Times(4)
+	s.mockConfig.On("GetInt", "jwt.ttl").Return(2).Twice()
+
+	s.False(s.auth.Can("posts.read"))
+
+	token, err := s.auth.WithAbilities("posts.*", "comments.read").LoginUsingID(1)
+	s.Nil(err)
+
+	s.mockCache.On("GetBool", "jwt:disabled:"+token, false).Return(false).Once()
+
+	payload, err := s.auth.Parse(token)
+	s.Nil(err)
+	s.Equal([]string{"posts.*", "comments.read"}, payload.Abilities)
+	s.True(s.auth.Can("posts.read"))
+	s.True(s.auth.Can("posts.comments.write"))
+	s.True(s.auth.Can("comments.read"))
+	s.False(s.auth.Can("comments.write"))
+	s.False(s.auth.Can("posts"))
+	s.False(s.auth.Guard("admin").Can("posts.read"))
+
+	// The tokens issued without abilities have all the abilities.
+	token, err = s.auth.LoginUsingID(1)
+	s.Nil(err)
+
+	s.mockCache.On("GetBool", "jwt:disabled:"+token, false).Return(false).Once()
+
+	_, err = s.auth.Parse(token)
+	s.Nil(err)
+	s.True(s.auth.Can("comments.write"))
+
+	s.mockConfig.AssertExpectations(s.T())
+}
+
 func (s *AuthTestSuite) TestMakeAuthContext() {
 	testAdminGuard := "admin"
 
@@ -54,13 +54,13 @@ func (receiver *PolicyMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Policy created successfully")
+	color.Green().Printf("Policy [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *PolicyMakeCommand) getStub() string {
-	return PolicyStubs{}.Policy()
+	return supportconsole.Stub("policy.stub", PolicyStubs{}.Policy())
 }
 
 // populateStub Populate the place-holders in the command stub.
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"github.com/goravel/framework/contracts/event"
+)
+
+// Login is dispatched after a user logs in, the args are the guard, the user id, the IP and the user agent of the request.
+type Login struct {
+}
+
+func (receiver *Login) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// Logout is dispatched after a user logs out, the args are the guard, the user id, the IP and the user agent of the request.
+type Logout struct {
+}
+
+func (receiver *Logout) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// Failed is dispatched when a token is rejected because it's invalid or disabled, or a login fails, the args are the
+// guard, the error, the IP and the user agent of the request.
+type Failed struct {
+}
+
+func (receiver *Failed) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
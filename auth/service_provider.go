@@ -6,8 +6,10 @@ import (
 	"github.com/goravel/framework/auth/access"
 	"github.com/goravel/framework/auth/console"
 	contractconsole "github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/foundation"
 	"github.com/goravel/framework/contracts/http"
+	frameworkevent "github.com/goravel/framework/event"
 )
 
 const BindingAuth = "goravel.auth"
@@ -19,8 +21,19 @@ type ServiceProvider struct {
 func (database *ServiceProvider) Register(app foundation.Application) {
 	app.BindWith(BindingAuth, func(app foundation.Application, parameters map[string]any) (any, error) {
 		config := app.MakeConfig()
-		return NewAuth(config.GetString("auth.defaults.guard"),
-			app.MakeCache(), config, parameters["ctx"].(http.Context), app.MakeOrm()), nil
+		auth := NewAuth(config.GetString("auth.defaults.guard"),
+			app.MakeCache(), config, parameters["ctx"].(http.Context), app.MakeOrm())
+		auth.events = func() event.Instance {
+			instance, err := app.Make(frameworkevent.Binding)
+			if err != nil {
+				return nil
+			}
+			events, _ := instance.(event.Instance)
+
+			return events
+		}
+
+		return auth, nil
 	})
 	app.Singleton(BindingGate, func(app foundation.Application) (any, error) {
 		return access.NewGate(context.Background()), nil
@@ -1,35 +1,47 @@
 package cache
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/log"
+	frameworkevent "github.com/goravel/framework/event"
 )
 
 type Application struct {
 	cache.Driver
 	config config.Config
 	driver Driver
+	events func() event.Instance
 	log    log.Log
 	stores map[string]cache.Driver
 }
 
 func NewApplication(config config.Config, log log.Log, store string) (*Application, error) {
-	driver := NewDriverImpl(config)
-	instance, err := driver.New(store)
+	app := &Application{
+		config: config,
+		driver: NewDriverImpl(config),
+		log:    log,
+		stores: make(map[string]cache.Driver),
+	}
+
+	instance, err := app.newStore(store)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Application{
-		Driver: instance,
-		config: config,
-		driver: driver,
-		log:    log,
-		stores: map[string]cache.Driver{
-			store: instance,
-		},
-	}, nil
+	app.Driver = instance
+	app.stores[store] = instance
+
+	return app, nil
+}
+
+// SetEvents Set the events module used to dispatch the store and the cache events.
+func (app *Application) SetEvents(events func() event.Instance) {
+	app.events = events
 }
 
 func (app *Application) Store(name string) cache.Driver {
@@ -37,7 +49,7 @@ func (app *Application) Store(name string) cache.Driver {
 		return driver
 	}
 
-	instance, err := app.driver.New(name)
+	instance, err := app.newStore(name)
 	if err != nil {
 		app.log.Error(err)
 
@@ -48,3 +60,50 @@ func (app *Application) Store(name string) cache.Driver {
 
 	return instance
 }
+
+// Tags Get a cache instance of the default store whose items are tagged with the given names.
+func (app *Application) Tags(names ...string) cache.Driver {
+	return Tags(app.Driver, names...)
+}
+
+// Flexible Get an item of the default store with the stale-while-revalidate strategy.
+func (app *Application) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return Flexible(app.Driver, key, fresh, stale, callback)
+}
+
+// newStore Create a store dispatching the cache events, it's wrapped by a fallback to the memory if the fallback of
+// the store is enabled.
+func (app *Application) newStore(name string) (cache.Driver, error) {
+	instance, err := app.driver.New(name)
+	if err != nil {
+		return nil, err
+	}
+	if !app.config.GetBool(fmt.Sprintf("cache.stores.%s.fallback", name)) {
+		return NewEvented(instance, name, app.dispatch), nil
+	}
+
+	fallback, err := NewFallback(app.config, name, instance, func(degraded bool, err error) {
+		if degraded {
+			app.log.Warningf("Cache store [%s] is unreachable, falling back to the memory: %v", name, err)
+			app.dispatch(&StoreDegraded{}, []event.Arg{{Type: "string", Value: name}, {Type: "string", Value: err.Error()}})
+		} else {
+			app.log.Infof("Cache store [%s] has recovered", name)
+			app.dispatch(&StoreRecovered{}, []event.Arg{{Type: "string", Value: name}})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEvented(fallback, name, app.dispatch), nil
+}
+
+// dispatch Dispatch an event if the application has registered listeners for it.
+func (app *Application) dispatch(e event.Event, args []event.Arg) {
+	if app.events == nil {
+		return
+	}
+	if err := frameworkevent.DispatchIfListened(app.events(), e, args); err != nil {
+		app.log.Error(err)
+	}
+}
@@ -1,6 +1,8 @@
 package console
 
 import (
+	"strings"
+
 	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/console/command"
@@ -29,15 +31,55 @@ func (receiver *ClearCommand) Description() string {
 func (receiver *ClearCommand) Extend() command.Extend {
 	return command.Extend{
 		Category: "cache",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "store",
+				Usage: "the store to flush, the default store is flushed if it's empty",
+			},
+			&command.StringFlag{
+				Name:  "tags",
+				Usage: "the comma separated tags whose items are flushed, e.g. users,posts",
+			},
+		},
 	}
 }
 
 // Handle Execute the console command.
 func (receiver *ClearCommand) Handle(ctx console.Context) error {
-	if receiver.cache.Flush() {
-		color.Green().Println("Application cache cleared")
-	} else {
+	name := ctx.Option("store")
+	var store cache.Driver = receiver.cache
+	if name != "" {
+		if store = receiver.cache.Store(name); store == nil {
+			color.Red().Printf("Cache store [%s] not found\n", name)
+
+			return nil
+		}
+	}
+
+	tags := parseTags(ctx.Option("tags"))
+	if len(tags) > 0 {
+		tagged, ok := tagStore(receiver.cache, store, name, tags)
+		if !ok {
+			color.Red().Println("The cache store doesn't support tags")
+
+			return nil
+		}
+		store = tagged
+	}
+
+	if !store.Flush() {
 		color.Red().Println("Clear Application cache Failed")
+
+		return nil
+	}
+
+	switch {
+	case len(tags) > 0:
+		color.Green().Printf("The items of the [%s] tags have been removed from the cache\n", strings.Join(tags, ", "))
+	case name != "":
+		color.Green().Printf("Cache store [%s] cleared\n", name)
+	default:
+		color.Green().Println("Application cache cleared")
 	}
 
 	return nil
@@ -0,0 +1,83 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/console"
+	cachemocks "github.com/goravel/framework/mocks/cache"
+)
+
+type taggableDriver struct {
+	*cachemocks.Driver
+	*cachemocks.Taggable
+}
+
+func TestClearCommand(t *testing.T) {
+	t.Run("flush the default store", func(t *testing.T) {
+		mockCache := cachemocks.NewCache(t)
+		mockCache.EXPECT().Flush().Return(true).Once()
+
+		console.NewTester(t, NewClearCommand(mockCache)).
+			Run().
+			AssertSuccessful().
+			AssertOutputContains("Application cache cleared")
+	})
+
+	t.Run("flush a store", func(t *testing.T) {
+		mockCache := cachemocks.NewCache(t)
+		mockDriver := cachemocks.NewDriver(t)
+		mockCache.EXPECT().Store("redis").Return(mockDriver).Once()
+		mockDriver.EXPECT().Flush().Return(true).Once()
+
+		console.NewTester(t, NewClearCommand(mockCache)).
+			Run("--store", "redis").
+			AssertSuccessful().
+			AssertOutputContains("Cache store [redis] cleared")
+	})
+
+	t.Run("flush the tags of the default store", func(t *testing.T) {
+		mockCache := cachemocks.NewCache(t)
+		mockTagged := cachemocks.NewDriver(t)
+		mockCache.EXPECT().Tags("users", "posts").Return(mockTagged).Once()
+		mockTagged.EXPECT().Flush().Return(true).Once()
+
+		console.NewTester(t, NewClearCommand(mockCache)).
+			Run("--tags", "users, posts").
+			AssertSuccessful().
+			AssertOutputContains("The items of the [users, posts] tags have been removed from the cache")
+	})
+
+	t.Run("flush the tags of a store", func(t *testing.T) {
+		mockCache := cachemocks.NewCache(t)
+		mockTagged := cachemocks.NewDriver(t)
+		driver := &taggableDriver{Driver: cachemocks.NewDriver(t), Taggable: cachemocks.NewTaggable(t)}
+		mockCache.EXPECT().Store("redis").Return(driver).Once()
+		driver.Taggable.EXPECT().Tags("users").Return(mockTagged).Once()
+		mockTagged.EXPECT().Flush().Return(true).Once()
+
+		console.NewTester(t, NewClearCommand(mockCache)).
+			Run("--store", "redis", "--tags", "users").
+			AssertSuccessful().
+			AssertOutputContains("The items of the [users] tags have been removed from the cache")
+	})
+
+	t.Run("tags aren't supported", func(t *testing.T) {
+		mockCache := cachemocks.NewCache(t)
+		mockCache.EXPECT().Store("custom").Return(cachemocks.NewDriver(t)).Once()
+
+		console.NewTester(t, NewClearCommand(mockCache)).
+			Run("--store", "custom", "--tags", "users").
+			AssertSuccessful().
+			AssertOutputContains("The cache store doesn't support tags")
+	})
+
+	t.Run("store isn't found", func(t *testing.T) {
+		mockCache := cachemocks.NewCache(t)
+		mockCache.EXPECT().Store("missing").Return(nil).Once()
+
+		console.NewTester(t, NewClearCommand(mockCache)).
+			Run("--store", "missing").
+			AssertSuccessful().
+			AssertOutputContains("Cache store [missing] not found")
+	})
+}
@@ -0,0 +1,109 @@
+package console
+
+import (
+	"strings"
+
+	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+)
+
+type ForgetCommand struct {
+	cache  cache.Cache
+	config config.Config
+}
+
+func NewForgetCommand(config config.Config, cache cache.Cache) *ForgetCommand {
+	return &ForgetCommand{cache: cache, config: config}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ForgetCommand) Signature() string {
+	return "cache:forget"
+}
+
+// Description The console command description.
+func (receiver *ForgetCommand) Description() string {
+	return "Remove an item or the items matching a pattern from the cache"
+}
+
+// Extend The console command extend.
+func (receiver *ForgetCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "cache",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "store",
+				Usage: "the store to remove the items from",
+			},
+			&command.StringFlag{
+				Name:  "tags",
+				Usage: "the comma separated tags of the items, e.g. users,posts",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ForgetCommand) Handle(ctx console.Context) error {
+	key := ctx.Argument(0)
+	if key == "" {
+		color.Red().Println("Not enough arguments (missing: key)")
+
+		return nil
+	}
+
+	name := storeName(receiver.config, ctx.Option("store"))
+	store := receiver.cache.Store(name)
+	if store == nil {
+		color.Red().Printf("Cache store [%s] not found\n", name)
+
+		return nil
+	}
+
+	if tags := parseTags(ctx.Option("tags")); len(tags) > 0 {
+		if strings.ContainsAny(key, "*?") {
+			color.Red().Println("The items can't be removed by pattern with tags")
+
+			return nil
+		}
+
+		tagged, ok := tagStore(receiver.cache, store, name, tags)
+		if !ok {
+			color.Red().Println("The cache store doesn't support tags")
+
+			return nil
+		}
+		store = tagged
+	}
+
+	if !strings.ContainsAny(key, "*?") {
+		store.Forget(key)
+		color.Green().Printf("The [%s] key has been removed from the cache\n", key)
+
+		return nil
+	}
+
+	searchable, ok := unwrap(store).(cache.Searchable)
+	if !ok {
+		color.Red().Println("The cache store doesn't support removing the items by pattern")
+
+		return nil
+	}
+
+	keys, err := searchable.Keys(key)
+	if err != nil {
+		color.Red().Println(err.Error())
+
+		return nil
+	}
+	for _, item := range keys {
+		store.Forget(item)
+	}
+
+	color.Green().Printf("%d keys matching [%s] have been removed from the cache\n", len(keys), key)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/console"
+	cachemocks "github.com/goravel/framework/mocks/cache"
+	configmocks "github.com/goravel/framework/mocks/config"
+)
+
+type searchableDriver struct {
+	*cachemocks.Driver
+	*cachemocks.Searchable
+}
+
+func TestForgetCommand(t *testing.T) {
+	t.Run("forget a key of the default store", func(t *testing.T) {
+		mockConfig := configmocks.NewConfig(t)
+		mockCache := cachemocks.NewCache(t)
+		mockDriver := cachemocks.NewDriver(t)
+		mockConfig.EXPECT().GetString("cache.default").Return("memory").Once()
+		mockCache.EXPECT().Store("memory").Return(mockDriver).Once()
+		mockDriver.EXPECT().Forget("name").Return(true).Once()
+
+		console.NewTester(t, NewForgetCommand(mockConfig, mockCache)).
+			Run("name").
+			AssertSuccessful().
+			AssertOutputContains("The [name] key has been removed from the cache")
+	})
+
+	t.Run("forget the keys matching a pattern", func(t *testing.T) {
+		mockConfig := configmocks.NewConfig(t)
+		mockCache := cachemocks.NewCache(t)
+		driver := &searchableDriver{Driver: cachemocks.NewDriver(t), Searchable: cachemocks.NewSearchable(t)}
+		mockCache.EXPECT().Store("redis").Return(driver).Once()
+		driver.Searchable.EXPECT().Keys("user:*").Return([]string{"user:1", "user:2"}, nil).Once()
+		driver.Driver.EXPECT().Forget("user:1").Return(true).Once()
+		driver.Driver.EXPECT().Forget("user:2").Return(true).Once()
+
+		console.NewTester(t, NewForgetCommand(mockConfig, mockCache)).
+			Run("--store", "redis", "user:*").
+			AssertSuccessful().
+			AssertOutputContains("2 keys matching [user:*] have been removed from the cache")
+	})
+
+	t.Run("forget a tagged key", func(t *testing.T) {
+		mockConfig := configmocks.NewConfig(t)
+		mockCache := cachemocks.NewCache(t)
+		mockTagged := cachemocks.NewDriver(t)
+		driver := &taggableDriver{Driver: cachemocks.NewDriver(t), Taggable: cachemocks.NewTaggable(t)}
+		mockConfig.EXPECT().GetString("cache.default").Return("memory").Once()
+		mockCache.EXPECT().Store("memory").Return(driver).Once()
+		driver.Taggable.EXPECT().Tags("users", "posts").Return(mockTagged).Once()
+		mockTagged.EXPECT().Forget("name").Return(true).Once()
+
+		console.NewTester(t, NewForgetCommand(mockConfig, mockCache)).
+			Run("--tags", "users,posts", "name").
+			AssertSuccessful().
+			AssertOutputContains("The [name] key has been removed from the cache")
+	})
+
+	t.Run("pattern with tags", func(t *testing.T) {
+		mockConfig := configmocks.NewConfig(t)
+		mockCache := cachemocks.NewCache(t)
+		mockConfig.EXPECT().GetString("cache.default").Return("memory").Once()
+		mockCache.EXPECT().Store("memory").Return(cachemocks.NewDriver(t)).Once()
+
+		console.NewTester(t, NewForgetCommand(mockConfig, mockCache)).
+			Run("--tags", "users", "user:*").
+			AssertSuccessful().
+			AssertOutputContains("The items can't be removed by pattern with tags")
+	})
+
+	t.Run("pattern isn't supported", func(t *testing.T) {
+		mockConfig := configmocks.NewConfig(t)
+		mockCache := cachemocks.NewCache(t)
+		mockConfig.EXPECT().GetString("cache.default").Return("memory").Once()
+		mockCache.EXPECT().Store("memory").Return(cachemocks.NewDriver(t)).Once()
+
+		console.NewTester(t, NewForgetCommand(mockConfig, mockCache)).
+			Run("user:*").
+			AssertSuccessful().
+			AssertOutputContains("The cache store doesn't support removing the items by pattern")
+	})
+}
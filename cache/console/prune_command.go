@@ -0,0 +1,70 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+)
+
+type PruneCommand struct {
+	cache  cache.Cache
+	config config.Config
+}
+
+func NewPruneCommand(config config.Config, cache cache.Cache) *PruneCommand {
+	return &PruneCommand{cache: cache, config: config}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *PruneCommand) Signature() string {
+	return "cache:prune"
+}
+
+// Description The console command description.
+func (receiver *PruneCommand) Description() string {
+	return "Remove the expired items from the cache"
+}
+
+// Extend The console command extend.
+func (receiver *PruneCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "cache",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "store",
+				Usage: "the store to prune",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *PruneCommand) Handle(ctx console.Context) error {
+	name := storeName(receiver.config, ctx.Option("store"))
+	store := receiver.cache.Store(name)
+	if store == nil {
+		color.Red().Printf("Cache store [%s] not found\n", name)
+
+		return nil
+	}
+
+	prunable, ok := unwrap(store).(cache.Prunable)
+	if !ok {
+		color.Yellow().Printf("Cache store [%s] removes the expired items by itself\n", name)
+
+		return nil
+	}
+
+	count, err := prunable.Prune()
+	if err != nil {
+		color.Red().Println(err.Error())
+
+		return nil
+	}
+
+	color.Green().Printf("%d expired items have been pruned from the [%s] store\n", count, name)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/console"
+	cachemocks "github.com/goravel/framework/mocks/cache"
+	configmocks "github.com/goravel/framework/mocks/config"
+)
+
+type prunableDriver struct {
+	*cachemocks.Driver
+	*cachemocks.Prunable
+}
+
+func TestPruneCommand(t *testing.T) {
+	t.Run("prune the default store", func(t *testing.T) {
+		mockConfig := configmocks.NewConfig(t)
+		mockCache := cachemocks.NewCache(t)
+		driver := &prunableDriver{Driver: cachemocks.NewDriver(t), Prunable: cachemocks.NewPrunable(t)}
+		mockConfig.EXPECT().GetString("cache.default").Return("memory").Once()
+		mockCache.EXPECT().Store("memory").Return(driver).Once()
+		driver.Prunable.EXPECT().Prune().Return(2, nil).Once()
+
+		console.NewTester(t, NewPruneCommand(mockConfig, mockCache)).
+			Run().
+			AssertSuccessful().
+			AssertOutputContains("2 expired items have been pruned from the [memory] store")
+	})
+
+	t.Run("the store removes the expired items by itself", func(t *testing.T) {
+		mockConfig := configmocks.NewConfig(t)
+		mockCache := cachemocks.NewCache(t)
+		mockCache.EXPECT().Store("redis").Return(cachemocks.NewDriver(t)).Once()
+
+		console.NewTester(t, NewPruneCommand(mockConfig, mockCache)).
+			Run("--store", "redis").
+			AssertSuccessful().
+			AssertOutputContains("Cache store [redis] removes the expired items by itself")
+	})
+}
@@ -0,0 +1,124 @@
+package console
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+)
+
+type StatsCommand struct {
+	cache  cache.Cache
+	config config.Config
+}
+
+func NewStatsCommand(config config.Config, cache cache.Cache) *StatsCommand {
+	return &StatsCommand{cache: cache, config: config}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *StatsCommand) Signature() string {
+	return "cache:stats"
+}
+
+// Description The console command description.
+func (receiver *StatsCommand) Description() string {
+	return "Display the statistics of the cache stores"
+}
+
+// Extend The console command extend.
+func (receiver *StatsCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "cache",
+		Json:     true,
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "store",
+				Usage: "the store to display, all the configured stores are displayed by default",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *StatsCommand) Handle(ctx console.Context) error {
+	names := receiver.stores(ctx.Option("store"))
+
+	stats := make(map[string]cache.Stats, len(names))
+	rows := make([][]string, 0, len(names))
+	for _, name := range names {
+		store := receiver.cache.Store(name)
+		if store == nil {
+			color.Red().Printf("Cache store [%s] not found\n", name)
+
+			return nil
+		}
+
+		reporter, ok := unwrap(store).(cache.StatsReporter)
+		if !ok {
+			rows = append(rows, []string{name, "-", "-", "-"})
+			continue
+		}
+
+		stat, err := reporter.Stats()
+		if err != nil {
+			color.Red().Println(err.Error())
+
+			return nil
+		}
+
+		stats[name] = stat
+		rows = append(rows, []string{name, cast.ToString(stat.Keys), memory(stat.Memory), hitRate(stat.Hits, stat.Misses)})
+	}
+
+	if ctx.OptionBool("json") {
+		return ctx.Json(stats)
+	}
+
+	return ctx.Table([]string{"Store", "Keys", "Memory", "Hit Rate"}, rows)
+}
+
+// stores Get the given store, or all the configured stores sorted by name.
+func (receiver *StatsCommand) stores(store string) []string {
+	if store != "" {
+		return []string{store}
+	}
+
+	var names []string
+	for name := range cast.ToStringMap(receiver.config.Get("cache.stores")) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func memory(bytes int64) string {
+	if bytes < 0 {
+		return "-"
+	}
+
+	units := []string{"B", "KB", "MB", "GB"}
+	size := float64(bytes)
+	unit := 0
+	for size >= 1024 && unit < len(units)-1 {
+		size /= 1024
+		unit++
+	}
+
+	return fmt.Sprintf("%.2f %s", size, units[unit])
+}
+
+func hitRate(hits, misses int64) string {
+	if hits < 0 || misses < 0 || hits+misses == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.2f%%", float64(hits)/float64(hits+misses)*100)
+}
@@ -0,0 +1,36 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/console"
+	contractscache "github.com/goravel/framework/contracts/cache"
+	cachemocks "github.com/goravel/framework/mocks/cache"
+	configmocks "github.com/goravel/framework/mocks/config"
+)
+
+type reportableDriver struct {
+	*cachemocks.Driver
+	*cachemocks.StatsReporter
+}
+
+func TestStatsCommand(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockCache := cachemocks.NewCache(t)
+	driver := &reportableDriver{Driver: cachemocks.NewDriver(t), StatsReporter: cachemocks.NewStatsReporter(t)}
+	mockConfig.EXPECT().Get("cache.stores").Return(map[string]any{
+		"memory": map[string]any{"driver": "memory"},
+		"custom": map[string]any{"driver": "custom"},
+	}).Once()
+	mockCache.EXPECT().Store("custom").Return(cachemocks.NewDriver(t)).Once()
+	mockCache.EXPECT().Store("memory").Return(driver).Once()
+	driver.StatsReporter.EXPECT().Stats().Return(contractscache.Stats{Keys: 3, Memory: 2048, Hits: 3, Misses: 1}, nil).Once()
+
+	console.NewTester(t, NewStatsCommand(mockConfig, mockCache)).
+		Run().
+		AssertSuccessful().
+		AssertTable([]string{"Store", "Keys", "Memory", "Hit Rate"}, [][]string{
+			{"custom", "-", "-", "-"},
+			{"memory", "3", "2.00 KB", "75.00%"},
+		})
+}
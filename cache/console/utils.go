@@ -0,0 +1,55 @@
+package console
+
+import (
+	"strings"
+
+	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+)
+
+// storeName Get the store given by the --store option, or the default store.
+func storeName(config config.Config, store string) string {
+	if store != "" {
+		return store
+	}
+
+	return config.GetString("cache.default")
+}
+
+// unwrap Get the store wrapped by the cache events, the optional capabilities of the store are implemented by it.
+func unwrap(store cache.Driver) cache.Driver {
+	for {
+		wrapper, ok := store.(interface{ Unwrap() cache.Driver })
+		if !ok {
+			return store
+		}
+		store = wrapper.Unwrap()
+	}
+}
+
+// parseTags Parse the comma separated tags given by the --tags option.
+func parseTags(tags string) []string {
+	var names []string
+	for _, name := range strings.Split(tags, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// tagStore Get a cache instance of the store whose items are tagged with the given names, the default store is tagged
+// by the cache if the store name is empty.
+func tagStore(instance cache.Cache, store cache.Driver, name string, tags []string) (cache.Driver, bool) {
+	if name == "" {
+		return instance.Tags(tags...), true
+	}
+
+	taggable, ok := store.(cache.Taggable)
+	if !ok {
+		return nil, false
+	}
+
+	return taggable.Tags(tags...), true
+}
@@ -3,6 +3,8 @@ package cache
 import (
 	"fmt"
 
+	"github.com/spf13/cast"
+
 	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
 )
@@ -26,16 +28,20 @@ func (d *DriverImpl) New(store string) (cache.Driver, error) {
 	driver := d.config.GetString(fmt.Sprintf("cache.stores.%s.driver", store))
 	switch driver {
 	case "memory":
-		return d.memory()
+		return d.memory(store)
+	case "dynamodb":
+		return d.dynamodb(store)
+	case "stack":
+		return d.stack(store)
 	case "custom":
 		return d.custom(store)
 	default:
-		return nil, fmt.Errorf("invalid driver: %s, only support memory, custom\n", driver)
+		return nil, fmt.Errorf("invalid driver: %s, only support memory, dynamodb, stack, custom\n", driver)
 	}
 }
 
-func (d *DriverImpl) memory() (cache.Driver, error) {
-	memory, err := NewMemory(d.config)
+func (d *DriverImpl) memory(store string) (cache.Driver, error) {
+	memory, err := NewBoundedMemory(d.config, store)
 	if err != nil {
 		return nil, fmt.Errorf("init memory driver error: %v", err)
 	}
@@ -43,6 +49,38 @@ func (d *DriverImpl) memory() (cache.Driver, error) {
 	return memory, nil
 }
 
+func (d *DriverImpl) dynamodb(store string) (cache.Driver, error) {
+	dynamodb, err := NewDynamoDB(d.config, store)
+	if err != nil {
+		return nil, fmt.Errorf("init dynamodb driver error: %v", err)
+	}
+
+	return dynamodb, nil
+}
+
+// stack Create a stack of the stores, the stores are created without their fallbacks.
+func (d *DriverImpl) stack(store string) (cache.Driver, error) {
+	names := cast.ToStringSlice(d.config.Get(fmt.Sprintf("cache.stores.%s.stores", store)))
+	if len(names) == 0 {
+		return nil, fmt.Errorf("init stack driver error: the stores of %s are empty", store)
+	}
+
+	stores := make([]cache.Driver, len(names))
+	for i, name := range names {
+		if name == store {
+			return nil, fmt.Errorf("init stack driver error: %s can't contain itself", store)
+		}
+
+		instance, err := d.New(name)
+		if err != nil {
+			return nil, fmt.Errorf("init stack driver error: %v", err)
+		}
+		stores[i] = instance
+	}
+
+	return NewStack(d.config, store, stores), nil
+}
+
 func (d *DriverImpl) custom(store string) (cache.Driver, error) {
 	if custom, ok := d.config.Get(fmt.Sprintf("cache.stores.%s.via", store)).(cache.Driver); ok {
 		return custom, nil
@@ -31,9 +31,61 @@ func (s *DriverTestSuite) SetupTest() {
 
 func (s *DriverTestSuite) TestMemory() {
 	s.mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
-	memory, err := s.driver.memory()
+	s.mockConfig.On("GetInt", "cache.stores.memory.sweep_interval", 60).Return(60).Once()
+	s.mockConfig.On("GetInt", "cache.stores.memory.max_entries", 0).Return(100).Once()
+	s.mockConfig.On("GetInt", "cache.stores.memory.max_bytes", 0).Return(0).Once()
+	memory, err := s.driver.memory("memory")
 	s.NotNil(memory)
 	s.Nil(err)
+	s.NotNil(memory.(*Memory).usage)
+
+	s.mockConfig.AssertExpectations(s.T())
+}
+
+func (s *DriverTestSuite) TestDynamoDB() {
+	s.mockConfig.On("GetString", "cache.stores.dynamodb.region").Return("us-east-1").Once()
+	s.mockConfig.On("GetString", "cache.stores.dynamodb.key").Return("key").Once()
+	s.mockConfig.On("GetString", "cache.stores.dynamodb.secret").Return("secret").Once()
+	s.mockConfig.On("GetString", "cache.stores.dynamodb.endpoint").Return("http://localhost:8000").Once()
+	s.mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	s.mockConfig.On("GetString", "cache.stores.dynamodb.table", "cache").Return("cache").Once()
+	s.mockConfig.On("GetString", "cache.stores.dynamodb.attributes.key", "key").Return("key").Once()
+	s.mockConfig.On("GetString", "cache.stores.dynamodb.attributes.value", "value").Return("value").Once()
+	s.mockConfig.On("GetString", "cache.stores.dynamodb.attributes.expiration", "expires_at").Return("expires_at").Once()
+
+	dynamodb, err := s.driver.dynamodb("dynamodb")
+	s.NotNil(dynamodb)
+	s.Nil(err)
+
+	s.mockConfig.AssertExpectations(s.T())
+}
+
+func (s *DriverTestSuite) TestStack() {
+	s.mockConfig.On("Get", "cache.stores.stack.stores").Return([]string{"memory"}).Once()
+	s.mockConfig.On("GetString", "cache.stores.memory.driver").Return("memory").Once()
+	s.mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	s.mockConfig.On("GetInt", "cache.stores.memory.sweep_interval", 60).Return(60).Once()
+	s.mockConfig.On("GetInt", "cache.stores.memory.max_entries", 0).Return(0).Once()
+	s.mockConfig.On("GetInt", "cache.stores.memory.max_bytes", 0).Return(0).Once()
+	s.mockConfig.On("GetInt", "cache.stores.stack.jitter", 0).Return(10).Once()
+	s.mockConfig.On("GetInt", "cache.stores.stack.ttl", 60).Return(60).Once()
+	s.mockConfig.On("GetInt", "cache.stores.stack.size", 1000).Return(1000).Once()
+
+	stack, err := s.driver.stack("stack")
+	s.NotNil(stack)
+	s.Nil(err)
+
+	s.mockConfig.On("Get", "cache.stores.stack.stores").Return([]string{"stack"}).Once()
+	stack, err = s.driver.stack("stack")
+	s.Nil(stack)
+	s.EqualError(err, "init stack driver error: stack can't contain itself")
+
+	s.mockConfig.On("Get", "cache.stores.stack.stores").Return(nil).Once()
+	stack, err = s.driver.stack("stack")
+	s.Nil(stack)
+	s.EqualError(err, "init stack driver error: the stores of stack are empty")
+
+	s.mockConfig.AssertExpectations(s.T())
 }
 
 func (s *DriverTestSuite) TestCustom() {
@@ -50,6 +102,10 @@ func (s *DriverTestSuite) TestCustom() {
 func (s *DriverTestSuite) TestStore() {
 	s.mockConfig.On("GetString", "cache.stores.memory.driver").Return("memory").Once()
 	s.mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	s.mockConfig.On("GetInt", "cache.stores.memory.sweep_interval", 60).Return(60).Once()
+	s.mockConfig.On("GetInt", "cache.stores.memory.max_entries", 0).Return(0).Once()
+	s.mockConfig.On("GetInt", "cache.stores.memory.max_bytes", 0).Return(0).Once()
+	s.mockConfig.On("GetBool", "cache.stores.memory.fallback").Return(false).Once()
 
 	memory, err := NewApplication(s.mockConfig, s.mockLog, "memory")
 	s.NotNil(memory)
@@ -59,12 +115,14 @@ func (s *DriverTestSuite) TestStore() {
 
 	s.mockConfig.On("GetString", "cache.stores.custom.driver").Return("custom").Once()
 	s.mockConfig.On("Get", "cache.stores.custom.via").Return(&Store{}).Once()
+	s.mockConfig.On("GetBool", "cache.stores.custom.fallback").Return(false).Once()
 
 	custom := memory.Store("custom")
 	s.NotNil(custom)
-	s.Equal("", custom.GetString("hello"))
+	// The typed getters of the stores are resolved from Get, so the cache events can be dispatched.
+	s.Equal("hello", custom.GetString("hello"))
 	s.True(custom.Add("hello", "world", 5*time.Second))
-	s.Equal("", custom.GetString("hello"))
+	s.Equal("hello", custom.GetString("hello"))
 
 	s.Equal("goravel", memory.GetString("hello"))
 
@@ -0,0 +1,429 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/spf13/cast"
+	"golang.org/x/sync/singleflight"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+)
+
+// dynamoDBBatchSize The max number of the items deleted by a BatchWriteItem request.
+const dynamoDBBatchSize = 25
+
+// DynamoDB Store the items in a DynamoDB table, the table has a string partition key, and the expiration attribute
+// should be set as the TTL attribute of the table, so DynamoDB removes the expired items. DynamoDB removes them
+// lazily, so the expired items are ignored when they are read.
+type DynamoDB struct {
+	ctx        context.Context
+	client     dynamodbiface.DynamoDBAPI
+	prefix     string
+	table      string
+	key        string
+	value      string
+	expiration string
+	// flights The executions of the callbacks of Remember, they are shared by the instances with different contexts.
+	flights *singleflight.Group
+}
+
+func NewDynamoDB(config config.Config, store string) (*DynamoDB, error) {
+	awsConfig := aws.NewConfig().WithRegion(config.GetString(fmt.Sprintf("cache.stores.%s.region", store)))
+	if key := config.GetString(fmt.Sprintf("cache.stores.%s.key", store)); key != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(key, config.GetString(fmt.Sprintf("cache.stores.%s.secret", store)), ""))
+	}
+	if endpoint := config.GetString(fmt.Sprintf("cache.stores.%s.endpoint", store)); endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(endpoint)
+	}
+
+	instance, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDynamoDBWithClient(config, store, dynamodb.New(instance)), nil
+}
+
+// NewDynamoDBWithClient Create the store with a DynamoDB client, e.g. a client sharing the session of the application.
+func NewDynamoDBWithClient(config config.Config, store string, client dynamodbiface.DynamoDBAPI) *DynamoDB {
+	return &DynamoDB{
+		ctx:        context.Background(),
+		client:     client,
+		prefix:     prefix(config),
+		table:      config.GetString(fmt.Sprintf("cache.stores.%s.table", store), "cache"),
+		key:        config.GetString(fmt.Sprintf("cache.stores.%s.attributes.key", store), "key"),
+		value:      config.GetString(fmt.Sprintf("cache.stores.%s.attributes.value", store), "value"),
+		expiration: config.GetString(fmt.Sprintf("cache.stores.%s.attributes.expiration", store), "expires_at"),
+		flights:    &singleflight.Group{},
+	}
+}
+
+// Add Driver an item in the cache if the key does not exist or the item has expired.
+func (r *DynamoDB) Add(key string, value any, t time.Duration) bool {
+	item, err := r.item(key, value, t)
+	if err != nil {
+		return false
+	}
+
+	_, err = r.client.PutItemWithContext(r.ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.table),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(#key) OR #expiration <= :now"),
+		ExpressionAttributeNames: map[string]*string{
+			"#key":        aws.String(r.key),
+			"#expiration": aws.String(r.expiration),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":now": r.number(time.Now().Unix()),
+		},
+	})
+
+	return err == nil
+}
+
+// Decrement Decrement the value of an item in the cache.
+func (r *DynamoDB) Decrement(key string, value ...int64) (int64, error) {
+	if len(value) == 0 {
+		value = append(value, 1)
+	}
+
+	return r.Increment(key, -value[0])
+}
+
+// Forever Driver an item in the cache indefinitely.
+func (r *DynamoDB) Forever(key string, value any) bool {
+	if err := r.Put(key, value, NoExpiration); err != nil {
+		return false
+	}
+
+	return true
+}
+
+// Forget Remove an item from the cache.
+func (r *DynamoDB) Forget(key string) bool {
+	_, err := r.client.DeleteItemWithContext(r.ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(r.table),
+		Key:       r.itemKey(key),
+	})
+
+	return err == nil
+}
+
+// Flush Remove all items of the prefix from the cache, the table is scanned, so it's expensive on large tables.
+func (r *DynamoDB) Flush() bool {
+	var keys []map[string]*dynamodb.AttributeValue
+	err := r.client.ScanPagesWithContext(r.ctx, &dynamodb.ScanInput{
+		TableName:                aws.String(r.table),
+		ProjectionExpression:     aws.String("#key"),
+		FilterExpression:         aws.String("begins_with(#key, :prefix)"),
+		ExpressionAttributeNames: map[string]*string{"#key": aws.String(r.key)},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":prefix": {S: aws.String(r.prefix)},
+		},
+	}, func(output *dynamodb.ScanOutput, _ bool) bool {
+		keys = append(keys, output.Items...)
+
+		return true
+	})
+	if err != nil {
+		return false
+	}
+
+	for start := 0; start < len(keys); start += dynamoDBBatchSize {
+		end := min(start+dynamoDBBatchSize, len(keys))
+		requests := make([]*dynamodb.WriteRequest, 0, end-start)
+		for _, key := range keys[start:end] {
+			requests = append(requests, &dynamodb.WriteRequest{DeleteRequest: &dynamodb.DeleteRequest{Key: key}})
+		}
+
+		// The unprocessed items are throttled by DynamoDB, they are written again until all of them are processed.
+		for len(requests) > 0 {
+			output, err := r.client.BatchWriteItemWithContext(r.ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]*dynamodb.WriteRequest{r.table: requests},
+			})
+			if err != nil {
+				return false
+			}
+			requests = output.UnprocessedItems[r.table]
+		}
+	}
+
+	return true
+}
+
+// Get Retrieve an item from the cache by key.
+func (r *DynamoDB) Get(key string, def ...any) any {
+	output, err := r.client.GetItemWithContext(r.ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.table),
+		Key:            r.itemKey(key),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err == nil && output.Item != nil && !r.expired(output.Item) {
+		if value, exist := output.Item[r.value]; exist {
+			return r.unmarshal(value)
+		}
+	}
+
+	if len(def) == 0 {
+		return nil
+	}
+
+	switch s := def[0].(type) {
+	case func() any:
+		return s()
+	default:
+		return s
+	}
+}
+
+func (r *DynamoDB) GetBool(key string, def ...bool) bool {
+	if len(def) == 0 {
+		def = append(def, false)
+	}
+
+	return cast.ToBool(r.Get(key, def[0]))
+}
+
+func (r *DynamoDB) GetInt(key string, def ...int) int {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt(r.Get(key, def[0]))
+}
+
+func (r *DynamoDB) GetInt64(key string, def ...int64) int64 {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt64(r.Get(key, def[0]))
+}
+
+func (r *DynamoDB) GetString(key string, def ...string) string {
+	if len(def) == 0 {
+		def = append(def, "")
+	}
+
+	return cast.ToString(r.Get(key, def[0]))
+}
+
+// Has Check an item exists in the cache.
+func (r *DynamoDB) Has(key string) bool {
+	return r.Get(key) != nil
+}
+
+// Increment Increment the value of an item in the cache atomically, the item is created with the value if it
+// doesn't exist or has expired, and the expiration of an existing item is kept.
+func (r *DynamoDB) Increment(key string, value ...int64) (int64, error) {
+	if len(value) == 0 {
+		value = append(value, 1)
+	}
+
+	for {
+		output, err := r.client.UpdateItemWithContext(r.ctx, &dynamodb.UpdateItemInput{
+			TableName:           aws.String(r.table),
+			Key:                 r.itemKey(key),
+			UpdateExpression:    aws.String("SET #value = if_not_exists(#value, :zero) + :amount"),
+			ConditionExpression: aws.String("attribute_not_exists(#expiration) OR #expiration > :now"),
+			ExpressionAttributeNames: map[string]*string{
+				"#value":      aws.String(r.value),
+				"#expiration": aws.String(r.expiration),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":zero":   r.number(0),
+				":amount": r.number(value[0]),
+				":now":    r.number(time.Now().Unix()),
+			},
+			ReturnValues: aws.String(dynamodb.ReturnValueUpdatedNew),
+		})
+		if err == nil {
+			return r.incremented(key, output)
+		}
+		if !conditionFailed(err) {
+			return 0, err
+		}
+
+		// The item has expired but isn't removed by DynamoDB yet, so it's replaced by a new counter, and the
+		// increment is retried if another process replaces it first.
+		if r.Add(key, value[0], NoExpiration) {
+			return value[0], nil
+		}
+	}
+}
+
+// incremented Get the value of the item returned by the update of Increment.
+func (r *DynamoDB) incremented(key string, output *dynamodb.UpdateItemOutput) (int64, error) {
+	attribute, exist := output.Attributes[r.value]
+	if !exist || attribute.N == nil {
+		return 0, fmt.Errorf("value type of %s is not a number", key)
+	}
+
+	return strconv.ParseInt(*attribute.N, 10, 64)
+}
+
+func (r *DynamoDB) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return NewLock(r, key, t...)
+}
+
+func (r *DynamoDB) acquireLock(key, owner string, t *time.Duration) bool {
+	if t == nil {
+		return r.Add(key, owner, NoExpiration)
+	}
+
+	return r.Add(key, owner, *t)
+}
+
+// releaseLock Delete the lock only if it's held by the owner, the condition is checked by DynamoDB, so the lock
+// acquired by another owner in the meantime isn't released.
+func (r *DynamoDB) releaseLock(key, owner string) bool {
+	_, err := r.client.DeleteItemWithContext(r.ctx, &dynamodb.DeleteItemInput{
+		TableName:           aws.String(r.table),
+		Key:                 r.itemKey(key),
+		ConditionExpression: aws.String("attribute_not_exists(#key) OR #value = :owner"),
+		ExpressionAttributeNames: map[string]*string{
+			"#key":   aws.String(r.key),
+			"#value": aws.String(r.value),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":owner": {S: aws.String(owner)},
+		},
+	})
+
+	return err == nil
+}
+
+func (r *DynamoDB) forceReleaseLock(key string) bool {
+	return r.Forget(key)
+}
+
+// Pull Retrieve an item from the cache and delete it.
+func (r *DynamoDB) Pull(key string, def ...any) any {
+	res := r.Get(key, def...)
+	r.Forget(key)
+
+	return res
+}
+
+// Put Driver an item in the cache for a given time.
+func (r *DynamoDB) Put(key string, value any, t time.Duration) error {
+	item, err := r.item(key, value, t)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.PutItemWithContext(r.ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(r.table),
+		Item:      item,
+	})
+
+	return err
+}
+
+// Remember Get an item from the cache, or execute the given Closure and store the result.
+func (r *DynamoDB) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	return remember(r.flights, r, key, ttl, callback)
+}
+
+// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
+func (r *DynamoDB) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return remember(r.flights, r, key, NoExpiration, callback)
+}
+
+func (r *DynamoDB) WithContext(ctx context.Context) contractscache.Driver {
+	store := *r
+	store.ctx = ctx
+
+	return &store
+}
+
+// item Get the attributes of an item, the numbers are stored as numbers, so they can be incremented, and the other
+// values are stored as strings.
+func (r *DynamoDB) item(key string, value any, t time.Duration) (map[string]*dynamodb.AttributeValue, error) {
+	attribute, err := r.marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	item := r.itemKey(key)
+	item[r.value] = attribute
+	if t != NoExpiration {
+		item[r.expiration] = r.number(time.Now().Add(t).Unix())
+	}
+
+	return item, nil
+}
+
+func (r *DynamoDB) itemKey(key string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		r.key: {S: aws.String(r.prefix + key)},
+	}
+}
+
+func (r *DynamoDB) expired(item map[string]*dynamodb.AttributeValue) bool {
+	attribute, exist := item[r.expiration]
+	if !exist || attribute.N == nil {
+		return false
+	}
+
+	return cast.ToInt64(*attribute.N) <= time.Now().Unix()
+}
+
+func (r *DynamoDB) marshal(value any) (*dynamodb.AttributeValue, error) {
+	switch value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return &dynamodb.AttributeValue{N: aws.String(cast.ToString(value))}, nil
+	}
+
+	if str, err := cast.ToStringE(value); err == nil {
+		return &dynamodb.AttributeValue{S: aws.String(str)}, nil
+	}
+
+	// The values that can't be cast to a string, e.g. structs, are stored as JSON.
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.AttributeValue{S: aws.String(string(encoded))}, nil
+}
+
+func (r *DynamoDB) unmarshal(attribute *dynamodb.AttributeValue) any {
+	switch {
+	case attribute.S != nil:
+		return *attribute.S
+	case attribute.N != nil:
+		if value, err := strconv.ParseInt(*attribute.N, 10, 64); err == nil {
+			return value
+		}
+
+		return cast.ToFloat64(*attribute.N)
+	case attribute.BOOL != nil:
+		return *attribute.BOOL
+	default:
+		return nil
+	}
+}
+
+// conditionFailed Check whether a request failed because of its condition expression.
+func conditionFailed(err error) bool {
+	var awsErr awserr.Error
+
+	return errors.As(err, &awsErr) && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+}
+
+func (r *DynamoDB) number(value int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(value, 10))}
+}
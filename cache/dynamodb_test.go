@@ -0,0 +1,264 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	configmock "github.com/goravel/framework/mocks/config"
+)
+
+// testDynamoDBClient An in-memory table evaluating the conditions used by the DynamoDB store.
+type testDynamoDBClient struct {
+	dynamodbiface.DynamoDBAPI
+	items map[string]map[string]*dynamodb.AttributeValue
+	mu    sync.Mutex
+}
+
+func newTestDynamoDBClient() *testDynamoDBClient {
+	return &testDynamoDBClient{items: make(map[string]map[string]*dynamodb.AttributeValue)}
+}
+
+func (r *testDynamoDBClient) conditionFailed() error {
+	return awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "the conditional request failed", nil)
+}
+
+func (r *testDynamoDBClient) GetItemWithContext(_ aws.Context, input *dynamodb.GetItemInput, _ ...request.Option) (*dynamodb.GetItemOutput, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &dynamodb.GetItemOutput{Item: r.items[*input.Key["key"].S]}, nil
+}
+
+func (r *testDynamoDBClient) PutItemWithContext(_ aws.Context, input *dynamodb.PutItemInput, _ ...request.Option) (*dynamodb.PutItemOutput, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := *input.Item["key"].S
+	if existing, exist := r.items[key]; exist && input.ConditionExpression != nil {
+		expiration, ok := existing["expires_at"]
+		if !ok || *expiration.N > *input.ExpressionAttributeValues[":now"].N {
+			return nil, r.conditionFailed()
+		}
+	}
+	r.items[key] = input.Item
+
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (r *testDynamoDBClient) DeleteItemWithContext(_ aws.Context, input *dynamodb.DeleteItemInput, _ ...request.Option) (*dynamodb.DeleteItemOutput, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := *input.Key["key"].S
+	if existing, exist := r.items[key]; exist && input.ConditionExpression != nil && *existing["value"].S != *input.ExpressionAttributeValues[":owner"].S {
+		return nil, r.conditionFailed()
+	}
+	delete(r.items, key)
+
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func (r *testDynamoDBClient) UpdateItemWithContext(_ aws.Context, input *dynamodb.UpdateItemInput, _ ...request.Option) (*dynamodb.UpdateItemOutput, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := *input.Key["key"].S
+	item, exist := r.items[key]
+	if !exist {
+		item = map[string]*dynamodb.AttributeValue{"key": input.Key["key"], "value": input.ExpressionAttributeValues[":zero"]}
+		r.items[key] = item
+	} else if expiration, ok := item["expires_at"]; ok && input.ConditionExpression != nil && *expiration.N <= *input.ExpressionAttributeValues[":now"].N {
+		return nil, r.conditionFailed()
+	}
+	if item["value"].N == nil {
+		return nil, awserr.New("ValidationException", "an operand in the update expression has an incorrect data type", nil)
+	}
+
+	current, _ := strconv.ParseInt(*item["value"].N, 10, 64)
+	amount, _ := strconv.ParseInt(*input.ExpressionAttributeValues[":amount"].N, 10, 64)
+	item["value"] = &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(current+amount, 10))}
+
+	return &dynamodb.UpdateItemOutput{Attributes: map[string]*dynamodb.AttributeValue{"value": item["value"]}}, nil
+}
+
+func (r *testDynamoDBClient) ScanPagesWithContext(_ aws.Context, input *dynamodb.ScanInput, fn func(*dynamodb.ScanOutput, bool) bool, _ ...request.Option) error {
+	r.mu.Lock()
+	var items []map[string]*dynamodb.AttributeValue
+	for key, item := range r.items {
+		if strings.HasPrefix(key, *input.ExpressionAttributeValues[":prefix"].S) {
+			items = append(items, map[string]*dynamodb.AttributeValue{"key": item["key"]})
+		}
+	}
+	r.mu.Unlock()
+
+	fn(&dynamodb.ScanOutput{Items: items}, true)
+
+	return nil
+}
+
+func (r *testDynamoDBClient) BatchWriteItemWithContext(_ aws.Context, input *dynamodb.BatchWriteItemInput, _ ...request.Option) (*dynamodb.BatchWriteItemOutput, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, requests := range input.RequestItems {
+		for _, writeRequest := range requests {
+			delete(r.items, *writeRequest.DeleteRequest.Key["key"].S)
+		}
+	}
+
+	return &dynamodb.BatchWriteItemOutput{}, nil
+}
+
+type DynamoDBTestSuite struct {
+	suite.Suite
+	client   *testDynamoDBClient
+	dynamodb *DynamoDB
+}
+
+func TestDynamoDBTestSuite(t *testing.T) {
+	suite.Run(t, new(DynamoDBTestSuite))
+}
+
+func (s *DynamoDBTestSuite) SetupTest() {
+	mockConfig := &configmock.Config{}
+	mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
+	mockConfig.On("GetString", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(func(_ string, def ...any) string {
+		return def[0].(string)
+	})
+
+	s.client = newTestDynamoDBClient()
+	s.dynamodb = NewDynamoDBWithClient(mockConfig, "dynamodb", s.client)
+}
+
+func (s *DynamoDBTestSuite) TestAdd() {
+	s.Nil(s.dynamodb.Put("name", "Goravel", time.Hour))
+	s.False(s.dynamodb.Add("name", "World", time.Hour))
+	s.True(s.dynamodb.Add("name1", "World", time.Hour))
+	s.Equal("World", s.dynamodb.GetString("name1"))
+
+	// The expired items are replaced, even if DynamoDB hasn't removed them yet.
+	s.client.items["goravel_cache:name2"] = map[string]*dynamodb.AttributeValue{
+		"key":        {S: aws.String("goravel_cache:name2")},
+		"value":      {S: aws.String("Expired")},
+		"expires_at": {N: aws.String(strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10))},
+	}
+	s.False(s.dynamodb.Has("name2"))
+	s.True(s.dynamodb.Add("name2", "World", time.Hour))
+	s.Equal("World", s.dynamodb.Get("name2"))
+}
+
+func (s *DynamoDBTestSuite) TestPutAndGet() {
+	s.Nil(s.dynamodb.Put("string", "Goravel", time.Hour))
+	s.Nil(s.dynamodb.Put("int", 1, time.Hour))
+	s.Nil(s.dynamodb.Put("bool", true, time.Hour))
+	s.Nil(s.dynamodb.Put("struct", struct{ Name string }{Name: "Goravel"}, time.Hour))
+	s.True(s.dynamodb.Forever("forever", "Goravel"))
+
+	s.Equal("Goravel", s.dynamodb.Get("string"))
+	s.Equal(int64(1), s.dynamodb.Get("int"))
+	s.Equal(1, s.dynamodb.GetInt("int"))
+	s.True(s.dynamodb.GetBool("bool"))
+	s.Equal(`{"Name":"Goravel"}`, s.dynamodb.GetString("struct"))
+	s.Equal("Goravel", s.dynamodb.GetString("forever"))
+	s.NotContains(s.client.items["goravel_cache:forever"], "expires_at")
+	s.Contains(s.client.items["goravel_cache:string"], "expires_at")
+
+	s.Equal("default", s.dynamodb.Get("missing", "default"))
+	s.Equal("default", s.dynamodb.Get("missing", func() any { return "default" }))
+	s.Equal(int64(2), s.dynamodb.GetInt64("missing", 2))
+
+	s.Equal("Goravel", s.dynamodb.Pull("string"))
+	s.False(s.dynamodb.Has("string"))
+	s.True(s.dynamodb.Forget("int"))
+	s.False(s.dynamodb.Has("int"))
+}
+
+func (s *DynamoDBTestSuite) TestIncrementAndDecrement() {
+	res, err := s.dynamodb.Increment("counter")
+	s.Nil(err)
+	s.Equal(int64(1), res)
+
+	res, err = s.dynamodb.Increment("counter", 4)
+	s.Nil(err)
+	s.Equal(int64(5), res)
+
+	res, err = s.dynamodb.Decrement("counter", 2)
+	s.Nil(err)
+	s.Equal(int64(3), res)
+	s.Equal(3, s.dynamodb.GetInt("counter"))
+
+	s.Nil(s.dynamodb.Put("name", "Goravel", time.Hour))
+	_, err = s.dynamodb.Increment("name")
+	s.Error(err)
+
+	// The counter that has expired but isn't removed by DynamoDB yet is reset.
+	s.Nil(s.dynamodb.Put("attempts", 5, time.Hour))
+	s.client.items["goravel_cache:attempts"]["expires_at"] = s.dynamodb.number(time.Now().Add(-time.Second).Unix())
+	s.False(s.dynamodb.Has("attempts"))
+	res, err = s.dynamodb.Increment("attempts", 2)
+	s.Nil(err)
+	s.Equal(int64(2), res)
+	s.Equal(2, s.dynamodb.GetInt("attempts"))
+	res, err = s.dynamodb.Increment("attempts")
+	s.Nil(err)
+	s.Equal(int64(3), res)
+}
+
+func (s *DynamoDBTestSuite) TestRemember() {
+	value, err := s.dynamodb.Remember("name", time.Hour, func() (any, error) {
+		return "Goravel", nil
+	})
+	s.Nil(err)
+	s.Equal("Goravel", value)
+
+	value, err = s.dynamodb.RememberForever("name", func() (any, error) {
+		return "World", nil
+	})
+	s.Nil(err)
+	s.Equal("Goravel", value)
+}
+
+func (s *DynamoDBTestSuite) TestLock() {
+	lock := s.dynamodb.Lock("lock", time.Hour)
+	s.True(lock.Get())
+
+	other := s.dynamodb.Lock("lock", time.Hour)
+	s.False(other.Get())
+	s.False(other.Release())
+	s.True(lock.Release())
+	s.True(other.Get())
+	s.True(lock.ForceRelease())
+	s.True(lock.Get())
+}
+
+func (s *DynamoDBTestSuite) TestFlush() {
+	s.Nil(s.dynamodb.Put("name", "Goravel", time.Hour))
+	s.True(s.dynamodb.Forever("forever", "Goravel"))
+	s.client.items["other:name"] = map[string]*dynamodb.AttributeValue{"key": {S: aws.String("other:name")}}
+
+	s.True(s.dynamodb.Flush())
+	s.False(s.dynamodb.Has("name"))
+	s.False(s.dynamodb.Has("forever"))
+	s.Contains(s.client.items, "other:name")
+}
+
+func (s *DynamoDBTestSuite) TestWithContext() {
+	type key string
+	ctx := context.WithValue(context.Background(), key("name"), "Goravel")
+
+	store := s.dynamodb.WithContext(ctx).(*DynamoDB)
+	s.Equal(ctx, store.ctx)
+	s.Equal(context.Background(), s.dynamodb.ctx)
+}
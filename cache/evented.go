@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cast"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/event"
+)
+
+// Evented Dispatch the CacheHit, CacheMissed, KeyWritten and KeyForgotten events for the operations of a store.
+type Evented struct {
+	dispatch func(e event.Event, args []event.Arg)
+	driver   contractscache.Driver
+	store    string
+	tags     []string
+}
+
+func NewEvented(driver contractscache.Driver, store string, dispatch func(e event.Event, args []event.Arg)) *Evented {
+	return &Evented{dispatch: dispatch, driver: driver, store: store}
+}
+
+// Unwrap Get the store whose operations dispatch the events, the optional capabilities are implemented by it.
+func (r *Evented) Unwrap() contractscache.Driver {
+	return r.driver
+}
+
+func (r *Evented) Add(key string, value any, t time.Duration) bool {
+	if !r.driver.Add(key, value, t) {
+		return false
+	}
+	r.event(&KeyWritten{}, key)
+
+	return true
+}
+
+func (r *Evented) Decrement(key string, value ...int64) (int64, error) {
+	return r.driver.Decrement(key, value...)
+}
+
+func (r *Evented) Forever(key string, value any) bool {
+	if !r.driver.Forever(key, value) {
+		return false
+	}
+	r.event(&KeyWritten{}, key)
+
+	return true
+}
+
+func (r *Evented) Forget(key string) bool {
+	if !r.driver.Forget(key) {
+		return false
+	}
+	r.event(&KeyForgotten{}, key)
+
+	return true
+}
+
+func (r *Evented) Flush() bool {
+	return r.driver.Flush()
+}
+
+func (r *Evented) Get(key string, def ...any) any {
+	if val := r.driver.Get(key, nil); val != nil {
+		r.event(&CacheHit{}, key)
+
+		return val
+	}
+	r.event(&CacheMissed{}, key)
+
+	return defaultValue(def...)
+}
+
+func (r *Evented) GetBool(key string, def ...bool) bool {
+	if len(def) == 0 {
+		def = append(def, false)
+	}
+
+	return cast.ToBool(r.Get(key, def[0]))
+}
+
+func (r *Evented) GetInt(key string, def ...int) int {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt(r.Get(key, def[0]))
+}
+
+func (r *Evented) GetInt64(key string, def ...int64) int64 {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt64(r.Get(key, def[0]))
+}
+
+func (r *Evented) GetString(key string, def ...string) string {
+	if len(def) == 0 {
+		def = append(def, "")
+	}
+
+	return cast.ToString(r.Get(key, def[0]))
+}
+
+func (r *Evented) Has(key string) bool {
+	if !r.driver.Has(key) {
+		r.event(&CacheMissed{}, key)
+
+		return false
+	}
+	r.event(&CacheHit{}, key)
+
+	return true
+}
+
+func (r *Evented) Increment(key string, value ...int64) (int64, error) {
+	return r.driver.Increment(key, value...)
+}
+
+func (r *Evented) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return r.driver.Lock(key, t...)
+}
+
+func (r *Evented) Put(key string, value any, t time.Duration) error {
+	if err := r.driver.Put(key, value, t); err != nil {
+		return err
+	}
+	r.event(&KeyWritten{}, key)
+
+	return nil
+}
+
+func (r *Evented) Pull(key string, def ...any) any {
+	val := r.driver.Pull(key, nil)
+	if val == nil {
+		r.event(&CacheMissed{}, key)
+
+		return defaultValue(def...)
+	}
+	r.event(&CacheHit{}, key)
+	r.event(&KeyForgotten{}, key)
+
+	return val
+}
+
+// Remember Get an item from the cache, or execute the callback and store the result, the item is missed and written
+// if the callback is executed by this call.
+func (r *Evented) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	return r.remember(key, callback, func(callback func() (any, error)) (any, error) {
+		return r.driver.Remember(key, ttl, callback)
+	})
+}
+
+func (r *Evented) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.remember(key, callback, func(callback func() (any, error)) (any, error) {
+		return r.driver.RememberForever(key, callback)
+	})
+}
+
+// Tags Get a cache instance whose items are tagged with the given names, the tags are passed to the events.
+func (r *Evented) Tags(names ...string) contractscache.Driver {
+	evented := *r
+	evented.driver = Tags(r.driver, names...)
+	evented.tags = append(append([]string{}, r.tags...), names...)
+
+	return &evented
+}
+
+func (r *Evented) WithContext(ctx context.Context) contractscache.Driver {
+	evented := *r
+	evented.driver = r.driver.WithContext(ctx)
+
+	return &evented
+}
+
+func (r *Evented) event(e event.Event, key string) {
+	if r.dispatch == nil {
+		return
+	}
+
+	tags := r.tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	r.dispatch(e, []event.Arg{
+		{Type: "string", Value: r.store},
+		{Type: "string", Value: key},
+		{Type: "[]string", Value: tags},
+	})
+}
+
+func (r *Evented) remember(key string, callback func() (any, error), remember func(func() (any, error)) (any, error)) (any, error) {
+	var executed bool
+	val, err := remember(func() (any, error) {
+		executed = true
+
+		return callback()
+	})
+	if !executed {
+		r.event(&CacheHit{}, key)
+
+		return val, err
+	}
+
+	r.event(&CacheMissed{}, key)
+	if err != nil {
+		return nil, err
+	}
+	r.event(&KeyWritten{}, key)
+
+	return val, nil
+}
+
+// defaultValue Get the default value of a missed item, the default value can be a function returning it.
+func defaultValue(def ...any) any {
+	if len(def) == 0 {
+		return nil
+	}
+
+	switch s := def[0].(type) {
+	case func() any:
+		return s()
+	default:
+		return s
+	}
+}
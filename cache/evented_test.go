@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/event"
+	configmocks "github.com/goravel/framework/mocks/config"
+	eventmocks "github.com/goravel/framework/mocks/event"
+	logmocks "github.com/goravel/framework/mocks/log"
+)
+
+func TestEvented(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+
+	var events []string
+	evented := NewEvented(memory, "memory", func(e event.Event, args []event.Arg) {
+		events = append(events, reflect.TypeOf(e).Elem().Name()+":"+args[1].Value.(string))
+		assert.Equal(t, "memory", args[0].Value)
+	})
+	assert.Equal(t, memory, evented.Unwrap())
+
+	assert.Equal(t, "default", evented.Get("name", "default"))
+	assert.Nil(t, evented.Put("name", "Goravel", time.Minute))
+	assert.Equal(t, "Goravel", evented.GetString("name"))
+	assert.True(t, evented.Has("name"))
+	assert.False(t, evented.Add("name", "World", time.Minute))
+	assert.True(t, evented.Forget("name"))
+	assert.True(t, evented.Forever("forever", "Goravel"))
+	assert.Equal(t, "Goravel", evented.Pull("forever"))
+	assert.Equal(t, []string{
+		"CacheMissed:name",
+		"KeyWritten:name",
+		"CacheHit:name",
+		"CacheHit:name",
+		"KeyForgotten:name",
+		"KeyWritten:forever",
+		"CacheHit:forever",
+		"KeyForgotten:forever",
+	}, events)
+
+	events = nil
+	value, err := evented.Remember("remember", time.Minute, func() (any, error) {
+		return "Goravel", nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Goravel", value)
+	value, err = evented.RememberForever("remember", func() (any, error) {
+		return "World", nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Goravel", value)
+	_, err = evented.Remember("error", time.Minute, func() (any, error) {
+		return nil, errors.New("error")
+	})
+	assert.EqualError(t, err, "error")
+	assert.Equal(t, []string{
+		"CacheMissed:remember",
+		"KeyWritten:remember",
+		"CacheHit:remember",
+		"CacheMissed:error",
+	}, events)
+}
+
+func TestEventedTags(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+
+	var tags [][]string
+	evented := NewEvented(memory, "memory", func(e event.Event, args []event.Arg) {
+		tags = append(tags, args[2].Value.([]string))
+	})
+
+	assert.Nil(t, evented.Put("name", "Goravel", time.Minute))
+	tagged := evented.Tags("users").(*Evented).Tags("posts")
+	assert.Nil(t, tagged.Put("name", "World", time.Minute))
+	assert.Equal(t, "World", tagged.Get("name"))
+	assert.Equal(t, [][]string{{}, {"users", "posts"}, {"users", "posts"}}, tags)
+}
+
+func TestApplicationEvents(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockEvent := eventmocks.NewInstance(t)
+	mockTask := eventmocks.NewTask(t)
+	mockConfig.EXPECT().GetString("cache.stores.memory.driver").Return("memory").Once()
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel_cache").Once()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.sweep_interval", 60).Return(60).Once()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.max_entries", 0).Return(0).Once()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.max_bytes", 0).Return(0).Once()
+	mockConfig.EXPECT().GetBool("cache.stores.memory.fallback").Return(false).Once()
+
+	app, err := NewApplication(mockConfig, logmocks.NewLog(t), "memory")
+	assert.Nil(t, err)
+
+	// The events without listeners aren't dispatched.
+	missed := &CacheMissed{}
+	app.SetEvents(func() event.Instance {
+		return mockEvent
+	})
+	mockEvent.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{
+		missed: nil,
+	}).Twice()
+	mockEvent.EXPECT().Job(missed, []event.Arg{
+		{Type: "string", Value: "memory"},
+		{Type: "string", Value: "name"},
+		{Type: "[]string", Value: []string{}},
+	}).Return(mockTask).Once()
+	mockTask.EXPECT().Dispatch().Return(nil).Once()
+
+	assert.Nil(t, app.Get("name"))
+	assert.Nil(t, app.Put("name", "Goravel", time.Minute))
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"github.com/goravel/framework/contracts/event"
+)
+
+// StoreDegraded is dispatched when a store falls back to the memory, the args are the store and the error.
+type StoreDegraded struct {
+}
+
+func (receiver *StoreDegraded) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// StoreRecovered is dispatched when a degraded store is reachable again, the args are the store.
+type StoreRecovered struct {
+}
+
+func (receiver *StoreRecovered) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// CacheHit is dispatched when an item is found in the cache, the args are the store, the key and the tags.
+type CacheHit struct {
+}
+
+func (receiver *CacheHit) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// CacheMissed is dispatched when an item isn't found in the cache, the args are the store, the key and the tags.
+type CacheMissed struct {
+}
+
+func (receiver *CacheMissed) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// KeyWritten is dispatched after an item is stored in the cache, the args are the store, the key and the tags.
+type KeyWritten struct {
+}
+
+func (receiver *KeyWritten) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// KeyForgotten is dispatched after an item is removed from the cache, the args are the store, the key and the tags.
+type KeyForgotten struct {
+}
+
+func (receiver *KeyForgotten) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
@@ -0,0 +1,225 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+)
+
+const fallbackProbeKey = "goravel:fallback:probe"
+
+// fallbackState The state of a store shared by the instances of its fallback with different contexts.
+type fallbackState struct {
+	degraded atomic.Bool
+	probing  atomic.Bool
+	probeAt  atomic.Int64
+}
+
+// Fallback Fall back to the memory when the writes of a store fail, e.g. Redis is unreachable. The items are kept in
+// the memory for the fallback TTL at most, and the store is probed in the background every probe interval until it
+// recovers, then the memory is flushed.
+type Fallback struct {
+	interval time.Duration
+	memory   *Memory
+	onChange func(degraded bool, err error)
+	primary  contractscache.Driver
+	state    *fallbackState
+	store    string
+	ttl      time.Duration
+}
+
+func NewFallback(config config.Config, store string, primary contractscache.Driver, onChange func(degraded bool, err error)) (*Fallback, error) {
+	memory, err := NewMemory(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Fallback{
+		interval: time.Duration(config.GetInt(fmt.Sprintf("cache.stores.%s.probe_interval", store), 5)) * time.Second,
+		memory:   memory,
+		onChange: onChange,
+		primary:  primary,
+		state:    &fallbackState{},
+		store:    store,
+		ttl:      time.Duration(config.GetInt(fmt.Sprintf("cache.stores.%s.fallback_ttl", store), 60)) * time.Second,
+	}, nil
+}
+
+// Degraded Determine whether the store falls back to the memory.
+func (r *Fallback) Degraded() bool {
+	return r.state.degraded.Load()
+}
+
+func (r *Fallback) Add(key string, value any, t time.Duration) bool {
+	if r.driver() == r.memory {
+		return r.memory.Add(key, value, r.clamp(t))
+	}
+
+	return r.primary.Add(key, value, t)
+}
+
+func (r *Fallback) Decrement(key string, value ...int64) (int64, error) {
+	if r.driver() != r.memory {
+		result, err := r.primary.Decrement(key, value...)
+		if err == nil {
+			return result, nil
+		}
+		r.degrade(err)
+	}
+
+	return r.memory.Decrement(key, value...)
+}
+
+func (r *Fallback) Forever(key string, value any) bool {
+	if r.driver() == r.memory {
+		return r.memory.Put(key, value, r.ttl) == nil
+	}
+
+	return r.primary.Forever(key, value)
+}
+
+func (r *Fallback) Forget(key string) bool {
+	return r.driver().Forget(key)
+}
+
+func (r *Fallback) Flush() bool {
+	return r.driver().Flush()
+}
+
+func (r *Fallback) Get(key string, def ...any) any {
+	return r.driver().Get(key, def...)
+}
+
+func (r *Fallback) GetBool(key string, def ...bool) bool {
+	return r.driver().GetBool(key, def...)
+}
+
+func (r *Fallback) GetInt(key string, def ...int) int {
+	return r.driver().GetInt(key, def...)
+}
+
+func (r *Fallback) GetInt64(key string, def ...int64) int64 {
+	return r.driver().GetInt64(key, def...)
+}
+
+func (r *Fallback) GetString(key string, def ...string) string {
+	return r.driver().GetString(key, def...)
+}
+
+func (r *Fallback) Has(key string) bool {
+	return r.driver().Has(key)
+}
+
+func (r *Fallback) Increment(key string, value ...int64) (int64, error) {
+	if r.driver() != r.memory {
+		result, err := r.primary.Increment(key, value...)
+		if err == nil {
+			return result, nil
+		}
+		r.degrade(err)
+	}
+
+	return r.memory.Increment(key, value...)
+}
+
+func (r *Fallback) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return r.driver().Lock(key, t...)
+}
+
+func (r *Fallback) Put(key string, value any, t time.Duration) error {
+	if r.driver() != r.memory {
+		err := r.primary.Put(key, value, t)
+		if err == nil {
+			return nil
+		}
+		r.degrade(err)
+	}
+
+	return r.memory.Put(key, value, r.clamp(t))
+}
+
+func (r *Fallback) Pull(key string, def ...any) any {
+	return r.driver().Pull(key, def...)
+}
+
+func (r *Fallback) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	val := r.Get(key, nil)
+	if val != nil {
+		return val, nil
+	}
+
+	val, err := callback()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Put(key, val, ttl); err != nil {
+		return nil, err
+	}
+
+	return val, nil
+}
+
+func (r *Fallback) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.Remember(key, NoExpiration, callback)
+}
+
+func (r *Fallback) WithContext(ctx context.Context) contractscache.Driver {
+	fallback := *r
+	fallback.primary = r.primary.WithContext(ctx)
+
+	return &fallback
+}
+
+// driver Get the driver serving the operations, the store is probed if it's degraded and the probe is due.
+func (r *Fallback) driver() contractscache.Driver {
+	if !r.state.degraded.Load() {
+		return r.primary
+	}
+
+	if time.Now().UnixNano() >= r.state.probeAt.Load() && r.state.probing.CompareAndSwap(false, true) {
+		go r.probe()
+	}
+
+	return r.memory
+}
+
+// clamp Limit the TTL of the items kept in the memory, so they don't outlive the outage for long.
+func (r *Fallback) clamp(t time.Duration) time.Duration {
+	if t == NoExpiration || t > r.ttl {
+		return r.ttl
+	}
+
+	return t
+}
+
+func (r *Fallback) degrade(err error) {
+	if !r.state.degraded.CompareAndSwap(false, true) {
+		return
+	}
+
+	r.state.probeAt.Store(time.Now().Add(r.interval).UnixNano())
+	if r.onChange != nil {
+		r.onChange(true, err)
+	}
+}
+
+func (r *Fallback) probe() {
+	defer r.state.probing.Store(false)
+
+	if err := r.primary.Put(fallbackProbeKey, time.Now().Unix(), time.Second); err != nil {
+		r.state.probeAt.Store(time.Now().Add(r.interval).UnixNano())
+
+		return
+	}
+
+	r.memory.Flush()
+	r.state.degraded.Store(false)
+	if r.onChange != nil {
+		r.onChange(false, nil)
+	}
+}
@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/event"
+	configmocks "github.com/goravel/framework/mocks/config"
+	eventmocks "github.com/goravel/framework/mocks/event"
+	logmocks "github.com/goravel/framework/mocks/log"
+)
+
+func TestFallback(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel_cache")
+	mockConfig.EXPECT().GetInt("cache.stores.redis.probe_interval", 5).Return(0).Once()
+	mockConfig.EXPECT().GetInt("cache.stores.redis.fallback_ttl", 60).Return(1).Once()
+
+	primary, err := NewMemory(mockConfig)
+	assert.Nil(t, err)
+	store := &unreachableStore{Memory: primary}
+
+	var mu sync.Mutex
+	var changes []bool
+	fallback, err := NewFallback(mockConfig, "redis", store, func(degraded bool, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		changes = append(changes, degraded)
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, fallback.Put("name", "goravel", time.Minute))
+	assert.Equal(t, "goravel", fallback.GetString("name"))
+	assert.False(t, fallback.Degraded())
+
+	// The writes fall back to the memory once the store is unreachable, and the items expire after the fallback TTL.
+	store.down.Store(true)
+	assert.Nil(t, fallback.Put("name", "memory", time.Minute))
+	assert.True(t, fallback.Degraded())
+	assert.Equal(t, []bool{true}, changes)
+	assert.Equal(t, "memory", fallback.GetString("name"))
+	value, err := fallback.Increment("count")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), value)
+	assert.Eventually(t, func() bool {
+		return !fallback.Has("name")
+	}, 3*time.Second, 50*time.Millisecond)
+
+	// The store is probed until it recovers, then the memory is flushed.
+	store.down.Store(false)
+	assert.Eventually(t, func() bool {
+		return !fallback.Has("count") && !fallback.Degraded()
+	}, time.Second, 10*time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, []bool{true, false}, changes)
+	mu.Unlock()
+	assert.Equal(t, "goravel", fallback.GetString("name"))
+}
+
+func TestApplicationFallback(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockLog := logmocks.NewLog(t)
+	mockEvent := eventmocks.NewInstance(t)
+	mockTask := eventmocks.NewTask(t)
+	store := &unreachableStore{}
+	mockConfig.EXPECT().GetString("cache.stores.redis.driver").Return("custom").Once()
+	mockConfig.EXPECT().Get("cache.stores.redis.via").Return(store).Once()
+	mockConfig.EXPECT().GetBool("cache.stores.redis.fallback").Return(true).Once()
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel_cache")
+	mockConfig.EXPECT().GetInt("cache.stores.redis.probe_interval", 5).Return(60).Once()
+	mockConfig.EXPECT().GetInt("cache.stores.redis.fallback_ttl", 60).Return(60).Once()
+
+	app, err := NewApplication(mockConfig, mockLog, "redis")
+	assert.Nil(t, err)
+	store.Memory, err = NewMemory(mockConfig)
+	assert.Nil(t, err)
+
+	degraded := &StoreDegraded{}
+	app.SetEvents(func() event.Instance {
+		return mockEvent
+	})
+	// The store events, and the KeyWritten and CacheHit events of Put and GetString are dispatched.
+	mockEvent.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{
+		degraded: nil,
+	}).Times(3)
+	mockEvent.EXPECT().Job(degraded, []event.Arg{{Type: "string", Value: "redis"}, {Type: "string", Value: "connection refused"}}).Return(mockTask).Once()
+	mockTask.EXPECT().Dispatch().Return(nil).Once()
+	mockLog.EXPECT().Warningf("Cache store [%s] is unreachable, falling back to the memory: %v", "redis", errors.New("connection refused")).Once()
+
+	store.down.Store(true)
+	assert.Nil(t, app.Put("name", "goravel", time.Minute))
+	assert.Equal(t, "goravel", app.GetString("name"))
+}
+
+// unreachableStore A store failing the writes while it's down, like an unreachable Redis.
+type unreachableStore struct {
+	*Memory
+	down atomic.Bool
+}
+
+func (r *unreachableStore) Put(key string, value any, t time.Duration) error {
+	if r.down.Load() {
+		return errors.New("connection refused")
+	}
+
+	return r.Memory.Put(key, value, t)
+}
+
+func (r *unreachableStore) Increment(key string, value ...int64) (int64, error) {
+	if r.down.Load() {
+		return 0, errors.New("connection refused")
+	}
+
+	return r.Memory.Increment(key, value...)
+}
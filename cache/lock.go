@@ -3,29 +3,38 @@ package cache
 import (
 	"time"
 
+	"github.com/google/uuid"
+
 	contractscache "github.com/goravel/framework/contracts/cache"
 )
 
+// lockStore is implemented by the stores that acquire and release the locks atomically, the locks of the other
+// stores are acquired by adding the owner as the value of the key, e.g. SET NX on redis.
+type lockStore interface {
+	acquireLock(key, owner string, t *time.Duration) bool
+	releaseLock(key, owner string) bool
+	forceReleaseLock(key string) bool
+}
+
 type Lock struct {
 	store contractscache.Driver
 	key   string
+	owner string
 	time  *time.Duration
 	get   bool
 }
 
 func NewLock(instance contractscache.Driver, key string, t ...time.Duration) *Lock {
-	if len(t) == 0 {
-		return &Lock{
-			store: instance,
-			key:   key,
-		}
-	}
-
-	return &Lock{
+	lock := &Lock{
 		store: instance,
 		key:   key,
-		time:  &t[0],
+		owner: uuid.NewString(),
+	}
+	if len(t) > 0 {
+		lock.time = &t[0]
 	}
+
+	return lock
 }
 
 func (r *Lock) Block(t time.Duration, callback ...func()) bool {
@@ -59,10 +68,12 @@ func (r *Lock) Block(t time.Duration, callback ...func()) bool {
 
 func (r *Lock) Get(callback ...func()) bool {
 	var res bool
-	if r.time == nil {
-		res = r.store.Add(r.key, 1, NoExpiration)
+	if store, ok := r.store.(lockStore); ok {
+		res = store.acquireLock(r.key, r.owner, r.time)
+	} else if r.time == nil {
+		res = r.store.Add(r.key, r.owner, NoExpiration)
 	} else {
-		res = r.store.Add(r.key, 1, *r.time)
+		res = r.store.Add(r.key, r.owner, *r.time)
 	}
 
 	if !res {
@@ -80,14 +91,31 @@ func (r *Lock) Get(callback ...func()) bool {
 	return r.Release()
 }
 
+// Owner Get the token identifying the owner of the lock.
+func (r *Lock) Owner() string {
+	return r.owner
+}
+
+// Release Release the lock if it isn't held by another owner, a lock that expired and was acquired by another owner
+// isn't released.
 func (r *Lock) Release() bool {
-	if r.get {
-		return r.ForceRelease()
+	if !r.get {
+		return false
+	}
+	if store, ok := r.store.(lockStore); ok {
+		return store.releaseLock(r.key, r.owner)
+	}
+	if owner := r.store.GetString(r.key); owner != "" && owner != r.owner {
+		return false
 	}
 
-	return false
+	return r.store.Forget(r.key)
 }
 
 func (r *Lock) ForceRelease() bool {
+	if store, ok := r.store.(lockStore); ok {
+		return store.forceReleaseLock(r.key)
+	}
+
 	return r.store.Forget(r.key)
 }
@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock_Driver(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+	// The locks of the drivers without native locks are acquired by adding the owner as the value of the key.
+	driver := untaggedDriver{Driver: memory}
+
+	lock := NewLock(driver, "lock", 1*time.Second)
+	assert.True(t, lock.Get())
+	assert.Equal(t, lock.Owner(), memory.GetString("lock"))
+	assert.False(t, NewLock(driver, "lock").Get())
+
+	time.Sleep(2 * time.Second)
+
+	lock1 := NewLock(driver, "lock")
+	assert.True(t, lock1.Get())
+	assert.False(t, lock.Release())
+	assert.True(t, memory.Has("lock"))
+	assert.True(t, lock1.Release())
+	assert.False(t, memory.Has("lock"))
+
+	assert.True(t, lock.Get())
+	assert.True(t, NewLock(driver, "lock").ForceRelease())
+	assert.True(t, NewLock(driver, "lock").Get(func() {}))
+	assert.False(t, memory.Has("lock"))
+}
@@ -1,13 +1,17 @@
 package cache
 
 import (
+	"container/list"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/spf13/cast"
+	"golang.org/x/sync/singleflight"
 
 	contractscache "github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
@@ -17,24 +21,78 @@ type Memory struct {
 	ctx      context.Context
 	prefix   string
 	instance sync.Map
+	// expirations The expiration times of the items, the expired items are removed when they're read or swept.
+	expirations sync.Map
+	// interval The interval between the sweeps of the expired items, a due sweep runs on a write.
+	interval time.Duration
+	sweepAt  atomic.Int64
+	// usage The recency and the sizes of the items, it's nil if the store isn't bounded.
+	usage *memoryUsage
+	// tags The keys of the tagged items, keyed by the tag names.
+	tags   sync.Map
+	hits   atomic.Int64
+	misses atomic.Int64
+	// locks The owners and the expiration times of the locks, they are guarded by the mutex, so a lock is acquired
+	// and released atomically.
+	locks   map[string]memoryLock
+	locksMu sync.Mutex
+	// flights The executions of the callbacks of Remember, they are shared by the concurrent calls of a key.
+	flights singleflight.Group
+}
+
+type memoryLock struct {
+	owner     string
+	expiresAt time.Time
 }
 
 func NewMemory(config config.Config) (*Memory, error) {
 	return &Memory{
-		prefix: prefix(config),
+		prefix:   prefix(config),
+		interval: time.Minute,
+		locks:    make(map[string]memoryLock),
 	}, nil
 }
 
+// NewBoundedMemory Create a memory store bounded by the max entries and the max bytes of the store, the least recently
+// used items are evicted once one of them is exceeded, a limit is disabled if it's 0.
+func NewBoundedMemory(config config.Config, store string) (*Memory, error) {
+	memory, err := NewMemory(config)
+	if err != nil {
+		return nil, err
+	}
+
+	memory.interval = time.Duration(config.GetInt(fmt.Sprintf("cache.stores.%s.sweep_interval", store), 60)) * time.Second
+	maxEntries := config.GetInt(fmt.Sprintf("cache.stores.%s.max_entries", store), 0)
+	maxBytes := config.GetInt(fmt.Sprintf("cache.stores.%s.max_bytes", store), 0)
+	if maxEntries > 0 || maxBytes > 0 {
+		memory.usage = newMemoryUsage(maxEntries, int64(maxBytes))
+	}
+
+	return memory, nil
+}
+
+// newLocalMemory Create an in-process memory store without a prefix, keeping the most recently used items up to the
+// max entries, it's the local layer of the stack store.
+func newLocalMemory(maxEntries int) *Memory {
+	return &Memory{
+		interval: time.Minute,
+		locks:    make(map[string]memoryLock),
+		usage:    newMemoryUsage(maxEntries, 0),
+	}
+}
+
 // Add Driver an item in the cache if the key does not exist.
 func (r *Memory) Add(key string, value any, t time.Duration) bool {
-	if t != NoExpiration {
-		time.AfterFunc(t, func() {
-			r.Forget(key)
-		})
+	key = r.key(key)
+	if _, exist := r.load(key); exist {
+		return false
+	}
+	if _, loaded := r.instance.LoadOrStore(key, value); loaded {
+		return false
 	}
+	r.stored(key, value, t)
 
-	_, loaded := r.instance.LoadOrStore(r.key(key), value)
-	return !loaded
+	return true
 }
 
 // Decrement Decrement the value of an item in the cache.
@@ -44,7 +102,7 @@ func (r *Memory) Decrement(key string, value ...int64) (int64, error) {
 	}
 
 	r.Add(key, new(int64), NoExpiration)
-	pv := r.Get(key)
+	pv := r.counter(key, r.Get(key))
 	switch nv := pv.(type) {
 	case *atomic.Int64:
 		return nv.Add(-value[0]), nil
@@ -59,6 +117,24 @@ func (r *Memory) Decrement(key string, value ...int64) (int64, error) {
 	}
 }
 
+// counter Replace an integer stored by Put with a counter, so it can be incremented like the other stores, the
+// expiration of the item is kept.
+func (r *Memory) counter(key string, value any) any {
+	switch value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+	default:
+		return value
+	}
+
+	counter := cast.ToInt64(value)
+	if r.instance.CompareAndSwap(r.key(key), value, &counter) {
+		return &counter
+	}
+
+	// The item has been replaced in the meantime, e.g. by a concurrent increment.
+	return r.Get(key)
+}
+
 // Forever Driver an item in the cache indefinitely.
 func (r *Memory) Forever(key string, value any) bool {
 	if err := r.Put(key, value, NoExpiration); err != nil {
@@ -70,7 +146,7 @@ func (r *Memory) Forever(key string, value any) bool {
 
 // Forget Remove an item from the cache.
 func (r *Memory) Forget(key string) bool {
-	r.instance.Delete(r.key(key))
+	r.delete(r.key(key))
 
 	return true
 }
@@ -78,15 +154,23 @@ func (r *Memory) Forget(key string) bool {
 // Flush Remove all items from the cache.
 func (r *Memory) Flush() bool {
 	r.instance = sync.Map{}
+	r.expirations = sync.Map{}
+	r.tags = sync.Map{}
+	if r.usage != nil {
+		r.usage.reset()
+	}
 	return true
 }
 
 // Get Retrieve an item from the cache by key.
 func (r *Memory) Get(key string, def ...any) any {
-	val, exist := r.instance.Load(r.key(key))
+	val, exist := r.load(r.key(key))
 	if exist {
+		r.hits.Add(1)
+
 		return val
 	}
+	r.misses.Add(1)
 	if len(def) == 0 {
 		return nil
 	}
@@ -134,7 +218,7 @@ func (r *Memory) GetString(key string, def ...string) string {
 
 // Has Check an item exists in the cache.
 func (r *Memory) Has(key string) bool {
-	_, exist := r.instance.Load(r.key(key))
+	_, exist := r.load(r.key(key))
 	return exist
 }
 
@@ -144,7 +228,7 @@ func (r *Memory) Increment(key string, value ...int64) (int64, error) {
 	}
 
 	r.Add(key, new(int64), NoExpiration)
-	pv := r.Get(key)
+	pv := r.counter(key, r.Get(key))
 	switch nv := pv.(type) {
 	case *atomic.Int64:
 		return nv.Add(value[0]), nil
@@ -159,10 +243,72 @@ func (r *Memory) Increment(key string, value ...int64) (int64, error) {
 	}
 }
 
+// Prune Remove the expired items, the expired items are otherwise removed when they're read or swept.
+func (r *Memory) Prune() (int, error) {
+	return r.prune(time.Now()), nil
+}
+
+// Keys Get the keys matching the pattern, the keys are returned without the prefix.
+func (r *Memory) Keys(pattern string) ([]string, error) {
+	var keys []string
+	r.instance.Range(func(key, _ any) bool {
+		if r.expired(key.(string)) {
+			return true
+		}
+		if name, ok := strings.CutPrefix(cast.ToString(key), r.prefix); ok && match(pattern, name) {
+			keys = append(keys, name)
+		}
+
+		return true
+	})
+
+	return keys, nil
+}
+
 func (r *Memory) Lock(key string, t ...time.Duration) contractscache.Lock {
 	return NewLock(r, key, t...)
 }
 
+func (r *Memory) acquireLock(key, owner string, t *time.Duration) bool {
+	r.locksMu.Lock()
+	defer r.locksMu.Unlock()
+
+	key = r.key(key)
+	if lock, exist := r.locks[key]; exist && (lock.expiresAt.IsZero() || time.Now().Before(lock.expiresAt)) {
+		return false
+	}
+
+	lock := memoryLock{owner: owner}
+	if t != nil && *t != NoExpiration {
+		lock.expiresAt = time.Now().Add(*t)
+	}
+	r.locks[key] = lock
+
+	return true
+}
+
+func (r *Memory) releaseLock(key, owner string) bool {
+	r.locksMu.Lock()
+	defer r.locksMu.Unlock()
+
+	key = r.key(key)
+	if lock, exist := r.locks[key]; exist && lock.owner != owner {
+		return false
+	}
+	delete(r.locks, key)
+
+	return true
+}
+
+func (r *Memory) forceReleaseLock(key string) bool {
+	r.locksMu.Lock()
+	defer r.locksMu.Unlock()
+
+	delete(r.locks, r.key(key))
+
+	return true
+}
+
 // Pull Retrieve an item from the cache and delete it.
 func (r *Memory) Pull(key string, def ...any) any {
 	var res any
@@ -176,64 +322,246 @@ func (r *Memory) Pull(key string, def ...any) any {
 	return res
 }
 
+// Stats Get the statistics of the store, the memory usage is estimated if the store is bounded, otherwise it isn't
+// tracked.
+func (r *Memory) Stats() (contractscache.Stats, error) {
+	var keys int64
+	r.instance.Range(func(key, _ any) bool {
+		if !r.expired(key.(string)) {
+			keys++
+		}
+
+		return true
+	})
+
+	memory := int64(-1)
+	if r.usage != nil {
+		memory = r.usage.size()
+	}
+
+	return contractscache.Stats{
+		Keys:   keys,
+		Memory: memory,
+		Hits:   r.hits.Load(),
+		Misses: r.misses.Load(),
+	}, nil
+}
+
 // Put Driver an item in the cache for a given number of seconds.
 func (r *Memory) Put(key string, value any, t time.Duration) error {
-	if t != NoExpiration {
-		time.AfterFunc(t, func() {
-			r.Forget(key)
-		})
-	}
+	key = r.key(key)
+	r.instance.Store(key, value)
+	r.stored(key, value, t)
 
-	r.instance.Store(r.key(key), value)
 	return nil
 }
 
 // Remember Get an item from the cache, or execute the given Closure and store the result.
 func (r *Memory) Remember(key string, seconds time.Duration, callback func() (any, error)) (any, error) {
-	val := r.Get(key, nil)
-	if val != nil {
-		return val, nil
+	return remember(&r.flights, r, key, seconds, callback)
+}
+
+// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
+func (r *Memory) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return remember(&r.flights, r, key, NoExpiration, callback)
+}
+
+// Tags Get a cache instance whose items are tagged with the given names.
+func (r *Memory) Tags(names ...string) contractscache.Driver {
+	return &MemoryTaggedCache{Memory: r, names: names}
+}
+
+func (r *Memory) WithContext(ctx context.Context) contractscache.Driver {
+	r.ctx = ctx
+
+	return r
+}
+
+func (r *Memory) key(key string) string {
+	return r.prefix + key
+}
+
+// load Load an item by the prefixed key, an expired item is removed.
+func (r *Memory) load(key string) (any, bool) {
+	val, exist := r.instance.Load(key)
+	if !exist {
+		return nil, false
 	}
+	if r.expired(key) {
+		r.delete(key)
 
-	var err error
-	val, err = callback()
-	if err != nil {
-		return nil, err
+		return nil, false
+	}
+	if r.usage != nil {
+		r.usage.access(key)
 	}
 
-	if err := r.Put(key, val, seconds); err != nil {
-		return nil, err
+	return val, true
+}
+
+// stored Record the expiration time and the size of a stored item, the least recently used items are evicted if the
+// store is over its limits.
+func (r *Memory) stored(key string, value any, t time.Duration) {
+	if t == NoExpiration {
+		r.expirations.Delete(key)
+	} else {
+		r.expirations.Store(key, time.Now().Add(t))
 	}
 
-	return val, nil
+	if r.usage != nil {
+		for _, evicted := range r.usage.add(key, memorySize(key, value)) {
+			r.delete(evicted)
+		}
+	}
+
+	r.sweep()
 }
 
-// RememberForever Get an item from the cache, or execute the given Closure and store the result forever.
-func (r *Memory) RememberForever(key string, callback func() (any, error)) (any, error) {
-	val := r.Get(key, nil)
-	if val != nil {
-		return val, nil
+func (r *Memory) expired(key string) bool {
+	expiresAt, exist := r.expirations.Load(key)
+
+	return exist && time.Now().After(expiresAt.(time.Time))
+}
+
+func (r *Memory) delete(key string) {
+	r.instance.Delete(key)
+	r.expirations.Delete(key)
+	if r.usage != nil {
+		r.usage.remove(key)
 	}
+}
 
-	var err error
-	val, err = callback()
-	if err != nil {
-		return nil, err
+// sweep Remove the expired items if the sweep is due, so the items that are never read again don't stay forever.
+func (r *Memory) sweep() {
+	if r.interval <= 0 {
+		return
 	}
 
-	if err = r.Put(key, val, NoExpiration); err != nil {
-		return nil, err
+	now := time.Now()
+	sweepAt := r.sweepAt.Load()
+	if now.UnixNano() < sweepAt || !r.sweepAt.CompareAndSwap(sweepAt, now.Add(r.interval).UnixNano()) {
+		return
 	}
 
-	return val, nil
+	r.prune(now)
 }
 
-func (r *Memory) WithContext(ctx context.Context) contractscache.Driver {
-	r.ctx = ctx
+// prune Remove the items expired by now, and return the number of removed items.
+func (r *Memory) prune(now time.Time) int {
+	count := 0
+	r.expirations.Range(func(key, expiresAt any) bool {
+		if now.After(expiresAt.(time.Time)) {
+			r.delete(key.(string))
+			count++
+		}
 
-	return r
+		return true
+	})
+
+	return count
 }
 
-func (r *Memory) key(key string) string {
-	return r.prefix + key
+// memoryUsage The recency and the sizes of the items of a bounded memory store.
+type memoryUsage struct {
+	bytes      int64
+	items      map[string]*list.Element
+	maxBytes   int64
+	maxEntries int
+	mu         sync.Mutex
+	order      *list.List
+}
+
+type memoryEntry struct {
+	key  string
+	size int64
+}
+
+func newMemoryUsage(maxEntries int, maxBytes int64) *memoryUsage {
+	return &memoryUsage{
+		items:      make(map[string]*list.Element),
+		maxBytes:   maxBytes,
+		maxEntries: maxEntries,
+		order:      list.New(),
+	}
+}
+
+// add Record a stored item as the most recently used one, and return the least recently used items exceeding the
+// limits, the stored item itself is never evicted.
+func (r *memoryUsage) add(key string, size int64) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if element, exist := r.items[key]; exist {
+		entry := element.Value.(*memoryEntry)
+		r.bytes += size - entry.size
+		entry.size = size
+		r.order.MoveToFront(element)
+	} else {
+		r.items[key] = r.order.PushFront(&memoryEntry{key: key, size: size})
+		r.bytes += size
+	}
+
+	var evicted []string
+	for r.order.Len() > 1 && ((r.maxEntries > 0 && r.order.Len() > r.maxEntries) || (r.maxBytes > 0 && r.bytes > r.maxBytes)) {
+		entry := r.order.Remove(r.order.Back()).(*memoryEntry)
+		delete(r.items, entry.key)
+		r.bytes -= entry.size
+		evicted = append(evicted, entry.key)
+	}
+
+	return evicted
+}
+
+func (r *memoryUsage) access(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if element, exist := r.items[key]; exist {
+		r.order.MoveToFront(element)
+	}
+}
+
+func (r *memoryUsage) remove(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if element, exist := r.items[key]; exist {
+		r.order.Remove(element)
+		delete(r.items, key)
+		r.bytes -= element.Value.(*memoryEntry).size
+	}
+}
+
+func (r *memoryUsage) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.items = make(map[string]*list.Element)
+	r.order.Init()
+	r.bytes = 0
+}
+
+func (r *memoryUsage) size() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.bytes
+}
+
+// memorySize Estimate the size of an item in bytes, the values other than strings and bytes are measured by their
+// JSON encodings.
+func memorySize(key string, value any) int64 {
+	size := int64(len(key))
+	switch v := value.(type) {
+	case string:
+		return size + int64(len(v))
+	case []byte:
+		return size + int64(len(v))
+	}
+
+	if data, err := json.Marshal(value); err == nil {
+		size += int64(len(data))
+	}
+
+	return size
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	configmock "github.com/goravel/framework/mocks/config"
@@ -172,6 +173,15 @@ func (s *MemoryTestSuite) TestIncrement() {
 	res, err = s.memory.Increment("Increment2", 2)
 	s.Equal(int64(4), res)
 	s.Nil(err)
+
+	// The integers stored by Put are incremented and keep their expiration.
+	s.Nil(s.memory.Put("Increment3", 5, 100*time.Millisecond))
+	res, err = s.memory.Increment("Increment3", 2)
+	s.Equal(int64(7), res)
+	s.Nil(err)
+	s.Equal(7, s.memory.GetInt("Increment3"))
+	time.Sleep(150 * time.Millisecond)
+	s.False(s.memory.Has("Increment3"))
 }
 
 func (s *MemoryTestSuite) TestIncrementWithConcurrent() {
@@ -424,6 +434,121 @@ func (s *MemoryTestSuite) TestRememberForever() {
 	s.Nil(value)
 }
 
+func (s *MemoryTestSuite) TestKeys() {
+	s.Nil(s.memory.Put("user:1", "Goravel", NoExpiration))
+	s.Nil(s.memory.Put("user:2", "Goravel", NoExpiration))
+	s.Nil(s.memory.Put("post:1", "Goravel", NoExpiration))
+
+	keys, err := s.memory.Keys("user:*")
+	s.Nil(err)
+	s.ElementsMatch([]string{"user:1", "user:2"}, keys)
+
+	keys, err = s.memory.Keys("?ost:1")
+	s.Nil(err)
+	s.Equal([]string{"post:1"}, keys)
+
+	keys, err = s.memory.Keys("comment:*")
+	s.Nil(err)
+	s.Empty(keys)
+	s.True(s.memory.Flush())
+}
+
+func (s *MemoryTestSuite) TestPrune() {
+	s.Nil(s.memory.Put("name", "Goravel", time.Millisecond))
+	s.Nil(s.memory.Put("name1", "Goravel", NoExpiration))
+	time.Sleep(5 * time.Millisecond)
+
+	count, err := s.memory.Prune()
+	s.Nil(err)
+	s.Equal(1, count)
+	_, exist := s.memory.instance.Load(s.memory.key("name"))
+	s.False(exist)
+	s.True(s.memory.Has("name1"))
+	s.True(s.memory.Flush())
+}
+
+func (s *MemoryTestSuite) TestStats() {
+	s.Nil(s.memory.Put("name", "Goravel", NoExpiration))
+	s.Equal("Goravel", s.memory.Get("name"))
+	s.Nil(s.memory.Get("name1"))
+
+	stats, err := s.memory.Stats()
+	s.Nil(err)
+	s.Equal(int64(1), stats.Keys)
+	s.Equal(int64(-1), stats.Memory)
+	s.Equal(int64(1), stats.Hits)
+	s.Equal(int64(1), stats.Misses)
+	s.True(s.memory.Flush())
+}
+
+func (s *MemoryTestSuite) TestPutWithLongerTTL() {
+	// The item isn't removed by the expiration time of the item it replaced.
+	s.Nil(s.memory.Put("name", "Goravel", 100*time.Millisecond))
+	s.Nil(s.memory.Put("name", "World", time.Minute))
+	time.Sleep(200 * time.Millisecond)
+	s.Equal("World", s.memory.Get("name"))
+	s.True(s.memory.Flush())
+}
+
+func (s *MemoryTestSuite) TestSweep() {
+	s.memory.interval = 100 * time.Millisecond
+	s.Nil(s.memory.Put("name", "Goravel", 50*time.Millisecond))
+	s.Nil(s.memory.Put("forever", "Goravel", NoExpiration))
+	time.Sleep(150 * time.Millisecond)
+
+	// The expired item is swept by the next write, even if it's never read.
+	s.Nil(s.memory.Put("other", "Goravel", time.Minute))
+	_, exist := s.memory.instance.Load(s.memory.key("name"))
+	s.False(exist)
+	s.True(s.memory.Has("forever"))
+	s.True(s.memory.Flush())
+}
+
+func TestBoundedMemory(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel_cache").Twice()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.sweep_interval", 60).Return(60).Twice()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.max_entries", 0).Return(2).Once()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.max_bytes", 0).Return(0).Once()
+
+	memory, err := NewBoundedMemory(mockConfig, "memory")
+	assert.Nil(t, err)
+
+	// The least recently used item is evicted once the max entries are exceeded.
+	assert.Nil(t, memory.Put("a", "1", NoExpiration))
+	assert.Nil(t, memory.Put("b", "2", NoExpiration))
+	assert.True(t, memory.Has("a"))
+	assert.Nil(t, memory.Put("c", "3", NoExpiration))
+	assert.True(t, memory.Has("a"))
+	assert.False(t, memory.Has("b"))
+	assert.True(t, memory.Has("c"))
+
+	mockConfig.EXPECT().GetInt("cache.stores.memory.max_entries", 0).Return(0).Once()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.max_bytes", 0).Return(60).Once()
+
+	memory, err = NewBoundedMemory(mockConfig, "memory")
+	assert.Nil(t, err)
+
+	// The keys are prefixed with "goravel_cache:", so every item takes 23 bytes.
+	assert.Nil(t, memory.Put("a", "12345678", NoExpiration))
+	assert.Nil(t, memory.Put("b", "12345678", NoExpiration))
+	stats, err := memory.Stats()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(46), stats.Memory)
+	assert.Nil(t, memory.Put("c", "12345678", NoExpiration))
+	assert.False(t, memory.Has("a"))
+	assert.True(t, memory.Has("b"))
+
+	assert.True(t, memory.Forget("b"))
+	stats, err = memory.Stats()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(23), stats.Memory)
+	assert.True(t, memory.Flush())
+	stats, err = memory.Stats()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), stats.Memory)
+}
+
 func getMemoryStore() (*Memory, error) {
 	mockConfig := &configmock.Config{}
 	mockConfig.On("GetString", "cache.prefix").Return("goravel_cache").Once()
@@ -435,3 +560,43 @@ func getMemoryStore() (*Memory, error) {
 
 	return memory, nil
 }
+
+func (s *MemoryTestSuite) TestTags() {
+	s.Nil(s.memory.Tags("users", "reports").Put("report", "Goravel", NoExpiration))
+	s.Nil(s.memory.Tags("users").Put("user", "Goravel", NoExpiration))
+	s.Nil(s.memory.Put("name", "Goravel", NoExpiration))
+	s.Equal("Goravel", s.memory.Tags("reports").Get("report"))
+	s.Equal("Goravel", s.memory.Get("report"))
+
+	s.True(s.memory.Tags("reports").Flush())
+	s.False(s.memory.Has("report"))
+	s.True(s.memory.Has("user"))
+	s.True(s.memory.Has("name"))
+
+	s.True(s.memory.Tags("users").Flush())
+	s.False(s.memory.Has("user"))
+	s.True(s.memory.Has("name"))
+	s.True(s.memory.Flush())
+}
+
+func (s *MemoryTestSuite) TestLock_Owner() {
+	lock := s.memory.Lock("lock", 1*time.Second)
+	s.NotEmpty(lock.Owner())
+	s.NotEqual(lock.Owner(), s.memory.Lock("lock").Owner())
+	s.True(lock.Get())
+
+	time.Sleep(2 * time.Second)
+
+	// The expired lock is acquired by another owner, so it can't be released by the previous owner.
+	lock1 := s.memory.Lock("lock")
+	s.True(lock1.Get())
+	s.False(lock.Release())
+	s.False(s.memory.Lock("lock").Get())
+	s.True(lock1.Release())
+
+	// The locks aren't removed by flushing the items.
+	s.True(lock.Get())
+	s.True(s.memory.Flush())
+	s.False(s.memory.Lock("lock").Get())
+	s.True(lock.Release())
+}
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+)
+
+// remember Get an item from the cache, or execute the callback and store the result. The concurrent calls of a key
+// share a single execution of the callback, so an expired item doesn't stampede the source of the item.
+func remember(flights *singleflight.Group, driver contractscache.Driver, key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	if val := driver.Get(key, nil); val != nil {
+		return val, nil
+	}
+
+	val, err, _ := flights.Do(key, func() (any, error) {
+		// The item may have been stored by the call that finished just before this one started.
+		if val := driver.Get(key, nil); val != nil {
+			return val, nil
+		}
+
+		val, err := callback()
+		if err != nil {
+			return nil, err
+		}
+		if err := driver.Put(key, val, ttl); err != nil {
+			return nil, err
+		}
+
+		return val, nil
+	})
+
+	return val, err
+}
+
+// Flexible Get an item from the cache with the stale-while-revalidate strategy. The item is fresh for the fresh
+// duration, then it's served as stale until the stale duration while it's refreshed in the background, the refresh
+// is guarded by a lock, so only one process refreshes the item. The item is refreshed synchronously once it expires,
+// and the errors of the background refreshes are ignored, the stale item is served until it expires.
+func Flexible(driver contractscache.Driver, key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	if val := driver.Get(key, nil); val != nil {
+		if created := driver.GetInt64(flexibleCreatedKey(key)); created == 0 || time.Since(time.UnixMilli(created)) > fresh {
+			refresh(driver, key, stale, fresh, callback)
+		}
+
+		return val, nil
+	}
+
+	return driver.Remember(key, stale, func() (any, error) {
+		val, err := callback()
+		if err != nil {
+			return nil, err
+		}
+		if err := driver.Put(flexibleCreatedKey(key), time.Now().UnixMilli(), stale); err != nil {
+			return nil, err
+		}
+
+		return val, nil
+	})
+}
+
+// refresh Refresh a stale item in the background if no other process is refreshing it.
+func refresh(driver contractscache.Driver, key string, stale, timeout time.Duration, callback func() (any, error)) {
+	lock := driver.Lock(flexibleLockKey(key), timeout)
+	if !lock.Get() {
+		return
+	}
+
+	go func() {
+		defer lock.Release()
+
+		val, err := callback()
+		if err != nil {
+			return
+		}
+		if err := driver.Put(key, val, stale); err != nil {
+			return
+		}
+		_ = driver.Put(flexibleCreatedKey(key), time.Now().UnixMilli(), stale)
+	}()
+}
+
+func flexibleCreatedKey(key string) string {
+	return "flexible:created:" + key
+}
+
+func flexibleLockKey(key string) string {
+	return "flexible:lock:" + key
+}
@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRememberSingleFlight(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			value, err := memory.Remember("name", time.Minute, func() (any, error) {
+				calls.Add(1)
+				<-release
+
+				return "Goravel", nil
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "Goravel", value)
+		}()
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, "Goravel", memory.Get("name"))
+}
+
+func TestFlexible(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+
+	var calls atomic.Int32
+	callback := func() (any, error) {
+		return int(calls.Add(1)), nil
+	}
+
+	value, err := Flexible(memory, "count", 100*time.Millisecond, time.Minute, callback)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, value)
+
+	// The item is fresh, the callback isn't executed.
+	value, err = Flexible(memory, "count", 100*time.Millisecond, time.Minute, callback)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// The stale item is served while it's refreshed in the background.
+	time.Sleep(150 * time.Millisecond)
+	value, err = Flexible(memory, "count", 100*time.Millisecond, time.Minute, callback)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, value)
+	assert.Eventually(t, func() bool {
+		return memory.Get("count") == 2
+	}, time.Second, 10*time.Millisecond)
+
+	value, err = Flexible(memory, "count", 100*time.Millisecond, time.Minute, callback)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, value)
+
+	// The item is refreshed synchronously once it expires.
+	memory.Forget("count")
+	value, err = Flexible(memory, "count", 100*time.Millisecond, time.Minute, callback)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, value)
+
+	value, err = Flexible(memory, "error", 100*time.Millisecond, time.Minute, func() (any, error) {
+		return nil, errors.New("error")
+	})
+	assert.EqualError(t, err, "error")
+	assert.Nil(t, value)
+}
+
+func TestFlexibleRefreshOnce(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+	assert.Nil(t, memory.Put("name", "Goravel", time.Minute))
+
+	// The item without the created time is stale, and only one refresh runs at a time.
+	var calls atomic.Int32
+	release := make(chan struct{})
+	for i := 0; i < 5; i++ {
+		value, err := Flexible(memory, "name", time.Minute, time.Minute, func() (any, error) {
+			calls.Add(1)
+			<-release
+
+			return "World", nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "Goravel", value)
+	}
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		return memory.Get("name") == "World"
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -28,5 +28,8 @@ func (database *ServiceProvider) Boot(app foundation.Application) {
 func (database *ServiceProvider) registerCommands(app foundation.Application) {
 	app.MakeArtisan().Register([]contractsconsole.Command{
 		console.NewClearCommand(app.MakeCache()),
+		console.NewForgetCommand(app.MakeConfig(), app.MakeCache()),
+		console.NewPruneCommand(app.MakeConfig(), app.MakeCache()),
+		console.NewStatsCommand(app.MakeConfig(), app.MakeCache()),
 	})
 }
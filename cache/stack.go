@@ -0,0 +1,281 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/spf13/cast"
+	"golang.org/x/sync/singleflight"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
+)
+
+// Stack Read the items through an in-process bounded memory store and the stores in order, e.g. Redis, an item
+// found in a store is written back to the local store and the stores before it. The last store is the source of the
+// items, the increments and the locks are handled by it.
+type Stack struct {
+	flights *singleflight.Group
+	// jitter The percentage of the TTLs that is randomly cut, so the items written together don't expire together.
+	jitter int
+	// local The in-process memory store bounded by the size, it's nil if the size is 0.
+	local  *Memory
+	stores []contractscache.Driver
+	// ttl The TTL of the items in the local store and of the items written back to the stores, since the TTLs of the
+	// found items are unknown.
+	ttl time.Duration
+}
+
+func NewStack(config config.Config, store string, stores []contractscache.Driver) *Stack {
+	stack := &Stack{
+		flights: &singleflight.Group{},
+		jitter:  config.GetInt(fmt.Sprintf("cache.stores.%s.jitter", store), 0),
+		stores:  stores,
+		ttl:     time.Duration(config.GetInt(fmt.Sprintf("cache.stores.%s.ttl", store), 60)) * time.Second,
+	}
+	if size := config.GetInt(fmt.Sprintf("cache.stores.%s.size", store), 1000); size > 0 {
+		stack.local = newLocalMemory(size)
+	}
+
+	return stack
+}
+
+// Add Store an item in the cache if the key does not exist in the last store.
+func (r *Stack) Add(key string, value any, t time.Duration) bool {
+	t = r.jittered(t)
+	if !r.source().Add(key, value, t) {
+		return false
+	}
+	for _, store := range r.upper() {
+		_ = store.Put(key, value, t)
+	}
+	r.putLocal(key, value, t)
+
+	return true
+}
+
+func (r *Stack) Decrement(key string, value ...int64) (int64, error) {
+	result, err := r.source().Decrement(key, value...)
+	r.forgetUpper(key)
+
+	return result, err
+}
+
+func (r *Stack) Forever(key string, value any) bool {
+	for i := len(r.stores) - 1; i >= 0; i-- {
+		if !r.stores[i].Forever(key, value) {
+			return false
+		}
+	}
+	r.putLocal(key, value, NoExpiration)
+
+	return true
+}
+
+func (r *Stack) Forget(key string) bool {
+	if r.local != nil {
+		r.local.Forget(key)
+	}
+
+	forgotten := true
+	for _, store := range r.stores {
+		if !store.Forget(key) {
+			forgotten = false
+		}
+	}
+
+	return forgotten
+}
+
+func (r *Stack) Flush() bool {
+	if r.local != nil {
+		r.local.Flush()
+	}
+
+	flushed := true
+	for _, store := range r.stores {
+		if !store.Flush() {
+			flushed = false
+		}
+	}
+
+	return flushed
+}
+
+// Get Retrieve an item from the local store or the first store having it, the item is written back to the layers before it.
+func (r *Stack) Get(key string, def ...any) any {
+	if r.local != nil {
+		if val := r.local.Get(key); val != nil {
+			return val
+		}
+	}
+
+	for i, store := range r.stores {
+		val := store.Get(key, nil)
+		if val == nil {
+			continue
+		}
+
+		t := r.jittered(r.ttl)
+		for _, upper := range r.stores[:i] {
+			_ = upper.Put(key, val, t)
+		}
+		r.putLocal(key, val, t)
+
+		return val
+	}
+
+	return defaultValue(def...)
+}
+
+func (r *Stack) GetBool(key string, def ...bool) bool {
+	if len(def) == 0 {
+		def = append(def, false)
+	}
+
+	return cast.ToBool(r.Get(key, def[0]))
+}
+
+func (r *Stack) GetInt(key string, def ...int) int {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt(r.Get(key, def[0]))
+}
+
+func (r *Stack) GetInt64(key string, def ...int64) int64 {
+	if len(def) == 0 {
+		def = append(def, 0)
+	}
+
+	return cast.ToInt64(r.Get(key, def[0]))
+}
+
+func (r *Stack) GetString(key string, def ...string) string {
+	if len(def) == 0 {
+		def = append(def, "")
+	}
+
+	return cast.ToString(r.Get(key, def[0]))
+}
+
+func (r *Stack) Has(key string) bool {
+	return r.Get(key) != nil
+}
+
+func (r *Stack) Increment(key string, value ...int64) (int64, error) {
+	result, err := r.source().Increment(key, value...)
+	r.forgetUpper(key)
+
+	return result, err
+}
+
+func (r *Stack) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return r.source().Lock(key, t...)
+}
+
+// Put Store an item in the stores from the last one, then in the local store.
+func (r *Stack) Put(key string, value any, t time.Duration) error {
+	t = r.jittered(t)
+	for i := len(r.stores) - 1; i >= 0; i-- {
+		if err := r.stores[i].Put(key, value, t); err != nil {
+			return err
+		}
+	}
+	r.putLocal(key, value, t)
+
+	return nil
+}
+
+// Prune Remove the expired items from the local store and the prunable stores, and return the number of items
+// removed from the stores.
+func (r *Stack) Prune() (int, error) {
+	if r.local != nil {
+		_, _ = r.local.Prune()
+	}
+
+	count := 0
+	for _, store := range r.stores {
+		if prunable, ok := store.(contractscache.Prunable); ok {
+			pruned, err := prunable.Prune()
+			if err != nil {
+				return count, err
+			}
+			count += pruned
+		}
+	}
+
+	return count, nil
+}
+
+func (r *Stack) Pull(key string, def ...any) any {
+	val := r.Get(key, def...)
+	r.Forget(key)
+
+	return val
+}
+
+func (r *Stack) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	return remember(r.flights, r, key, ttl, callback)
+}
+
+func (r *Stack) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return remember(r.flights, r, key, NoExpiration, callback)
+}
+
+func (r *Stack) WithContext(ctx context.Context) contractscache.Driver {
+	stack := *r
+	stack.stores = make([]contractscache.Driver, len(r.stores))
+	for i, store := range r.stores {
+		stack.stores[i] = store.WithContext(ctx)
+	}
+
+	return &stack
+}
+
+// forgetUpper Remove an item changed in the last store from the local store and the stores before it.
+func (r *Stack) forgetUpper(key string) {
+	if r.local != nil {
+		r.local.Forget(key)
+	}
+	for _, store := range r.upper() {
+		store.Forget(key)
+	}
+}
+
+// jittered Cut a random part of the TTL, up to the jitter percentage of it.
+func (r *Stack) jittered(t time.Duration) time.Duration {
+	if r.jitter <= 0 || t == NoExpiration {
+		return t
+	}
+
+	limit := int64(t) * int64(r.jitter) / 100
+	if limit <= 0 {
+		return t
+	}
+
+	return t - time.Duration(rand.Int63n(limit+1))
+}
+
+// putLocal Store an item in the local store, the item can't be kept longer than the TTL of the local store.
+func (r *Stack) putLocal(key string, value any, t time.Duration) {
+	if r.local == nil {
+		return
+	}
+	if t == NoExpiration || t > r.ttl {
+		t = r.ttl
+	}
+
+	_ = r.local.Put(key, value, t)
+}
+
+func (r *Stack) source() contractscache.Driver {
+	return r.stores[len(r.stores)-1]
+}
+
+func (r *Stack) upper() []contractscache.Driver {
+	return r.stores[:len(r.stores)-1]
+}
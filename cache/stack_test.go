@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+	configmock "github.com/goravel/framework/mocks/config"
+)
+
+type StackTestSuite struct {
+	suite.Suite
+	mockConfig *configmock.Config
+	upper      *Memory
+	source     *Memory
+	stack      *Stack
+}
+
+func TestStackTestSuite(t *testing.T) {
+	suite.Run(t, new(StackTestSuite))
+}
+
+func (s *StackTestSuite) SetupTest() {
+	var err error
+	s.upper, err = getMemoryStore()
+	s.Nil(err)
+	s.source, err = getMemoryStore()
+	s.Nil(err)
+
+	s.mockConfig = configmock.NewConfig(s.T())
+	s.mockConfig.EXPECT().GetInt("cache.stores.stack.jitter", 0).Return(0).Once()
+	s.mockConfig.EXPECT().GetInt("cache.stores.stack.ttl", 60).Return(60).Once()
+	s.mockConfig.EXPECT().GetInt("cache.stores.stack.size", 1000).Return(2).Once()
+	s.stack = NewStack(s.mockConfig, "stack", []contractscache.Driver{s.upper, s.source})
+}
+
+func (s *StackTestSuite) TestPutAndGet() {
+	s.Nil(s.stack.Put("name", "Goravel", time.Minute))
+	s.Equal("Goravel", s.upper.Get("name"))
+	s.Equal("Goravel", s.source.Get("name"))
+	s.Equal("Goravel", s.stack.Get("name"))
+	s.Equal("default", s.stack.Get("missing", "default"))
+	s.Equal("default", s.stack.GetString("missing", "default"))
+	s.False(s.stack.Has("missing"))
+
+	// The item is served by the local store, even if the stores are changed by other processes.
+	s.Nil(s.source.Put("name", "World", time.Minute))
+	s.Nil(s.upper.Put("name", "World", time.Minute))
+	s.Equal("Goravel", s.stack.Get("name"))
+
+	s.True(s.stack.Forget("name"))
+	s.False(s.stack.Has("name"))
+	s.False(s.source.Has("name"))
+}
+
+func (s *StackTestSuite) TestWriteBack() {
+	s.Nil(s.source.Put("name", "Goravel", time.Minute))
+
+	s.Equal("Goravel", s.stack.Get("name"))
+	s.Equal("Goravel", s.upper.Get("name"))
+	s.Equal("Goravel", s.stack.local.Get("name"))
+}
+
+func (s *StackTestSuite) TestAdd() {
+	s.True(s.stack.Add("name", "Goravel", time.Minute))
+	s.False(s.stack.Add("name", "World", time.Minute))
+	s.Equal("Goravel", s.upper.Get("name"))
+	s.Equal("Goravel", s.stack.Get("name"))
+}
+
+func (s *StackTestSuite) TestIncrement() {
+	res, err := s.stack.Increment("count")
+	s.Nil(err)
+	s.Equal(int64(1), res)
+	s.Equal(1, s.stack.GetInt("count"))
+
+	// The copies of the item are removed once it's changed in the last store.
+	res, err = s.stack.Decrement("count", 3)
+	s.Nil(err)
+	s.Equal(int64(-2), res)
+	s.False(s.upper.Has("count"))
+	s.Equal(-2, s.stack.GetInt("count"))
+}
+
+func (s *StackTestSuite) TestRemember() {
+	value, err := s.stack.Remember("name", time.Minute, func() (any, error) {
+		return "Goravel", nil
+	})
+	s.Nil(err)
+	s.Equal("Goravel", value)
+	s.Equal("Goravel", s.source.Get("name"))
+
+	value, err = s.stack.RememberForever("name", func() (any, error) {
+		return "World", nil
+	})
+	s.Nil(err)
+	s.Equal("Goravel", value)
+}
+
+func (s *StackTestSuite) TestFlush() {
+	s.Nil(s.stack.Put("name", "Goravel", time.Minute))
+	s.True(s.stack.Flush())
+	s.False(s.stack.Has("name"))
+	s.False(s.source.Has("name"))
+}
+
+func (s *StackTestSuite) TestLocal() {
+	s.Nil(s.stack.local.Put("a", 1, time.Minute))
+	s.Nil(s.stack.local.Put("b", 2, time.Minute))
+	s.True(s.stack.local.Has("a"))
+
+	// The least recently used item is evicted.
+	s.Nil(s.stack.local.Put("c", 3, time.Minute))
+	s.False(s.stack.local.Has("b"))
+	s.True(s.stack.local.Has("a"))
+
+	s.Nil(s.stack.local.Put("d", 4, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	s.False(s.stack.local.Has("d"))
+}
+
+func (s *StackTestSuite) TestPrune() {
+	s.Nil(s.stack.Put("name", "Goravel", time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	count, err := s.stack.Prune()
+	s.Nil(err)
+	s.Equal(2, count)
+	s.False(s.stack.Has("name"))
+}
+
+func (s *StackTestSuite) TestJittered() {
+	s.Equal(time.Minute, s.stack.jittered(time.Minute))
+
+	s.stack.jitter = 10
+	for i := 0; i < 100; i++ {
+		t := s.stack.jittered(time.Minute)
+		s.True(t <= time.Minute && t >= 54*time.Second)
+	}
+	s.Equal(NoExpiration, s.stack.jittered(NoExpiration))
+}
@@ -0,0 +1,218 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+)
+
+// TaggedCache Tag the items of a driver that doesn't support tags natively. The version of every tag is stored in the
+// driver, and the keys of the items are prefixed with the hash of the versions of their tags, so flushing the tags only
+// changes their versions, the items tagged with the old versions can't be read anymore and expire by themselves.
+type TaggedCache struct {
+	driver contractscache.Driver
+	names  []string
+}
+
+func NewTaggedCache(driver contractscache.Driver, names ...string) *TaggedCache {
+	return &TaggedCache{driver: driver, names: names}
+}
+
+// Tags Get a tagged cache of the driver, the tags of the drivers that implement Taggable are handled by the drivers.
+func Tags(driver contractscache.Driver, names ...string) contractscache.Driver {
+	if taggable, ok := driver.(contractscache.Taggable); ok {
+		return taggable.Tags(names...)
+	}
+
+	return NewTaggedCache(driver, names...)
+}
+
+func (r *TaggedCache) Add(key string, value any, t time.Duration) bool {
+	return r.driver.Add(r.key(key), value, t)
+}
+
+func (r *TaggedCache) Decrement(key string, value ...int64) (int64, error) {
+	return r.driver.Decrement(r.key(key), value...)
+}
+
+func (r *TaggedCache) Forever(key string, value any) bool {
+	return r.driver.Forever(r.key(key), value)
+}
+
+func (r *TaggedCache) Forget(key string) bool {
+	return r.driver.Forget(r.key(key))
+}
+
+// Flush Remove the items of the tags by changing the versions of the tags.
+func (r *TaggedCache) Flush() bool {
+	for _, name := range r.names {
+		if !r.driver.Forever(tagVersionKey(name), uuid.NewString()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *TaggedCache) Get(key string, def ...any) any {
+	return r.driver.Get(r.key(key), def...)
+}
+
+func (r *TaggedCache) GetBool(key string, def ...bool) bool {
+	return r.driver.GetBool(r.key(key), def...)
+}
+
+func (r *TaggedCache) GetInt(key string, def ...int) int {
+	return r.driver.GetInt(r.key(key), def...)
+}
+
+func (r *TaggedCache) GetInt64(key string, def ...int64) int64 {
+	return r.driver.GetInt64(r.key(key), def...)
+}
+
+func (r *TaggedCache) GetString(key string, def ...string) string {
+	return r.driver.GetString(r.key(key), def...)
+}
+
+func (r *TaggedCache) Has(key string) bool {
+	return r.driver.Has(r.key(key))
+}
+
+func (r *TaggedCache) Increment(key string, value ...int64) (int64, error) {
+	return r.driver.Increment(r.key(key), value...)
+}
+
+func (r *TaggedCache) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return r.driver.Lock(r.key(key), t...)
+}
+
+func (r *TaggedCache) Put(key string, value any, t time.Duration) error {
+	return r.driver.Put(r.key(key), value, t)
+}
+
+func (r *TaggedCache) Pull(key string, def ...any) any {
+	return r.driver.Pull(r.key(key), def...)
+}
+
+func (r *TaggedCache) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	return r.driver.Remember(r.key(key), ttl, callback)
+}
+
+func (r *TaggedCache) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.driver.RememberForever(r.key(key), callback)
+}
+
+func (r *TaggedCache) WithContext(ctx context.Context) contractscache.Driver {
+	return NewTaggedCache(r.driver.WithContext(ctx), r.names...)
+}
+
+// key Prefix the key with the hash of the versions of the tags, the version of a tag is created if it doesn't exist.
+func (r *TaggedCache) key(key string) string {
+	versions := make([]string, len(r.names))
+	for i, name := range r.names {
+		version := r.driver.GetString(tagVersionKey(name))
+		if version == "" {
+			r.driver.Add(tagVersionKey(name), uuid.NewString(), NoExpiration)
+			version = r.driver.GetString(tagVersionKey(name))
+		}
+		versions[i] = version
+	}
+
+	hash := sha1.Sum([]byte(strings.Join(versions, "|")))
+
+	return hex.EncodeToString(hash[:]) + ":" + key
+}
+
+func tagVersionKey(name string) string {
+	return "tag:" + name + ":version"
+}
+
+// MemoryTaggedCache Tag the items of the memory driver, the keys of the items are recorded in the sets of their tags,
+// so flushing the tags removes the items of the sets.
+type MemoryTaggedCache struct {
+	*Memory
+	names []string
+}
+
+func (r *MemoryTaggedCache) Add(key string, value any, t time.Duration) bool {
+	if !r.Memory.Add(key, value, t) {
+		return false
+	}
+	r.tag(key)
+
+	return true
+}
+
+func (r *MemoryTaggedCache) Decrement(key string, value ...int64) (int64, error) {
+	r.tag(key)
+
+	return r.Memory.Decrement(key, value...)
+}
+
+func (r *MemoryTaggedCache) Forever(key string, value any) bool {
+	r.tag(key)
+
+	return r.Memory.Forever(key, value)
+}
+
+// Flush Remove the items of the tags.
+func (r *MemoryTaggedCache) Flush() bool {
+	for _, name := range r.names {
+		keys, ok := r.Memory.tags.LoadAndDelete(name)
+		if !ok {
+			continue
+		}
+		keys.(*sync.Map).Range(func(key, _ any) bool {
+			r.Memory.Forget(key.(string))
+
+			return true
+		})
+	}
+
+	return true
+}
+
+func (r *MemoryTaggedCache) Increment(key string, value ...int64) (int64, error) {
+	r.tag(key)
+
+	return r.Memory.Increment(key, value...)
+}
+
+func (r *MemoryTaggedCache) Put(key string, value any, t time.Duration) error {
+	r.tag(key)
+
+	return r.Memory.Put(key, value, t)
+}
+
+func (r *MemoryTaggedCache) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	r.tag(key)
+
+	return r.Memory.Remember(key, ttl, callback)
+}
+
+func (r *MemoryTaggedCache) RememberForever(key string, callback func() (any, error)) (any, error) {
+	r.tag(key)
+
+	return r.Memory.RememberForever(key, callback)
+}
+
+func (r *MemoryTaggedCache) WithContext(ctx context.Context) contractscache.Driver {
+	r.Memory.WithContext(ctx)
+
+	return r
+}
+
+// tag Record the key in the sets of the tags.
+func (r *MemoryTaggedCache) tag(key string) {
+	for _, name := range r.names {
+		keys, _ := r.Memory.tags.LoadOrStore(name, &sync.Map{})
+		keys.(*sync.Map).Store(key, struct{}{})
+	}
+}
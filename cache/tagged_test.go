@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractscache "github.com/goravel/framework/contracts/cache"
+)
+
+// untaggedDriver Hide the native tags of a driver.
+type untaggedDriver struct {
+	contractscache.Driver
+}
+
+func TestTaggedCache(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+	driver := untaggedDriver{Driver: memory}
+
+	_, ok := Tags(memory, "users").(*MemoryTaggedCache)
+	assert.True(t, ok)
+	_, ok = Tags(driver, "users").(*TaggedCache)
+	assert.True(t, ok)
+
+	assert.Nil(t, Tags(driver, "users", "reports").Put("report", "Goravel", NoExpiration))
+	assert.Nil(t, Tags(driver, "users").Put("user", "Goravel", NoExpiration))
+	assert.Nil(t, driver.Put("name", "Goravel", NoExpiration))
+	assert.Equal(t, "Goravel", Tags(driver, "users", "reports").Get("report"))
+	assert.Equal(t, "Goravel", Tags(driver, "users").GetString("user"))
+	assert.False(t, Tags(driver, "reports").Has("report"))
+	assert.False(t, driver.Has("report"))
+
+	count, err := Tags(driver, "users").Increment("count", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), count)
+
+	assert.True(t, Tags(driver, "reports").Flush())
+	assert.Nil(t, Tags(driver, "users", "reports").Get("report"))
+	assert.Equal(t, "Goravel", Tags(driver, "users").Get("user"))
+
+	assert.True(t, Tags(driver, "users").Flush())
+	assert.Nil(t, Tags(driver, "users").Get("user"))
+	assert.Equal(t, int64(0), Tags(driver, "users").GetInt64("count"))
+	assert.Equal(t, "Goravel", driver.Get("name"))
+}
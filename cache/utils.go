@@ -1,9 +1,23 @@
 package cache
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/goravel/framework/contracts/config"
 )
 
 func prefix(config config.Config) string {
 	return config.GetString("cache.prefix") + ":"
 }
+
+// match Determine whether the key matches the glob pattern, "*" matches any sequence of characters and "?" a single character.
+func match(pattern, key string) bool {
+	expression := regexp.QuoteMeta(pattern)
+	expression = strings.ReplaceAll(expression, `\*`, ".*")
+	expression = strings.ReplaceAll(expression, `\?`, ".")
+
+	matched, _ := regexp.MatchString("^"+expression+"$", key)
+
+	return matched
+}
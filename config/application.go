@@ -23,7 +23,13 @@ func NewApplication(envPath string) *Application {
 	app.vip = viper.New()
 	app.vip.AutomaticEnv()
 
-	if file.Exists(envPath) {
+	if file.Exists(CacheFile()) {
+		if err := app.readCache(); err != nil {
+			color.Red().Println("Invalid Config Cache error: " + err.Error())
+			color.Default().Println("Run command: go run . artisan config:clear")
+			os.Exit(0)
+		}
+	} else if file.Exists(envPath) {
 		app.vip.SetConfigType("env")
 		app.vip.SetConfigFile(envPath)
 
@@ -52,6 +58,20 @@ func NewApplication(envPath string) *Application {
 	return app
 }
 
+// NewMemoryApplication Create a config of the settings without loading the .env file or requiring the APP_KEY,
+// it's used by the test applications.
+func NewMemoryApplication(settings map[string]any) *Application {
+	app := &Application{}
+	app.vip = viper.New()
+	app.vip.AutomaticEnv()
+
+	for name, configuration := range settings {
+		app.Add(name, configuration)
+	}
+
+	return app
+}
+
 // Env Get config from env.
 func (app *Application) Env(envName string, defaultValue ...any) any {
 	value := app.Get(envName, defaultValue...)
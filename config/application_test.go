@@ -153,3 +153,15 @@ func TestOsVariables(t *testing.T) {
 	assert.Equal(t, 3306, config.GetInt("APP_PORT"))
 	assert.True(t, config.GetBool("APP_DEBUG"))
 }
+
+func TestNewMemoryApplication(t *testing.T) {
+	config := NewMemoryApplication(map[string]any{
+		"cache": map[string]any{
+			"default": "memory",
+		},
+	})
+	assert.Equal(t, "memory", config.GetString("cache.default"))
+
+	config.Add("cache.default", "redis")
+	assert.Equal(t, "redis", config.GetString("cache.default"))
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	"github.com/goravel/framework/support"
+	"github.com/goravel/framework/support/file"
+)
+
+// CacheFile Get the path of the cached environment, it's loaded instead of the .env file while it exists.
+func CacheFile() string {
+	return filepath.Join(support.RelativePath, "storage", "framework", "config.json")
+}
+
+// Cache Write the variables of the .env file to the cache file, the system variables aren't cached,
+// so they still override the cached variables.
+func Cache(envPath string) error {
+	vip := viper.New()
+	vip.SetConfigType("env")
+	vip.SetConfigFile(envPath)
+	if err := vip.ReadInConfig(); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(vip.AllSettings())
+	if err != nil {
+		return err
+	}
+
+	return file.Create(CacheFile(), string(content))
+}
+
+// ClearCache Remove the cache file, the .env file is loaded again by the next start.
+func ClearCache() error {
+	return file.Remove(CacheFile())
+}
+
+func (app *Application) readCache() error {
+	content, err := os.ReadFile(CacheFile())
+	if err != nil {
+		return err
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal(content, &settings); err != nil {
+		return err
+	}
+
+	return app.vip.MergeConfigMap(settings)
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/support"
+	"github.com/goravel/framework/support/file"
+)
+
+func TestCache(t *testing.T) {
+	support.RelativePath = t.TempDir()
+	defer func() {
+		support.RelativePath = ""
+	}()
+
+	envPath := filepath.Join(support.RelativePath, ".env")
+	assert.Nil(t, file.Create(envPath, `
+APP_KEY=12345678901234567890123456789012
+CACHED_APP_NAME=goravel
+CACHED_APP_PORT=3306
+`))
+
+	assert.Nil(t, Cache(envPath))
+	assert.True(t, file.Exists(CacheFile()))
+
+	// The cache is loaded instead of the .env file while it exists.
+	assert.Nil(t, file.Create(envPath, `
+APP_KEY=12345678901234567890123456789012
+CACHED_APP_NAME=changed
+`))
+	config := NewApplication(envPath)
+	assert.Equal(t, "goravel", config.GetString("CACHED_APP_NAME"))
+	assert.Equal(t, 3306, config.GetInt("CACHED_APP_PORT"))
+
+	// The system variables still override the cached variables.
+	t.Setenv("CACHED_APP_PORT", "3307")
+	assert.Equal(t, 3307, NewApplication(envPath).GetInt("CACHED_APP_PORT"))
+
+	assert.Nil(t, ClearCache())
+	assert.False(t, file.Exists(CacheFile()))
+	assert.Nil(t, ClearCache())
+	assert.Equal(t, "changed", NewApplication(envPath).GetString("CACHED_APP_NAME"))
+
+	assert.NotNil(t, Cache(filepath.Join(support.RelativePath, "missing.env")))
+	_, err := os.Stat(CacheFile())
+	assert.True(t, os.IsNotExist(err))
+}
@@ -1,16 +1,28 @@
 package console
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/event"
+	frameworkevent "github.com/goravel/framework/event"
+	"github.com/goravel/framework/support/color"
 )
 
+// isolationLockTTL The lock of an isolated command expires after the duration, in case the process is killed.
+const isolationLockTTL = time.Hour
+
 type Application struct {
+	cache     func() cache.Cache
+	events    func() event.Instance
 	instance  *cli.App
 	isArtisan bool
 }
@@ -31,27 +43,31 @@ func NewApplication(name, usage, usageText, version string, artisan ...bool) con
 
 func (c *Application) Register(commands []console.Command) {
 	for _, item := range commands {
-		item := item
-		cliCommand := cli.Command{
-			Name:  item.Signature(),
-			Usage: item.Description(),
-			Action: func(ctx *cli.Context) error {
-				return item.Handle(&CliContext{ctx})
-			},
-			Category: item.Extend().Category,
-			Flags:    flagsToCliFlags(item.Extend().Flags),
-		}
-		c.instance.Commands = append(c.instance.Commands, &cliCommand)
+		c.register(item)
 	}
 }
 
+// Command Register a closure based command.
+func (c *Application) Command(signature string, handle func(ctx console.Context) error) console.ClosureCommand {
+	closureCommand := NewClosureCommand(signature, handle)
+	closureCommand.cliCommand = c.register(closureCommand)
+
+	return closureCommand
+}
+
 // Call Run an Artisan console command by name.
 func (c *Application) Call(command string) {
+	_ = c.CallWithError(command)
+}
+
+// CallWithError Run an Artisan console command by name and return its error, the error is rendered already.
+func (c *Application) CallWithError(command string) error {
 	commands := []string{os.Args[0]}
 	if c.isArtisan {
 		commands = append(commands, "artisan")
 	}
-	c.Run(append(commands, strings.Split(command, " ")...), false)
+
+	return c.run(append(commands, strings.Split(command, " ")...), false)
 }
 
 // CallAndExit Run an Artisan console command by name and exit.
@@ -65,6 +81,10 @@ func (c *Application) CallAndExit(command string) {
 
 // Run a command. Args come from os.Args.
 func (c *Application) Run(args []string, exitIfArtisan bool) {
+	_ = c.run(args, exitIfArtisan)
+}
+
+func (c *Application) run(args []string, exitIfArtisan bool) error {
 	artisanIndex := -1
 	if c.isArtisan {
 		for i, arg := range args {
@@ -85,13 +105,110 @@ func (c *Application) Run(args []string, exitIfArtisan bool) {
 
 		cliArgs := append([]string{args[0]}, args[artisanIndex+1:]...)
 		if err := c.instance.Run(cliArgs); err != nil {
-			panic(err.Error())
+			var rendered *renderedError
+			if errors.As(err, &rendered) {
+				err = rendered.error
+			} else {
+				renderError(err, false)
+			}
+
+			if exitIfArtisan {
+				os.Exit(1)
+			}
+
+			return err
 		}
 
 		if exitIfArtisan {
 			os.Exit(0)
 		}
 	}
+
+	return nil
+}
+
+func (c *Application) register(item console.Command) *cli.Command {
+	cliCommand := cli.Command{
+		Name:  item.Signature(),
+		Usage: item.Description(),
+		Action: func(ctx *cli.Context) error {
+			cliContext := NewCliContext(ctx)
+			err := c.handle(item, cliContext)
+			if err == nil {
+				err = cliContext.flushJson()
+			}
+			if err != nil {
+				renderError(err, ctx.Bool("verbose"))
+
+				return &renderedError{err}
+			}
+
+			return nil
+		},
+		Category: item.Extend().Category,
+		Flags:    withVerboseFlag(withJsonFlag(item.Extend(), flagsToCliFlags(item.Extend().Flags))),
+	}
+	if closureCommand, ok := item.(*ClosureCommand); ok && len(closureCommand.arguments) > 0 {
+		cliCommand.ArgsUsage = "<" + strings.Join(closureCommand.arguments, "> <") + ">"
+	}
+	c.instance.Commands = append(c.instance.Commands, &cliCommand)
+
+	return &cliCommand
+}
+
+// handle Run the command between the CommandStarting and CommandFinished events, a panic of the command is returned as a PanicError.
+func (c *Application) handle(item console.Command, ctx *CliContext) (err error) {
+	args := []event.Arg{
+		{Type: "string", Value: item.Signature()},
+		{Type: "[]string", Value: ctx.Arguments()},
+	}
+	c.dispatch(&CommandStarting{}, args)
+
+	defer func() {
+		exitCode := 0
+		if err != nil {
+			exitCode = 1
+		}
+		c.dispatch(&CommandFinished{}, append(args, event.Arg{Type: "int", Value: exitCode}))
+	}()
+	defer recoverPanic(&err)
+
+	if item.Extend().Isolatable {
+		return c.handleIsolated(item, ctx)
+	}
+
+	return item.Handle(ctx)
+}
+
+// dispatch Dispatch a command event if the application has registered listeners for it.
+func (c *Application) dispatch(e event.Event, args []event.Arg) {
+	if c.events == nil {
+		return
+	}
+	if err := frameworkevent.DispatchIfListened(c.events(), e, args); err != nil {
+		color.Red().Println(err)
+	}
+}
+
+// handleIsolated Run the command while holding a cache lock, the command is skipped if another instance holds the lock.
+func (c *Application) handleIsolated(item console.Command, ctx console.Context) error {
+	var instance cache.Cache
+	if c.cache != nil {
+		instance = c.cache()
+	}
+	if instance == nil {
+		return errors.New("the cache is required to run an isolated command")
+	}
+
+	lock := instance.Lock("framework:command:"+item.Signature(), isolationLockTTL)
+	if !lock.Get() {
+		ctx.Warning(fmt.Sprintf("The [%s] command is already running.", item.Signature()))
+
+		return nil
+	}
+	defer lock.Release()
+
+	return item.Handle(ctx)
 }
 
 func flagsToCliFlags(flags []command.Flag) []cli.Flag {
@@ -184,3 +301,41 @@ func flagsToCliFlags(flags []command.Flag) []cli.Flag {
 
 	return cliFlags
 }
+
+// withVerboseFlag adds the framework handled --verbose flag, which prints the stack trace of a panic,
+// unless the command defines its own verbose flag.
+func withVerboseFlag(flags []cli.Flag) []cli.Flag {
+	for _, flag := range flags {
+		for _, name := range flag.Names() {
+			if name == "verbose" {
+				return flags
+			}
+		}
+	}
+
+	return append(flags, &cli.BoolFlag{
+		Name:  "verbose",
+		Usage: "print the stack trace when the command panics",
+	})
+}
+
+// withJsonFlag adds the framework handled --json flag to the commands supporting it, which switches structured output
+// to JSON, unless the command defines its own json flag.
+func withJsonFlag(extend command.Extend, flags []cli.Flag) []cli.Flag {
+	if !extend.Json {
+		return flags
+	}
+
+	for _, flag := range flags {
+		for _, name := range flag.Names() {
+			if name == "json" {
+				return flags
+			}
+		}
+	}
+
+	return append(flags, &cli.BoolFlag{
+		Name:  "json",
+		Usage: "output the result as JSON",
+	})
+}
@@ -1,13 +1,22 @@
 package console
 
 import (
+	"errors"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 
+	contractscache "github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/console/command"
+	contractsevent "github.com/goravel/framework/contracts/event"
+	cachemocks "github.com/goravel/framework/mocks/cache"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	eventmocks "github.com/goravel/framework/mocks/event"
+	"github.com/goravel/framework/support/color"
 )
 
 var testCommand = 0
@@ -18,10 +27,157 @@ func TestRun(t *testing.T) {
 		&TestCommand{},
 	})
 
-	cliApp.Call("test")
+	assert.Nil(t, cliApp.CallWithError("test"))
 	assert.Equal(t, 1, testCommand)
 }
 
+func TestClosureCommand(t *testing.T) {
+	var (
+		user  string
+		queue string
+		force bool
+	)
+	cliApp := NewApplication("test", "test", "test", "test", true)
+	cliApp.Command("mail:send {user : The ID of the user} {--Q|queue=default : The queue name} {--force}", func(ctx console.Context) error {
+		user = ctx.Argument(0)
+		queue = ctx.Option("queue")
+		force = ctx.OptionBool("force")
+
+		return nil
+	}).Describe("Send a mail to the user")
+
+	cliApp.Call("mail:send 1")
+	assert.Equal(t, "1", user)
+	assert.Equal(t, "default", queue)
+	assert.False(t, force)
+
+	cliApp.Call("mail:send -Q emails --force 2")
+	assert.Equal(t, "2", user)
+	assert.Equal(t, "emails", queue)
+	assert.True(t, force)
+
+	cliCommand := cliApp.(*Application).instance.Command("mail:send")
+	assert.Equal(t, "Send a mail to the user", cliCommand.Usage)
+	assert.Equal(t, "<user>", cliCommand.ArgsUsage)
+}
+
+func TestNewClosureCommand(t *testing.T) {
+	closureCommand := NewClosureCommand("users:prune {days} {--ids=* : The IDs} {--dry-run}", nil)
+	assert.Equal(t, "users:prune", closureCommand.Signature())
+	assert.Equal(t, []string{"days"}, closureCommand.arguments)
+	assert.Equal(t, []command.Flag{
+		&command.StringSliceFlag{Name: "ids", Usage: "The IDs"},
+		&command.BoolFlag{Name: "dry-run"},
+	}, closureCommand.Extend().Flags)
+
+	closureCommand = NewClosureCommand("inspire", nil)
+	assert.Equal(t, "inspire", closureCommand.Signature())
+	assert.Empty(t, closureCommand.Extend().Flags)
+}
+
+func TestCommandEvents(t *testing.T) {
+	cliApp := NewApplication("test", "test", "test", "test", true).(*Application)
+	mockEvent := &eventmocks.Instance{}
+	mockTask := &eventmocks.Task{}
+	cliApp.events = func() contractsevent.Instance {
+		return mockEvent
+	}
+	starting := &CommandStarting{}
+	finished := &CommandFinished{}
+	mockEvent.On("GetEvents").Return(map[contractsevent.Event][]contractsevent.Listener{
+		starting: nil,
+		finished: nil,
+	}).Twice()
+	mockEvent.On("Job", starting, []contractsevent.Arg{
+		{Type: "string", Value: "panic"},
+		{Type: "[]string", Value: []string{"goravel"}},
+	}).Return(mockTask).Once()
+	mockEvent.On("Job", finished, []contractsevent.Arg{
+		{Type: "string", Value: "panic"},
+		{Type: "[]string", Value: []string{"goravel"}},
+		{Type: "int", Value: 1},
+	}).Return(mockTask).Once()
+	mockTask.On("Dispatch").Return(nil).Twice()
+
+	cliApp.Register([]console.Command{&TestPanicCommand{}})
+	var err error
+	output := color.CaptureOutput(func(w io.Writer) {
+		err = cliApp.CallWithError("panic goravel")
+	})
+	var panicError *PanicError
+	assert.ErrorAs(t, err, &panicError)
+	assert.Equal(t, 1, strings.Count(output, "ERROR  panic: something went wrong"))
+	assert.Contains(t, output, "Run the command with --verbose to see the stack trace.")
+
+	mockEvent.AssertExpectations(t)
+	mockTask.AssertExpectations(t)
+}
+
+func TestRenderError(t *testing.T) {
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		renderError(errors.New("failed"), false)
+	}), "  ERROR  failed")
+
+	var err error
+	func() {
+		defer recoverPanic(&err)
+		panic("something went wrong")
+	}()
+	assert.EqualError(t, err, "panic: something went wrong")
+
+	output := color.CaptureOutput(func(w io.Writer) {
+		renderError(err, true)
+	})
+	assert.Contains(t, output, "ERROR  panic: something went wrong")
+	assert.Contains(t, output, "runtime/debug.Stack")
+}
+
+func TestHandleIsolated(t *testing.T) {
+	cliApp := NewApplication("test", "test", "test", "test", true).(*Application)
+	isolatedCommand := &TestIsolatedCommand{}
+	mockContext := &consolemocks.Context{}
+	assert.EqualError(t, cliApp.handleIsolated(isolatedCommand, mockContext), "the cache is required to run an isolated command")
+
+	// The cache facade is nil if the cache provider isn't registered.
+	cliApp.cache = func() contractscache.Cache {
+		return nil
+	}
+	assert.EqualError(t, cliApp.handleIsolated(isolatedCommand, mockContext), "the cache is required to run an isolated command")
+
+	mockCache := &cachemocks.Cache{}
+	mockLock := &cachemocks.Lock{}
+	cliApp.cache = func() contractscache.Cache {
+		return mockCache
+	}
+	mockCache.On("Lock", "framework:command:isolated", isolationLockTTL).Return(mockLock).Twice()
+	mockLock.On("Get").Return(true).Once()
+	mockLock.On("Release").Return(true).Once()
+	assert.Nil(t, cliApp.handleIsolated(isolatedCommand, mockContext))
+	assert.Equal(t, 1, isolatedCommand.handled)
+
+	mockLock.On("Get").Return(false).Once()
+	mockContext.On("Warning", "The [isolated] command is already running.").Once()
+	assert.Nil(t, cliApp.handleIsolated(isolatedCommand, mockContext))
+	assert.Equal(t, 1, isolatedCommand.handled)
+
+	mockCache.AssertExpectations(t)
+	mockLock.AssertExpectations(t)
+	mockContext.AssertExpectations(t)
+}
+
+func TestWithJsonFlag(t *testing.T) {
+	// Only the commands supporting JSON get the flag.
+	assert.Empty(t, withJsonFlag(command.Extend{}, nil))
+
+	flags := withJsonFlag(command.Extend{Json: true}, nil)
+	assert.Len(t, flags, 1)
+	assert.Equal(t, []string{"json"}, flags[0].Names())
+
+	flags = withJsonFlag(command.Extend{Json: true}, []cli.Flag{&cli.StringFlag{Name: "json", Value: "pretty"}})
+	assert.Len(t, flags, 1)
+	assert.IsType(t, &cli.StringFlag{}, flags[0])
+}
+
 func TestFlagsToCliFlags(t *testing.T) {
 	// Mock flags of different types
 	boolFlag := &command.BoolFlag{Name: "boolFlag", Aliases: []string{"bf"}, Usage: "bool flag", Required: false, Value: false}
@@ -142,3 +298,46 @@ func (receiver *TestCommand) Handle(ctx console.Context) error {
 
 	return nil
 }
+
+type TestIsolatedCommand struct {
+	handled int
+}
+
+func (receiver *TestIsolatedCommand) Signature() string {
+	return "isolated"
+}
+
+func (receiver *TestIsolatedCommand) Description() string {
+	return "Isolated command"
+}
+
+func (receiver *TestIsolatedCommand) Extend() command.Extend {
+	return command.Extend{
+		Isolatable: true,
+	}
+}
+
+func (receiver *TestIsolatedCommand) Handle(ctx console.Context) error {
+	receiver.handled++
+
+	return nil
+}
+
+type TestPanicCommand struct {
+}
+
+func (receiver *TestPanicCommand) Signature() string {
+	return "panic"
+}
+
+func (receiver *TestPanicCommand) Description() string {
+	return "Panic command"
+}
+
+func (receiver *TestPanicCommand) Extend() command.Extend {
+	return command.Extend{}
+}
+
+func (receiver *TestPanicCommand) Handle(ctx console.Context) error {
+	panic("something went wrong")
+}
@@ -1,9 +1,14 @@
 package console
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/huh/spinner"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/pterm/pterm"
 	"github.com/urfave/cli/v2"
 
 	"github.com/goravel/framework/contracts/console"
@@ -12,10 +17,13 @@ import (
 
 type CliContext struct {
 	instance *cli.Context
+	// details The details written by TwoColumnDetail when the --json option is set, they are written as a single
+	// JSON object once the command finishes.
+	details map[string]string
 }
 
 func NewCliContext(instance *cli.Context) *CliContext {
-	return &CliContext{instance}
+	return &CliContext{instance: instance}
 }
 
 func (r *CliContext) Ask(question string, option ...console.AskOption) (string, error) {
@@ -59,6 +67,28 @@ func (r *CliContext) Ask(question string, option ...console.AskOption) (string,
 	return answer, nil
 }
 
+func (r *CliContext) Anticipate(question string, suggestions []string, option ...console.AnticipateOption) (string, error) {
+	var answer string
+	if len(option) > 0 {
+		answer = option[0].Default
+	}
+
+	input := huh.NewInput().Title(question).Suggestions(suggestions)
+	if len(option) > 0 {
+		input.CharLimit(option[0].Limit).Description(option[0].Description).Placeholder(option[0].Placeholder).Prompt(option[0].Prompt)
+		if option[0].Validate != nil {
+			input.Validate(option[0].Validate)
+		}
+	}
+
+	err := input.Value(&answer).Run()
+	if err != nil {
+		return "", err
+	}
+
+	return answer, nil
+}
+
 func (r *CliContext) Argument(index int) string {
 	return r.instance.Args().Get(index)
 }
@@ -128,6 +158,17 @@ func (r *CliContext) Info(message string) {
 	color.Green().Println(message)
 }
 
+func (r *CliContext) Json(value any) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	pterm.Println(string(content))
+
+	return nil
+}
+
 func (r *CliContext) Line(message string) {
 	color.Default().Println(message)
 }
@@ -244,10 +285,76 @@ func (r *CliContext) Spinner(message string, option console.SpinnerOption) error
 	return err
 }
 
+func (r *CliContext) Table(headers []string, rows [][]string) error {
+	if r.isJson() {
+		items := make([]map[string]string, len(rows))
+		for i, row := range rows {
+			items[i] = make(map[string]string, len(headers))
+			for j, header := range headers {
+				if j < len(row) {
+					items[i][header] = row[j]
+				}
+			}
+		}
+
+		return r.Json(items)
+	}
+
+	data := append([][]string{headers}, rows...)
+
+	return pterm.DefaultTable.WithHasHeader(len(headers) > 0).WithBoxed().WithData(data).Render()
+}
+
+func (r *CliContext) TwoColumnDetail(first, second string, filler ...rune) error {
+	if r.isJson() {
+		if r.details == nil {
+			r.details = make(map[string]string)
+		}
+		r.details[first] = second
+
+		return nil
+	}
+
+	fill := '.'
+	if len(filler) > 0 {
+		fill = filler[0]
+	}
+
+	width := pterm.GetTerminalWidth() - lipgloss.Width(first) - lipgloss.Width(second) - 2
+	if width < 1 {
+		width = 1
+	}
+
+	color.Default().Println(fmt.Sprintf("%s %s %s", first, color.Gray().Sprint(strings.Repeat(string(fill), width)), second))
+
+	return nil
+}
+
 func (r *CliContext) Warning(message string) {
 	color.Yellow().Println(message)
 }
 
+func (r *CliContext) WithChunkProgressBar(total, size int, callback func(offset, limit int) error) error {
+	if size < 1 {
+		size = 1
+	}
+
+	bar := r.CreateProgressBar(total)
+	if err := bar.Start(); err != nil {
+		return err
+	}
+
+	for offset := 0; offset < total; offset += size {
+		limit := min(size, total-offset)
+		if err := callback(offset, limit); err != nil {
+			return err
+		}
+		bar.Advance(limit)
+	}
+
+	return bar.Finish()
+}
+
 func (r *CliContext) WithProgressBar(items []any, callback func(any) error) ([]any, error) {
 	bar := r.CreateProgressBar(len(items))
 	err := bar.Start()
@@ -270,3 +377,25 @@ func (r *CliContext) WithProgressBar(items []any, callback func(any) error) ([]a
 
 	return items, nil
 }
+
+func (r *CliContext) WithSpinner(message string, callback func() error) error {
+	return r.Spinner(message, console.SpinnerOption{
+		Action: callback,
+	})
+}
+
+// flushJson Write the details collected by TwoColumnDetail as a single JSON object.
+func (r *CliContext) flushJson() error {
+	if len(r.details) == 0 {
+		return nil
+	}
+
+	details := r.details
+	r.details = nil
+
+	return r.Json(details)
+}
+
+func (r *CliContext) isJson() bool {
+	return r.instance != nil && r.instance.Bool("json")
+}
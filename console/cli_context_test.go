@@ -1,7 +1,15 @@
 package console
 
 import (
+	"errors"
+	"flag"
+	"io"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+
+	"github.com/goravel/framework/support/color"
 )
 
 func TestAsk(_ *testing.T) {
@@ -53,6 +61,31 @@ func TestAsk(_ *testing.T) {
 	*/
 }
 
+func TestAnticipate(_ *testing.T) {
+	/*
+		ctx := &CliContext{}
+		question := "Which environment do you want to deploy to?"
+		answer, err := ctx.Anticipate(question, []string{"local", "staging", "production"}, console.AnticipateOption{
+			Default:     "staging",
+			Description: "Press tab to accept a suggestion",
+			Placeholder: "environment",
+			Validate: func(s string) error {
+				if s == "" {
+					return fmt.Errorf("please enter an environment")
+				}
+
+				return nil
+			},
+		})
+		if err != nil {
+			ctx.Error(err.Error())
+			return
+		}
+
+		ctx.Info(fmt.Sprintf("Deploying to: %s", answer))
+	*/
+}
+
 func TestCreateProgressBar(_ *testing.T) {
 	/*
 		ctx := &CliContext{}
@@ -239,6 +272,22 @@ func TestWarn(_ *testing.T) {
 	*/
 }
 
+func TestWithChunkProgressBar(t *testing.T) {
+	ctx := &CliContext{}
+
+	var chunks [][2]int
+	assert.Nil(t, ctx.WithChunkProgressBar(5, 2, func(offset, limit int) error {
+		chunks = append(chunks, [2]int{offset, limit})
+
+		return nil
+	}))
+	assert.Equal(t, [][2]int{{0, 2}, {2, 2}, {4, 1}}, chunks)
+
+	assert.EqualError(t, ctx.WithChunkProgressBar(5, 2, func(offset, limit int) error {
+		return errors.New("failed")
+	}), "failed")
+}
+
 func TestWithProgressBar(_ *testing.T) {
 	/*
 		ctx := &CliContext{}
@@ -256,3 +305,64 @@ func TestWithProgressBar(_ *testing.T) {
 		ctx.Info("Task completed successfully.")
 	*/
 }
+
+func TestWithSpinner(_ *testing.T) {
+	/*
+		ctx := &CliContext{}
+		err := ctx.WithSpinner("Seeding database...", func() error {
+			// seedDatabase()
+			time.Sleep(2 * time.Second)
+			return nil
+		})
+		if err != nil {
+			ctx.Error(err.Error())
+			return
+		}
+
+		ctx.Info("Database seeded successfully.")
+	*/
+}
+
+func TestTable(t *testing.T) {
+	ctx := &CliContext{instance: newJsonCliContext(false)}
+	output := color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, ctx.Table([]string{"Name", "Status"}, [][]string{{"create_users_table", "Ran"}}))
+	})
+	assert.Contains(t, output, "Name")
+	assert.Contains(t, output, "create_users_table")
+
+	ctx = &CliContext{instance: newJsonCliContext(true)}
+	output = color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, ctx.Table([]string{"Name", "Status"}, [][]string{{"create_users_table", "Ran"}}))
+	})
+	assert.Equal(t, "[{\"Name\":\"create_users_table\",\"Status\":\"Ran\"}]\n", output)
+}
+
+func TestTwoColumnDetail(t *testing.T) {
+	ctx := &CliContext{instance: newJsonCliContext(false)}
+	output := color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, ctx.TwoColumnDetail("Version", "1", '-'))
+	})
+	assert.Contains(t, output, "Version")
+	assert.Contains(t, output, "---")
+
+	// The details are written as a single object once the command finishes.
+	ctx = &CliContext{instance: newJsonCliContext(true)}
+	output = color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, ctx.TwoColumnDetail("Version", "1"))
+		assert.Nil(t, ctx.TwoColumnDetail("Status", "Clean"))
+	})
+	assert.Empty(t, output)
+	output = color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, ctx.flushJson())
+		assert.Nil(t, ctx.flushJson())
+	})
+	assert.Equal(t, "{\"Status\":\"Clean\",\"Version\":\"1\"}\n", output)
+}
+
+func newJsonCliContext(json bool) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	set.Bool("json", json, "")
+
+	return cli.NewContext(cli.NewApp(), set, nil)
+}
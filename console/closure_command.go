@@ -0,0 +1,111 @@
+package console
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+)
+
+var closureParameterRegex = regexp.MustCompile(`\{\s*([^}]+?)\s*}`)
+
+type ClosureCommand struct {
+	arguments   []string
+	cliCommand  *cli.Command
+	description string
+	flags       []command.Flag
+	handle      func(ctx console.Context) error
+	signature   string
+}
+
+// NewClosureCommand Parse the signature of a closure based command, e.g.
+// "mail:send {user : The ID of the user} {--Q|queue=default : The queue name} {--force}".
+func NewClosureCommand(signature string, handle func(ctx console.Context) error) *ClosureCommand {
+	closureCommand := &ClosureCommand{
+		handle:    handle,
+		signature: strings.TrimSpace(strings.SplitN(signature, "{", 2)[0]),
+	}
+
+	for _, match := range closureParameterRegex.FindAllStringSubmatch(signature, -1) {
+		parameter, usage, _ := strings.Cut(match[1], ":")
+		parameter = strings.TrimSpace(parameter)
+		usage = strings.TrimSpace(usage)
+
+		if !strings.HasPrefix(parameter, "--") {
+			closureCommand.arguments = append(closureCommand.arguments, parameter)
+			continue
+		}
+
+		closureCommand.flags = append(closureCommand.flags, parseClosureOption(strings.TrimPrefix(parameter, "--"), usage))
+	}
+
+	return closureCommand
+}
+
+// Signature The name and signature of the console command.
+func (r *ClosureCommand) Signature() string {
+	return r.signature
+}
+
+// Description The console command description.
+func (r *ClosureCommand) Description() string {
+	return r.description
+}
+
+// Extend The console command extend.
+func (r *ClosureCommand) Extend() command.Extend {
+	return command.Extend{
+		Flags: r.flags,
+	}
+}
+
+// Handle Execute the console command.
+func (r *ClosureCommand) Handle(ctx console.Context) error {
+	return r.handle(ctx)
+}
+
+func (r *ClosureCommand) Describe(description string) console.ClosureCommand {
+	r.description = description
+	if r.cliCommand != nil {
+		r.cliCommand.Usage = description
+	}
+
+	return r
+}
+
+// parseClosureOption Parse an option like "Q|queue=default", an option without "=" is a bool flag,
+// an option ending with "=*" accepts multiple values.
+func parseClosureOption(option, usage string) command.Flag {
+	var aliases []string
+	if shortcut, name, found := strings.Cut(option, "|"); found {
+		aliases = []string{shortcut}
+		option = name
+	}
+
+	name, value, hasValue := strings.Cut(option, "=")
+	if !hasValue {
+		return &command.BoolFlag{
+			Name:    name,
+			Aliases: aliases,
+			Usage:   usage,
+		}
+	}
+
+	if value == "*" {
+		return &command.StringSliceFlag{
+			Name:    name,
+			Aliases: aliases,
+			Usage:   usage,
+		}
+	}
+
+	return &command.StringFlag{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   usage,
+		Value:   value,
+	}
+}
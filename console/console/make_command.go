@@ -32,6 +32,13 @@ func (receiver *MakeCommand) Description() string {
 func (receiver *MakeCommand) Extend() command.Extend {
 	return command.Extend{
 		Category: "make",
+		Flags: []command.Flag{
+			&command.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Create the command even if it already exists",
+			},
+		},
 	}
 }
 
@@ -47,13 +54,13 @@ func (receiver *MakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Console command created successfully")
+	color.Green().Printf("Console command [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *MakeCommand) getStub() string {
-	return Stubs{}.Command()
+	return supportconsole.Stub("command.stub", Stubs{}.Command())
 }
 
 // populateStub Populate the place-holders in the command stub.
@@ -24,7 +24,9 @@ func TestMakeCommand(t *testing.T) {
 
 	mockContext.On("Argument", 0).Return("CleanCache").Once()
 	mockContext.On("OptionBool", "force").Return(false).Once()
-	assert.Nil(t, makeCommand.Handle(mockContext))
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, makeCommand.Handle(mockContext))
+	}), "Console command [app/console/commands/clean_cache.go] created successfully")
 	assert.True(t, file.Exists("app/console/commands/clean_cache.go"))
 
 	mockContext.On("Argument", 0).Return("CleanCache").Once()
@@ -40,5 +42,16 @@ func TestMakeCommand(t *testing.T) {
 	assert.True(t, file.Contain("app/console/commands/Goravel/clean_cache.go", "package Goravel"))
 	assert.True(t, file.Contain("app/console/commands/Goravel/clean_cache.go", "type CleanCache struct"))
 
+	mockContext.On("Argument", 0).Return("Goravel/Admin/CleanCache").Once()
+	mockContext.On("OptionBool", "force").Return(false).Once()
+	assert.Nil(t, makeCommand.Handle(mockContext))
+	assert.True(t, file.Contain("app/console/commands/Goravel/Admin/clean_cache.go", "package Admin"))
+
+	assert.Nil(t, file.Create("app/console/commands/clean_cache.go", "custom"))
+	mockContext.On("Argument", 0).Return("CleanCache").Once()
+	mockContext.On("OptionBool", "force").Return(true).Once()
+	assert.Nil(t, makeCommand.Handle(mockContext))
+	assert.True(t, file.Contain("app/console/commands/clean_cache.go", "type CleanCache struct"))
+
 	assert.Nil(t, file.Remove("app"))
 }
@@ -0,0 +1,21 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/event"
+)
+
+// CommandStarting is dispatched before a command is handled, the args are the signature and the arguments of the command.
+type CommandStarting struct {
+}
+
+func (receiver *CommandStarting) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// CommandFinished is dispatched after a command is handled, the args are the signature, the arguments and the exit code of the command.
+type CommandFinished struct {
+}
+
+func (receiver *CommandFinished) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
@@ -0,0 +1,51 @@
+package console
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/goravel/framework/support/color"
+)
+
+// renderedError wraps the error of a command after it has been rendered, so it isn't rendered again.
+type renderedError struct {
+	error
+}
+
+func (r *renderedError) Unwrap() error {
+	return r.error
+}
+
+// PanicError wraps a panic recovered from the Handle method of a command.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (r *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", r.Value)
+}
+
+// recoverPanic Convert a panic to a PanicError, it should be deferred directly.
+func recoverPanic(err *error) {
+	if value := recover(); value != nil {
+		*err = &PanicError{Value: value, Stack: debug.Stack()}
+	}
+}
+
+// renderError Print a friendly message of the error, the stack trace of a panic is printed in verbose mode.
+func renderError(err error, verbose bool) {
+	color.Red().Printfln("  ERROR  %s", err.Error())
+
+	var panicError *PanicError
+	if !errors.As(err, &panicError) {
+		return
+	}
+
+	if verbose {
+		color.Gray().Println(string(panicError.Stack))
+	} else {
+		color.Gray().Println("  Run the command with --verbose to see the stack trace.")
+	}
+}
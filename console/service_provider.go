@@ -3,7 +3,9 @@ package console
 import (
 	"github.com/goravel/framework/console/console"
 	consolecontract "github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/foundation"
+	frameworkevent "github.com/goravel/framework/event"
 )
 
 const Binding = "goravel.console"
@@ -21,6 +23,19 @@ func (receiver *ServiceProvider) Register(app foundation.Application) {
 }
 
 func (receiver *ServiceProvider) Boot(app foundation.Application) {
+	if artisan, ok := app.MakeArtisan().(*Application); ok {
+		artisan.cache = app.MakeCache
+		artisan.events = func() event.Instance {
+			instance, err := app.Make(frameworkevent.Binding)
+			if err != nil {
+				return nil
+			}
+			events, _ := instance.(event.Instance)
+
+			return events
+		}
+	}
+
 	receiver.registerCommands(app)
 }
 
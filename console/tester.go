@@ -0,0 +1,285 @@
+package console
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/urfave/cli/v2"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/support/color"
+)
+
+// Tester runs a command in tests, the prompts of the command are answered by the expected answers
+// instead of reading from the terminal, e.g.
+//
+//	console.NewTester(t, &commands.SendEmails{}).
+//		ExpectsQuestion("Enter the user name", "goravel").
+//		ExpectsConfirmation("Do you want to continue?", true).
+//		Run("--queue", "emails").
+//		AssertSuccessful().
+//		AssertOutputContains("Emails sent")
+type Tester struct {
+	t       TestingT
+	command console.Command
+	answers map[string][]any
+}
+
+// TestingT is the part of *testing.T used by the tester to report the failed assertions.
+type TestingT interface {
+	Errorf(format string, args ...any)
+}
+
+func NewTester(t TestingT, command console.Command) *Tester {
+	return &Tester{
+		t:       t,
+		command: command,
+		answers: make(map[string][]any),
+	}
+}
+
+// ExpectsQuestion answers the Ask, Secret or Anticipate prompt with the question.
+func (r *Tester) ExpectsQuestion(question, answer string) *Tester {
+	return r.expects(question, answer)
+}
+
+// ExpectsConfirmation answers the Confirm prompt with the question.
+func (r *Tester) ExpectsConfirmation(question string, answer bool) *Tester {
+	return r.expects(question, answer)
+}
+
+// ExpectsChoice answers the Choice prompt with the question, the answer is the value of the selected choice.
+func (r *Tester) ExpectsChoice(question, answer string) *Tester {
+	return r.expects(question, answer)
+}
+
+// ExpectsMultiSelect answers the MultiSelect prompt with the question.
+func (r *Tester) ExpectsMultiSelect(question string, answers []string) *Tester {
+	return r.expects(question, answers)
+}
+
+// Run the command with the arguments and options, e.g. Run("--force", "User").
+func (r *Tester) Run(args ...string) *TestResult {
+	result := &TestResult{t: r.t}
+	context := &testContext{tester: r, result: result}
+
+	cliCommand := &cli.Command{
+		Name: r.command.Signature(),
+		Action: func(ctx *cli.Context) error {
+			context.CliContext = NewCliContext(ctx)
+			if err := r.command.Handle(context); err != nil {
+				return err
+			}
+
+			return context.flushJson()
+		},
+		Flags: withJsonFlag(r.command.Extend(), flagsToCliFlags(r.command.Extend().Flags)),
+	}
+
+	instance := cli.NewApp()
+	instance.Commands = []*cli.Command{cliCommand}
+	instance.Writer = io.Discard
+	instance.ErrWriter = io.Discard
+
+	result.Output = pterm.RemoveColorFromString(color.CaptureOutput(func(w io.Writer) {
+		instance.Writer = w
+		result.Err = instance.Run(append([]string{"artisan", r.command.Signature()}, args...))
+	}))
+	if result.Err != nil {
+		result.ExitCode = 1
+	}
+
+	for question, answers := range r.answers {
+		if len(answers) > 0 {
+			r.t.Errorf("question %q was not asked", question)
+		}
+	}
+
+	return result
+}
+
+func (r *Tester) expects(question string, answer any) *Tester {
+	r.answers[question] = append(r.answers[question], answer)
+
+	return r
+}
+
+func (r *Tester) answer(question string) (any, error) {
+	answers := r.answers[question]
+	if len(answers) == 0 {
+		return nil, fmt.Errorf("unexpected question: %s", question)
+	}
+	r.answers[question] = answers[1:]
+
+	return answers[0], nil
+}
+
+type TestResult struct {
+	t        TestingT
+	ExitCode int
+	Err      error
+	Output   string
+	Tables   []OutputTable
+}
+
+type OutputTable struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// AssertExitCode asserts the exit code of the command, a command returning an error exits with 1.
+func (r *TestResult) AssertExitCode(code int) *TestResult {
+	if r.ExitCode != code {
+		r.errorf("unexpected exit code %d, expected %d, error: %v", r.ExitCode, code, r.Err)
+	}
+
+	return r
+}
+
+func (r *TestResult) AssertSuccessful() *TestResult {
+	return r.AssertExitCode(0)
+}
+
+func (r *TestResult) AssertFailed() *TestResult {
+	if r.ExitCode == 0 {
+		r.errorf("the command is expected to fail")
+	}
+
+	return r
+}
+
+func (r *TestResult) AssertOutputContains(text string) *TestResult {
+	if !strings.Contains(r.Output, text) {
+		r.errorf("the output doesn't contain %q, output:\n%s", text, r.Output)
+	}
+
+	return r
+}
+
+func (r *TestResult) AssertOutputMissing(text string) *TestResult {
+	if strings.Contains(r.Output, text) {
+		r.errorf("the output contains %q, output:\n%s", text, r.Output)
+	}
+
+	return r
+}
+
+// AssertOutputLines asserts the lines are printed in order, the lines are compared after trimming spaces.
+func (r *TestResult) AssertOutputLines(lines ...string) *TestResult {
+	outputLines := strings.Split(r.Output, "\n")
+	index := 0
+	for _, outputLine := range outputLines {
+		if index < len(lines) && strings.TrimSpace(outputLine) == strings.TrimSpace(lines[index]) {
+			index++
+		}
+	}
+	if index < len(lines) {
+		r.errorf("the line %q is not printed in order, output:\n%s", lines[index], r.Output)
+	}
+
+	return r
+}
+
+// AssertTable asserts a table with the headers and rows is printed by ctx.Table.
+func (r *TestResult) AssertTable(headers []string, rows [][]string) *TestResult {
+	expected := OutputTable{Headers: headers, Rows: rows}
+	for _, table := range r.Tables {
+		if reflect.DeepEqual(table, expected) {
+			return r
+		}
+	}
+	r.errorf("the table %v is not printed, tables: %v", expected, r.Tables)
+
+	return r
+}
+
+func (r *TestResult) errorf(format string, args ...any) {
+	if helper, ok := r.t.(interface{ Helper() }); ok {
+		helper.Helper()
+	}
+
+	r.t.Errorf(format, args...)
+}
+
+// testContext replaces the prompts of the CLI context with the expected answers of the tester.
+type testContext struct {
+	*CliContext
+	tester *Tester
+	result *TestResult
+}
+
+func (r *testContext) Anticipate(question string, _ []string, _ ...console.AnticipateOption) (string, error) {
+	return answerOf[string](r.tester, question)
+}
+
+func (r *testContext) Ask(question string, option ...console.AskOption) (string, error) {
+	answer, err := answerOf[string](r.tester, question)
+	if err != nil {
+		return "", err
+	}
+	if len(option) > 0 && option[0].Validate != nil {
+		if err := option[0].Validate(answer); err != nil {
+			return "", err
+		}
+	}
+
+	return answer, nil
+}
+
+func (r *testContext) Choice(question string, _ []console.Choice, _ ...console.ChoiceOption) (string, error) {
+	return answerOf[string](r.tester, question)
+}
+
+func (r *testContext) Confirm(question string, _ ...console.ConfirmOption) (bool, error) {
+	return answerOf[bool](r.tester, question)
+}
+
+func (r *testContext) MultiSelect(question string, _ []console.Choice, _ ...console.MultiSelectOption) ([]string, error) {
+	return answerOf[[]string](r.tester, question)
+}
+
+func (r *testContext) Secret(question string, option ...console.SecretOption) (string, error) {
+	answer, err := answerOf[string](r.tester, question)
+	if err != nil {
+		return "", err
+	}
+	if len(option) > 0 && option[0].Validate != nil {
+		if err := option[0].Validate(answer); err != nil {
+			return "", err
+		}
+	}
+
+	return answer, nil
+}
+
+func (r *testContext) Spinner(_ string, option console.SpinnerOption) error {
+	return option.Action()
+}
+
+func (r *testContext) Table(headers []string, rows [][]string) error {
+	r.result.Tables = append(r.result.Tables, OutputTable{Headers: headers, Rows: rows})
+
+	return r.CliContext.Table(headers, rows)
+}
+
+func (r *testContext) WithSpinner(_ string, callback func() error) error {
+	return callback()
+}
+
+func answerOf[T any](tester *Tester, question string) (T, error) {
+	var zero T
+	answer, err := tester.answer(question)
+	if err != nil {
+		return zero, err
+	}
+
+	value, ok := answer.(T)
+	if !ok {
+		return zero, fmt.Errorf("the answer of %q is %T, expected %T", question, answer, zero)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,96 @@
+package console
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+)
+
+func TestTester(t *testing.T) {
+	NewTester(t, &TestPromptCommand{}).
+		ExpectsQuestion("What is your name?", "Goravel").
+		ExpectsConfirmation("Do you want to continue?", true).
+		ExpectsChoice("Which driver?", "redis").
+		Run("--count", "2").
+		AssertSuccessful().
+		AssertOutputContains("Hello Goravel").
+		AssertOutputLines("Hello Goravel", "Driver: redis").
+		AssertOutputMissing("Cancelled").
+		AssertTable([]string{"Name", "Count"}, [][]string{{"Goravel", "2"}})
+
+	NewTester(t, &TestPromptCommand{}).
+		ExpectsQuestion("What is your name?", "Goravel").
+		ExpectsConfirmation("Do you want to continue?", false).
+		Run().
+		AssertFailed().
+		AssertExitCode(1).
+		AssertOutputContains("Cancelled")
+
+	mockT := &testing.T{}
+	result := NewTester(mockT, &TestPromptCommand{}).
+		ExpectsQuestion("What is your name?", "").
+		ExpectsChoice("Unknown?", "value").
+		Run()
+	assert.EqualError(t, result.Err, "the name is required")
+	assert.True(t, mockT.Failed())
+}
+
+type TestPromptCommand struct {
+}
+
+func (receiver *TestPromptCommand) Signature() string {
+	return "prompt"
+}
+
+func (receiver *TestPromptCommand) Description() string {
+	return "Prompt command"
+}
+
+func (receiver *TestPromptCommand) Extend() command.Extend {
+	return command.Extend{
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "count",
+				Value: "1",
+			},
+		},
+	}
+}
+
+func (receiver *TestPromptCommand) Handle(ctx console.Context) error {
+	name, err := ctx.Ask("What is your name?", console.AskOption{
+		Validate: func(s string) error {
+			if s == "" {
+				return errors.New("the name is required")
+			}
+
+			return nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	proceed, err := ctx.Confirm("Do you want to continue?")
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		ctx.Warning("Cancelled")
+
+		return errors.New("cancelled")
+	}
+
+	ctx.Info("Hello " + name)
+	driver, err := ctx.Choice("Which driver?", []console.Choice{{Key: "Redis", Value: "redis"}})
+	if err != nil {
+		return err
+	}
+	ctx.Line("Driver: " + driver)
+
+	return ctx.Table([]string{"Name", "Count"}, [][]string{{name, ctx.Option("count")}})
+}
@@ -21,11 +21,16 @@ type Auth interface {
 	Refresh() (token string, err error)
 	// Logout logs the user out of the application.
 	Logout() error
+	// Can determines if the token of the current user has the given ability, the token must be parsed first.
+	Can(ability string) bool
+	// WithAbilities sets the abilities (e.g. "posts.read" or "posts.*") of the tokens issued by Login and LoginUsingID.
+	WithAbilities(abilities ...string) Auth
 }
 
 type Payload struct {
-	Guard    string
-	Key      string
-	ExpireAt time.Time
-	IssuedAt time.Time
+	Guard     string
+	Key       string
+	Abilities []string
+	ExpireAt  time.Time
+	IssuedAt  time.Time
 }
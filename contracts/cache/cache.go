@@ -8,6 +8,12 @@ import (
 type Cache interface {
 	Driver
 	Store(name string) Driver
+	// Tags returns a cache instance of the default store whose items are tagged with the given names, Flush of the
+	// instance only removes the items of the tags.
+	Tags(names ...string) Driver
+	// Flexible gets an item of the default store with the stale-while-revalidate strategy, the item is served as
+	// stale between the fresh and the stale durations while it's refreshed in the background.
+	Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error)
 }
 
 type Driver interface {
@@ -54,8 +60,46 @@ type Lock interface {
 	Block(t time.Duration, callback ...func()) bool
 	// Get attempts to acquire the lock.
 	Get(callback ...func()) bool
-	// Release the lock.
+	// Owner returns the token identifying the owner of the lock.
+	Owner() string
+	// Release the lock if it isn't held by another owner.
 	Release() bool
 	// ForceRelease releases the lock in disregard of ownership.
 	ForceRelease() bool
 }
+
+// Taggable is implemented by the drivers that support tags natively, the items of the other drivers are tagged by
+// prefixing their keys with the versions of the tags, and a flush of the tags only changes the versions.
+type Taggable interface {
+	// Tags returns a cache instance whose items are tagged with the given names.
+	Tags(names ...string) Driver
+}
+
+// Prunable is implemented by the drivers that don't remove the expired items by themselves, e.g. memory.
+type Prunable interface {
+	// Prune removes the expired items and returns the number of removed items.
+	Prune() (int, error)
+}
+
+// Searchable is implemented by the drivers that can list their keys.
+type Searchable interface {
+	// Keys returns the keys matching the pattern, "*" matches any sequence of characters and "?" a single character.
+	Keys(pattern string) ([]string, error)
+}
+
+// StatsReporter is implemented by the drivers that can report their statistics.
+type StatsReporter interface {
+	// Stats returns the statistics of the store.
+	Stats() (Stats, error)
+}
+
+type Stats struct {
+	// Keys the number of items in the store.
+	Keys int64 `json:"keys"`
+	// Memory the memory used by the store in bytes, -1 if the driver can't report it.
+	Memory int64 `json:"memory"`
+	// Hits the number of reads that found the item, -1 if the driver can't report it.
+	Hits int64 `json:"hits"`
+	// Misses the number of reads that didn't find the item, -1 if the driver can't report it.
+	Misses int64 `json:"misses"`
+}
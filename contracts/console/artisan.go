@@ -7,9 +7,21 @@ type Artisan interface {
 	// Call run an Artisan console command by name.
 	Call(command string)
 
+	// CallWithError run an Artisan console command by name, the error of the command is returned after it's rendered.
+	CallWithError(command string) error
+
 	// CallAndExit run an Artisan console command by name and exit.
 	CallAndExit(command string)
 
+	// Command register a closure based command, the signature supports arguments and options,
+	// e.g. "mail:send {user : The ID of the user} {--Q|queue=default : The queue name} {--force}".
+	Command(signature string, handle func(ctx Context) error) ClosureCommand
+
 	// Run a command. args include: ["./main", "artisan", "command"]
 	Run(args []string, exitIfArtisan bool)
 }
+
+type ClosureCommand interface {
+	// Describe set the description of the command.
+	Describe(description string) ClosureCommand
+}
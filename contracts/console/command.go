@@ -18,6 +18,8 @@ type Command interface {
 }
 
 type Context interface {
+	// Anticipate prompts the user for input with auto-completion suggestions.
+	Anticipate(question string, suggestions []string, option ...AnticipateOption) (string, error)
 	// Ask prompts the user for input.
 	Ask(question string, option ...AskOption) (string, error)
 	// CreateProgressBar creates a new progress bar instance.
@@ -36,6 +38,8 @@ type Context interface {
 	Info(message string)
 	// Error writes an error message to the console.
 	Error(message string)
+	// Json writes the given value to the console as JSON.
+	Json(value any) error
 	// Line writes a string to the console.
 	Line(message string)
 	// MultiSelect prompts the user to select multiple options from a list of options.
@@ -64,10 +68,20 @@ type Context interface {
 	Secret(question string, option ...SecretOption) (string, error)
 	// Spinner creates a new spinner instance.
 	Spinner(message string, option SpinnerOption) error
+	// Table writes a table to the console, or a JSON array of rows when the --json option is set.
+	Table(headers []string, rows [][]string) error
+	// TwoColumnDetail writes a line with the first column on the left and the second one on the right,
+	// or a JSON object when the --json option is set.
+	TwoColumnDetail(first, second string, filler ...rune) error
 	// Warning writes a warning message to the console.
 	Warning(message string)
+	// WithChunkProgressBar executes a callback for each chunk of the total items with a progress bar, the bar is
+	// advanced by the size of each chunk, e.g. the chunks of the records of a query.
+	WithChunkProgressBar(total, size int, callback func(offset, limit int) error) error
 	// WithProgressBar executes a callback with a progress bar.
 	WithProgressBar(items []any, callback func(any) error) ([]any, error)
+	// WithSpinner executes a callback while a spinner is displayed.
+	WithSpinner(message string, callback func() error) error
 }
 
 type Progress interface {
@@ -113,6 +127,21 @@ type AskOption struct {
 	Validate func(string) error
 }
 
+type AnticipateOption struct {
+	// Default the default value for the input.
+	Default string
+	// Description the input description.
+	Description string
+	// Limit the character limit for the input.
+	Limit int
+	// Placeholder the input placeholder.
+	Placeholder string
+	// Prompt the prompt message.
+	Prompt string
+	// Validate the input validation function.
+	Validate func(string) error
+}
+
 type ChoiceOption struct {
 	// Default the default value for the input.
 	Default string
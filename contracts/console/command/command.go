@@ -15,6 +15,11 @@ const (
 type Extend struct {
 	Category string
 	Flags    []Flag
+	// Isolatable only one instance of the command can run at a time across processes,
+	// it requires a cache store that supports locks shared by the servers.
+	Isolatable bool
+	// Json the command supports the --json option, which switches Table and TwoColumnDetail to JSON.
+	Json bool
 }
 
 type Flag interface {
@@ -6,3 +6,12 @@ type Crypt interface {
 	// DecryptString decrypts the given string payload, returning the decrypted string and an error if any.
 	DecryptString(payload string) (string, error)
 }
+
+// AdditionalDataCrypt is implemented by the encrypters authenticating additional data along with the value, e.g.
+// AES-GCM, the payload can only be decrypted with the same additional data.
+type AdditionalDataCrypt interface {
+	// EncryptStringWithData encrypts the given string value and authenticates the additional data along with it.
+	EncryptStringWithData(value, data string) (string, error)
+	// DecryptStringWithData decrypts the given string payload, failing if the additional data doesn't match.
+	DecryptStringWithData(payload, data string) (string, error)
+}
@@ -1,4 +1,14 @@
 package migration
 
 type Blueprint interface {
+	// Column Add a column with its definition, e.g. Column("name", "varchar(255) NOT NULL").
+	Column(column, definition string)
+	// Comment Set the comment of the table, it's ignored by SQLite.
+	Comment(comment string)
+	// GetTableName Get the name of the table.
+	GetTableName() string
+	// RenameColumn Rename a column of the table.
+	RenameColumn(from, to string)
+	// Temporary Indicate that the table is temporary, it's dropped when the connection closes.
+	Temporary()
 }
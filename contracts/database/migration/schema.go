@@ -2,17 +2,19 @@ package migration
 
 type Schema interface {
 	// Create a new table on the schema.
-	//Create(table string, callback func(table Blueprint))
+	Create(table string, callback func(table Blueprint)) error
 	// Connection Get the connection for the schema.
 	Connection(name string) Schema
+	// Drop a table from the schema.
+	Drop(table string) error
 	// DropIfExists Drop a table from the schema if exists.
-	//DropIfExists(table string)
+	DropIfExists(table string) error
 	// Register migrations.
 	Register([]Migration)
 	// Sql Execute a sql directly.
 	Sql(sql string)
 	// Table Modify a table on the schema.
-	//Table(table string, callback func(table Blueprint))
+	Table(table string, callback func(table Blueprint)) error
 }
 
 type Migration interface {
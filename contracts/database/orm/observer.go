@@ -24,3 +24,10 @@ type Observer interface {
 	// ForceDeleted called when the model has been force deleted.
 	ForceDeleted(Event) error
 }
+
+type ObserveOption struct {
+	// Connection only fire the observer for the queries of the connection, all connections if empty.
+	Connection string
+	// When only fire the observer if the predicate returns true, e.g. for a specific tenant.
+	When func(Event) bool
+}
@@ -15,15 +15,24 @@ type Orm interface {
 	// Factory gets a new factory instance for the given model name.
 	Factory() Factory
 	// Observe registers an observer with the Orm.
-	Observe(model any, observer Observer)
+	Observe(model any, observer Observer, option ...ObserveOption)
+	// Project registers a projection that is kept in sync by the models it watches.
+	Project(projection Projection)
+	// Retain registers the models whose old records are pruned by their retention policy.
+	Retain(models ...Retention)
 	// Transaction runs a callback wrapped in a database transaction.
 	Transaction(txFunc func(tx Transaction) error) error
+	// UnitOfWork gets a new unit of work that batches writes into a single transaction.
+	UnitOfWork() UnitOfWork
 	// WithContext sets the context to be used by the Orm.
 	WithContext(ctx context.Context) Orm
 }
 
 type Transaction interface {
 	Query
+	// Context gets the context of the transaction, the events, jobs and mails dispatched with it are dispatched
+	// after the transaction commits if they should be.
+	Context() context.Context
 	// Commit commits the changes in a transaction.
 	Commit() error
 	// Rollback rolls back the changes in a transaction.
@@ -51,6 +60,9 @@ type Query interface {
 	Exec(sql string, values ...any) (*Result, error)
 	// Exists returns true if matching records exist; otherwise, it returns false.
 	Exists(exists *bool) error
+	// ExportTo streams the results to a file of a storage disk in chunks, the format is csv, ndjson or parquet,
+	// the progress callbacks are called with the number of the exported rows after each chunk.
+	ExportTo(disk, path, format string, progress ...func(exported int64)) error
 	// Find finds records that match given conditions.
 	Find(dest any, conds ...any) error
 	// FindOrFail finds records that match given conditions or throws an error.
@@ -70,12 +82,16 @@ type Query interface {
 	FirstOrNew(dest any, attributes any, values ...any) error
 	// ForceDelete forces delete records matching given conditions.
 	ForceDelete(value any, conds ...any) (*Result, error)
+	// ForceIndex forces the query to use the given indexes, e.g. ForceIndex("idx_users_email").
+	ForceIndex(indexes ...string) Query
 	// Get retrieves all rows from the database.
 	Get(dest any) error
 	// Group specifies the group method on the query.
 	Group(name string) Query
 	// Having specifying HAVING conditions for the query.
 	Having(query any, args ...any) Query
+	// Hint passes the optimizer hints to the database, e.g. Hint("MAX_EXECUTION_TIME(1000)").
+	Hint(hints ...string) Query
 	// InRandomOrder specifies the order randomly.
 	InRandomOrder() Query
 	// Join specifying JOIN conditions for the query.
@@ -0,0 +1,12 @@
+package orm
+
+type Projection interface {
+	// Signature returns the unique name of the projection.
+	Signature() string
+	// Models returns the models whose changes should update the projection.
+	Models() []any
+	// Project applies a created, updated, deleted or force deleted model to the read model.
+	Project(event EventType, model Event) error
+	// Rebuild recomputes the read model from scratch.
+	Rebuild(query Query) error
+}
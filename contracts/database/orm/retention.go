@@ -0,0 +1,23 @@
+package orm
+
+import (
+	"time"
+)
+
+// Retention is implemented by the models whose old records are archived or deleted by the model:prune command.
+type Retention interface {
+	// RetentionPolicy returns how long the records of the model are kept.
+	RetentionPolicy() RetentionPolicy
+}
+
+type RetentionPolicy struct {
+	// Column is the time column that the age of a record is computed from, created_at by default.
+	Column string
+	// ArchiveAfter is the age after which the records are moved to the archive disk, they are written to the
+	// disk as JSON lines and removed from the table. It's disabled if it's 0.
+	ArchiveAfter time.Duration
+	// ArchiveDisk is the filesystem disk the records are archived to, the default disk is used if it's empty.
+	ArchiveDisk string
+	// DeleteAfter is the age after which the records are deleted without being archived. It's disabled if it's 0.
+	DeleteAfter time.Duration
+}
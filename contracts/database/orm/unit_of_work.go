@@ -0,0 +1,16 @@
+package orm
+
+type UnitOfWork interface {
+	// Create registers a model to be inserted when the unit of work is flushed.
+	Create(value any) UnitOfWork
+	// Update registers a model to be saved when the unit of work is flushed.
+	Update(value any) UnitOfWork
+	// Delete registers a model to be deleted when the unit of work is flushed.
+	Delete(value any) UnitOfWork
+	// Discard drops all pending operations without touching the database.
+	Discard()
+	// Flush writes all pending operations in a single transaction.
+	Flush() error
+	// Pending returns the number of operations waiting to be flushed.
+	Pending() int
+}
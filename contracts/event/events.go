@@ -1,5 +1,9 @@
 package event
 
+import (
+	"context"
+)
+
 type Instance interface {
 	// Register event listeners to the application.
 	Register(map[Event][]Listener)
@@ -23,9 +27,33 @@ type Listener interface {
 	Handle(args ...any) error
 }
 
+// ShouldDispatchAfterCommit is implemented by the events that should be dispatched after the open
+// database transaction commits, the event is dropped if the transaction rolls back.
+type ShouldDispatchAfterCommit interface {
+	// DispatchAfterCommit determine whether the event should be dispatched after commit.
+	DispatchAfterCommit() bool
+}
+
+// ShouldPublish is implemented by the events that are published to a topic of a Kafka queue connection
+// once they are handled, so they can be consumed by other services.
+type ShouldPublish interface {
+	// PublishOn returns the queue connection and the topic the event is published to, the default
+	// queue connection is used if the connection is empty.
+	PublishOn() (connection string, topic string)
+}
+
 type Task interface {
+	// AfterCommit dispatches the event after the open database transaction commits, the event is dropped if the
+	// transaction rolls back, it overrides the ShouldDispatchAfterCommit of the event.
+	AfterCommit() Task
+	// BeforeCommit dispatches the event immediately even if it's dispatched inside a database transaction, it
+	// overrides the ShouldDispatchAfterCommit of the event.
+	BeforeCommit() Task
 	// Dispatch an event and call the listeners.
 	Dispatch() error
+	// WithContext sets the context of the task, the task waits for the commit of the transaction the context comes
+	// from if it should be dispatched after commit, e.g. orm.Transaction.Context().
+	WithContext(ctx context.Context) Task
 }
 
 type Arg struct {
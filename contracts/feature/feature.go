@@ -0,0 +1,23 @@
+package feature
+
+import (
+	"context"
+)
+
+// Resolver determines whether a feature is active for the context, e.g. for a percentage of the users.
+type Resolver func(ctx context.Context) bool
+
+type Feature interface {
+	// Active determines whether a feature is active.
+	Active(ctx context.Context, name string) bool
+	// Inactive determines whether a feature is inactive.
+	Inactive(ctx context.Context, name string) bool
+	// Define sets the resolver of a feature, the resolver takes precedence over the configuration.
+	Define(name string, resolver Resolver)
+	// Activate activates a feature, it takes precedence over the resolver and the configuration.
+	Activate(name string)
+	// Deactivate deactivates a feature, it takes precedence over the resolver and the configuration.
+	Deactivate(name string)
+	// Forget removes the activation or deactivation of a feature.
+	Forget(name string)
+}
@@ -24,6 +24,8 @@ type Driver interface {
 	DeleteDirectory(directory string) error
 	// Directories get all the directories within a given directory.
 	Directories(path string) ([]string, error)
+	// ETag gets the entity tag of the file, it changes whenever the contents of the file change.
+	ETag(file string) (string, error)
 	// Exists determines if a file exists.
 	Exists(file string) bool
 	// Files gets all the files from the given directory.
@@ -34,6 +36,8 @@ type Driver interface {
 	GetBytes(file string) ([]byte, error)
 	// LastModified gets the file's last modified time.
 	LastModified(file string) (time.Time, error)
+	// Lock acquires an exclusive advisory lock of the file, the file is created if it doesn't exist.
+	Lock(file string) (unlock func() error, err error)
 	// MakeDirectory creates a directory.
 	MakeDirectory(directory string) error
 	// MimeType gets the file's mime type.
@@ -44,8 +48,12 @@ type Driver interface {
 	Move(oldFile, newFile string) error
 	// Path gets the full path for the file.
 	Path(file string) string
-	// Put writes the contents of a file.
+	// Put writes the contents of a file, the file is replaced atomically.
 	Put(file, content string) error
+	// PutIfAbsent writes the contents of a file only if the file doesn't exist (If-None-Match: *).
+	PutIfAbsent(file, content string) error
+	// PutIfMatch writes the contents of a file only if the ETag of the file matches the given ETag (If-Match).
+	PutIfMatch(file, content, etag string) error
 	// PutFile upload the given file.
 	PutFile(path string, source File) (string, error)
 	// PutFileAs upload the given file with a new name.
@@ -83,4 +91,7 @@ type File interface {
 	Store(path string) (string, error)
 	// StoreAs store the file at the given path with a new name.
 	StoreAs(path string, name string) (string, error)
+	// Validate checks the file size doesn't exceed the max size (in bytes, 0 means no limit), and the mime type
+	// detected from the content matches one of the given mime types, e.g. image/png or image/*.
+	Validate(maxSize int64, mimeTypes ...string) error
 }
@@ -8,6 +8,8 @@ import (
 
 type Application interface {
 	Container
+	// About register the given items to be displayed in the section of the "about" command.
+	About(section string, items []AboutItem)
 	// Boot register and bootstrap configured service providers.
 	Boot()
 	// Commands register the given commands with the console application.
@@ -28,6 +30,8 @@ type Application interface {
 	PublicPath(path ...string) string
 	// ExecutablePath get the path to the executable of the running Goravel application.
 	ExecutablePath(path ...string) string
+	// Optimizes register the given commands to be run by the "optimize" and "optimize:clear" commands.
+	Optimizes(optimize, clear string, key ...string)
 	// Publishes register the given paths to be published by the "vendor:publish" command.
 	Publishes(packageName string, paths map[string]string, groups ...string)
 	// CurrentLocale get the current application locale.
@@ -42,4 +46,12 @@ type Application interface {
 	SetJson(json Json)
 	// GetJson get the JSON implementation.
 	GetJson() Json
+	// Shutdown stop the resolved services gracefully, e.g. drain the HTTP server, quit the queue workers and stop the
+	// scheduler, until the context is done.
+	Shutdown(ctx context.Context) error
+}
+
+type AboutItem struct {
+	Key   string
+	Value string
 }
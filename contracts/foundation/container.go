@@ -13,10 +13,14 @@ import (
 	"github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/contracts/database/seeder"
 	"github.com/goravel/framework/contracts/event"
+	"github.com/goravel/framework/contracts/feature"
 	"github.com/goravel/framework/contracts/filesystem"
 	"github.com/goravel/framework/contracts/grpc"
 	"github.com/goravel/framework/contracts/hash"
+	"github.com/goravel/framework/contracts/health"
 	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/http/client"
+	"github.com/goravel/framework/contracts/id"
 	"github.com/goravel/framework/contracts/log"
 	"github.com/goravel/framework/contracts/mail"
 	"github.com/goravel/framework/contracts/queue"
@@ -26,6 +30,7 @@ import (
 	"github.com/goravel/framework/contracts/testing"
 	"github.com/goravel/framework/contracts/translation"
 	"github.com/goravel/framework/contracts/validation"
+	"github.com/goravel/framework/contracts/websocket"
 )
 
 type Container interface {
@@ -49,12 +54,20 @@ type Container interface {
 	MakeCrypt() crypt.Crypt
 	// MakeEvent resolves the event instance.
 	MakeEvent() event.Instance
+	// MakeFeature resolves the feature instance.
+	MakeFeature() feature.Feature
 	// MakeGate resolves the gate instance.
 	MakeGate() access.Gate
 	// MakeGrpc resolves the grpc instance.
 	MakeGrpc() grpc.Grpc
 	// MakeHash resolves the hash instance.
 	MakeHash() hash.Hash
+	// MakeHealth resolves the health instance.
+	MakeHealth() health.Health
+	// MakeHttp resolves the HTTP client instance.
+	MakeHttp() client.Factory
+	// MakeID resolves the id instance.
+	MakeID() id.ID
 	// MakeLang resolves the lang instance.
 	MakeLang(ctx context.Context) translation.Translator
 	// MakeLog resolves the log instance.
@@ -67,8 +80,12 @@ type Container interface {
 	MakeQueue() queue.Queue
 	// MakeRateLimiter resolves the rate limiter instance.
 	MakeRateLimiter() http.RateLimiter
+	// MakeResponseTransformer resolves the response transformer instance.
+	MakeResponseTransformer() http.ResponseTransformer
 	// MakeRoute resolves the route instance.
 	MakeRoute() route.Route
+	// MakeURL resolves the URL generator instance.
+	MakeURL() route.URL
 	// MakeSchedule resolves the schedule instance.
 	MakeSchedule() schedule.Schedule
 	// MakeSchema resolves the schema instance.
@@ -85,6 +102,8 @@ type Container interface {
 	MakeView() http.View
 	// MakeSeeder resolves the seeder instance.
 	MakeSeeder() seeder.Facade
+	// MakeWebSocket resolves the websocket hub instance.
+	MakeWebSocket() websocket.Hub
 	// MakeWith resolves the given type with the given parameters from the container.
 	MakeWith(key any, parameters map[string]any) (any, error)
 	// Singleton registers a shared binding in the container.
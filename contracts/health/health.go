@@ -0,0 +1,41 @@
+package health
+
+import (
+	"context"
+	"time"
+)
+
+// Check checks whether a dependency of the application is healthy, e.g. the database can be pinged, it returns an
+// error if the dependency is unhealthy.
+type Check func(ctx context.Context) error
+
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+type Health interface {
+	// Register registers a check by name, the check registered with the same name is replaced.
+	Register(name string, check Check)
+	// Forget removes a check by name.
+	Forget(name string)
+	// Run runs the checks concurrently and reports their results.
+	Run(ctx context.Context) Report
+}
+
+type Report struct {
+	// The status is down if any check is down
+	Status Status
+	Checks map[string]Result
+	// The time the checks took together
+	Latency time.Duration
+}
+
+type Result struct {
+	Status  Status
+	Latency time.Duration
+	// The error of the check, it's empty if the check is up
+	Error string
+}
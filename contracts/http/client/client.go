@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+type Factory interface {
+	Request
+	// Fake makes the requests return the stub responses instead of being sent, the key of a stub is the URL pattern
+	// without the scheme, e.g. goravel.dev/users/*, and "*" matches all the requests. The requests are recorded.
+	Fake(stubs map[string]Stub) Factory
+	// Recorded gets the requests sent while the factory is fake, and their responses.
+	Recorded() []Record
+	// AssertSent determines whether a recorded request matches the callback.
+	AssertSent(callback func(record Record) bool) bool
+	// AssertSentCount determines whether the number of the recorded requests is the count.
+	AssertSentCount(count int) bool
+}
+
+type Request interface {
+	// Accept sets the content type the response is expected in.
+	Accept(contentType string) Request
+	// AcceptJSON expects the response in JSON.
+	AcceptJSON() Request
+	// AsForm sends the body as a form, the body should be a map[string]string or url.Values.
+	AsForm() Request
+	// Attach sends the body as a multipart form with the file, the body should be a map[string]string of the fields.
+	Attach(name, filename string, content io.Reader) Request
+	// BaseURL sets the URL the relative URLs of the requests are resolved against.
+	BaseURL(url string) Request
+	// Retry retries the request for the times when it fails, the sleep doubles after each attempt with a jitter, up
+	// to a minute, and the Retry-After header of the 429 and 503 responses is honored. A request fails on connection
+	// errors and 5xx and 429 responses by default, when determines it otherwise.
+	Retry(times int, sleep time.Duration, when ...func(response Response, err error) bool) Request
+	// Timeout sets the timeout of each attempt of the request.
+	Timeout(timeout time.Duration) Request
+	// WithBasicAuth sets the basic authentication credentials of the request.
+	WithBasicAuth(username, password string) Request
+	// WithContext sets the context of the request.
+	WithContext(ctx context.Context) Request
+	// WithHeader sets a header of the request.
+	WithHeader(key, value string) Request
+	// WithHeaders sets the headers of the request.
+	WithHeaders(headers map[string]string) Request
+	// WithQueryParameters sets the query parameters of the request.
+	WithQueryParameters(parameters map[string]string) Request
+	// WithToken sets the bearer token of the request, the type of the token is Bearer by default.
+	WithToken(token string, tokenType ...string) Request
+
+	// Delete sends a DELETE request.
+	Delete(url string, body ...any) (Response, error)
+	// Get sends a GET request.
+	Get(url string) (Response, error)
+	// Head sends a HEAD request.
+	Head(url string) (Response, error)
+	// Patch sends a PATCH request.
+	Patch(url string, body ...any) (Response, error)
+	// Post sends a POST request, the body is encoded in JSON unless it's a string, []byte or io.Reader.
+	Post(url string, body ...any) (Response, error)
+	// Put sends a PUT request.
+	Put(url string, body ...any) (Response, error)
+	// Send sends a request with the method.
+	Send(method, url string, body ...any) (Response, error)
+}
+
+type Response interface {
+	// Bind decodes the JSON body into the value, e.g. a pointer to a struct.
+	Bind(value any) error
+	// Body gets the body of the response.
+	Body() string
+	// ClientError determines whether the status is 4xx.
+	ClientError() bool
+	// Failed determines whether the status is 4xx or 5xx.
+	Failed() bool
+	// Header gets a header of the response.
+	Header(key string) string
+	// Headers gets the headers of the response.
+	Headers() http.Header
+	// Json decodes the JSON body into a map.
+	Json() (map[string]any, error)
+	// ServerError determines whether the status is 5xx.
+	ServerError() bool
+	// Status gets the status of the response.
+	Status() int
+	// Successful determines whether the status is 2xx.
+	Successful() bool
+}
+
+// Stub builds the fake response of a request.
+type Stub func(request *http.Request) (*http.Response, error)
+
+type Record struct {
+	// Request is the sent request.
+	Request *http.Request
+	// Body is the body of the request.
+	Body []byte
+	// Response is the fake response of the request, it's nil if the stub returns an error.
+	Response Response
+}
@@ -2,10 +2,22 @@ package http
 
 import (
 	"context"
+	"net/http"
 )
 
 type Middleware func(Context)
 type HandlerFunc func(Context) Response
+
+// Terminatable is a middleware doing its slow work once the response is sent, e.g. writing the audit logs, it's
+// registered by http.Terminate.
+type Terminatable interface {
+	// Handle handles the request like a Middleware.
+	Handle(ctx Context)
+	// Terminate runs once the response is sent. The context, the request and the response are copies detached from
+	// the handled request, the context isn't canceled when the request ends.
+	Terminate(ctx context.Context, request *http.Request, response ResponseOrigin)
+}
+
 type ResourceController interface {
 	// Index method for controller
 	Index(Context) Response
@@ -1,5 +1,9 @@
 package http
 
+import (
+	"time"
+)
+
 type RateLimiter interface {
 	// For register a new rate limiter.
 	For(name string, callback func(ctx Context) Limit)
@@ -7,6 +11,23 @@ type RateLimiter interface {
 	ForWithLimits(name string, callback func(ctx Context) []Limit)
 	// Limiter get a rate limiter instance by name.
 	Limiter(name string) func(ctx Context) []Limit
+
+	// Attempt executes the callback and hits the key if the key hasn't reached the max attempts, it returns whether
+	// the callback is executed and the error of the callback.
+	Attempt(key string, maxAttempts int, callback func() error, decay ...time.Duration) (bool, error)
+	// Attempts get the number of the attempts of the key.
+	Attempts(key string) int
+	// AvailableIn get the duration until the attempts of the key are reset.
+	AvailableIn(key string) time.Duration
+	// Clear the attempts of the key.
+	Clear(key string)
+	// Hit increments the attempts of the key and returns them, the attempts are reset after the decay, 1 minute by
+	// default.
+	Hit(key string, decay ...time.Duration) int
+	// Remaining get the number of the remaining attempts of the key.
+	Remaining(key string, maxAttempts int) int
+	// TooManyAttempts determine whether the key has reached the max attempts.
+	TooManyAttempts(key string, maxAttempts int) bool
 }
 
 type Limit interface {
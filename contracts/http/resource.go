@@ -0,0 +1,7 @@
+package http
+
+// Resource transforms a model to the data of a response, e.g. hiding the columns or renaming the fields.
+type Resource interface {
+	// ToArray returns the fields of the resource, the fields can be resources, collections or conditional values.
+	ToArray(ctx Context) map[string]any
+}
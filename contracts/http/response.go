@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"io"
 	"net/http"
 )
 
@@ -12,9 +13,11 @@ type ContextResponse interface {
 	Cookie(cookie Cookie) ContextResponse
 	// Data write the given data to the response.
 	Data(code int, contentType string, data []byte) Response
-	// Download initiates a file download by specifying the file path and the desired filename
+	// Download initiates a file download by specifying the file path and the desired filename, the Range and the
+	// conditional requests are supported.
 	Download(filepath, filename string) Response
-	// File serves a file located at the specified file path as the response.
+	// File serves a file located at the specified file path as the response, the Range and the conditional requests
+	// are supported.
 	File(filepath string) Response
 	// Header sets an HTTP header field with the given key and value.
 	Header(key, value string) ContextResponse
@@ -35,6 +38,9 @@ type ContextResponse interface {
 	Status(code int) ResponseStatus
 	// Stream sends a streaming response with the specified status code and the given reader.
 	Stream(code int, step func(w StreamWriter) error) Response
+	// StreamDownload initiates a download of the content written by the callback, e.g. a CSV export generated row by
+	// row, the content isn't buffered.
+	StreamDownload(filename string, callback func(w io.Writer) error) Response
 	// View returns ResponseView
 	View() ResponseView
 	// Writer returns the underlying http.ResponseWriter associated with the response.
@@ -49,6 +55,13 @@ type Response interface {
 	Render() error
 }
 
+// ResponseWriterSetter is implemented by the responses whose writer can be replaced, e.g. by the middleware
+// buffering or compressing the body.
+type ResponseWriterSetter interface {
+	// SetWriter replaces the underlying http.ResponseWriter of the response.
+	SetWriter(writer http.ResponseWriter)
+}
+
 type StreamWriter interface {
 	// Write writes the specified data to the response.
 	Write(data []byte) (int, error)
@@ -0,0 +1,11 @@
+package http
+
+// Transformer transforms the body of a response before it's encoded, e.g. wrapping the body in an envelope.
+type Transformer func(ctx Context, code int, obj any) any
+
+type ResponseTransformer interface {
+	// Register register global transformers that are applied to every response.
+	Register(transformers ...Transformer)
+	// Transform apply the transformers of the route and then the global transformers to the response body.
+	Transform(ctx Context, code int, obj any) any
+}
@@ -0,0 +1,18 @@
+package http
+
+type WebSocketHandlerFunc func(socket WebSocket) error
+
+type WebSocket interface {
+	// Broadcast sends a message to the other connections that joined the given room.
+	Broadcast(room string, message []byte) error
+	// Close closes the connection.
+	Close() error
+	// Context returns the HTTP context of the upgraded request, the values set by the middleware are kept.
+	Context() Context
+	// ID returns the unique identifier of the connection.
+	ID() string
+	// Read reads the next message from the connection, it blocks until a message is received.
+	Read() ([]byte, error)
+	// Send writes a message to the connection.
+	Send(message []byte) error
+}
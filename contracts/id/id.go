@@ -0,0 +1,12 @@
+package id
+
+type Generator interface {
+	// Generate returns a new ID.
+	Generate() string
+}
+
+type ID interface {
+	Generator
+	// Driver returns the generator of a driver: uuid, ulid, snowflake or custom.
+	Driver(name string) Generator
+}
@@ -1,5 +1,9 @@
 package mail
 
+import (
+	"context"
+)
+
 type Mail interface {
 	// Attach attaches files to the Mail.
 	Attach(files []string) Mail
@@ -19,6 +23,9 @@ type Mail interface {
 	Subject(subject string) Mail
 	// To set the recipients of Mail.
 	To(addresses []string) Mail
+	// WithContext sets the context of Mail, the mail waits for the commit of the transaction the context comes from
+	// if it should be sent after commit, e.g. orm.Transaction.Context().
+	WithContext(ctx context.Context) Mail
 }
 
 type Mailable interface {
@@ -32,6 +39,13 @@ type Mailable interface {
 	Queue() *Queue
 }
 
+// ShouldDispatchAfterCommit is implemented by the mailables that should be sent or queued after the open
+// database transaction commits, the mail is dropped if the transaction rolls back.
+type ShouldDispatchAfterCommit interface {
+	// DispatchAfterCommit determine whether the mail should be sent after commit.
+	DispatchAfterCommit() bool
+}
+
 type Content struct {
 	Html string
 }
@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"time"
+)
+
+type PendingBatch interface {
+	// Name sets the name of the batch.
+	Name(name string) PendingBatch
+	// Then sets the job dispatched after all the jobs of the batch succeed.
+	Then(job Job, args []Arg) PendingBatch
+	// Catch sets the job dispatched when the first job of the batch fails.
+	Catch(job Job, args []Arg) PendingBatch
+	// Finally sets the job dispatched after all the jobs of the batch finish, whether they fail or not.
+	Finally(job Job, args []Arg) PendingBatch
+	// OnConnection sets the connection of the batch.
+	OnConnection(connection string) PendingBatch
+	// OnQueue sets the queue of the batch.
+	OnQueue(queue string) PendingBatch
+	// Dispatch stores the batch and dispatches its jobs.
+	Dispatch() (*Batch, error)
+}
+
+type Batch struct {
+	ID          string
+	Name        string
+	TotalJobs   int
+	PendingJobs int
+	FailedJobs  int
+	CreatedAt   time.Time
+	CancelledAt *time.Time
+	FinishedAt  *time.Time
+}
+
+// ProcessedJobs gets the number of the jobs that have finished.
+func (r *Batch) ProcessedJobs() int {
+	return r.TotalJobs - r.PendingJobs
+}
+
+// Progress gets the percentage of the jobs that have finished.
+func (r *Batch) Progress() int {
+	if r.TotalJobs == 0 {
+		return 100
+	}
+
+	return r.ProcessedJobs() * 100 / r.TotalJobs
+}
+
+// Finished determine if all the jobs of the batch have finished.
+func (r *Batch) Finished() bool {
+	return r.FinishedAt != nil
+}
+
+// Cancelled determine if the batch is cancelled by a failed job.
+func (r *Batch) Cancelled() bool {
+	return r.CancelledAt != nil
+}
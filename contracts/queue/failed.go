@@ -0,0 +1,29 @@
+package queue
+
+import (
+	"time"
+)
+
+type Failer interface {
+	// All gets all the failed jobs, the oldest first.
+	All() ([]FailedJob, error)
+	// Find gets a failed job by its ID.
+	Find(id uint) (*FailedJob, error)
+	// Retry pushes a failed job back onto its queue and removes it from the failed jobs.
+	Retry(job FailedJob) error
+	// Forget removes a failed job, it returns false if the job doesn't exist.
+	Forget(id uint) (bool, error)
+	// Flush removes the failed jobs older than the given hours, all the failed jobs are removed if hours is 0.
+	Flush(hours int) (int64, error)
+}
+
+type FailedJob struct {
+	ID         uint
+	UUID       string
+	Connection string
+	Queue      string
+	Signature  string
+	Payload    string
+	Exception  string
+	FailedAt   time.Time
+}
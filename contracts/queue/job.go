@@ -1,5 +1,10 @@
 package queue
 
+import (
+	"context"
+	"time"
+)
+
 type Job interface {
 	// Signature set the unique signature of the job.
 	Signature() string
@@ -7,6 +12,99 @@ type Job interface {
 	Handle(args ...any) error
 }
 
+// ShouldDispatchAfterCommit is implemented by the jobs that should be dispatched after the open
+// database transaction commits, the job is dropped if the transaction rolls back.
+type ShouldDispatchAfterCommit interface {
+	// DispatchAfterCommit determine whether the job should be dispatched after commit.
+	DispatchAfterCommit() bool
+}
+
+// Checkpointable is implemented by the long-running jobs that can resume from their progress. The context
+// is canceled when the worker receives a shutdown signal, the job should then stop and return the checkpoint
+// of its progress, the job is released back to the queue and resumes from the checkpoint on the next attempt.
+type Checkpointable interface {
+	// HandleWithCheckpoint executes the job from the checkpoint, the checkpoint is nil on the first attempt.
+	HandleWithCheckpoint(ctx context.Context, checkpoint []byte, args ...any) ([]byte, error)
+}
+
+// ShouldHandleWithContext is implemented by the jobs receiving the context of their attempt, it's called instead of
+// Handle. The context is canceled when the timeout of the job is reached, the job should then stop and return.
+type ShouldHandleWithContext interface {
+	// HandleWithContext executes the job with the context of the attempt.
+	HandleWithContext(ctx context.Context, args ...any) error
+}
+
+// SerializesModels is implemented by the jobs receiving ORM models as arguments, e.g. queue.Arg{Type: queue.ArgModel,
+// Value: &user}. A model is pushed onto the queue as its type, primary key and connection, and the job receives the
+// fresh model when it runs, the job fails without being retried if the model has been deleted.
+type SerializesModels interface {
+	// Models gets the models the job receives, e.g. []any{&models.User{}}.
+	Models() []any
+}
+
+// HasTries is implemented by the jobs that are attempted more than once when they fail.
+type HasTries interface {
+	// Tries gets the number of times the job may be attempted.
+	Tries() int
+}
+
+// HasBackoff is implemented by the jobs that wait before they are retried.
+type HasBackoff interface {
+	// Backoff gets the delays before the retries, e.g. []time.Duration{time.Second, 10 * time.Second}, the last
+	// delay is used by the remaining retries, so a single delay is a fixed backoff.
+	Backoff() []time.Duration
+}
+
+// HasTimeout is implemented by the jobs that fail when they run longer than a duration. The context of the jobs
+// implementing Checkpointable or ShouldHandleWithContext is canceled when the duration is reached, and the worker
+// waits for them to return before a retry. The other jobs can't be stopped, so their attempt fails at once and they
+// keep running in the background.
+type HasTimeout interface {
+	// Timeout gets the maximum duration of an attempt of the job.
+	Timeout() time.Duration
+}
+
+// HasRetryUntil is implemented by the jobs that are retried until a time instead of a number of tries.
+type HasRetryUntil interface {
+	// RetryUntil gets the time after which the job is no longer retried, it's evaluated when the job is dispatched.
+	RetryUntil() time.Time
+}
+
+// ShouldBeUnique is implemented by the jobs that should only be on the queue once at a time, a job is dropped
+// when it's dispatched while a job with the same unique ID is pending or running.
+type ShouldBeUnique interface {
+	// UniqueID gets the unique ID of the job from its args, e.g. the ID of the model processed by the job.
+	UniqueID(args ...any) string
+	// UniqueFor gets the duration after which the job is no longer unique even if it hasn't finished, the
+	// job is unique until it finishes if the duration is 0.
+	UniqueFor() time.Duration
+}
+
+// HasFeature is implemented by the jobs that are gated by a feature flag, e.g. during a staged rollout, the job
+// is skipped without failing while the feature is inactive.
+type HasFeature interface {
+	// Feature gets the name of the feature gating the job.
+	Feature() string
+}
+
+// Middleware wraps the handling of a job, next handles the job or calls the next middleware.
+type Middleware interface {
+	Handle(job Job, args []any, next func() error) error
+}
+
+// MiddlewareFunc is an adapter to use a function as a job middleware, e.g. to set the tenant or the trace of a job.
+type MiddlewareFunc func(job Job, args []any, next func() error) error
+
+func (f MiddlewareFunc) Handle(job Job, args []any, next func() error) error {
+	return f(job, args, next)
+}
+
+// HasMiddleware is implemented by the jobs that are handled through middleware.
+type HasMiddleware interface {
+	// Middleware gets the middleware of the job, the first middleware is the outermost.
+	Middleware() []Middleware
+}
+
 type Jobs struct {
 	Job  Job
 	Args []Arg
@@ -0,0 +1,30 @@
+package queue
+
+import (
+	"time"
+)
+
+type Monitor interface {
+	// Metrics gets the metrics of a queue recorded by the workers.
+	Metrics(connection, queue string) (*Metrics, error)
+	// Size gets the number of the pending jobs of a queue.
+	Size(connection, queue string) (int, error)
+	// Reset clears the metrics of a queue.
+	Reset(connection, queue string) error
+}
+
+type Metrics struct {
+	Connection string
+	Queue      string
+	// The number of the jobs processed, including the failed ones
+	Processed int64
+	// The number of the jobs failed permanently
+	Failed int64
+	// The average number of the jobs processed per minute since the metrics started
+	Throughput float64
+	// The average time the jobs waited on the queue before being processed
+	AverageWait time.Duration
+	// The average time the jobs took to be processed
+	AverageRuntime time.Duration
+	StartedAt      time.Time
+}
@@ -1,5 +1,10 @@
 package queue
 
+import (
+	"context"
+	"time"
+)
+
 type Queue interface {
 	Worker(args ...Args) Worker
 	// Register register jobs
@@ -10,21 +15,43 @@ type Queue interface {
 	Job(job Job, args []Arg) Task
 	// Chain creates a chain of jobs to be processed one by one, passing
 	Chain(jobs []Jobs) Task
+	// Batch creates a batch of jobs to be processed in parallel, the progress of the batch is tracked in the database.
+	Batch(jobs []Jobs) PendingBatch
+	// FindBatch gets a batch by its ID.
+	FindBatch(id string) (*Batch, error)
+	// Failer gets the store of the failed jobs.
+	Failer() Failer
+	// Monitor gets the metrics and the sizes of the queues.
+	Monitor() Monitor
+	// Clear deletes all the jobs of a queue including the delayed and the reserved ones, the number of the
+	// deleted jobs is returned. The default queue of the connection is cleared if the queue is empty.
+	Clear(connection, queue string) (int, error)
 }
 
 type Worker interface {
 	Run() error
+	// Shutdown quits the worker gracefully, and waits for the running jobs to finish until the context is done.
+	Shutdown(ctx context.Context) error
 }
 
 type Args struct {
 	// Specify connection
 	Connection string
-	// Specify queue
+	// Specify queue, multiple queues are separated by commas in priority order, e.g. "high,default"
 	Queue string
 	// Concurrent num
 	Concurrent int
+	// Stop the worker after processing the number of jobs, 0 means no limit
+	MaxJobs int
+	// Stop the worker after running for the duration, 0 means no limit
+	MaxTime time.Duration
+	// Stop the worker when the memory it allocates exceeds the megabytes, 0 means no limit
+	Memory int
 }
 
+// ArgModel is the type of the arguments holding ORM models, see SerializesModels.
+const ArgModel = "model"
+
 type Arg struct {
 	Type  string
 	Value any
@@ -1,18 +1,35 @@
 package queue
 
 import (
+	"context"
 	"time"
 )
 
 type Task interface {
+	// AfterCommit dispatches the task after the open database transaction commits, the task is dropped if the
+	// transaction rolls back, it overrides the ShouldDispatchAfterCommit of the jobs.
+	AfterCommit() Task
+	// BeforeCommit dispatches the task immediately even if it's dispatched inside a database transaction, it
+	// overrides the ShouldDispatchAfterCommit of the jobs.
+	BeforeCommit() Task
+	// CatchChain sets the job dispatched when a job of the chain fails, the remaining jobs of the chain are
+	// skipped and the job receives the error message followed by the given args.
+	CatchChain(job Job, args []Arg) Task
+	// DeferOn delays the task to the end of the exclusion when it is due during an exclusion of the calendar.
+	DeferOn(calendar string) Task
 	// Dispatch dispatches the task.
 	Dispatch() error
 	// DispatchSync dispatches the task synchronously.
 	DispatchSync() error
 	// Delay dispatches the task after the given delay.
 	Delay(time time.Time) Task
+	// DelayFor dispatches the task after the given duration.
+	DelayFor(duration time.Duration) Task
 	// OnConnection sets the connection of the task.
 	OnConnection(connection string) Task
 	// OnQueue sets the queue of the task.
 	OnQueue(queue string) Task
+	// WithContext sets the context of the task, the task waits for the commit of the transaction the context comes
+	// from if it should be dispatched after commit, e.g. orm.Transaction.Context().
+	WithContext(ctx context.Context) Task
 }
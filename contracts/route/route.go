@@ -1,6 +1,7 @@
 package route
 
 import (
+	"context"
 	"net/http"
 
 	contractshttp "github.com/goravel/framework/contracts/http"
@@ -12,6 +13,8 @@ type Route interface {
 	Router
 	// Fallback registers a handler to be executed when no other route was matched.
 	Fallback(handler contractshttp.HandlerFunc)
+	// GetRoutes returns the information of all registered routes.
+	GetRoutes() []Info
 	// GlobalMiddleware registers global middleware to be applied to all routes of the router.
 	GlobalMiddleware(middlewares ...contractshttp.Middleware)
 	// Run starts the HTTP server and listens for incoming connections on the specified host.
@@ -22,6 +25,9 @@ type Route interface {
 	RunTLSWithCert(host, certFile, keyFile string) error
 	// ServeHTTP serves HTTP requests.
 	ServeHTTP(writer http.ResponseWriter, request *http.Request)
+	// Shutdown stops the server from accepting new connections, and waits for the in-flight requests to finish
+	// until the context is done.
+	Shutdown(ctx context.Context) error
 }
 
 type Router interface {
@@ -56,3 +62,28 @@ type Router interface {
 	// StaticFS registers a new route with a path prefix to serve static files from the provided file system.
 	StaticFS(relativePath string, fs http.FileSystem)
 }
+
+// DomainRouter is implemented by the routers matching the domains of the route groups natively.
+type DomainRouter interface {
+	// Domain returns a router whose routes match only the requests of the domain, e.g. {tenant}.goravel.dev.
+	Domain(domain string) Router
+}
+
+// WebSocketRouter is implemented by the routers serving the WebSocket routes natively.
+type WebSocketRouter interface {
+	// WebSocket registers a route upgrading the requests to WebSocket connections.
+	WebSocket(relativePath string, handler contractshttp.WebSocketHandlerFunc)
+}
+
+type Info struct {
+	// Method is the HTTP method of the route, e.g. GET.
+	Method string `json:"method"`
+	// Path is the URI pattern of the route, e.g. /users/{id}.
+	Path string `json:"path"`
+	// Name is the name of the route, empty if the route is not named.
+	Name string `json:"name"`
+	// Action is the handler of the route, e.g. controllers.(*UserController).Show.
+	Action string `json:"action"`
+	// Middleware is the middleware applied to the route, including the global middleware.
+	Middleware []string `json:"middleware"`
+}
@@ -0,0 +1,17 @@
+package route
+
+import (
+	"time"
+)
+
+type URL interface {
+	// Route generates the URL of a named route, the parameters fill the parameters of the route path, and the
+	// others are appended as the query string.
+	Route(name string, parameters ...map[string]any) (string, error)
+	// SignedRoute generates the URL of a named route with a signature, so the URL can't be tampered with.
+	SignedRoute(name string, parameters ...map[string]any) (string, error)
+	// TemporarySignedRoute generates the URL of a named route with a signature expiring after the expiration.
+	TemporarySignedRoute(name string, expiration time.Duration, parameters ...map[string]any) (string, error)
+	// HasValidSignature determines whether the URL has a valid signature that hasn't expired.
+	HasValidSignature(url string) bool
+}
@@ -9,6 +9,8 @@ type Event interface {
 	Daily() Event
 	// DailyAt schedule the event to run daily at a given time (10:00, 19:30, etc).
 	DailyAt(time string) Event
+	// DeferOn defer the event to the end of the exclusion when it is due during an exclusion of the calendar.
+	DeferOn(calendar string) Event
 	// DelayIfStillRunning if the event is still running, the event will be delayed.
 	DelayIfStillRunning() Event
 	// EveryMinute schedule the event to run every minute.
@@ -39,6 +41,10 @@ type Event interface {
 	GetCron() string
 	// GetCommand get the command.
 	GetCommand() string
+	// GetCalendar get the calendar the event skips or is deferred on.
+	GetCalendar() string
+	// GetDeferOnCalendar get deferOnCalendar bool.
+	GetDeferOnCalendar() bool
 	// GetCallback get callback.
 	GetCallback() func()
 	// GetName get name.
@@ -57,6 +63,8 @@ type Event interface {
 	Name(name string) Event
 	// OnOneServer only allow the event to run on one server for each cron expression.
 	OnOneServer() Event
+	// SkipOn skip the event when it is due during an exclusion of the calendar.
+	SkipOn(calendar string) Event
 	// SkipIfStillRunning if the event is still running, the event will be skipped.
 	SkipIfStillRunning() Event
 }
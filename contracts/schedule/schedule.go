@@ -1,5 +1,9 @@
 package schedule
 
+import (
+	"context"
+)
+
 type Schedule interface {
 	// Call add a new callback event to the schedule.
 	Call(callback func()) Event
@@ -9,4 +13,6 @@ type Schedule interface {
 	Register(events []Event)
 	// Run schedules.
 	Run()
+	// Shutdown stops running new events, and waits for the running events to finish until the context is done.
+	Shutdown(ctx context.Context) error
 }
@@ -54,6 +54,8 @@ type DatabaseConfig struct {
 }
 
 type Image struct {
+	// Cmd the arguments passed to the entrypoint of the image.
+	Cmd          []string
 	Env          []string
 	ExposedPorts []string
 	Repository   string
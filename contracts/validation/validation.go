@@ -33,6 +33,10 @@ type Errors interface {
 	All() map[string]map[string]string
 	// Has checks if there are any error messages for a given field.
 	Has(key string) bool
+	// Codes gets the error codes of the failed rules for a given field.
+	Codes(key string) map[string]string
+	// AllCodes gets all the error codes.
+	AllCodes() map[string]map[string]string
 }
 
 type Data interface {
@@ -0,0 +1,58 @@
+package websocket
+
+import (
+	"context"
+
+	"github.com/goravel/framework/contracts/auth/access"
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+)
+
+type Connection interface {
+	// ID returns the unique identifier of the connection.
+	ID() string
+	// Send writes a message to the connection.
+	Send(message []byte) error
+	// Close closes the connection.
+	Close() error
+}
+
+type Hub interface {
+	// Add registers a connection with the hub.
+	Add(connection Connection)
+	// Allow reports whether the connection may send another message under its rate limit.
+	Allow(id string) bool
+	// Authenticate binds a user to the connection.
+	Authenticate(id, user string) error
+	// Channel defines the authorization of the channels matching the pattern, e.g. orders.{id}, as a gate ability.
+	Channel(pattern string, callback func(ctx context.Context, arguments map[string]any) access.Response)
+	// Connection gets a registered connection by id.
+	Connection(id string) (Connection, bool)
+	// Count returns the number of registered connections.
+	Count() int
+	// Emit sends a message to all registered connections.
+	Emit(message []byte) error
+	// EmitToRoom sends a message to the connections that joined the given room.
+	EmitToRoom(room string, message []byte) error
+	// EmitToUser sends a message to the connections bound to the given user.
+	EmitToUser(user string, message []byte) error
+	// Join adds the connection to the given room.
+	Join(id, room string) error
+	// JoinChannel authorizes the connection through the gate, then adds it to the given channel.
+	JoinChannel(ctx context.Context, id, channel string) error
+	// Leave removes the connection from the given room.
+	Leave(id, room string)
+	// Members returns the users of the connections that joined the given room.
+	Members(room string) []string
+	// Remove unregisters the connection and removes it from all of its rooms.
+	Remove(id string)
+	// Rooms returns the rooms the connection has joined.
+	Rooms(id string) []string
+	// Route registers a GET route upgrading the requests to WebSocket connections, the routers implementing
+	// route.WebSocketRouter serve it natively.
+	Route(router route.Router, relativePath string, handler http.WebSocketHandlerFunc)
+	// Upgrade returns an HTTP handler upgrading the requests to WebSocket connections registered with the hub.
+	Upgrade(handler http.WebSocketHandlerFunc) http.HandlerFunc
+	// User returns the user bound to the connection, empty if it isn't authenticated.
+	User(id string) string
+}
@@ -42,6 +42,26 @@ func NewAES(config config.Config, json foundation.Json) *AES {
 
 // EncryptString encrypts the given string, and returns the iv and ciphertext as base64 encoded strings.
 func (b *AES) EncryptString(value string) (string, error) {
+	return b.encrypt(value, nil)
+}
+
+// EncryptStringWithData encrypts the given string like EncryptString, the additional data is authenticated by GCM,
+// so the payload can only be decrypted with the same data.
+func (b *AES) EncryptStringWithData(value, data string) (string, error) {
+	return b.encrypt(value, []byte(data))
+}
+
+// DecryptString decrypts the given iv and ciphertext, and returns the plaintext.
+func (b *AES) DecryptString(payload string) (string, error) {
+	return b.decrypt(payload, nil)
+}
+
+// DecryptStringWithData decrypts the payload encrypted by EncryptStringWithData with the same additional data.
+func (b *AES) DecryptStringWithData(payload, data string) (string, error) {
+	return b.decrypt(payload, []byte(data))
+}
+
+func (b *AES) encrypt(value string, data []byte) (string, error) {
 	block, err := aes.NewCipher(b.key)
 	if err != nil {
 		return "", err
@@ -59,7 +79,7 @@ func (b *AES) EncryptString(value string) (string, error) {
 		return "", err
 	}
 
-	ciphertext := aesgcm.Seal(nil, iv, plaintext, nil)
+	ciphertext := aesgcm.Seal(nil, iv, plaintext, data)
 
 	var jsonEncoded []byte
 	jsonEncoded, err = b.json.Marshal(map[string][]byte{
@@ -74,8 +94,7 @@ func (b *AES) EncryptString(value string) (string, error) {
 	return base64.StdEncoding.EncodeToString(jsonEncoded), nil
 }
 
-// DecryptString decrypts the given iv and ciphertext, and returns the plaintext.
-func (b *AES) DecryptString(payload string) (string, error) {
+func (b *AES) decrypt(payload string, data []byte) (string, error) {
 	decodePayload, err := base64.StdEncoding.DecodeString(payload)
 	if err != nil {
 		return "", err
@@ -108,7 +127,7 @@ func (b *AES) DecryptString(payload string) (string, error) {
 		return "", err
 	}
 
-	plaintext, err := aesgcm.Open(nil, decodeIv, decodeCiphertext, nil)
+	plaintext, err := aesgcm.Open(nil, decodeIv, decodeCiphertext, data)
 	if err != nil {
 		return "", err
 	}
@@ -57,3 +57,19 @@ func (s *AesTestSuite) TestDecryptString() {
 	_, err = s.aes.DecryptString("eyJpdiI6IjEyMzQ1IiwidmFsdWUiOiIxMjM0NSJ9")
 	s.Error(err)
 }
+
+func (s *AesTestSuite) TestDecryptStringWithData() {
+	payload, err := s.aes.EncryptStringWithData("Goravel", "job")
+	s.NoError(err)
+	s.NotEmpty(payload)
+
+	value, err := s.aes.DecryptStringWithData(payload, "job")
+	s.NoError(err)
+	s.Equal("Goravel", value)
+
+	_, err = s.aes.DecryptStringWithData(payload, "other")
+	s.Error(err)
+
+	_, err = s.aes.DecryptString(payload)
+	s.Error(err)
+}
@@ -0,0 +1,469 @@
+package console
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	ormcontract "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+	"github.com/goravel/framework/support/str"
+)
+
+var (
+	// enumValue A quoted value of an enum or a check constraint, the quotes are escaped by doubling them.
+	enumValue = regexp.MustCompile(`'((?:[^']|'')*)'`)
+	// enumIn A check constraint of the values of a column, e.g. status IN ('a', 'b').
+	enumIn = regexp.MustCompile("(?i)^\\(*[`\"\\[]?(\\w+)[`\"\\]]?\\)*\\s+IN\\s*\\(([^)]*)\\)\\)*$")
+	// enumAny A check constraint of PostgreSQL, e.g. (status)::text = ANY ((ARRAY['a'::character varying])::text[]).
+	enumAny = regexp.MustCompile(`(?i)^\(*"?(\w+)"?\)?(?:::[\w ]+)?\s*=\s*ANY\s*\(+ARRAY\[([^\]]*)\]`)
+	// enumEqual A comparison of a check constraint joined by OR, e.g. [status]='a' OR [status]='b'.
+	enumEqual = regexp.MustCompile("(?i)[`\"\\[]?(\\w+)[`\"\\]]?\\s*=\\s*N?'((?:[^']|'')*)'")
+	enumCheck = regexp.MustCompile(`(?i)\bCHECK\s*\(`)
+	enumAnd   = regexp.MustCompile(`(?i)\sAND\s`)
+	enumOr    = regexp.MustCompile(`(?i)\sOR\s`)
+)
+
+type EnumGenerateCommand struct {
+	config config.Config
+}
+
+func NewEnumGenerateCommand(config config.Config) *EnumGenerateCommand {
+	return &EnumGenerateCommand{
+		config: config,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *EnumGenerateCommand) Signature() string {
+	return "enum:generate"
+}
+
+// Description The console command description.
+func (receiver *EnumGenerateCommand) Description() string {
+	return "Generate the Go constants of the enums and the check constraints of the database"
+}
+
+// Extend The console command extend.
+func (receiver *EnumGenerateCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "db",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:    "database",
+				Aliases: []string{"d"},
+				Usage:   "the database connection",
+			},
+			&command.StringSliceFlag{
+				Name:    "table",
+				Aliases: []string{"t"},
+				Usage:   "specify the table(s) of the enums",
+			},
+			&command.StringFlag{
+				Name:  "output",
+				Value: filepath.Join("app", "enums", "enums.go"),
+				Usage: "the file of the generated constants",
+			},
+			&command.BoolFlag{
+				Name:  "check",
+				Usage: "verify the constants of the output file match the database without generating them",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command. The enums are the values of the enum columns and the check constraints
+// limiting a column to a list of values, e.g. CHECK (status IN ('pending', 'shipped')).
+func (receiver *EnumGenerateCommand) Handle(ctx console.Context) error {
+	connection := ctx.Option("database")
+	if connection == "" {
+		connection = receiver.config.GetString("database.default")
+	}
+
+	query, err := gorm.InitializeQuery(context.Background(), receiver.config, connection)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	enums, err := readEnums(query, ctx.OptionSlice("table"))
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	output := ctx.Option("output")
+	if ctx.OptionBool("check") {
+		drifts, err := checkEnums(output, enums)
+		if err != nil {
+			color.Red().Println(err)
+			return nil
+		}
+		if len(drifts) > 0 {
+			for _, drift := range drifts {
+				color.Red().Println(drift)
+			}
+
+			return errors.New("the enums are out of sync with the database")
+		}
+
+		color.Green().Println("The enums are in sync with the database")
+
+		return nil
+	}
+
+	if len(enums) == 0 {
+		color.Yellow().Println("No enums found in the database")
+		return nil
+	}
+
+	content, err := generateEnums(filepath.Base(filepath.Dir(output)), enums)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+	if err := file.Create(output, content); err != nil {
+		return err
+	}
+
+	color.Green().Printf("Enums [%s] generated successfully\n", output)
+
+	return nil
+}
+
+type enum struct {
+	Table  string
+	Column string
+	Values []string
+}
+
+// TypeName Get the name of the Go type of the enum, e.g. OrdersStatus of the status column of the orders table.
+func (r enum) TypeName() string {
+	return identifier(r.Table) + identifier(r.Column)
+}
+
+// readEnums Read the enums of the tables from the database, the enums of all the tables are read if no table is
+// given. The native enums are preferred over the check constraints of the same column.
+func readEnums(query ormcontract.Query, tables []string) ([]enum, error) {
+	type enumRow struct {
+		TableName  string
+		ColumnName string
+		Definition string
+	}
+	var columns, checks []enumRow
+
+	switch query.Driver() {
+	case ormcontract.DriverMysql:
+		if err := query.Raw("SELECT TABLE_NAME AS table_name, COLUMN_NAME AS column_name, COLUMN_TYPE AS definition FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND DATA_TYPE = 'enum' ORDER BY TABLE_NAME, ORDINAL_POSITION").Scan(&columns); err != nil {
+			return nil, err
+		}
+		if err := query.Raw("SELECT tc.TABLE_NAME AS table_name, cc.CHECK_CLAUSE AS definition FROM information_schema.TABLE_CONSTRAINTS tc JOIN information_schema.CHECK_CONSTRAINTS cc ON cc.CONSTRAINT_SCHEMA = tc.CONSTRAINT_SCHEMA AND cc.CONSTRAINT_NAME = tc.CONSTRAINT_NAME WHERE tc.TABLE_SCHEMA = DATABASE() AND tc.CONSTRAINT_TYPE = 'CHECK'").Scan(&checks); err != nil {
+			return nil, err
+		}
+	case ormcontract.DriverPostgres, ormcontract.DriverPostgresql:
+		var labels []enumRow
+		if err := query.Raw("SELECT c.table_name, c.column_name, e.enumlabel AS definition FROM information_schema.columns c JOIN pg_type t ON t.typname = c.udt_name JOIN pg_enum e ON e.enumtypid = t.oid WHERE c.table_schema = current_schema() AND c.data_type = 'USER-DEFINED' ORDER BY c.table_name, c.ordinal_position, e.enumsortorder").Scan(&labels); err != nil {
+			return nil, err
+		}
+		// The labels are quoted, so the enums of all the drivers are parsed the same way.
+		for _, label := range labels {
+			value := "'" + strings.ReplaceAll(label.Definition, "'", "''") + "'"
+			if last := len(columns) - 1; last >= 0 && columns[last].TableName == label.TableName && columns[last].ColumnName == label.ColumnName {
+				columns[last].Definition += "," + value
+			} else {
+				columns = append(columns, enumRow{TableName: label.TableName, ColumnName: label.ColumnName, Definition: value})
+			}
+		}
+		if err := query.Raw("SELECT rel.relname AS table_name, pg_get_constraintdef(con.oid) AS definition FROM pg_constraint con JOIN pg_class rel ON rel.oid = con.conrelid JOIN pg_namespace ns ON ns.oid = rel.relnamespace WHERE con.contype = 'c' AND ns.nspname = current_schema()").Scan(&checks); err != nil {
+			return nil, err
+		}
+	case ormcontract.DriverSqlite:
+		var definitions []enumRow
+		if err := query.Raw("SELECT name AS table_name, sql AS definition FROM sqlite_master WHERE type = 'table' AND sql IS NOT NULL").Scan(&definitions); err != nil {
+			return nil, err
+		}
+		for _, definition := range definitions {
+			for _, clause := range checkClauses(definition.Definition) {
+				checks = append(checks, enumRow{TableName: definition.TableName, Definition: clause})
+			}
+		}
+	case ormcontract.DriverSqlserver:
+		if err := query.Raw("SELECT t.name AS table_name, cc.definition FROM sys.check_constraints cc JOIN sys.tables t ON t.object_id = cc.parent_object_id").Scan(&checks); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("the enums of the driver [%s] aren't supported", query.Driver())
+	}
+
+	included := func(table string) bool {
+		if len(tables) == 0 {
+			return true
+		}
+		for _, t := range tables {
+			if t == table {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	exists := make(map[string]bool)
+	var enums []enum
+	for _, column := range columns {
+		if !included(column.TableName) {
+			continue
+		}
+		enums = append(enums, enum{Table: column.TableName, Column: column.ColumnName, Values: enumValues(column.Definition)})
+		exists[column.TableName+"."+column.ColumnName] = true
+	}
+	for _, check := range checks {
+		if !included(check.TableName) {
+			continue
+		}
+		column, values, ok := parseCheckConstraint(check.Definition)
+		if !ok || exists[check.TableName+"."+column] {
+			continue
+		}
+		enums = append(enums, enum{Table: check.TableName, Column: column, Values: values})
+		exists[check.TableName+"."+column] = true
+	}
+
+	sort.SliceStable(enums, func(i, j int) bool {
+		if enums[i].Table != enums[j].Table {
+			return enums[i].Table < enums[j].Table
+		}
+
+		return enums[i].Column < enums[j].Column
+	})
+
+	return enums, nil
+}
+
+// parseCheckConstraint Parse the column and the values of a check constraint limiting a column to a list of
+// values, the constraints of the drivers are in the forms:
+//
+//	status IN ('pending', 'shipped')
+//	((status)::text = ANY ((ARRAY['pending'::character varying, 'shipped'::character varying])::text[]))
+//	([status]='pending' OR [status]='shipped')
+func parseCheckConstraint(definition string) (column string, values []string, ok bool) {
+	definition = strings.TrimSpace(definition)
+	if strings.HasPrefix(strings.ToUpper(definition), "CHECK") {
+		definition = strings.TrimSpace(definition[len("CHECK"):])
+	}
+
+	if matches := enumIn.FindStringSubmatch(definition); matches != nil {
+		return matches[1], enumValues(matches[2]), true
+	}
+	if matches := enumAny.FindStringSubmatch(definition); matches != nil {
+		return matches[1], enumValues(matches[2]), true
+	}
+
+	// Every comparison joined by OR has to be of the same column.
+	if enumAnd.MatchString(definition) {
+		return "", nil, false
+	}
+	comparisons := enumEqual.FindAllStringSubmatch(definition, -1)
+	if len(comparisons) == 0 || len(comparisons) != len(enumOr.Split(definition, -1)) {
+		return "", nil, false
+	}
+	for _, comparison := range comparisons {
+		if column != "" && column != comparison[1] {
+			return "", nil, false
+		}
+		column = comparison[1]
+		values = append(values, strings.ReplaceAll(comparison[2], "''", "'"))
+	}
+
+	return column, values, true
+}
+
+// generateEnums Generate the Go constants of the enums, a string type is declared for every enum.
+func generateEnums(pkg string, enums []enum) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by enum:generate. DO NOT EDIT.\n\n")
+	buf.WriteString("package " + pkg + "\n")
+
+	for _, e := range enums {
+		typeName := e.TypeName()
+		buf.WriteString(fmt.Sprintf("\n// %s is the enum of the %s column of the %s table.\n", typeName, e.Column, e.Table))
+		buf.WriteString(fmt.Sprintf("type %s string\n\nconst (\n", typeName))
+
+		names := make(map[string]bool)
+		for i, value := range e.Values {
+			name := typeName + identifier(value)
+			if names[name] {
+				name += strconv.Itoa(i)
+			}
+			names[name] = true
+			buf.WriteString(fmt.Sprintf("\t%s %s = %s\n", name, typeName, strconv.Quote(value)))
+		}
+		buf.WriteString(")\n")
+
+		buf.WriteString(fmt.Sprintf("\n// %sValues returns the values of %s.\nfunc %sValues() []%s {\n\treturn []%s{", typeName, typeName, typeName, typeName, typeName))
+		for i, value := range e.Values {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(strconv.Quote(value))
+		}
+		buf.WriteString("}\n}\n")
+	}
+
+	content, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// checkEnums Compare the constants of the output file with the enums of the database, the drifts are returned.
+func checkEnums(output string, enums []enum) ([]string, error) {
+	constants, err := enumConstants(output)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts []string
+	for _, e := range enums {
+		typeName := e.TypeName()
+		values, exist := constants[typeName]
+		if !exist {
+			drifts = append(drifts, fmt.Sprintf("The enum %s of %s.%s isn't generated", typeName, e.Table, e.Column))
+			continue
+		}
+		delete(constants, typeName)
+
+		for _, value := range e.Values {
+			if !values[value] {
+				drifts = append(drifts, fmt.Sprintf("The value %q of %s is missing", value, typeName))
+			}
+			delete(values, value)
+		}
+		for _, value := range sortedKeys(values) {
+			drifts = append(drifts, fmt.Sprintf("The value %q of %s isn't in the database", value, typeName))
+		}
+	}
+	for _, typeName := range sortedKeys(constants) {
+		drifts = append(drifts, fmt.Sprintf("The enum %s isn't in the database", typeName))
+	}
+
+	return drifts, nil
+}
+
+// enumConstants Parse the string constants of the file, keyed by their types.
+func enumConstants(path string) (map[string]map[string]bool, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the enums [%s]: %w", path, err)
+	}
+
+	constants := make(map[string]map[string]bool)
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if ident, ok := spec.Type.(*ast.Ident); ok && ident.Name == "string" && constants[spec.Name.Name] == nil {
+					constants[spec.Name.Name] = make(map[string]bool)
+				}
+			case *ast.ValueSpec:
+				ident, ok := spec.Type.(*ast.Ident)
+				if genDecl.Tok != token.CONST || !ok {
+					continue
+				}
+				for _, value := range spec.Values {
+					lit, ok := value.(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+					unquoted, err := strconv.Unquote(lit.Value)
+					if err != nil {
+						continue
+					}
+					if constants[ident.Name] == nil {
+						constants[ident.Name] = make(map[string]bool)
+					}
+					constants[ident.Name][unquoted] = true
+				}
+			}
+		}
+	}
+
+	return constants, nil
+}
+
+// checkClauses Get the clauses of the check constraints of a CREATE TABLE statement.
+func checkClauses(sql string) []string {
+	var clauses []string
+	for _, loc := range enumCheck.FindAllStringIndex(sql, -1) {
+		depth, quoted := 1, false
+		for i := loc[1]; i < len(sql); i++ {
+			switch {
+			case sql[i] == '\'':
+				quoted = !quoted
+			case quoted:
+			case sql[i] == '(':
+				depth++
+			case sql[i] == ')':
+				depth--
+			}
+			if depth == 0 {
+				clauses = append(clauses, sql[loc[1]:i])
+				break
+			}
+		}
+	}
+
+	return clauses
+}
+
+func enumValues(definition string) []string {
+	var values []string
+	for _, matches := range enumValue.FindAllStringSubmatch(definition, -1) {
+		values = append(values, strings.ReplaceAll(matches[1], "''", "'"))
+	}
+
+	return values
+}
+
+// identifier Convert a name to an exported Go identifier, the characters other than letters and digits are removed.
+func identifier(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+
+		return -1
+	}, str.Of(name).Studly().String())
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,125 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+func TestParseCheckConstraint(t *testing.T) {
+	tests := []struct {
+		definition     string
+		expectColumn   string
+		expectValues   []string
+		expectNotParse bool
+	}{
+		{definition: "status IN ('pending', 'shipped')", expectColumn: "status", expectValues: []string{"pending", "shipped"}},
+		{definition: "(`status` in (_utf8mb4'pending',_utf8mb4'it''s shipped'))", expectColumn: "status", expectValues: []string{"pending", "it's shipped"}},
+		{definition: "CHECK (((status)::text = ANY ((ARRAY['pending'::character varying, 'shipped'::character varying])::text[])))", expectColumn: "status", expectValues: []string{"pending", "shipped"}},
+		{definition: "([status]='shipped' OR [status]='pending')", expectColumn: "status", expectValues: []string{"shipped", "pending"}},
+		{definition: "([status]='shipped' OR [type]='pending')", expectNotParse: true},
+		{definition: "([status]='shipped' AND [type]='pending')", expectNotParse: true},
+		{definition: "price > 0", expectNotParse: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.definition, func(t *testing.T) {
+			column, values, ok := parseCheckConstraint(test.definition)
+			assert.Equal(t, !test.expectNotParse, ok)
+			assert.Equal(t, test.expectColumn, column)
+			assert.Equal(t, test.expectValues, values)
+		})
+	}
+}
+
+func TestGenerateAndCheckEnums(t *testing.T) {
+	enums := []enum{
+		{Table: "orders", Column: "status", Values: []string{"pending", "in-progress", "In Progress"}},
+		{Table: "users", Column: "role", Values: []string{"admin"}},
+	}
+
+	content, err := generateEnums("enums", enums)
+	assert.Nil(t, err)
+	assert.Equal(t, `// Code generated by enum:generate. DO NOT EDIT.
+
+package enums
+
+// OrdersStatus is the enum of the status column of the orders table.
+type OrdersStatus string
+
+const (
+	OrdersStatusPending     OrdersStatus = "pending"
+	OrdersStatusInProgress  OrdersStatus = "in-progress"
+	OrdersStatusInProgress2 OrdersStatus = "In Progress"
+)
+
+// OrdersStatusValues returns the values of OrdersStatus.
+func OrdersStatusValues() []OrdersStatus {
+	return []OrdersStatus{"pending", "in-progress", "In Progress"}
+}
+
+// UsersRole is the enum of the role column of the users table.
+type UsersRole string
+
+const (
+	UsersRoleAdmin UsersRole = "admin"
+)
+
+// UsersRoleValues returns the values of UsersRole.
+func UsersRoleValues() []UsersRole {
+	return []UsersRole{"admin"}
+}
+`, content)
+
+	output := filepath.Join(t.TempDir(), "enums.go")
+	assert.Nil(t, os.WriteFile(output, []byte(content), 0644))
+
+	drifts, err := checkEnums(output, enums)
+	assert.Nil(t, err)
+	assert.Empty(t, drifts)
+
+	drifts, err = checkEnums(output, []enum{
+		{Table: "orders", Column: "status", Values: []string{"pending", "in-progress", "shipped"}},
+		{Table: "posts", Column: "state", Values: []string{"draft"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		`The value "shipped" of OrdersStatus is missing`,
+		`The value "In Progress" of OrdersStatus isn't in the database`,
+		"The enum PostsState of posts.state isn't generated",
+		"The enum UsersRole isn't in the database",
+	}, drifts)
+
+	_, err = checkEnums(filepath.Join(t.TempDir(), "missing.go"), enums)
+	assert.Error(t, err)
+}
+
+func TestReadEnums(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	query, err := gorm.NewSqliteDocker(docker.Sqlite()).New()
+	assert.Nil(t, err)
+	_, err = query.Exec("DROP TABLE IF EXISTS enum_orders")
+	assert.Nil(t, err)
+	_, err = query.Exec("CREATE TABLE enum_orders (id integer PRIMARY KEY AUTOINCREMENT, status varchar(255) NOT NULL CHECK (status IN ('pending', 'shipped')), price real CHECK (price > 0), kind varchar(255) CHECK (kind = 'a' OR kind = 'b'))")
+	assert.Nil(t, err)
+
+	enums, err := readEnums(query, []string{"enum_orders"})
+	assert.Nil(t, err)
+	assert.Equal(t, []enum{
+		{Table: "enum_orders", Column: "kind", Values: []string{"a", "b"}},
+		{Table: "enum_orders", Column: "status", Values: []string{"pending", "shipped"}},
+	}, enums)
+
+	enums, err = readEnums(query, []string{"missing"})
+	assert.Nil(t, err)
+	assert.Empty(t, enums)
+}
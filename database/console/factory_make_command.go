@@ -54,13 +54,13 @@ func (receiver *FactoryMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Factory created successfully")
+	color.Green().Printf("Factory [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *FactoryMakeCommand) getStub() string {
-	return Stubs{}.Factory()
+	return supportconsole.Stub("factory.stub", Stubs{}.Factory())
 }
 
 // populateStub Populate the place-holders in the command stub.
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/mysql"
@@ -17,12 +18,37 @@ import (
 	"github.com/goravel/framework/support"
 )
 
-func getMigrate(config config.Config) (*migrate.Migrate, error) {
-	connection := config.GetString("database.default")
+// getMigrate Get the migrate instance of the connection, the default connection is used if the connection is empty.
+// Each connection can declare its own migration directory and tracking table:
+//
+//	"analytics": map[string]any{
+//	  "migrations": map[string]any{
+//	    "path":  "database/migrations/analytics",
+//	    "table": "migrations",
+//	  },
+//	}
+func getMigrate(config config.Config, connection string) (*migrate.Migrate, error) {
+	if connection == "" {
+		connection = config.GetString("database.default")
+	}
+
+	return newMigrate(config, connection, migrationPath(config, connection))
+}
+
+// migrationPath Get the migration directory of the connection.
+func migrationPath(config config.Config, connection string) string {
+	return config.GetString("database.connections."+connection+".migrations.path", "database/migrations")
+}
+
+// newMigrate Get the migrate instance running the migrations of the path on the connection.
+func newMigrate(config config.Config, connection, path string) (*migrate.Migrate, error) {
 	driver := config.GetString("database.connections." + connection + ".driver")
-	dir := "file://./database/migrations"
-	if support.RelativePath != "" {
-		dir = fmt.Sprintf("file://%s/database/migrations", support.RelativePath)
+	table := config.GetString("database.connections."+connection+".migrations.table", config.GetString("database.migrations"))
+	dir := "file://./" + path
+	if filepath.IsAbs(path) {
+		dir = "file://" + path
+	} else if support.RelativePath != "" {
+		dir = fmt.Sprintf("file://%s/%s", support.RelativePath, path)
 	}
 
 	gormConfig := db.NewConfigImpl(config, connection)
@@ -45,7 +71,7 @@ func getMigrate(config config.Config) (*migrate.Migrate, error) {
 		}
 
 		instance, err := mysql.WithInstance(db, &mysql.Config{
-			MigrationsTable: config.GetString("database.migrations"),
+			MigrationsTable: table,
 		})
 		if err != nil {
 			return nil, err
@@ -65,7 +91,7 @@ func getMigrate(config config.Config) (*migrate.Migrate, error) {
 		}
 
 		instance, err := postgres.WithInstance(db, &postgres.Config{
-			MigrationsTable: config.GetString("database.migrations"),
+			MigrationsTable: table,
 		})
 		if err != nil {
 			return nil, err
@@ -85,7 +111,7 @@ func getMigrate(config config.Config) (*migrate.Migrate, error) {
 		}
 
 		instance, err := sqlite.WithInstance(db, &sqlite.Config{
-			MigrationsTable: config.GetString("database.migrations"),
+			MigrationsTable: table,
 		})
 		if err != nil {
 			return nil, err
@@ -105,7 +131,7 @@ func getMigrate(config config.Config) (*migrate.Migrate, error) {
 		}
 
 		instance, err := sqlserver.WithInstance(db, &sqlserver.Config{
-			MigrationsTable: config.GetString("database.migrations"),
+			MigrationsTable: table,
 		})
 
 		if err != nil {
@@ -2,6 +2,7 @@ package console
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/golang-migrate/migrate/v4"
 
@@ -35,25 +36,41 @@ func (receiver *MigrateCommand) Description() string {
 func (receiver *MigrateCommand) Extend() command.Extend {
 	return command.Extend{
 		Category: "migrate",
+		Flags: []command.Flag{
+			&command.StringSliceFlag{
+				Name:  "database",
+				Usage: "the database connection(s) to use, e.g. --database=default,analytics",
+			},
+		},
 	}
 }
 
 // Handle Execute the console command.
 func (receiver *MigrateCommand) Handle(ctx console.Context) error {
-	m, err := getMigrate(receiver.config)
-	if err != nil {
-		return err
+	connections := ctx.OptionSlice("database")
+	if len(connections) == 0 {
+		connections = []string{""}
 	}
-	if m == nil {
-		color.Yellow().Println("Please fill database config first")
 
-		return nil
-	}
+	// Each connection has its own migration directory and tracking table, so they are migrated independently.
+	for _, connection := range connections {
+		m, err := getMigrate(receiver.config, connection)
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			color.Yellow().Println("Please fill database config first")
+
+			return nil
+		}
 
-	if err = m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		color.Red().Println("Migration failed:", err.Error())
+		if err = ctx.WithSpinner("Migrating...", m.Up); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			if connection != "" {
+				return fmt.Errorf("migration failed on [%s]: %w", connection, err)
+			}
 
-		return nil
+			return fmt.Errorf("migration failed: %w", err)
+		}
 	}
 
 	color.Green().Println("Migration success")
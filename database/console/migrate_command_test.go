@@ -1,9 +1,11 @@
 package console
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	ormcontract "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/gorm"
@@ -91,6 +93,8 @@ func TestMigrateCommand(t *testing.T) {
 
 			migrateCommand := NewMigrateCommand(mockConfig)
 			mockContext := &consolemock.Context{}
+			mockContext.On("OptionSlice", "database").Return(nil).Once()
+			mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
 			assert.Nil(t, migrateCommand.Handle(mockContext))
 
 			var agent Agent
@@ -151,6 +155,70 @@ INSERT INTO agents (name, created_at, updated_at) VALUES ('goravel', '2023-03-11
 `)
 }
 
+func TestMigrateCommandWithDatabase(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	sqlite := docker.Sqlite()
+	query, err := gorm.NewSqliteDocker(sqlite).New()
+	assert.Nil(t, err)
+
+	// Migrations of the connection are placed in its own directory and tracked in its own table.
+	mockConfig := migrateConfig(t, sqlite.Config().Database)
+	createSqliteMigrations()
+	assert.Nil(t, os.Rename("database/migrations", "database/sqlite"))
+	defer removeMigrations()
+
+	mockContext := &consolemock.Context{}
+	mockContext.On("OptionSlice", "database").Return([]string{"sqlite"}).Once()
+	mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
+	assert.Nil(t, NewMigrateCommand(mockConfig).Handle(mockContext))
+
+	var agent Agent
+	assert.Nil(t, query.Where("name", "goravel").First(&agent))
+	assert.True(t, agent.ID > 0)
+
+	var count int64
+	assert.Nil(t, query.Table("analytics_migrations").Count(&count))
+	assert.Equal(t, int64(1), count)
+
+	mockContext.AssertExpectations(t)
+}
+
+func TestMigrateCommandFailed(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	sqlite := docker.Sqlite()
+	mockConfig := migrateConfig(t, sqlite.Config().Database)
+	assert.Nil(t, file.Create("database/sqlite/20230311160527_create_agents_table.up.sql", "CREATE TABLE;"))
+	assert.Nil(t, file.Create("database/sqlite/20230311160527_create_agents_table.down.sql", ""))
+	defer removeMigrations()
+
+	mockContext := &consolemock.Context{}
+	mockContext.On("OptionSlice", "database").Return([]string{"sqlite"}).Once()
+	mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
+	assert.ErrorContains(t, NewMigrateCommand(mockConfig).Handle(mockContext), "migration failed on [sqlite]")
+
+	mockContext.AssertExpectations(t)
+}
+
+// migrateConfig Mock the config of a sqlite connection whose migrations are placed in database/sqlite and tracked in
+// the analytics_migrations table.
+func migrateConfig(t *testing.T, database string) *configmock.Config {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("database.connections.sqlite.migrations.path", "database/migrations").Return("database/sqlite").Once()
+	mockConfig.EXPECT().GetString("database.connections.sqlite.driver").Return("sqlite").Twice()
+	mockConfig.EXPECT().GetString("database.migrations").Return("migrations").Once()
+	mockConfig.EXPECT().GetString("database.connections.sqlite.migrations.table", "migrations").Return("analytics_migrations").Once()
+	mockConfig.EXPECT().Get("database.connections.sqlite.write").Return(nil).Once()
+	mockConfig.EXPECT().GetString("database.connections.sqlite.database").Return(database).Once()
+
+	return mockConfig
+}
+
 func createSqliteMigrations() {
 	_ = file.Create("database/migrations/20230311160527_create_agents_table.up.sql",
 		`CREATE TABLE agents (
@@ -170,3 +238,7 @@ func removeMigrations() {
 	_ = file.Remove("database")
 	_ = file.Remove("goravel")
 }
+
+func runSpinner(_ string, callback func() error) error {
+	return callback()
+}
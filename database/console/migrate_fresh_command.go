@@ -39,6 +39,10 @@ func (receiver *MigrateFreshCommand) Extend() command.Extend {
 	return command.Extend{
 		Category: "migrate",
 		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "database",
+				Usage: "the database connection to use",
+			},
 			&command.BoolFlag{
 				Name:  "seed",
 				Usage: "seed the database after running migrations",
@@ -53,7 +57,8 @@ func (receiver *MigrateFreshCommand) Extend() command.Extend {
 
 // Handle Execute the console command.
 func (receiver *MigrateFreshCommand) Handle(ctx console.Context) error {
-	m, err := getMigrate(receiver.config)
+	connection := ctx.Option("database")
+	m, err := getMigrate(receiver.config, connection)
 	if err != nil {
 		return err
 	}
@@ -67,7 +72,7 @@ func (receiver *MigrateFreshCommand) Handle(ctx console.Context) error {
 		return nil
 	}
 
-	m2, err2 := getMigrate(receiver.config)
+	m2, err2 := getMigrate(receiver.config, connection)
 	if err2 != nil {
 		return err2
 	}
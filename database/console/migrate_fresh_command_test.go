@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	ormcontract "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/gorm"
@@ -85,9 +86,12 @@ func TestMigrateFreshCommand(t *testing.T) {
 			mockContext := &consolemocks.Context{}
 			mockArtisan := &consolemocks.Artisan{}
 			migrateCommand := NewMigrateCommand(mockConfig)
+			mockContext.On("OptionSlice", "database").Return(nil).Once()
+			mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
 			assert.Nil(t, migrateCommand.Handle(mockContext))
 			mockContext.On("OptionBool", "seed").Return(false).Once()
 			migrateFreshCommand := NewMigrateFreshCommand(mockConfig, mockArtisan)
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateFreshCommand.Handle(mockContext))
 
 			var agent Agent
@@ -102,6 +106,7 @@ func TestMigrateFreshCommand(t *testing.T) {
 			mockContext.On("OptionSlice", "seeder").Return([]string{"MockSeeder"}).Once()
 			mockArtisan.On("Call", "db:seed --seeder MockSeeder").Return(nil).Once()
 			migrateFreshCommand = NewMigrateFreshCommand(mockConfig, mockArtisan)
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateFreshCommand.Handle(mockContext))
 
 			var agent1 Agent
@@ -116,6 +121,7 @@ func TestMigrateFreshCommand(t *testing.T) {
 			mockContext.On("OptionSlice", "seeder").Return([]string{}).Once()
 			mockArtisan.On("Call", "db:seed").Return(nil).Once()
 			migrateFreshCommand = NewMigrateFreshCommand(mockConfig, mockArtisan)
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateFreshCommand.Handle(mockContext))
 
 			var agent2 Agent
@@ -40,6 +40,10 @@ func (receiver *MigrateRefreshCommand) Extend() command.Extend {
 	return command.Extend{
 		Category: "migrate",
 		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "database",
+				Usage: "the database connection to use",
+			},
 			&command.StringFlag{
 				Name:  "step",
 				Value: "",
@@ -59,7 +63,7 @@ func (receiver *MigrateRefreshCommand) Extend() command.Extend {
 
 // Handle Execute the console command.
 func (receiver *MigrateRefreshCommand) Handle(ctx console.Context) error {
-	m, err := getMigrate(receiver.config)
+	m, err := getMigrate(receiver.config, ctx.Option("database"))
 	if err != nil {
 		return err
 	}
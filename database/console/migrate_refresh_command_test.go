@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	ormcontract "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/gorm"
@@ -87,11 +88,14 @@ func TestMigrateRefreshCommand(t *testing.T) {
 			mockContext.On("Option", "step").Return("").Once()
 
 			migrateCommand := NewMigrateCommand(mockConfig)
+			mockContext.On("OptionSlice", "database").Return(nil).Once()
+			mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
 			assert.Nil(t, migrateCommand.Handle(mockContext))
 
 			// Test MigrateRefreshCommand without --seed flag
 			mockContext.On("OptionBool", "seed").Return(false).Once()
 			migrateRefreshCommand := NewMigrateRefreshCommand(mockConfig, mockArtisan)
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateRefreshCommand.Handle(mockContext))
 
 			var agent Agent
@@ -104,6 +108,8 @@ func TestMigrateRefreshCommand(t *testing.T) {
 			mockContext.On("Option", "step").Return("5").Once()
 
 			migrateCommand = NewMigrateCommand(mockConfig)
+			mockContext.On("OptionSlice", "database").Return(nil).Once()
+			mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
 			assert.Nil(t, migrateCommand.Handle(mockContext))
 
 			// Test MigrateRefreshCommand with --seed flag and --seeder specified
@@ -111,6 +117,7 @@ func TestMigrateRefreshCommand(t *testing.T) {
 			mockContext.On("OptionSlice", "seeder").Return([]string{"UserSeeder"}).Once()
 			mockArtisan.On("Call", "db:seed --seeder UserSeeder").Return(nil).Once()
 			migrateRefreshCommand = NewMigrateRefreshCommand(mockConfig, mockArtisan)
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateRefreshCommand.Handle(mockContext))
 
 			mockArtisan = &consolemocks.Artisan{}
@@ -122,6 +129,7 @@ func TestMigrateRefreshCommand(t *testing.T) {
 			mockContext.On("OptionSlice", "seeder").Return([]string{}).Once()
 			mockArtisan.On("Call", "db:seed").Return(nil).Once()
 			migrateRefreshCommand = NewMigrateRefreshCommand(mockConfig, mockArtisan)
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateRefreshCommand.Handle(mockContext))
 
 			var agent1 Agent
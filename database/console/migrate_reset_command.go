@@ -35,12 +35,18 @@ func (receiver *MigrateResetCommand) Description() string {
 func (receiver *MigrateResetCommand) Extend() command.Extend {
 	return command.Extend{
 		Category: "migrate",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "database",
+				Usage: "the database connection to use",
+			},
+		},
 	}
 }
 
 // Handle Execute the console command.
 func (receiver *MigrateResetCommand) Handle(ctx console.Context) error {
-	m, err := getMigrate(receiver.config)
+	m, err := getMigrate(receiver.config, ctx.Option("database"))
 	if err != nil {
 		return err
 	}
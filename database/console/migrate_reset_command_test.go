@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	ormcontract "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/gorm"
@@ -86,9 +87,12 @@ func TestMigrateResetCommand(t *testing.T) {
 			mockContext := &consolemocks.Context{}
 
 			migrateCommand := NewMigrateCommand(mockConfig)
+			mockContext.On("OptionSlice", "database").Return(nil).Once()
+			mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
 			assert.Nil(t, migrateCommand.Handle(mockContext))
 
 			migrateResetCommand := NewMigrateResetCommand(mockConfig)
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateResetCommand.Handle(mockContext))
 
 			var agent Agent
@@ -39,6 +39,10 @@ func (receiver *MigrateRollbackCommand) Extend() command.Extend {
 	return command.Extend{
 		Category: "migrate",
 		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "database",
+				Usage: "the database connection to use",
+			},
 			&command.StringFlag{
 				Name:  "step",
 				Value: "1",
@@ -50,7 +54,7 @@ func (receiver *MigrateRollbackCommand) Extend() command.Extend {
 
 // Handle Execute the console command.
 func (receiver *MigrateRollbackCommand) Handle(ctx console.Context) error {
-	m, err := getMigrate(receiver.config)
+	m, err := getMigrate(receiver.config, ctx.Option("database"))
 	if err != nil {
 		return err
 	}
@@ -68,7 +72,9 @@ func (receiver *MigrateRollbackCommand) Handle(ctx console.Context) error {
 		return nil
 	}
 
-	if err = m.Steps(step); err != nil && !errors.Is(err, migrate.ErrNoChange) && !errors.Is(err, migrate.ErrNilVersion) {
+	if err = ctx.WithSpinner("Rolling back...", func() error {
+		return m.Steps(step)
+	}); err != nil && !errors.Is(err, migrate.ErrNoChange) && !errors.Is(err, migrate.ErrNilVersion) {
 		var errShortLimit migrate.ErrShortLimit
 		switch {
 		case errors.As(err, &errShortLimit):
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	ormcontract "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/gorm"
@@ -87,6 +88,8 @@ func TestMigrateRollbackCommand(t *testing.T) {
 			mockContext.On("Option", "step").Return("1").Once()
 
 			migrateCommand := NewMigrateCommand(mockConfig)
+			mockContext.On("OptionSlice", "database").Return(nil).Once()
+			mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
 			assert.Nil(t, migrateCommand.Handle(mockContext))
 
 			var agent Agent
@@ -95,6 +98,8 @@ func TestMigrateRollbackCommand(t *testing.T) {
 			assert.True(t, agent.ID > 0)
 
 			migrateRollbackCommand := NewMigrateRollbackCommand(mockConfig)
+			mockContext.On("Option", "database").Return("").Once()
+			mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
 			assert.Nil(t, migrateRollbackCommand.Handle(mockContext))
 
 			var agent1 Agent
@@ -1,6 +1,8 @@
 package console
 
 import (
+	"strconv"
+
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 
@@ -34,12 +36,19 @@ func (receiver *MigrateStatusCommand) Description() string {
 func (receiver *MigrateStatusCommand) Extend() command.Extend {
 	return command.Extend{
 		Category: "migrate",
+		Json:     true,
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "database",
+				Usage: "the database connection to use",
+			},
+		},
 	}
 }
 
 // Handle Execute the console command.
 func (receiver *MigrateStatusCommand) Handle(ctx console.Context) error {
-	m, err := getMigrate(receiver.config)
+	m, err := getMigrate(receiver.config, ctx.Option("database"))
 	if err != nil {
 		return err
 	}
@@ -55,15 +64,14 @@ func (receiver *MigrateStatusCommand) Handle(ctx console.Context) error {
 		return nil
 	}
 
+	status := "clean"
 	if dirty {
-		color.Yellow().Println("Migration status: dirty")
-		color.Green().Println("Migration version:", version)
-
-		return nil
+		status = "dirty"
 	}
 
-	color.Green().Println("Migration status: clean")
-	color.Green().Println("Migration version:", version)
+	if err := ctx.TwoColumnDetail("Migration status", status); err != nil {
+		return err
+	}
 
-	return nil
+	return ctx.TwoColumnDetail("Migration version", strconv.FormatUint(uint64(version), 10))
 }
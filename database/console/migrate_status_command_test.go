@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	ormcontract "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/gorm"
@@ -86,17 +87,26 @@ func TestMigrateStatusCommand(t *testing.T) {
 			mockContext := &consolemocks.Context{}
 
 			migrateCommand := NewMigrateCommand(mockConfig)
+			mockContext.On("OptionSlice", "database").Return(nil).Once()
+			mockContext.On("WithSpinner", mock.Anything, mock.Anything).Return(runSpinner).Once()
 			assert.Nil(t, migrateCommand.Handle(mockContext))
 
+			mockContext.On("TwoColumnDetail", "Migration status", "clean").Return(nil).Once()
+			mockContext.On("TwoColumnDetail", "Migration version", "20230311160527").Return(nil).Once()
 			migrateStatusCommand := NewMigrateStatusCommand(mockConfig)
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateStatusCommand.Handle(mockContext))
 
 			res, err := query.Table("migrations").Where("dirty", false).Update("dirty", true)
 			assert.Nil(t, err)
 			assert.Equal(t, int64(1), res.RowsAffected)
 
+			mockContext.On("TwoColumnDetail", "Migration status", "dirty").Return(nil).Once()
+			mockContext.On("TwoColumnDetail", "Migration version", "20230311160527").Return(nil).Once()
+			mockContext.On("Option", "database").Return("").Once()
 			assert.Nil(t, migrateStatusCommand.Handle(mockContext))
 
+			mockContext.AssertExpectations(t)
 			removeMigrations()
 		})
 	}
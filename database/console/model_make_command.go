@@ -1,9 +1,12 @@
 package console
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 
+	"gorm.io/gorm/schema"
+
 	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/console/command"
 	"github.com/goravel/framework/support/color"
@@ -12,10 +15,13 @@ import (
 )
 
 type ModelMakeCommand struct {
+	artisan console.Artisan
 }
 
-func NewModelMakeCommand() *ModelMakeCommand {
-	return &ModelMakeCommand{}
+func NewModelMakeCommand(artisan console.Artisan) *ModelMakeCommand {
+	return &ModelMakeCommand{
+		artisan: artisan,
+	}
 }
 
 // Signature The name and signature of the console command.
@@ -38,6 +44,30 @@ func (receiver *ModelMakeCommand) Extend() command.Extend {
 				Aliases: []string{"f"},
 				Usage:   "Create the model even if it already exists",
 			},
+			&command.BoolFlag{
+				Name:    "all",
+				Aliases: []string{"a"},
+				Usage:   "Generate a migration, factory, seeder, and resource controller for the model",
+			},
+			&command.BoolFlag{
+				Name:    "controller",
+				Aliases: []string{"c"},
+				Usage:   "Create a new resource controller for the model",
+			},
+			&command.BoolFlag{
+				Name:  "factory",
+				Usage: "Create a new factory for the model",
+			},
+			&command.BoolFlag{
+				Name:    "migration",
+				Aliases: []string{"m"},
+				Usage:   "Create a new migration file for the model",
+			},
+			&command.BoolFlag{
+				Name:    "seeder",
+				Aliases: []string{"s"},
+				Usage:   "Create a new seeder for the model",
+			},
 		},
 	}
 }
@@ -54,13 +84,34 @@ func (receiver *ModelMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Model created successfully")
+	color.Green().Printf("Model [%s] created successfully\n", m.GetRelativePath())
+
+	receiver.makeRelated(ctx, m.GetStructName())
 
 	return nil
 }
 
+// makeRelated Create the migration, factory, seeder and controller of the model if they are requested.
+func (receiver *ModelMakeCommand) makeRelated(ctx console.Context, structName string) {
+	all := ctx.OptionBool("all")
+
+	if all || ctx.OptionBool("migration") {
+		table := schema.NamingStrategy{}.TableName(structName)
+		receiver.artisan.Call(fmt.Sprintf("make:migration create_%s_table", table))
+	}
+	if all || ctx.OptionBool("factory") {
+		receiver.artisan.Call(fmt.Sprintf("make:factory %sFactory", structName))
+	}
+	if all || ctx.OptionBool("seeder") {
+		receiver.artisan.Call(fmt.Sprintf("make:seeder %sSeeder", structName))
+	}
+	if all || ctx.OptionBool("controller") {
+		receiver.artisan.Call(fmt.Sprintf("make:controller --resource %sController", structName))
+	}
+}
+
 func (receiver *ModelMakeCommand) getStub() string {
-	return Stubs{}.Model()
+	return supportconsole.Stub("model.stub", Stubs{}.Model())
 }
 
 // populateStub Populate the place-holders in the command stub.
@@ -14,7 +14,8 @@ import (
 )
 
 func TestModelMakeCommand(t *testing.T) {
-	modelMakeCommand := &ModelMakeCommand{}
+	mockArtisan := &consolemocks.Artisan{}
+	modelMakeCommand := NewModelMakeCommand(mockArtisan)
 	mockContext := &consolemocks.Context{}
 	mockContext.On("Argument", 0).Return("").Once()
 	mockContext.On("Ask", "Enter the model name", mock.Anything).Return("", errors.New("the model name cannot be empty")).Once()
@@ -25,6 +26,7 @@ func TestModelMakeCommand(t *testing.T) {
 
 	mockContext.On("Argument", 0).Return("User").Once()
 	mockContext.On("OptionBool", "force").Return(false).Once()
+	mockRelatedOptions(mockContext, false, false, false, false, false)
 	assert.Nil(t, modelMakeCommand.Handle(mockContext))
 	assert.True(t, file.Exists("app/models/user.go"))
 
@@ -36,12 +38,34 @@ func TestModelMakeCommand(t *testing.T) {
 
 	mockContext.On("Argument", 0).Return("User/Phone").Once()
 	mockContext.On("OptionBool", "force").Return(false).Once()
+	mockRelatedOptions(mockContext, false, true, false, true, false)
+	mockArtisan.On("Call", "make:migration create_phones_table").Once()
+	mockArtisan.On("Call", "make:seeder PhoneSeeder").Once()
 	assert.Nil(t, modelMakeCommand.Handle(mockContext))
 	assert.True(t, file.Exists("app/models/User/phone.go"))
 	assert.True(t, file.Contain("app/models/User/phone.go", "package User"))
 	assert.True(t, file.Contain("app/models/User/phone.go", "type Phone struct"))
 
+	mockContext.On("Argument", 0).Return("OrderItem").Once()
+	mockContext.On("OptionBool", "force").Return(false).Once()
+	mockContext.On("OptionBool", "all").Return(true).Once()
+	mockArtisan.On("Call", "make:migration create_order_items_table").Once()
+	mockArtisan.On("Call", "make:factory OrderItemFactory").Once()
+	mockArtisan.On("Call", "make:seeder OrderItemSeeder").Once()
+	mockArtisan.On("Call", "make:controller --resource OrderItemController").Once()
+	assert.Nil(t, modelMakeCommand.Handle(mockContext))
+	assert.True(t, file.Exists("app/models/order_item.go"))
+
 	assert.Nil(t, file.Remove("app"))
 
 	mockContext.AssertExpectations(t)
+	mockArtisan.AssertExpectations(t)
+}
+
+func mockRelatedOptions(mockContext *consolemocks.Context, all, migration, factory, seeder, controller bool) {
+	mockContext.On("OptionBool", "all").Return(all).Once()
+	mockContext.On("OptionBool", "migration").Return(migration).Once()
+	mockContext.On("OptionBool", "factory").Return(factory).Once()
+	mockContext.On("OptionBool", "seeder").Return(seeder).Once()
+	mockContext.On("OptionBool", "controller").Return(controller).Once()
 }
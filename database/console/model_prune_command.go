@@ -0,0 +1,261 @@
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	ormcontract "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/database/orm"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/str"
+)
+
+type ModelPruneCommand struct {
+	config  config.Config
+	storage filesystem.Storage
+}
+
+func NewModelPruneCommand(config config.Config, storage filesystem.Storage) *ModelPruneCommand {
+	return &ModelPruneCommand{
+		config:  config,
+		storage: storage,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ModelPruneCommand) Signature() string {
+	return "model:prune"
+}
+
+// Description The console command description.
+func (receiver *ModelPruneCommand) Description() string {
+	return "Archive or delete the old records of the models by their retention policies"
+}
+
+// Extend The console command extend.
+func (receiver *ModelPruneCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "model",
+		Flags: []command.Flag{
+			&command.StringSliceFlag{
+				Name:    "model",
+				Aliases: []string{"m"},
+				Usage:   "specify the model(s) to prune",
+			},
+			&command.IntFlag{
+				Name:  "chunk",
+				Value: 1000,
+				Usage: "the number of the records pruned at a time",
+			},
+			&command.IntFlag{
+				Name:  "sleep",
+				Usage: "the milliseconds to sleep between the chunks",
+			},
+			&command.BoolFlag{
+				Name:  "pretend",
+				Usage: "display the number of the records that would be pruned without pruning them",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ModelPruneCommand) Handle(ctx console.Context) error {
+	models, err := receiver.GetModels(ctx.OptionSlice("model"))
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+	if len(models) == 0 {
+		color.Red().Println("no models with a retention policy found")
+		return nil
+	}
+
+	options := pruneOptions{
+		chunk:   ctx.OptionInt("chunk"),
+		sleep:   time.Duration(ctx.OptionInt("sleep")) * time.Millisecond,
+		pretend: ctx.OptionBool("pretend"),
+	}
+	if options.chunk <= 0 {
+		options.chunk = 1000
+	}
+
+	for _, model := range models {
+		name := modelName(model)
+		query, err := receiver.query(model)
+		if err != nil {
+			color.Red().Printf("error pruning model %s: %v\n", name, err)
+			return nil
+		}
+
+		archived, deleted, err := receiver.Prune(query, model, options)
+		if err != nil {
+			color.Red().Printf("error pruning model %s: %v\n", name, err)
+			return nil
+		}
+
+		if options.pretend {
+			color.Yellow().Printf("%s: %d records would be archived, %d records would be deleted\n", name, archived, deleted)
+		} else {
+			color.Green().Printf("%s: %d records archived, %d records deleted\n", name, archived, deleted)
+		}
+	}
+
+	return nil
+}
+
+// GetModels returns the registered models matching the given names, or all of them if no name is given.
+func (receiver *ModelPruneCommand) GetModels(names []string) ([]ormcontract.Retention, error) {
+	if len(names) == 0 {
+		return orm.Retentions, nil
+	}
+
+	var models []ormcontract.Retention
+	for _, name := range names {
+		var found ormcontract.Retention
+		for _, model := range orm.Retentions {
+			if modelName(model) == name {
+				found = model
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("no model of %s found", name)
+		}
+		models = append(models, found)
+	}
+
+	return models, nil
+}
+
+type pruneOptions struct {
+	chunk   int
+	sleep   time.Duration
+	pretend bool
+}
+
+// Prune Delete the records of a model older than its delete window, then archive the records older than its
+// archive window. The counts are the records that would be pruned if pretending.
+func (receiver *ModelPruneCommand) Prune(query ormcontract.Query, model ormcontract.Retention, options pruneOptions) (archived, deleted int64, err error) {
+	policy := model.RetentionPolicy()
+	column := policy.Column
+	if column == "" {
+		column = "created_at"
+	}
+	now := carbon.Now().StdTime()
+
+	// The records in the delete window aren't archived, it's only needed when pretending since they're deleted first.
+	var deleteBefore time.Time
+	if policy.DeleteAfter > 0 {
+		deleteBefore = now.Add(-policy.DeleteAfter)
+		if deleted, err = receiver.prune(query, model, column, time.Time{}, deleteBefore, options, nil); err != nil {
+			return archived, deleted, err
+		}
+	}
+
+	if policy.ArchiveAfter > 0 {
+		var disk filesystem.Driver
+		if !options.pretend {
+			if receiver.storage == nil {
+				return archived, deleted, errors.New("filesystem support is required to archive the records")
+			}
+
+			disk = receiver.storage
+			if policy.ArchiveDisk != "" {
+				disk = receiver.storage.Disk(policy.ArchiveDisk)
+			}
+		}
+
+		part := 0
+		archived, err = receiver.prune(query, model, column, deleteBefore, now.Add(-policy.ArchiveAfter), options, func(records reflect.Value) error {
+			part++
+			lines := make([]string, 0, records.Len())
+			for i := 0; i < records.Len(); i++ {
+				line, err := json.Marshal(records.Index(i).Interface())
+				if err != nil {
+					return err
+				}
+				lines = append(lines, string(line))
+			}
+
+			return disk.Put(fmt.Sprintf("retention/%s/%s-%d.json", str.Of(modelName(model)).Snake().String(), now.Format("20060102150405"), part), strings.Join(lines, "\n")+"\n")
+		})
+	}
+
+	return archived, deleted, err
+}
+
+// prune Remove the records created in [after, before) in chunks, after is ignored if it's zero. The records of a
+// chunk are archived before being removed.
+func (receiver *ModelPruneCommand) prune(query ormcontract.Query, model ormcontract.Retention, column string, after, before time.Time, options pruneOptions, archive func(records reflect.Value) error) (int64, error) {
+	newQuery := func() ormcontract.Query {
+		newQuery := query.Model(model).WithTrashed().Where(column+" < ?", before)
+		if !after.IsZero() {
+			newQuery = newQuery.Where(column+" >= ?", after)
+		}
+
+		return newQuery
+	}
+
+	if options.pretend {
+		var count int64
+		err := newQuery().Count(&count)
+
+		return count, err
+	}
+
+	var total int64
+	for {
+		records := reflect.New(reflect.SliceOf(reflect.TypeOf(model)))
+		if err := newQuery().Order(column).Limit(options.chunk).Find(records.Interface()); err != nil {
+			return total, err
+		}
+		if records.Elem().Len() == 0 {
+			return total, nil
+		}
+
+		if archive != nil {
+			if err := archive(records.Elem()); err != nil {
+				return total, err
+			}
+		}
+
+		result, err := query.ForceDelete(records.Interface())
+		if err != nil {
+			return total, err
+		}
+		total += result.RowsAffected
+
+		if records.Elem().Len() < options.chunk {
+			return total, nil
+		}
+		if options.sleep > 0 {
+			time.Sleep(options.sleep)
+		}
+	}
+}
+
+func (receiver *ModelPruneCommand) query(model ormcontract.Retention) (ormcontract.Query, error) {
+	connection := receiver.config.GetString("database.default")
+	if connectionModel, ok := model.(ormcontract.ConnectionModel); ok && connectionModel.Connection() != "" {
+		connection = connectionModel.Connection()
+	}
+
+	return gorm.InitializeQuery(context.Background(), receiver.config, connection)
+}
+
+// modelName Get the name of the struct of a model, e.g. User.
+func modelName(model any) string {
+	return reflect.Indirect(reflect.ValueOf(model)).Type().Name()
+}
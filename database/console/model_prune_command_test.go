@@ -0,0 +1,129 @@
+package console
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	ormcontract "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/database/orm"
+	configmocks "github.com/goravel/framework/mocks/config"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	filesystemmocks "github.com/goravel/framework/mocks/filesystem"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+type PruneLog struct {
+	orm.Model
+	orm.SoftDeletes
+	Message string
+	policy  ormcontract.RetentionPolicy
+}
+
+func (r *PruneLog) RetentionPolicy() ormcontract.RetentionPolicy {
+	return r.policy
+}
+
+func TestModelPruneCommandGetModels(t *testing.T) {
+	originRetentions := orm.Retentions
+	defer func() {
+		orm.Retentions = originRetentions
+	}()
+
+	log := &PruneLog{}
+	orm.Retentions = []ormcontract.Retention{log}
+
+	modelPruneCommand := NewModelPruneCommand(&configmocks.Config{}, nil)
+
+	models, err := modelPruneCommand.GetModels(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []ormcontract.Retention{log}, models)
+
+	models, err = modelPruneCommand.GetModels([]string{"PruneLog"})
+	assert.Nil(t, err)
+	assert.Equal(t, []ormcontract.Retention{log}, models)
+
+	models, err = modelPruneCommand.GetModels([]string{"missing"})
+	assert.EqualError(t, err, "no model of missing found")
+	assert.Nil(t, models)
+}
+
+func TestModelPruneCommandHandleWithoutModels(t *testing.T) {
+	originRetentions := orm.Retentions
+	defer func() {
+		orm.Retentions = originRetentions
+	}()
+	orm.Retentions = nil
+
+	mockContext := consolemocks.NewContext(t)
+	mockContext.EXPECT().OptionSlice("model").Return([]string{}).Once()
+
+	assert.Nil(t, NewModelPruneCommand(&configmocks.Config{}, nil).Handle(mockContext))
+}
+
+func TestModelPruneCommandPrune(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	now := carbon.FromDateTime(2024, 8, 17, 12, 0, 0)
+	carbon.SetTestNow(now)
+	defer carbon.UnsetTestNow()
+
+	query, err := gorm.NewSqliteDocker(docker.Sqlite()).New()
+	assert.Nil(t, err)
+	_, err = query.Exec("DROP TABLE IF EXISTS prune_logs")
+	assert.Nil(t, err)
+	_, err = query.Exec("CREATE TABLE prune_logs (id integer PRIMARY KEY AUTOINCREMENT, message varchar(255) NOT NULL, created_at datetime, updated_at datetime, deleted_at datetime)")
+	assert.Nil(t, err)
+
+	for i, days := range []int{100, 95, 40, 35, 31, 1} {
+		log := &PruneLog{Message: strings.Repeat("a", i+1)}
+		log.CreatedAt = carbon.NewDateTime(now.SubDays(days))
+		assert.Nil(t, query.Create(log))
+	}
+	_, err = query.Where("message", "aaa").Delete(&PruneLog{})
+	assert.Nil(t, err)
+
+	model := &PruneLog{policy: ormcontract.RetentionPolicy{
+		ArchiveAfter: 30 * 24 * time.Hour,
+		ArchiveDisk:  "cold",
+		DeleteAfter:  90 * 24 * time.Hour,
+	}}
+	mockStorage := filesystemmocks.NewStorage(t)
+	mockDriver := filesystemmocks.NewDriver(t)
+	command := NewModelPruneCommand(&configmocks.Config{}, mockStorage)
+
+	// The soft deleted records are pruned as well.
+	archived, deleted, err := command.Prune(query, model, pruneOptions{chunk: 2, pretend: true})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), archived)
+	assert.Equal(t, int64(2), deleted)
+
+	mockStorage.EXPECT().Disk("cold").Return(mockDriver).Once()
+	mockDriver.EXPECT().Put("retention/prune_log/20240817120000-1.json", mock.MatchedBy(func(content string) bool {
+		return strings.Count(content, "\n") == 2 && strings.Contains(content, `"Message":"aaa"`) && strings.Contains(content, `"Message":"aaaa"`)
+	})).Return(nil).Once()
+	mockDriver.EXPECT().Put("retention/prune_log/20240817120000-2.json", mock.MatchedBy(func(content string) bool {
+		return strings.Count(content, "\n") == 1 && strings.Contains(content, `"Message":"aaaaa"`)
+	})).Return(nil).Once()
+
+	archived, deleted, err = command.Prune(query, model, pruneOptions{chunk: 2, sleep: time.Millisecond})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), archived)
+	assert.Equal(t, int64(2), deleted)
+
+	var logs []PruneLog
+	assert.Nil(t, query.WithTrashed().Find(&logs))
+	assert.Len(t, logs, 1)
+	assert.Equal(t, "aaaaaa", logs[0].Message)
+
+	_, _, err = NewModelPruneCommand(&configmocks.Config{}, nil).Prune(query, model, pruneOptions{chunk: 2})
+	assert.EqualError(t, err, "filesystem support is required to archive the records")
+}
@@ -54,13 +54,13 @@ func (receiver *ObserverMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Observer created successfully")
+	color.Green().Printf("Observer [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *ObserverMakeCommand) getStub() string {
-	return Stubs{}.Observer()
+	return supportconsole.Stub("observer.stub", Stubs{}.Observer())
 }
 
 // populateStub Populate the place-holders in the command stub.
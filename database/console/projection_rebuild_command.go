@@ -0,0 +1,122 @@
+package console
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	ormcontract "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/database/orm"
+	"github.com/goravel/framework/support/color"
+)
+
+type ProjectionRebuildCommand struct {
+	config config.Config
+}
+
+func NewProjectionRebuildCommand(config config.Config) *ProjectionRebuildCommand {
+	return &ProjectionRebuildCommand{
+		config: config,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ProjectionRebuildCommand) Signature() string {
+	return "projection:rebuild"
+}
+
+// Description The console command description.
+func (receiver *ProjectionRebuildCommand) Description() string {
+	return "Rebuild the read models of the registered projections"
+}
+
+// Extend The console command extend.
+func (receiver *ProjectionRebuildCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "projection",
+		Flags: []command.Flag{
+			&command.StringSliceFlag{
+				Name:    "projection",
+				Aliases: []string{"p"},
+				Usage:   "specify the projection(s) to rebuild",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ProjectionRebuildCommand) Handle(ctx console.Context) error {
+	projections, err := receiver.GetProjections(ctx.OptionSlice("projection"))
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+	if len(projections) == 0 {
+		color.Red().Println("no projections found")
+		return nil
+	}
+
+	for _, projection := range projections {
+		if err := receiver.rebuild(projection); err != nil {
+			color.Red().Printf("error rebuilding projection %s: %v\n", projection.Signature(), err)
+			return nil
+		}
+
+		color.Green().Printf("Rebuilt: %s\n", projection.Signature())
+	}
+
+	return nil
+}
+
+// GetProjections returns the registered projections matching the given names, or all of them if no name is given.
+func (receiver *ProjectionRebuildCommand) GetProjections(names []string) ([]ormcontract.Projection, error) {
+	if len(names) == 0 {
+		return orm.Projections, nil
+	}
+
+	var projections []ormcontract.Projection
+	for _, name := range names {
+		var found ormcontract.Projection
+		for _, projection := range orm.Projections {
+			if projection.Signature() == name {
+				found = projection
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("no projection of %s found", name)
+		}
+		projections = append(projections, found)
+	}
+
+	return projections, nil
+}
+
+func (receiver *ProjectionRebuildCommand) rebuild(projection ormcontract.Projection) error {
+	connection := receiver.config.GetString("database.default")
+	if connectionModel, ok := projection.(ormcontract.ConnectionModel); ok && connectionModel.Connection() != "" {
+		connection = connectionModel.Connection()
+	}
+
+	query, err := gorm.InitializeQuery(context.Background(), receiver.config, connection)
+	if err != nil {
+		return err
+	}
+
+	tx, err := query.Begin()
+	if err != nil {
+		return err
+	}
+	if err := projection.Rebuild(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%v, rollback error: %v", err, rollbackErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
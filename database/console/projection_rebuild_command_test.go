@@ -0,0 +1,72 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ormcontract "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/orm"
+	configmocks "github.com/goravel/framework/mocks/config"
+	consolemocks "github.com/goravel/framework/mocks/console"
+)
+
+func TestProjectionRebuildCommandGetProjections(t *testing.T) {
+	originProjections := orm.Projections
+	defer func() {
+		orm.Projections = originProjections
+	}()
+
+	userStats := &MockProjection{signature: "user_stats"}
+	orderStats := &MockProjection{signature: "order_stats"}
+	orm.Projections = []ormcontract.Projection{userStats, orderStats}
+
+	projectionRebuildCommand := NewProjectionRebuildCommand(&configmocks.Config{})
+
+	projections, err := projectionRebuildCommand.GetProjections(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []ormcontract.Projection{userStats, orderStats}, projections)
+
+	projections, err = projectionRebuildCommand.GetProjections([]string{"order_stats"})
+	assert.Nil(t, err)
+	assert.Equal(t, []ormcontract.Projection{orderStats}, projections)
+
+	projections, err = projectionRebuildCommand.GetProjections([]string{"missing"})
+	assert.EqualError(t, err, "no projection of missing found")
+	assert.Nil(t, projections)
+}
+
+func TestProjectionRebuildCommandHandleWithoutProjections(t *testing.T) {
+	originProjections := orm.Projections
+	defer func() {
+		orm.Projections = originProjections
+	}()
+	orm.Projections = nil
+
+	mockContext := &consolemocks.Context{}
+	mockContext.On("OptionSlice", "projection").Return([]string{}).Once()
+
+	assert.Nil(t, NewProjectionRebuildCommand(&configmocks.Config{}).Handle(mockContext))
+
+	mockContext.AssertExpectations(t)
+}
+
+type MockProjection struct {
+	signature string
+}
+
+func (m *MockProjection) Signature() string {
+	return m.signature
+}
+
+func (m *MockProjection) Models() []any {
+	return nil
+}
+
+func (m *MockProjection) Project(ormcontract.EventType, ormcontract.Event) error {
+	return nil
+}
+
+func (m *MockProjection) Rebuild(ormcontract.Query) error {
+	return nil
+}
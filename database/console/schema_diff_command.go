@@ -0,0 +1,343 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	ormcontract "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/db"
+	"github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/support/color"
+)
+
+type SchemaDiffCommand struct {
+	config config.Config
+}
+
+func NewSchemaDiffCommand(config config.Config) *SchemaDiffCommand {
+	return &SchemaDiffCommand{
+		config: config,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *SchemaDiffCommand) Signature() string {
+	return "schema:diff"
+}
+
+// Description The console command description.
+func (receiver *SchemaDiffCommand) Description() string {
+	return "Compare the schema of the database with the migrations or another connection"
+}
+
+// Extend The console command extend.
+func (receiver *SchemaDiffCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "db",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:    "database",
+				Aliases: []string{"d"},
+				Usage:   "the database connection to check",
+			},
+			&command.StringFlag{
+				Name:  "against",
+				Usage: "the database connection whose schema is expected",
+			},
+			&command.StringFlag{
+				Name:  "scratch",
+				Usage: "the database connection the migrations are run on to get the expected schema, it's wiped before and after the comparison",
+			},
+			&command.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "force the scratch connection to be wiped when in production",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command, the command fails if the schemas differ, so it can guard the deployments.
+func (receiver *SchemaDiffCommand) Handle(ctx console.Context) error {
+	connection := ctx.Option("database")
+	if connection == "" {
+		connection = receiver.config.GetString("database.default")
+	}
+	against := ctx.Option("against")
+	scratch := ctx.Option("scratch")
+	if (against == "") == (scratch == "") {
+		color.Red().Println("Please specify either the --against connection or the --scratch connection running the migrations")
+		return nil
+	}
+	if scratch != "" {
+		if scratch == connection || receiver.sameDatabase(scratch, connection) {
+			color.Red().Println("The scratch connection can't be the checked connection, since it's wiped")
+			return nil
+		}
+		if !ctx.OptionBool("force") && receiver.config.Env("APP_ENV") == "production" {
+			color.Red().Println("application in production use --force to run this command")
+			return nil
+		}
+	}
+
+	actual, driver, err := receiver.readSchema(connection)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	var expected *databaseSchema
+	var expectedDriver ormcontract.Driver
+	if against != "" {
+		expected, expectedDriver, err = receiver.readSchema(against)
+	} else {
+		expected, expectedDriver, err = receiver.migrateSchema(connection, scratch)
+	}
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	// The column types are named differently by the drivers, so they are only compared on the same driver.
+	drifts := diffSchemas(actual, expected, driver == expectedDriver)
+	if len(drifts) > 0 {
+		for _, drift := range drifts {
+			color.Red().Println(drift)
+		}
+
+		return errors.New("the schema of the database is out of sync")
+	}
+
+	color.Green().Println("The schema of the database is in sync")
+
+	return nil
+}
+
+func (receiver *SchemaDiffCommand) readSchema(connection string) (*databaseSchema, ormcontract.Driver, error) {
+	query, err := gorm.InitializeQuery(context.Background(), receiver.config, connection)
+	if err != nil {
+		return nil, "", err
+	}
+
+	result, err := readSchema(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return result, query.Driver(), nil
+}
+
+// sameDatabase Determine whether the connections point to the same database, they are compared by the host, the port
+// and the database of their resolved write config, since the connections can be named differently.
+func (receiver *SchemaDiffCommand) sameDatabase(first, second string) bool {
+	target := func(connection string) string {
+		writes := db.NewConfigImpl(receiver.config, connection).Writes()
+		if len(writes) == 0 {
+			return ""
+		}
+
+		return fmt.Sprintf("%s:%d/%s", writes[0].Host, writes[0].Port, writes[0].Database)
+	}
+
+	firstTarget := target(first)
+
+	return firstTarget != ":0/" && firstTarget == target(second)
+}
+
+// migrateSchema Run the migrations of the connection on the scratch connection and read the schema of it.
+func (receiver *SchemaDiffCommand) migrateSchema(connection, scratch string) (*databaseSchema, ormcontract.Driver, error) {
+	path := migrationPath(receiver.config, connection)
+	m, err := newMigrate(receiver.config, scratch, path)
+	if err != nil {
+		return nil, "", err
+	}
+	if m == nil {
+		return nil, "", fmt.Errorf("please fill the config of the [%s] connection first", scratch)
+	}
+	err = m.Drop()
+	_, _ = m.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The migrations table is dropped as well, so the migrations are run by a new instance creating it again.
+	if m, err = newMigrate(receiver.config, scratch, path); err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = m.Drop()
+		_, _ = m.Close()
+	}()
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, "", err
+	}
+
+	return receiver.readSchema(scratch)
+}
+
+type schemaColumn struct {
+	Type     string
+	Nullable bool
+}
+
+type schemaIndex struct {
+	Columns []string
+	Unique  bool
+}
+
+type databaseSchema struct {
+	Columns map[string]map[string]schemaColumn
+	Indexes map[string]map[string]schemaIndex
+}
+
+// readSchema Read the tables, columns and indexes of the database, the columns of a table are keyed by the table name.
+func readSchema(query ormcontract.Query) (*databaseSchema, error) {
+	type columnRow struct {
+		TableName  string
+		ColumnName string
+		ColumnType string
+		Nullable   bool
+	}
+	type indexRow struct {
+		TableName  string
+		IndexName  string
+		ColumnName string
+		Unique     bool
+	}
+	var columns []columnRow
+	var indexes []indexRow
+
+	switch query.Driver() {
+	case ormcontract.DriverMysql:
+		if err := query.Raw("SELECT TABLE_NAME AS table_name, COLUMN_NAME AS column_name, COLUMN_TYPE AS column_type, IS_NULLABLE = 'YES' AS nullable FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() ORDER BY TABLE_NAME, ORDINAL_POSITION").Scan(&columns); err != nil {
+			return nil, err
+		}
+		if err := query.Raw("SELECT TABLE_NAME AS table_name, INDEX_NAME AS index_name, COLUMN_NAME AS column_name, NON_UNIQUE = 0 AS `unique` FROM information_schema.STATISTICS WHERE TABLE_SCHEMA = DATABASE() ORDER BY TABLE_NAME, INDEX_NAME, SEQ_IN_INDEX").Scan(&indexes); err != nil {
+			return nil, err
+		}
+	case ormcontract.DriverPostgres, ormcontract.DriverPostgresql:
+		if err := query.Raw("SELECT table_name, column_name, data_type AS column_type, is_nullable = 'YES' AS nullable FROM information_schema.columns WHERE table_schema = current_schema() ORDER BY table_name, ordinal_position").Scan(&columns); err != nil {
+			return nil, err
+		}
+		if err := query.Raw("SELECT t.relname AS table_name, i.relname AS index_name, a.attname AS column_name, ix.indisunique AS unique FROM pg_index ix JOIN pg_class t ON t.oid = ix.indrelid JOIN pg_class i ON i.oid = ix.indexrelid JOIN pg_namespace n ON n.oid = t.relnamespace JOIN LATERAL unnest(ix.indkey) WITH ORDINALITY AS k(attnum, ord) ON true JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum WHERE n.nspname = current_schema() ORDER BY t.relname, i.relname, k.ord").Scan(&indexes); err != nil {
+			return nil, err
+		}
+	case ormcontract.DriverSqlite:
+		if err := query.Raw("SELECT m.name AS table_name, p.name AS column_name, p.type AS column_type, p.\"notnull\" = 0 AND p.pk = 0 AS nullable FROM sqlite_master m JOIN pragma_table_info(m.name) p WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%' ORDER BY m.name, p.cid").Scan(&columns); err != nil {
+			return nil, err
+		}
+		if err := query.Raw("SELECT m.name AS table_name, il.name AS index_name, ii.name AS column_name, il.\"unique\" AS \"unique\" FROM sqlite_master m JOIN pragma_index_list(m.name) il JOIN pragma_index_info(il.name) ii WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%' ORDER BY m.name, il.name, ii.seqno").Scan(&indexes); err != nil {
+			return nil, err
+		}
+	case ormcontract.DriverSqlserver:
+		if err := query.Raw("SELECT c.TABLE_NAME AS table_name, c.COLUMN_NAME AS column_name, c.DATA_TYPE AS column_type, CASE WHEN c.IS_NULLABLE = 'YES' THEN 1 ELSE 0 END AS nullable FROM INFORMATION_SCHEMA.COLUMNS c JOIN INFORMATION_SCHEMA.TABLES t ON t.TABLE_SCHEMA = c.TABLE_SCHEMA AND t.TABLE_NAME = c.TABLE_NAME WHERE t.TABLE_TYPE = 'BASE TABLE' AND c.TABLE_SCHEMA = SCHEMA_NAME() ORDER BY c.TABLE_NAME, c.ORDINAL_POSITION").Scan(&columns); err != nil {
+			return nil, err
+		}
+		if err := query.Raw("SELECT t.name AS table_name, i.name AS index_name, c.name AS column_name, i.is_unique AS [unique] FROM sys.indexes i JOIN sys.tables t ON t.object_id = i.object_id JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id JOIN sys.columns c ON c.object_id = ic.object_id AND c.column_id = ic.column_id WHERE i.name IS NOT NULL AND t.schema_id = SCHEMA_ID() ORDER BY t.name, i.name, ic.key_ordinal").Scan(&indexes); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("the schema of the driver [%s] isn't supported", query.Driver())
+	}
+
+	result := &databaseSchema{
+		Columns: make(map[string]map[string]schemaColumn),
+		Indexes: make(map[string]map[string]schemaIndex),
+	}
+	for _, column := range columns {
+		if result.Columns[column.TableName] == nil {
+			result.Columns[column.TableName] = make(map[string]schemaColumn)
+		}
+		result.Columns[column.TableName][column.ColumnName] = schemaColumn{
+			Type:     strings.ToLower(column.ColumnType),
+			Nullable: column.Nullable,
+		}
+	}
+	for _, index := range indexes {
+		if result.Indexes[index.TableName] == nil {
+			result.Indexes[index.TableName] = make(map[string]schemaIndex)
+		}
+		current := result.Indexes[index.TableName][index.IndexName]
+		current.Columns = append(current.Columns, index.ColumnName)
+		current.Unique = index.Unique
+		result.Indexes[index.TableName][index.IndexName] = current
+	}
+
+	return result, nil
+}
+
+// diffSchemas Get the differences of the actual schema from the expected one, the missing items are expected but not
+// in the database, and the extra items are in the database but not expected.
+func diffSchemas(actual, expected *databaseSchema, compareTypes bool) []string {
+	var drifts []string
+	for _, table := range sortedKeys(expected.Columns) {
+		if _, exist := actual.Columns[table]; !exist {
+			drifts = append(drifts, fmt.Sprintf("The table %s is missing", table))
+		}
+	}
+	for _, table := range sortedKeys(actual.Columns) {
+		expectedColumns, exist := expected.Columns[table]
+		if !exist {
+			drifts = append(drifts, fmt.Sprintf("The table %s is extra", table))
+			continue
+		}
+
+		actualColumns := actual.Columns[table]
+		for _, name := range sortedKeys(expectedColumns) {
+			if _, exist := actualColumns[name]; !exist {
+				drifts = append(drifts, fmt.Sprintf("The column %s.%s is missing", table, name))
+			}
+		}
+		for _, name := range sortedKeys(actualColumns) {
+			expectedColumn, exist := expectedColumns[name]
+			if !exist {
+				drifts = append(drifts, fmt.Sprintf("The column %s.%s is extra", table, name))
+				continue
+			}
+
+			actualColumn := actualColumns[name]
+			if compareTypes && actualColumn.Type != expectedColumn.Type {
+				drifts = append(drifts, fmt.Sprintf("The type of the column %s.%s is %s, expected %s", table, name, actualColumn.Type, expectedColumn.Type))
+			}
+			if actualColumn.Nullable != expectedColumn.Nullable {
+				drifts = append(drifts, fmt.Sprintf("The nullability of the column %s.%s is %t, expected %t", table, name, actualColumn.Nullable, expectedColumn.Nullable))
+			}
+		}
+
+		actualIndexes, expectedIndexes := actual.Indexes[table], expected.Indexes[table]
+		for _, name := range sortedKeys(expectedIndexes) {
+			if _, exist := actualIndexes[name]; !exist {
+				drifts = append(drifts, fmt.Sprintf("The index %s of the table %s is missing", name, table))
+			}
+		}
+		for _, name := range sortedKeys(actualIndexes) {
+			expectedIndex, exist := expectedIndexes[name]
+			if !exist {
+				drifts = append(drifts, fmt.Sprintf("The index %s of the table %s is extra", name, table))
+				continue
+			}
+
+			actualIndex := actualIndexes[name]
+			if strings.Join(actualIndex.Columns, ",") != strings.Join(expectedIndex.Columns, ",") || actualIndex.Unique != expectedIndex.Unique {
+				drifts = append(drifts, fmt.Sprintf("The index %s of the table %s is %s, expected %s", name, table, actualIndex, expectedIndex))
+			}
+		}
+	}
+
+	return drifts
+}
+
+func (r schemaIndex) String() string {
+	if r.Unique {
+		return "unique (" + strings.Join(r.Columns, ", ") + ")"
+	}
+
+	return "(" + strings.Join(r.Columns, ", ") + ")"
+}
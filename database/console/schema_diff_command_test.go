@@ -0,0 +1,144 @@
+package console
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/database/gorm"
+	configmocks "github.com/goravel/framework/mocks/config"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+func TestSchemaDiffCommand(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockContext := consolemocks.NewContext(t)
+	schemaDiffCommand := NewSchemaDiffCommand(mockConfig)
+
+	mockContext.EXPECT().Option("database").Return("").Once()
+	mockConfig.EXPECT().GetString("database.default").Return("mysql").Once()
+	mockContext.EXPECT().Option("against").Return("").Once()
+	mockContext.EXPECT().Option("scratch").Return("").Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, schemaDiffCommand.Handle(mockContext))
+	}), "Please specify either the --against connection or the --scratch connection running the migrations")
+
+	mockContext.EXPECT().Option("database").Return("mysql").Once()
+	mockContext.EXPECT().Option("against").Return("").Once()
+	mockContext.EXPECT().Option("scratch").Return("mysql").Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, schemaDiffCommand.Handle(mockContext))
+	}), "The scratch connection can't be the checked connection, since it's wiped")
+
+	// The connections named differently can point to the same database.
+	mockContext.EXPECT().Option("database").Return("mysql").Once()
+	mockContext.EXPECT().Option("against").Return("").Once()
+	mockContext.EXPECT().Option("scratch").Return("scratch").Once()
+	mockConnection(mockConfig, "scratch", "localhost", 3306, "goravel")
+	mockConnection(mockConfig, "mysql", "localhost", 3306, "goravel")
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, schemaDiffCommand.Handle(mockContext))
+	}), "The scratch connection can't be the checked connection, since it's wiped")
+
+	// The scratch connection is only wiped in production with --force.
+	mockContext.EXPECT().Option("database").Return("mysql").Once()
+	mockContext.EXPECT().Option("against").Return("").Once()
+	mockContext.EXPECT().Option("scratch").Return("scratch").Once()
+	mockConnection(mockConfig, "scratch", "localhost", 3306, "scratch")
+	mockConnection(mockConfig, "mysql", "localhost", 3306, "goravel")
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	mockConfig.EXPECT().Env("APP_ENV").Return("production").Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, schemaDiffCommand.Handle(mockContext))
+	}), "application in production use --force to run this command")
+}
+
+func mockConnection(mockConfig *configmocks.Config, connection, host string, port int, database string) {
+	prefix := "database.connections." + connection
+	mockConfig.EXPECT().Get(prefix + ".write").Return(nil).Once()
+	mockConfig.EXPECT().GetString(prefix + ".driver").Return("mysql").Once()
+	mockConfig.EXPECT().GetString(prefix + ".host").Return(host).Once()
+	mockConfig.EXPECT().GetInt(prefix + ".port").Return(port).Once()
+	mockConfig.EXPECT().GetString(prefix + ".username").Return("root").Once()
+	mockConfig.EXPECT().GetString(prefix + ".password").Return("").Once()
+	mockConfig.EXPECT().GetString(prefix + ".database").Return(database).Once()
+}
+
+func TestDiffSchemas(t *testing.T) {
+	expected := &databaseSchema{
+		Columns: map[string]map[string]schemaColumn{
+			"users": {
+				"id":    {Type: "bigint"},
+				"email": {Type: "varchar(255)"},
+				"name":  {Type: "varchar(255)", Nullable: true},
+			},
+			"posts": {"id": {Type: "bigint"}},
+		},
+		Indexes: map[string]map[string]schemaIndex{
+			"users": {
+				"users_email_unique": {Columns: []string{"email"}, Unique: true},
+				"users_name_index":   {Columns: []string{"name"}},
+			},
+		},
+	}
+	actual := &databaseSchema{
+		Columns: map[string]map[string]schemaColumn{
+			"users": {
+				"id":    {Type: "bigint"},
+				"email": {Type: "text", Nullable: true},
+				"age":   {Type: "int"},
+			},
+			"jobs": {"id": {Type: "bigint"}},
+		},
+		Indexes: map[string]map[string]schemaIndex{
+			"users": {
+				"users_email_unique": {Columns: []string{"email"}},
+				"users_age_index":    {Columns: []string{"age"}},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"The table posts is missing",
+		"The table jobs is extra",
+		"The column users.name is missing",
+		"The column users.age is extra",
+		"The type of the column users.email is text, expected varchar(255)",
+		"The nullability of the column users.email is true, expected false",
+		"The index users_name_index of the table users is missing",
+		"The index users_age_index of the table users is extra",
+		"The index users_email_unique of the table users is (email), expected unique (email)",
+	}, diffSchemas(actual, expected, true))
+	assert.NotContains(t, diffSchemas(actual, expected, false), "The type of the column users.email is text, expected varchar(255)")
+	assert.Empty(t, diffSchemas(expected, expected, true))
+}
+
+func TestReadSchema(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	query, err := gorm.NewSqliteDocker(docker.Sqlite()).New()
+	assert.Nil(t, err)
+	_, err = query.Exec("DROP TABLE IF EXISTS schema_users")
+	assert.Nil(t, err)
+	_, err = query.Exec("CREATE TABLE schema_users (id integer PRIMARY KEY AUTOINCREMENT, email varchar(255) NOT NULL, name varchar(255))")
+	assert.Nil(t, err)
+	_, err = query.Exec("CREATE UNIQUE INDEX schema_users_email_name_unique ON schema_users (email, name)")
+	assert.Nil(t, err)
+
+	result, err := readSchema(query)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]schemaColumn{
+		"id":    {Type: "integer"},
+		"email": {Type: "varchar(255)"},
+		"name":  {Type: "varchar(255)", Nullable: true},
+	}, result.Columns["schema_users"])
+	assert.Equal(t, map[string]schemaIndex{
+		"schema_users_email_name_unique": {Columns: []string{"email", "name"}, Unique: true},
+	}, result.Indexes["schema_users"])
+}
@@ -71,7 +71,9 @@ func (receiver *SeedCommand) Handle(ctx console.Context) error {
 		return nil
 	}
 
-	if err := receiver.seeder.Call(seeders); err != nil {
+	if err := ctx.WithSpinner("Seeding database...", func() error {
+		return receiver.seeder.Call(seeders)
+	}); err != nil {
 		color.Red().Printf("error running seeder: %v\n", err)
 	}
 	color.Green().Println("Database seeding completed successfully.")
@@ -3,6 +3,7 @@ package console
 import (
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/goravel/framework/contracts/database/seeder"
@@ -36,6 +37,7 @@ func (s *SeedCommandTestSuite) TestHandle() {
 	s.mockContext.On("OptionSlice", "seeder").Return([]string{"mock", "mock2"}).Once()
 	s.mockFacade.On("GetSeeder", "mock").Return(&MockSeeder{}).Once()
 	s.mockFacade.On("GetSeeder", "mock2").Return(&MockSeeder2{}).Once()
+	s.mockContext.On("WithSpinner", "Seeding database...", mock.Anything).Return(runSpinner).Once()
 	s.mockFacade.On("Call", []seeder.Seeder{&MockSeeder{}, &MockSeeder2{}}).Return(nil).Once()
 	s.NoError(s.seedCommand.Handle(s.mockContext))
 
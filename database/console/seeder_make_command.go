@@ -54,13 +54,13 @@ func (receiver *SeederMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Seeder created successfully")
+	color.Green().Printf("Seeder [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *SeederMakeCommand) getStub() string {
-	return Stubs{}.Seeder()
+	return supportconsole.Stub("seeder.stub", Stubs{}.Seeder())
 }
 
 // populateStub Populate the place-holders in the command stub.
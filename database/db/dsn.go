@@ -2,6 +2,7 @@ package db
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/goravel/framework/contracts/config"
 	databasecontract "github.com/goravel/framework/contracts/database"
@@ -53,6 +54,11 @@ func (d *DsnImpl) Postgresql(config databasecontract.Config) string {
 }
 
 func (d *DsnImpl) Sqlite(config databasecontract.Config) string {
+	// The database can be a URI with parameters, e.g. file:goravel?mode=memory&cache=shared for an in-memory database.
+	if strings.Contains(config.Database, "?") {
+		return fmt.Sprintf("%s&multi_stmts=true", config.Database)
+	}
+
 	return fmt.Sprintf("%s?multi_stmts=true", config.Database)
 }
 
@@ -67,6 +67,7 @@ func (s *DsnTestSuite) TestPostgresql() {
 func (s *DsnTestSuite) TestSqlite() {
 	dsn := NewDsnImpl(s.mockConfig, "")
 	s.Equal(fmt.Sprintf("%s?multi_stmts=true", testDatabase), dsn.Sqlite(testConfig))
+	s.Equal("file:goravel?mode=memory&cache=shared&multi_stmts=true", dsn.Sqlite(databasecontract.Config{Database: "file:goravel?mode=memory&cache=shared"}))
 }
 
 func (s *DsnTestSuite) TestSqlserver() {
@@ -6,8 +6,10 @@ import (
 
 type Conditions struct {
 	distinct      []any
+	forceIndexes  []string
 	group         string
 	having        *Having
+	hints         []string
 	join          []Join
 	limit         *int
 	lockForUpdate bool
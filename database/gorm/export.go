@@ -0,0 +1,370 @@
+package gorm
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/spf13/cast"
+
+	filesystemcontract "github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/filesystem"
+)
+
+// exportChunk The number of rows written to the export file before the progress is reported.
+const exportChunk = 1000
+
+const (
+	ExportCsv     = "csv"
+	ExportNdjson  = "ndjson"
+	ExportParquet = "parquet"
+)
+
+// ExportTo Stream the rows of the query to a file of a storage disk, the rows are written to a temporary file in
+// chunks, so the rows aren't held in memory, and the file is put on the disk once all the rows are written.
+func (r *QueryImpl) ExportTo(disk, path, format string, progress ...func(exported int64)) error {
+	if filesystem.StorageFacade == nil {
+		return errors.New("filesystem support is required to export the records")
+	}
+
+	temp, err := os.CreateTemp("", "goravel_export_*"+filepath.Ext(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	if err := r.export(temp, format, progress...); err != nil {
+		_ = temp.Close()
+
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+
+	file, err := filesystem.NewFile(temp.Name())
+	if err != nil {
+		return err
+	}
+
+	var storage filesystemcontract.Driver = filesystem.StorageFacade
+	if disk != "" {
+		storage = filesystem.StorageFacade.Disk(disk)
+	}
+	if r.ctx != nil {
+		storage = storage.WithContext(r.ctx)
+	}
+	_, err = storage.PutFileAs(filepath.Dir(path), file, filepath.Base(path))
+
+	return err
+}
+
+func (r *QueryImpl) export(writer io.Writer, format string, progress ...func(exported int64)) error {
+	query := r.buildConditions()
+	rows, err := query.instance.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columns, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+
+	exporter, err := newExporter(writer, format, columns)
+	if err != nil {
+		return err
+	}
+
+	report := func(exported int64) {
+		for _, callback := range progress {
+			callback(exported)
+		}
+	}
+
+	var exported int64
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return err
+		}
+		if err := exporter.Write(values); err != nil {
+			return err
+		}
+
+		exported++
+		if exported%exportChunk == 0 {
+			if err := exporter.Flush(); err != nil {
+				return err
+			}
+			report(exported)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if err := exporter.Close(); err != nil {
+		return err
+	}
+	if exported%exportChunk != 0 || exported == 0 {
+		report(exported)
+	}
+
+	return nil
+}
+
+// exporter Write the rows of an export in a format, the values are the columns of a row.
+type exporter interface {
+	Write(values []any) error
+	Flush() error
+	Close() error
+}
+
+func newExporter(writer io.Writer, format string, columns []*sql.ColumnType) (exporter, error) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name()
+	}
+
+	switch format {
+	case ExportCsv:
+		return newCsvExporter(writer, names)
+	case ExportNdjson:
+		return &ndjsonExporter{encoder: json.NewEncoder(writer), names: names}, nil
+	case ExportParquet:
+		return newParquetExporter(writer, columns), nil
+	default:
+		return nil, fmt.Errorf("export format [%s] isn't supported, it should be csv, ndjson or parquet", format)
+	}
+}
+
+type csvExporter struct {
+	writer *csv.Writer
+	record []string
+}
+
+func newCsvExporter(writer io.Writer, names []string) (*csvExporter, error) {
+	exporter := &csvExporter{writer: csv.NewWriter(writer), record: make([]string, len(names))}
+	if err := exporter.writer.Write(names); err != nil {
+		return nil, err
+	}
+
+	return exporter, nil
+}
+
+func (r *csvExporter) Write(values []any) error {
+	for i, value := range values {
+		r.record[i] = exportString(value)
+	}
+
+	return r.writer.Write(r.record)
+}
+
+func (r *csvExporter) Flush() error {
+	r.writer.Flush()
+
+	return r.writer.Error()
+}
+
+func (r *csvExporter) Close() error {
+	return r.Flush()
+}
+
+type ndjsonExporter struct {
+	encoder *json.Encoder
+	names   []string
+}
+
+func (r *ndjsonExporter) Write(values []any) error {
+	row := make(map[string]any, len(values))
+	for i, value := range values {
+		if bytes, ok := value.([]byte); ok {
+			value = string(bytes)
+		}
+		row[r.names[i]] = value
+	}
+
+	return r.encoder.Encode(row)
+}
+
+func (r *ndjsonExporter) Flush() error {
+	return nil
+}
+
+func (r *ndjsonExporter) Close() error {
+	return nil
+}
+
+// parquetExporter Write the rows to a parquet file, the columns are optional, and their types are inferred from the
+// database types: integers, floats, booleans and timestamps keep their types, the others are written as strings.
+type parquetExporter struct {
+	kinds   []reflect.Kind
+	indexes []int
+	rows    []parquet.Row
+	writer  *parquet.Writer
+}
+
+func newParquetExporter(writer io.Writer, columns []*sql.ColumnType) *parquetExporter {
+	group := parquet.Group{}
+	kinds := make([]reflect.Kind, len(columns))
+	for i, column := range columns {
+		kinds[i] = exportKind(column)
+		switch kinds[i] {
+		case reflect.Int64:
+			group[column.Name()] = parquet.Optional(parquet.Int(64))
+		case reflect.Float64:
+			group[column.Name()] = parquet.Optional(parquet.Leaf(parquet.DoubleType))
+		case reflect.Bool:
+			group[column.Name()] = parquet.Optional(parquet.Leaf(parquet.BooleanType))
+		case reflect.Struct:
+			group[column.Name()] = parquet.Optional(parquet.Timestamp(parquet.Millisecond))
+		default:
+			group[column.Name()] = parquet.Optional(parquet.String())
+		}
+	}
+
+	// The columns of a parquet group are sorted by their names.
+	schema := parquet.NewSchema("export", group)
+	indexes := make([]int, len(columns))
+	for index, path := range schema.Columns() {
+		for i, column := range columns {
+			if column.Name() == path[0] {
+				indexes[i] = index
+			}
+		}
+	}
+
+	return &parquetExporter{
+		kinds:   kinds,
+		indexes: indexes,
+		writer:  parquet.NewWriter(writer, schema),
+	}
+}
+
+func (r *parquetExporter) Write(values []any) error {
+	row := make(parquet.Row, len(values))
+	for i, value := range values {
+		row[r.indexes[i]] = r.value(r.kinds[i], value).Level(0, 1, r.indexes[i])
+		if value == nil {
+			row[r.indexes[i]] = parquet.NullValue().Level(0, 0, r.indexes[i])
+		}
+	}
+	r.rows = append(r.rows, row)
+
+	return nil
+}
+
+func (r *parquetExporter) value(kind reflect.Kind, value any) parquet.Value {
+	switch kind {
+	case reflect.Int64:
+		return parquet.Int64Value(cast.ToInt64(exportString(value)))
+	case reflect.Float64:
+		return parquet.DoubleValue(cast.ToFloat64(exportString(value)))
+	case reflect.Bool:
+		return parquet.BooleanValue(cast.ToBool(exportString(value)))
+	case reflect.Struct:
+		if t, ok := value.(time.Time); ok {
+			return parquet.Int64Value(t.UnixMilli())
+		}
+
+		return parquet.Int64Value(cast.ToTime(exportString(value)).UnixMilli())
+	default:
+		return parquet.ByteArrayValue([]byte(exportString(value)))
+	}
+}
+
+// Flush Write the buffered rows as a row group.
+func (r *parquetExporter) Flush() error {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	if _, err := r.writer.WriteRows(r.rows); err != nil {
+		return err
+	}
+	r.rows = r.rows[:0]
+
+	return r.writer.Flush()
+}
+
+func (r *parquetExporter) Close() error {
+	if err := r.Flush(); err != nil {
+		return err
+	}
+
+	return r.writer.Close()
+}
+
+// exportKind Get the kind of the values of a column, a timestamp column is a struct kind. The kind is inferred from
+// the database type if the driver doesn't report the scan type, e.g. sqlite.
+func exportKind(column *sql.ColumnType) reflect.Kind {
+	scanType := column.ScanType()
+	if scanType == nil || scanType.Kind() == reflect.Interface {
+		typeName := strings.ToUpper(column.DatabaseTypeName())
+		switch {
+		case strings.Contains(typeName, "INT"):
+			return reflect.Int64
+		case strings.Contains(typeName, "REAL") || strings.Contains(typeName, "FLOAT") || strings.Contains(typeName, "DOUBLE"):
+			return reflect.Float64
+		case strings.Contains(typeName, "BOOL"):
+			return reflect.Bool
+		case strings.Contains(typeName, "DATE") || strings.Contains(typeName, "TIME"):
+			return reflect.Struct
+		default:
+			return reflect.String
+		}
+	}
+	for scanType.Kind() == reflect.Pointer {
+		scanType = scanType.Elem()
+	}
+
+	switch {
+	case scanType == reflect.TypeOf(time.Time{}) || scanType == reflect.TypeOf(sql.NullTime{}):
+		return reflect.Struct
+	case scanType == reflect.TypeOf(sql.NullInt64{}) || scanType == reflect.TypeOf(sql.NullInt32{}) || scanType == reflect.TypeOf(sql.NullInt16{}):
+		return reflect.Int64
+	case scanType == reflect.TypeOf(sql.NullFloat64{}):
+		return reflect.Float64
+	case scanType == reflect.TypeOf(sql.NullBool{}):
+		return reflect.Bool
+	}
+
+	switch scanType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.Int64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	case reflect.Bool:
+		return reflect.Bool
+	default:
+		return reflect.String
+	}
+}
+
+func exportString(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(value)
+	case time.Time:
+		return value.Format(time.RFC3339)
+	default:
+		return cast.ToString(value)
+	}
+}
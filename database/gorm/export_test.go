@@ -0,0 +1,88 @@
+package gorm
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/filesystem"
+	configmocks "github.com/goravel/framework/mocks/config"
+	filesystemmocks "github.com/goravel/framework/mocks/filesystem"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+func TestExportTo(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	query, err := NewSqliteDocker(docker.Sqlite()).New()
+	assert.Nil(t, err)
+	_, err = query.Exec("DROP TABLE IF EXISTS export_logs")
+	assert.Nil(t, err)
+	_, err = query.Exec("CREATE TABLE export_logs (id integer PRIMARY KEY AUTOINCREMENT, message varchar(255), score real, active boolean)")
+	assert.Nil(t, err)
+	_, err = query.Exec("INSERT INTO export_logs (message, score, active) VALUES ('hello, world', 1.5, true), (NULL, 2, false), ('goravel', NULL, true)")
+	assert.Nil(t, err)
+
+	assert.EqualError(t, query.Table("export_logs").ExportTo("", "exports/logs.csv", ExportCsv), "filesystem support is required to export the records")
+
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetString("filesystems.default").Return("local")
+	mockStorage := filesystemmocks.NewStorage(t)
+	mockDriver := filesystemmocks.NewDriver(t)
+	filesystem.ConfigFacade = mockConfig
+	filesystem.StorageFacade = mockStorage
+	t.Cleanup(func() {
+		filesystem.ConfigFacade = nil
+		filesystem.StorageFacade = nil
+	})
+
+	var content string
+	put := func(path string, source contractsfilesystem.File, name string) (string, error) {
+		data, err := os.ReadFile(source.File())
+		assert.Nil(t, err)
+		content = string(data)
+
+		return path + "/" + name, nil
+	}
+
+	var progress []int64
+	mockStorage.EXPECT().Disk("backups").Return(mockDriver).Once()
+	mockDriver.EXPECT().PutFileAs("exports", mock.Anything, "logs.csv").RunAndReturn(put).Once()
+	assert.Nil(t, query.Table("export_logs").OrderBy("id").ExportTo("backups", "exports/logs.csv", ExportCsv, func(exported int64) {
+		progress = append(progress, exported)
+	}))
+	assert.Equal(t, "id,message,score,active\n1,\"hello, world\",1.5,1\n2,,2,0\n3,goravel,,1\n", content)
+	assert.Equal(t, []int64{3}, progress)
+
+	mockStorage.EXPECT().PutFileAs("exports", mock.Anything, "logs.ndjson").RunAndReturn(put).Once()
+	assert.Nil(t, query.Table("export_logs").Select("id", "message").Where("active", true).OrderBy("id").ExportTo("", "exports/logs.ndjson", ExportNdjson))
+	assert.Equal(t, "{\"id\":1,\"message\":\"hello, world\"}\n{\"id\":3,\"message\":\"goravel\"}\n", content)
+
+	mockStorage.EXPECT().PutFileAs("exports", mock.Anything, "logs.parquet").RunAndReturn(put).Once()
+	assert.Nil(t, query.Table("export_logs").OrderBy("id").ExportTo("", "exports/logs.parquet", ExportParquet))
+
+	file, err := parquet.OpenFile(strings.NewReader(content), int64(len(content)))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), file.NumRows())
+	rows := make([]parquet.Row, 3)
+	reader := parquet.NewReader(file)
+	count, _ := reader.ReadRows(rows)
+	assert.Equal(t, 3, count)
+	// The columns are sorted by their names: active, id, message, score.
+	assert.True(t, rows[0][0].Boolean())
+	assert.Equal(t, int64(1), rows[0][1].Int64())
+	assert.Equal(t, "hello, world", rows[0][2].String())
+	assert.Equal(t, 1.5, rows[0][3].Double())
+	assert.True(t, rows[1][2].IsNull())
+	assert.True(t, rows[2][3].IsNull())
+
+	assert.EqualError(t, query.Table("export_logs").ExportTo("", "exports/logs.xml", "xml"), "export format [xml] isn't supported, it should be csv, ndjson or parquet")
+}
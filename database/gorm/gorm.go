@@ -10,7 +10,6 @@ import (
 	"github.com/google/wire"
 	gormio "gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
-	"gorm.io/gorm/schema"
 	"gorm.io/plugin/dbresolver"
 
 	"github.com/goravel/framework/contracts/config"
@@ -125,14 +124,17 @@ func (r *GormImpl) init(dialector gormio.Dialector) error {
 		NowFunc: func() time.Time {
 			return carbon.Now().StdTime()
 		},
-		NamingStrategy: schema.NamingStrategy{
-			TablePrefix:   r.config.GetString(fmt.Sprintf("database.connections.%s.prefix", r.connection)),
-			SingularTable: r.config.GetBool(fmt.Sprintf("database.connections.%s.singular", r.connection)),
-		},
+		NamingStrategy: NewNamingStrategy(r.config, r.connection),
 	})
 	if err != nil {
 		return err
 	}
+	if err := registerMorphMap(instance); err != nil {
+		return err
+	}
+	if err := registerIDGenerator(instance); err != nil {
+		return err
+	}
 
 	r.instance = instance
 
@@ -0,0 +1,122 @@
+package hints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlserver"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QueryHint Pass the optimizer hints and the forced indexes of a query to the database in the syntax of its driver:
+//
+//	MySQL: SELECT /*+ hints */ ... FROM users FORCE INDEX (indexes)
+//	PostgreSQL: /*+ hints IndexScan(users indexes) */ SELECT ..., it requires the pg_hint_plan extension
+//	SQLite: SELECT ... FROM users INDEXED BY index, the optimizer hints aren't supported
+//	SQL Server: SELECT ... FROM users WITH (INDEX(indexes)) ... OPTION (hints)
+//
+// The hints of PostgreSQL are passed to all the statements, the others are only passed to the SELECT statements,
+// except the table hints of SQL Server.
+type QueryHint struct {
+	Hints   []string
+	Indexes []string
+}
+
+func (r QueryHint) ModifyStatement(stmt *gorm.Statement) {
+	switch stmt.Name() {
+	case (&mysql.Dialector{}).Name():
+		if len(r.Hints) > 0 {
+			c := stmt.Clauses["SELECT"]
+			c.AfterNameExpression = merge(c.AfterNameExpression, comment(r.Hints))
+			stmt.Clauses["SELECT"] = c
+		}
+		if len(r.Indexes) > 0 {
+			r.afterFrom(stmt, selectOnly(clause.Expr{SQL: fmt.Sprintf("FORCE INDEX (%s)", strings.Join(r.Indexes, ", "))}))
+		}
+	case (&postgres.Dialector{}).Name():
+		if len(r.Hints) > 0 || len(r.Indexes) > 0 {
+			for _, name := range []string{"SELECT", "UPDATE", "DELETE"} {
+				c := stmt.Clauses[name]
+				c.BeforeExpression = merge(c.BeforeExpression, postgresHint{hints: r.Hints, indexes: r.Indexes, forSelect: name == "SELECT"})
+				stmt.Clauses[name] = c
+			}
+		}
+	case (&sqlite.Dialector{}).Name():
+		if len(r.Indexes) > 0 {
+			// SQLite uses only one index for a table.
+			r.afterFrom(stmt, selectOnly(clause.Expr{SQL: "INDEXED BY " + r.Indexes[0]}))
+		}
+	case (&sqlserver.Dialector{}).Name():
+		if len(r.Indexes) > 0 {
+			With(fmt.Sprintf("INDEX(%s)", strings.Join(r.Indexes, ", "))).ModifyStatement(stmt)
+		}
+		if len(r.Hints) > 0 {
+			// The query hints are the last clause of the statement, the FOR clause isn't used by SQL Server.
+			c := stmt.Clauses["FOR"]
+			option := clause.Expr{SQL: fmt.Sprintf("OPTION (%s)", strings.Join(r.Hints, ", "))}
+			if c.Expression == nil {
+				c.Expression = option
+			} else {
+				c.AfterExpression = merge(c.AfterExpression, option)
+			}
+			stmt.Clauses["FOR"] = c
+		}
+	}
+}
+
+// Build The hints are built by the clauses of the statement modified.
+func (r QueryHint) Build(clause.Builder) {
+}
+
+func (r QueryHint) afterFrom(stmt *gorm.Statement, expression clause.Expression) {
+	c := stmt.Clauses["FROM"]
+	c.AfterExpression = merge(c.AfterExpression, expression)
+	stmt.Clauses["FROM"] = c
+}
+
+func comment(hints []string) clause.Expression {
+	return clause.Expr{SQL: fmt.Sprintf("/*+ %s */", strings.Join(hints, " "))}
+}
+
+func merge(expression, other clause.Expression) clause.Expression {
+	if expression == nil {
+		return other
+	}
+
+	return Exprs{expression, other}
+}
+
+// postgresHint The hint comment of pg_hint_plan, the table of the forced indexes is resolved when building the
+// statement since the model may be parsed after the hints are added.
+type postgresHint struct {
+	hints     []string
+	indexes   []string
+	forSelect bool
+}
+
+func (r postgresHint) Build(builder clause.Builder) {
+	hints := r.hints
+	if stmt, ok := builder.(*gorm.Statement); ok && r.forSelect && len(r.indexes) > 0 && stmt.Table != "" {
+		hints = append(append([]string{}, hints...), fmt.Sprintf("IndexScan(%s %s)", stmt.Table, strings.Join(r.indexes, " ")))
+	}
+	if len(hints) > 0 {
+		comment(hints).Build(builder)
+	}
+}
+
+// selectOnly Build the expression only for the SELECT statements.
+type selectOnly clause.Expr
+
+func (r selectOnly) Build(builder clause.Builder) {
+	if stmt, ok := builder.(*gorm.Statement); ok {
+		if c, exist := stmt.Clauses["SELECT"]; !exist || c.Expression == nil {
+			return
+		}
+	}
+
+	clause.Expr(r).Build(builder)
+}
@@ -17,9 +17,13 @@ func (indexHint WithHint) ModifyStatement(stmt *gorm.Statement) {
 		for _, name := range []string{"FROM"} {
 			clause := stmt.Clauses[name]
 
-			if clause.AfterExpression == nil {
+			// The table hints are merged into one WITH, e.g. WITH (rowlock,INDEX(idx_users_email)).
+			switch expression := clause.AfterExpression.(type) {
+			case nil:
 				clause.AfterExpression = indexHint
-			} else {
+			case WithHint:
+				clause.AfterExpression = WithHint{Type: expression.Type, Keys: append(append([]string{}, expression.Keys...), indexHint.Keys...)}
+			default:
 				clause.AfterExpression = Exprs{clause.AfterExpression, indexHint}
 			}
 
@@ -0,0 +1,52 @@
+package gorm
+
+import (
+	"reflect"
+
+	gormio "gorm.io/gorm"
+
+	"github.com/goravel/framework/id"
+)
+
+// registerIDGenerator Fill the empty string primary keys of the created models with the ID generator, the primary
+// keys are left to the database if the ID generator isn't registered.
+func registerIDGenerator(instance *gormio.DB) error {
+	return instance.Callback().Create().Before("gorm:create").Register("goravel:id", func(db *gormio.DB) {
+		if id.Facade == nil || db.Statement.Schema == nil {
+			return
+		}
+
+		var fields []int
+		for i, field := range db.Statement.Schema.PrimaryFields {
+			if field.FieldType.Kind() == reflect.String {
+				fields = append(fields, i)
+			}
+		}
+		if len(fields) == 0 {
+			return
+		}
+
+		fill := func(model reflect.Value) {
+			for _, i := range fields {
+				field := db.Statement.Schema.PrimaryFields[i]
+				if _, zero := field.ValueOf(db.Statement.Context, model); zero {
+					if err := field.Set(db.Statement.Context, model, id.Facade.Generate()); err != nil {
+						_ = db.AddError(err)
+					}
+				}
+			}
+		}
+
+		switch db.Statement.ReflectValue.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < db.Statement.ReflectValue.Len(); i++ {
+				model := reflect.Indirect(db.Statement.ReflectValue.Index(i))
+				if model.Kind() == reflect.Struct {
+					fill(model)
+				}
+			}
+		case reflect.Struct:
+			fill(db.Statement.ReflectValue)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package gorm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/id"
+	idmocks "github.com/goravel/framework/mocks/id"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+type IDToken struct {
+	ID   string `gorm:"primaryKey"`
+	Name string
+}
+
+func TestIDGenerator(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	query, err := NewSqliteDocker(docker.Sqlite()).New()
+	assert.Nil(t, err)
+	_, err = query.Exec("DROP TABLE IF EXISTS id_tokens")
+	assert.Nil(t, err)
+	_, err = query.Exec("CREATE TABLE id_tokens (id varchar(36) PRIMARY KEY, name varchar(255))")
+	assert.Nil(t, err)
+
+	mockID := idmocks.NewID(t)
+	id.Facade = mockID
+	t.Cleanup(func() {
+		id.Facade = nil
+	})
+
+	mockID.EXPECT().Generate().Return("01J0000000000000000000001").Once()
+	token := IDToken{Name: "first"}
+	assert.Nil(t, query.Create(&token))
+	assert.Equal(t, "01J0000000000000000000001", token.ID)
+
+	mockID.EXPECT().Generate().Return("01J0000000000000000000002").Once()
+	tokens := []IDToken{{Name: "second"}, {ID: "custom", Name: "third"}}
+	assert.Nil(t, query.Create(&tokens))
+	assert.Equal(t, "01J0000000000000000000002", tokens[0].ID)
+	assert.Equal(t, "custom", tokens[1].ID)
+
+	var count int64
+	assert.Nil(t, query.Table("id_tokens").Count(&count))
+	assert.Equal(t, int64(3), count)
+}
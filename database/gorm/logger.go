@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"gorm.io/gorm/logger"
+
+	"github.com/goravel/framework/http/requestid"
 )
 
 func NewLogger(writer logger.Writer, config logger.Config) logger.Interface {
@@ -62,14 +64,14 @@ func (l *Logger) LogMode(level logger.LogLevel) logger.Interface {
 // Info print info
 func (l Logger) Info(ctx context.Context, msg string, data ...any) {
 	if l.LogLevel >= logger.Info {
-		l.Printf(l.infoStr+msg, append([]any{FileWithLineNum()}, data...)...)
+		l.Printf(l.infoStr+msg, append([]any{withRequestID(ctx, FileWithLineNum())}, data...)...)
 	}
 }
 
 // Warn print warn messages
 func (l Logger) Warn(ctx context.Context, msg string, data ...any) {
 	if l.LogLevel >= logger.Warn {
-		l.Printf(l.warnStr+msg, append([]any{FileWithLineNum()}, data...)...)
+		l.Printf(l.warnStr+msg, append([]any{withRequestID(ctx, FileWithLineNum())}, data...)...)
 	}
 }
 
@@ -97,7 +99,7 @@ func (l Logger) Error(ctx context.Context, msg string, data ...any) {
 	}
 
 	if l.LogLevel >= logger.Error {
-		l.Printf(l.errStr+msg, append([]any{FileWithLineNum()}, data...)...)
+		l.Printf(l.errStr+msg, append([]any{withRequestID(ctx, FileWithLineNum())}, data...)...)
 	}
 }
 
@@ -112,28 +114,38 @@ func (l Logger) Trace(ctx context.Context, begin time.Time, fc func() (string, i
 	case err != nil && l.LogLevel >= logger.Error && (!errors.Is(err, logger.ErrRecordNotFound) || !l.IgnoreRecordNotFoundError):
 		sql, rows := fc()
 		if rows == -1 {
-			l.Printf(l.traceErrStr, FileWithLineNum(), err, float64(elapsed.Nanoseconds())/1e6, "-", sql)
+			l.Printf(l.traceErrStr, withRequestID(ctx, FileWithLineNum()), err, float64(elapsed.Nanoseconds())/1e6, "-", sql)
 		} else {
-			l.Printf(l.traceErrStr, FileWithLineNum(), err, float64(elapsed.Nanoseconds())/1e6, rows, sql)
+			l.Printf(l.traceErrStr, withRequestID(ctx, FileWithLineNum()), err, float64(elapsed.Nanoseconds())/1e6, rows, sql)
 		}
 	case elapsed > l.SlowThreshold && l.SlowThreshold != 0 && l.LogLevel >= logger.Warn:
 		sql, rows := fc()
 		slowLog := fmt.Sprintf("SLOW SQL >= %v", l.SlowThreshold)
 		if rows == -1 {
-			l.Printf(l.traceWarnStr, FileWithLineNum(), slowLog, float64(elapsed.Nanoseconds())/1e6, "-", sql)
+			l.Printf(l.traceWarnStr, withRequestID(ctx, FileWithLineNum()), slowLog, float64(elapsed.Nanoseconds())/1e6, "-", sql)
 		} else {
-			l.Printf(l.traceWarnStr, FileWithLineNum(), slowLog, float64(elapsed.Nanoseconds())/1e6, rows, sql)
+			l.Printf(l.traceWarnStr, withRequestID(ctx, FileWithLineNum()), slowLog, float64(elapsed.Nanoseconds())/1e6, rows, sql)
 		}
 	case l.LogLevel == logger.Info:
 		sql, rows := fc()
 		if rows == -1 {
-			l.Printf(l.traceStr, FileWithLineNum(), float64(elapsed.Nanoseconds())/1e6, "-", sql)
+			l.Printf(l.traceStr, withRequestID(ctx, FileWithLineNum()), float64(elapsed.Nanoseconds())/1e6, "-", sql)
 		} else {
-			l.Printf(l.traceStr, FileWithLineNum(), float64(elapsed.Nanoseconds())/1e6, rows, sql)
+			l.Printf(l.traceStr, withRequestID(ctx, FileWithLineNum()), float64(elapsed.Nanoseconds())/1e6, rows, sql)
 		}
 	}
 }
 
+// withRequestID Append the ID of the request running the query to the source of the query, so the queries can be
+// traced by the request if the orm is used with the context of the request.
+func withRequestID(ctx context.Context, source string) string {
+	if id := requestid.FromContext(ctx); id != "" {
+		return source + " [request_id:" + id + "]"
+	}
+
+	return source
+}
+
 // FileWithLineNum return the file name and line number of the current file
 func FileWithLineNum() string {
 	_, file, _, _ := runtime.Caller(0)
@@ -0,0 +1,39 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/logger"
+
+	"github.com/goravel/framework/http/requestid"
+)
+
+type testWriter struct {
+	lines []string
+}
+
+func (r *testWriter) Printf(format string, args ...any) {
+	r.lines = append(r.lines, fmt.Sprintf(format, args...))
+}
+
+func TestLoggerTrace(t *testing.T) {
+	writer := &testWriter{}
+	log := NewLogger(writer, logger.Config{LogLevel: logger.Info})
+
+	log.Trace(context.Background(), time.Now(), func() (string, int64) {
+		return "SELECT * FROM users", 1
+	}, nil)
+	log.Trace(requestid.WithContext(context.Background(), "01J0000000000000000000000"), time.Now(), func() (string, int64) {
+		return "SELECT * FROM users", 1
+	}, nil)
+
+	assert.Len(t, writer.lines, 2)
+	assert.NotContains(t, writer.lines[0], "request_id")
+	assert.Contains(t, writer.lines[0], "SELECT * FROM users")
+	assert.Contains(t, writer.lines[1], " [request_id:01J0000000000000000000000]\n")
+	assert.Contains(t, writer.lines[1], "SELECT * FROM users")
+}
@@ -0,0 +1,128 @@
+package gorm
+
+import (
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/goravel/framework/database/orm"
+)
+
+// registerMorphMap Replace the table names stored in the type column of the polymorphic relations with the
+// aliases of the morph map, before the relations of a model are used.
+func registerMorphMap(instance *gormio.DB) error {
+	callback := func(db *gormio.DB) {
+		db.Statement.Schema = morphSchema(db.Statement.Schema)
+	}
+
+	if err := instance.Callback().Create().Before("*").Register("goravel:morph_map", callback); err != nil {
+		return err
+	}
+	if err := instance.Callback().Query().Before("*").Register("goravel:morph_map", callback); err != nil {
+		return err
+	}
+	if err := instance.Callback().Update().Before("*").Register("goravel:morph_map", callback); err != nil {
+		return err
+	}
+
+	return instance.Callback().Delete().Before("*").Register("goravel:morph_map", callback)
+}
+
+// morphSchema Get a copy of a schema whose polymorphic relations store the aliases of the morph map. The schemas are
+// cached and shared by the concurrent queries of gorm, so they are copied instead of updated. The schema is returned
+// as is if none of the relations reachable from it has an alias.
+func morphSchema(s *schema.Schema) *schema.Schema {
+	if s == nil || !hasMorphAlias(s, make(map[*schema.Schema]bool)) {
+		return s
+	}
+
+	return cloneSchema(s, make(map[*schema.Schema]*schema.Schema))
+}
+
+func hasMorphAlias(s *schema.Schema, visited map[*schema.Schema]bool) bool {
+	if s == nil || visited[s] {
+		return false
+	}
+	visited[s] = true
+
+	for _, relation := range s.Relationships.Relations {
+		if relation.Polymorphic != nil {
+			if alias := orm.MorphAlias(relation.Schema.ModelType); alias != "" && relation.Polymorphic.Value != alias {
+				return true
+			}
+		}
+
+		if hasMorphAlias(relation.FieldSchema, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cloneSchema(s *schema.Schema, clones map[*schema.Schema]*schema.Schema) *schema.Schema {
+	if s == nil {
+		return nil
+	}
+	if clone, ok := clones[s]; ok {
+		return clone
+	}
+
+	clone := *s
+	clones[s] = &clone
+
+	relations := make(map[*schema.Relationship]*schema.Relationship, len(s.Relationships.Relations))
+	cloneRelations := func(originals []*schema.Relationship) []*schema.Relationship {
+		if originals == nil {
+			return nil
+		}
+
+		cloned := make([]*schema.Relationship, len(originals))
+		for i, original := range originals {
+			if _, ok := relations[original]; !ok {
+				relations[original] = cloneRelationship(original, &clone, clones)
+			}
+			cloned[i] = relations[original]
+		}
+
+		return cloned
+	}
+
+	clone.Relationships.HasOne = cloneRelations(s.Relationships.HasOne)
+	clone.Relationships.BelongsTo = cloneRelations(s.Relationships.BelongsTo)
+	clone.Relationships.HasMany = cloneRelations(s.Relationships.HasMany)
+	clone.Relationships.Many2Many = cloneRelations(s.Relationships.Many2Many)
+	clone.Relationships.Relations = make(map[string]*schema.Relationship, len(s.Relationships.Relations))
+	for name, original := range s.Relationships.Relations {
+		clone.Relationships.Relations[name] = cloneRelations([]*schema.Relationship{original})[0]
+	}
+
+	return &clone
+}
+
+func cloneRelationship(relation *schema.Relationship, owner *schema.Schema, clones map[*schema.Schema]*schema.Schema) *schema.Relationship {
+	clone := *relation
+	clone.Schema = owner
+	clone.FieldSchema = cloneSchema(relation.FieldSchema, clones)
+
+	clone.References = make([]*schema.Reference, len(relation.References))
+	for i, reference := range relation.References {
+		clonedReference := *reference
+		clone.References[i] = &clonedReference
+	}
+
+	if relation.Polymorphic != nil {
+		polymorphic := *relation.Polymorphic
+		clone.Polymorphic = &polymorphic
+
+		if alias := orm.MorphAlias(relation.Schema.ModelType); alias != "" && polymorphic.Value != alias {
+			for _, reference := range clone.References {
+				if reference.PrimaryKey == nil && reference.ForeignKey == polymorphic.PolymorphicType {
+					reference.PrimaryValue = alias
+				}
+			}
+			polymorphic.Value = alias
+		}
+	}
+
+	return &clone
+}
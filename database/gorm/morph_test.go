@@ -0,0 +1,74 @@
+package gorm
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/schema"
+
+	"github.com/goravel/framework/database/orm"
+)
+
+type morphPost struct {
+	orm.Model
+	Comments []*morphComment `gorm:"polymorphic:Commentable"`
+}
+
+type morphVideo struct {
+	orm.Model
+	Comments []*morphComment `gorm:"polymorphic:Commentable"`
+}
+
+type morphComment struct {
+	orm.Model
+	CommentableID   uint
+	CommentableType string
+}
+
+func TestMorphSchema(t *testing.T) {
+	orm.MorphMap(map[string]any{"post": &morphPost{}})
+
+	cache := &sync.Map{}
+	post, err := schema.Parse(&morphPost{}, cache, schema.NamingStrategy{})
+	assert.Nil(t, err)
+	video, err := schema.Parse(&morphVideo{}, cache, schema.NamingStrategy{})
+	assert.Nil(t, err)
+
+	// The schemas are copied by the concurrent queries, the cached ones aren't updated.
+	var wg sync.WaitGroup
+	morphed := make([]*schema.Schema, 10)
+	for i := range morphed {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			morphed[i] = morphSchema(post)
+		}(i)
+	}
+	wg.Wait()
+
+	original := post.Relationships.Relations["Comments"]
+	assert.Equal(t, "morph_posts", original.Polymorphic.Value)
+	for _, s := range morphed {
+		assert.NotSame(t, post, s)
+		relation := s.Relationships.Relations["Comments"]
+		assert.Same(t, s, relation.Schema)
+		assert.Same(t, relation, s.Relationships.HasMany[0])
+		assert.Equal(t, "post", relation.Polymorphic.Value)
+		for _, reference := range relation.References {
+			if reference.PrimaryKey == nil {
+				assert.Equal(t, "post", reference.PrimaryValue)
+			}
+		}
+	}
+	for _, reference := range original.References {
+		if reference.PrimaryKey == nil {
+			assert.Equal(t, "morph_posts", reference.PrimaryValue)
+		}
+	}
+
+	// The models without an alias keep their table names and schemas.
+	assert.Same(t, video, morphSchema(video))
+	assert.Equal(t, "morph_videos", video.Relationships.Relations["Comments"].Polymorphic.Value)
+	assert.Nil(t, morphSchema(nil))
+}
@@ -0,0 +1,47 @@
+package gorm
+
+import (
+	"fmt"
+
+	"gorm.io/gorm/schema"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/support/str"
+)
+
+const (
+	ColumnNamingSnake = "snake"
+	ColumnNamingCamel = "camel"
+)
+
+// NamingStrategy extends the gorm naming strategy with camelCase column names, so legacy
+// schemas can be mapped without a column tag on every field.
+type NamingStrategy struct {
+	schema.NamingStrategy
+	ColumnNaming string
+}
+
+func (r NamingStrategy) ColumnName(table, column string) string {
+	name := r.NamingStrategy.ColumnName(table, column)
+	if r.ColumnNaming == ColumnNamingCamel {
+		return str.Of(name).Camel().String()
+	}
+
+	return name
+}
+
+// NewNamingStrategy builds the naming strategy of a connection. A schema.Namer set in
+// database.naming.strategy replaces the built-in strategy for all connections.
+func NewNamingStrategy(config config.Config, connection string) schema.Namer {
+	if namer, ok := config.Get("database.naming.strategy").(schema.Namer); ok && namer != nil {
+		return namer
+	}
+
+	return NamingStrategy{
+		NamingStrategy: schema.NamingStrategy{
+			TablePrefix:   config.GetString(fmt.Sprintf("database.connections.%s.prefix", connection)),
+			SingularTable: config.GetBool(fmt.Sprintf("database.connections.%s.singular", connection)),
+		},
+		ColumnNaming: config.GetString("database.naming.column", ColumnNamingSnake),
+	}
+}
@@ -0,0 +1,38 @@
+package gorm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/schema"
+
+	configmock "github.com/goravel/framework/mocks/config"
+)
+
+func TestNamingStrategy(t *testing.T) {
+	snake := NamingStrategy{ColumnNaming: ColumnNamingSnake}
+	assert.Equal(t, "user_id", snake.ColumnName("users", "UserID"))
+	assert.Equal(t, "users", snake.TableName("User"))
+
+	camel := NamingStrategy{ColumnNaming: ColumnNamingCamel}
+	assert.Equal(t, "userId", camel.ColumnName("users", "UserID"))
+	assert.Equal(t, "createdAt", camel.ColumnName("users", "CreatedAt"))
+	assert.Equal(t, "users", camel.TableName("User"))
+}
+
+func TestNewNamingStrategy(t *testing.T) {
+	mockConfig := &configmock.Config{}
+	mockConfig.On("Get", "database.naming.strategy").Return(nil).Once()
+	mockConfig.On("GetString", "database.connections.mysql.prefix").Return("goravel_").Once()
+	mockConfig.On("GetBool", "database.connections.mysql.singular").Return(true).Once()
+	mockConfig.On("GetString", "database.naming.column", ColumnNamingSnake).Return(ColumnNamingCamel).Once()
+	namer := NewNamingStrategy(mockConfig, "mysql")
+	assert.Equal(t, "goravel_user", namer.TableName("User"))
+	assert.Equal(t, "userId", namer.ColumnName("", "UserID"))
+
+	custom := schema.NamingStrategy{NoLowerCase: true}
+	mockConfig.On("Get", "database.naming.strategy").Return(custom).Once()
+	assert.Equal(t, custom, NewNamingStrategy(mockConfig, "mysql"))
+
+	mockConfig.AssertExpectations(t)
+}
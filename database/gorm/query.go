@@ -65,8 +65,16 @@ func BuildQueryImpl(ctx context.Context, config config.Config, connection string
 
 func (r *QueryImpl) Association(association string) ormcontract.Association {
 	query := r.buildConditions()
+	result := query.instance.Association(association)
 
-	return query.instance.Association(association)
+	// The association builds the conditions of the relation before running the callbacks.
+	if result.Error == nil {
+		if morphed := morphSchema(query.instance.Statement.Schema); morphed != nil {
+			result.Relationship = morphed.Relationships.Relations[association]
+		}
+	}
+
+	return result
 }
 
 func (r *QueryImpl) Begin() (ormcontract.Transaction, error) {
@@ -364,6 +372,13 @@ func (r *QueryImpl) ForceDelete(value any, conds ...any) (*ormcontract.Result, e
 	}, res.Error
 }
 
+func (r *QueryImpl) ForceIndex(indexes ...string) ormcontract.Query {
+	conditions := r.conditions
+	conditions.forceIndexes = append(conditions.forceIndexes, indexes...)
+
+	return r.setConditions(conditions)
+}
+
 func (r *QueryImpl) Get(dest any) error {
 	return r.Find(dest)
 }
@@ -385,6 +400,13 @@ func (r *QueryImpl) Having(query any, args ...any) ormcontract.Query {
 	return r.setConditions(conditions)
 }
 
+func (r *QueryImpl) Hint(hints ...string) ormcontract.Query {
+	conditions := r.conditions
+	conditions.hints = append(conditions.hints, hints...)
+
+	return r.setConditions(conditions)
+}
+
 func (r *QueryImpl) Instance() *gormio.DB {
 	return r.instance
 }
@@ -854,6 +876,7 @@ func (r *QueryImpl) buildConditions() *QueryImpl {
 	db = query.buildDistinct(db)
 	db = query.buildGroup(db)
 	db = query.buildHaving(db)
+	db = query.buildHints(db)
 	db = query.buildJoin(db)
 	db = query.buildLockForUpdate(db)
 	db = query.buildLimit(db)
@@ -904,6 +927,18 @@ func (r *QueryImpl) buildHaving(db *gormio.DB) *gormio.DB {
 	return db
 }
 
+func (r *QueryImpl) buildHints(db *gormio.DB) *gormio.DB {
+	if len(r.conditions.hints) == 0 && len(r.conditions.forceIndexes) == 0 {
+		return db
+	}
+
+	db = db.Clauses(hints.QueryHint{Hints: r.conditions.hints, Indexes: r.conditions.forceIndexes})
+	r.conditions.hints = nil
+	r.conditions.forceIndexes = nil
+
+	return db
+}
+
 func (r *QueryImpl) buildJoin(db *gormio.DB) *gormio.DB {
 	if r.conditions.join == nil {
 		return db
@@ -1186,43 +1221,11 @@ func (r *QueryImpl) event(event ormcontract.EventType, model, dest any) error {
 	}
 
 	instance := NewEvent(r, model, dest)
-
-	if dispatchesEvents, exist := dest.(ormcontract.DispatchesEvents); exist {
-		if event, exist := dispatchesEvents.DispatchesEvents()[event]; exist {
-			return event(instance)
-		}
-
-		return nil
-	}
-	if model != nil {
-		if dispatchesEvents, exist := model.(ormcontract.DispatchesEvents); exist {
-			if event, exist := dispatchesEvents.DispatchesEvents()[event]; exist {
-				return event(instance)
-			}
-
-			return nil
-		}
-	}
-
-	if observer := observer(dest); observer != nil {
-		if observerEvent := observerEvent(event, observer); observerEvent != nil {
-			return observerEvent(instance)
-		}
-
-		return nil
-	}
-
-	if model != nil {
-		if observer := observer(model); observer != nil {
-			if observerEvent := observerEvent(event, observer); observerEvent != nil {
-				return observerEvent(instance)
-			}
-
-			return nil
-		}
+	if err := dispatchEvent(event, instance, r.connection, model, dest); err != nil {
+		return err
 	}
 
-	return nil
+	return project(event, instance, model, dest)
 }
 
 func (r *QueryImpl) new(db *gormio.DB) *QueryImpl {
@@ -1433,6 +1436,45 @@ func (r *QueryImpl) updates(values any) (*ormcontract.Result, error) {
 	}, result.Error
 }
 
+func dispatchEvent(event ormcontract.EventType, instance ormcontract.Event, connection string, model, dest any) error {
+	if dispatchesEvents, exist := dest.(ormcontract.DispatchesEvents); exist {
+		if event, exist := dispatchesEvents.DispatchesEvents()[event]; exist {
+			return event(instance)
+		}
+
+		return nil
+	}
+	if model != nil {
+		if dispatchesEvents, exist := model.(ormcontract.DispatchesEvents); exist {
+			if event, exist := dispatchesEvents.DispatchesEvents()[event]; exist {
+				return event(instance)
+			}
+
+			return nil
+		}
+	}
+
+	if observer := observer(dest, connection, instance); observer != nil {
+		if observerEvent := observerEvent(event, observer); observerEvent != nil {
+			return observerEvent(instance)
+		}
+
+		return nil
+	}
+
+	if model != nil {
+		if observer := observer(model, connection, instance); observer != nil {
+			if observerEvent := observerEvent(event, observer); observerEvent != nil {
+				return observerEvent(instance)
+			}
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
 func filterFindConditions(conds ...any) error {
 	if len(conds) > 0 {
 		switch cond := conds[0].(type) {
@@ -1485,7 +1527,8 @@ func getModelConnection(model any) (string, error) {
 	return connectionModel.Connection(), nil
 }
 
-func observer(dest any) ormcontract.Observer {
+// observer Get the first observer of the model that is registered for the connection and whose predicate passes.
+func observer(dest any, connection string, instance ormcontract.Event) ormcontract.Observer {
 	destType := reflect.TypeOf(dest)
 	if destType.Kind() == reflect.Pointer {
 		destType = destType.Elem()
@@ -1496,9 +1539,17 @@ func observer(dest any) ormcontract.Observer {
 		if modelType.Kind() == reflect.Pointer {
 			modelType = modelType.Elem()
 		}
-		if destType.Name() == modelType.Name() {
-			return observer.Observer
+		if destType.Name() != modelType.Name() {
+			continue
+		}
+		if observer.Connection != "" && observer.Connection != connection {
+			continue
+		}
+		if observer.When != nil && !observer.When(instance) {
+			continue
 		}
+
+		return observer.Observer
 	}
 
 	return nil
@@ -1532,3 +1583,50 @@ func observerEvent(event ormcontract.EventType, observer ormcontract.Observer) f
 
 	return nil
 }
+
+func project(event ormcontract.EventType, instance ormcontract.Event, model, dest any) error {
+	switch event {
+	case ormcontract.EventCreated, ormcontract.EventUpdated, ormcontract.EventDeleted, ormcontract.EventForceDeleted:
+	default:
+		return nil
+	}
+
+	for _, projection := range projections(dest, model) {
+		if err := projection.Project(event, instance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func projections(dest, model any) []ormcontract.Projection {
+	var result []ormcontract.Projection
+	for _, projection := range orm.Projections {
+		for _, projectionModel := range projection.Models() {
+			if sameModel(dest, projectionModel) || (model != nil && sameModel(model, projectionModel)) {
+				result = append(result, projection)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func sameModel(value, model any) bool {
+	valueType := reflect.TypeOf(value)
+	if valueType == nil {
+		return false
+	}
+	if valueType.Kind() == reflect.Pointer {
+		valueType = valueType.Elem()
+	}
+
+	modelType := reflect.TypeOf(model)
+	if modelType.Kind() == reflect.Pointer {
+		modelType = modelType.Elem()
+	}
+
+	return valueType.Name() == modelType.Name()
+}
@@ -18,6 +18,7 @@ import (
 	databasedb "github.com/goravel/framework/database/db"
 	"github.com/goravel/framework/database/orm"
 	mocksconfig "github.com/goravel/framework/mocks/config"
+	ormmocks "github.com/goravel/framework/mocks/database/orm"
 	supportdocker "github.com/goravel/framework/support/docker"
 	"github.com/goravel/framework/support/env"
 )
@@ -2493,6 +2494,24 @@ func (s *QueryTestSuite) TestSum() {
 	}
 }
 
+func (s *QueryTestSuite) TestHintAndForceIndex() {
+	for driver, query := range s.queries {
+		s.Run(driver.String(), func() {
+			sql := query.Hint("MAX_EXECUTION_TIME(1000)").ForceIndex("idx_users_name").Where("id", 1).ToSql().Find(User{})
+			switch driver {
+			case contractsorm.DriverMysql:
+				s.Equal("SELECT /*+ MAX_EXECUTION_TIME(1000) */ * FROM `users` FORCE INDEX (idx_users_name) WHERE `id` = ? AND `users`.`deleted_at` IS NULL", sql)
+			case contractsorm.DriverPostgresql:
+				s.Equal("/*+ MAX_EXECUTION_TIME(1000) IndexScan(users idx_users_name) */ SELECT * FROM \"users\" WHERE \"id\" = $1 AND \"users\".\"deleted_at\" IS NULL", sql)
+			case contractsorm.DriverSqlserver:
+				s.Equal("SELECT * FROM \"users\" with (INDEX(idx_users_name)) WHERE \"id\" = @p1 AND \"users\".\"deleted_at\" IS NULL OPTION (MAX_EXECUTION_TIME(1000))", sql)
+			default:
+				s.Equal("SELECT * FROM `users` INDEXED BY idx_users_name WHERE `id` = ? AND `users`.`deleted_at` IS NULL", sql)
+			}
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestToSql() {
 	for driver, query := range s.queries {
 		s.Run(driver.String(), func() {
@@ -3229,8 +3248,42 @@ func TestObserver(t *testing.T) {
 		Observer: &UserObserver{},
 	})
 
-	assert.Nil(t, observer(Product{}))
-	assert.Equal(t, &UserObserver{}, observer(User{}))
+	assert.Nil(t, observer(Product{}, "mysql", nil))
+	assert.Equal(t, &UserObserver{}, observer(User{}, "mysql", nil))
+}
+
+func TestObserverWithOption(t *testing.T) {
+	observers := orm.Observers
+	defer func() {
+		orm.Observers = observers
+	}()
+
+	type tenantKey struct{}
+	mockObserver := &ormmocks.Observer{}
+	orm.Observers = []orm.Observer{
+		{
+			Model:      Product{},
+			Observer:   &UserObserver{},
+			Connection: "postgresql",
+		},
+		{
+			Model:    Product{},
+			Observer: mockObserver,
+			When: func(event contractsorm.Event) bool {
+				return event.Context().Value(tenantKey{}) == "goravel"
+			},
+		},
+	}
+
+	mockEvent := &ormmocks.Event{}
+	mockEvent.On("Context").Return(context.WithValue(context.Background(), tenantKey{}, "goravel")).Once()
+	assert.Equal(t, &UserObserver{}, observer(Product{}, "postgresql", mockEvent))
+	assert.Equal(t, mockObserver, observer(Product{}, "mysql", mockEvent))
+
+	mockEvent.On("Context").Return(context.Background()).Once()
+	assert.Nil(t, observer(Product{}, "mysql", mockEvent))
+
+	mockEvent.AssertExpectations(t)
 }
 
 func TestObserverEvent(t *testing.T) {
@@ -3248,6 +3301,26 @@ func TestObserverEvent(t *testing.T) {
 	assert.Nil(t, observerEvent("error", &UserObserver{}))
 }
 
+func TestProject(t *testing.T) {
+	projection := &UserProjection{}
+	orm.Projections = append(orm.Projections, projection)
+	defer func() {
+		orm.Projections = orm.Projections[:len(orm.Projections)-1]
+	}()
+
+	assert.Empty(t, projections(&Product{}, nil))
+	assert.Equal(t, []contractsorm.Projection{projection}, projections(&User{}, nil))
+	assert.Equal(t, []contractsorm.Projection{projection}, projections(map[string]any{}, &User{}))
+
+	assert.Nil(t, project(contractsorm.EventCreating, nil, nil, &User{}))
+	assert.Equal(t, 0, projection.projected)
+
+	assert.Nil(t, project(contractsorm.EventCreated, nil, nil, &User{}))
+	assert.Nil(t, project(contractsorm.EventDeleted, nil, nil, &User{}))
+	assert.Nil(t, project(contractsorm.EventCreated, nil, nil, &Product{}))
+	assert.Equal(t, 2, projection.projected)
+}
+
 func TestReadWriteSeparate(t *testing.T) {
 	if env.IsWindows() {
 		t.Skip("Skipping tests of using docker")
@@ -3434,6 +3507,7 @@ func paginator(page string, limit string) func(methods contractsorm.Query) contr
 func mockDummyConnection(mockConfig *mocksconfig.Config, databaseConfig contractstesting.DatabaseConfig) {
 	mockConfig.On("GetString", "database.connections.dummy.prefix").Return("")
 	mockConfig.On("GetBool", "database.connections.dummy.singular").Return(false)
+	mockNaming(mockConfig)
 	mockConfig.On("Get", "database.connections.dummy.read").Return(nil)
 	mockConfig.On("Get", "database.connections.dummy.write").Return(nil)
 	mockConfig.On("GetString", "database.connections.dummy.host").Return("127.0.0.1")
@@ -3449,6 +3523,7 @@ func mockDummyConnection(mockConfig *mocksconfig.Config, databaseConfig contract
 func mockPostgresqlConnection(mockConfig *mocksconfig.Config, databaseConfig contractstesting.DatabaseConfig) {
 	mockConfig.On("GetString", "database.connections.postgresql.prefix").Return("")
 	mockConfig.On("GetBool", "database.connections.postgresql.singular").Return(false)
+	mockNaming(mockConfig)
 	mockConfig.On("Get", "database.connections.postgresql.read").Return(nil)
 	mockConfig.On("Get", "database.connections.postgresql.write").Return(nil)
 	mockConfig.On("GetString", "database.connections.postgresql.host").Return("127.0.0.1")
@@ -3506,3 +3581,25 @@ func (u *UserObserver) ForceDeleting(event contractsorm.Event) error {
 func (u *UserObserver) ForceDeleted(event contractsorm.Event) error {
 	return errors.New("forceDeleted")
 }
+
+type UserProjection struct {
+	projected int
+}
+
+func (u *UserProjection) Signature() string {
+	return "user_projection"
+}
+
+func (u *UserProjection) Models() []any {
+	return []any{User{}}
+}
+
+func (u *UserProjection) Project(contractsorm.EventType, contractsorm.Event) error {
+	u.projected++
+
+	return nil
+}
+
+func (u *UserProjection) Rebuild(contractsorm.Query) error {
+	return nil
+}
@@ -94,6 +94,8 @@ func (r *MysqlDocker) MockReadWrite(readPort, writePort int) {
 func (r *MysqlDocker) mock() {
 	r.MockConfig.On("GetString", "database.default").Return("mysql")
 	r.MockConfig.On("GetString", "database.migrations").Return("migrations")
+	r.MockConfig.On("GetString", "database.connections.mysql.migrations.path", "database/migrations").Return("database/migrations")
+	r.MockConfig.On("GetString", "database.connections.mysql.migrations.table", "migrations").Return("migrations")
 	r.MockConfig.On("GetString", "database.connections.mysql.prefix").Return("")
 	r.MockConfig.On("GetBool", "database.connections.mysql.singular").Return(false)
 	r.mockSingleOfCommon()
@@ -125,6 +127,7 @@ func (r *MysqlDocker) mockOfCommon() {
 	r.MockConfig.On("GetString", "database.connections.mysql.database").Return(r.database)
 
 	mockPool(r.MockConfig)
+	mockNaming(r.MockConfig)
 }
 
 type PostgresqlDocker struct {
@@ -209,6 +212,8 @@ func (r *PostgresqlDocker) MockReadWrite(readPort, writePort int) {
 func (r *PostgresqlDocker) mock() {
 	r.MockConfig.On("GetString", "database.default").Return("postgresql")
 	r.MockConfig.On("GetString", "database.migrations").Return("migrations")
+	r.MockConfig.On("GetString", "database.connections.postgresql.migrations.path", "database/migrations").Return("database/migrations")
+	r.MockConfig.On("GetString", "database.connections.postgresql.migrations.table", "migrations").Return("migrations")
 	r.MockConfig.On("GetString", "database.connections.postgresql.prefix").Return("")
 	r.MockConfig.On("GetBool", "database.connections.postgresql.singular").Return(false)
 	r.mockSingleOfCommon()
@@ -239,6 +244,7 @@ func (r *PostgresqlDocker) mockOfCommon() {
 	r.MockConfig.On("GetString", "database.connections.postgresql.database").Return(r.database)
 
 	mockPool(r.MockConfig)
+	mockNaming(r.MockConfig)
 }
 
 type SqliteDocker struct {
@@ -318,6 +324,8 @@ func (r *SqliteDocker) MockReadWrite(readDatabase string) {
 func (r *SqliteDocker) mock() {
 	r.MockConfig.On("GetString", "database.default").Return("sqlite")
 	r.MockConfig.On("GetString", "database.migrations").Return("migrations")
+	r.MockConfig.On("GetString", "database.connections.sqlite.migrations.path", "database/migrations").Return("database/migrations")
+	r.MockConfig.On("GetString", "database.connections.sqlite.migrations.table", "migrations").Return("migrations")
 	r.MockConfig.On("GetString", "database.connections.sqlite.prefix").Return("")
 	r.MockConfig.On("GetBool", "database.connections.sqlite.singular").Return(false)
 	r.mockSingleOfCommon()
@@ -341,6 +349,7 @@ func (r *SqliteDocker) mockOfCommon() {
 	r.MockConfig.On("GetBool", "app.debug").Return(true)
 	r.MockConfig.On("GetString", "database.connections.sqlite.driver").Return(orm.DriverSqlite.String())
 	mockPool(r.MockConfig)
+	mockNaming(r.MockConfig)
 }
 
 type SqlserverDocker struct {
@@ -412,6 +421,8 @@ func (r *SqlserverDocker) QueryWithPrefixAndSingular() (orm.Query, error) {
 func (r *SqlserverDocker) mock() {
 	r.MockConfig.On("GetString", "database.default").Return("sqlserver")
 	r.MockConfig.On("GetString", "database.migrations").Return("migrations")
+	r.MockConfig.On("GetString", "database.connections.sqlserver.migrations.path", "database/migrations").Return("database/migrations")
+	r.MockConfig.On("GetString", "database.connections.sqlserver.migrations.table", "migrations").Return("migrations")
 	r.MockConfig.On("GetString", "database.connections.sqlserver.prefix").Return("")
 	r.MockConfig.On("GetBool", "database.connections.sqlserver.singular").Return(false)
 	r.mockSingleOfCommon()
@@ -453,6 +464,7 @@ func (r *SqlserverDocker) mockOfCommon() {
 	r.MockConfig.On("GetString", "database.connections.sqlserver.database").Return(r.database)
 	r.MockConfig.On("GetString", "database.connections.sqlserver.charset").Return("utf8mb4")
 	mockPool(r.MockConfig)
+	mockNaming(r.MockConfig)
 }
 
 type Tables struct {
@@ -1148,3 +1160,8 @@ func mockPool(mockConfig *mocksconfig.Config) {
 	mockConfig.On("GetInt", "database.pool.conn_max_idletime", 3600).Return(3600)
 	mockConfig.On("GetInt", "database.pool.conn_max_lifetime", 3600).Return(3600)
 }
+
+func mockNaming(mockConfig *mocksconfig.Config) {
+	mockConfig.On("Get", "database.naming.strategy").Return(nil)
+	mockConfig.On("GetString", "database.naming.column", "snake").Return("snake")
+}
@@ -1,25 +1,52 @@
 package gorm
 
 import (
+	"context"
+
 	"gorm.io/gorm"
 
 	"github.com/goravel/framework/contracts/config"
 	"github.com/goravel/framework/contracts/database/orm"
+	databaseorm "github.com/goravel/framework/database/orm"
 )
 
 type Transaction struct {
 	orm.Query
+	ctx      context.Context
 	instance *gorm.DB
+	pending  *databaseorm.PendingTransaction
 }
 
 func NewTransaction(tx *gorm.DB, config config.Config, connection string) *Transaction {
-	return &Transaction{Query: NewQueryImpl(tx.Statement.Context, config, connection, tx, nil), instance: tx}
+	ctx := tx.Statement.Context
+	var pending *databaseorm.PendingTransaction
+	if tx.Error == nil {
+		pending, ctx = databaseorm.BeginTransaction(ctx)
+		tx = tx.WithContext(ctx)
+	}
+
+	return &Transaction{Query: NewQueryImpl(ctx, config, connection, tx, nil), ctx: ctx, instance: tx, pending: pending}
+}
+
+// Context Get the context of the transaction, the events, jobs and mails dispatched with it wait for the commit.
+func (r *Transaction) Context() context.Context {
+	return r.ctx
 }
 
+// Commit the transaction and release the events, jobs and mails that should be dispatched after commit,
+// the errors of dispatching are returned after the transaction is committed.
 func (r *Transaction) Commit() error {
-	return r.instance.Commit().Error
+	if err := r.instance.Commit().Error; err != nil {
+		databaseorm.RollbackTransaction(r.pending)
+
+		return err
+	}
+
+	return databaseorm.CommitTransaction(r.pending)
 }
 
 func (r *Transaction) Rollback() error {
+	databaseorm.RollbackTransaction(r.pending)
+
 	return r.instance.Rollback().Error
 }
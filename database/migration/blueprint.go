@@ -0,0 +1,92 @@
+package migration
+
+import (
+	"github.com/goravel/framework/contracts/database/migration"
+	"github.com/goravel/framework/contracts/database/orm"
+)
+
+var _ migration.Blueprint = (*Blueprint)(nil)
+
+type column struct {
+	name       string
+	definition string
+}
+
+type rename struct {
+	from string
+	to   string
+}
+
+type Blueprint struct {
+	columns   []column
+	comment   *string
+	create    bool
+	renames   []rename
+	table     string
+	temporary bool
+}
+
+func NewBlueprint(table string) *Blueprint {
+	return &Blueprint{
+		table: table,
+	}
+}
+
+func (r *Blueprint) Column(name, definition string) {
+	r.columns = append(r.columns, column{name: name, definition: definition})
+}
+
+func (r *Blueprint) Comment(comment string) {
+	r.comment = &comment
+}
+
+func (r *Blueprint) GetTableName() string {
+	return r.table
+}
+
+func (r *Blueprint) RenameColumn(from, to string) {
+	r.renames = append(r.renames, rename{from: from, to: to})
+}
+
+func (r *Blueprint) Temporary() {
+	r.temporary = true
+}
+
+// Build Execute the statements of the blueprint in order.
+func (r *Blueprint) Build(query orm.Query, grammar Grammar) error {
+	execute := func(statements ...string) error {
+		for _, statement := range statements {
+			if _, err := query.Exec(statement); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if r.create {
+		if err := execute(grammar.CompileCreate(r)); err != nil {
+			return err
+		}
+	} else {
+		for _, column := range r.columns {
+			if err := execute(grammar.CompileAdd(r, column.name, column.definition)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rename := range r.renames {
+		if err := execute(grammar.CompileRenameColumn(r, rename.from, rename.to)); err != nil {
+			return err
+		}
+	}
+
+	if r.comment != nil {
+		if statement := grammar.CompileComment(r, *r.comment); statement != "" {
+			return execute(statement)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	contractstesting "github.com/goravel/framework/contracts/testing"
+	"github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+	"github.com/goravel/framework/support/file"
+)
+
+type BlueprintTestSuite struct {
+	suite.Suite
+	driver contractstesting.DatabaseDriver
+	query  orm.Query
+}
+
+func TestBlueprintTestSuite(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	driver := docker.Sqlite()
+	suite.Run(t, &BlueprintTestSuite{
+		driver: driver,
+	})
+
+	assert.Nil(t, file.Remove(driver.Config().Database))
+}
+
+func (s *BlueprintTestSuite) SetupTest() {
+	query, err := gorm.NewSqliteDocker(s.driver).New()
+	s.Require().Nil(err)
+	_, err = query.Exec("DROP TABLE IF EXISTS schema_users")
+	s.Require().Nil(err)
+
+	s.query = query
+}
+
+func (s *BlueprintTestSuite) TestBuild_Sqlite() {
+	blueprint := NewBlueprint("schema_users")
+	blueprint.create = true
+	blueprint.Column("id", "integer PRIMARY KEY AUTOINCREMENT")
+	blueprint.Column("name", "varchar(255) NOT NULL DEFAULT ''")
+	blueprint.Column("label", "varchar(255) NOT NULL DEFAULT 'name' CHECK (label <> 'from name')")
+	blueprint.Comment("ignored by sqlite")
+	s.Nil(blueprint.Build(s.query, &SqliteGrammar{}))
+
+	_, err := s.query.Exec(`CREATE INDEX "schema_users_name_index" ON "schema_users" ("name")`)
+	s.Nil(err)
+	_, err = s.query.Exec(`INSERT INTO "schema_users" ("name") VALUES ('goravel')`)
+	s.Nil(err)
+
+	blueprint = NewBlueprint("schema_users")
+	blueprint.Column("age", "integer NOT NULL DEFAULT 0")
+	blueprint.RenameColumn("name", "full_name")
+	blueprint.RenameColumn("full_name", "nickname")
+	s.Nil(blueprint.Build(s.query, &SqliteGrammar{}))
+
+	var users []struct {
+		ID       uint
+		Nickname string
+		Age      int
+	}
+	s.Nil(s.query.Raw(`SELECT * FROM "schema_users"`).Scan(&users))
+	s.Len(users, 1)
+	s.Equal("goravel", users[0].Nickname)
+	s.Equal(0, users[0].Age)
+
+	var indexes []struct {
+		Sql string
+	}
+	s.Nil(s.query.Raw("SELECT sql FROM sqlite_master WHERE type = 'index' AND tbl_name = 'schema_users' AND sql IS NOT NULL").Scan(&indexes))
+	s.Len(indexes, 1)
+	s.Equal(`CREATE INDEX "schema_users_name_index" ON "schema_users" ("nickname")`, indexes[0].Sql)
+
+	// The literals containing the name of the renamed column are kept.
+	var labels []struct {
+		Label string
+	}
+	s.Nil(s.query.Raw(`SELECT label FROM "schema_users"`).Scan(&labels))
+	s.Len(labels, 1)
+	s.Equal("name", labels[0].Label)
+	_, err = s.query.Exec(`INSERT INTO "schema_users" ("nickname", "label") VALUES ('framework', 'from name')`)
+	s.NotNil(err)
+
+	blueprint = NewBlueprint("schema_users")
+	blueprint.RenameColumn("missing", "other")
+	s.NotNil(blueprint.Build(s.query, &SqliteGrammar{}))
+
+	blueprint = NewBlueprint("schema_missing")
+	blueprint.RenameColumn("name", "other")
+	s.NotNil(blueprint.Build(s.query, &SqliteGrammar{}))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/goravel/framework/support/carbon"
+	supportconsole "github.com/goravel/framework/support/console"
 	"github.com/goravel/framework/support/file"
 	"github.com/goravel/framework/support/str"
 )
@@ -43,14 +44,14 @@ func (r *DefaultDriver) Create(name string) error {
 // getStub Get the migration stub file.
 func (r *DefaultDriver) getStub(table string, create bool) string {
 	if table == "" {
-		return Stubs{}.Empty()
+		return supportconsole.Stub("migration.stub", Stubs{}.Empty())
 	}
 
 	if create {
-		return Stubs{}.Create()
+		return supportconsole.Stub("migration.create.stub", Stubs{}.Create())
 	}
 
-	return Stubs{}.Update()
+	return supportconsole.Stub("migration.update.stub", Stubs{}.Update())
 }
 
 // populateStub Populate the place-holders in the migration stub.
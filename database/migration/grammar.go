@@ -0,0 +1,53 @@
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goravel/framework/contracts/database/orm"
+)
+
+type Grammar interface {
+	// CompileAdd Compile an add column command.
+	CompileAdd(blueprint *Blueprint, column, definition string) string
+	// CompileComment Compile a table comment command, it's empty if the driver doesn't support the table comments.
+	CompileComment(blueprint *Blueprint, comment string) string
+	// CompileCreate Compile a create table command.
+	CompileCreate(blueprint *Blueprint) string
+	// CompileDrop Compile a drop table command.
+	CompileDrop(table string) string
+	// CompileDropIfExists Compile a drop table (if exists) command.
+	CompileDropIfExists(table string) string
+	// CompileRenameColumn Compile a rename column command.
+	CompileRenameColumn(blueprint *Blueprint, from, to string) string
+}
+
+func NewGrammar(driver orm.Driver) (Grammar, error) {
+	switch driver {
+	case orm.DriverMysql:
+		return &MysqlGrammar{}, nil
+	case orm.DriverPostgres, orm.DriverPostgresql:
+		return &PostgresGrammar{}, nil
+	case orm.DriverSqlite:
+		return &SqliteGrammar{}, nil
+	case orm.DriverSqlserver:
+		return &SqlserverGrammar{}, nil
+	default:
+		return nil, fmt.Errorf("the schema doesn't support the driver: %s", driver)
+	}
+}
+
+// columns Get the definitions of the columns of a blueprint, the names are wrapped by the wrap function.
+func columns(blueprint *Blueprint, wrap func(string) string) string {
+	definitions := make([]string, 0, len(blueprint.columns))
+	for _, column := range blueprint.columns {
+		definitions = append(definitions, wrap(column.name)+" "+column.definition)
+	}
+
+	return strings.Join(definitions, ", ")
+}
+
+// quoteString Quote a string value, e.g. a comment.
+func quoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,97 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/database/orm"
+)
+
+func TestNewGrammar(t *testing.T) {
+	for driver, expected := range map[orm.Driver]Grammar{
+		orm.DriverMysql:      &MysqlGrammar{},
+		orm.DriverPostgres:   &PostgresGrammar{},
+		orm.DriverPostgresql: &PostgresGrammar{},
+		orm.DriverSqlite:     &SqliteGrammar{},
+		orm.DriverSqlserver:  &SqlserverGrammar{},
+	} {
+		grammar, err := NewGrammar(driver)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, grammar)
+	}
+
+	_, err := NewGrammar("oracle")
+	assert.EqualError(t, err, "the schema doesn't support the driver: oracle")
+}
+
+func TestGrammars(t *testing.T) {
+	blueprint := NewBlueprint("users")
+	blueprint.Column("id", "integer")
+	blueprint.Column("name", "varchar(255) NOT NULL")
+	temporary := NewBlueprint("users")
+	temporary.Column("id", "integer")
+	temporary.Temporary()
+
+	tests := []struct {
+		grammar      Grammar
+		create       string
+		temporary    string
+		add          string
+		comment      string
+		drop         string
+		dropIfExists string
+		renameColumn string
+	}{
+		{
+			grammar:      &MysqlGrammar{},
+			create:       "CREATE TABLE `users` (`id` integer, `name` varchar(255) NOT NULL)",
+			temporary:    "CREATE TEMPORARY TABLE `users` (`id` integer)",
+			add:          "ALTER TABLE `users` ADD COLUMN `age` int",
+			comment:      "ALTER TABLE `users` COMMENT = 'the users''s table'",
+			drop:         "DROP TABLE `users`",
+			dropIfExists: "DROP TABLE IF EXISTS `users`",
+			renameColumn: "ALTER TABLE `users` RENAME COLUMN `name` TO `full_name`",
+		},
+		{
+			grammar:      &PostgresGrammar{},
+			create:       `CREATE TABLE "users" ("id" integer, "name" varchar(255) NOT NULL)`,
+			temporary:    `CREATE TEMPORARY TABLE "users" ("id" integer)`,
+			add:          `ALTER TABLE "users" ADD COLUMN "age" int`,
+			comment:      `COMMENT ON TABLE "users" IS 'the users''s table'`,
+			drop:         `DROP TABLE "users"`,
+			dropIfExists: `DROP TABLE IF EXISTS "users"`,
+			renameColumn: `ALTER TABLE "users" RENAME COLUMN "name" TO "full_name"`,
+		},
+		{
+			grammar:      &SqliteGrammar{},
+			create:       `CREATE TABLE "users" ("id" integer, "name" varchar(255) NOT NULL)`,
+			temporary:    `CREATE TEMPORARY TABLE "users" ("id" integer)`,
+			add:          `ALTER TABLE "users" ADD COLUMN "age" int`,
+			drop:         `DROP TABLE "users"`,
+			dropIfExists: `DROP TABLE IF EXISTS "users"`,
+			renameColumn: `ALTER TABLE "users" RENAME COLUMN "name" TO "full_name"`,
+		},
+		{
+			grammar:      &SqlserverGrammar{},
+			create:       `CREATE TABLE [users] ([id] integer, [name] varchar(255) NOT NULL)`,
+			temporary:    `CREATE TABLE [#users] ([id] integer)`,
+			add:          `ALTER TABLE [users] ADD [age] int`,
+			comment:      `EXEC sp_addextendedproperty 'MS_Description', N'the users''s table', 'SCHEMA', 'dbo', 'TABLE', 'users'`,
+			drop:         `DROP TABLE [users]`,
+			dropIfExists: `IF OBJECT_ID('users', 'U') IS NOT NULL DROP TABLE [users]`,
+			renameColumn: `EXEC sp_rename 'users.name', 'full_name', 'COLUMN'`,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.create, test.grammar.CompileCreate(blueprint))
+		assert.Equal(t, test.temporary, test.grammar.CompileCreate(temporary))
+		assert.Equal(t, test.add, test.grammar.CompileAdd(blueprint, "age", "int"))
+		assert.Equal(t, test.comment, test.grammar.CompileComment(blueprint, "the users's table"))
+		assert.Equal(t, test.drop, test.grammar.CompileDrop("users"))
+		assert.Equal(t, test.dropIfExists, test.grammar.CompileDropIfExists("users"))
+
+		assert.Equal(t, test.renameColumn, test.grammar.CompileRenameColumn(blueprint, "name", "full_name"))
+	}
+}
@@ -0,0 +1,41 @@
+package migration
+
+import (
+	"fmt"
+)
+
+type MysqlGrammar struct {
+}
+
+func (r *MysqlGrammar) CompileAdd(blueprint *Blueprint, column, definition string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", r.wrap(blueprint.GetTableName()), r.wrap(column), definition)
+}
+
+func (r *MysqlGrammar) CompileComment(blueprint *Blueprint, comment string) string {
+	return fmt.Sprintf("ALTER TABLE %s COMMENT = %s", r.wrap(blueprint.GetTableName()), quoteString(comment))
+}
+
+func (r *MysqlGrammar) CompileCreate(blueprint *Blueprint) string {
+	create := "CREATE TABLE"
+	if blueprint.temporary {
+		create = "CREATE TEMPORARY TABLE"
+	}
+
+	return fmt.Sprintf("%s %s (%s)", create, r.wrap(blueprint.GetTableName()), columns(blueprint, r.wrap))
+}
+
+func (r *MysqlGrammar) CompileDrop(table string) string {
+	return fmt.Sprintf("DROP TABLE %s", r.wrap(table))
+}
+
+func (r *MysqlGrammar) CompileDropIfExists(table string) string {
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s", r.wrap(table))
+}
+
+func (r *MysqlGrammar) CompileRenameColumn(blueprint *Blueprint, from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", r.wrap(blueprint.GetTableName()), r.wrap(from), r.wrap(to))
+}
+
+func (r *MysqlGrammar) wrap(value string) string {
+	return "`" + value + "`"
+}
@@ -0,0 +1,41 @@
+package migration
+
+import (
+	"fmt"
+)
+
+type PostgresGrammar struct {
+}
+
+func (r *PostgresGrammar) CompileAdd(blueprint *Blueprint, column, definition string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", r.wrap(blueprint.GetTableName()), r.wrap(column), definition)
+}
+
+func (r *PostgresGrammar) CompileComment(blueprint *Blueprint, comment string) string {
+	return fmt.Sprintf("COMMENT ON TABLE %s IS %s", r.wrap(blueprint.GetTableName()), quoteString(comment))
+}
+
+func (r *PostgresGrammar) CompileCreate(blueprint *Blueprint) string {
+	create := "CREATE TABLE"
+	if blueprint.temporary {
+		create = "CREATE TEMPORARY TABLE"
+	}
+
+	return fmt.Sprintf("%s %s (%s)", create, r.wrap(blueprint.GetTableName()), columns(blueprint, r.wrap))
+}
+
+func (r *PostgresGrammar) CompileDrop(table string) string {
+	return fmt.Sprintf("DROP TABLE %s", r.wrap(table))
+}
+
+func (r *PostgresGrammar) CompileDropIfExists(table string) string {
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s", r.wrap(table))
+}
+
+func (r *PostgresGrammar) CompileRenameColumn(blueprint *Blueprint, from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", r.wrap(blueprint.GetTableName()), r.wrap(from), r.wrap(to))
+}
+
+func (r *PostgresGrammar) wrap(value string) string {
+	return `"` + value + `"`
+}
@@ -33,3 +33,55 @@ func (r *Schema) Sql(sql string) {
 	// TODO catch error and rollback
 	_, _ = r.orm.Connection(r.connection).Query().Exec(sql)
 }
+
+func (r *Schema) Create(table string, callback func(table migration.Blueprint)) error {
+	blueprint := NewBlueprint(table)
+	blueprint.create = true
+	callback(blueprint)
+
+	return r.build(blueprint)
+}
+
+func (r *Schema) Drop(table string) error {
+	return r.execute(func(grammar Grammar) string {
+		return grammar.CompileDrop(table)
+	})
+}
+
+func (r *Schema) DropIfExists(table string) error {
+	return r.execute(func(grammar Grammar) string {
+		return grammar.CompileDropIfExists(table)
+	})
+}
+
+func (r *Schema) Table(table string, callback func(table migration.Blueprint)) error {
+	blueprint := NewBlueprint(table)
+	callback(blueprint)
+
+	return r.build(blueprint)
+}
+
+// build Execute a blueprint in a transaction, so a table rebuilt by SQLite isn't left half done.
+func (r *Schema) build(blueprint *Blueprint) error {
+	orm := r.orm.Connection(r.connection)
+	grammar, err := NewGrammar(orm.Query().Driver())
+	if err != nil {
+		return err
+	}
+
+	return orm.Transaction(func(tx contractsorm.Transaction) error {
+		return blueprint.Build(tx, grammar)
+	})
+}
+
+func (r *Schema) execute(compile func(grammar Grammar) string) error {
+	query := r.orm.Connection(r.connection).Query()
+	grammar, err := NewGrammar(query.Driver())
+	if err != nil {
+		return err
+	}
+
+	_, err = query.Exec(compile(grammar))
+
+	return err
+}
@@ -0,0 +1,67 @@
+package migration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsmigration "github.com/goravel/framework/contracts/database/migration"
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+)
+
+func TestSchemaDrop(t *testing.T) {
+	mockOrm := mocksorm.NewOrm(t)
+	mockQuery := mocksorm.NewQuery(t)
+	mockOrm.EXPECT().Connection("postgres").Return(mockOrm).Twice()
+	mockOrm.EXPECT().Query().Return(mockQuery).Twice()
+	mockQuery.EXPECT().Driver().Return(contractsorm.DriverPostgres).Twice()
+	mockQuery.EXPECT().Exec(`DROP TABLE "users"`).Return(&contractsorm.Result{}, nil).Once()
+	mockQuery.EXPECT().Exec(`DROP TABLE IF EXISTS "users"`).Return(nil, errors.New("error")).Once()
+
+	schema := NewSchema(mockOrm).Connection("postgres")
+	assert.Nil(t, schema.Drop("users"))
+	assert.EqualError(t, schema.DropIfExists("users"), "error")
+}
+
+func TestSchemaCreate(t *testing.T) {
+	mockOrm := mocksorm.NewOrm(t)
+	mockQuery := mocksorm.NewQuery(t)
+	mockTransaction := mocksorm.NewTransaction(t)
+	mockOrm.EXPECT().Connection("").Return(mockOrm).Once()
+	mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Driver().Return(contractsorm.DriverMysql).Once()
+	mockOrm.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(contractsorm.Transaction) error) error {
+		return txFunc(mockTransaction)
+	}).Once()
+	mockTransaction.EXPECT().Exec("CREATE TEMPORARY TABLE `users` (`id` bigint)").Return(&contractsorm.Result{}, nil).Once()
+	mockTransaction.EXPECT().Exec("ALTER TABLE `users` COMMENT = 'users'").Return(&contractsorm.Result{}, nil).Once()
+
+	assert.Nil(t, NewSchema(mockOrm).Create("users", func(table contractsmigration.Blueprint) {
+		table.Column("id", "bigint")
+		table.Comment("users")
+		table.Temporary()
+	}))
+}
+
+func TestSchemaTable(t *testing.T) {
+	mockOrm := mocksorm.NewOrm(t)
+	mockQuery := mocksorm.NewQuery(t)
+	mockTransaction := mocksorm.NewTransaction(t)
+	mockOrm.EXPECT().Connection("").Return(mockOrm).Once()
+	mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Driver().Return(contractsorm.DriverSqlserver).Once()
+	mockOrm.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(contractsorm.Transaction) error) error {
+		return txFunc(mockTransaction)
+	}).Once()
+	mockTransaction.EXPECT().Exec("ALTER TABLE [users] ADD [age] int").Return(&contractsorm.Result{}, nil).Once()
+	mockTransaction.EXPECT().Exec("EXEC sp_rename 'users.name', 'full_name', 'COLUMN'").Return(nil, errors.New("error")).Once()
+
+	assert.EqualError(t, NewSchema(mockOrm).Table("users", func(table contractsmigration.Blueprint) {
+		table.Column("age", "int")
+		table.RenameColumn("name", "full_name")
+		table.Comment("users")
+	}), "error")
+}
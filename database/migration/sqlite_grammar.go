@@ -0,0 +1,43 @@
+package migration
+
+import (
+	"fmt"
+)
+
+type SqliteGrammar struct {
+}
+
+func (r *SqliteGrammar) CompileAdd(blueprint *Blueprint, column, definition string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", r.wrap(blueprint.GetTableName()), r.wrap(column), definition)
+}
+
+// CompileComment SQLite doesn't support the table comments.
+func (r *SqliteGrammar) CompileComment(_ *Blueprint, _ string) string {
+	return ""
+}
+
+func (r *SqliteGrammar) CompileCreate(blueprint *Blueprint) string {
+	create := "CREATE TABLE"
+	if blueprint.temporary {
+		create = "CREATE TEMPORARY TABLE"
+	}
+
+	return fmt.Sprintf("%s %s (%s)", create, r.wrap(blueprint.GetTableName()), columns(blueprint, r.wrap))
+}
+
+func (r *SqliteGrammar) CompileDrop(table string) string {
+	return fmt.Sprintf("DROP TABLE %s", r.wrap(table))
+}
+
+func (r *SqliteGrammar) CompileDropIfExists(table string) string {
+	return fmt.Sprintf("DROP TABLE IF EXISTS %s", r.wrap(table))
+}
+
+// CompileRenameColumn SQLite supports renaming a column in place since 3.25.
+func (r *SqliteGrammar) CompileRenameColumn(blueprint *Blueprint, from, to string) string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", r.wrap(blueprint.GetTableName()), r.wrap(from), r.wrap(to))
+}
+
+func (r *SqliteGrammar) wrap(value string) string {
+	return `"` + value + `"`
+}
@@ -0,0 +1,46 @@
+package migration
+
+import (
+	"fmt"
+)
+
+type SqlserverGrammar struct {
+}
+
+func (r *SqlserverGrammar) CompileAdd(blueprint *Blueprint, column, definition string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD %s %s", r.wrapTable(blueprint), r.wrap(column), definition)
+}
+
+func (r *SqlserverGrammar) CompileComment(blueprint *Blueprint, comment string) string {
+	return fmt.Sprintf("EXEC sp_addextendedproperty 'MS_Description', N%s, 'SCHEMA', 'dbo', 'TABLE', %s",
+		quoteString(comment), quoteString(blueprint.GetTableName()))
+}
+
+// CompileCreate The name of a temporary table is prefixed by #.
+func (r *SqlserverGrammar) CompileCreate(blueprint *Blueprint) string {
+	return fmt.Sprintf("CREATE TABLE %s (%s)", r.wrapTable(blueprint), columns(blueprint, r.wrap))
+}
+
+func (r *SqlserverGrammar) CompileDrop(table string) string {
+	return fmt.Sprintf("DROP TABLE %s", r.wrap(table))
+}
+
+func (r *SqlserverGrammar) CompileDropIfExists(table string) string {
+	return fmt.Sprintf("IF OBJECT_ID(%s, 'U') IS NOT NULL DROP TABLE %s", quoteString(table), r.wrap(table))
+}
+
+func (r *SqlserverGrammar) CompileRenameColumn(blueprint *Blueprint, from, to string) string {
+	return fmt.Sprintf("EXEC sp_rename %s, %s, 'COLUMN'", quoteString(blueprint.GetTableName()+"."+from), quoteString(to))
+}
+
+func (r *SqlserverGrammar) wrap(value string) string {
+	return "[" + value + "]"
+}
+
+func (r *SqlserverGrammar) wrapTable(blueprint *Blueprint) string {
+	if blueprint.temporary {
+		return r.wrap("#" + blueprint.GetTableName())
+	}
+
+	return r.wrap(blueprint.GetTableName())
+}
@@ -82,11 +82,25 @@ func (r *OrmImpl) Factory() ormcontract.Factory {
 	return NewFactoryImpl(r.Query())
 }
 
-func (r *OrmImpl) Observe(model any, observer ormcontract.Observer) {
-	orm.Observers = append(orm.Observers, orm.Observer{
+func (r *OrmImpl) Observe(model any, observer ormcontract.Observer, option ...ormcontract.ObserveOption) {
+	ormObserver := orm.Observer{
 		Model:    model,
 		Observer: observer,
-	})
+	}
+	if len(option) > 0 {
+		ormObserver.Connection = option[0].Connection
+		ormObserver.When = option[0].When
+	}
+
+	orm.Observers = append(orm.Observers, ormObserver)
+}
+
+func (r *OrmImpl) Project(projection ormcontract.Projection) {
+	orm.Projections = append(orm.Projections, projection)
+}
+
+func (r *OrmImpl) Retain(models ...ormcontract.Retention) {
+	orm.Retentions = append(orm.Retentions, models...)
 }
 
 func (r *OrmImpl) Transaction(txFunc func(tx ormcontract.Transaction) error) error {
@@ -106,6 +120,10 @@ func (r *OrmImpl) Transaction(txFunc func(tx ormcontract.Transaction) error) err
 	}
 }
 
+func (r *OrmImpl) UnitOfWork() ormcontract.UnitOfWork {
+	return NewUnitOfWorkImpl(r.Query())
+}
+
 func (r *OrmImpl) WithContext(ctx context.Context) ormcontract.Orm {
 	for _, query := range r.queries {
 		query := query.(*databasegorm.QueryImpl)
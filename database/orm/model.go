@@ -16,9 +16,17 @@ var ErrRecordNotFound = errors.New("record not found")
 
 var Observers = make([]Observer, 0)
 
+var Projections = make([]contractsorm.Projection, 0)
+
+var Retentions = make([]contractsorm.Retention, 0)
+
 type Observer struct {
 	Model    any
 	Observer contractsorm.Observer
+	// Connection the observer only fires for the connection, all connections if empty.
+	Connection string
+	// When the observer only fires if the predicate returns true.
+	When func(contractsorm.Event) bool
 }
 
 type Model struct {
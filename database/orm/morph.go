@@ -0,0 +1,69 @@
+package orm
+
+import (
+	"reflect"
+	"sync"
+)
+
+var morphMap = struct {
+	sync.RWMutex
+	aliases map[reflect.Type]string
+	models  map[string]reflect.Type
+}{
+	aliases: make(map[reflect.Type]string),
+	models:  make(map[string]reflect.Type),
+}
+
+// MorphMap Register the aliases stored in the type column of the polymorphic relations instead of the table
+// names of the models, e.g. orm.MorphMap(map[string]any{"post": &Post{}, "video": &Video{}}).
+func MorphMap(aliases map[string]any) {
+	morphMap.Lock()
+	defer morphMap.Unlock()
+
+	for alias, model := range aliases {
+		modelType := indirectType(model)
+		morphMap.aliases[modelType] = alias
+		morphMap.models[alias] = modelType
+	}
+}
+
+// MorphAlias Get the alias of a model or a model type, it returns an empty string if the model isn't registered.
+func MorphAlias(model any) string {
+	var modelType reflect.Type
+	if value, ok := model.(reflect.Type); ok {
+		modelType = value
+		for modelType.Kind() == reflect.Pointer {
+			modelType = modelType.Elem()
+		}
+	} else {
+		modelType = indirectType(model)
+	}
+
+	morphMap.RLock()
+	defer morphMap.RUnlock()
+
+	return morphMap.aliases[modelType]
+}
+
+// MorphedModel Get a new instance of the model registered by an alias, e.g. to load the owner of a polymorphic
+// relation by its type column, it returns nil if the alias isn't registered.
+func MorphedModel(alias string) any {
+	morphMap.RLock()
+	defer morphMap.RUnlock()
+
+	modelType, ok := morphMap.models[alias]
+	if !ok {
+		return nil
+	}
+
+	return reflect.New(modelType).Interface()
+}
+
+func indirectType(model any) reflect.Type {
+	modelType := reflect.TypeOf(model)
+	for modelType.Kind() == reflect.Pointer {
+		modelType = modelType.Elem()
+	}
+
+	return modelType
+}
@@ -0,0 +1,32 @@
+package orm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type morphPost struct {
+	Model
+}
+
+type morphVideo struct {
+	Model
+}
+
+func TestMorphMap(t *testing.T) {
+	MorphMap(map[string]any{
+		"post":  &morphPost{},
+		"video": morphVideo{},
+	})
+
+	assert.Equal(t, "post", MorphAlias(&morphPost{}))
+	assert.Equal(t, "post", MorphAlias(morphPost{}))
+	assert.Equal(t, "video", MorphAlias(reflect.TypeOf(&morphVideo{})))
+	assert.Empty(t, MorphAlias(&relationUser{}))
+
+	assert.Equal(t, &morphPost{}, MorphedModel("post"))
+	assert.Equal(t, &morphVideo{}, MorphedModel("video"))
+	assert.Nil(t, MorphedModel("user"))
+}
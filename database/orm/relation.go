@@ -0,0 +1,59 @@
+package orm
+
+import (
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+)
+
+// RelationQuery queries the related models of a model through the association API, the related models are
+// returned as T instead of being scanned into a destination of any type.
+type RelationQuery[T any] struct {
+	query contractsorm.Query
+	model any
+	name  string
+}
+
+// Relation Get the typed relation of a model, e.g. orm.Relation[Post](facades.Orm().Query(), &user, "Posts").Get().
+func Relation[T any](query contractsorm.Query, model any, name string) *RelationQuery[T] {
+	return &RelationQuery[T]{
+		query: query,
+		model: model,
+		name:  name,
+	}
+}
+
+// Get Get the related models matching the given conditions.
+func (r *RelationQuery[T]) Get(conds ...any) ([]T, error) {
+	return r.find(r.query, conds...)
+}
+
+// First Get the first related model matching the given conditions, ErrRecordNotFound is returned if there is none.
+func (r *RelationQuery[T]) First(conds ...any) (T, error) {
+	var model T
+	models, err := r.find(r.query.Limit(1), conds...)
+	if err != nil {
+		return model, err
+	}
+	if len(models) == 0 {
+		return model, ErrRecordNotFound
+	}
+
+	return models[0], nil
+}
+
+// Count Get the number of the related models.
+func (r *RelationQuery[T]) Count() int64 {
+	return r.association(r.query).Count()
+}
+
+func (r *RelationQuery[T]) association(query contractsorm.Query) contractsorm.Association {
+	return query.Model(r.model).Association(r.name)
+}
+
+func (r *RelationQuery[T]) find(query contractsorm.Query, conds ...any) ([]T, error) {
+	var models []T
+	if err := r.association(query).Find(&models, conds...); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
@@ -0,0 +1,63 @@
+package orm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	ormmocks "github.com/goravel/framework/mocks/database/orm"
+)
+
+type relationUser struct {
+	Model
+	Posts []*relationPost
+}
+
+type relationPost struct {
+	Model
+	Title string
+}
+
+func TestRelation(t *testing.T) {
+	user := &relationUser{Model: Model{ID: 1}}
+	mockQuery := &ormmocks.Query{}
+	mockAssociation := &ormmocks.Association{}
+	mockQuery.On("Model", user).Return(mockQuery)
+	// First only loads one related model.
+	mockQuery.On("Limit", 1).Return(mockQuery).Twice()
+	mockQuery.On("Association", "Posts").Return(mockAssociation)
+
+	mockAssociation.On("Find", mock.AnythingOfType("*[]orm.relationPost"), "title = ?", "goravel").Run(func(args mock.Arguments) {
+		posts := args.Get(0).(*[]relationPost)
+		*posts = []relationPost{{Model: Model{ID: 1}, Title: "goravel"}}
+	}).Return(nil).Once()
+	posts, err := Relation[relationPost](mockQuery, user, "Posts").Get("title = ?", "goravel")
+	assert.Nil(t, err)
+	assert.Equal(t, []relationPost{{Model: Model{ID: 1}, Title: "goravel"}}, posts)
+
+	mockAssociation.On("Find", mock.AnythingOfType("*[]*orm.relationPost")).Run(func(args mock.Arguments) {
+		posts := args.Get(0).(*[]*relationPost)
+		*posts = []*relationPost{{Model: Model{ID: 2}, Title: "framework"}}
+	}).Return(nil).Once()
+	post, err := Relation[*relationPost](mockQuery, user, "Posts").First()
+	assert.Nil(t, err)
+	assert.Equal(t, "framework", post.Title)
+
+	mockAssociation.On("Find", mock.AnythingOfType("*[]*orm.relationPost")).Return(nil).Once()
+	post, err = Relation[*relationPost](mockQuery, user, "Posts").First()
+	assert.ErrorIs(t, err, ErrRecordNotFound)
+	assert.Nil(t, post)
+
+	mockAssociation.On("Find", mock.AnythingOfType("*[]orm.relationPost")).Return(errors.New("error")).Once()
+	posts, err = Relation[relationPost](mockQuery, user, "Posts").Get()
+	assert.EqualError(t, err, "error")
+	assert.Nil(t, posts)
+
+	mockAssociation.On("Count").Return(int64(2)).Once()
+	assert.Equal(t, int64(2), Relation[relationPost](mockQuery, user, "Posts").Count())
+
+	mockQuery.AssertExpectations(t)
+	mockAssociation.AssertExpectations(t)
+}
@@ -0,0 +1,107 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// pendingTransactionKey The context key of the open transaction, the context of a transaction carries it.
+type pendingTransactionKey struct{}
+
+// PendingTransaction holds the callbacks that are released when the transaction commits.
+type PendingTransaction struct {
+	callbacks []func() error
+	// done The transaction is committed or rolled back, the callbacks registered later run immediately.
+	done  bool
+	mu    sync.Mutex
+	outer *PendingTransaction
+}
+
+// BeginTransaction Track a transaction opened with the context, it returns the context of the transaction. The
+// transaction is nested in the transaction the context comes from, if any.
+func BeginTransaction(ctx context.Context) (*PendingTransaction, context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	transaction := &PendingTransaction{outer: pendingTransaction(ctx)}
+
+	return transaction, context.WithValue(ctx, pendingTransactionKey{}, transaction)
+}
+
+// CommitTransaction Release the callbacks of a committed transaction, the callbacks of a nested transaction
+// are moved to the outer transaction and released when the outer transaction commits.
+func CommitTransaction(transaction *PendingTransaction) error {
+	if transaction == nil {
+		return nil
+	}
+
+	callbacks := transaction.finish()
+	if transaction.outer != nil && transaction.outer.add(callbacks...) {
+		return nil
+	}
+
+	var errs []error
+	for _, callback := range callbacks {
+		if err := callback(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// RollbackTransaction Drop the callbacks of a rolled back transaction.
+func RollbackTransaction(transaction *PendingTransaction) {
+	if transaction == nil {
+		return
+	}
+
+	transaction.finish()
+}
+
+// AfterCommit Run the callback when the transaction the context comes from commits, the callback runs immediately
+// if the context doesn't come from an open transaction.
+func AfterCommit(ctx context.Context, callback func() error) error {
+	if transaction := pendingTransaction(ctx); transaction != nil && transaction.add(callback) {
+		return nil
+	}
+
+	return callback()
+}
+
+// add Add the callbacks to the transaction, it returns false if the transaction is already finished.
+func (r *PendingTransaction) add(callbacks ...func() error) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.done {
+		return false
+	}
+	r.callbacks = append(r.callbacks, callbacks...)
+
+	return true
+}
+
+// finish Mark the transaction as finished and return its callbacks.
+func (r *PendingTransaction) finish() []func() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	callbacks := r.callbacks
+	r.callbacks = nil
+	r.done = true
+
+	return callbacks
+}
+
+func pendingTransaction(ctx context.Context) *PendingTransaction {
+	if ctx == nil {
+		return nil
+	}
+
+	transaction, _ := ctx.Value(pendingTransactionKey{}).(*PendingTransaction)
+
+	return transaction
+}
@@ -0,0 +1,80 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAfterCommit(t *testing.T) {
+	var calls []string
+	callback := func(name string) func() error {
+		return func() error {
+			calls = append(calls, name)
+
+			return nil
+		}
+	}
+
+	// Run immediately without a transaction.
+	assert.Nil(t, AfterCommit(context.Background(), callback("immediately")))
+	assert.Nil(t, AfterCommit(nil, callback("nil")))
+	assert.Equal(t, []string{"immediately", "nil"}, calls)
+
+	// Dropped on rollback.
+	calls = nil
+	transaction, ctx := BeginTransaction(context.Background())
+	assert.Nil(t, AfterCommit(ctx, callback("rollback")))
+	RollbackTransaction(transaction)
+	assert.Nil(t, calls)
+
+	// Run immediately once the transaction is finished.
+	assert.Nil(t, AfterCommit(ctx, callback("finished")))
+	assert.Equal(t, []string{"finished"}, calls)
+
+	// Released on commit, nested transactions are released with the outer transaction.
+	calls = nil
+	outer, outerCtx := BeginTransaction(context.Background())
+	assert.Nil(t, AfterCommit(outerCtx, callback("outer")))
+	inner, innerCtx := BeginTransaction(outerCtx)
+	assert.Nil(t, AfterCommit(innerCtx, callback("inner")))
+	assert.Nil(t, CommitTransaction(inner))
+	rolledBack, rolledBackCtx := BeginTransaction(outerCtx)
+	assert.Nil(t, AfterCommit(rolledBackCtx, callback("rolled back")))
+	RollbackTransaction(rolledBack)
+	assert.Nil(t, calls)
+	assert.Nil(t, CommitTransaction(outer))
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+
+	// The errors of the callbacks are returned.
+	transaction, ctx = BeginTransaction(context.Background())
+	assert.Nil(t, AfterCommit(ctx, func() error {
+		return errors.New("error")
+	}))
+	assert.EqualError(t, CommitTransaction(transaction), "error")
+}
+
+func TestAfterCommitInOtherGoroutine(t *testing.T) {
+	transaction, ctx := BeginTransaction(context.Background())
+
+	// The callbacks registered with the context of the transaction wait for the commit in any goroutine.
+	var called bool
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Nil(t, AfterCommit(ctx, func() error {
+			called = true
+
+			return nil
+		}))
+	}()
+	wg.Wait()
+
+	assert.False(t, called)
+	assert.Nil(t, CommitTransaction(transaction))
+	assert.True(t, called)
+}
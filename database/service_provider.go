@@ -5,9 +5,12 @@ import (
 	"fmt"
 
 	consolecontract "github.com/goravel/framework/contracts/console"
+	filesystemcontract "github.com/goravel/framework/contracts/filesystem"
 	"github.com/goravel/framework/contracts/foundation"
+	schedulecontract "github.com/goravel/framework/contracts/schedule"
 	"github.com/goravel/framework/database/console"
 	"github.com/goravel/framework/database/migration"
+	"github.com/goravel/framework/filesystem"
 )
 
 const BindingOrm = "goravel.orm"
@@ -41,6 +44,7 @@ func (database *ServiceProvider) Register(app foundation.Application) {
 
 func (database *ServiceProvider) Boot(app foundation.Application) {
 	database.registerCommands(app)
+	database.registerSchedule(app)
 }
 
 func (database *ServiceProvider) registerCommands(app foundation.Application) {
@@ -55,10 +59,42 @@ func (database *ServiceProvider) registerCommands(app foundation.Application) {
 		console.NewMigrateRefreshCommand(config, artisan),
 		console.NewMigrateFreshCommand(config, artisan),
 		console.NewMigrateStatusCommand(config),
-		console.NewModelMakeCommand(),
+		console.NewModelMakeCommand(artisan),
 		console.NewObserverMakeCommand(),
 		console.NewSeedCommand(config, seeder),
 		console.NewSeederMakeCommand(),
 		console.NewFactoryMakeCommand(),
+		console.NewProjectionRebuildCommand(config),
+		console.NewEnumGenerateCommand(config),
+		console.NewSchemaDiffCommand(config),
+		console.NewModelPruneCommand(config, database.storage(app)),
+	})
+}
+
+// storage Get the storage to archive the pruned models if the filesystem is registered, it's optional.
+func (database *ServiceProvider) storage(app foundation.Application) filesystemcontract.Storage {
+	instance, err := app.Make(filesystem.Binding)
+	if err != nil {
+		return nil
+	}
+	storage, _ := instance.(filesystemcontract.Storage)
+
+	return storage
+}
+
+// registerSchedule Schedule the model:prune command if the database.retention.schedule cron expression is set.
+func (database *ServiceProvider) registerSchedule(app foundation.Application) {
+	expression := app.MakeConfig().GetString("database.retention.schedule")
+	if expression == "" {
+		return
+	}
+
+	schedule := app.MakeSchedule()
+	if schedule == nil {
+		return
+	}
+
+	schedule.Register([]schedulecontract.Event{
+		schedule.Command("model:prune").Cron(expression).SkipIfStillRunning().OnOneServer(),
 	})
 }
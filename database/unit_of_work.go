@@ -0,0 +1,140 @@
+package database
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	ormcontract "github.com/goravel/framework/contracts/database/orm"
+)
+
+type unitOfWorkOperation int
+
+const (
+	unitOfWorkCreate unitOfWorkOperation = iota
+	unitOfWorkUpdate
+	unitOfWorkDelete
+)
+
+type unitOfWorkEntry struct {
+	operation unitOfWorkOperation
+	value     any
+}
+
+type UnitOfWorkImpl struct {
+	entries []unitOfWorkEntry
+	mu      sync.Mutex
+	query   ormcontract.Query
+}
+
+func NewUnitOfWorkImpl(query ormcontract.Query) *UnitOfWorkImpl {
+	return &UnitOfWorkImpl{
+		query: query,
+	}
+}
+
+// Create registers a model to be inserted when the unit of work is flushed.
+func (r *UnitOfWorkImpl) Create(value any) ormcontract.UnitOfWork {
+	return r.register(unitOfWorkCreate, value)
+}
+
+// Update registers a model to be saved when the unit of work is flushed.
+func (r *UnitOfWorkImpl) Update(value any) ormcontract.UnitOfWork {
+	return r.register(unitOfWorkUpdate, value)
+}
+
+// Delete registers a model to be deleted when the unit of work is flushed.
+func (r *UnitOfWorkImpl) Delete(value any) ormcontract.UnitOfWork {
+	return r.register(unitOfWorkDelete, value)
+}
+
+// Discard drops all pending operations without touching the database.
+func (r *UnitOfWorkImpl) Discard() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = nil
+}
+
+// Flush writes all pending operations in a single transaction. Creates run first in the
+// order they were registered, so parents registered before their children are inserted
+// first, then updates, then deletes in reverse order so children are removed before parents.
+// The pending operations are kept if the transaction fails, so Flush can be retried.
+func (r *UnitOfWorkImpl) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.entries) == 0 {
+		return nil
+	}
+
+	tx, err := r.query.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := r.flush(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Wrapf(err, "rollback error: %v", rollbackErr)
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	r.entries = nil
+
+	return nil
+}
+
+// Pending returns the number of operations waiting to be flushed.
+func (r *UnitOfWorkImpl) Pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.entries)
+}
+
+func (r *UnitOfWorkImpl) flush(tx ormcontract.Transaction) error {
+	for _, entry := range r.entries {
+		if entry.operation != unitOfWorkCreate {
+			continue
+		}
+		if err := tx.Create(entry.value); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range r.entries {
+		if entry.operation != unitOfWorkUpdate {
+			continue
+		}
+		if err := tx.Save(entry.value); err != nil {
+			return err
+		}
+	}
+
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		entry := r.entries[i]
+		if entry.operation != unitOfWorkDelete {
+			continue
+		}
+		if _, err := tx.Delete(entry.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *UnitOfWorkImpl) register(operation unitOfWorkOperation, value any) ormcontract.UnitOfWork {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, unitOfWorkEntry{operation: operation, value: value})
+
+	return r
+}
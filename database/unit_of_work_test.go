@@ -0,0 +1,114 @@
+package database
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	ormcontract "github.com/goravel/framework/contracts/database/orm"
+	ormmock "github.com/goravel/framework/mocks/database/orm"
+)
+
+type unitOfWorkParent struct {
+	ID uint
+}
+
+type unitOfWorkChild struct {
+	ID       uint
+	ParentID uint
+}
+
+func TestUnitOfWorkFlush(t *testing.T) {
+	var (
+		mockQuery *ormmock.Query
+		mockTx    *ormmock.Transaction
+	)
+
+	parent := &unitOfWorkParent{}
+	child := &unitOfWorkChild{}
+	oldParent := &unitOfWorkParent{ID: 1}
+	oldChild := &unitOfWorkChild{ID: 2}
+
+	beforeEach := func() {
+		mockQuery = &ormmock.Query{}
+		mockTx = &ormmock.Transaction{}
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(unitOfWork *UnitOfWorkImpl)
+		expectErr error
+		pending   int
+	}{
+		{
+			name: "no pending operations",
+			setup: func(unitOfWork *UnitOfWorkImpl) {
+			},
+		},
+		{
+			name: "creates, updates and deletes are ordered",
+			setup: func(unitOfWork *UnitOfWorkImpl) {
+				unitOfWork.Delete(oldParent).Delete(oldChild).Update(parent).Create(parent).Create(child)
+
+				var order []string
+				mockQuery.On("Begin").Return(mockTx, nil).Once()
+				mockTx.On("Create", parent).Run(func(args mock.Arguments) { order = append(order, "create parent") }).Return(nil).Once()
+				mockTx.On("Create", child).Run(func(args mock.Arguments) { order = append(order, "create child") }).Return(nil).Once()
+				mockTx.On("Save", parent).Run(func(args mock.Arguments) { order = append(order, "update parent") }).Return(nil).Once()
+				mockTx.On("Delete", oldChild).Run(func(args mock.Arguments) { order = append(order, "delete child") }).Return(&ormcontract.Result{}, nil).Once()
+				mockTx.On("Delete", oldParent).Run(func(args mock.Arguments) { order = append(order, "delete parent") }).Return(&ormcontract.Result{}, nil).Once()
+				mockTx.On("Commit").Run(func(args mock.Arguments) {
+					assert.Equal(t, []string{"create parent", "create child", "update parent", "delete child", "delete parent"}, order)
+				}).Return(nil).Once()
+			},
+		},
+		{
+			name: "rollback and keep pending operations when a write fails",
+			setup: func(unitOfWork *UnitOfWorkImpl) {
+				unitOfWork.Create(parent).Create(child)
+
+				mockQuery.On("Begin").Return(mockTx, nil).Once()
+				mockTx.On("Create", parent).Return(errors.New("error")).Once()
+				mockTx.On("Rollback").Return(nil).Once()
+			},
+			expectErr: errors.New("error"),
+			pending:   2,
+		},
+		{
+			name: "begin fails",
+			setup: func(unitOfWork *UnitOfWorkImpl) {
+				unitOfWork.Create(parent)
+
+				mockQuery.On("Begin").Return(nil, errors.New("error")).Once()
+			},
+			expectErr: errors.New("error"),
+			pending:   1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			unitOfWork := NewUnitOfWorkImpl(mockQuery)
+			test.setup(unitOfWork)
+
+			assert.Equal(t, test.expectErr, unitOfWork.Flush())
+			assert.Equal(t, test.pending, unitOfWork.Pending())
+
+			mockQuery.AssertExpectations(t)
+			mockTx.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUnitOfWorkDiscard(t *testing.T) {
+	unitOfWork := NewUnitOfWorkImpl(&ormmock.Query{})
+	unitOfWork.Create(&unitOfWorkParent{}).Update(&unitOfWorkParent{ID: 1})
+	assert.Equal(t, 2, unitOfWork.Pending())
+
+	unitOfWork.Discard()
+	assert.Equal(t, 0, unitOfWork.Pending())
+	assert.Nil(t, unitOfWork.Flush())
+}
@@ -6,8 +6,9 @@ import (
 )
 
 type Application struct {
-	events map[event.Event][]event.Listener
-	queue  queuecontract.Queue
+	events    map[event.Event][]event.Listener
+	publisher publisher
+	queue     queuecontract.Queue
 }
 
 func NewApplication(queue queuecontract.Queue) *Application {
@@ -16,6 +17,11 @@ func NewApplication(queue queuecontract.Queue) *Application {
 	}
 }
 
+// SetPublisher Set the publisher of the events published to Kafka, e.g. queue.KafkaProducer.
+func (app *Application) SetPublisher(publisher publisher) {
+	app.publisher = publisher
+}
+
 func (app *Application) Register(events map[event.Event][]event.Listener) {
 	var jobs []queuecontract.Job
 
@@ -43,5 +49,8 @@ func (app *Application) Job(e event.Event, args []event.Arg) event.Task {
 		listeners = make([]event.Listener, 0)
 	}
 
-	return NewTask(app.queue, args, e, listeners)
+	task := NewTask(app.queue, args, e, listeners)
+	task.publisher = app.publisher
+
+	return task
 }
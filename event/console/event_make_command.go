@@ -50,13 +50,13 @@ func (receiver *EventMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Event created successfully")
+	color.Green().Printf("Event [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *EventMakeCommand) getStub() string {
-	return Stubs{}.Event()
+	return supportconsole.Stub("event.stub", Stubs{}.Event())
 }
 
 // populateStub Populate the place-holders in the command stub.
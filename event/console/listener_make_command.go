@@ -51,13 +51,13 @@ func (receiver *ListenerMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Listener created successfully")
+	color.Green().Printf("Listener [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *ListenerMakeCommand) getStub() string {
-	return Stubs{}.Listener()
+	return supportconsole.Stub("listener.stub", Stubs{}.Listener())
 }
 
 // populateStub Populate the place-holders in the command stub.
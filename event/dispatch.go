@@ -0,0 +1,23 @@
+package event
+
+import (
+	"reflect"
+
+	"github.com/goravel/framework/contracts/event"
+)
+
+// DispatchIfListened Dispatch the event if the instance has registered listeners for an event of its type, the
+// registered event is dispatched, so its listeners receive it. Nothing is dispatched if the instance is nil.
+func DispatchIfListened(instance event.Instance, e event.Event, args []event.Arg) error {
+	if instance == nil {
+		return nil
+	}
+
+	for registered := range instance.GetEvents() {
+		if reflect.TypeOf(registered) == reflect.TypeOf(e) {
+			return instance.Job(registered, args).Dispatch()
+		}
+	}
+
+	return nil
+}
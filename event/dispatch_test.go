@@ -0,0 +1,39 @@
+package event
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/event"
+	eventmock "github.com/goravel/framework/mocks/event"
+)
+
+type TestListenedEvent struct {
+}
+
+func (receiver *TestListenedEvent) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+func TestDispatchIfListened(t *testing.T) {
+	assert.Nil(t, DispatchIfListened(nil, &TestListenedEvent{}, nil))
+
+	// The event isn't listened.
+	mockInstance := eventmock.NewInstance(t)
+	mockInstance.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{}).Once()
+	assert.Nil(t, DispatchIfListened(mockInstance, &TestListenedEvent{}, nil))
+
+	// The registered event is dispatched.
+	registered := &TestListenedEvent{}
+	args := []event.Arg{{Type: "string", Value: "goravel"}}
+	mockTask := eventmock.NewTask(t)
+	mockInstance.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{registered: nil}).Twice()
+	mockInstance.EXPECT().Job(registered, args).Return(mockTask).Twice()
+	mockTask.EXPECT().Dispatch().Return(nil).Once()
+	assert.Nil(t, DispatchIfListened(mockInstance, &TestListenedEvent{}, args))
+
+	mockTask.EXPECT().Dispatch().Return(errors.New("failed")).Once()
+	assert.EqualError(t, DispatchIfListened(mockInstance, &TestListenedEvent{}, args), "failed")
+}
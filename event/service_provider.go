@@ -2,6 +2,7 @@ package event
 
 import (
 	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/foundation"
 	eventConsole "github.com/goravel/framework/event/console"
 )
@@ -18,6 +19,21 @@ func (receiver *ServiceProvider) Register(app foundation.Application) {
 }
 
 func (receiver *ServiceProvider) Boot(app foundation.Application) {
+	events := func() event.Instance {
+		instance, err := app.Make(Binding)
+		if err != nil {
+			return nil
+		}
+		events, _ := instance.(event.Instance)
+
+		return events
+	}
+
+	// The cache dispatches the StoreDegraded and StoreRecovered events of the stores falling back to the memory.
+	if cacheApp, ok := app.MakeCache().(interface{ SetEvents(func() event.Instance) }); ok {
+		cacheApp.SetEvents(events)
+	}
+
 	receiver.registerCommands(app)
 }
 
@@ -1,17 +1,31 @@
 package event
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+
+	"github.com/segmentio/kafka-go"
 
 	"github.com/goravel/framework/contracts/event"
 	queuecontract "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/database/orm"
 )
 
+// publisher writes the messages to a Kafka queue connection, it's implemented by queue.KafkaProducer.
+type publisher interface {
+	Publish(ctx context.Context, connection string, messages ...kafka.Message) error
+}
+
 type Task struct {
-	args      []event.Arg
-	event     event.Event
-	listeners []event.Listener
-	queue     queuecontract.Queue
+	afterCommit *bool
+	args        []event.Arg
+	ctx         context.Context
+	event       event.Event
+	listeners   []event.Listener
+	publisher   publisher
+	queue       queuecontract.Queue
 }
 
 func NewTask(queue queuecontract.Queue, args []event.Arg, event event.Event, listeners []event.Listener) *Task {
@@ -24,10 +38,52 @@ func NewTask(queue queuecontract.Queue, args []event.Arg, event event.Event, lis
 }
 
 func (receiver *Task) Dispatch() error {
-	if len(receiver.listeners) == 0 {
+	if _, ok := receiver.event.(event.ShouldPublish); !ok && len(receiver.listeners) == 0 {
 		return fmt.Errorf("event %v doesn't bind listeners", receiver.event)
 	}
 
+	if receiver.shouldDispatchAfterCommit() {
+		return orm.AfterCommit(receiver.ctx, receiver.dispatch)
+	}
+
+	return receiver.dispatch()
+}
+
+func (receiver *Task) AfterCommit() event.Task {
+	afterCommit := true
+	receiver.afterCommit = &afterCommit
+
+	return receiver
+}
+
+// WithContext Set the context of the task, the task is dispatched after the transaction the context comes from
+// commits if it should be, e.g. the context of orm.Transaction.
+func (receiver *Task) WithContext(ctx context.Context) event.Task {
+	receiver.ctx = ctx
+
+	return receiver
+}
+
+func (receiver *Task) BeforeCommit() event.Task {
+	afterCommit := false
+	receiver.afterCommit = &afterCommit
+
+	return receiver
+}
+
+// shouldDispatchAfterCommit Determine whether the event should be dispatched after the open transaction commits,
+// AfterCommit and BeforeCommit override the event.
+func (receiver *Task) shouldDispatchAfterCommit() bool {
+	if receiver.afterCommit != nil {
+		return *receiver.afterCommit
+	}
+
+	afterCommit, ok := receiver.event.(event.ShouldDispatchAfterCommit)
+
+	return ok && afterCommit.DispatchAfterCommit()
+}
+
+func (receiver *Task) dispatch() error {
 	handledArgs, err := receiver.event.Handle(receiver.args)
 	if err != nil {
 		return err
@@ -59,9 +115,47 @@ func (receiver *Task) Dispatch() error {
 		}
 	}
 
+	if shouldPublish, ok := receiver.event.(event.ShouldPublish); ok {
+		return receiver.publish(shouldPublish, handledArgs)
+	}
+
 	return nil
 }
 
+// publish Write the name and the handled arguments of the event to its topic, the name is set to the
+// "event" header as well, so the consumers can filter the events without decoding them.
+func (receiver *Task) publish(shouldPublish event.ShouldPublish, args []event.Arg) error {
+	if receiver.publisher == nil {
+		return fmt.Errorf("event %v can't be published without a publisher", receiver.event)
+	}
+
+	name := eventName(receiver.event)
+	value, err := json.Marshal(map[string]any{
+		"event": name,
+		"args":  args,
+	})
+	if err != nil {
+		return err
+	}
+
+	connection, topic := shouldPublish.PublishOn()
+
+	return receiver.publisher.Publish(context.Background(), connection, kafka.Message{
+		Topic:   topic,
+		Value:   value,
+		Headers: []kafka.Header{{Key: "event", Value: []byte(name)}},
+	})
+}
+
+func eventName(e event.Event) string {
+	t := reflect.TypeOf(e)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
 func eventArgsToQueueArgs(args []event.Arg) []queuecontract.Arg {
 	var queueArgs []queuecontract.Arg
 	for _, arg := range args {
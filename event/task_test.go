@@ -1,13 +1,16 @@
 package event
 
 import (
+	"context"
 	"errors"
 	"testing"
 
+	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/goravel/framework/contracts/event"
 	queuecontract "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/database/orm"
 	queuemock "github.com/goravel/framework/mocks/queue"
 )
 
@@ -96,3 +99,89 @@ func TestDispatch(t *testing.T) {
 		})
 	}
 }
+
+func TestDispatchAfterCommit(t *testing.T) {
+	mockQueue := &queuemock.Queue{}
+	mockTask := &queuemock.Task{}
+	listener := &TestListener{}
+	args := []event.Arg{{Type: "string", Value: "test"}}
+
+	// Dropped when the transaction rolls back.
+	transaction, ctx := orm.BeginTransaction(context.Background())
+	assert.Nil(t, NewTask(mockQueue, args, &TestAfterCommitEvent{}, []event.Listener{listener}).WithContext(ctx).Dispatch())
+	orm.RollbackTransaction(transaction)
+	mockQueue.AssertNotCalled(t, "Job", listener, []queuecontract.Arg{{Type: "string", Value: "test"}})
+
+	// Released when the transaction commits.
+	transaction, ctx = orm.BeginTransaction(context.Background())
+	assert.Nil(t, NewTask(mockQueue, args, &TestAfterCommitEvent{}, []event.Listener{listener}).WithContext(ctx).Dispatch())
+	mockQueue.AssertNotCalled(t, "Job", listener, []queuecontract.Arg{{Type: "string", Value: "test"}})
+
+	mockQueue.On("Job", listener, []queuecontract.Arg{{Type: "string", Value: "test"}}).Return(mockTask).Once()
+	mockTask.On("DispatchSync").Return(nil).Once()
+	assert.Nil(t, orm.CommitTransaction(transaction))
+
+	mockQueue.AssertExpectations(t)
+	mockTask.AssertExpectations(t)
+}
+
+func TestDispatchAfterCommit_Override(t *testing.T) {
+	mockQueue := &queuemock.Queue{}
+	mockTask := &queuemock.Task{}
+	listener := &TestListener{}
+	args := []event.Arg{{Type: "string", Value: "test"}}
+
+	// AfterCommit defers an event that doesn't implement ShouldDispatchAfterCommit.
+	transaction, ctx := orm.BeginTransaction(context.Background())
+	assert.Nil(t, NewTask(mockQueue, args, &TestEvent{}, []event.Listener{listener}).AfterCommit().WithContext(ctx).Dispatch())
+	orm.RollbackTransaction(transaction)
+	mockQueue.AssertNotCalled(t, "Job", listener, []queuecontract.Arg{{Type: "string", Value: "test"}})
+
+	// BeforeCommit dispatches an event implementing ShouldDispatchAfterCommit immediately.
+	transaction, ctx = orm.BeginTransaction(context.Background())
+	mockQueue.On("Job", listener, []queuecontract.Arg{{Type: "string", Value: "test"}}).Return(mockTask).Once()
+	mockTask.On("DispatchSync").Return(nil).Once()
+	assert.Nil(t, NewTask(mockQueue, args, &TestAfterCommitEvent{}, []event.Listener{listener}).BeforeCommit().WithContext(ctx).Dispatch())
+	orm.RollbackTransaction(transaction)
+
+	mockQueue.AssertExpectations(t)
+	mockTask.AssertExpectations(t)
+}
+
+type testPublisher struct {
+	connection string
+	messages   []kafka.Message
+	err        error
+}
+
+func (r *testPublisher) Publish(ctx context.Context, connection string, messages ...kafka.Message) error {
+	r.connection = connection
+	r.messages = append(r.messages, messages...)
+
+	return r.err
+}
+
+func TestDispatchPublish(t *testing.T) {
+	mockQueue := &queuemock.Queue{}
+	args := []event.Arg{{Type: "string", Value: "test"}}
+
+	// The events are published without listeners.
+	publisher := &testPublisher{}
+	task := NewTask(mockQueue, args, &TestPublishEvent{}, nil)
+	task.publisher = publisher
+	assert.Nil(t, task.Dispatch())
+	assert.Equal(t, "kafka", publisher.connection)
+	assert.Len(t, publisher.messages, 1)
+	assert.Equal(t, "orders", publisher.messages[0].Topic)
+	assert.Equal(t, []kafka.Header{{Key: "event", Value: []byte("TestPublishEvent")}}, publisher.messages[0].Headers)
+	assert.JSONEq(t, `{"event":"TestPublishEvent","args":[{"Type":"string","Value":"test"}]}`, string(publisher.messages[0].Value))
+
+	task = NewTask(mockQueue, args, &TestPublishEvent{}, nil)
+	task.publisher = &testPublisher{err: errors.New("error")}
+	assert.EqualError(t, task.Dispatch(), "error")
+
+	task = NewTask(mockQueue, args, &TestPublishEvent{}, nil)
+	assert.NotNil(t, task.Dispatch())
+
+	mockQueue.AssertExpectations(t)
+}
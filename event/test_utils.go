@@ -65,3 +65,23 @@ func (receiver *TestListenerHandleError) Queue(args ...any) event.Queue {
 func (receiver *TestListenerHandleError) Handle(args ...any) error {
 	return errors.New("error")
 }
+
+type TestAfterCommitEvent struct{}
+
+func (receiver *TestAfterCommitEvent) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+func (receiver *TestAfterCommitEvent) DispatchAfterCommit() bool {
+	return true
+}
+
+type TestPublishEvent struct{}
+
+func (receiver *TestPublishEvent) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+func (receiver *TestPublishEvent) PublishOn() (string, string) {
+	return "kafka", "orders"
+}
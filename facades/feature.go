@@ -0,0 +1,9 @@
+package facades
+
+import (
+	"github.com/goravel/framework/contracts/feature"
+)
+
+func Feature() feature.Feature {
+	return App().MakeFeature()
+}
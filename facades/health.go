@@ -0,0 +1,9 @@
+package facades
+
+import (
+	"github.com/goravel/framework/contracts/health"
+)
+
+func Health() health.Health {
+	return App().MakeHealth()
+}
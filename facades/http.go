@@ -2,12 +2,21 @@ package facades
 
 import (
 	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/http/client"
 )
 
+func Http() client.Factory {
+	return App().MakeHttp()
+}
+
 func RateLimiter() http.RateLimiter {
 	return App().MakeRateLimiter()
 }
 
+func ResponseTransformer() http.ResponseTransformer {
+	return App().MakeResponseTransformer()
+}
+
 func View() http.View {
 	return App().MakeView()
 }
@@ -0,0 +1,9 @@
+package facades
+
+import (
+	"github.com/goravel/framework/contracts/id"
+)
+
+func ID() id.ID {
+	return App().MakeID()
+}
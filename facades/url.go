@@ -0,0 +1,9 @@
+package facades
+
+import (
+	"github.com/goravel/framework/contracts/route"
+)
+
+func URL() route.URL {
+	return App().MakeURL()
+}
@@ -0,0 +1,9 @@
+package facades
+
+import (
+	"github.com/goravel/framework/contracts/websocket"
+)
+
+func WebSocket() websocket.Hub {
+	return App().MakeWebSocket()
+}
@@ -0,0 +1,74 @@
+package feature
+
+import (
+	"context"
+	"sync"
+
+	"github.com/goravel/framework/contracts/config"
+	contractsfeature "github.com/goravel/framework/contracts/feature"
+)
+
+type Application struct {
+	config    config.Config
+	resolvers map[string]contractsfeature.Resolver
+	overrides map[string]bool
+	mu        sync.RWMutex
+}
+
+func NewApplication(config config.Config) *Application {
+	return &Application{
+		config:    config,
+		resolvers: make(map[string]contractsfeature.Resolver),
+		overrides: make(map[string]bool),
+	}
+}
+
+// Active Determine whether a feature is active, the activation or deactivation of the feature is checked first,
+// then its resolver, then the feature.flags.{name} configuration, the undefined features are inactive.
+func (app *Application) Active(ctx context.Context, name string) bool {
+	app.mu.RLock()
+	active, overridden := app.overrides[name]
+	resolver := app.resolvers[name]
+	app.mu.RUnlock()
+
+	if overridden {
+		return active
+	}
+	if resolver != nil {
+		return resolver(ctx)
+	}
+
+	return app.config.GetBool("feature.flags." + name)
+}
+
+func (app *Application) Inactive(ctx context.Context, name string) bool {
+	return !app.Active(ctx, name)
+}
+
+func (app *Application) Define(name string, resolver contractsfeature.Resolver) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	app.resolvers[name] = resolver
+}
+
+func (app *Application) Activate(name string) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	app.overrides[name] = true
+}
+
+func (app *Application) Deactivate(name string) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	app.overrides[name] = false
+}
+
+func (app *Application) Forget(name string) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	delete(app.overrides, name)
+}
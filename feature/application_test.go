@@ -0,0 +1,36 @@
+package feature
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	configmocks "github.com/goravel/framework/mocks/config"
+)
+
+func TestApplication(t *testing.T) {
+	ctx := context.Background()
+	mockConfig := configmocks.NewConfig(t)
+	app := NewApplication(mockConfig)
+
+	mockConfig.EXPECT().GetBool("feature.flags.new-checkout").Return(true).Once()
+	assert.True(t, app.Active(ctx, "new-checkout"))
+	mockConfig.EXPECT().GetBool("feature.flags.new-search").Return(false).Once()
+	assert.True(t, app.Inactive(ctx, "new-search"))
+
+	app.Define("new-search", func(ctx context.Context) bool {
+		return ctx.Value("user") == "admin"
+	})
+	assert.False(t, app.Active(ctx, "new-search"))
+	assert.True(t, app.Active(context.WithValue(ctx, "user", "admin"), "new-search"))
+
+	app.Deactivate("new-checkout")
+	assert.False(t, app.Active(ctx, "new-checkout"))
+	app.Activate("new-search")
+	assert.True(t, app.Active(ctx, "new-search"))
+
+	app.Forget("new-checkout")
+	mockConfig.EXPECT().GetBool("feature.flags.new-checkout").Return(true).Once()
+	assert.True(t, app.Active(ctx, "new-checkout"))
+}
@@ -0,0 +1,25 @@
+package feature
+
+import (
+	contractsfeature "github.com/goravel/framework/contracts/feature"
+	"github.com/goravel/framework/contracts/foundation"
+)
+
+const Binding = "goravel.feature"
+
+// Facade The feature flags used by the framework to gate the routes and the jobs, it's nil if the service provider
+// isn't registered.
+var Facade contractsfeature.Feature
+
+type ServiceProvider struct {
+}
+
+func (feature *ServiceProvider) Register(app foundation.Application) {
+	app.Singleton(Binding, func(app foundation.Application) (any, error) {
+		return NewApplication(app.MakeConfig()), nil
+	})
+}
+
+func (feature *ServiceProvider) Boot(app foundation.Application) {
+	Facade = app.MakeFeature()
+}
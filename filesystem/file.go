@@ -16,6 +16,13 @@ import (
 	"github.com/goravel/framework/support/str"
 )
 
+var (
+	// ErrFileTooLarge is returned by File.Validate when the file size exceeds the max size.
+	ErrFileTooLarge = errors.New("the file is too large")
+	// ErrFileMimeType is returned by File.Validate when the mime type of the file isn't allowed.
+	ErrFileMimeType = errors.New("the mime type of the file isn't allowed")
+)
+
 type File struct {
 	config  config.Config
 	disk    string
@@ -129,3 +136,34 @@ func (f *File) Store(path string) (string, error) {
 func (f *File) StoreAs(path string, name string) (string, error) {
 	return f.storage.Disk(f.disk).PutFileAs(path, f, name)
 }
+
+// Validate The mime type is detected from the content instead of the client extension, so a renamed file can't
+// bypass the check.
+func (f *File) Validate(maxSize int64, mimeTypes ...string) error {
+	if maxSize > 0 {
+		size, err := f.Size()
+		if err != nil {
+			return err
+		}
+		if size > maxSize {
+			return fmt.Errorf("%w: %d bytes exceeds %d bytes", ErrFileTooLarge, size, maxSize)
+		}
+	}
+
+	if len(mimeTypes) == 0 {
+		return nil
+	}
+
+	mimeType, err := f.MimeType()
+	if err != nil {
+		return err
+	}
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	for _, allowed := range mimeTypes {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); (ok && strings.HasPrefix(mimeType, prefix)) || mimeType == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrFileMimeType, mimeType)
+}
@@ -61,6 +61,17 @@ func (s *FileTestSuite) TestExtension() {
 	s.Nil(err)
 }
 
+func (s *FileTestSuite) TestValidate() {
+	size, err := s.file.Size()
+	s.Nil(err)
+
+	s.Nil(s.file.Validate(0))
+	s.Nil(s.file.Validate(size, "text/plain"))
+	s.Nil(s.file.Validate(size, "image/*", "text/*"))
+	s.ErrorIs(s.file.Validate(size-1), ErrFileTooLarge)
+	s.ErrorIs(s.file.Validate(0, "image/*"), ErrFileMimeType)
+}
+
 func TestNewFileFromRequest(t *testing.T) {
 	mockConfig := &configmock.Config{}
 	ConfigFacade = mockConfig
@@ -2,8 +2,11 @@ package filesystem
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -15,6 +18,9 @@ import (
 	"github.com/goravel/framework/support/str"
 )
 
+// ErrPreconditionFailed is returned by the conditional writes when the condition of the file isn't met.
+var ErrPreconditionFailed = errors.New("the precondition of the file failed")
+
 type Local struct {
 	config config.Config
 	root   string
@@ -149,10 +155,29 @@ func (r *Local) GetBytes(file string) ([]byte, error) {
 	return data, nil
 }
 
+func (r *Local) ETag(file string) (string, error) {
+	f, err := os.Open(r.fullPath(file))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func (r *Local) LastModified(file string) (time.Time, error) {
 	return supportfile.LastModified(r.fullPath(file), r.config.GetString("app.timezone"))
 }
 
+func (r *Local) Lock(file string) (func() error, error) {
+	return r.lock(file, true)
+}
+
 func (r *Local) MakeDirectory(directory string) error {
 	return os.MkdirAll(filepath.Dir(r.fullPath(directory)+string(filepath.Separator)), os.ModePerm)
 }
@@ -182,41 +207,90 @@ func (r *Local) Path(file string) string {
 	return r.fullPath(file)
 }
 
+// Put Write the contents to a temporary file and rename it to the file, so readers never see a partially written file.
 func (r *Local) Put(file, content string) error {
 	file = r.fullPath(file)
-	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+	temp, err := r.writeTemp(file, strings.NewReader(content))
+	if err != nil {
 		return err
 	}
 
-	f, err := os.Create(file)
+	if err := os.Rename(temp, file); err != nil {
+		_ = os.Remove(temp)
+
+		return err
+	}
+
+	return nil
+}
+
+// PutIfAbsent Link the temporary file to the file, the link fails if the file exists, so the check and the write are atomic.
+func (r *Local) PutIfAbsent(file, content string) error {
+	file = r.fullPath(file)
+	temp, err := r.writeTemp(file, strings.NewReader(content))
 	if err != nil {
 		return err
 	}
-	defer f.Close()
+	defer os.Remove(temp)
+
+	if err := os.Link(temp, file); err != nil {
+		if os.IsExist(err) {
+			return ErrPreconditionFailed
+		}
 
-	if _, err = f.WriteString(content); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func (r *Local) PutIfMatch(file, content, etag string) error {
+	unlock, err := r.lock(file, false)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrPreconditionFailed
+		}
+
+		return err
+	}
+	defer unlock()
+
+	current, err := r.ETag(file)
+	if err != nil {
+		return err
+	}
+	if current != strings.Trim(etag, `"`) {
+		return ErrPreconditionFailed
+	}
+
+	return r.Put(file, content)
+}
+
 func (r *Local) PutFile(filePath string, source filesystem.File) (string, error) {
 	return r.PutFileAs(filePath, source, str.Random(40))
 }
 
+// PutFileAs Copy the file to the disk as a stream, so a large upload isn't loaded into the memory.
 func (r *Local) PutFileAs(filePath string, source filesystem.File, name string) (string, error) {
-	data, err := os.ReadFile(source.File())
+	src, err := os.Open(source.File())
 	if err != nil {
 		return "", err
 	}
+	defer src.Close()
 
 	fullPath, err := fullPathOfFile(filePath, source, name)
 	if err != nil {
 		return "", err
 	}
 
-	if err := r.Put(fullPath, string(data)); err != nil {
+	file := r.fullPath(fullPath)
+	temp, err := r.writeTemp(file, src)
+	if err != nil {
+		return "", err
+	}
+	if err := os.Rename(temp, file); err != nil {
+		_ = os.Remove(temp)
+
 		return "", err
 	}
 
@@ -239,6 +313,98 @@ func (r *Local) Url(file string) string {
 	return strings.TrimSuffix(r.url, "/") + "/" + strings.TrimPrefix(filepath.ToSlash(file), "/")
 }
 
+// lock Acquire an exclusive lock of the file, the file may be replaced by an atomic write while waiting
+// for the lock, the new file is locked in this case.
+func (r *Local) lock(file string, create bool) (func() error, error) {
+	file = r.fullPath(file)
+	flag := os.O_RDWR
+	if create {
+		if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+			return nil, err
+		}
+		flag |= os.O_CREATE
+	}
+
+	for {
+		f, err := os.OpenFile(file, flag, 0644)
+		if err != nil {
+			return nil, err
+		}
+		if err := lockFile(f); err != nil {
+			_ = f.Close()
+
+			return nil, err
+		}
+
+		locked, err := f.Stat()
+		if err != nil {
+			_ = unlockFile(f)
+			_ = f.Close()
+
+			return nil, err
+		}
+		current, err := os.Stat(file)
+		if err == nil && os.SameFile(locked, current) {
+			return func() error {
+				if err := unlockFile(f); err != nil {
+					_ = f.Close()
+
+					return err
+				}
+
+				return f.Close()
+			}, nil
+		}
+
+		_ = unlockFile(f)
+		_ = f.Close()
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err != nil && !create {
+			return nil, err
+		}
+	}
+}
+
+// writeTemp Write the contents to a temporary file in the directory of the file, the permission of
+// the existing file is kept.
+func (r *Local) writeTemp(file string, content io.Reader) (string, error) {
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(file); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	if _, err := io.Copy(f, content); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+
+		return "", err
+	}
+	if err := f.Chmod(mode); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
 func (r *Local) fullPath(path string) string {
 	realPath := filepath.Clean(path)
 
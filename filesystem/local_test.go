@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -207,6 +208,20 @@ func (s *LocalTestSuite) TestDirectories() {
 	s.Nil(s.local.DeleteDirectory("Directories"))
 }
 
+func (s *LocalTestSuite) TestETag() {
+	etag, err := s.local.ETag("test.txt")
+	s.Nil(err)
+	s.Equal("2565a37ed7eaeb9246b7b47f414b4ba9", etag)
+
+	s.Nil(s.local.Put("test.txt", "goravel2"))
+	etag2, err := s.local.ETag("test.txt")
+	s.Nil(err)
+	s.NotEqual(etag, etag2)
+
+	_, err = s.local.ETag("missing.txt")
+	s.True(os.IsNotExist(err))
+}
+
 func (s *LocalTestSuite) TestExists() {
 	exists := s.local.Exists("test.txt")
 	s.True(exists)
@@ -339,6 +354,86 @@ func (s *LocalTestSuite) TestPut() {
 	s.Nil(s.local.DeleteDirectory("Put"))
 }
 
+func (s *LocalTestSuite) TestPut_Atomic() {
+	s.Nil(os.Chmod(s.local.Path("test.txt"), 0600))
+	s.Nil(s.local.Put("test.txt", "goravel2"))
+
+	data, err := s.local.Get("test.txt")
+	s.Nil(err)
+	s.Equal("goravel2", data)
+
+	info, err := os.Stat(s.local.Path("test.txt"))
+	s.Nil(err)
+	if !env.IsWindows() {
+		s.Equal(os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// No temporary file is left.
+	files, err := s.local.Files("")
+	s.Nil(err)
+	s.Equal([]string{"test.txt"}, files)
+}
+
+func (s *LocalTestSuite) TestPutIfAbsent() {
+	s.ErrorIs(s.local.PutIfAbsent("test.txt", "goravel2"), ErrPreconditionFailed)
+	data, err := s.local.Get("test.txt")
+	s.Nil(err)
+	s.Equal("goravel", data)
+
+	s.Nil(s.local.PutIfAbsent("absent/test.txt", "goravel"))
+	data, err = s.local.Get("absent/test.txt")
+	s.Nil(err)
+	s.Equal("goravel", data)
+
+	files, err := s.local.Files("absent")
+	s.Nil(err)
+	s.Equal([]string{"test.txt"}, files)
+}
+
+func (s *LocalTestSuite) TestPutIfMatch() {
+	etag, err := s.local.ETag("test.txt")
+	s.Nil(err)
+
+	s.ErrorIs(s.local.PutIfMatch("test.txt", "goravel2", "invalid"), ErrPreconditionFailed)
+	s.ErrorIs(s.local.PutIfMatch("missing.txt", "goravel2", etag), ErrPreconditionFailed)
+	s.False(s.local.Exists("missing.txt"))
+
+	s.Nil(s.local.PutIfMatch("test.txt", "goravel2", `"`+etag+`"`))
+	data, err := s.local.Get("test.txt")
+	s.Nil(err)
+	s.Equal("goravel2", data)
+
+	// The ETag is changed by the first write, the stale ETag is rejected.
+	s.ErrorIs(s.local.PutIfMatch("test.txt", "goravel3", etag), ErrPreconditionFailed)
+}
+
+func (s *LocalTestSuite) TestLock() {
+	unlock, err := s.local.Lock("lock/test.txt")
+	s.Nil(err)
+	s.True(s.local.Exists("lock/test.txt"))
+
+	acquired := make(chan struct{})
+	go func() {
+		unlock, err := s.local.Lock("lock/test.txt")
+		s.Nil(err)
+		close(acquired)
+		s.Nil(unlock())
+	}()
+
+	select {
+	case <-acquired:
+		s.Fail("the lock is acquired twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	s.Nil(unlock())
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		s.Fail("the lock isn't released")
+	}
+}
+
 func (s *LocalTestSuite) TestPutFile_Text() {
 	path, err := s.local.PutFile("PutFile", s.file)
 	s.Nil(err)
@@ -7,7 +7,8 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/goravel/framework/config"
+	frameworkconfig "github.com/goravel/framework/config"
+	configcontract "github.com/goravel/framework/contracts/config"
 	consolecontract "github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/foundation"
 	"github.com/goravel/framework/foundation/console"
@@ -26,28 +27,53 @@ func init() {
 	setEnv()
 	setRootPath()
 
-	app := &Application{
-		Container:     NewContainer(),
-		publishes:     make(map[string]map[string]string),
-		publishGroups: make(map[string]map[string]string),
-	}
+	app := newApplication()
 	app.registerBaseServiceProviders()
 	app.bootBaseServiceProviders()
-	app.SetJson(json.NewJson())
 	App = app
 }
 
 type Application struct {
 	foundation.Container
-	publishes     map[string]map[string]string
-	publishGroups map[string]map[string]string
-	json          foundation.Json
+	abouts         map[string][]foundation.AboutItem
+	optimizes      map[string]string
+	optimizeClears map[string]string
+	publishes      map[string]map[string]string
+	publishGroups  map[string]map[string]string
+	json           foundation.Json
 }
 
 func NewApplication() foundation.Application {
 	return App
 }
 
+// NewTestApplication Create an application that only registers and boots the given service providers with the
+// given config, the .env file isn't loaded and the artisan commands aren't run.
+func NewTestApplication(config configcontract.Config, providers []foundation.ServiceProvider) foundation.Application {
+	app := newApplication()
+	app.Instance(frameworkconfig.Binding, config)
+	app.registerServiceProviders(providers)
+	app.bootServiceProviders(providers)
+
+	return app
+}
+
+func newApplication() *Application {
+	container := NewContainer()
+	app := &Application{
+		Container:      container,
+		abouts:         make(map[string][]foundation.AboutItem),
+		optimizes:      make(map[string]string),
+		optimizeClears: make(map[string]string),
+		publishes:      make(map[string]map[string]string),
+		publishGroups:  make(map[string]map[string]string),
+		json:           json.NewJson(),
+	}
+	container.app = app
+
+	return app
+}
+
 // Boot Register and bootstrap configured service providers.
 func (app *Application) Boot() {
 	app.registerConfiguredServiceProviders()
@@ -55,12 +81,27 @@ func (app *Application) Boot() {
 	app.registerCommands([]consolecontract.Command{
 		console.NewTestMakeCommand(),
 		console.NewPackageMakeCommand(),
+		console.NewCrudMakeCommand(),
 		console.NewVendorPublishCommand(app.publishes, app.publishGroups),
+		console.NewOptimizeCommand(app.MakeArtisan(), app.optimizes),
+		console.NewOptimizeClearCommand(app.MakeArtisan(), app.optimizeClears),
+		console.NewStubPublishCommand(),
+		console.NewAboutCommand(app.MakeConfig(), app.abouts),
+		console.NewTinkerCommand(app),
+		console.NewDownCommand(),
+		console.NewUpCommand(),
+		console.NewConfigCacheCommand(),
+		console.NewConfigClearCommand(),
 	})
+	app.Optimizes("config:cache", "config:clear")
 	app.bootArtisan()
 	app.setTimezone()
 }
 
+func (app *Application) About(section string, items []foundation.AboutItem) {
+	app.abouts[section] = append(app.abouts[section], items...)
+}
+
 func (app *Application) Commands(commands []consolecontract.Command) {
 	app.registerCommands(commands)
 }
@@ -104,6 +145,20 @@ func (app *Application) ExecutablePath(path ...string) string {
 	return filepath.Join(path...)
 }
 
+func (app *Application) Optimizes(optimize, clear string, key ...string) {
+	name := strings.Split(optimize, ":")[0]
+	if len(key) > 0 && key[0] != "" {
+		name = key[0]
+	}
+
+	if optimize != "" {
+		app.optimizes[name] = optimize
+	}
+	if clear != "" {
+		app.optimizeClears[name] = clear
+	}
+}
+
 func (app *Application) Publishes(packageName string, paths map[string]string, groups ...string) {
 	app.ensurePublishArrayInitialized(packageName)
 
@@ -142,6 +197,18 @@ func (app *Application) IsLocale(ctx context.Context, locale string) bool {
 	return app.CurrentLocale(ctx) == locale
 }
 
+// Shutdown Stop the resolved services gracefully in parallel, the HTTP server stops accepting new connections and
+// drains the in-flight requests, the queue workers and the scheduler wait for the running jobs, until the context is
+// done.
+func (app *Application) Shutdown(ctx context.Context) error {
+	container, ok := app.Container.(*Container)
+	if !ok {
+		return nil
+	}
+
+	return container.shutdown(ctx)
+}
+
 func (app *Application) ensurePublishArrayInitialized(packageName string) {
 	if _, exist := app.publishes[packageName]; !exist {
 		app.publishes[packageName] = make(map[string]string)
@@ -166,7 +233,7 @@ func (app *Application) bootArtisan() {
 // getBaseServiceProviders Get base service providers.
 func (app *Application) getBaseServiceProviders() []foundation.ServiceProvider {
 	return []foundation.ServiceProvider{
-		&config.ServiceProvider{},
+		&frameworkconfig.ServiceProvider{},
 	}
 }
 
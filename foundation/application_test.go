@@ -1,6 +1,7 @@
 package foundation
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -18,10 +19,13 @@ import (
 	"github.com/goravel/framework/database"
 	"github.com/goravel/framework/database/gorm"
 	"github.com/goravel/framework/event"
+	"github.com/goravel/framework/feature"
 	"github.com/goravel/framework/filesystem"
 	"github.com/goravel/framework/grpc"
 	"github.com/goravel/framework/hash"
+	"github.com/goravel/framework/health"
 	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/id"
 	frameworklog "github.com/goravel/framework/log"
 	"github.com/goravel/framework/mail"
 	cachemocks "github.com/goravel/framework/mocks/cache"
@@ -32,6 +36,7 @@ import (
 	queuemocks "github.com/goravel/framework/mocks/queue"
 	routemocks "github.com/goravel/framework/mocks/route"
 	"github.com/goravel/framework/queue"
+	"github.com/goravel/framework/route"
 	"github.com/goravel/framework/schedule"
 	frameworksession "github.com/goravel/framework/session"
 	supportdocker "github.com/goravel/framework/support/docker"
@@ -39,6 +44,7 @@ import (
 	"github.com/goravel/framework/support/file"
 	frameworktranslation "github.com/goravel/framework/translation"
 	"github.com/goravel/framework/validation"
+	"github.com/goravel/framework/websocket"
 )
 
 type ApplicationTestSuite struct {
@@ -56,9 +62,12 @@ func TestApplicationTestSuite(t *testing.T) {
 
 func (s *ApplicationTestSuite) SetupTest() {
 	s.app = &Application{
-		Container:     NewContainer(),
-		publishes:     make(map[string]map[string]string),
-		publishGroups: make(map[string]map[string]string),
+		Container:      NewContainer(),
+		abouts:         make(map[string][]foundation.AboutItem),
+		optimizes:      make(map[string]string),
+		optimizeClears: make(map[string]string),
+		publishes:      make(map[string]map[string]string),
+		publishGroups:  make(map[string]map[string]string),
 	}
 	App = s.app
 }
@@ -110,6 +119,31 @@ func (s *ApplicationTestSuite) TestExecutablePath() {
 	s.Equal(filepath.Join(path, "test", "test2/test3"), executable3)
 }
 
+func (s *ApplicationTestSuite) TestOptimizes() {
+	s.app.Optimizes("config:cache", "config:clear")
+	s.app.Optimizes("route:cache", "route:clear", "routes")
+	s.app.Optimizes("", "view:clear", "views")
+
+	s.Equal(map[string]string{
+		"config": "config:cache",
+		"routes": "route:cache",
+	}, s.app.optimizes)
+	s.Equal(map[string]string{
+		"config": "config:clear",
+		"routes": "route:clear",
+		"views":  "view:clear",
+	}, s.app.optimizeClears)
+}
+
+func (s *ApplicationTestSuite) TestAbout() {
+	s.app.About("Sms", []foundation.AboutItem{{Key: "Driver", Value: "aliyun"}})
+	s.app.About("Sms", []foundation.AboutItem{{Key: "Version", Value: "v1.0.0"}})
+
+	s.Equal(map[string][]foundation.AboutItem{
+		"Sms": {{Key: "Driver", Value: "aliyun"}, {Key: "Version", Value: "v1.0.0"}},
+	}, s.app.abouts)
+}
+
 func (s *ApplicationTestSuite) TestPublishes() {
 	s.app.Publishes("github.com/goravel/sms", map[string]string{
 		"config.go": "config.go",
@@ -175,6 +209,10 @@ func (s *ApplicationTestSuite) TestMakeCache() {
 	mockConfig.On("GetString", "cache.default").Return("memory").Once()
 	mockConfig.On("GetString", "cache.stores.memory.driver").Return("memory").Once()
 	mockConfig.On("GetString", "cache.prefix").Return("goravel").Once()
+	mockConfig.On("GetInt", "cache.stores.memory.sweep_interval", 60).Return(60).Once()
+	mockConfig.On("GetInt", "cache.stores.memory.max_entries", 0).Return(0).Once()
+	mockConfig.On("GetInt", "cache.stores.memory.max_bytes", 0).Return(0).Once()
+	mockConfig.On("GetBool", "cache.stores.memory.fallback").Return(false).Once()
 
 	s.app.Singleton(frameworkconfig.Binding, func(app foundation.Application) (any, error) {
 		return mockConfig, nil
@@ -259,6 +297,72 @@ func (s *ApplicationTestSuite) TestMakeHash() {
 	mockConfig.AssertExpectations(s.T())
 }
 
+func (s *ApplicationTestSuite) TestMakeFeature() {
+	mockConfig := &configmocks.Config{}
+	mockConfig.On("GetBool", "feature.flags.new-checkout").Return(true).Once()
+
+	s.app.Singleton(frameworkconfig.Binding, func(app foundation.Application) (any, error) {
+		return mockConfig, nil
+	})
+
+	serviceProvider := &feature.ServiceProvider{}
+	serviceProvider.Register(s.app)
+
+	s.True(s.app.MakeFeature().Active(context.Background(), "new-checkout"))
+	mockConfig.AssertExpectations(s.T())
+}
+
+func (s *ApplicationTestSuite) TestMakeHealth() {
+	mockConfig := configmocks.NewConfig(s.T())
+	mockConfig.EXPECT().GetInt("health.timeout", 5).Return(5).Once()
+
+	s.app.Singleton(frameworkconfig.Binding, func(app foundation.Application) (any, error) {
+		return mockConfig, nil
+	})
+
+	serviceProvider := &health.ServiceProvider{}
+	serviceProvider.Register(s.app)
+
+	s.NotNil(s.app.MakeHealth())
+}
+
+func (s *ApplicationTestSuite) TestMakeHttp() {
+	mockConfig := configmocks.NewConfig(s.T())
+	mockConfig.EXPECT().GetInt("http.client.max_idle_conns", 100).Return(100).Once()
+	mockConfig.EXPECT().GetInt("http.client.max_idle_conns_per_host", 10).Return(10).Once()
+	mockConfig.EXPECT().GetInt("http.client.max_conns_per_host", 0).Return(0).Once()
+	mockConfig.EXPECT().GetInt("http.client.idle_conn_timeout", 90).Return(90).Once()
+	mockConfig.EXPECT().GetString("http.client.base_url").Return("").Once()
+	mockConfig.EXPECT().GetInt("http.client.timeout", 30).Return(30).Once()
+
+	s.app.Singleton(frameworkconfig.Binding, func(app foundation.Application) (any, error) {
+		return mockConfig, nil
+	})
+
+	serviceProvider := &http.ServiceProvider{}
+	serviceProvider.Register(s.app)
+
+	s.NotNil(s.app.MakeHttp())
+}
+
+func (s *ApplicationTestSuite) TestMakeID() {
+	mockConfig := &configmocks.Config{}
+	mockConfig.On("GetString", "id.driver", "uuid").Return("ulid").Once()
+
+	s.app.Singleton(frameworkconfig.Binding, func(app foundation.Application) (any, error) {
+		return mockConfig, nil
+	})
+	s.app.Singleton(frameworklog.Binding, func(app foundation.Application) (any, error) {
+		return &logmocks.Log{}, nil
+	})
+
+	serviceProvider := &id.ServiceProvider{}
+	serviceProvider.Register(s.app)
+
+	s.Len(s.app.MakeID().Generate(), 26)
+	mockConfig.AssertExpectations(s.T())
+}
+
 func (s *ApplicationTestSuite) TestMakeLang() {
 	mockConfig := &configmocks.Config{}
 	mockConfig.On("GetString", "app.locale").Return("en").Once()
@@ -326,6 +430,8 @@ func (s *ApplicationTestSuite) TestMakeOrm() {
 	mockConfig.On("GetString", "database.connections.mysql.prefix").Return("").Once()
 	mockConfig.On("GetInt", "database.connections.mysql.port").Return(config.Port).Once()
 	mockConfig.On("GetBool", "database.connections.mysql.singular").Return(true).Once()
+	mockConfig.On("Get", "database.naming.strategy").Return(nil).Once()
+	mockConfig.On("GetString", "database.naming.column", "snake").Return("snake").Once()
 	mockConfig.On("GetBool", "app.debug").Return(true).Once()
 	mockConfig.On("GetInt", "database.pool.max_idle_conns", 10).Return(10)
 	mockConfig.On("GetInt", "database.pool.max_open_conns", 100).Return(100)
@@ -361,6 +467,13 @@ func (s *ApplicationTestSuite) TestMakeRateLimiter() {
 	s.NotNil(s.app.MakeRateLimiter())
 }
 
+func (s *ApplicationTestSuite) TestMakeResponseTransformer() {
+	serviceProvider := &http.ServiceProvider{}
+	serviceProvider.Register(s.app)
+
+	s.NotNil(s.app.MakeResponseTransformer())
+}
+
 func (s *ApplicationTestSuite) TestMakeRoute() {
 	mockConfig := &configmocks.Config{}
 
@@ -377,6 +490,20 @@ func (s *ApplicationTestSuite) TestMakeRoute() {
 	mockConfig.AssertExpectations(s.T())
 }
 
+func (s *ApplicationTestSuite) TestMakeURL() {
+	mockConfig := &configmocks.Config{}
+
+	s.app.Singleton(frameworkconfig.Binding, func(app foundation.Application) (any, error) {
+		return mockConfig, nil
+	})
+
+	serviceProvider := &route.ServiceProvider{}
+	serviceProvider.Register(s.app)
+
+	s.NotNil(s.app.MakeURL())
+	mockConfig.AssertExpectations(s.T())
+}
+
 func (s *ApplicationTestSuite) TestMakeSchedule() {
 	mockConfig := &configmocks.Config{}
 	mockConfig.On("GetBool", "app.debug").Return(false).Once()
@@ -441,3 +568,23 @@ func (s *ApplicationTestSuite) TestMakeValidation() {
 
 	s.NotNil(s.app.MakeValidation())
 }
+
+func (s *ApplicationTestSuite) TestMakeWebSocket() {
+	mockConfig := &configmocks.Config{}
+	mockConfig.On("GetInt", "websocket.rate_limit", 0).Return(0).Once()
+	mockConfig.On("GetInt", "websocket.burst", 0).Return(0).Once()
+	mockConfig.On("Get", "websocket.allowed_origins", []string{}).Return([]string{}).Once()
+
+	s.app.Singleton(frameworkconfig.Binding, func(app foundation.Application) (any, error) {
+		return mockConfig, nil
+	})
+	s.app.Singleton(frameworklog.Binding, func(app foundation.Application) (any, error) {
+		return &logmocks.Log{}, nil
+	})
+
+	serviceProvider := &websocket.ServiceProvider{}
+	serviceProvider.Register(s.app)
+	s.NotNil(s.app.MakeWebSocket())
+
+	mockConfig.AssertExpectations(s.T())
+}
@@ -0,0 +1,206 @@
+package console
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	frameworkconfig "github.com/goravel/framework/config"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/route"
+	"github.com/goravel/framework/support"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+type AboutCommand struct {
+	config config.Config
+	abouts map[string][]foundation.AboutItem
+}
+
+func NewAboutCommand(config config.Config, abouts map[string][]foundation.AboutItem) *AboutCommand {
+	return &AboutCommand{
+		config: config,
+		abouts: abouts,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *AboutCommand) Signature() string {
+	return "about"
+}
+
+// Description The console command description.
+func (receiver *AboutCommand) Description() string {
+	return "Display basic information about your application"
+}
+
+// Extend The console command extend.
+func (receiver *AboutCommand) Extend() command.Extend {
+	return command.Extend{
+		Json: true,
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "only",
+				Usage: "the section to display",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *AboutCommand) Handle(ctx console.Context) error {
+	sections := receiver.sections()
+	if only := ctx.Option("only"); only != "" {
+		var filtered []aboutSection
+		for _, section := range sections {
+			if strings.EqualFold(section.name, only) {
+				filtered = append(filtered, section)
+			}
+		}
+		if len(filtered) == 0 {
+			color.Red().Printf("Section [%s] not found\n", only)
+
+			return nil
+		}
+		sections = filtered
+	}
+
+	if ctx.OptionBool("json") {
+		data := make(map[string]map[string]string, len(sections))
+		for _, section := range sections {
+			data[section.name] = make(map[string]string, len(section.items))
+			for _, item := range section.items {
+				data[section.name][item.Key] = item.Value
+			}
+		}
+
+		return ctx.Json(data)
+	}
+
+	for _, section := range sections {
+		ctx.NewLine()
+		color.Green().Println(section.name)
+		for _, item := range section.items {
+			if err := ctx.TwoColumnDetail(item.Key, item.Value); err != nil {
+				return err
+			}
+		}
+	}
+	ctx.NewLine()
+
+	return nil
+}
+
+type aboutSection struct {
+	name  string
+	items []foundation.AboutItem
+}
+
+// sections Get the built-in sections followed by the sections registered by packages, the package
+// sections are sorted by name, and the items of a package registered to a built-in section are appended to it.
+func (receiver *AboutCommand) sections() []aboutSection {
+	sections := []aboutSection{
+		{
+			name: "Environment",
+			items: []foundation.AboutItem{
+				{Key: "Application Name", Value: receiver.config.GetString("app.name")},
+				{Key: "Goravel Version", Value: support.Version},
+				{Key: "Go Version", Value: runtime.Version()},
+				{Key: "Environment", Value: receiver.config.GetString("app.env")},
+				{Key: "Debug Mode", Value: enabled(receiver.config.GetBool("app.debug"))},
+				{Key: "URL", Value: receiver.config.GetString("app.url")},
+				{Key: "Timezone", Value: receiver.config.GetString("app.timezone")},
+				{Key: "Locale", Value: receiver.config.GetString("app.locale")},
+			},
+		},
+		{
+			name: "Cache",
+			items: []foundation.AboutItem{
+				{Key: "Config", Value: cached(frameworkconfig.CacheFile())},
+				{Key: "Routes", Value: cached(route.CacheFile())},
+				{Key: "Views", Value: cached(http.ViewCacheFile())},
+			},
+		},
+		{
+			name: "Drivers",
+			items: []foundation.AboutItem{
+				{Key: "Database", Value: receiver.databaseDriver()},
+				{Key: "Cache", Value: receiver.config.GetString("cache.default")},
+				{Key: "Queue", Value: receiver.queueDriver()},
+				{Key: "Mail", Value: receiver.mailDriver()},
+			},
+		},
+	}
+
+	var names []string
+	for name, items := range receiver.abouts {
+		builtIn := false
+		for i := range sections {
+			if sections[i].name == name {
+				sections[i].items = append(sections[i].items, items...)
+				builtIn = true
+			}
+		}
+		if !builtIn {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		sections = append(sections, aboutSection{name: name, items: receiver.abouts[name]})
+	}
+
+	return sections
+}
+
+func (receiver *AboutCommand) databaseDriver() string {
+	connection := receiver.config.GetString("database.default")
+	if connection == "" {
+		return ""
+	}
+
+	return receiver.config.GetString("database.connections." + connection + ".driver")
+}
+
+func (receiver *AboutCommand) queueDriver() string {
+	connection := receiver.config.GetString("queue.default")
+	if connection == "" {
+		return ""
+	}
+
+	return receiver.config.GetString("queue.connections." + connection + ".driver")
+}
+
+func (receiver *AboutCommand) mailDriver() string {
+	host := receiver.config.GetString("mail.host")
+	if host == "" {
+		return ""
+	}
+
+	return "smtp (" + host + ":" + cast.ToString(receiver.config.GetInt("mail.port")) + ")"
+}
+
+func enabled(value bool) string {
+	if value {
+		return "enabled"
+	}
+
+	return "disabled"
+}
+
+// cached Report whether the cache file of an optimization exists.
+func cached(path string) string {
+	if file.Exists(path) {
+		return "CACHED"
+	}
+
+	return "NOT CACHED"
+}
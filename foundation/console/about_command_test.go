@@ -0,0 +1,80 @@
+package console
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	frameworkconfig "github.com/goravel/framework/config"
+	"github.com/goravel/framework/console"
+	"github.com/goravel/framework/contracts/foundation"
+	configmocks "github.com/goravel/framework/mocks/config"
+	"github.com/goravel/framework/support"
+	"github.com/goravel/framework/support/file"
+)
+
+func TestAboutCommand(t *testing.T) {
+	mockConfig := func(t *testing.T) *configmocks.Config {
+		mockConfig := configmocks.NewConfig(t)
+		mockConfig.EXPECT().GetString("app.name").Return("goravel").Once()
+		mockConfig.EXPECT().GetString("app.env").Return("local").Once()
+		mockConfig.EXPECT().GetBool("app.debug").Return(true).Once()
+		mockConfig.EXPECT().GetString("app.url").Return("http://localhost").Once()
+		mockConfig.EXPECT().GetString("app.timezone").Return("UTC").Once()
+		mockConfig.EXPECT().GetString("app.locale").Return("en").Once()
+		mockConfig.EXPECT().GetString("database.default").Return("mysql").Once()
+		mockConfig.EXPECT().GetString("database.connections.mysql.driver").Return("mysql").Once()
+		mockConfig.EXPECT().GetString("cache.default").Return("redis").Once()
+		mockConfig.EXPECT().GetString("queue.default").Return("sync").Once()
+		mockConfig.EXPECT().GetString("queue.connections.sync.driver").Return("sync").Once()
+		mockConfig.EXPECT().GetString("mail.host").Return("smtp.example.com").Once()
+		mockConfig.EXPECT().GetInt("mail.port").Return(465).Once()
+
+		return mockConfig
+	}
+	abouts := map[string][]foundation.AboutItem{
+		"Sms":     {{Key: "Driver", Value: "aliyun"}},
+		"Drivers": {{Key: "Sms", Value: "aliyun"}},
+	}
+
+	t.Run("display all sections", func(t *testing.T) {
+		console.NewTester(t, NewAboutCommand(mockConfig(t), abouts)).
+			Run().
+			AssertSuccessful().
+			AssertOutputLines("Environment", "Cache", "Drivers", "Sms").
+			AssertOutputContains("Goravel Version").
+			AssertOutputContains(support.Version).
+			AssertOutputContains(runtime.Version()).
+			AssertOutputContains("enabled").
+			AssertOutputContains("NOT CACHED").
+			AssertOutputContains("smtp (smtp.example.com:465)").
+			AssertOutputContains("aliyun")
+	})
+
+	t.Run("display only a section as json", func(t *testing.T) {
+		console.NewTester(t, NewAboutCommand(mockConfig(t), abouts)).
+			Run("--only", "drivers", "--json").
+			AssertSuccessful().
+			AssertOutputContains(`{"Drivers":{"Cache":"redis","Database":"mysql","Mail":"smtp (smtp.example.com:465)","Queue":"sync","Sms":"aliyun"}}`)
+	})
+
+	t.Run("display the cached optimizations", func(t *testing.T) {
+		assert.Nil(t, file.Create(frameworkconfig.CacheFile(), "{}"))
+		defer func() {
+			assert.Nil(t, file.Remove("storage"))
+		}()
+
+		console.NewTester(t, NewAboutCommand(mockConfig(t), abouts)).
+			Run("--only", "cache", "--json").
+			AssertSuccessful().
+			AssertOutputContains(`{"Cache":{"Config":"CACHED","Routes":"NOT CACHED","Views":"NOT CACHED"}}`)
+	})
+
+	t.Run("section not found", func(t *testing.T) {
+		console.NewTester(t, NewAboutCommand(mockConfig(t), abouts)).
+			Run("--only", "unknown").
+			AssertSuccessful().
+			AssertOutputContains("Section [unknown] not found")
+	})
+}
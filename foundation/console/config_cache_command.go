@@ -0,0 +1,79 @@
+package console
+
+import (
+	"github.com/goravel/framework/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support"
+	"github.com/goravel/framework/support/color"
+)
+
+type ConfigCacheCommand struct {
+}
+
+func NewConfigCacheCommand() *ConfigCacheCommand {
+	return &ConfigCacheCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ConfigCacheCommand) Signature() string {
+	return "config:cache"
+}
+
+// Description The console command description.
+func (receiver *ConfigCacheCommand) Description() string {
+	return "Create a cache file of the .env file for faster configuration loading"
+}
+
+// Extend The console command extend.
+func (receiver *ConfigCacheCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "config",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ConfigCacheCommand) Handle(ctx console.Context) error {
+	if err := config.Cache(support.EnvPath); err != nil {
+		return err
+	}
+
+	color.Green().Println("Configuration cached successfully")
+
+	return nil
+}
+
+type ConfigClearCommand struct {
+}
+
+func NewConfigClearCommand() *ConfigClearCommand {
+	return &ConfigClearCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ConfigClearCommand) Signature() string {
+	return "config:clear"
+}
+
+// Description The console command description.
+func (receiver *ConfigClearCommand) Description() string {
+	return "Remove the configuration cache file"
+}
+
+// Extend The console command extend.
+func (receiver *ConfigClearCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "config",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ConfigClearCommand) Handle(ctx console.Context) error {
+	if err := config.ClearCache(); err != nil {
+		return err
+	}
+
+	color.Green().Println("Configuration cache cleared successfully")
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package console
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/jinzhu/inflection"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+	supportconsole "github.com/goravel/framework/support/console"
+	"github.com/goravel/framework/support/file"
+	"github.com/goravel/framework/support/str"
+)
+
+// crudPart A file generated by the make:crud command, the path is relative to the working directory and the
+// place-holders of the path are populated like the ones of the stub.
+type crudPart struct {
+	name string
+	path string
+	stub func() string
+}
+
+var crudParts = []crudPart{
+	{name: "controller", path: filepath.Join("app", "http", "controllers", "DummySnake_controller.go"), stub: CrudStubs{}.Controller},
+	{name: "request", path: filepath.Join("app", "http", "requests", "DummySnake_request.go"), stub: CrudStubs{}.Request},
+	{name: "resource", path: filepath.Join("app", "http", "resources", "DummySnake_resource.go"), stub: CrudStubs{}.Resource},
+	{name: "route", path: filepath.Join("routes", "DummySnake.go"), stub: CrudStubs{}.Route},
+	{name: "policy", path: filepath.Join("app", "policies", "DummySnake_policy.go"), stub: CrudStubs{}.Policy},
+	{name: "factory", path: filepath.Join("database", "factories", "DummySnake_factory.go"), stub: CrudStubs{}.Factory},
+	{name: "test", path: filepath.Join("tests", "feature", "DummySnake_controller_test.go"), stub: CrudStubs{}.Test},
+}
+
+type CrudMakeCommand struct {
+}
+
+func NewCrudMakeCommand() *CrudMakeCommand {
+	return &CrudMakeCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *CrudMakeCommand) Signature() string {
+	return "make:crud"
+}
+
+// Description The console command description.
+func (receiver *CrudMakeCommand) Description() string {
+	return "Create the controller, request, resource, routes, policy, factory and test of a model"
+}
+
+// Extend The console command extend.
+func (receiver *CrudMakeCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "make",
+		Flags: []command.Flag{
+			&command.StringSliceFlag{
+				Name:  "only",
+				Usage: "Create only the given files: controller, request, resource, route, policy, factory and test",
+			},
+			&command.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Create the files even if they already exist",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command, the files are created from the crud.*.stub stubs, so they can be customized
+// after running stub:publish.
+func (receiver *CrudMakeCommand) Handle(ctx console.Context) error {
+	model := ctx.Argument(0)
+	if model == "" {
+		var err error
+		model, err = ctx.Ask("Enter the model name", console.AskOption{
+			Validate: func(s string) error {
+				if s == "" {
+					return errors.New("the model name cannot be empty")
+				}
+
+				return nil
+			},
+		})
+		if err != nil {
+			color.Red().Println(err)
+			return nil
+		}
+	}
+
+	only := ctx.OptionSlice("only")
+	for _, name := range only {
+		if !slices.ContainsFunc(crudParts, func(part crudPart) bool { return part.name == name }) {
+			color.Red().Printf("The file [%s] isn't supported, it should be controller, request, resource, route, policy, factory or test\n", name)
+			return nil
+		}
+	}
+
+	pwd, _ := os.Getwd()
+	force := ctx.OptionBool("force")
+	for _, part := range crudParts {
+		if len(only) > 0 && !slices.Contains(only, part.name) {
+			continue
+		}
+
+		path := receiver.populateStub(part.path, model)
+		if !force && file.Exists(filepath.Join(pwd, path)) {
+			color.Yellow().Printf("The %s [%s] already exists, use the --force or -f flag to overwrite\n", part.name, path)
+			continue
+		}
+
+		stub := supportconsole.Stub(fmt.Sprintf("crud.%s.stub", part.name), part.stub())
+		if err := file.Create(filepath.Join(pwd, path), receiver.populateStub(stub, model)); err != nil {
+			return err
+		}
+
+		color.Green().Printf("%s [%s] created successfully\n", str.Of(part.name).UcFirst().String(), path)
+	}
+
+	return nil
+}
+
+// populateStub Populate the place-holders in the command stub, the folders of the model name are ignored, since the
+// generated files import the models package.
+func (receiver *CrudMakeCommand) populateStub(stub string, model string) string {
+	segments := strings.Split(strings.TrimSuffix(model, ".go"), "/")
+	structName := str.Of(segments[len(segments)-1]).Studly().String()
+	variable := str.Of(structName).Camel().String()
+	snake := str.Of(structName).Snake().String()
+
+	stub = strings.ReplaceAll(stub, "DummyModel", structName)
+	stub = strings.ReplaceAll(stub, "DummyVariable", variable)
+	stub = strings.ReplaceAll(stub, "DummyPlural", inflection.Plural(variable))
+	stub = strings.ReplaceAll(stub, "DummySnake", snake)
+	stub = strings.ReplaceAll(stub, "DummyPath", inflection.Plural(str.Of(structName).Kebab().String()))
+
+	return stub
+}
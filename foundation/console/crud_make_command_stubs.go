@@ -0,0 +1,324 @@
+package console
+
+type CrudStubs struct {
+}
+
+func (r CrudStubs) Controller() string {
+	return `package controllers
+
+import (
+	"net/http"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+
+	"goravel/app/http/requests"
+	"goravel/app/http/resources"
+	"goravel/app/models"
+)
+
+type DummyModelController struct {
+	// Dependent services
+}
+
+func NewDummyModelController() *DummyModelController {
+	return &DummyModelController{
+		// Inject services
+	}
+}
+
+func (r *DummyModelController) Index(ctx contractshttp.Context) contractshttp.Response {
+	if response := facades.Gate().WithContext(ctx).Inspect("DummySnake.viewAny", nil); !response.Allowed() {
+		return ctx.Response().Json(http.StatusForbidden, contractshttp.Json{"message": response.Message()})
+	}
+
+	var DummyPlural []models.DummyModel
+	var total int64
+	if err := facades.Orm().WithContext(ctx).Query().Paginate(ctx.Request().QueryInt("page", 1), ctx.Request().QueryInt("limit", 15), &DummyPlural, &total); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, contractshttp.Json{"message": err.Error()})
+	}
+
+	return ctx.Response().Success().Json(contractshttp.Json{
+		"data":  resources.NewDummyModelCollection(DummyPlural),
+		"total": total,
+	})
+}
+
+func (r *DummyModelController) Show(ctx contractshttp.Context) contractshttp.Response {
+	var DummyVariable models.DummyModel
+	if err := facades.Orm().WithContext(ctx).Query().FindOrFail(&DummyVariable, ctx.Request().Route("id")); err != nil {
+		return ctx.Response().Json(http.StatusNotFound, contractshttp.Json{"message": err.Error()})
+	}
+	if response := facades.Gate().WithContext(ctx).Inspect("DummySnake.view", map[string]any{"DummyVariable": DummyVariable}); !response.Allowed() {
+		return ctx.Response().Json(http.StatusForbidden, contractshttp.Json{"message": response.Message()})
+	}
+
+	return ctx.Response().Success().Json(contractshttp.Json{"data": resources.NewDummyModelResource(DummyVariable)})
+}
+
+func (r *DummyModelController) Store(ctx contractshttp.Context) contractshttp.Response {
+	if response := facades.Gate().WithContext(ctx).Inspect("DummySnake.create", nil); !response.Allowed() {
+		return ctx.Response().Json(http.StatusForbidden, contractshttp.Json{"message": response.Message()})
+	}
+
+	var request requests.DummyModelRequest
+	errors, err := ctx.Request().ValidateRequest(&request)
+	if err != nil {
+		return ctx.Response().Json(http.StatusBadRequest, contractshttp.Json{"message": err.Error()})
+	}
+	if errors != nil {
+		return ctx.Response().Json(http.StatusUnprocessableEntity, contractshttp.Json{"errors": errors.All()})
+	}
+
+	DummyVariable := request.Fill(models.DummyModel{})
+	if err := facades.Orm().WithContext(ctx).Query().Create(&DummyVariable); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, contractshttp.Json{"message": err.Error()})
+	}
+
+	return ctx.Response().Json(http.StatusCreated, contractshttp.Json{"data": resources.NewDummyModelResource(DummyVariable)})
+}
+
+func (r *DummyModelController) Update(ctx contractshttp.Context) contractshttp.Response {
+	var DummyVariable models.DummyModel
+	if err := facades.Orm().WithContext(ctx).Query().FindOrFail(&DummyVariable, ctx.Request().Route("id")); err != nil {
+		return ctx.Response().Json(http.StatusNotFound, contractshttp.Json{"message": err.Error()})
+	}
+	if response := facades.Gate().WithContext(ctx).Inspect("DummySnake.update", map[string]any{"DummyVariable": DummyVariable}); !response.Allowed() {
+		return ctx.Response().Json(http.StatusForbidden, contractshttp.Json{"message": response.Message()})
+	}
+
+	var request requests.DummyModelRequest
+	errors, err := ctx.Request().ValidateRequest(&request)
+	if err != nil {
+		return ctx.Response().Json(http.StatusBadRequest, contractshttp.Json{"message": err.Error()})
+	}
+	if errors != nil {
+		return ctx.Response().Json(http.StatusUnprocessableEntity, contractshttp.Json{"errors": errors.All()})
+	}
+
+	DummyVariable = request.Fill(DummyVariable)
+	if err := facades.Orm().WithContext(ctx).Query().Save(&DummyVariable); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, contractshttp.Json{"message": err.Error()})
+	}
+
+	return ctx.Response().Success().Json(contractshttp.Json{"data": resources.NewDummyModelResource(DummyVariable)})
+}
+
+func (r *DummyModelController) Destroy(ctx contractshttp.Context) contractshttp.Response {
+	var DummyVariable models.DummyModel
+	if err := facades.Orm().WithContext(ctx).Query().FindOrFail(&DummyVariable, ctx.Request().Route("id")); err != nil {
+		return ctx.Response().Json(http.StatusNotFound, contractshttp.Json{"message": err.Error()})
+	}
+	if response := facades.Gate().WithContext(ctx).Inspect("DummySnake.delete", map[string]any{"DummyVariable": DummyVariable}); !response.Allowed() {
+		return ctx.Response().Json(http.StatusForbidden, contractshttp.Json{"message": response.Message()})
+	}
+
+	if _, err := facades.Orm().WithContext(ctx).Query().Delete(&DummyVariable); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, contractshttp.Json{"message": err.Error()})
+	}
+
+	return ctx.Response().NoContent()
+}
+`
+}
+
+func (r CrudStubs) Request() string {
+	return `package requests
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/validation"
+
+	"goravel/app/models"
+)
+
+type DummyModelRequest struct {
+	// Add the fields of the model, e.g. Name string ` + "`form:\"name\" json:\"name\"`" + `
+}
+
+func (r *DummyModelRequest) Authorize(ctx http.Context) error {
+	return nil
+}
+
+func (r *DummyModelRequest) Filters(ctx http.Context) map[string]string {
+	return map[string]string{}
+}
+
+func (r *DummyModelRequest) Rules(ctx http.Context) map[string]string {
+	return map[string]string{}
+}
+
+func (r *DummyModelRequest) Messages(ctx http.Context) map[string]string {
+	return map[string]string{}
+}
+
+func (r *DummyModelRequest) Attributes(ctx http.Context) map[string]string {
+	return map[string]string{}
+}
+
+func (r *DummyModelRequest) PrepareForValidation(ctx http.Context, data validation.Data) error {
+	return nil
+}
+
+// Fill Fill the model with the validated fields of the request.
+func (r *DummyModelRequest) Fill(DummyVariable models.DummyModel) models.DummyModel {
+	return DummyVariable
+}
+`
+}
+
+func (r CrudStubs) Resource() string {
+	return `package resources
+
+import (
+	"goravel/app/models"
+)
+
+// NewDummyModelResource Transform the model to the body of a response.
+func NewDummyModelResource(DummyVariable models.DummyModel) map[string]any {
+	return map[string]any{
+		"id":         DummyVariable.ID,
+		"created_at": DummyVariable.CreatedAt,
+		"updated_at": DummyVariable.UpdatedAt,
+	}
+}
+
+// NewDummyModelCollection Transform the models to the body of a response.
+func NewDummyModelCollection(DummyPlural []models.DummyModel) []map[string]any {
+	collection := make([]map[string]any, len(DummyPlural))
+	for i, DummyVariable := range DummyPlural {
+		collection[i] = NewDummyModelResource(DummyVariable)
+	}
+
+	return collection
+}
+`
+}
+
+func (r CrudStubs) Route() string {
+	return `package routes
+
+import (
+	"github.com/goravel/framework/facades"
+
+	"goravel/app/http/controllers"
+)
+
+// DummyModel Register the routes of the DummyVariable resource, call it in the Boot method of the route service provider.
+func DummyModel() {
+	facades.Route().Resource("DummyPath", controllers.NewDummyModelController())
+}
+`
+}
+
+func (r CrudStubs) Policy() string {
+	return `package policies
+
+import (
+	"context"
+
+	"github.com/goravel/framework/auth/access"
+	contractsaccess "github.com/goravel/framework/contracts/auth/access"
+)
+
+type DummyModelPolicy struct {
+}
+
+func NewDummyModelPolicy() *DummyModelPolicy {
+	return &DummyModelPolicy{}
+}
+
+// Register Define the abilities of the policy, call it in the Boot method of the auth service provider, e.g.
+// policies.NewDummyModelPolicy().Register(facades.Gate()).
+func (r *DummyModelPolicy) Register(gate contractsaccess.Gate) {
+	gate.Define("DummySnake.viewAny", r.ViewAny)
+	gate.Define("DummySnake.view", r.View)
+	gate.Define("DummySnake.create", r.Create)
+	gate.Define("DummySnake.update", r.Update)
+	gate.Define("DummySnake.delete", r.Delete)
+}
+
+func (r *DummyModelPolicy) ViewAny(ctx context.Context, arguments map[string]any) contractsaccess.Response {
+	return access.NewAllowResponse()
+}
+
+func (r *DummyModelPolicy) View(ctx context.Context, arguments map[string]any) contractsaccess.Response {
+	return access.NewAllowResponse()
+}
+
+func (r *DummyModelPolicy) Create(ctx context.Context, arguments map[string]any) contractsaccess.Response {
+	return access.NewAllowResponse()
+}
+
+func (r *DummyModelPolicy) Update(ctx context.Context, arguments map[string]any) contractsaccess.Response {
+	return access.NewAllowResponse()
+}
+
+func (r *DummyModelPolicy) Delete(ctx context.Context, arguments map[string]any) contractsaccess.Response {
+	return access.NewAllowResponse()
+}
+`
+}
+
+func (r CrudStubs) Factory() string {
+	return `package factories
+
+type DummyModelFactory struct {
+}
+
+// Definition Define the model's default state.
+func (f *DummyModelFactory) Definition() map[string]any {
+	return map[string]any{}
+}
+`
+}
+
+func (r CrudStubs) Test() string {
+	return `package feature
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"goravel/tests"
+)
+
+type DummyModelControllerTestSuite struct {
+	suite.Suite
+	tests.TestCase
+}
+
+func TestDummyModelControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(DummyModelControllerTestSuite))
+}
+
+// SetupTest will run before each test in the suite.
+func (s *DummyModelControllerTestSuite) SetupTest() {
+}
+
+// TearDownTest will run after each test in the suite.
+func (s *DummyModelControllerTestSuite) TearDownTest() {
+}
+
+func (s *DummyModelControllerTestSuite) TestIndex() {
+	// TODO: GET /DummyPath
+}
+
+func (s *DummyModelControllerTestSuite) TestShow() {
+	// TODO: GET /DummyPath/{id}
+}
+
+func (s *DummyModelControllerTestSuite) TestStore() {
+	// TODO: POST /DummyPath
+}
+
+func (s *DummyModelControllerTestSuite) TestUpdate() {
+	// TODO: PUT /DummyPath/{id}
+}
+
+func (s *DummyModelControllerTestSuite) TestDestroy() {
+	// TODO: DELETE /DummyPath/{id}
+}
+`
+}
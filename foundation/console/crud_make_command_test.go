@@ -0,0 +1,78 @@
+package console
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	consolemocks "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+func TestCrudMakeCommand(t *testing.T) {
+	crudMakeCommand := NewCrudMakeCommand()
+	mockContext := consolemocks.NewContext(t)
+	mockContext.EXPECT().Argument(0).Return("").Once()
+	mockContext.EXPECT().Ask("Enter the model name", mock.Anything).Return("", errors.New("the model name cannot be empty")).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, crudMakeCommand.Handle(mockContext))
+	}), "the model name cannot be empty")
+
+	mockContext.EXPECT().Argument(0).Return("BlogPost").Once()
+	mockContext.EXPECT().OptionSlice("only").Return(nil).Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, crudMakeCommand.Handle(mockContext))
+	}), "Controller [app/http/controllers/blog_post_controller.go] created successfully")
+
+	files := []string{
+		filepath.Join("app", "http", "controllers", "blog_post_controller.go"),
+		filepath.Join("app", "http", "requests", "blog_post_request.go"),
+		filepath.Join("app", "http", "resources", "blog_post_resource.go"),
+		filepath.Join("routes", "blog_post.go"),
+		filepath.Join("app", "policies", "blog_post_policy.go"),
+		filepath.Join("database", "factories", "blog_post_factory.go"),
+		filepath.Join("tests", "feature", "blog_post_controller_test.go"),
+	}
+	for _, path := range files {
+		assert.True(t, file.Exists(path), path)
+		_, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.AllErrors)
+		assert.Nil(t, err, path)
+	}
+	assert.True(t, file.Contain(files[0], "func (r *BlogPostController) Destroy(ctx contractshttp.Context) contractshttp.Response {"))
+	assert.True(t, file.Contain(files[0], `Inspect("blog_post.update", map[string]any{"blogPost": blogPost})`))
+	assert.True(t, file.Contain(files[0], "var blogPosts []models.BlogPost"))
+	assert.True(t, file.Contain(files[3], `facades.Route().Resource("blog-posts", controllers.NewBlogPostController())`))
+	assert.True(t, file.Contain(files[4], `gate.Define("blog_post.delete", r.Delete)`))
+
+	mockContext.EXPECT().Argument(0).Return("BlogPost").Once()
+	mockContext.EXPECT().OptionSlice("only").Return([]string{"controller"}).Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, crudMakeCommand.Handle(mockContext))
+	}), "The controller [app/http/controllers/blog_post_controller.go] already exists, use the --force or -f flag to overwrite")
+
+	assert.Nil(t, file.Create(filepath.Join("stubs", "crud.route.stub"), "package routes\n\n// DummyModel DummyPath\n"))
+	mockContext.EXPECT().Argument(0).Return("BlogPost").Once()
+	mockContext.EXPECT().OptionSlice("only").Return([]string{"route"}).Once()
+	mockContext.EXPECT().OptionBool("force").Return(true).Once()
+	assert.Nil(t, crudMakeCommand.Handle(mockContext))
+	assert.True(t, file.Contain(files[3], "// BlogPost blog-posts"))
+
+	mockContext.EXPECT().Argument(0).Return("BlogPost").Once()
+	mockContext.EXPECT().OptionSlice("only").Return([]string{"view"}).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, crudMakeCommand.Handle(mockContext))
+	}), "The file [view] isn't supported")
+
+	for _, path := range []string{"app", "routes", "database", "tests", "stubs"} {
+		assert.Nil(t, file.Remove(path))
+	}
+}
@@ -0,0 +1,88 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http/maintenance"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/str"
+)
+
+type DownCommand struct {
+}
+
+func NewDownCommand() *DownCommand {
+	return &DownCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *DownCommand) Signature() string {
+	return "down"
+}
+
+// Description The console command description.
+func (receiver *DownCommand) Description() string {
+	return "Put the application into maintenance mode"
+}
+
+// Extend The console command extend.
+func (receiver *DownCommand) Extend() command.Extend {
+	return command.Extend{
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "secret",
+				Usage: "the secret phrase that may be used to bypass maintenance mode",
+			},
+			&command.BoolFlag{
+				Name:  "with-secret",
+				Usage: "generate a random secret phrase that may be used to bypass maintenance mode",
+			},
+			&command.IntFlag{
+				Name:  "retry",
+				Usage: "the number of seconds after which the request may be retried",
+			},
+			&command.IntFlag{
+				Name:  "status",
+				Usage: "the status code that should be used when returning the maintenance mode response",
+				Value: http.StatusServiceUnavailable,
+			},
+			&command.StringSliceFlag{
+				Name:  "except",
+				Usage: "the paths that should be served during maintenance mode, e.g. /health or /api/status/*",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *DownCommand) Handle(ctx console.Context) error {
+	if maintenance.IsDown() {
+		color.Yellow().Println("Application is already down.")
+
+		return nil
+	}
+
+	secret := ctx.Option("secret")
+	if secret == "" && ctx.OptionBool("with-secret") {
+		secret = str.Random(32)
+	}
+
+	if err := maintenance.Write(maintenance.Payload{
+		Except: ctx.OptionSlice("except"),
+		Retry:  ctx.OptionInt("retry"),
+		Secret: secret,
+		Status: ctx.OptionInt("status"),
+	}); err != nil {
+		color.Red().Println("Failed to enter maintenance mode:", err.Error())
+
+		return nil
+	}
+
+	color.Green().Println("Application is now in maintenance mode.")
+	if secret != "" {
+		color.Default().Printf("You may bypass maintenance mode via [/%s].\n", secret)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/console"
+	"github.com/goravel/framework/http/maintenance"
+	"github.com/goravel/framework/support"
+)
+
+func TestDownAndUpCommand(t *testing.T) {
+	support.RelativePath = t.TempDir()
+	defer func() {
+		support.RelativePath = ""
+	}()
+
+	console.NewTester(t, NewUpCommand()).
+		Run().
+		AssertSuccessful().
+		AssertOutputContains("Application is not in maintenance mode.")
+
+	console.NewTester(t, NewDownCommand()).
+		Run("--secret", "bypass", "--retry", "60", "--except", "/health", "--except", "/api/status/*").
+		AssertSuccessful().
+		AssertOutputContains("Application is now in maintenance mode.").
+		AssertOutputContains("/bypass")
+
+	payload, err := maintenance.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, &maintenance.Payload{
+		Except: []string{"/health", "/api/status/*"},
+		Retry:  60,
+		Secret: "bypass",
+		Status: 503,
+	}, payload)
+
+	console.NewTester(t, NewDownCommand()).
+		Run().
+		AssertSuccessful().
+		AssertOutputContains("Application is already down.")
+
+	console.NewTester(t, NewUpCommand()).
+		Run().
+		AssertSuccessful().
+		AssertOutputContains("Application is now live.")
+	assert.False(t, maintenance.IsDown())
+
+	console.NewTester(t, NewDownCommand()).
+		Run("--with-secret").
+		AssertSuccessful()
+
+	payload, err = maintenance.Read()
+	assert.Nil(t, err)
+	assert.Len(t, payload.Secret, 32)
+	assert.Nil(t, maintenance.Remove())
+}
@@ -0,0 +1,122 @@
+package console
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+)
+
+type OptimizeCommand struct {
+	artisan   console.Artisan
+	optimizes map[string]string
+}
+
+func NewOptimizeCommand(artisan console.Artisan, optimizes map[string]string) *OptimizeCommand {
+	return &OptimizeCommand{
+		artisan:   artisan,
+		optimizes: optimizes,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *OptimizeCommand) Signature() string {
+	return "optimize"
+}
+
+// Description The console command description.
+func (receiver *OptimizeCommand) Description() string {
+	return "Cache framework bootstrap, configuration, and metadata to increase performance"
+}
+
+// Extend The console command extend.
+func (receiver *OptimizeCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "optimize",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *OptimizeCommand) Handle(ctx console.Context) error {
+	if len(receiver.optimizes) == 0 {
+		color.Yellow().Println("No optimize commands registered")
+		return nil
+	}
+
+	color.Green().Println("Caching framework bootstrap, configuration, and metadata")
+
+	return callCommands(receiver.artisan, receiver.optimizes)
+}
+
+type OptimizeClearCommand struct {
+	artisan        console.Artisan
+	optimizeClears map[string]string
+}
+
+func NewOptimizeClearCommand(artisan console.Artisan, optimizeClears map[string]string) *OptimizeClearCommand {
+	return &OptimizeClearCommand{
+		artisan:        artisan,
+		optimizeClears: optimizeClears,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *OptimizeClearCommand) Signature() string {
+	return "optimize:clear"
+}
+
+// Description The console command description.
+func (receiver *OptimizeClearCommand) Description() string {
+	return "Remove the cached bootstrap files"
+}
+
+// Extend The console command extend.
+func (receiver *OptimizeClearCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "optimize",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *OptimizeClearCommand) Handle(ctx console.Context) error {
+	if len(receiver.optimizeClears) == 0 {
+		color.Yellow().Println("No optimize clear commands registered")
+		return nil
+	}
+
+	color.Green().Println("Clearing cached bootstrap files")
+
+	return callCommands(receiver.artisan, receiver.optimizeClears)
+}
+
+// callCommands calls the given commands sorted by their keys, so the output is stable, the remaining commands
+// are still called if one fails, and the error lists the failed keys.
+func callCommands(artisan console.Artisan, commands map[string]string) error {
+	keys := make([]string, 0, len(commands))
+	for key := range commands {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var failed []string
+	for _, key := range keys {
+		err := artisan.CallWithError(commands[key])
+		color.Default().Printf("%s ", key)
+		if err != nil {
+			color.Red().Println("FAIL")
+			failed = append(failed, key)
+
+			continue
+		}
+		color.Green().Println("DONE")
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to run the commands of [%s]", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package console
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	consolemocks "github.com/goravel/framework/mocks/console"
+)
+
+func TestOptimizeCommand(t *testing.T) {
+	mockArtisan := &consolemocks.Artisan{}
+	mockContext := &consolemocks.Context{}
+
+	var calls []string
+	mockArtisan.On("CallWithError", "config:cache").Run(func(args mock.Arguments) { calls = append(calls, "config:cache") }).Return(nil).Once()
+	mockArtisan.On("CallWithError", "route:cache").Run(func(args mock.Arguments) { calls = append(calls, "route:cache") }).Return(nil).Once()
+
+	optimizeCommand := NewOptimizeCommand(mockArtisan, map[string]string{
+		"routes": "route:cache",
+		"config": "config:cache",
+	})
+	assert.Nil(t, optimizeCommand.Handle(mockContext))
+	assert.Equal(t, []string{"config:cache", "route:cache"}, calls)
+
+	assert.Nil(t, NewOptimizeCommand(mockArtisan, map[string]string{}).Handle(mockContext))
+
+	mockArtisan.AssertExpectations(t)
+}
+
+func TestOptimizeCommand_Failed(t *testing.T) {
+	mockArtisan := &consolemocks.Artisan{}
+	mockContext := &consolemocks.Context{}
+
+	mockArtisan.On("CallWithError", "config:cache").Return(errors.New("invalid .env")).Once()
+	mockArtisan.On("CallWithError", "route:cache").Return(nil).Once()
+
+	optimizeCommand := NewOptimizeCommand(mockArtisan, map[string]string{
+		"config": "config:cache",
+		"routes": "route:cache",
+	})
+	assert.EqualError(t, optimizeCommand.Handle(mockContext), "failed to run the commands of [config]")
+
+	mockArtisan.AssertExpectations(t)
+}
+
+func TestOptimizeClearCommand(t *testing.T) {
+	mockArtisan := &consolemocks.Artisan{}
+	mockContext := &consolemocks.Context{}
+
+	mockArtisan.On("CallWithError", "config:clear").Return(nil).Once()
+	mockArtisan.On("CallWithError", "route:clear").Return(nil).Once()
+
+	optimizeClearCommand := NewOptimizeClearCommand(mockArtisan, map[string]string{
+		"config": "config:clear",
+		"route":  "route:clear",
+	})
+	assert.Nil(t, optimizeClearCommand.Handle(mockContext))
+
+	mockArtisan.AssertExpectations(t)
+}
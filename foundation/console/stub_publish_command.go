@@ -0,0 +1,120 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	authconsole "github.com/goravel/framework/auth/console"
+	consoleconsole "github.com/goravel/framework/console/console"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	databaseconsole "github.com/goravel/framework/database/console"
+	"github.com/goravel/framework/database/migration"
+	eventconsole "github.com/goravel/framework/event/console"
+	httpconsole "github.com/goravel/framework/http/console"
+	mailconsole "github.com/goravel/framework/mail/console"
+	queueconsole "github.com/goravel/framework/queue/console"
+	"github.com/goravel/framework/support/color"
+	supportconsole "github.com/goravel/framework/support/console"
+	"github.com/goravel/framework/support/file"
+	validationconsole "github.com/goravel/framework/validation/console"
+)
+
+type StubPublishCommand struct {
+}
+
+func NewStubPublishCommand() *StubPublishCommand {
+	return &StubPublishCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *StubPublishCommand) Signature() string {
+	return "stub:publish"
+}
+
+// Description The console command description.
+func (receiver *StubPublishCommand) Description() string {
+	return "Publish all stubs that are available for customization"
+}
+
+// Extend The console command extend.
+func (receiver *StubPublishCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "stub",
+		Flags: []command.Flag{
+			&command.BoolFlag{
+				Name:    "existing",
+				Aliases: []string{"e"},
+				Usage:   "Publish and overwrite only the files that have already been published",
+			},
+			&command.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Overwrite any existing files",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *StubPublishCommand) Handle(ctx console.Context) error {
+	pwd, _ := os.Getwd()
+	existing := ctx.OptionBool("existing")
+	force := ctx.OptionBool("force")
+	stubs := receiver.stubs()
+
+	names := make([]string, 0, len(stubs))
+	for name := range stubs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		target := filepath.Join(pwd, supportconsole.StubPath, name)
+		if (existing && !file.Exists(target)) || (!existing && !force && file.Exists(target)) {
+			continue
+		}
+
+		if err := file.Create(target, stubs[name]); err != nil {
+			return err
+		}
+	}
+
+	color.Green().Println("Stubs published successfully")
+
+	return nil
+}
+
+// stubs The framework stubs that can be customized, keyed by the file name in the stubs directory.
+func (receiver *StubPublishCommand) stubs() map[string]string {
+	return map[string]string{
+		"command.stub":             consoleconsole.Stubs{}.Command(),
+		"controller.stub":          httpconsole.Stubs{}.Controller(),
+		"controller.resource.stub": httpconsole.Stubs{}.ResourceController(),
+		"crud.controller.stub":     CrudStubs{}.Controller(),
+		"crud.factory.stub":        CrudStubs{}.Factory(),
+		"crud.policy.stub":         CrudStubs{}.Policy(),
+		"crud.request.stub":        CrudStubs{}.Request(),
+		"crud.resource.stub":       CrudStubs{}.Resource(),
+		"crud.route.stub":          CrudStubs{}.Route(),
+		"crud.test.stub":           CrudStubs{}.Test(),
+		"event.stub":               eventconsole.Stubs{}.Event(),
+		"factory.stub":             databaseconsole.Stubs{}.Factory(),
+		"filter.stub":              validationconsole.Stubs{}.Filter(),
+		"job.stub":                 queueconsole.JobStubs{}.Job(),
+		"listener.stub":            eventconsole.Stubs{}.Listener(),
+		"mail.stub":                mailconsole.Stubs{}.Mail(),
+		"middleware.stub":          httpconsole.Stubs{}.Middleware(),
+		"migration.stub":           migration.Stubs{}.Empty(),
+		"migration.create.stub":    migration.Stubs{}.Create(),
+		"migration.update.stub":    migration.Stubs{}.Update(),
+		"model.stub":               databaseconsole.Stubs{}.Model(),
+		"observer.stub":            databaseconsole.Stubs{}.Observer(),
+		"policy.stub":              authconsole.PolicyStubs{}.Policy(),
+		"request.stub":             httpconsole.Stubs{}.Request(),
+		"rule.stub":                validationconsole.Stubs{}.Rule(),
+		"seeder.stub":              databaseconsole.Stubs{}.Seeder(),
+		"test.stub":                Stubs{}.Test(),
+	}
+}
@@ -0,0 +1,45 @@
+package console
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	consolemocks "github.com/goravel/framework/mocks/console"
+	supportconsole "github.com/goravel/framework/support/console"
+	"github.com/goravel/framework/support/file"
+)
+
+func TestStubPublishCommand(t *testing.T) {
+	stubPublishCommand := NewStubPublishCommand()
+	mockContext := &consolemocks.Context{}
+	mockContext.On("OptionBool", "existing").Return(false).Once()
+	mockContext.On("OptionBool", "force").Return(false).Once()
+	assert.Nil(t, stubPublishCommand.Handle(mockContext))
+	assert.True(t, file.Contain(filepath.Join("stubs", "test.stub"), "DummyTest"))
+	assert.True(t, file.Exists(filepath.Join("stubs", "controller.resource.stub")))
+	assert.Equal(t, Stubs{}.Test(), supportconsole.Stub("test.stub", ""))
+
+	assert.Nil(t, file.Create(filepath.Join("stubs", "test.stub"), "custom"))
+	mockContext.On("OptionBool", "existing").Return(false).Once()
+	mockContext.On("OptionBool", "force").Return(false).Once()
+	assert.Nil(t, stubPublishCommand.Handle(mockContext))
+	assert.Equal(t, "custom", supportconsole.Stub("test.stub", Stubs{}.Test()))
+
+	assert.Nil(t, file.Remove(filepath.Join("stubs", "model.stub")))
+	mockContext.On("OptionBool", "existing").Return(true).Once()
+	mockContext.On("OptionBool", "force").Return(false).Once()
+	assert.Nil(t, stubPublishCommand.Handle(mockContext))
+	assert.Equal(t, Stubs{}.Test(), supportconsole.Stub("test.stub", ""))
+	assert.False(t, file.Exists(filepath.Join("stubs", "model.stub")))
+
+	mockContext.On("OptionBool", "existing").Return(false).Once()
+	mockContext.On("OptionBool", "force").Return(true).Once()
+	assert.Nil(t, stubPublishCommand.Handle(mockContext))
+	assert.True(t, file.Exists(filepath.Join("stubs", "model.stub")))
+
+	assert.Nil(t, file.Remove("stubs"))
+
+	mockContext.AssertExpectations(t)
+}
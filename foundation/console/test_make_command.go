@@ -55,13 +55,13 @@ func (receiver *TestMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Test created successfully")
+	color.Green().Printf("Test [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *TestMakeCommand) getStub() string {
-	return Stubs{}.Test()
+	return supportconsole.Stub("test.stub", Stubs{}.Test())
 }
 
 // populateStub Populate the place-holders in the command stub.
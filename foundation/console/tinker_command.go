@@ -0,0 +1,221 @@
+package console
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+const tinkerHelp = `Available statements:
+  config <key>                  Display the value of a configuration
+  env <key>                     Display the value of an environment variable
+  connection [name]             Display or switch the database connection used by "sql"
+  sql <query>                   Run a raw SQL query, the rows of a SELECT are displayed as a table
+  dispatch <job> [args...]      Dispatch a registered job, the arguments are passed as strings
+  artisan <command>             Run an Artisan command
+  help                          Display this help
+  exit                          Exit the shell`
+
+type TinkerCommand struct {
+	app        foundation.Application
+	connection string
+	input      io.Reader
+}
+
+func NewTinkerCommand(app foundation.Application) *TinkerCommand {
+	return &TinkerCommand{
+		app:   app,
+		input: os.Stdin,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *TinkerCommand) Signature() string {
+	return "tinker"
+}
+
+// Description The console command description.
+func (receiver *TinkerCommand) Description() string {
+	return "Interact with your application"
+}
+
+// Extend The console command extend.
+func (receiver *TinkerCommand) Extend() command.Extend {
+	return command.Extend{}
+}
+
+// Handle Execute the console command.
+func (receiver *TinkerCommand) Handle(ctx console.Context) error {
+	color.Green().Println("Goravel tinker, type \"help\" to list the available statements, use --env to run against another environment.")
+
+	scanner := bufio.NewScanner(receiver.input)
+	for {
+		color.Default().Print(">>> ")
+		if !scanner.Scan() {
+			ctx.NewLine()
+
+			return scanner.Err()
+		}
+
+		statement := strings.TrimSpace(scanner.Text())
+		if statement == "" {
+			continue
+		}
+		if statement == "exit" || statement == "quit" {
+			return nil
+		}
+
+		if err := receiver.execute(ctx, statement); err != nil {
+			color.Red().Println(err.Error())
+		}
+	}
+}
+
+func (receiver *TinkerCommand) execute(ctx console.Context, statement string) error {
+	name, argument, _ := strings.Cut(statement, " ")
+	argument = strings.TrimSpace(argument)
+
+	switch name {
+	case "help":
+		color.Default().Println(tinkerHelp)
+
+		return nil
+	case "config":
+		return receiver.dump(receiver.app.MakeConfig().Get(argument))
+	case "env":
+		return receiver.dump(receiver.app.MakeConfig().Env(argument))
+	case "connection":
+		if argument == "" {
+			connection := receiver.connection
+			if connection == "" {
+				connection = receiver.app.MakeConfig().GetString("database.default")
+			}
+			color.Default().Println(connection)
+
+			return nil
+		}
+		receiver.connection = argument
+
+		return nil
+	case "sql":
+		return receiver.sql(ctx, argument)
+	case "dispatch":
+		return receiver.dispatch(argument)
+	case "artisan":
+		receiver.app.MakeArtisan().Call(argument)
+
+		return nil
+	default:
+		return fmt.Errorf("unknown statement [%s], type \"help\" to list the available statements", name)
+	}
+}
+
+func (receiver *TinkerCommand) dump(value any) error {
+	content, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	color.Default().Println(string(content))
+
+	return nil
+}
+
+// sql Run a raw query on the current connection, the statements returning rows are displayed as a table.
+func (receiver *TinkerCommand) sql(ctx console.Context, query string) error {
+	if query == "" {
+		return fmt.Errorf("the query is required")
+	}
+
+	instance := receiver.app.MakeOrm()
+	if receiver.connection != "" {
+		instance = instance.Connection(receiver.connection)
+	}
+
+	switch strings.ToLower(strings.Fields(query)[0]) {
+	case "select", "show", "with", "pragma", "describe", "desc", "explain":
+		var rows []map[string]any
+		if err := instance.Query().Raw(query).Scan(&rows); err != nil {
+			return err
+		}
+
+		return receiver.table(ctx, rows)
+	default:
+		result, err := instance.Query().Exec(query)
+		if err != nil {
+			return err
+		}
+
+		color.Green().Printf("Query OK, %d rows affected\n", result.RowsAffected)
+
+		return nil
+	}
+}
+
+func (receiver *TinkerCommand) table(ctx console.Context, rows []map[string]any) error {
+	if len(rows) == 0 {
+		color.Yellow().Println("Empty set")
+
+		return nil
+	}
+
+	var headers []string
+	for column := range rows[0] {
+		headers = append(headers, column)
+	}
+	sort.Strings(headers)
+
+	data := make([][]string, len(rows))
+	for i, row := range rows {
+		data[i] = make([]string, len(headers))
+		for j, header := range headers {
+			if value, ok := row[header].([]byte); ok {
+				data[i][j] = string(value)
+			} else {
+				data[i][j] = cast.ToString(row[header])
+			}
+		}
+	}
+
+	return ctx.Table(headers, data)
+}
+
+func (receiver *TinkerCommand) dispatch(statement string) error {
+	fields := strings.Fields(statement)
+	if len(fields) == 0 {
+		return fmt.Errorf("the job is required")
+	}
+
+	queueInstance := receiver.app.MakeQueue()
+	for _, job := range queueInstance.GetJobs() {
+		if job.Signature() != fields[0] {
+			continue
+		}
+
+		args := make([]queue.Arg, len(fields)-1)
+		for i, field := range fields[1:] {
+			args[i] = queue.Arg{Type: "string", Value: field}
+		}
+		if err := queueInstance.Job(job, args).Dispatch(); err != nil {
+			return err
+		}
+
+		color.Green().Printf("Job [%s] dispatched\n", fields[0])
+
+		return nil
+	}
+
+	return fmt.Errorf("job [%s] is not registered", fields[0])
+}
@@ -0,0 +1,82 @@
+package console
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/console"
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/queue"
+	configmocks "github.com/goravel/framework/mocks/config"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	ormmocks "github.com/goravel/framework/mocks/database/orm"
+	foundationmocks "github.com/goravel/framework/mocks/foundation"
+	queuemocks "github.com/goravel/framework/mocks/queue"
+)
+
+func TestTinkerCommand(t *testing.T) {
+	mockApp := foundationmocks.NewApplication(t)
+	mockConfig := configmocks.NewConfig(t)
+	mockOrm := ormmocks.NewOrm(t)
+	mockQuery := ormmocks.NewQuery(t)
+	mockQueue := queuemocks.NewQueue(t)
+	mockTask := queuemocks.NewTask(t)
+	mockJob := queuemocks.NewJob(t)
+	mockArtisan := consolemocks.NewArtisan(t)
+
+	mockApp.EXPECT().MakeConfig().Return(mockConfig).Times(3)
+	mockConfig.EXPECT().Get("app.name").Return("goravel").Once()
+	mockConfig.EXPECT().Env("APP_ENV").Return("testing").Once()
+	mockConfig.EXPECT().GetString("database.default").Return("mysql").Once()
+
+	mockApp.EXPECT().MakeOrm().Return(mockOrm).Twice()
+	mockOrm.EXPECT().Connection("sqlite").Return(mockOrm).Twice()
+	mockOrm.EXPECT().Query().Return(mockQuery).Twice()
+	mockQuery.EXPECT().Raw("select id, name from users").Return(mockQuery).Once()
+	mockQuery.EXPECT().Scan(mock.Anything).RunAndReturn(func(dest any) error {
+		*dest.(*[]map[string]any) = []map[string]any{{"id": int64(1), "name": []byte("goravel")}}
+		return nil
+	}).Once()
+	mockQuery.EXPECT().Exec("delete from users").Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+
+	mockApp.EXPECT().MakeQueue().Return(mockQueue).Twice()
+	mockQueue.EXPECT().GetJobs().Return([]queue.Job{mockJob}).Twice()
+	mockJob.EXPECT().Signature().Return("send_email")
+	mockQueue.EXPECT().Job(mockJob, []queue.Arg{{Type: "string", Value: "1"}}).Return(mockTask).Once()
+	mockTask.EXPECT().Dispatch().Return(nil).Once()
+
+	mockApp.EXPECT().MakeArtisan().Return(mockArtisan).Once()
+	mockArtisan.EXPECT().Call("route:list").Once()
+
+	tinkerCommand := NewTinkerCommand(mockApp)
+	tinkerCommand.input = strings.NewReader(strings.Join([]string{
+		"help",
+		"config app.name",
+		"env APP_ENV",
+		"connection",
+		"connection sqlite",
+		"sql select id, name from users",
+		"sql delete from users",
+		"dispatch send_email 1",
+		"dispatch unknown",
+		"artisan route:list",
+		"unknown",
+		"exit",
+		"config ignored",
+	}, "\n"))
+
+	console.NewTester(t, tinkerCommand).
+		Run().
+		AssertSuccessful().
+		AssertOutputContains("Available statements:").
+		AssertOutputContains(`"goravel"`).
+		AssertOutputContains(`"testing"`).
+		AssertOutputContains("mysql").
+		AssertTable([]string{"id", "name"}, [][]string{{"1", "goravel"}}).
+		AssertOutputContains("Query OK, 1 rows affected").
+		AssertOutputContains("Job [send_email] dispatched").
+		AssertOutputContains("job [unknown] is not registered").
+		AssertOutputContains("unknown statement [unknown]")
+}
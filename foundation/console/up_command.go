@@ -0,0 +1,49 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/http/maintenance"
+	"github.com/goravel/framework/support/color"
+)
+
+type UpCommand struct {
+}
+
+func NewUpCommand() *UpCommand {
+	return &UpCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *UpCommand) Signature() string {
+	return "up"
+}
+
+// Description The console command description.
+func (receiver *UpCommand) Description() string {
+	return "Bring the application out of maintenance mode"
+}
+
+// Extend The console command extend.
+func (receiver *UpCommand) Extend() command.Extend {
+	return command.Extend{}
+}
+
+// Handle Execute the console command.
+func (receiver *UpCommand) Handle(ctx console.Context) error {
+	if !maintenance.IsDown() {
+		color.Yellow().Println("Application is not in maintenance mode.")
+
+		return nil
+	}
+
+	if err := maintenance.Remove(); err != nil {
+		color.Red().Println("Failed to bring the application out of maintenance mode:", err.Error())
+
+		return nil
+	}
+
+	color.Green().Println("Application is now live.")
+
+	return nil
+}
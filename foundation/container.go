@@ -2,6 +2,7 @@ package foundation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -19,11 +20,15 @@ import (
 	ormcontract "github.com/goravel/framework/contracts/database/orm"
 	seerdercontract "github.com/goravel/framework/contracts/database/seeder"
 	eventcontract "github.com/goravel/framework/contracts/event"
+	featurecontract "github.com/goravel/framework/contracts/feature"
 	filesystemcontract "github.com/goravel/framework/contracts/filesystem"
 	foundationcontract "github.com/goravel/framework/contracts/foundation"
 	grpccontract "github.com/goravel/framework/contracts/grpc"
 	hashcontract "github.com/goravel/framework/contracts/hash"
+	healthcontract "github.com/goravel/framework/contracts/health"
 	httpcontract "github.com/goravel/framework/contracts/http"
+	httpclientcontract "github.com/goravel/framework/contracts/http/client"
+	idcontract "github.com/goravel/framework/contracts/id"
 	logcontract "github.com/goravel/framework/contracts/log"
 	mailcontract "github.com/goravel/framework/contracts/mail"
 	queuecontract "github.com/goravel/framework/contracts/queue"
@@ -33,13 +38,17 @@ import (
 	testingcontract "github.com/goravel/framework/contracts/testing"
 	translationcontract "github.com/goravel/framework/contracts/translation"
 	validationcontract "github.com/goravel/framework/contracts/validation"
+	websocketcontract "github.com/goravel/framework/contracts/websocket"
 	"github.com/goravel/framework/crypt"
 	"github.com/goravel/framework/database"
 	"github.com/goravel/framework/event"
+	"github.com/goravel/framework/feature"
 	"github.com/goravel/framework/filesystem"
 	"github.com/goravel/framework/grpc"
 	"github.com/goravel/framework/hash"
+	"github.com/goravel/framework/health"
 	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/id"
 	goravellog "github.com/goravel/framework/log"
 	"github.com/goravel/framework/mail"
 	"github.com/goravel/framework/queue"
@@ -50,6 +59,7 @@ import (
 	"github.com/goravel/framework/testing"
 	"github.com/goravel/framework/translation"
 	"github.com/goravel/framework/validation"
+	"github.com/goravel/framework/websocket"
 )
 
 type instance struct {
@@ -57,7 +67,14 @@ type instance struct {
 	shared   bool
 }
 
+// shutdowner is implemented by the services which can be shut down gracefully, e.g. the route, the queue and the
+// schedule.
+type shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
 type Container struct {
+	app       foundationcontract.Application
 	bindings  sync.Map
 	instances sync.Map
 }
@@ -144,6 +161,16 @@ func (c *Container) MakeEvent() eventcontract.Instance {
 	return instance.(eventcontract.Instance)
 }
 
+func (c *Container) MakeFeature() featurecontract.Feature {
+	instance, err := c.Make(feature.Binding)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	return instance.(featurecontract.Feature)
+}
+
 func (c *Container) MakeGate() accesscontract.Gate {
 	instance, err := c.Make(auth.BindingGate)
 	if err != nil {
@@ -174,6 +201,36 @@ func (c *Container) MakeHash() hashcontract.Hash {
 	return instance.(hashcontract.Hash)
 }
 
+func (c *Container) MakeHealth() healthcontract.Health {
+	instance, err := c.Make(health.Binding)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	return instance.(healthcontract.Health)
+}
+
+func (c *Container) MakeHttp() httpclientcontract.Factory {
+	instance, err := c.Make(http.BindingHttp)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	return instance.(httpclientcontract.Factory)
+}
+
+func (c *Container) MakeID() idcontract.ID {
+	instance, err := c.Make(id.Binding)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	return instance.(idcontract.ID)
+}
+
 func (c *Container) MakeLang(ctx context.Context) translationcontract.Translator {
 	instance, err := c.MakeWith(translation.Binding, map[string]any{
 		"ctx": ctx,
@@ -236,6 +293,16 @@ func (c *Container) MakeRateLimiter() httpcontract.RateLimiter {
 	return instance.(httpcontract.RateLimiter)
 }
 
+func (c *Container) MakeResponseTransformer() httpcontract.ResponseTransformer {
+	instance, err := c.Make(http.BindingResponseTransformer)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	return instance.(httpcontract.ResponseTransformer)
+}
+
 func (c *Container) MakeRoute() routecontract.Route {
 	instance, err := c.Make(route.Binding)
 	if err != nil {
@@ -246,6 +313,16 @@ func (c *Container) MakeRoute() routecontract.Route {
 	return instance.(routecontract.Route)
 }
 
+func (c *Container) MakeURL() routecontract.URL {
+	instance, err := c.Make(route.BindingURL)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	return instance.(routecontract.URL)
+}
+
 func (c *Container) MakeSchedule() schedulecontract.Schedule {
 	instance, err := c.Make(schedule.Binding)
 	if err != nil {
@@ -327,6 +404,16 @@ func (c *Container) MakeSeeder() seerdercontract.Facade {
 	return instance.(seerdercontract.Facade)
 }
 
+func (c *Container) MakeWebSocket() websocketcontract.Hub {
+	instance, err := c.Make(websocket.Binding)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	return instance.(websocketcontract.Hub)
+}
+
 func (c *Container) MakeWith(key any, parameters map[string]any) (any, error) {
 	return c.make(key, parameters)
 }
@@ -351,7 +438,7 @@ func (c *Container) make(key any, parameters map[string]any) (any, error) {
 	bindingImpl := binding.(instance)
 	switch concrete := bindingImpl.concrete.(type) {
 	case func(app foundationcontract.Application) (any, error):
-		concreteImpl, err := concrete(App)
+		concreteImpl, err := concrete(c.application())
 		if err != nil {
 			return nil, err
 		}
@@ -361,7 +448,7 @@ func (c *Container) make(key any, parameters map[string]any) (any, error) {
 
 		return concreteImpl, nil
 	case func(app foundationcontract.Application, parameters map[string]any) (any, error):
-		concreteImpl, err := concrete(App, parameters)
+		concreteImpl, err := concrete(c.application(), parameters)
 		if err != nil {
 			return nil, err
 		}
@@ -373,3 +460,37 @@ func (c *Container) make(key any, parameters map[string]any) (any, error) {
 		return concrete, nil
 	}
 }
+
+// application Get the application the bindings are resolved with, it's the global application by default.
+func (c *Container) application() foundationcontract.Application {
+	if c.app != nil {
+		return c.app
+	}
+
+	return App
+}
+
+// shutdown Shut down the resolved singletons which can be shut down gracefully in parallel.
+func (c *Container) shutdown(ctx context.Context) error {
+	var shutdowners []shutdowner
+	c.instances.Range(func(_, value any) bool {
+		if instance, ok := value.(shutdowner); ok {
+			shutdowners = append(shutdowners, instance)
+		}
+
+		return true
+	})
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(shutdowners))
+	for i, instance := range shutdowners {
+		wg.Add(1)
+		go func(i int, instance shutdowner) {
+			defer wg.Done()
+			errs[i] = instance.Shutdown(ctx)
+		}(i, instance)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
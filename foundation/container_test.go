@@ -1,7 +1,10 @@
 package foundation
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -171,3 +174,40 @@ func (s *ContainerTestSuite) TestMake() {
 		})
 	}
 }
+
+func (s *ContainerTestSuite) TestShutdown() {
+	var shutdown []string
+	var mu sync.Mutex
+	singleton := func(key string, err error) {
+		s.container.Singleton(key, func(app foundation.Application) (any, error) {
+			return &shutdownService{shutdown: func(ctx context.Context) error {
+				mu.Lock()
+				defer mu.Unlock()
+				shutdown = append(shutdown, key)
+
+				return err
+			}}, nil
+		})
+	}
+	singleton("a", nil)
+	singleton("b", errors.New("b"))
+	singleton("c", nil)
+	s.container.Instance("d", 1)
+
+	// The singletons which aren't resolved aren't shut down.
+	for _, key := range []string{"a", "b", "d"} {
+		_, err := s.container.Make(key)
+		s.Nil(err)
+	}
+
+	s.EqualError(s.container.shutdown(context.Background()), "b")
+	s.ElementsMatch([]string{"a", "b"}, shutdown)
+}
+
+type shutdownService struct {
+	shutdown func(ctx context.Context) error
+}
+
+func (r *shutdownService) Shutdown(ctx context.Context) error {
+	return r.shutdown(ctx)
+}
@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/goravel/framework/config"
+	"github.com/goravel/framework/console"
+	"github.com/goravel/framework/contracts/foundation"
+	frameworkfoundation "github.com/goravel/framework/foundation"
+	"github.com/goravel/framework/log"
+	"github.com/goravel/framework/mail"
+)
+
+// TestApp builds a minimal application for the unit tests of a package, only the selected service providers are
+// booted, and the in-memory drivers are configured by default: a sqlite memory database, a memory cache, a sync
+// queue and an array mail driver, so the tests don't depend on docker or the .env file.
+type TestApp struct {
+	config    map[string]any
+	providers []foundation.ServiceProvider
+}
+
+func NewTestApp() *TestApp {
+	return &TestApp{
+		config: make(map[string]any),
+	}
+}
+
+// WithConfig Set a config, it overrides the default config of the same name, e.g. "cache.default".
+func (r *TestApp) WithConfig(name string, configuration any) *TestApp {
+	r.config[name] = configuration
+
+	return r
+}
+
+// WithProviders Select the service providers to boot, the providers they depend on should be selected too, e.g.
+// the cache provider for the queue provider. The console and log providers are always booted.
+func (r *TestApp) WithProviders(providers ...foundation.ServiceProvider) *TestApp {
+	r.providers = append(r.providers, providers...)
+
+	return r
+}
+
+// Boot Register and boot the selected service providers, each application has its own sqlite memory database.
+func (r *TestApp) Boot() foundation.Application {
+	configuration := config.NewMemoryApplication(defaultConfig())
+	for name, value := range r.config {
+		configuration.Add(name, value)
+	}
+
+	providers := append([]foundation.ServiceProvider{
+		&console.ServiceProvider{},
+		&log.ServiceProvider{},
+	}, r.providers...)
+
+	return frameworkfoundation.NewTestApplication(configuration, providers)
+}
+
+func defaultConfig() map[string]any {
+	return map[string]any{
+		"app": map[string]any{
+			"name":     "Goravel",
+			"env":      "testing",
+			"debug":    false,
+			"timezone": "UTC",
+			"locale":   "en",
+			"key":      "goravel_test_app_key_of_32_bytes",
+		},
+		"logging": map[string]any{
+			"default": "",
+		},
+		"cache": map[string]any{
+			"default": "memory",
+			"stores": map[string]any{
+				"memory": map[string]any{
+					"driver": "memory",
+				},
+			},
+			"prefix": "goravel_cache",
+		},
+		"database": map[string]any{
+			"default": "sqlite",
+			"connections": map[string]any{
+				"sqlite": map[string]any{
+					"driver":   "sqlite",
+					"database": "file:goravel_" + uuid.NewString() + "?mode=memory&cache=shared",
+					"prefix":   "",
+					"singular": false,
+				},
+			},
+			// The memory database is dropped when its last connection is closed.
+			"pool": map[string]any{
+				"conn_max_idletime": 0,
+				"conn_max_lifetime": 0,
+			},
+			"migrations": "migrations",
+		},
+		"queue": map[string]any{
+			"default": "sync",
+			"connections": map[string]any{
+				"sync": map[string]any{
+					"driver": "sync",
+				},
+			},
+		},
+		"mail": map[string]any{
+			"driver": mail.DriverArray,
+			"from": map[string]any{
+				"address": "hello@example.com",
+				"name":    "Goravel",
+			},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/cache"
+	contractsmail "github.com/goravel/framework/contracts/mail"
+	"github.com/goravel/framework/database"
+	"github.com/goravel/framework/mail"
+	"github.com/goravel/framework/queue"
+)
+
+func TestTestApp(t *testing.T) {
+	app := NewTestApp().
+		WithProviders(&cache.ServiceProvider{}, &database.ServiceProvider{}, &queue.ServiceProvider{}, &mail.ServiceProvider{}).
+		WithConfig("app.name", "Test").
+		Boot()
+
+	assert.Equal(t, "Test", app.MakeConfig().GetString("app.name"))
+	assert.Equal(t, "memory", app.MakeConfig().GetString("cache.stores.memory.driver"))
+
+	assert.Nil(t, app.MakeCache().Put("name", "goravel", time.Minute))
+	assert.Equal(t, "goravel", app.MakeCache().GetString("name"))
+
+	query := app.MakeOrm().Query()
+	_, err := query.Exec("CREATE TABLE users (id integer PRIMARY KEY AUTOINCREMENT, name varchar(255) NOT NULL)")
+	assert.Nil(t, err)
+	_, err = query.Exec("INSERT INTO users (name) VALUES (?)", "goravel")
+	assert.Nil(t, err)
+	var count int64
+	assert.Nil(t, app.MakeOrm().Query().Table("users").Count(&count))
+	assert.Equal(t, int64(1), count)
+
+	mail.FlushSent()
+	assert.Nil(t, app.MakeMail().To([]string{"to@example.com"}).Subject("Subject").Content(contractsmail.Content{Html: "<p>Hello</p>"}).Send())
+	assert.Nil(t, app.MakeMail().To([]string{"queue@example.com"}).Subject("Queued").Queue())
+	sent := mail.Sent()
+	assert.Len(t, sent, 2)
+	assert.Equal(t, "Subject", sent[0].Subject)
+	assert.Equal(t, "Goravel <hello@example.com>", sent[0].From)
+	assert.Equal(t, []string{"queue@example.com"}, sent[1].To)
+	mail.FlushSent()
+
+	// Each application has its own database.
+	other := NewTestApp().WithProviders(&database.ServiceProvider{}).Boot()
+	_, err = other.MakeOrm().Query().Exec("SELECT * FROM users")
+	assert.ErrorContains(t, err, "no such table")
+}
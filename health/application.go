@@ -0,0 +1,115 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/goravel/framework/contracts/config"
+	contractshealth "github.com/goravel/framework/contracts/health"
+)
+
+type Application struct {
+	checks map[string]contractshealth.Check
+	mu     sync.RWMutex
+	// timeout The time a check can take, the check is down once it's reached.
+	timeout time.Duration
+}
+
+func NewApplication(config config.Config) *Application {
+	return &Application{
+		checks:  make(map[string]contractshealth.Check),
+		timeout: time.Duration(config.GetInt("health.timeout", 5)) * time.Second,
+	}
+}
+
+func (app *Application) Register(name string, check contractshealth.Check) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	app.checks[name] = check
+}
+
+func (app *Application) Forget(name string) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	delete(app.checks, name)
+}
+
+// Run Run the checks concurrently, each check is down if it returns an error, panics or doesn't return in time.
+func (app *Application) Run(ctx context.Context) contractshealth.Report {
+	app.mu.RLock()
+	checks := make(map[string]contractshealth.Check, len(app.checks))
+	for name, check := range app.checks {
+		checks[name] = check
+	}
+	app.mu.RUnlock()
+
+	report := contractshealth.Report{
+		Status: contractshealth.StatusUp,
+		Checks: make(map[string]contractshealth.Result, len(checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	start := time.Now()
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check contractshealth.Check) {
+			defer wg.Done()
+
+			result := app.run(ctx, check)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+			if result.Status == contractshealth.StatusDown {
+				report.Status = contractshealth.StatusDown
+			}
+		}(name, check)
+	}
+	wg.Wait()
+	report.Latency = time.Since(start)
+
+	return report
+}
+
+func (app *Application) run(ctx context.Context, check contractshealth.Check) contractshealth.Result {
+	if app.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, app.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				errCh <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		errCh <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := contractshealth.Result{
+		Status:  contractshealth.StatusUp,
+		Latency: time.Since(start),
+	}
+	if err != nil {
+		result.Status = contractshealth.StatusDown
+		result.Error = err.Error()
+	}
+
+	return result
+}
@@ -0,0 +1,158 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractshealth "github.com/goravel/framework/contracts/health"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	configmocks "github.com/goravel/framework/mocks/config"
+	healthmocks "github.com/goravel/framework/mocks/health"
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func TestApplication(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetInt("health.timeout", 5).Return(1).Once()
+	app := NewApplication(mockConfig)
+
+	report := app.Run(context.Background())
+	assert.Equal(t, contractshealth.StatusUp, report.Status)
+	assert.Empty(t, report.Checks)
+
+	app.Register("database", func(ctx context.Context) error {
+		time.Sleep(10 * time.Millisecond)
+
+		return nil
+	})
+	app.Register("redis", func(ctx context.Context) error {
+		return nil
+	})
+	report = app.Run(context.Background())
+	assert.Equal(t, contractshealth.StatusUp, report.Status)
+	assert.Len(t, report.Checks, 2)
+	assert.Equal(t, contractshealth.StatusUp, report.Checks["database"].Status)
+	assert.True(t, report.Checks["database"].Latency >= 10*time.Millisecond)
+	assert.True(t, report.Latency >= report.Checks["database"].Latency)
+
+	// The checks returning an error, panicking or not returning in time are down.
+	app.Register("queue", func(ctx context.Context) error {
+		return errors.New("the queue has 1000 pending jobs, more than 100")
+	})
+	app.Register("disk", func(ctx context.Context) error {
+		panic("disk is gone")
+	})
+	app.Register("redis", func(ctx context.Context) error {
+		time.Sleep(2 * time.Second)
+
+		return nil
+	})
+	report = app.Run(context.Background())
+	assert.Equal(t, contractshealth.StatusDown, report.Status)
+	assert.Equal(t, contractshealth.StatusUp, report.Checks["database"].Status)
+	assert.Equal(t, contractshealth.Result{
+		Status:  contractshealth.StatusDown,
+		Latency: report.Checks["queue"].Latency,
+		Error:   "the queue has 1000 pending jobs, more than 100",
+	}, report.Checks["queue"])
+	assert.Equal(t, "panic: disk is gone", report.Checks["disk"].Error)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["redis"].Error)
+	assert.True(t, report.Checks["redis"].Latency < 2*time.Second)
+
+	app.Forget("queue")
+	app.Forget("disk")
+	app.Forget("redis")
+	report = app.Run(context.Background())
+	assert.Equal(t, contractshealth.StatusUp, report.Status)
+	assert.Len(t, report.Checks, 1)
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		report contractshealth.Report
+		debug  bool
+		code   int
+		body   map[string]any
+	}{
+		{
+			name: "up",
+			report: contractshealth.Report{
+				Status:  contractshealth.StatusUp,
+				Latency: 1500 * time.Microsecond,
+				Checks: map[string]contractshealth.Result{
+					"database": {Status: contractshealth.StatusUp, Latency: 1500 * time.Microsecond},
+				},
+			},
+			code: contractshttp.StatusOK,
+			body: map[string]any{
+				"status":     contractshealth.StatusUp,
+				"latency_ms": 1.5,
+				"checks": map[string]any{
+					"database": map[string]any{"status": contractshealth.StatusUp, "latency_ms": 1.5},
+				},
+			},
+		},
+		{
+			name: "down",
+			report: contractshealth.Report{
+				Status:  contractshealth.StatusDown,
+				Latency: 2 * time.Millisecond,
+				Checks: map[string]contractshealth.Result{
+					"database": {Status: contractshealth.StatusUp, Latency: time.Millisecond},
+					"redis":    {Status: contractshealth.StatusDown, Latency: 2 * time.Millisecond, Error: "connection refused"},
+				},
+			},
+			code: contractshttp.StatusServiceUnavailable,
+			body: map[string]any{
+				"status":     contractshealth.StatusDown,
+				"latency_ms": 2.0,
+				"checks": map[string]any{
+					"database": map[string]any{"status": contractshealth.StatusUp, "latency_ms": 1.0},
+					"redis":    map[string]any{"status": contractshealth.StatusDown, "latency_ms": 2.0},
+				},
+			},
+		},
+		{
+			name: "down in debug mode",
+			report: contractshealth.Report{
+				Status:  contractshealth.StatusDown,
+				Latency: 2 * time.Millisecond,
+				Checks: map[string]contractshealth.Result{
+					"database": {Status: contractshealth.StatusUp, Latency: time.Millisecond},
+					"redis":    {Status: contractshealth.StatusDown, Latency: 2 * time.Millisecond, Error: "connection refused"},
+				},
+			},
+			debug: true,
+			code:  contractshttp.StatusServiceUnavailable,
+			body: map[string]any{
+				"status":     contractshealth.StatusDown,
+				"latency_ms": 2.0,
+				"checks": map[string]any{
+					"database": map[string]any{"status": contractshealth.StatusUp, "latency_ms": 1.0},
+					"redis":    map[string]any{"status": contractshealth.StatusDown, "latency_ms": 2.0, "error": "connection refused"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockHealth := healthmocks.NewHealth(t)
+			mockContext := httpmocks.NewContext(t)
+			mockResponse := httpmocks.NewContextResponse(t)
+			mockJson := httpmocks.NewResponse(t)
+			mockHealth.EXPECT().Run(mock.Anything).Return(test.report).Once()
+			mockContext.EXPECT().Response().Return(mockResponse).Once()
+			mockResponse.EXPECT().Header("Cache-Control", "no-store").Return(mockResponse).Once()
+			mockResponse.EXPECT().Json(test.code, test.body).Return(mockJson).Once()
+
+			assert.Equal(t, mockJson, Handler(mockHealth, test.debug)(mockContext))
+		})
+	}
+}
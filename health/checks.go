@@ -0,0 +1,62 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	contractshealth "github.com/goravel/framework/contracts/health"
+	"github.com/goravel/framework/contracts/queue"
+)
+
+// Database Check whether the database of the orm can be pinged, use orm.Connection to check another connection.
+func Database(orm orm.Orm) contractshealth.Check {
+	return func(ctx context.Context) error {
+		db, err := orm.DB()
+		if err != nil {
+			return err
+		}
+
+		return db.PingContext(ctx)
+	}
+}
+
+// Redis Check whether the Redis server can be pinged.
+func Redis(client redis.UniversalClient) contractshealth.Check {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
+
+// QueueDepth Check whether the pending jobs of a queue don't exceed the maximum, the empty connection and queue are
+// the default ones.
+func QueueDepth(monitor queue.Monitor, connection, queue string, max int) contractshealth.Check {
+	return func(ctx context.Context) error {
+		size, err := monitor.Size(connection, queue)
+		if err != nil {
+			return err
+		}
+		if size > max {
+			return fmt.Errorf("the queue has %d pending jobs, more than %d", size, max)
+		}
+
+		return nil
+	}
+}
+
+// DiskSpace Check whether the free space of the disk containing the path isn't less than the minimum in bytes.
+func DiskSpace(path string, min uint64) contractshealth.Check {
+	return func(ctx context.Context) error {
+		free, err := freeSpace(path)
+		if err != nil {
+			return err
+		}
+		if free < min {
+			return fmt.Errorf("the disk has %d bytes free, less than %d", free, min)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,32 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	queuemocks "github.com/goravel/framework/mocks/queue"
+)
+
+func TestQueueDepth(t *testing.T) {
+	mockMonitor := queuemocks.NewMonitor(t)
+	check := QueueDepth(mockMonitor, "redis", "emails", 100)
+
+	mockMonitor.EXPECT().Size("redis", "emails").Return(100, nil).Once()
+	assert.Nil(t, check(context.Background()))
+
+	mockMonitor.EXPECT().Size("redis", "emails").Return(101, nil).Once()
+	assert.EqualError(t, check(context.Background()), "the queue has 101 pending jobs, more than 100")
+
+	mockMonitor.EXPECT().Size("redis", "emails").Return(0, errors.New("connection refused")).Once()
+	assert.EqualError(t, check(context.Background()), "connection refused")
+}
+
+func TestDiskSpace(t *testing.T) {
+	assert.Nil(t, DiskSpace(t.TempDir(), 1)(context.Background()))
+	assert.ErrorContains(t, DiskSpace(t.TempDir(), math.MaxUint64)(context.Background()), "bytes free, less than")
+	assert.Error(t, DiskSpace("/not/exist", 1)(context.Background()))
+}
@@ -0,0 +1,11 @@
+//go:build !(darwin || linux || windows)
+
+package health
+
+import (
+	"errors"
+)
+
+func freeSpace(path string) (uint64, error) {
+	return 0, errors.New("getting the free disk space is not supported on this platform")
+}
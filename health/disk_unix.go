@@ -0,0 +1,16 @@
+//go:build darwin || linux
+
+package health
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+func freeSpace(path string) (uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
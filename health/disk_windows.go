@@ -0,0 +1,21 @@
+//go:build windows
+
+package health
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+func freeSpace(path string) (uint64, error) {
+	dir, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var free uint64
+	if err := windows.GetDiskFreeSpaceEx(dir, &free, nil, nil); err != nil {
+		return 0, err
+	}
+
+	return free, nil
+}
@@ -0,0 +1,43 @@
+package health
+
+import (
+	"time"
+
+	contractshealth "github.com/goravel/framework/contracts/health"
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+// Handler Run the checks and report their results, the status is 503 if any check is down. The errors of the checks
+// may contain the hosts of the dependencies, so they're reported only in debug mode.
+func Handler(health contractshealth.Health, debug bool) contractshttp.HandlerFunc {
+	return func(ctx contractshttp.Context) contractshttp.Response {
+		report := health.Run(ctx)
+
+		checks := make(map[string]any, len(report.Checks))
+		for name, result := range report.Checks {
+			check := map[string]any{
+				"status":     result.Status,
+				"latency_ms": milliseconds(result.Latency),
+			}
+			if debug && result.Error != "" {
+				check["error"] = result.Error
+			}
+			checks[name] = check
+		}
+
+		code := contractshttp.StatusOK
+		if report.Status == contractshealth.StatusDown {
+			code = contractshttp.StatusServiceUnavailable
+		}
+
+		return ctx.Response().Header("Cache-Control", "no-store").Json(code, map[string]any{
+			"status":     report.Status,
+			"latency_ms": milliseconds(report.Latency),
+			"checks":     checks,
+		})
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,56 @@
+package health
+
+import (
+	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/route"
+)
+
+const Binding = "goravel.health"
+
+type ServiceProvider struct {
+}
+
+func (health *ServiceProvider) Register(app foundation.Application) {
+	app.Singleton(Binding, func(app foundation.Application) (any, error) {
+		return NewApplication(app.MakeConfig()), nil
+	})
+}
+
+func (health *ServiceProvider) Boot(app foundation.Application) {
+	health.registerChecks(app)
+	health.registerRoute(app)
+}
+
+// registerChecks Register the built-in checks enabled by the configuration, the other checks can be registered by
+// the service providers of the application via facades.Health().Register.
+func (health *ServiceProvider) registerChecks(app foundation.Application) {
+	config := app.MakeConfig()
+	instance := app.MakeHealth()
+
+	if config.GetBool("health.checks.database") {
+		instance.Register("database", Database(app.MakeOrm()))
+	}
+	// The monitor counts the jobs on the connections shared by the checks, so the endpoint doesn't open new ones.
+	if maxDepth := config.GetInt("health.checks.queue.max_depth"); maxDepth > 0 {
+		instance.Register("queue", QueueDepth(app.MakeQueue().Monitor(), "", "", maxDepth))
+	}
+	if minFree := config.GetInt("health.checks.disk.min_free"); minFree > 0 {
+		instance.Register("disk", DiskSpace(config.GetString("health.checks.disk.path", app.StoragePath()), uint64(minFree)*1024*1024))
+	}
+}
+
+// registerRoute Expose the checks on the health.path config, it's /up by default and disabled if it's empty.
+func (health *ServiceProvider) registerRoute(app foundation.Application) {
+	path := app.MakeConfig().GetString("health.path", "/up")
+	if path == "" {
+		return
+	}
+
+	// NewRoute returns nil if the http driver isn't configured.
+	router := app.MakeRoute()
+	if instance, ok := router.(*route.Route); router == nil || ok && instance == nil {
+		return
+	}
+
+	router.Get(path, Handler(app.MakeHealth(), app.MakeConfig().GetBool("app.debug")))
+}
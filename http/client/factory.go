@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/contracts/http/client"
+)
+
+// Factory Create the requests sharing a pooled transport configured by the http.client config.
+type Factory struct {
+	baseURL   string
+	client    *http.Client
+	fake      *fake
+	json      foundation.Json
+	mu        sync.RWMutex
+	timeout   time.Duration
+	transport *http.Transport
+}
+
+func NewFactory(config config.Config, json foundation.Json) *Factory {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = config.GetInt("http.client.max_idle_conns", 100)
+	transport.MaxIdleConnsPerHost = config.GetInt("http.client.max_idle_conns_per_host", 10)
+	transport.MaxConnsPerHost = config.GetInt("http.client.max_conns_per_host", 0)
+	transport.IdleConnTimeout = time.Duration(config.GetInt("http.client.idle_conn_timeout", 90)) * time.Second
+
+	factory := &Factory{
+		baseURL:   config.GetString("http.client.base_url"),
+		json:      json,
+		timeout:   time.Duration(config.GetInt("http.client.timeout", 30)) * time.Second,
+		transport: transport,
+	}
+	factory.client = &http.Client{Transport: roundTripperFunc(factory.roundTrip)}
+
+	return factory
+}
+
+func (r *Factory) Fake(stubs map[string]client.Stub) client.Factory {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fake = newFake(stubs, r.json)
+
+	return r
+}
+
+func (r *Factory) Recorded() []client.Record {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.fake == nil {
+		return nil
+	}
+
+	return r.fake.recorded()
+}
+
+func (r *Factory) AssertSent(callback func(record client.Record) bool) bool {
+	for _, record := range r.Recorded() {
+		if callback(record) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Factory) AssertSentCount(count int) bool {
+	return len(r.Recorded()) == count
+}
+
+func (r *Factory) Accept(contentType string) client.Request {
+	return r.request().Accept(contentType)
+}
+
+func (r *Factory) AcceptJSON() client.Request {
+	return r.request().AcceptJSON()
+}
+
+func (r *Factory) AsForm() client.Request {
+	return r.request().AsForm()
+}
+
+func (r *Factory) Attach(name, filename string, content io.Reader) client.Request {
+	return r.request().Attach(name, filename, content)
+}
+
+func (r *Factory) BaseURL(url string) client.Request {
+	return r.request().BaseURL(url)
+}
+
+func (r *Factory) Retry(times int, sleep time.Duration, when ...func(response client.Response, err error) bool) client.Request {
+	return r.request().Retry(times, sleep, when...)
+}
+
+func (r *Factory) Timeout(timeout time.Duration) client.Request {
+	return r.request().Timeout(timeout)
+}
+
+func (r *Factory) WithBasicAuth(username, password string) client.Request {
+	return r.request().WithBasicAuth(username, password)
+}
+
+func (r *Factory) WithContext(ctx context.Context) client.Request {
+	return r.request().WithContext(ctx)
+}
+
+func (r *Factory) WithHeader(key, value string) client.Request {
+	return r.request().WithHeader(key, value)
+}
+
+func (r *Factory) WithHeaders(headers map[string]string) client.Request {
+	return r.request().WithHeaders(headers)
+}
+
+func (r *Factory) WithQueryParameters(parameters map[string]string) client.Request {
+	return r.request().WithQueryParameters(parameters)
+}
+
+func (r *Factory) WithToken(token string, tokenType ...string) client.Request {
+	return r.request().WithToken(token, tokenType...)
+}
+
+func (r *Factory) Delete(url string, body ...any) (client.Response, error) {
+	return r.request().Delete(url, body...)
+}
+
+func (r *Factory) Get(url string) (client.Response, error) {
+	return r.request().Get(url)
+}
+
+func (r *Factory) Head(url string) (client.Response, error) {
+	return r.request().Head(url)
+}
+
+func (r *Factory) Patch(url string, body ...any) (client.Response, error) {
+	return r.request().Patch(url, body...)
+}
+
+func (r *Factory) Post(url string, body ...any) (client.Response, error) {
+	return r.request().Post(url, body...)
+}
+
+func (r *Factory) Put(url string, body ...any) (client.Response, error) {
+	return r.request().Put(url, body...)
+}
+
+func (r *Factory) Send(method, url string, body ...any) (client.Response, error) {
+	return r.request().Send(method, url, body...)
+}
+
+func (r *Factory) request() *Request {
+	return NewRequest(r.client, r.json, r.baseURL, r.timeout)
+}
+
+// roundTrip Send the requests through the pooled transport, or through the fake if the factory is fake.
+func (r *Factory) roundTrip(request *http.Request) (*http.Response, error) {
+	r.mu.RLock()
+	fake := r.fake
+	r.mu.RUnlock()
+
+	if fake != nil {
+		return fake.RoundTrip(request)
+	}
+
+	return r.transport.RoundTrip(request)
+}
+
+type roundTripperFunc func(request *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http/client"
+	"github.com/goravel/framework/foundation/json"
+	configmocks "github.com/goravel/framework/mocks/config"
+)
+
+func newTestFactory(t *testing.T, baseURL string) *Factory {
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetInt("http.client.max_idle_conns", 100).Return(100).Once()
+	mockConfig.EXPECT().GetInt("http.client.max_idle_conns_per_host", 10).Return(10).Once()
+	mockConfig.EXPECT().GetInt("http.client.max_conns_per_host", 0).Return(0).Once()
+	mockConfig.EXPECT().GetInt("http.client.idle_conn_timeout", 90).Return(90).Once()
+	mockConfig.EXPECT().GetString("http.client.base_url").Return(baseURL).Once()
+	mockConfig.EXPECT().GetInt("http.client.timeout", 30).Return(30).Once()
+
+	return NewFactory(mockConfig, json.NewJson())
+}
+
+func TestNewFactory(t *testing.T) {
+	factory := newTestFactory(t, "https://goravel.dev")
+
+	assert.Equal(t, 100, factory.transport.MaxIdleConns)
+	assert.Equal(t, 10, factory.transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 90*time.Second, factory.transport.IdleConnTimeout)
+	assert.Equal(t, "https://goravel.dev", factory.request().baseURL)
+	assert.Equal(t, 30*time.Second, factory.request().timeout)
+}
+
+func TestFake(t *testing.T) {
+	factory := newTestFactory(t, "https://goravel.dev")
+	factory.Fake(map[string]client.Stub{
+		"goravel.dev/users/*": FakeResponse(map[string]any{"name": "Goravel"}),
+		"goravel.dev/users/2": FakeResponse("not found", http.StatusNotFound),
+		"github.com/*":        FakeConnectionFailed(),
+	})
+
+	response, err := factory.WithToken("token").Post("/users/1", map[string]string{"name": "Goravel"})
+	assert.Nil(t, err)
+	assert.True(t, response.Successful())
+	assert.Equal(t, "application/json", response.Header("Content-Type"))
+	var user struct {
+		Name string `json:"name"`
+	}
+	assert.Nil(t, response.Bind(&user))
+	assert.Equal(t, "Goravel", user.Name)
+
+	// The more specific pattern is matched first.
+	response, err = factory.Get("/users/2")
+	assert.Nil(t, err)
+	assert.True(t, response.ClientError())
+	assert.Equal(t, "not found", response.Body())
+
+	_, err = factory.Get("https://github.com/goravel")
+	assert.True(t, errors.Is(err, ErrConnectionFailed))
+
+	// The requests matching no stub get an empty response.
+	response, err = factory.Get("https://laravel.com")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.Status())
+	assert.Equal(t, "", response.Body())
+
+	assert.True(t, factory.AssertSentCount(4))
+	assert.True(t, factory.AssertSent(func(record client.Record) bool {
+		return record.Request.Method == http.MethodPost &&
+			record.Request.URL.String() == "https://goravel.dev/users/1" &&
+			record.Request.Header.Get("Authorization") == "Bearer token" &&
+			string(record.Body) == `{"name":"Goravel"}` &&
+			record.Response.Status() == http.StatusOK
+	}))
+	assert.False(t, factory.AssertSent(func(record client.Record) bool {
+		return strings.Contains(record.Request.URL.Path, "posts")
+	}))
+}
+
+func TestFakeSequence(t *testing.T) {
+	factory := newTestFactory(t, "")
+	factory.Fake(map[string]client.Stub{
+		"*": FakeSequence(FakeResponse("first"), FakeResponse("second")),
+	})
+
+	for _, body := range []string{"first", "second", "second"} {
+		response, err := factory.Get("https://goravel.dev")
+		assert.Nil(t, err)
+		assert.Equal(t, body, response.Body())
+	}
+}
+
+func TestRecordedWithoutFake(t *testing.T) {
+	factory := newTestFactory(t, "")
+
+	assert.Nil(t, factory.Recorded())
+	assert.True(t, factory.AssertSentCount(0))
+}
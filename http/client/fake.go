@@ -0,0 +1,162 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/contracts/http/client"
+	"github.com/goravel/framework/foundation/json"
+	"github.com/goravel/framework/support/str"
+)
+
+// ErrConnectionFailed is returned by the FakeConnectionFailed stub.
+var ErrConnectionFailed = errors.New("connection failed")
+
+// fake A transport returning the stub responses and recording the requests.
+type fake struct {
+	json     foundation.Json
+	mu       sync.Mutex
+	patterns []string
+	records  []client.Record
+	stubs    map[string]client.Stub
+}
+
+func newFake(stubs map[string]client.Stub, json foundation.Json) *fake {
+	// The patterns with fewer wildcards and the longer patterns are more specific, they're matched first.
+	patterns := make([]string, 0, len(stubs))
+	for pattern := range stubs {
+		patterns = append(patterns, pattern)
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		if wildcards := strings.Count(patterns[i], "*") - strings.Count(patterns[j], "*"); wildcards != 0 {
+			return wildcards < 0
+		}
+		if len(patterns[i]) != len(patterns[j]) {
+			return len(patterns[i]) > len(patterns[j])
+		}
+
+		return patterns[i] < patterns[j]
+	})
+
+	return &fake{json: json, patterns: patterns, stubs: stubs}
+}
+
+// RoundTrip Return the response of the stub matching the URL, a request matching no stub gets an empty 200 response.
+func (r *fake) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := request.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if request.Body != nil {
+		var err error
+		if body, err = io.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	response, err := r.stub(request)(request)
+	record := client.Record{Request: request, Body: body}
+	if err == nil {
+		recorded, err := NewResponse(response, r.json)
+		if err != nil {
+			return nil, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(recorded.body))
+		record.Response = recorded
+	}
+
+	r.mu.Lock()
+	r.records = append(r.records, record)
+	r.mu.Unlock()
+
+	return response, err
+}
+
+func (r *fake) recorded() []client.Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]client.Record{}, r.records...)
+}
+
+func (r *fake) stub(request *http.Request) client.Stub {
+	target := request.URL.Host + request.URL.Path
+	for _, pattern := range r.patterns {
+		if str.Of(target).Is(strings.TrimPrefix(strings.TrimPrefix(pattern, "https://"), "http://")) {
+			return r.stubs[pattern]
+		}
+	}
+
+	return FakeResponse("")
+}
+
+// FakeResponse Build a stub returning the body with the status, 200 by default. The body is encoded in JSON unless
+// it's a string or []byte.
+func FakeResponse(body any, status ...int) client.Stub {
+	code := http.StatusOK
+	if len(status) > 0 {
+		code = status[0]
+	}
+
+	return func(request *http.Request) (*http.Response, error) {
+		header := make(http.Header)
+		var data []byte
+		switch body := body.(type) {
+		case string:
+			data = []byte(body)
+		case []byte:
+			data = body
+		default:
+			var err error
+			if data, err = json.NewJson().Marshal(body); err != nil {
+				return nil, err
+			}
+			header.Set("Content-Type", "application/json")
+		}
+
+		return &http.Response{
+			Status:        http.StatusText(code),
+			StatusCode:    code,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(data)),
+			ContentLength: int64(len(data)),
+			Request:       request,
+		}, nil
+	}
+}
+
+// FakeSequence Build a stub returning the responses of the stubs in order, the last stub is repeated once the others
+// are used.
+func FakeSequence(stubs ...client.Stub) client.Stub {
+	var mu sync.Mutex
+	var index int
+
+	return func(request *http.Request) (*http.Response, error) {
+		mu.Lock()
+		stub := stubs[index]
+		if index < len(stubs)-1 {
+			index++
+		}
+		mu.Unlock()
+
+		return stub(request)
+	}
+}
+
+// FakeConnectionFailed Build a stub failing as the connection to the server can't be made.
+func FakeConnectionFailed() client.Stub {
+	return func(request *http.Request) (*http.Response, error) {
+		return nil, ErrConnectionFailed
+	}
+}
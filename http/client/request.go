@@ -0,0 +1,424 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/contracts/http/client"
+	"github.com/goravel/framework/http/requestid"
+	"github.com/goravel/framework/support/retry"
+)
+
+// maxRetryDelay The upper bound of the delay between the attempts of a request, including the Retry-After delay.
+const maxRetryDelay = time.Minute
+
+// Request A request built fluently, every method returns a copy of the request, so a request can be shared.
+type Request struct {
+	attachments []attachment
+	baseURL     string
+	client      *http.Client
+	ctx         context.Context
+	// err The error of building the request, it's returned once the request is sent.
+	err     error
+	form    bool
+	headers http.Header
+	json    foundation.Json
+	query   url.Values
+	retries int
+	sleep   time.Duration
+	timeout time.Duration
+	when    func(response client.Response, err error) bool
+}
+
+type attachment struct {
+	content  []byte
+	filename string
+	name     string
+}
+
+func NewRequest(client *http.Client, json foundation.Json, baseURL string, timeout time.Duration) *Request {
+	return &Request{
+		baseURL: baseURL,
+		client:  client,
+		ctx:     context.Background(),
+		headers: make(http.Header),
+		json:    json,
+		query:   make(url.Values),
+		timeout: timeout,
+	}
+}
+
+func (r *Request) Accept(contentType string) client.Request {
+	return r.WithHeader("Accept", contentType)
+}
+
+func (r *Request) AcceptJSON() client.Request {
+	return r.Accept("application/json")
+}
+
+func (r *Request) AsForm() client.Request {
+	request := r.clone()
+	request.form = true
+
+	return request
+}
+
+func (r *Request) Attach(name, filename string, content io.Reader) client.Request {
+	request := r.clone()
+	data, err := io.ReadAll(content)
+	if err != nil {
+		request.err = fmt.Errorf("read the attachment [%s] error: %w", name, err)
+
+		return request
+	}
+	request.attachments = append(request.attachments, attachment{content: data, filename: filename, name: name})
+
+	return request
+}
+
+func (r *Request) BaseURL(url string) client.Request {
+	request := r.clone()
+	request.baseURL = url
+
+	return request
+}
+
+func (r *Request) Retry(times int, sleep time.Duration, when ...func(response client.Response, err error) bool) client.Request {
+	request := r.clone()
+	request.retries = times
+	request.sleep = sleep
+	request.when = nil
+	if len(when) > 0 {
+		request.when = when[0]
+	}
+
+	return request
+}
+
+func (r *Request) Timeout(timeout time.Duration) client.Request {
+	request := r.clone()
+	request.timeout = timeout
+
+	return request
+}
+
+func (r *Request) WithBasicAuth(username, password string) client.Request {
+	return r.WithHeader("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+}
+
+func (r *Request) WithContext(ctx context.Context) client.Request {
+	request := r.clone()
+	request.ctx = ctx
+
+	return request
+}
+
+func (r *Request) WithHeader(key, value string) client.Request {
+	request := r.clone()
+	request.headers.Set(key, value)
+
+	return request
+}
+
+func (r *Request) WithHeaders(headers map[string]string) client.Request {
+	request := r.clone()
+	for key, value := range headers {
+		request.headers.Set(key, value)
+	}
+
+	return request
+}
+
+func (r *Request) WithQueryParameters(parameters map[string]string) client.Request {
+	request := r.clone()
+	for key, value := range parameters {
+		request.query.Set(key, value)
+	}
+
+	return request
+}
+
+func (r *Request) WithToken(token string, tokenType ...string) client.Request {
+	typ := "Bearer"
+	if len(tokenType) > 0 {
+		typ = tokenType[0]
+	}
+
+	return r.WithHeader("Authorization", typ+" "+token)
+}
+
+func (r *Request) Delete(url string, body ...any) (client.Response, error) {
+	return r.Send(http.MethodDelete, url, body...)
+}
+
+func (r *Request) Get(url string) (client.Response, error) {
+	return r.Send(http.MethodGet, url)
+}
+
+func (r *Request) Head(url string) (client.Response, error) {
+	return r.Send(http.MethodHead, url)
+}
+
+func (r *Request) Patch(url string, body ...any) (client.Response, error) {
+	return r.Send(http.MethodPatch, url, body...)
+}
+
+func (r *Request) Post(url string, body ...any) (client.Response, error) {
+	return r.Send(http.MethodPost, url, body...)
+}
+
+func (r *Request) Put(url string, body ...any) (client.Response, error) {
+	return r.Send(http.MethodPut, url, body...)
+}
+
+// Send Send the request, it's retried by the backoff of the retry if it fails and the retry is set.
+func (r *Request) Send(method, url string, body ...any) (client.Response, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	target, err := r.url(url)
+	if err != nil {
+		return nil, err
+	}
+	payload, contentType, err := r.encode(body...)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := retry.Policy{Delay: r.sleep, MaxDelay: maxRetryDelay, Jitter: retry.JitterEqual}
+	var delay time.Duration
+	for attempt := 1; ; attempt++ {
+		response, err := r.attempt(method, target, payload, contentType)
+		if attempt > r.retries || !r.shouldRetry(response, err) {
+			return response, err
+		}
+
+		delay = r.backoff(policy, attempt, delay, response)
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-r.ctx.Done():
+			timer.Stop()
+			return response, r.ctx.Err()
+		}
+	}
+}
+
+func (r *Request) attempt(method, target string, payload []byte, contentType string) (client.Response, error) {
+	ctx := r.ctx
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	var body io.Reader = http.NoBody
+	if len(payload) > 0 {
+		body = bytes.NewReader(payload)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header = r.headers.Clone()
+	if contentType != "" && request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	// The ID of the request the context comes from is passed on, so the request can be traced across the services.
+	if id := requestid.FromContext(r.ctx); id != "" && request.Header.Get(requestid.Header) == "" {
+		request.Header.Set(requestid.Header, id)
+	}
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return NewResponse(response, r.json)
+}
+
+func (r *Request) clone() *Request {
+	request := *r
+	request.attachments = append([]attachment{}, r.attachments...)
+	request.headers = r.headers.Clone()
+	request.query = make(url.Values, len(r.query))
+	for key, values := range r.query {
+		request.query[key] = append([]string{}, values...)
+	}
+
+	return &request
+}
+
+// encode Encode the body as a multipart form if there are attachments, as a form if AsForm is called, or in JSON
+// unless it's a string, []byte or io.Reader.
+func (r *Request) encode(body ...any) ([]byte, string, error) {
+	var value any
+	if len(body) > 0 {
+		value = body[0]
+	}
+
+	if len(r.attachments) > 0 {
+		return r.encodeMultipart(value)
+	}
+	if value == nil {
+		return nil, "", nil
+	}
+	if r.form {
+		values, err := formValues(value)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return []byte(values.Encode()), "application/x-www-form-urlencoded", nil
+	}
+
+	switch value := value.(type) {
+	case string:
+		return []byte(value), "", nil
+	case []byte:
+		return value, "", nil
+	case io.Reader:
+		data, err := io.ReadAll(value)
+
+		return data, "", err
+	default:
+		data, err := r.json.Marshal(value)
+
+		return data, "application/json", err
+	}
+}
+
+func (r *Request) encodeMultipart(value any) ([]byte, string, error) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	if value != nil {
+		values, err := formValues(value)
+		if err != nil {
+			return nil, "", err
+		}
+		for key, items := range values {
+			for _, item := range items {
+				if err := writer.WriteField(key, item); err != nil {
+					return nil, "", err
+				}
+			}
+		}
+	}
+
+	for _, attachment := range r.attachments {
+		part, err := writer.CreateFormFile(attachment.name, attachment.filename)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := part.Write(attachment.content); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buffer.Bytes(), writer.FormDataContentType(), nil
+}
+
+// backoff Get the delay before the next attempt, the sleep doubles after each attempt with a jitter, and the delay
+// asked by the Retry-After header of a 429 or 503 response is used instead. The delay is capped by maxRetryDelay.
+func (r *Request) backoff(policy retry.Policy, attempt int, previous time.Duration, response client.Response) time.Duration {
+	if delay, ok := retryAfter(response); ok {
+		return min(delay, maxRetryDelay)
+	}
+	if r.sleep <= 0 {
+		return 0
+	}
+
+	return policy.Backoff(attempt, previous)
+}
+
+// retryAfter Get the delay of the Retry-After header of a 429 or 503 response, it's either seconds or an HTTP date.
+func retryAfter(response client.Response) (time.Duration, bool) {
+	if response == nil || response.Status() != http.StatusTooManyRequests && response.Status() != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(response.Header("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(min(max(seconds, 0), int64(maxRetryDelay/time.Second))) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+
+	return 0, false
+}
+
+// shouldRetry Determine whether a failed attempt should be retried, a request fails on connection errors and 5xx and
+// 429 responses by default.
+func (r *Request) shouldRetry(response client.Response, err error) bool {
+	if r.ctx.Err() != nil {
+		return false
+	}
+	if r.when != nil {
+		return r.when(response, err)
+	}
+	if err != nil {
+		return true
+	}
+
+	return response.ServerError() || response.Status() == http.StatusTooManyRequests
+}
+
+// url Resolve the URL against the base URL if it's relative, and add the query parameters.
+func (r *Request) url(target string) (string, error) {
+	if r.baseURL != "" && !strings.Contains(target, "://") {
+		target = strings.TrimRight(r.baseURL, "/") + "/" + strings.TrimLeft(target, "/")
+	}
+	if len(r.query) == 0 {
+		return target, nil
+	}
+
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+	query := parsed.Query()
+	for key, values := range r.query {
+		query[key] = values
+	}
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
+
+func formValues(value any) (url.Values, error) {
+	switch value := value.(type) {
+	case url.Values:
+		return value, nil
+	case map[string]string:
+		values := make(url.Values, len(value))
+		for key, item := range value {
+			values.Set(key, item)
+		}
+
+		return values, nil
+	default:
+		return nil, errors.New("the form body should be a map[string]string or url.Values")
+	}
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http/client"
+	"github.com/goravel/framework/http/requestid"
+)
+
+func TestRequestHeaders(t *testing.T) {
+	factory := newTestFactory(t, "")
+	factory.Fake(map[string]client.Stub{})
+
+	request := factory.AcceptJSON().WithHeaders(map[string]string{"X-Tenant": "goravel"})
+	_, err := request.WithBasicAuth("goravel", "secret").Get("https://goravel.dev")
+	assert.Nil(t, err)
+	_, err = request.WithToken("token", "Token").Get("https://goravel.dev")
+	assert.Nil(t, err)
+
+	records := factory.Recorded()
+	assert.Len(t, records, 2)
+	username, password, ok := records[0].Request.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "goravel", username)
+	assert.Equal(t, "secret", password)
+	assert.Equal(t, "application/json", records[0].Request.Header.Get("Accept"))
+	assert.Equal(t, "goravel", records[0].Request.Header.Get("X-Tenant"))
+
+	// The requests are copied, the headers of a request don't leak into the others.
+	assert.Equal(t, "Token token", records[1].Request.Header.Get("Authorization"))
+	assert.Equal(t, "goravel", records[1].Request.Header.Get("X-Tenant"))
+	assert.Empty(t, records[1].Request.Header.Get(requestid.Header))
+
+	// The ID of the request the context comes from is passed on, unless the header is set.
+	ctx := requestid.WithContext(context.Background(), "01J0000000000000000000000")
+	_, err = request.WithContext(ctx).Get("https://goravel.dev")
+	assert.Nil(t, err)
+	_, err = request.WithContext(ctx).WithHeader(requestid.Header, "upstream").Get("https://goravel.dev")
+	assert.Nil(t, err)
+
+	records = factory.Recorded()
+	assert.Equal(t, "01J0000000000000000000000", records[2].Request.Header.Get(requestid.Header))
+	assert.Equal(t, "upstream", records[3].Request.Header.Get(requestid.Header))
+}
+
+func TestRequestURL(t *testing.T) {
+	request := NewRequest(nil, nil, "https://goravel.dev/api/", 0)
+
+	target, err := request.url("/users")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://goravel.dev/api/users", target)
+
+	target, err = request.url("https://github.com/goravel")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://github.com/goravel", target)
+
+	target, err = request.WithQueryParameters(map[string]string{"page": "2"}).(*Request).url("users?sort=name")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://goravel.dev/api/users?page=2&sort=name", target)
+}
+
+func TestRequestBody(t *testing.T) {
+	factory := newTestFactory(t, "")
+	factory.Fake(map[string]client.Stub{})
+
+	_, err := factory.Post("https://goravel.dev", "raw")
+	assert.Nil(t, err)
+	_, err = factory.AsForm().Put("https://goravel.dev", map[string]string{"name": "Goravel"})
+	assert.Nil(t, err)
+	_, err = factory.AsForm().Patch("https://goravel.dev", []string{"Goravel"})
+	assert.EqualError(t, err, "the form body should be a map[string]string or url.Values")
+	_, err = factory.Attach("avatar", "avatar.png", strings.NewReader("image")).Post("https://goravel.dev", map[string]string{"name": "Goravel"})
+	assert.Nil(t, err)
+
+	records := factory.Recorded()
+	assert.Len(t, records, 3)
+	assert.Equal(t, "raw", string(records[0].Body))
+	assert.Equal(t, "", records[0].Request.Header.Get("Content-Type"))
+	assert.Equal(t, "name=Goravel", string(records[1].Body))
+	assert.Equal(t, "application/x-www-form-urlencoded", records[1].Request.Header.Get("Content-Type"))
+
+	assert.Nil(t, records[2].Request.ParseMultipartForm(1024))
+	assert.Equal(t, "Goravel", records[2].Request.FormValue("name"))
+	file, header, err := records[2].Request.FormFile("avatar")
+	assert.Nil(t, err)
+	assert.Equal(t, "avatar.png", header.Filename)
+	content, err := io.ReadAll(file)
+	assert.Nil(t, err)
+	assert.Equal(t, "image", string(content))
+}
+
+func TestRequestRetry(t *testing.T) {
+	factory := newTestFactory(t, "")
+	factory.Fake(map[string]client.Stub{
+		"goravel.dev": FakeSequence(FakeConnectionFailed(), FakeResponse("", http.StatusServiceUnavailable), FakeResponse("ok")),
+		"github.com":  FakeResponse("", http.StatusNotFound),
+	})
+
+	// The sleep doubles after each attempt, at least half of it is waited with the jitter.
+	start := time.Now()
+	response, err := factory.Retry(2, 10*time.Millisecond).Get("https://goravel.dev")
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", response.Body())
+	assert.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond)
+	assert.True(t, factory.AssertSentCount(3))
+
+	// The client errors aren't retried by default.
+	response, err = factory.Retry(2, 0).Get("https://github.com")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.Status())
+	assert.True(t, factory.AssertSentCount(4))
+
+	response, err = factory.Retry(2, 0, func(response client.Response, err error) bool {
+		return err == nil && response.Status() == http.StatusNotFound
+	}).Get("https://github.com")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.Status())
+	assert.True(t, factory.AssertSentCount(7))
+
+	// The retry stops once the context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = factory.WithContext(ctx).Retry(2, time.Second).Get("https://github.com")
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestRequestRetry_RetryAfter(t *testing.T) {
+	factory := newTestFactory(t, "")
+	factory.Fake(map[string]client.Stub{
+		"goravel.dev": FakeSequence(func(request *http.Request) (*http.Response, error) {
+			response, err := FakeResponse("", http.StatusTooManyRequests)(request)
+			response.Header.Set("Retry-After", "0")
+
+			return response, err
+		}, FakeResponse("ok")),
+	})
+
+	// The Retry-After delay is used instead of the sleep.
+	start := time.Now()
+	response, err := factory.Retry(1, time.Hour).Get("https://goravel.dev")
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", response.Body())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		header string
+		delay  time.Duration
+		ok     bool
+	}{
+		{name: "seconds", status: http.StatusTooManyRequests, header: "3", delay: 3 * time.Second, ok: true},
+		{name: "capped seconds", status: http.StatusServiceUnavailable, header: "99999999999999", delay: maxRetryDelay, ok: true},
+		{name: "past date", status: http.StatusServiceUnavailable, header: "Wed, 21 Oct 2015 07:28:00 GMT", ok: true},
+		{name: "invalid", status: http.StatusTooManyRequests, header: "soon"},
+		{name: "missing", status: http.StatusTooManyRequests},
+		{name: "other status", status: http.StatusInternalServerError, header: "3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := make(http.Header)
+			header.Set("Retry-After", test.header)
+			response, err := NewResponse(&http.Response{StatusCode: test.status, Header: header, Body: http.NoBody}, nil)
+			assert.Nil(t, err)
+
+			delay, ok := retryAfter(response)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.delay, delay)
+		})
+	}
+}
+
+func TestRequestServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		body, _ := io.ReadAll(request.Body)
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{"method":"` + request.Method + `","body":` + string(body) + `}`))
+	}))
+	defer server.Close()
+
+	factory := newTestFactory(t, server.URL)
+
+	response, err := factory.Delete("/users", map[string]any{"id": 1})
+	assert.Nil(t, err)
+	assert.True(t, response.Successful())
+	assert.False(t, response.Failed())
+	assert.Equal(t, "application/json", response.Headers().Get("Content-Type"))
+	data, err := response.Json()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"method": "DELETE", "body": map[string]any{"id": float64(1)}}, data)
+
+	response, err = factory.Head("/users")
+	assert.Nil(t, err)
+	assert.Equal(t, "", response.Body())
+
+	_, err = factory.Timeout(10*time.Millisecond).Send(http.MethodPost, "/slow", "{}")
+	var urlErr *url.Error
+	assert.True(t, errors.As(err, &urlErr))
+	assert.True(t, urlErr.Timeout())
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/goravel/framework/contracts/foundation"
+)
+
+// Response A response whose body is read, so it can be used after the connection is reused.
+type Response struct {
+	body     []byte
+	json     foundation.Json
+	response *http.Response
+}
+
+func NewResponse(response *http.Response, json foundation.Json) (*Response, error) {
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{body: body, json: json, response: response}, nil
+}
+
+func (r *Response) Bind(value any) error {
+	return r.json.Unmarshal(r.body, value)
+}
+
+func (r *Response) Body() string {
+	return string(r.body)
+}
+
+func (r *Response) ClientError() bool {
+	return r.Status() >= http.StatusBadRequest && r.Status() < http.StatusInternalServerError
+}
+
+func (r *Response) Failed() bool {
+	return r.ClientError() || r.ServerError()
+}
+
+func (r *Response) Header(key string) string {
+	return r.response.Header.Get(key)
+}
+
+func (r *Response) Headers() http.Header {
+	return r.response.Header
+}
+
+func (r *Response) Json() (map[string]any, error) {
+	var value map[string]any
+	if err := r.Bind(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (r *Response) ServerError() bool {
+	return r.Status() >= http.StatusInternalServerError
+}
+
+func (r *Response) Status() int {
+	return r.response.StatusCode
+}
+
+func (r *Response) Successful() bool {
+	return r.Status() >= http.StatusOK && r.Status() < http.StatusMultipleChoices
+}
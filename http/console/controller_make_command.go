@@ -60,17 +60,17 @@ func (receiver *ControllerMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Controller created successfully")
+	color.Green().Printf("Controller [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *ControllerMakeCommand) getStub() string {
-	return Stubs{}.Controller()
+	return supportconsole.Stub("controller.stub", Stubs{}.Controller())
 }
 
 func (receiver *ControllerMakeCommand) getResourceStub() string {
-	return Stubs{}.ResourceController()
+	return supportconsole.Stub("controller.resource.stub", Stubs{}.ResourceController())
 }
 
 // populateStub Populate the place-holders in the command stub.
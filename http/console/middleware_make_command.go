@@ -50,13 +50,13 @@ func (receiver *MiddlewareMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Middleware created successfully")
+	color.Green().Printf("Middleware [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *MiddlewareMakeCommand) getStub() string {
-	return Stubs{}.Middleware()
+	return supportconsole.Stub("middleware.stub", Stubs{}.Middleware())
 }
 
 // populateStub Populate the place-holders in the command stub.
@@ -50,13 +50,13 @@ func (receiver *RequestMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Request created successfully")
+	color.Green().Printf("Request [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *RequestMakeCommand) getStub() string {
-	return Stubs{}.Request()
+	return supportconsole.Stub("request.stub", Stubs{}.Request())
 }
 
 // populateStub Populate the place-holders in the command stub.
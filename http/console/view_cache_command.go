@@ -0,0 +1,131 @@
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+type ViewCacheCommand struct {
+	path string
+	file string
+}
+
+func NewViewCacheCommand(path, file string) *ViewCacheCommand {
+	return &ViewCacheCommand{
+		path: path,
+		file: file,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ViewCacheCommand) Signature() string {
+	return "view:cache"
+}
+
+// Description The console command description.
+func (receiver *ViewCacheCommand) Description() string {
+	return "Parse all the views to check their syntax and create a cache file of them"
+}
+
+// Extend The console command extend.
+func (receiver *ViewCacheCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "view",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ViewCacheCommand) Handle(ctx console.Context) error {
+	views := make([]string, 0)
+	err := filepath.WalkDir(receiver.path, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == receiver.path {
+				return filepath.SkipDir
+			}
+
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(receiver.path, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if _, err := template.New(name).Parse(string(content)); err != nil {
+			return fmt.Errorf("failed to parse the view [%s]: %w", name, err)
+		}
+		views = append(views, name)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(views)
+	if err != nil {
+		return err
+	}
+	if err := file.Create(receiver.file, string(content)); err != nil {
+		return err
+	}
+
+	color.Green().Println("Views cached successfully")
+
+	return nil
+}
+
+type ViewClearCommand struct {
+	file string
+}
+
+func NewViewClearCommand(file string) *ViewClearCommand {
+	return &ViewClearCommand{
+		file: file,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ViewClearCommand) Signature() string {
+	return "view:clear"
+}
+
+// Description The console command description.
+func (receiver *ViewClearCommand) Description() string {
+	return "Remove the view cache file"
+}
+
+// Extend The console command extend.
+func (receiver *ViewClearCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "view",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ViewClearCommand) Handle(ctx console.Context) error {
+	if err := file.Remove(receiver.file); err != nil {
+		return err
+	}
+
+	color.Green().Println("View cache cleared successfully")
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/console"
+	"github.com/goravel/framework/support/file"
+)
+
+func TestViewCacheAndClearCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views")
+	cacheFile := filepath.Join(t.TempDir(), "storage", "framework", "views.json")
+
+	console.NewTester(t, NewViewCacheCommand(path, cacheFile)).Run().AssertSuccessful()
+	content, err := os.ReadFile(cacheFile)
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(content))
+
+	assert.Nil(t, file.Create(filepath.Join(path, "welcome.tmpl"), `<h1>{{ .name }}</h1>`))
+	assert.Nil(t, file.Create(filepath.Join(path, "users", "index.tmpl"), `{{ range .users }}{{ .Name }}{{ end }}`))
+	console.NewTester(t, NewViewCacheCommand(path, cacheFile)).
+		Run().
+		AssertSuccessful().
+		AssertOutputContains("Views cached successfully")
+
+	content, err = os.ReadFile(cacheFile)
+	assert.Nil(t, err)
+	assert.Equal(t, `["users/index.tmpl","welcome.tmpl"]`, string(content))
+
+	assert.Nil(t, file.Create(filepath.Join(path, "broken.tmpl"), `{{ if .name }}`))
+	result := console.NewTester(t, NewViewCacheCommand(path, cacheFile)).Run().AssertFailed()
+	assert.ErrorContains(t, result.Err, "failed to parse the view [broken.tmpl]")
+
+	console.NewTester(t, NewViewClearCommand(cacheFile)).
+		Run().
+		AssertSuccessful().
+		AssertOutputContains("View cache cleared successfully")
+	assert.False(t, file.Exists(cacheFile))
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	nethttp "net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+const (
+	// DispositionAttachment The browsers save the file instead of displaying it, see ContextResponse.Download.
+	DispositionAttachment = "attachment"
+	// DispositionInline The browsers display the file, e.g. an image or a PDF, see ContextResponse.File.
+	DispositionInline = "inline"
+)
+
+// FileResponse Serve a file with the Range, If-Range, If-Modified-Since and If-None-Match support, so a large file
+// can be resumed or requested in parts, and an unchanged file isn't sent again.
+type FileResponse struct {
+	ctx         http.Context
+	disposition string
+	name        string
+	path        string
+}
+
+// NewFileResponse Build the response of ContextResponse.Download and ContextResponse.File, it's shared by the http
+// drivers. The name is the base of the path if it's empty.
+func NewFileResponse(ctx http.Context, path, disposition, name string) *FileResponse {
+	if name == "" {
+		name = filepath.Base(path)
+	}
+
+	return &FileResponse{ctx: ctx, disposition: disposition, name: name, path: path}
+}
+
+func (r *FileResponse) Render() error {
+	file, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", r.path)
+	}
+
+	writer := r.ctx.Response().Writer()
+	writer.Header().Set("Content-Disposition", contentDisposition(r.disposition, r.name))
+	writer.Header().Set("ETag", fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size()))
+	nethttp.ServeContent(writer, r.ctx.Request().Origin(), r.name, info.ModTime(), file)
+
+	return nil
+}
+
+// NewStreamDownloadResponse Build the response of ContextResponse.StreamDownload, it's shared by the http drivers. The
+// content isn't buffered, so the Range requests aren't supported.
+func NewStreamDownloadResponse(ctx http.Context, name string, callback func(writer io.Writer) error) http.Response {
+	return &streamDownload{callback: callback, ctx: ctx, name: name}
+}
+
+type streamDownload struct {
+	callback func(writer io.Writer) error
+	ctx      http.Context
+	name     string
+}
+
+func (r *streamDownload) Render() error {
+	writer := r.ctx.Response().Writer()
+	contentType := mime.TypeByExtension(filepath.Ext(r.name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	writer.Header().Set("Content-Type", contentType)
+	writer.Header().Set("Content-Disposition", contentDisposition(DispositionAttachment, r.name))
+	writer.Header().Set("X-Content-Type-Options", "nosniff")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := r.callback(writer); err != nil {
+		return err
+	}
+	if flusher, ok := writer.(nethttp.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+// contentDisposition Build the Content-Disposition header, the non-ASCII names are encoded by RFC 2231.
+func contentDisposition(disposition, name string) string {
+	if header := mime.FormatMediaType(disposition, map[string]string{"filename": name}); header != "" {
+		return header
+	}
+
+	return disposition + "; filename=" + strconv.Quote(name)
+}
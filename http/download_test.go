@@ -0,0 +1,83 @@
+package http
+
+import (
+	"errors"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func mockFileContext(t *testing.T, request *nethttp.Request, recorder *httptest.ResponseRecorder) contractshttp.Context {
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockResponse := httpmocks.NewContextResponse(t)
+	mockContext.EXPECT().Request().Return(mockRequest).Maybe()
+	mockContext.EXPECT().Response().Return(mockResponse).Once()
+	mockRequest.EXPECT().Origin().Return(request).Maybe()
+	mockResponse.EXPECT().Writer().Return(recorder).Once()
+
+	return mockContext
+}
+
+func TestFileResponse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.txt")
+	assert.Nil(t, os.WriteFile(path, []byte("Hello Goravel"), 0644))
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest("GET", "/download", nil)
+	assert.Nil(t, NewFileResponse(mockFileContext(t, request, recorder), path, DispositionAttachment, "报告.txt").Render())
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, "Hello Goravel", recorder.Body.String())
+	assert.Equal(t, "attachment; filename*=utf-8''%E6%8A%A5%E5%91%8A.txt", recorder.Header().Get("Content-Disposition"))
+	assert.Equal(t, "bytes", recorder.Header().Get("Accept-Ranges"))
+	etag := recorder.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// The range of the file is served.
+	recorder = httptest.NewRecorder()
+	request = httptest.NewRequest("GET", "/download", nil)
+	request.Header.Set("Range", "bytes=6-")
+	assert.Nil(t, NewFileResponse(mockFileContext(t, request, recorder), path, DispositionInline, "").Render())
+	assert.Equal(t, nethttp.StatusPartialContent, recorder.Code)
+	assert.Equal(t, "Goravel", recorder.Body.String())
+	assert.Equal(t, `inline; filename=report.txt`, recorder.Header().Get("Content-Disposition"))
+
+	// The unchanged file isn't sent again.
+	recorder = httptest.NewRecorder()
+	request = httptest.NewRequest("GET", "/download", nil)
+	request.Header.Set("If-None-Match", etag)
+	assert.Nil(t, NewFileResponse(mockFileContext(t, request, recorder), path, DispositionInline, "").Render())
+	assert.Equal(t, nethttp.StatusNotModified, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+
+	mockContext := httpmocks.NewContext(t)
+	assert.Error(t, NewFileResponse(mockContext, filepath.Join(t.TempDir(), "missing.txt"), DispositionInline, "").Render())
+}
+
+func TestStreamDownloadResponse(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest("GET", "/export", nil)
+	assert.Nil(t, NewStreamDownloadResponse(mockFileContext(t, request, recorder), "users.csv", func(writer io.Writer) error {
+		_, err := io.WriteString(writer, "id,name\n1,Goravel\n")
+
+		return err
+	}).Render())
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, "id,name\n1,Goravel\n", recorder.Body.String())
+	assert.Equal(t, "text/csv; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename=users.csv", recorder.Header().Get("Content-Disposition"))
+
+	recorder = httptest.NewRecorder()
+	assert.EqualError(t, NewStreamDownloadResponse(mockFileContext(t, request, recorder), "users", func(writer io.Writer) error {
+		return errors.New("error")
+	}).Render(), "error")
+	assert.Equal(t, "application/octet-stream", recorder.Header().Get("Content-Type"))
+}
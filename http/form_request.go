@@ -0,0 +1,69 @@
+package http
+
+import (
+	"reflect"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+const (
+	// FormRequestKey The context key of the FormRequest validated before the handler.
+	FormRequestKey = "goravel_form_request"
+	// ErrorsKey The session key of the validation errors flashed before redirecting back.
+	ErrorsKey = "errors"
+	// OldInputKey The session key of the input flashed before redirecting back, so the form can be refilled.
+	OldInputKey = "_old_input"
+)
+
+// Validated Resolve the FormRequest of the handler, then authorize and validate the request by it before the
+// handler runs. An unauthorized request gets 403, a request failing the validation gets 422 with the errors if
+// it expects JSON, otherwise it's redirected back with the errors and the input flashed to the session.
+func Validated[T http.FormRequest](handler func(ctx http.Context, request T) http.Response) http.HandlerFunc {
+	return func(ctx http.Context) http.Response {
+		request := newFormRequest[T]()
+		if err := request.Authorize(ctx); err != nil {
+			if ExpectsJson(ctx) {
+				return ctx.Response().Json(http.StatusForbidden, http.Json{"message": err.Error()})
+			}
+
+			return ctx.Response().String(http.StatusForbidden, err.Error())
+		}
+
+		errors, err := ctx.Request().ValidateRequest(request)
+		if err != nil {
+			if LogFacade != nil {
+				LogFacade.Error(err)
+			}
+
+			return ctx.Response().String(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		}
+		if errors != nil {
+			if ExpectsJson(ctx) {
+				return ctx.Response().Json(http.StatusUnprocessableEntity, http.Json{
+					"message": errors.One(),
+					"errors":  errors.All(),
+				})
+			}
+
+			if ctx.Request().HasSession() {
+				ctx.Request().Session().Flash(ErrorsKey, errors.All()).Flash(OldInputKey, ctx.Request().All())
+			}
+
+			return ctx.Response().Redirect(http.StatusFound, ctx.Request().Header("Referer", "/"))
+		}
+
+		ctx.WithValue(FormRequestKey, request)
+
+		return handler(ctx, request)
+	}
+}
+
+// newFormRequest Create an empty FormRequest of the type, the type can be a struct or a pointer to a struct.
+func newFormRequest[T http.FormRequest]() T {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() == reflect.Pointer {
+		return reflect.New(typ.Elem()).Interface().(T)
+	}
+
+	return reflect.New(typ).Elem().Interface().(T)
+}
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/validation"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	sessionmocks "github.com/goravel/framework/mocks/session"
+	validationmocks "github.com/goravel/framework/mocks/validation"
+)
+
+type StoreUserRequest struct {
+	Name string `form:"name" json:"name"`
+}
+
+func (r *StoreUserRequest) Authorize(ctx contractshttp.Context) error {
+	if ctx.Request().Header("Authorization") == "" {
+		return errors.New("unauthorized")
+	}
+
+	return nil
+}
+
+func (r *StoreUserRequest) Rules(ctx contractshttp.Context) map[string]string {
+	return map[string]string{"name": "required"}
+}
+
+func (r *StoreUserRequest) Filters(ctx contractshttp.Context) map[string]string {
+	return nil
+}
+
+func (r *StoreUserRequest) Messages(ctx contractshttp.Context) map[string]string {
+	return nil
+}
+
+func (r *StoreUserRequest) Attributes(ctx contractshttp.Context) map[string]string {
+	return nil
+}
+
+func (r *StoreUserRequest) PrepareForValidation(ctx contractshttp.Context, data validation.Data) error {
+	return nil
+}
+
+func TestValidated(t *testing.T) {
+	var (
+		mockContext  *httpmocks.Context
+		mockRequest  *httpmocks.ContextRequest
+		mockResponse *httpmocks.ContextResponse
+		mockResult   *httpmocks.Response
+		handled      *StoreUserRequest
+	)
+
+	handler := Validated(func(ctx contractshttp.Context, request *StoreUserRequest) contractshttp.Response {
+		handled = request
+
+		return mockResult
+	})
+
+	beforeEach := func() {
+		mockContext = httpmocks.NewContext(t)
+		mockRequest = httpmocks.NewContextRequest(t)
+		mockResponse = httpmocks.NewContextResponse(t)
+		mockResult = httpmocks.NewResponse(t)
+		mockContext.EXPECT().Request().Return(mockRequest)
+		mockContext.EXPECT().Response().Return(mockResponse).Maybe()
+		handled = nil
+	}
+
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "the unauthorized request gets 403",
+			setup: func() {
+				mockRequest.EXPECT().Header("Authorization").Return("").Once()
+				mockRequest.EXPECT().Header("X-Requested-With").Return("").Once()
+				mockRequest.EXPECT().Header("Accept").Return("application/json").Once()
+				mockResponse.EXPECT().Json(contractshttp.StatusForbidden, contractshttp.Json{"message": "unauthorized"}).Return(mockResult).Once()
+			},
+		},
+		{
+			name: "the invalid request expecting JSON gets 422",
+			setup: func() {
+				mockErrors := validationmocks.NewErrors(t)
+				mockRequest.EXPECT().Header("Authorization").Return("token").Once()
+				mockRequest.EXPECT().ValidateRequest(&StoreUserRequest{}).Return(mockErrors, nil).Once()
+				mockRequest.EXPECT().Header("X-Requested-With").Return("XMLHttpRequest").Once()
+				mockErrors.EXPECT().One().Return("The name field is required.").Once()
+				mockErrors.EXPECT().All().Return(map[string]map[string]string{"name": {"required": "The name field is required."}}).Once()
+				mockResponse.EXPECT().Json(contractshttp.StatusUnprocessableEntity, contractshttp.Json{
+					"message": "The name field is required.",
+					"errors":  map[string]map[string]string{"name": {"required": "The name field is required."}},
+				}).Return(mockResult).Once()
+			},
+		},
+		{
+			name: "the invalid form is redirected back with the errors",
+			setup: func() {
+				mockErrors := validationmocks.NewErrors(t)
+				mockSession := sessionmocks.NewSession(t)
+				mockRequest.EXPECT().Header("Authorization").Return("token").Once()
+				mockRequest.EXPECT().ValidateRequest(&StoreUserRequest{}).Return(mockErrors, nil).Once()
+				mockRequest.EXPECT().Header("X-Requested-With").Return("").Once()
+				mockRequest.EXPECT().Header("Accept").Return("text/html").Once()
+				mockRequest.EXPECT().HasSession().Return(true).Once()
+				mockRequest.EXPECT().Session().Return(mockSession).Once()
+				mockRequest.EXPECT().All().Return(map[string]any{"name": ""}).Once()
+				mockErrors.EXPECT().All().Return(map[string]map[string]string{"name": {"required": "required"}}).Once()
+				mockSession.EXPECT().Flash(ErrorsKey, map[string]map[string]string{"name": {"required": "required"}}).Return(mockSession).Once()
+				mockSession.EXPECT().Flash(OldInputKey, map[string]any{"name": ""}).Return(mockSession).Once()
+				mockRequest.EXPECT().Header("Referer", "/").Return("/users/create").Once()
+				mockResponse.EXPECT().Redirect(contractshttp.StatusFound, "/users/create").Return(mockResult).Once()
+			},
+		},
+		{
+			name: "the valid request reaches the handler",
+			setup: func() {
+				mockRequest.EXPECT().Header("Authorization").Return("token").Once()
+				mockRequest.EXPECT().ValidateRequest(&StoreUserRequest{}).Return(nil, nil).Once()
+				mockContext.EXPECT().WithValue(FormRequestKey, &StoreUserRequest{}).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+
+			assert.Equal(t, mockResult, handler(mockContext))
+		})
+	}
+
+	assert.NotNil(t, handled)
+}
+
+func TestNewFormRequest(t *testing.T) {
+	assert.Equal(t, &StoreUserRequest{}, newFormRequest[*StoreUserRequest]())
+}
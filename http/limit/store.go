@@ -2,6 +2,8 @@ package limit
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"sync"
 	"time"
 
@@ -11,6 +13,13 @@ import (
 
 // Code from https://github.com/sethvargo/go-limiter
 
+const (
+	// lockTimeout The time a request waits for the lock of a key, the lock is released once it's reached too.
+	lockTimeout = time.Second
+	// lockRetryInterval The time a request waits before trying to acquire the lock of a key again.
+	lockRetryInterval = 5 * time.Millisecond
+)
+
 // Store is an interface for limiter storage backends.
 //
 // Keys should be hash, sanitized, or otherwise scrubbed of identifiable
@@ -78,24 +87,34 @@ func NewStore(tokens uint64, interval time.Duration) (Store, error) {
 // Take attempts to remove a token from the named key. If the take is
 // successful, it returns true, otherwise false. It also returns the configured
 // limit, remaining tokens, and reset time.
-func (s *store) Take(_ context.Context, key string) (uint64, uint64, uint64, bool, error) {
-	b, ok := http.CacheFacade.Get(key).(*Bucket)
-	if ok {
+func (s *store) Take(_ context.Context, key string) (tokens, remaining, reset uint64, ok bool, err error) {
+	if b, shared := s.bucket(key); shared {
 		return b.take()
 	}
 
-	nb := NewBucket(s.tokens, s.interval)
-	if err := http.CacheFacade.Put(key, nb, s.interval); err != nil {
+	err = s.locked(key, func() error {
+		b, shared := s.bucket(key)
+		if b == nil {
+			b = NewBucket(s.tokens, s.interval)
+		}
+
+		tokens, remaining, reset, ok, err = b.take()
+		if shared || err != nil {
+			return err
+		}
+
+		return http.CacheFacade.Put(key, b, b.ttl())
+	})
+	if err != nil {
 		return 0, 0, 0, false, err
 	}
 
-	return nb.take()
+	return tokens, remaining, reset, ok, nil
 }
 
 // Get retrieves the information about the key, if any exists.
 func (s *store) Get(_ context.Context, key string) (uint64, uint64, error) {
-	b, ok := http.CacheFacade.Get(key).(*Bucket)
-	if ok {
+	if b, _ := s.bucket(key); b != nil {
 		return b.get()
 	}
 
@@ -110,16 +129,63 @@ func (s *store) Set(_ context.Context, key string, tokens uint64, interval time.
 
 // Burst adds the provided value to the Bucket's currently available tokens.
 func (s *store) Burst(_ context.Context, key string, tokens uint64) error {
-	b, ok := http.CacheFacade.Get(key).(*Bucket)
-	if ok {
-		b.lock.Lock()
-		b.availableTokens = b.availableTokens + tokens
-		b.lock.Unlock()
+	if b, shared := s.bucket(key); shared {
+		b.burst(tokens)
+
 		return nil
 	}
 
-	nb := NewBucket(s.tokens+tokens, s.interval)
-	return http.CacheFacade.Put(key, nb, s.interval)
+	return s.locked(key, func() error {
+		b, shared := s.bucket(key)
+		if b == nil {
+			b = NewBucket(s.tokens, s.interval)
+		}
+
+		b.burst(tokens)
+		if shared {
+			return nil
+		}
+
+		return http.CacheFacade.Put(key, b, b.ttl())
+	})
+}
+
+// locked Run the callback holding the lock of the key. The stores serializing the items, e.g. Redis, keep a copy of
+// the Bucket, so it's decoded, changed and stored again under the lock to not lose the changes of other requests.
+func (s *store) locked(key string, callback func() error) error {
+	lock := http.CacheFacade.Lock(key+":lock", lockTimeout)
+	deadline := time.Now().Add(lockTimeout)
+	for !lock.Get() {
+		if time.Now().After(deadline) {
+			return errors.New("failed to acquire the lock of the rate limiter")
+		}
+
+		time.Sleep(lockRetryInterval)
+	}
+	defer lock.Release()
+
+	return callback()
+}
+
+// bucket Get the Bucket of the key. The memory store keeps the Bucket itself, so it's shared by the requests, while
+// the other stores, e.g. Redis, keep the encoded Bucket, it's decoded and must be stored again once it's changed.
+func (s *store) bucket(key string) (b *Bucket, shared bool) {
+	switch value := http.CacheFacade.Get(key).(type) {
+	case *Bucket:
+		return value, true
+	case string:
+		b = &Bucket{}
+		if err := b.UnmarshalBinary([]byte(value)); err == nil {
+			return b, false
+		}
+	case []byte:
+		b = &Bucket{}
+		if err := b.UnmarshalBinary(value); err == nil {
+			return b, false
+		}
+	}
+
+	return nil, false
 }
 
 // Bucket is an internal wrapper around a taker.
@@ -146,6 +212,15 @@ type Bucket struct {
 	lock sync.Mutex
 }
 
+// bucketState is the encoded Bucket kept by the stores serializing the items.
+type bucketState struct {
+	StartTime       uint64        `json:"start_time"`
+	MaxTokens       uint64        `json:"max_tokens"`
+	Interval        time.Duration `json:"interval"`
+	AvailableTokens uint64        `json:"available_tokens"`
+	LastTick        uint64        `json:"last_tick"`
+}
+
 // NewBucket creates a new Bucket from the given tokens and interval.
 func NewBucket(tokens uint64, interval time.Duration) *Bucket {
 	b := &Bucket{
@@ -157,6 +232,49 @@ func NewBucket(tokens uint64, interval time.Duration) *Bucket {
 	return b
 }
 
+// MarshalJSON encodes the Bucket, so it can be kept by the stores serializing the items as JSON.
+func (b *Bucket) MarshalJSON() ([]byte, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return json.Marshal(bucketState{
+		StartTime:       b.startTime,
+		MaxTokens:       b.maxTokens,
+		Interval:        b.interval,
+		AvailableTokens: b.availableTokens,
+		LastTick:        b.lastTick,
+	})
+}
+
+// UnmarshalJSON decodes the Bucket encoded by MarshalJSON.
+func (b *Bucket) UnmarshalJSON(data []byte) error {
+	var state bucketState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.startTime = state.StartTime
+	b.maxTokens = state.MaxTokens
+	b.interval = state.Interval
+	b.availableTokens = state.AvailableTokens
+	b.lastTick = state.LastTick
+
+	return nil
+}
+
+// MarshalBinary encodes the Bucket, so it can be kept by the stores requiring binary values, e.g. Redis.
+func (b *Bucket) MarshalBinary() ([]byte, error) {
+	return b.MarshalJSON()
+}
+
+// UnmarshalBinary decodes the Bucket encoded by MarshalBinary.
+func (b *Bucket) UnmarshalBinary(data []byte) error {
+	return b.UnmarshalJSON(data)
+}
+
 // get returns information about the Bucket.
 func (b *Bucket) get() (tokens uint64, remaining uint64, retErr error) {
 	b.lock.Lock()
@@ -167,6 +285,31 @@ func (b *Bucket) get() (tokens uint64, remaining uint64, retErr error) {
 	return
 }
 
+// burst adds the tokens to the available tokens of the Bucket.
+func (b *Bucket) burst(tokens uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.availableTokens += tokens
+}
+
+// ttl returns the time until the next tick of the Bucket, the tokens are reset then, so the stores don't need to
+// keep it longer.
+func (b *Bucket) ttl() time.Duration {
+	now := uint64(carbon.Now().TimestampNano())
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if now < b.startTime {
+		return b.interval
+	}
+
+	reset := b.startTime + ((tick(b.startTime, now, b.interval) + 1) * uint64(b.interval))
+
+	return time.Duration(reset - now)
+}
+
 // take attempts to remove a token from the Bucket. If there are no tokens
 // available and the clock has ticked forward, it recalculates the number of
 // tokens and retries. It returns the limit, remaining tokens, time until
@@ -2,14 +2,21 @@ package limit
 
 import (
 	"context"
+	"encoding"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/goravel/framework/cache"
+	contractscache "github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/http"
 	cachemocks "github.com/goravel/framework/mocks/cache"
+	configmocks "github.com/goravel/framework/mocks/config"
 )
 
 type StoreTestSuite struct {
@@ -44,6 +51,59 @@ func (s *StoreTestSuite) TestStore_Take() {
 	s.mockCache.AssertExpectations(s.T())
 }
 
+func (s *StoreTestSuite) TestStore_TakeEncoded() {
+	// The stores serializing the items, e.g. Redis, keep the encoded bucket, it's stored again once it's changed.
+	data, err := NewBucket(10, time.Minute).MarshalBinary()
+	s.NoError(err)
+	mockLock := &cachemocks.Lock{}
+	s.mockCache.On("Get", "testKey").Return(string(data)).Twice()
+	s.mockCache.On("Lock", "testKey:lock", lockTimeout).Return(mockLock).Once()
+	mockLock.On("Get").Return(true).Once()
+	mockLock.On("Release").Return(true).Once()
+	s.mockCache.On("Put", "testKey", mock.MatchedBy(func(b *Bucket) bool {
+		return b.availableTokens == 9 && b.maxTokens == 10
+	}), mock.MatchedBy(func(ttl time.Duration) bool {
+		// The bucket is kept until its next tick, not for a whole interval from now.
+		return ttl > 0 && ttl <= time.Minute
+	})).Return(nil).Once()
+
+	tokens, remaining, reset, ok, err := s.store.Take(context.Background(), "testKey")
+
+	s.NoError(err)
+	s.True(ok)
+	s.Equal(uint64(10), tokens)
+	s.Equal(uint64(9), remaining)
+	s.NotZero(reset)
+	s.mockCache.AssertExpectations(s.T())
+	mockLock.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestStore_TakeConcurrently() {
+	http.CacheFacade = newEncodedCache(s.T())
+
+	var wg sync.WaitGroup
+	var taken atomic.Int64
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, _, _, ok, err := s.store.Take(context.Background(), "testKey")
+			s.NoError(err)
+			if ok {
+				taken.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(int64(10), taken.Load())
+	tokens, remaining, err := s.store.Get(context.Background(), "testKey")
+	s.NoError(err)
+	s.Equal(uint64(10), tokens)
+	s.Equal(uint64(0), remaining)
+}
+
 func (s *StoreTestSuite) TestStore_Get() {
 	s.mockCache.On("Get", "testKey").Return(NewBucket(10, time.Minute)).Once()
 	tokens, remaining, err := s.store.Get(context.Background(), "testKey")
@@ -89,6 +149,24 @@ func (s *StoreTestSuite) TestBucket_NewBucket() {
 	s.Equal(time.Second, b.interval)
 }
 
+func (s *StoreTestSuite) TestBucket_Encode() {
+	b := NewBucket(10, time.Second)
+	_, _, _, ok, err := b.take()
+	s.NoError(err)
+	s.True(ok)
+
+	data, err := b.MarshalBinary()
+	s.NoError(err)
+
+	decoded := &Bucket{}
+	s.NoError(decoded.UnmarshalBinary(data))
+	s.Equal(b.startTime, decoded.startTime)
+	s.Equal(uint64(10), decoded.maxTokens)
+	s.Equal(uint64(9), decoded.availableTokens)
+	s.Equal(time.Second, decoded.interval)
+	s.Error(decoded.UnmarshalBinary([]byte("invalid")))
+}
+
 func (s *StoreTestSuite) TestBucket_Get() {
 	b := NewBucket(10, time.Second)
 	tokens, remaining, err := b.get()
@@ -119,3 +197,39 @@ func (s *StoreTestSuite) TestBucket_Take_Failure() {
 	s.Equal(uint64(0), remaining)
 	s.NotZero(reset)
 }
+
+// encodedCache keeps the encoded items like the Redis store, so the buckets aren't shared by the requests, and takes
+// some time to put an item like a remote store.
+type encodedCache struct {
+	*cache.Memory
+}
+
+func newEncodedCache(t *testing.T) *encodedCache {
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel").Once()
+	memory, err := cache.NewMemory(mockConfig)
+	assert.Nil(t, err)
+
+	return &encodedCache{Memory: memory}
+}
+
+func (r *encodedCache) Put(key string, value any, t time.Duration) error {
+	if marshaler, ok := value.(encoding.BinaryMarshaler); ok {
+		data, err := marshaler.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		value = string(data)
+	}
+	time.Sleep(time.Millisecond)
+
+	return r.Memory.Put(key, value, t)
+}
+
+func (r *encodedCache) Store(name string) contractscache.Driver {
+	return r
+}
+
+func (r *encodedCache) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return cache.Flexible(r, key, fresh, stale, callback)
+}
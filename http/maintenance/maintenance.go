@@ -0,0 +1,82 @@
+package maintenance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/goravel/framework/support"
+	"github.com/goravel/framework/support/str"
+)
+
+// CookieName The cookie set by visiting the bypass secret, the requests with the cookie are served during maintenance.
+const CookieName = "goravel_maintenance"
+
+// Payload The options of the maintenance mode, they are stored in the maintenance file by the "down" command.
+type Payload struct {
+	// Except The paths that are served during maintenance, e.g. "/health" or "/api/status/*".
+	Except []string `json:"except,omitempty"`
+	// Retry The value of the Retry-After header in seconds, the header is omitted if zero.
+	Retry int `json:"retry,omitempty"`
+	// Secret The path segment that sets the bypass cookie, e.g. visiting "/1630542a" with the secret "1630542a".
+	Secret string `json:"secret,omitempty"`
+	// Status The status code of the maintenance response.
+	Status int `json:"status"`
+}
+
+// File Get the path of the maintenance file, the application is down while the file exists.
+func File() string {
+	return filepath.Join(support.RelativePath, "storage", "framework", "down")
+}
+
+// IsDown Determine whether the application is in maintenance mode.
+func IsDown() bool {
+	_, err := os.Stat(File())
+
+	return err == nil
+}
+
+// Read the payload of the maintenance file, the error is returned if the application isn't down.
+func Read() (*Payload, error) {
+	content, err := os.ReadFile(File())
+	if err != nil {
+		return nil, err
+	}
+
+	var payload Payload
+	if err := json.Unmarshal(content, &payload); err != nil {
+		return nil, err
+	}
+
+	return &payload, nil
+}
+
+func Write(payload Payload) error {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(File()), os.ModePerm); err != nil {
+		return err
+	}
+
+	return os.WriteFile(File(), content, 0644)
+}
+
+func Remove() error {
+	return os.Remove(File())
+}
+
+// Excepts Determine whether the path is served during maintenance, a pattern ending with "*" matches the paths with the prefix.
+func (r *Payload) Excepts(path string) bool {
+	return str.MatchPath(path, r.Except...)
+}
+
+// Hash Get the value of the bypass cookie, the secret itself is never sent to the client.
+func Hash(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+)
+
+// Abilities Abort the requests whose token doesn't have all the given abilities (e.g. "posts.read") with 403,
+// the token of the default guard must be parsed by a previous middleware.
+func Abilities(abilities ...string) httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		auth := http.AuthFacade(ctx)
+		for _, ability := range abilities {
+			if auth == nil || !auth.Can(ability) {
+				ctx.Request().AbortWithStatus(httpcontract.StatusForbidden)
+				return
+			}
+		}
+
+		ctx.Request().Next()
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"testing"
+
+	contractsauth "github.com/goravel/framework/contracts/auth"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	authmocks "github.com/goravel/framework/mocks/auth"
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func TestAbilities(t *testing.T) {
+	newContext := func(t *testing.T) (*httpmocks.Context, *httpmocks.ContextRequest, *authmocks.Auth) {
+		mockContext := httpmocks.NewContext(t)
+		mockRequest := httpmocks.NewContextRequest(t)
+		mockAuth := authmocks.NewAuth(t)
+		mockContext.EXPECT().Request().Return(mockRequest).Maybe()
+		http.AuthFacade = func(ctx contractshttp.Context) contractsauth.Auth {
+			return mockAuth
+		}
+
+		return mockContext, mockRequest, mockAuth
+	}
+	defer func() {
+		http.AuthFacade = nil
+	}()
+
+	t.Run("token has all the abilities", func(t *testing.T) {
+		mockContext, mockRequest, mockAuth := newContext(t)
+		mockAuth.EXPECT().Can("posts.read").Return(true).Once()
+		mockAuth.EXPECT().Can("posts.write").Return(true).Once()
+		mockRequest.EXPECT().Next().Once()
+
+		Abilities("posts.read", "posts.write")(mockContext)
+	})
+
+	t.Run("token misses an ability", func(t *testing.T) {
+		mockContext, mockRequest, mockAuth := newContext(t)
+		mockAuth.EXPECT().Can("posts.read").Return(false).Once()
+		mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusForbidden).Once()
+
+		Abilities("posts.read", "posts.write")(mockContext)
+	})
+}
@@ -0,0 +1,270 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/spf13/cast"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+)
+
+const (
+	HeaderAcceptEncoding  = "Accept-Encoding"
+	HeaderContentEncoding = "Content-Encoding"
+	HeaderContentLength   = "Content-Length"
+	HeaderContentType     = "Content-Type"
+	HeaderRange           = "Range"
+
+	EncodingBrotli = "br"
+	EncodingGzip   = "gzip"
+)
+
+// compressOptions The options of the Compress middleware, they are read from the http.compression config.
+type compressOptions struct {
+	contentTypes []string
+	level        int
+	minSize      int
+}
+
+// Compress Compress the responses with brotli or gzip by the Accept-Encoding header of the request, brotli is
+// preferred if both are accepted equally. The responses are compressed by the http.compression config: min_size
+// (1024 bytes by default), level (the default level of the encoding by default) and content_types, e.g. "text/*".
+//
+// The compression is implemented at the response writer level, so the middleware requires the response to implement
+// http.ResponseWriterSetter, an error is reported otherwise. The streamed responses are compressed and flushed as
+// they are written.
+func Compress() httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		if http.ConfigFacade == nil || ctx.Request().Method() == httpcontract.MethodHead ||
+			ctx.Request().Header(HeaderRange) != "" {
+			ctx.Request().Next()
+			return
+		}
+
+		writer := ctx.Response().Writer()
+		addVary(writer.Header(), HeaderAcceptEncoding)
+
+		encoding := negotiateEncoding(ctx.Request().Header(HeaderAcceptEncoding))
+		if encoding == "" {
+			ctx.Request().Next()
+			return
+		}
+
+		setter, ok := http.WriterSetter(ctx, "Compress")
+		if !ok {
+			ctx.Request().Next()
+			return
+		}
+
+		compressor := newCompressWriter(writer, encoding, compressConfig())
+		setter.SetWriter(compressor)
+		ctx.Request().Next()
+		setter.SetWriter(writer)
+
+		_ = compressor.Close()
+	}
+}
+
+func compressConfig() compressOptions {
+	return compressOptions{
+		contentTypes: cast.ToStringSlice(http.ConfigFacade.Get("http.compression.content_types", []string{
+			"text/*", "application/json", "application/javascript", "application/xml", "image/svg+xml",
+		})),
+		level:   http.ConfigFacade.GetInt("http.compression.level", -1),
+		minSize: http.ConfigFacade.GetInt("http.compression.min_size", 1024),
+	}
+}
+
+// addVary Add the value to the Vary header if it's missing.
+func addVary(header nethttp.Header, value string) {
+	for _, vary := range header.Values(HeaderVary) {
+		for _, item := range strings.Split(vary, ",") {
+			if strings.EqualFold(strings.TrimSpace(item), value) {
+				return
+			}
+		}
+	}
+
+	header.Add(HeaderVary, value)
+}
+
+// negotiateEncoding Get the supported encoding of the highest quality in the Accept-Encoding header, brotli is
+// preferred to gzip if their qualities are the same.
+func negotiateEncoding(acceptEncoding string) string {
+	qualities := make(map[string]float64)
+	for _, item := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(item), ";")
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		qualities[strings.ToLower(strings.TrimSpace(name))] = quality
+	}
+
+	var encoding string
+	var best float64
+	for _, name := range []string{EncodingBrotli, EncodingGzip} {
+		quality, exist := qualities[name]
+		if !exist {
+			quality, exist = qualities["*"]
+		}
+		if exist && quality > best {
+			encoding, best = name, quality
+		}
+	}
+
+	return encoding
+}
+
+// compressWriter Compress a response once it's known to be compressible, the body is buffered until it reaches the
+// min size, so the small responses aren't compressed. A flushed response is compressed regardless of its size.
+type compressWriter struct {
+	nethttp.ResponseWriter
+	buffer   bytes.Buffer
+	decided  bool
+	encoder  io.WriteCloser
+	encoding string
+	options  compressOptions
+	status   int
+}
+
+func newCompressWriter(writer nethttp.ResponseWriter, encoding string, options compressOptions) *compressWriter {
+	return &compressWriter{ResponseWriter: writer, encoding: encoding, options: options}
+}
+
+func (r *compressWriter) WriteHeader(status int) {
+	if r.decided {
+		r.ResponseWriter.WriteHeader(status)
+
+		return
+	}
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *compressWriter) Write(data []byte) (int, error) {
+	if !r.decided {
+		if r.status == 0 {
+			r.status = httpcontract.StatusOK
+		}
+		r.buffer.Write(data)
+		if r.buffer.Len() < r.options.minSize {
+			return len(data), nil
+		}
+		if err := r.decide(true); err != nil {
+			return 0, err
+		}
+
+		return len(data), nil
+	}
+	if r.encoder != nil {
+		return r.encoder.Write(data)
+	}
+
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *compressWriter) Flush() {
+	if !r.decided {
+		_ = r.decide(r.buffer.Len() > 0 || r.status != 0)
+	}
+	if flusher, ok := r.encoder.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	if flusher, ok := r.ResponseWriter.(nethttp.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Close Write the buffered response, it's compressed if it reaches the min size, and close the encoder.
+func (r *compressWriter) Close() error {
+	if !r.decided {
+		if err := r.decide(r.buffer.Len() >= r.options.minSize); err != nil {
+			return err
+		}
+	}
+	if r.encoder != nil {
+		return r.encoder.Close()
+	}
+
+	return nil
+}
+
+// decide Start to compress the response if compress is true and the response is compressible, then write the
+// status and the buffered body.
+func (r *compressWriter) decide(compress bool) error {
+	r.decided = true
+
+	header := r.Header()
+	if header.Get(HeaderContentType) == "" && r.buffer.Len() > 0 {
+		header.Set(HeaderContentType, nethttp.DetectContentType(r.buffer.Bytes()))
+	}
+
+	if compress && r.compressible() {
+		header.Set(HeaderContentEncoding, r.encoding)
+		header.Del(HeaderContentLength)
+		if r.encoding == EncodingBrotli {
+			level := r.options.level
+			if level < 0 {
+				level = brotli.DefaultCompression
+			}
+			r.encoder = brotli.NewWriterLevel(r.ResponseWriter, level)
+		} else {
+			encoder, err := gzip.NewWriterLevel(r.ResponseWriter, r.options.level)
+			if err != nil {
+				return err
+			}
+			r.encoder = encoder
+		}
+	}
+
+	if r.status != 0 {
+		r.ResponseWriter.WriteHeader(r.status)
+	}
+	if r.buffer.Len() == 0 {
+		return nil
+	}
+
+	var err error
+	if r.encoder != nil {
+		_, err = r.encoder.Write(r.buffer.Bytes())
+	} else {
+		_, err = r.ResponseWriter.Write(r.buffer.Bytes())
+	}
+	r.buffer.Reset()
+
+	return err
+}
+
+func (r *compressWriter) compressible() bool {
+	if r.status < httpcontract.StatusOK || r.status == httpcontract.StatusNoContent ||
+		r.status == httpcontract.StatusNotModified || r.Header().Get(HeaderContentEncoding) != "" {
+		return false
+	}
+
+	contentType, _, _ := strings.Cut(r.Header().Get(HeaderContentType), ";")
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	for _, pattern := range r.options.contentTypes {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(contentType, prefix) {
+				return true
+			}
+		} else if contentType == pattern {
+			return true
+		}
+	}
+
+	return false
+}
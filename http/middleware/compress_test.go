@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/http"
+	configmocks "github.com/goravel/framework/mocks/config"
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func TestCompress(t *testing.T) {
+	large := strings.Repeat("Goravel ", 200)
+
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		handler        func(writer nethttp.ResponseWriter)
+		assert         func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:           "the large response is compressed with gzip",
+			acceptEncoding: "gzip, deflate",
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set(HeaderContentType, "text/plain; charset=utf-8")
+				writer.Header().Set(HeaderContentLength, "1600")
+				_, _ = writer.Write([]byte(large))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, EncodingGzip, recorder.Header().Get(HeaderContentEncoding))
+				assert.Equal(t, HeaderAcceptEncoding, recorder.Header().Get(HeaderVary))
+				assert.Equal(t, "", recorder.Header().Get(HeaderContentLength))
+				reader, err := gzip.NewReader(recorder.Body)
+				assert.Nil(t, err)
+				body, err := io.ReadAll(reader)
+				assert.Nil(t, err)
+				assert.Equal(t, large, string(body))
+			},
+		},
+		{
+			name:           "brotli is preferred",
+			acceptEncoding: "gzip, br",
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set(HeaderContentType, "application/json")
+				writer.WriteHeader(nethttp.StatusCreated)
+				_, _ = writer.Write([]byte(large[:800]))
+				_, _ = writer.Write([]byte(large[800:]))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, nethttp.StatusCreated, recorder.Code)
+				assert.Equal(t, EncodingBrotli, recorder.Header().Get(HeaderContentEncoding))
+				body, err := io.ReadAll(brotli.NewReader(recorder.Body))
+				assert.Nil(t, err)
+				assert.Equal(t, large, string(body))
+			},
+		},
+		{
+			name:           "the small response isn't compressed",
+			acceptEncoding: "gzip",
+			handler: func(writer nethttp.ResponseWriter) {
+				_, _ = writer.Write([]byte("Goravel"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, "", recorder.Header().Get(HeaderContentEncoding))
+				assert.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get(HeaderContentType))
+				assert.Equal(t, "Goravel", recorder.Body.String())
+			},
+		},
+		{
+			name:           "the response of the other content type isn't compressed",
+			acceptEncoding: "gzip",
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set(HeaderContentType, "image/png")
+				_, _ = writer.Write([]byte(large))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, "", recorder.Header().Get(HeaderContentEncoding))
+				assert.Equal(t, large, recorder.Body.String())
+			},
+		},
+		{
+			name:           "the encoded response isn't compressed again",
+			acceptEncoding: "gzip",
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set(HeaderContentType, "text/plain")
+				writer.Header().Set(HeaderContentEncoding, "identity")
+				_, _ = writer.Write([]byte(large))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, "identity", recorder.Header().Get(HeaderContentEncoding))
+				assert.Equal(t, large, recorder.Body.String())
+			},
+		},
+		{
+			name:           "the streamed response is compressed as it's flushed",
+			acceptEncoding: "gzip",
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set(HeaderContentType, "text/event-stream")
+				_, _ = writer.Write([]byte("data: 1\n\n"))
+				writer.(nethttp.Flusher).Flush()
+				_, _ = writer.Write([]byte("data: 2\n\n"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.True(t, recorder.Flushed)
+				assert.Equal(t, EncodingGzip, recorder.Header().Get(HeaderContentEncoding))
+				reader, err := gzip.NewReader(recorder.Body)
+				assert.Nil(t, err)
+				body, err := io.ReadAll(reader)
+				assert.Nil(t, err)
+				assert.Equal(t, "data: 1\n\ndata: 2\n\n", string(body))
+			},
+		},
+		{
+			name:           "the response isn't compressed if no encoding is accepted",
+			acceptEncoding: "gzip;q=0, identity",
+			handler: func(writer nethttp.ResponseWriter) {
+				_, _ = writer.Write([]byte(large))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, "", recorder.Header().Get(HeaderContentEncoding))
+				assert.Equal(t, HeaderAcceptEncoding, recorder.Header().Get(HeaderVary))
+				assert.Equal(t, large, recorder.Body.String())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockConfig := configmocks.NewConfig(t)
+			mockConfig.EXPECT().Get("http.compression.content_types", mock.Anything).RunAndReturn(func(key string, def ...any) any {
+				return def[0]
+			}).Maybe()
+			mockConfig.EXPECT().GetInt("http.compression.level", -1).Return(-1).Maybe()
+			mockConfig.EXPECT().GetInt("http.compression.min_size", 1024).Return(1024).Maybe()
+			http.ConfigFacade = mockConfig
+			t.Cleanup(func() {
+				http.ConfigFacade = nil
+			})
+
+			recorder := httptest.NewRecorder()
+			mockContext := httpmocks.NewContext(t)
+			mockRequest := httpmocks.NewContextRequest(t)
+			response := &writableResponse{ContextResponse: httpmocks.NewContextResponse(t), writer: recorder}
+			mockContext.EXPECT().Request().Return(mockRequest)
+			mockContext.EXPECT().Response().Return(response)
+			mockRequest.EXPECT().Method().Return("GET").Once()
+			mockRequest.EXPECT().Header(HeaderRange).Return("").Once()
+			mockRequest.EXPECT().Header(HeaderAcceptEncoding).Return(test.acceptEncoding).Once()
+			mockRequest.EXPECT().Next().Run(func() {
+				test.handler(response.Writer())
+			}).Once()
+
+			Compress()(mockContext)
+
+			assert.Equal(t, recorder, response.writer)
+			test.assert(recorder)
+		})
+	}
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	assert.Equal(t, EncodingBrotli, negotiateEncoding("gzip, deflate, br"))
+	assert.Equal(t, EncodingGzip, negotiateEncoding("br;q=0.5, gzip"))
+	assert.Equal(t, EncodingBrotli, negotiateEncoding("*"))
+	assert.Equal(t, EncodingGzip, negotiateEncoding("br;q=0, *;q=0.1"))
+	assert.Equal(t, "", negotiateEncoding("identity"))
+	assert.Equal(t, "", negotiateEncoding(""))
+}
+
+func TestAddVary(t *testing.T) {
+	header := nethttp.Header{}
+	header.Set(HeaderVary, "Origin, accept-encoding")
+	addVary(header, HeaderAcceptEncoding)
+	assert.Equal(t, []string{"Origin, accept-encoding"}, header.Values(HeaderVary))
+
+	addVary(header, "Cookie")
+	assert.Equal(t, []string{"Origin, accept-encoding", "Cookie"}, header.Values(HeaderVary))
+}
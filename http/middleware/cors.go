@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/support/str"
+)
+
+const (
+	HeaderAccessControlAllowCredentials = "Access-Control-Allow-Credentials"
+	HeaderAccessControlAllowHeaders     = "Access-Control-Allow-Headers"
+	HeaderAccessControlAllowMethods     = "Access-Control-Allow-Methods"
+	HeaderAccessControlAllowOrigin      = "Access-Control-Allow-Origin"
+	HeaderAccessControlExposeHeaders    = "Access-Control-Expose-Headers"
+	HeaderAccessControlMaxAge           = "Access-Control-Max-Age"
+	HeaderAccessControlRequestHeaders   = "Access-Control-Request-Headers"
+	HeaderAccessControlRequestMethod    = "Access-Control-Request-Method"
+	HeaderOrigin                        = "Origin"
+	HeaderVary                          = "Vary"
+)
+
+// corsOptions The options of the CORS middleware, they are read from the cors config.
+type corsOptions struct {
+	allowedHeaders      []string
+	allowedMethods      []string
+	allowedOrigins      []string
+	exposedHeaders      []string
+	maxAge              int
+	paths               []string
+	supportsCredentials bool
+}
+
+// Cors Handle the cross-origin requests by the cors config: paths, allowed_origins (e.g. "https://*.goravel.dev"),
+// allowed_methods, allowed_headers, exposed_headers, max_age and supports_credentials. The preflight requests are
+// answered by the middleware, they don't reach the routes.
+func Cors() httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		if http.ConfigFacade == nil {
+			ctx.Request().Next()
+			return
+		}
+
+		options := corsConfig()
+		if len(options.paths) > 0 && !str.MatchPath(ctx.Request().Path(), options.paths...) {
+			ctx.Request().Next()
+			return
+		}
+
+		// The headers depend on the origin unless any origin is allowed, so the caches must vary by it, even for the
+		// requests without or with a disallowed origin.
+		anyOrigin := slices.Contains(options.allowedOrigins, "*") && !options.supportsCredentials
+		if !anyOrigin {
+			addVary(ctx.Response().Writer().Header(), HeaderOrigin)
+		}
+
+		origin := ctx.Request().Header(HeaderOrigin)
+		if origin == "" {
+			ctx.Request().Next()
+			return
+		}
+
+		preflight := ctx.Request().Method() == "OPTIONS" && ctx.Request().Header(HeaderAccessControlRequestMethod) != ""
+		if !http.MatchOrigin(options.allowedOrigins, origin) {
+			if preflight {
+				ctx.Request().AbortWithStatus(httpcontract.StatusForbidden)
+				return
+			}
+
+			ctx.Request().Next()
+			return
+		}
+
+		if anyOrigin {
+			ctx.Response().Header(HeaderAccessControlAllowOrigin, "*")
+		} else {
+			ctx.Response().Header(HeaderAccessControlAllowOrigin, origin)
+		}
+		if options.supportsCredentials {
+			ctx.Response().Header(HeaderAccessControlAllowCredentials, "true")
+		}
+
+		if !preflight {
+			if len(options.exposedHeaders) > 0 {
+				ctx.Response().Header(HeaderAccessControlExposeHeaders, strings.Join(options.exposedHeaders, ", "))
+			}
+
+			ctx.Request().Next()
+			return
+		}
+
+		if slices.Contains(options.allowedMethods, "*") {
+			ctx.Response().Header(HeaderAccessControlAllowMethods, strings.ToUpper(ctx.Request().Header(HeaderAccessControlRequestMethod)))
+		} else {
+			ctx.Response().Header(HeaderAccessControlAllowMethods, strings.ToUpper(strings.Join(options.allowedMethods, ", ")))
+		}
+		if slices.Contains(options.allowedHeaders, "*") {
+			if headers := ctx.Request().Header(HeaderAccessControlRequestHeaders); headers != "" {
+				ctx.Response().Header(HeaderAccessControlAllowHeaders, headers)
+			}
+		} else if len(options.allowedHeaders) > 0 {
+			ctx.Response().Header(HeaderAccessControlAllowHeaders, strings.Join(options.allowedHeaders, ", "))
+		}
+		if options.maxAge > 0 {
+			ctx.Response().Header(HeaderAccessControlMaxAge, strconv.Itoa(options.maxAge))
+		}
+
+		ctx.Request().AbortWithStatus(httpcontract.StatusNoContent)
+	}
+}
+
+func corsConfig() corsOptions {
+	return corsOptions{
+		allowedHeaders:      cast.ToStringSlice(http.ConfigFacade.Get("cors.allowed_headers", []string{"*"})),
+		allowedMethods:      cast.ToStringSlice(http.ConfigFacade.Get("cors.allowed_methods", []string{"*"})),
+		allowedOrigins:      cast.ToStringSlice(http.ConfigFacade.Get("cors.allowed_origins", []string{"*"})),
+		exposedHeaders:      cast.ToStringSlice(http.ConfigFacade.Get("cors.exposed_headers", []string{})),
+		maxAge:              http.ConfigFacade.GetInt("cors.max_age", 0),
+		paths:               cast.ToStringSlice(http.ConfigFacade.Get("cors.paths", []string{})),
+		supportsCredentials: http.ConfigFacade.GetBool("cors.supports_credentials", false),
+	}
+}
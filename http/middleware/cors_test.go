@@ -0,0 +1,180 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	configmocks "github.com/goravel/framework/mocks/config"
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func TestCors(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse)
+		config map[string]any
+		vary   []string
+	}{
+		{
+			name: "the request without origin is served",
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Header(HeaderOrigin).Return("").Once()
+				mockRequest.EXPECT().Next().Once()
+			},
+		},
+		{
+			name:   "the request without origin varies by origin if the origins are limited",
+			config: map[string]any{"cors.allowed_origins": []string{"https://goravel.dev"}},
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Header(HeaderOrigin).Return("").Once()
+				mockRequest.EXPECT().Next().Once()
+			},
+			vary: []string{"Accept-Encoding", HeaderOrigin},
+		},
+		{
+			name:   "the request out of the paths is served without the headers",
+			config: map[string]any{"cors.paths": []string{"/api/*"}},
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Path().Return("/users").Once()
+				mockRequest.EXPECT().Next().Once()
+			},
+		},
+		{
+			name: "the request of any origin",
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Header(HeaderOrigin).Return("https://goravel.dev").Once()
+				mockRequest.EXPECT().Method().Return("GET").Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowOrigin, "*").Return(mockResponse).Once()
+				mockRequest.EXPECT().Next().Once()
+			},
+		},
+		{
+			name: "the request of the wildcard origin with credentials",
+			config: map[string]any{
+				"cors.allowed_origins":      []string{"https://*.goravel.dev"},
+				"cors.exposed_headers":      []string{"X-Total"},
+				"cors.supports_credentials": true,
+				"cors.paths":                []string{"/api/*"},
+			},
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Header(HeaderOrigin).Return("https://www.goravel.dev").Once()
+				mockRequest.EXPECT().Path().Return("/api/users").Once()
+				mockRequest.EXPECT().Method().Return("POST").Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowOrigin, "https://www.goravel.dev").Return(mockResponse).Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowCredentials, "true").Return(mockResponse).Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlExposeHeaders, "X-Total").Return(mockResponse).Once()
+				mockRequest.EXPECT().Next().Once()
+			},
+			vary: []string{"Accept-Encoding", HeaderOrigin},
+		},
+		{
+			name:   "the request of the disallowed origin is served without the headers",
+			config: map[string]any{"cors.allowed_origins": []string{"https://*.goravel.dev"}},
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Header(HeaderOrigin).Return("https://goravel.com").Once()
+				mockRequest.EXPECT().Method().Return("GET").Once()
+				mockRequest.EXPECT().Next().Once()
+			},
+			vary: []string{"Accept-Encoding", HeaderOrigin},
+		},
+		{
+			name:   "the preflight request of the disallowed origin is aborted",
+			config: map[string]any{"cors.allowed_origins": []string{"https://goravel.dev"}},
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Header(HeaderOrigin).Return("https://goravel.com").Once()
+				mockRequest.EXPECT().Method().Return("OPTIONS").Once()
+				mockRequest.EXPECT().Header(HeaderAccessControlRequestMethod).Return("PUT").Once()
+				mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusForbidden).Once()
+			},
+			vary: []string{"Accept-Encoding", HeaderOrigin},
+		},
+		{
+			name: "the preflight request is answered",
+			config: map[string]any{
+				"cors.allowed_methods": []string{"get", "put"},
+				"cors.allowed_headers": []string{"Content-Type", "Authorization"},
+				"cors.max_age":         600,
+			},
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Header(HeaderOrigin).Return("https://goravel.dev").Once()
+				mockRequest.EXPECT().Method().Return("OPTIONS").Once()
+				mockRequest.EXPECT().Header(HeaderAccessControlRequestMethod).Return("PUT").Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowOrigin, "*").Return(mockResponse).Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowMethods, "GET, PUT").Return(mockResponse).Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowHeaders, "Content-Type, Authorization").Return(mockResponse).Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlMaxAge, "600").Return(mockResponse).Once()
+				mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusNoContent).Once()
+			},
+		},
+		{
+			name: "the preflight request is answered with the requested method and headers",
+			setup: func(mockRequest *httpmocks.ContextRequest, mockResponse *httpmocks.ContextResponse) {
+				mockRequest.EXPECT().Header(HeaderOrigin).Return("https://goravel.dev").Once()
+				mockRequest.EXPECT().Method().Return("OPTIONS").Once()
+				mockRequest.EXPECT().Header(HeaderAccessControlRequestMethod).Return("delete").Twice()
+				mockRequest.EXPECT().Header(HeaderAccessControlRequestHeaders).Return("X-Token").Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowOrigin, "*").Return(mockResponse).Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowMethods, "DELETE").Return(mockResponse).Once()
+				mockResponse.EXPECT().Header(HeaderAccessControlAllowHeaders, "X-Token").Return(mockResponse).Once()
+				mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusNoContent).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockConfig := configmocks.NewConfig(t)
+			mockContext := httpmocks.NewContext(t)
+			mockRequest := httpmocks.NewContextRequest(t)
+			mockResponse := httpmocks.NewContextResponse(t)
+			mockContext.EXPECT().Request().Return(mockRequest)
+			mockContext.EXPECT().Response().Return(mockResponse).Maybe()
+			recorder := httptest.NewRecorder()
+			recorder.Header().Set(HeaderVary, "Accept-Encoding")
+			mockResponse.EXPECT().Writer().Return(recorder).Maybe()
+
+			for _, key := range []string{"cors.allowed_headers", "cors.allowed_methods", "cors.allowed_origins"} {
+				value, exist := test.config[key]
+				if !exist {
+					value = []string{"*"}
+				}
+				mockConfig.EXPECT().Get(key, []string{"*"}).Return(value).Maybe()
+			}
+			for _, key := range []string{"cors.exposed_headers", "cors.paths"} {
+				value, exist := test.config[key]
+				if !exist {
+					value = []string{}
+				}
+				mockConfig.EXPECT().Get(key, []string{}).Return(value).Maybe()
+			}
+			mockConfig.EXPECT().GetInt("cors.max_age", 0).Return(maxAge(test.config)).Maybe()
+			mockConfig.EXPECT().GetBool("cors.supports_credentials", false).Return(test.config["cors.supports_credentials"] == true).Maybe()
+
+			http.ConfigFacade = mockConfig
+			t.Cleanup(func() {
+				http.ConfigFacade = nil
+			})
+
+			test.setup(mockRequest, mockResponse)
+			Cors()(mockContext)
+
+			vary := test.vary
+			if vary == nil {
+				vary = []string{"Accept-Encoding"}
+			}
+			assert.Equal(t, vary, recorder.Header().Values(HeaderVary))
+		})
+	}
+}
+
+func maxAge(config map[string]any) int {
+	if value, ok := config["cors.max_age"].(int); ok {
+		return value
+	}
+
+	return 0
+}
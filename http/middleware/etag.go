@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/goravel/framework/contracts/http"
+	frameworkhttp "github.com/goravel/framework/http"
+)
+
+const (
+	HeaderCacheControl    = "Cache-Control"
+	HeaderETag            = "ETag"
+	HeaderIfModifiedSince = "If-Modified-Since"
+	HeaderIfNoneMatch     = "If-None-Match"
+	HeaderLastModified    = "Last-Modified"
+
+	// ETagDisabledKey The context key disabling the ETag middleware for a route, see WithoutETag.
+	ETagDisabledKey = "goravel_etag_disabled"
+)
+
+// ETag Set the ETag header of the cacheable responses, i.e. the 200 responses of the GET and HEAD requests, and
+// answer the conditional requests with 304 if the If-None-Match or If-Modified-Since header matches. The ETag set
+// by the route is kept, otherwise it's the hash of the body, and it's weak if weak is true.
+//
+// The body is buffered to compute the ETag, so the middleware requires the response to implement
+// http.ResponseWriterSetter, an error is reported otherwise. The flushed responses, e.g. the streams, are written
+// through.
+func ETag(weak ...bool) http.Middleware {
+	return func(ctx http.Context) {
+		method := ctx.Request().Method()
+		if method != http.MethodGet && method != http.MethodHead {
+			ctx.Request().Next()
+
+			return
+		}
+
+		setter, ok := frameworkhttp.WriterSetter(ctx, "ETag")
+		if !ok {
+			ctx.Request().Next()
+
+			return
+		}
+
+		writer := ctx.Response().Writer()
+		buffer := newBufferedWriter(writer, func() bool {
+			disabled, _ := ctx.Value(ETagDisabledKey).(bool)
+
+			return disabled
+		})
+		setter.SetWriter(buffer)
+		ctx.Request().Next()
+		setter.SetWriter(writer)
+
+		if buffer.passthrough || buffer.status != http.StatusOK ||
+			strings.Contains(writer.Header().Get(HeaderCacheControl), "no-store") {
+			buffer.pass()
+
+			return
+		}
+
+		etag := writer.Header().Get(HeaderETag)
+		if etag == "" {
+			etag = computeETag(buffer.body.Bytes(), len(weak) > 0 && weak[0])
+			writer.Header().Set(HeaderETag, etag)
+		}
+
+		if notModified(ctx.Request(), etag, writer.Header().Get(HeaderLastModified)) {
+			writer.Header().Del("Content-Type")
+			writer.Header().Del("Content-Length")
+			writer.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		buffer.pass()
+	}
+}
+
+// WithoutETag Disable the ETag middleware for the route, the response is written through.
+func WithoutETag() http.Middleware {
+	return func(ctx http.Context) {
+		ctx.WithValue(ETagDisabledKey, true)
+		ctx.Request().Next()
+	}
+}
+
+func computeETag(body []byte, weak bool) string {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	if weak {
+		return "W/" + etag
+	}
+
+	return etag
+}
+
+// notModified Determine whether the conditional request matches the response, the If-Modified-Since header is
+// ignored if the If-None-Match header is present. The ETags are compared weakly.
+func notModified(request http.ContextRequest, etag, lastModified string) bool {
+	if match := request.Header(HeaderIfNoneMatch); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	since := request.Header(HeaderIfModifiedSince)
+	if since == "" || lastModified == "" {
+		return false
+	}
+	sinceTime, err := nethttp.ParseTime(since)
+	if err != nil {
+		return false
+	}
+	modifiedTime, err := nethttp.ParseTime(lastModified)
+	if err != nil {
+		return false
+	}
+
+	return !modifiedTime.After(sinceTime)
+}
+
+// bufferedWriter Buffer the status and the body of a response, the response is written through once it's flushed
+// or bypass returns true, e.g. the route disables the middleware.
+type bufferedWriter struct {
+	nethttp.ResponseWriter
+	body        bytes.Buffer
+	bypass      func() bool
+	passthrough bool
+	status      int
+}
+
+func newBufferedWriter(writer nethttp.ResponseWriter, bypass func() bool) *bufferedWriter {
+	return &bufferedWriter{ResponseWriter: writer, bypass: bypass}
+}
+
+func (r *bufferedWriter) WriteHeader(status int) {
+	if r.passthrough {
+		r.ResponseWriter.WriteHeader(status)
+
+		return
+	}
+	if r.status == 0 {
+		r.status = status
+	}
+	if r.bypass() {
+		r.pass()
+	}
+}
+
+func (r *bufferedWriter) Write(data []byte) (int, error) {
+	if !r.passthrough && r.bypass() {
+		r.pass()
+	}
+	if r.passthrough {
+		return r.ResponseWriter.Write(data)
+	}
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+
+	return r.body.Write(data)
+}
+
+func (r *bufferedWriter) Flush() {
+	r.pass()
+	if flusher, ok := r.ResponseWriter.(nethttp.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// pass Write the buffered response through, the later writes aren't buffered.
+func (r *bufferedWriter) pass() {
+	if r.passthrough {
+		return
+	}
+
+	r.passthrough = true
+	if r.status != 0 {
+		r.ResponseWriter.WriteHeader(r.status)
+	}
+	if r.body.Len() > 0 {
+		_, _ = r.ResponseWriter.Write(r.body.Bytes())
+		r.body.Reset()
+	}
+}
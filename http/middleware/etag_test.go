@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+// writableResponse A response whose writer can be replaced, as the responses of the drivers implementing
+// http.ResponseWriterSetter.
+type writableResponse struct {
+	*httpmocks.ContextResponse
+	writer nethttp.ResponseWriter
+}
+
+func (r *writableResponse) SetWriter(writer nethttp.ResponseWriter) {
+	r.writer = writer
+}
+
+func (r *writableResponse) Writer() nethttp.ResponseWriter {
+	return r.writer
+}
+
+func TestETag(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		headers map[string]string
+		weak    bool
+		handler func(writer nethttp.ResponseWriter)
+		assert  func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "the ETag of the body is set",
+			method: "GET",
+			handler: func(writer nethttp.ResponseWriter) {
+				_, _ = writer.Write([]byte("Goravel"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, nethttp.StatusOK, recorder.Code)
+				assert.Equal(t, computeETag([]byte("Goravel"), false), recorder.Header().Get(HeaderETag))
+				assert.Equal(t, "Goravel", recorder.Body.String())
+			},
+		},
+		{
+			name:   "the weak ETag is set",
+			method: "GET",
+			weak:   true,
+			handler: func(writer nethttp.ResponseWriter) {
+				_, _ = writer.Write([]byte("Goravel"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, "W/"+computeETag([]byte("Goravel"), false), recorder.Header().Get(HeaderETag))
+			},
+		},
+		{
+			name:    "the matched If-None-Match is answered with 304",
+			method:  "GET",
+			headers: map[string]string{HeaderIfNoneMatch: `"other", W/` + computeETag([]byte("Goravel"), false)},
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set("Content-Type", "text/plain")
+				_, _ = writer.Write([]byte("Goravel"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, nethttp.StatusNotModified, recorder.Code)
+				assert.Equal(t, "", recorder.Header().Get("Content-Type"))
+				assert.Equal(t, "", recorder.Body.String())
+			},
+		},
+		{
+			name:    "the ETag of the route is kept",
+			method:  "GET",
+			headers: map[string]string{HeaderIfNoneMatch: `"v1"`},
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set(HeaderETag, `"v2"`)
+				_, _ = writer.Write([]byte("Goravel"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, nethttp.StatusOK, recorder.Code)
+				assert.Equal(t, `"v2"`, recorder.Header().Get(HeaderETag))
+			},
+		},
+		{
+			name:    "the If-Modified-Since is answered with 304",
+			method:  "HEAD",
+			headers: map[string]string{HeaderIfModifiedSince: "Tue, 01 Oct 2024 00:00:00 GMT"},
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set(HeaderLastModified, "Mon, 30 Sep 2024 00:00:00 GMT")
+				writer.WriteHeader(nethttp.StatusOK)
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, nethttp.StatusNotModified, recorder.Code)
+			},
+		},
+		{
+			name:   "the failed response is written through",
+			method: "GET",
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.WriteHeader(nethttp.StatusNotFound)
+				_, _ = writer.Write([]byte("not found"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, nethttp.StatusNotFound, recorder.Code)
+				assert.Equal(t, "", recorder.Header().Get(HeaderETag))
+				assert.Equal(t, "not found", recorder.Body.String())
+			},
+		},
+		{
+			name:   "the no-store response is written through",
+			method: "GET",
+			handler: func(writer nethttp.ResponseWriter) {
+				writer.Header().Set(HeaderCacheControl, "no-store")
+				_, _ = writer.Write([]byte("Goravel"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, "", recorder.Header().Get(HeaderETag))
+				assert.Equal(t, "Goravel", recorder.Body.String())
+			},
+		},
+		{
+			name:   "the flushed response is written through",
+			method: "GET",
+			handler: func(writer nethttp.ResponseWriter) {
+				_, _ = writer.Write([]byte("Go"))
+				writer.(nethttp.Flusher).Flush()
+				_, _ = writer.Write([]byte("ravel"))
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				assert.True(t, recorder.Flushed)
+				assert.Equal(t, "", recorder.Header().Get(HeaderETag))
+				assert.Equal(t, "Goravel", recorder.Body.String())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			mockContext := httpmocks.NewContext(t)
+			mockRequest := httpmocks.NewContextRequest(t)
+			response := &writableResponse{ContextResponse: httpmocks.NewContextResponse(t), writer: recorder}
+			mockContext.EXPECT().Request().Return(mockRequest)
+			mockContext.EXPECT().Response().Return(response)
+			mockContext.EXPECT().Value(ETagDisabledKey).Return(nil).Maybe()
+			mockRequest.EXPECT().Method().Return(test.method).Once()
+			mockRequest.EXPECT().Header(HeaderIfNoneMatch).Return(test.headers[HeaderIfNoneMatch]).Maybe()
+			mockRequest.EXPECT().Header(HeaderIfModifiedSince).Return(test.headers[HeaderIfModifiedSince]).Maybe()
+			mockRequest.EXPECT().Next().Run(func() {
+				test.handler(response.Writer())
+			}).Once()
+
+			ETag(test.weak)(mockContext)
+
+			assert.Equal(t, recorder, response.writer)
+			test.assert(recorder)
+		})
+	}
+}
+
+func TestETagSkipped(t *testing.T) {
+	// The response writer can't be replaced.
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+	mockContext.EXPECT().Response().Return(httpmocks.NewContextResponse(t)).Once()
+	mockRequest.EXPECT().Method().Return("GET").Once()
+	mockRequest.EXPECT().Next().Once()
+	ETag()(mockContext)
+
+	// The route disables the middleware.
+	recorder := httptest.NewRecorder()
+	response := &writableResponse{ContextResponse: httpmocks.NewContextResponse(t), writer: recorder}
+	mockContext = httpmocks.NewContext(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+	mockContext.EXPECT().Response().Return(response)
+	mockContext.EXPECT().WithValue(ETagDisabledKey, true).Once()
+	mockContext.EXPECT().Value(ETagDisabledKey).Return(true).Once()
+	mockRequest.EXPECT().Method().Return("GET").Once()
+	mockRequest.EXPECT().Next().Run(func() {
+		WithoutETag()(mockContext)
+	}).Once()
+	mockRequest.EXPECT().Next().Run(func() {
+		_, _ = response.Writer().Write([]byte("Goravel"))
+	}).Once()
+	ETag()(mockContext)
+
+	assert.Equal(t, "", recorder.Header().Get(HeaderETag))
+	assert.Equal(t, "Goravel", recorder.Body.String())
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/feature"
+)
+
+// Feature Serve the requests only while a feature is active, e.g. to soft launch a route, the requests are aborted
+// with 404 or redirected to the redirect URL while the feature is inactive or the feature service isn't registered.
+func Feature(name string, redirect ...string) httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		if feature.Facade != nil && feature.Facade.Active(ctx, name) {
+			ctx.Request().Next()
+			return
+		}
+
+		if len(redirect) > 0 && redirect[0] != "" {
+			ctx.Response().Header("Location", redirect[0])
+			ctx.Request().AbortWithStatus(httpcontract.StatusFound)
+			return
+		}
+
+		ctx.Request().AbortWithStatus(httpcontract.StatusNotFound)
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"testing"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/feature"
+	featuremocks "github.com/goravel/framework/mocks/feature"
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func TestFeature(t *testing.T) {
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockResponse := httpmocks.NewContextResponse(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+
+	mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusNotFound).Once()
+	Feature("new-checkout")(mockContext)
+
+	mockFeature := featuremocks.NewFeature(t)
+	feature.Facade = mockFeature
+	t.Cleanup(func() {
+		feature.Facade = nil
+	})
+
+	mockFeature.EXPECT().Active(mockContext, "new-checkout").Return(true).Once()
+	mockRequest.EXPECT().Next().Once()
+	Feature("new-checkout")(mockContext)
+
+	mockFeature.EXPECT().Active(mockContext, "new-checkout").Return(false).Once()
+	mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusNotFound).Once()
+	Feature("new-checkout")(mockContext)
+
+	mockContext.EXPECT().Response().Return(mockResponse).Once()
+	mockFeature.EXPECT().Active(mockContext, "new-checkout").Return(false).Once()
+	mockResponse.EXPECT().Header("Location", "/checkout").Return(mockResponse).Once()
+	mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusFound).Once()
+	Feature("new-checkout", "/checkout")(mockContext)
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"strconv"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http/maintenance"
+)
+
+// Maintenance Abort the requests while the application is in maintenance mode, the requests to the except
+// paths (e.g. "/health" or "/api/status/*") and the requests with the bypass cookie are still served.
+func Maintenance(except ...string) httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		payload, err := maintenance.Read()
+		if err != nil {
+			ctx.Request().Next()
+			return
+		}
+
+		payload.Except = append(payload.Except, except...)
+		if payload.Excepts(ctx.Request().Path()) {
+			ctx.Request().Next()
+			return
+		}
+
+		if payload.Secret != "" {
+			if ctx.Request().Path() == "/"+payload.Secret {
+				ctx.Response().Cookie(httpcontract.Cookie{
+					Name:     maintenance.CookieName,
+					Value:    maintenance.Hash(payload.Secret),
+					Path:     "/",
+					MaxAge:   12 * 60 * 60,
+					HttpOnly: true,
+					SameSite: "Lax",
+				})
+				ctx.Response().Header("Location", "/")
+				ctx.Request().AbortWithStatus(httpcontract.StatusTemporaryRedirect)
+				return
+			}
+
+			if ctx.Request().Cookie(maintenance.CookieName) == maintenance.Hash(payload.Secret) {
+				ctx.Request().Next()
+				return
+			}
+		}
+
+		if payload.Retry > 0 {
+			ctx.Response().Header(HeaderRetryAfter, strconv.Itoa(payload.Retry))
+		}
+		status := payload.Status
+		if status == 0 {
+			status = httpcontract.StatusServiceUnavailable
+		}
+		ctx.Request().AbortWithStatus(status)
+	}
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http/maintenance"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	"github.com/goravel/framework/support"
+)
+
+func TestMaintenance(t *testing.T) {
+	support.RelativePath = t.TempDir()
+	defer func() {
+		support.RelativePath = ""
+	}()
+
+	newContext := func(t *testing.T) (*httpmocks.Context, *httpmocks.ContextRequest, *httpmocks.ContextResponse) {
+		mockContext := httpmocks.NewContext(t)
+		mockRequest := httpmocks.NewContextRequest(t)
+		mockResponse := httpmocks.NewContextResponse(t)
+		mockContext.EXPECT().Request().Return(mockRequest).Maybe()
+		mockContext.EXPECT().Response().Return(mockResponse).Maybe()
+
+		return mockContext, mockRequest, mockResponse
+	}
+
+	t.Run("application is up", func(t *testing.T) {
+		mockContext, mockRequest, _ := newContext(t)
+		mockRequest.EXPECT().Next().Once()
+
+		Maintenance()(mockContext)
+	})
+
+	assert.Nil(t, maintenance.Write(maintenance.Payload{
+		Except: []string{"/health"},
+		Retry:  60,
+		Secret: "secret",
+		Status: contractshttp.StatusServiceUnavailable,
+	}))
+
+	t.Run("abort with retry after", func(t *testing.T) {
+		mockContext, mockRequest, mockResponse := newContext(t)
+		mockRequest.EXPECT().Path().Return("/users")
+		mockRequest.EXPECT().Cookie(maintenance.CookieName).Return("").Once()
+		mockResponse.EXPECT().Header(HeaderRetryAfter, "60").Return(mockResponse).Once()
+		mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusServiceUnavailable).Once()
+
+		Maintenance()(mockContext)
+	})
+
+	t.Run("except paths", func(t *testing.T) {
+		mockContext, mockRequest, _ := newContext(t)
+		mockRequest.EXPECT().Path().Return("/health").Once()
+		mockRequest.EXPECT().Next().Once()
+
+		Maintenance()(mockContext)
+
+		mockContext, mockRequest, _ = newContext(t)
+		mockRequest.EXPECT().Path().Return("/api/status/db").Once()
+		mockRequest.EXPECT().Next().Once()
+
+		Maintenance("/api/status/*")(mockContext)
+	})
+
+	t.Run("bypass secret sets cookie", func(t *testing.T) {
+		mockContext, mockRequest, mockResponse := newContext(t)
+		mockRequest.EXPECT().Path().Return("/secret")
+		mockResponse.EXPECT().Cookie(contractshttp.Cookie{
+			Name:     maintenance.CookieName,
+			Value:    maintenance.Hash("secret"),
+			Path:     "/",
+			MaxAge:   12 * 60 * 60,
+			HttpOnly: true,
+			SameSite: "Lax",
+		}).Return(mockResponse).Once()
+		mockResponse.EXPECT().Header("Location", "/").Return(mockResponse).Once()
+		mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusTemporaryRedirect).Once()
+
+		Maintenance()(mockContext)
+	})
+
+	t.Run("bypass cookie", func(t *testing.T) {
+		mockContext, mockRequest, _ := newContext(t)
+		mockRequest.EXPECT().Path().Return("/users")
+		mockRequest.EXPECT().Cookie(maintenance.CookieName).Return(maintenance.Hash("secret")).Once()
+		mockRequest.EXPECT().Next().Once()
+
+		Maintenance()(mockContext)
+	})
+}
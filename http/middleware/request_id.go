@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http/requestid"
+	"github.com/goravel/framework/id"
+)
+
+const (
+	// RequestIDHeader The header carrying the ID of a request.
+	RequestIDHeader = requestid.Header
+	// RequestIDKey The context key of the ID of a request.
+	RequestIDKey = requestid.Key
+)
+
+// RequestID Set the ID of the request to the context and the response header. The ID in the header of the request
+// is kept, so a request can be traced across the services, otherwise it's generated by the ID generator. The ID is
+// added to the logs, the ORM query logs and the headers of the HTTP client requests using the context.
+func RequestID() http.Middleware {
+	return func(ctx http.Context) {
+		requestID := ctx.Request().Header(RequestIDHeader)
+		if !requestid.Valid(requestID) {
+			if id.Facade != nil {
+				requestID = id.Facade.Generate()
+			} else {
+				requestID = uuid.NewString()
+			}
+		}
+
+		ctx.WithValue(RequestIDKey, requestID)
+		ctx.Response().Header(RequestIDHeader, requestID)
+		ctx.Request().Next()
+	}
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/id"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	idmocks "github.com/goravel/framework/mocks/id"
+)
+
+func TestRequestID(t *testing.T) {
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockResponse := httpmocks.NewContextResponse(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+	mockContext.EXPECT().Response().Return(mockResponse)
+
+	mockRequest.EXPECT().Header(RequestIDHeader).Return("upstream").Once()
+	mockContext.EXPECT().WithValue(RequestIDKey, "upstream").Once()
+	mockResponse.EXPECT().Header(RequestIDHeader, "upstream").Return(mockResponse).Once()
+	mockRequest.EXPECT().Next().Once()
+	RequestID()(mockContext)
+
+	mockID := idmocks.NewID(t)
+	id.Facade = mockID
+	t.Cleanup(func() {
+		id.Facade = nil
+	})
+	mockID.EXPECT().Generate().Return("01J0000000000000000000000").Once()
+	mockRequest.EXPECT().Header(RequestIDHeader).Return("").Once()
+	mockContext.EXPECT().WithValue(RequestIDKey, "01J0000000000000000000000").Once()
+	mockResponse.EXPECT().Header(RequestIDHeader, "01J0000000000000000000000").Return(mockResponse).Once()
+	mockRequest.EXPECT().Next().Once()
+	RequestID()(mockContext)
+
+	// The ID that could break the log lines is replaced.
+	mockID.EXPECT().Generate().Return("01J0000000000000000000001").Once()
+	mockRequest.EXPECT().Header(RequestIDHeader).Return("upstream\nforged").Once()
+	mockContext.EXPECT().WithValue(RequestIDKey, "01J0000000000000000000001").Once()
+	mockResponse.EXPECT().Header(RequestIDHeader, "01J0000000000000000000001").Return(mockResponse).Once()
+	mockRequest.EXPECT().Next().Once()
+	RequestID()(mockContext)
+}
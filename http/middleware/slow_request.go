@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sync/atomic"
+	"time"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/support"
+)
+
+// profiling A CPU profile covers the whole process, so only one request is profiled at a time.
+var profiling atomic.Bool
+
+// SlowRequest Log the requests taking longer than threshold. A CPU profile is recorded for the given share
+// (0 to 1) of the requests, and it's written to storage/framework/profiles with a heap profile if the request
+// turns out to be slow, the profiles of the other requests are discarded.
+func SlowRequest(threshold time.Duration, sampleRate float64) httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		var cpu *bytes.Buffer
+		if sampleRate > 0 && rand.Float64() < sampleRate && profiling.CompareAndSwap(false, true) {
+			cpu = new(bytes.Buffer)
+			if err := pprof.StartCPUProfile(cpu); err != nil {
+				cpu = nil
+				profiling.Store(false)
+			}
+		}
+
+		start := time.Now()
+		ctx.Request().Next()
+		duration := time.Since(start)
+
+		if cpu != nil {
+			pprof.StopCPUProfile()
+			profiling.Store(false)
+		}
+
+		if duration <= threshold {
+			return
+		}
+
+		message := fmt.Sprintf("Slow request: %s %s took %s, exceeding %s", ctx.Request().Method(), ctx.Request().Path(), duration, threshold)
+		if cpu != nil {
+			if path, err := writeProfiles(cpu); err != nil {
+				message += fmt.Sprintf(", failed to write the profiles: %v", err)
+			} else {
+				message += ", profiles: " + path
+			}
+		}
+
+		if http.LogFacade != nil {
+			http.LogFacade.Warning(message)
+		}
+	}
+}
+
+// writeProfiles Write the CPU profile and a heap profile of a slow request, it returns the prefix of their paths.
+func writeProfiles(cpu *bytes.Buffer) (string, error) {
+	dir := filepath.Join(support.RelativePath, "storage", "framework", "profiles")
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("slow-%d", time.Now().UnixNano()))
+	if err := os.WriteFile(path+"-cpu.pprof", cpu.Bytes(), 0644); err != nil {
+		return "", err
+	}
+
+	heap, err := os.Create(path + "-heap.pprof")
+	if err != nil {
+		return "", err
+	}
+	defer heap.Close()
+
+	if err := pprof.WriteHeapProfile(heap); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
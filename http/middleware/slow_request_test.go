@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/http"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	logmocks "github.com/goravel/framework/mocks/log"
+	"github.com/goravel/framework/support"
+)
+
+func TestSlowRequest(t *testing.T) {
+	support.RelativePath = t.TempDir()
+	defer func() {
+		support.RelativePath = ""
+		http.LogFacade = nil
+	}()
+
+	newContext := func(t *testing.T, sleep time.Duration) *httpmocks.Context {
+		mockContext := httpmocks.NewContext(t)
+		mockRequest := httpmocks.NewContextRequest(t)
+		mockContext.EXPECT().Request().Return(mockRequest)
+		mockRequest.EXPECT().Next().Run(func() {
+			time.Sleep(sleep)
+		}).Once()
+		mockRequest.EXPECT().Method().Return("GET").Maybe()
+		mockRequest.EXPECT().Path().Return("/users").Maybe()
+
+		return mockContext
+	}
+
+	t.Run("fast request", func(t *testing.T) {
+		mockLog := logmocks.NewLog(t)
+		http.LogFacade = mockLog
+
+		SlowRequest(time.Second, 1)(newContext(t, 0))
+	})
+
+	t.Run("slow request without profiling", func(t *testing.T) {
+		mockLog := logmocks.NewLog(t)
+		mockLog.EXPECT().Warning(mock.MatchedBy(func(message string) bool {
+			return strings.HasPrefix(message, "Slow request: GET /users took") && !strings.Contains(message, "profiles")
+		})).Once()
+		http.LogFacade = mockLog
+
+		SlowRequest(time.Millisecond, 0)(newContext(t, 10*time.Millisecond))
+	})
+
+	t.Run("slow request with profiling", func(t *testing.T) {
+		var message string
+		mockLog := logmocks.NewLog(t)
+		mockLog.EXPECT().Warning(mock.Anything).Run(func(args ...any) {
+			message = args[0].(string)
+		}).Once()
+		http.LogFacade = mockLog
+
+		SlowRequest(time.Millisecond, 1)(newContext(t, 10*time.Millisecond))
+
+		assert.Contains(t, message, ", profiles: ")
+		path := message[strings.Index(message, ", profiles: ")+len(", profiles: "):]
+		assert.Equal(t, filepath.Join(support.RelativePath, "storage", "framework", "profiles"), filepath.Dir(path))
+		assert.FileExists(t, path+"-cpu.pprof")
+		assert.FileExists(t, path+"-heap.pprof")
+
+		files, err := os.ReadDir(filepath.Dir(path))
+		assert.Nil(t, err)
+		assert.Len(t, files, 2)
+	})
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"io"
 	nethttp "net/http"
 	"strconv"
 	"strings"
@@ -559,6 +560,10 @@ func (r *TestResponse) Stream(int, func(w contractshttp.StreamWriter) error) con
 	panic("do not need to implement it")
 }
 
+func (r *TestResponse) StreamDownload(string, func(io.Writer) error) contractshttp.Response {
+	panic("do not need to implement it")
+}
+
 func (r *TestResponse) WithoutCookie(name string) contractshttp.ContextResponse {
 	panic("do not need to implement it")
 }
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	frameworkhttp "github.com/goravel/framework/http"
+)
+
+// Transform Register transformers for the response body of the route, they run before the global
+// transformers in the order they are registered.
+func Transform(transformers ...http.Transformer) http.Middleware {
+	return func(ctx http.Context) {
+		registered, _ := ctx.Value(frameworkhttp.TransformersKey).([]http.Transformer)
+		ctx.WithValue(frameworkhttp.TransformersKey, append(registered[:len(registered):len(registered)], transformers...))
+		ctx.Request().Next()
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func TestTransform(t *testing.T) {
+	first := func(ctx contractshttp.Context, code int, obj any) any {
+		return obj
+	}
+	second := func(ctx contractshttp.Context, code int, obj any) any {
+		return obj
+	}
+
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockContext.EXPECT().Request().Return(mockRequest).Once()
+	mockContext.EXPECT().Value(http.TransformersKey).Return([]contractshttp.Transformer{first}).Once()
+	mockContext.EXPECT().WithValue(http.TransformersKey, mock.MatchedBy(func(transformers []contractshttp.Transformer) bool {
+		return len(transformers) == 2
+	})).Once()
+	mockRequest.EXPECT().Next().Once()
+
+	assert.NotPanics(t, func() {
+		Transform(second)(mockContext)
+	})
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/route"
+)
+
+// ValidateSignature Serve the requests only if their URLs are signed by the URL facade and the signatures haven't
+// expired, e.g. the email verification and the unsubscribe links, the other requests are aborted with 403.
+func ValidateSignature() httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		if route.URLFacade != nil && route.URLFacade.HasValidSignature(ctx.Request().FullUrl()) {
+			ctx.Request().Next()
+			return
+		}
+
+		ctx.Request().AbortWithStatus(httpcontract.StatusForbidden)
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"testing"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	routemocks "github.com/goravel/framework/mocks/route"
+	"github.com/goravel/framework/route"
+)
+
+func TestValidateSignature(t *testing.T) {
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+
+	mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusForbidden).Once()
+	ValidateSignature()(mockContext)
+
+	mockURL := routemocks.NewURL(t)
+	route.URLFacade = mockURL
+	t.Cleanup(func() {
+		route.URLFacade = nil
+	})
+
+	mockRequest.EXPECT().FullUrl().Return("/unsubscribe?signature=1").Twice()
+	mockURL.EXPECT().HasValidSignature("/unsubscribe?signature=1").Return(true).Once()
+	mockRequest.EXPECT().Next().Once()
+	ValidateSignature()(mockContext)
+
+	mockURL.EXPECT().HasValidSignature("/unsubscribe?signature=1").Return(false).Once()
+	mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusForbidden).Once()
+	ValidateSignature()(mockContext)
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+// names The names of the middleware given by Named, keyed by the closures of the middleware.
+var names sync.Map
+
+type namedMiddleware struct {
+	// middleware Keep the closure alive, so its address isn't reused by another middleware.
+	middleware http.Middleware
+	name       string
+}
+
+// Named Name a middleware, so it can be listed in the http.middleware_priority config by the name, e.g.
+// http.Named("session", http.Terminate(&Session{})). Every middleware returned by Named is a new closure, so the
+// middleware wrapped by the same function, e.g. http.Terminate, can be told apart.
+func Named(name string, middleware http.Middleware) http.Middleware {
+	named := func(ctx http.Context) {
+		middleware(ctx)
+	}
+	names.Store(closure(named), namedMiddleware{middleware: named, name: name})
+
+	return named
+}
+
+// MiddlewareName Get the name of a middleware given by Named.
+func MiddlewareName(middleware http.Middleware) (string, bool) {
+	if middleware == nil {
+		return "", false
+	}
+
+	named, ok := names.Load(closure(middleware))
+	if !ok {
+		return "", false
+	}
+
+	return named.(namedMiddleware).name, true
+}
+
+// closure Get the address of the closure of a middleware. Unlike reflect.Value.Pointer, which returns the code of the
+// function, it's different for every closure.
+func closure(middleware http.Middleware) uintptr {
+	return *(*uintptr)(unsafe.Pointer(&middleware))
+}
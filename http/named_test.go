@@ -0,0 +1,35 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+func TestNamed(t *testing.T) {
+	var handled []string
+	audit := Named("audit", Terminate(&auditLog{name: "audit"}))
+	session := Named("session", Terminate(&auditLog{name: "session"}))
+	cors := func(ctx contractshttp.Context) {
+		handled = append(handled, "cors")
+	}
+
+	// The middleware wrapped by the same function have their own names.
+	name, ok := MiddlewareName(audit)
+	assert.True(t, ok)
+	assert.Equal(t, "audit", name)
+	name, ok = MiddlewareName(session)
+	assert.True(t, ok)
+	assert.Equal(t, "session", name)
+
+	_, ok = MiddlewareName(cors)
+	assert.False(t, ok)
+	_, ok = MiddlewareName(nil)
+	assert.False(t, ok)
+
+	// The named middleware handles the request by the wrapped one.
+	Named("cors", cors)(nil)
+	assert.Equal(t, []string{"cors"}, handled)
+}
@@ -0,0 +1,229 @@
+package http
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+// contentTypeShorthands The content types can be given by their shorthands, e.g. "json".
+var contentTypeShorthands = map[string]string{
+	"html": "text/html",
+	"json": "application/json",
+	"text": "text/plain",
+	"xml":  "application/xml",
+}
+
+// mediaRange A media range of the Accept header, e.g. "text/*;q=0.8".
+type mediaRange struct {
+	index   int
+	quality float64
+	subtype string
+	typ     string
+}
+
+// Accepts Check whether the request accepts any of the content types, e.g. "application/json" or "json". A request
+// without the Accept header accepts any content type.
+func Accepts(ctx http.Context, contentTypes ...string) bool {
+	return Prefers(ctx, contentTypes...) != ""
+}
+
+// ExpectsJson Check whether the client expects a JSON response, e.g. an AJAX request or an API client.
+func ExpectsJson(ctx http.Context) bool {
+	if ctx.Request().Header("X-Requested-With") == "XMLHttpRequest" {
+		return true
+	}
+
+	accept := ctx.Request().Header("Accept")
+
+	return strings.Contains(accept, "/json") || strings.Contains(accept, "+json")
+}
+
+// Prefers Get the content type the request prefers among the content types by the Accept header, the content types
+// of the same quality are ordered by the Accept header, then by their order. It's empty if none is accepted.
+func Prefers(ctx http.Context, contentTypes ...string) string {
+	ranges := parseAccept(ctx.Request().Header("Accept"))
+
+	var preferred string
+	var best *mediaRange
+	for _, contentType := range contentTypes {
+		matched := matchMediaRange(ranges, contentType)
+		if matched == nil || matched.quality <= 0 {
+			continue
+		}
+		if best == nil || matched.quality > best.quality || matched.quality == best.quality && matched.index < best.index {
+			preferred, best = contentType, matched
+		}
+	}
+
+	return preferred
+}
+
+// Negotiate Render the data in JSON, XML or HTML by the content type the request prefers, JSON is rendered if the
+// request prefers none of them. The HTML is rendered by the view with the data, it's rendered only if the view is
+// given, and the status of a view is 200.
+func Negotiate(ctx http.Context, code int, data any, view ...string) http.Response {
+	ctx.Response().Header("Vary", "Accept")
+
+	contentTypes := []string{"json", "xml"}
+	if len(view) > 0 && view[0] != "" {
+		contentTypes = append(contentTypes, "html")
+	}
+
+	switch Prefers(ctx, contentTypes...) {
+	case "xml":
+		body, err := marshalXML(data)
+		if err != nil {
+			if LogFacade != nil {
+				LogFacade.Error(err)
+			}
+
+			return ctx.Response().String(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		}
+
+		return ctx.Response().Data(code, "application/xml; charset=utf-8", body)
+	case "html":
+		return ctx.Response().View().Make(view[0], data)
+	default:
+		return ctx.Response().Json(code, data)
+	}
+}
+
+func parseAccept(accept string) []mediaRange {
+	if strings.TrimSpace(accept) == "" {
+		return []mediaRange{{quality: 1, subtype: "*", typ: "*"}}
+	}
+
+	var ranges []mediaRange
+	for index, item := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(strings.TrimSpace(item), ";")
+		typ, subtype, found := strings.Cut(strings.ToLower(strings.TrimSpace(mediaType)), "/")
+		if !found {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = parsed
+				}
+			}
+		}
+
+		ranges = append(ranges, mediaRange{index: index, quality: quality, subtype: subtype, typ: typ})
+	}
+
+	return ranges
+}
+
+// matchMediaRange Get the most specific media range matching the content type.
+func matchMediaRange(ranges []mediaRange, contentType string) *mediaRange {
+	if full, ok := contentTypeShorthands[contentType]; ok {
+		contentType = full
+	}
+	typ, subtype, _ := strings.Cut(strings.ToLower(contentType), "/")
+
+	var matched *mediaRange
+	specificity := -1
+	for i := range ranges {
+		r := &ranges[i]
+		current := -1
+		switch {
+		case r.typ == typ && r.subtype == subtype:
+			current = 2
+		case r.typ == typ && r.subtype == "*":
+			current = 1
+		case r.typ == "*" && r.subtype == "*":
+			current = 0
+		}
+		if current > specificity {
+			matched, specificity = r, current
+		}
+	}
+
+	return matched
+}
+
+// marshalXML Encode the data in XML, the maps and the slices are encoded as the elements of their keys and items
+// under the root element "response", the other values are encoded by encoding/xml.
+func marshalXML(data any) ([]byte, error) {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Map && value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		body, err := xml.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte(xml.Header), body...), nil
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buffer)
+	if err := encodeXML(encoder, "response", value); err != nil {
+		return nil, err
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func encodeXML(encoder *xml.Encoder, name string, value reflect.Value) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			if err := encoder.EncodeToken(start); err != nil {
+				return err
+			}
+
+			return encoder.EncodeToken(start.End())
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		if err := encoder.EncodeToken(start); err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := encodeXML(encoder, fmt.Sprint(key.Interface()), value.MapIndex(key)); err != nil {
+				return err
+			}
+		}
+
+		return encoder.EncodeToken(start.End())
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+			return encoder.EncodeElement(value.Interface(), start)
+		}
+		if err := encoder.EncodeToken(start); err != nil {
+			return err
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := encodeXML(encoder, "item", value.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return encoder.EncodeToken(start.End())
+	default:
+		return encoder.EncodeElement(value.Interface(), start)
+	}
+}
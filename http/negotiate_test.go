@@ -0,0 +1,120 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func mockAcceptContext(t *testing.T, accept string) (*httpmocks.Context, *httpmocks.ContextRequest) {
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+	mockRequest.EXPECT().Header("Accept").Return(accept)
+
+	return mockContext, mockRequest
+}
+
+func TestPrefers(t *testing.T) {
+	tests := []struct {
+		name         string
+		accept       string
+		contentTypes []string
+		expected     string
+	}{
+		{name: "without the Accept header", accept: "", contentTypes: []string{"json", "xml"}, expected: "json"},
+		{name: "any content type", accept: "*/*", contentTypes: []string{"xml", "json"}, expected: "xml"},
+		{name: "the order of the Accept header", accept: "application/xml, application/json", contentTypes: []string{"json", "xml"}, expected: "xml"},
+		{name: "the quality", accept: "application/xml;q=0.5, application/json;q=0.9", contentTypes: []string{"xml", "json"}, expected: "json"},
+		{name: "the specific range", accept: "text/*;q=0.5, text/html", contentTypes: []string{"text", "html"}, expected: "html"},
+		{name: "the full content type", accept: "text/html, application/xhtml+xml", contentTypes: []string{"application/json", "text/html"}, expected: "text/html"},
+		{name: "the refused content type", accept: "application/json;q=0, */*;q=0.1", contentTypes: []string{"json", "xml"}, expected: "xml"},
+		{name: "none is accepted", accept: "image/png", contentTypes: []string{"json", "xml"}, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockContext, _ := mockAcceptContext(t, test.accept)
+			assert.Equal(t, test.expected, Prefers(mockContext, test.contentTypes...))
+		})
+	}
+}
+
+func TestAccepts(t *testing.T) {
+	mockContext, _ := mockAcceptContext(t, "text/html, application/json;q=0.9")
+	assert.True(t, Accepts(mockContext, "json"))
+	assert.True(t, Accepts(mockContext, "xml", "text/html"))
+	assert.False(t, Accepts(mockContext, "xml"))
+}
+
+func TestExpectsJson(t *testing.T) {
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+	mockRequest.EXPECT().Header("X-Requested-With").Return("XMLHttpRequest").Once()
+	assert.True(t, ExpectsJson(mockContext))
+
+	mockRequest.EXPECT().Header("X-Requested-With").Return("").Twice()
+	mockRequest.EXPECT().Header("Accept").Return("application/vnd.api+json").Once()
+	assert.True(t, ExpectsJson(mockContext))
+	mockRequest.EXPECT().Header("Accept").Return("text/html").Once()
+	assert.False(t, ExpectsJson(mockContext))
+}
+
+func TestNegotiate(t *testing.T) {
+	data := map[string]any{"name": "Goravel", "tags": []string{"go", "web"}}
+
+	// JSON is rendered if the request prefers none of the content types.
+	mockContext, _ := mockAcceptContext(t, "image/png")
+	mockResponse := httpmocks.NewContextResponse(t)
+	mockJson := httpmocks.NewResponse(t)
+	mockContext.EXPECT().Response().Return(mockResponse)
+	mockResponse.EXPECT().Header("Vary", "Accept").Return(mockResponse).Once()
+	mockResponse.EXPECT().Json(201, data).Return(mockJson).Once()
+	assert.Equal(t, mockJson, Negotiate(mockContext, 201, data))
+
+	mockContext, _ = mockAcceptContext(t, "application/xml")
+	mockResponse = httpmocks.NewContextResponse(t)
+	mockXml := httpmocks.NewResponse(t)
+	mockContext.EXPECT().Response().Return(mockResponse)
+	mockResponse.EXPECT().Header("Vary", "Accept").Return(mockResponse).Once()
+	mockResponse.EXPECT().Data(200, "application/xml; charset=utf-8", []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<response><name>Goravel</name><tags><item>go</item><item>web</item></tags></response>`)).Return(mockXml).Once()
+	assert.Equal(t, mockXml, Negotiate(mockContext, 200, data))
+
+	// HTML is rendered only if the view is given.
+	mockContext, _ = mockAcceptContext(t, "text/html, application/json;q=0.9")
+	mockResponse = httpmocks.NewContextResponse(t)
+	mockView := httpmocks.NewResponseView(t)
+	mockHtml := httpmocks.NewResponse(t)
+	mockContext.EXPECT().Response().Return(mockResponse)
+	mockResponse.EXPECT().Header("Vary", "Accept").Return(mockResponse).Once()
+	mockResponse.EXPECT().View().Return(mockView).Once()
+	mockView.EXPECT().Make("users.show", data).Return(mockHtml).Once()
+	assert.Equal(t, mockHtml, Negotiate(mockContext, 200, data, "users.show"))
+
+	mockContext, _ = mockAcceptContext(t, "text/html, application/json;q=0.9")
+	mockResponse = httpmocks.NewContextResponse(t)
+	mockContext.EXPECT().Response().Return(mockResponse)
+	mockResponse.EXPECT().Header("Vary", "Accept").Return(mockResponse).Once()
+	mockResponse.EXPECT().Json(200, data).Return(mockJson).Once()
+	assert.Equal(t, mockJson, Negotiate(mockContext, 200, data))
+}
+
+func TestMarshalXML(t *testing.T) {
+	type user struct {
+		Name string `xml:"name"`
+	}
+
+	body, err := marshalXML(&user{Name: "Goravel"})
+	assert.Nil(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<user><name>Goravel</name></user>`, string(body))
+
+	body, err = marshalXML([]map[string]any{{"id": 1, "name": nil}})
+	assert.Nil(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<response><item><id>1</id><name></name></item></response>`, string(body))
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	"strings"
+)
+
+// MatchOrigin Check whether the origin is allowed, the patterns can contain a wildcard, e.g. "https://*.goravel.dev".
+// It's shared by the CORS middleware and the websocket handshakes, so the allowed origins are matched the same way.
+func MatchOrigin(patterns []string, origin string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" || pattern == origin {
+			return true
+		}
+
+		prefix, suffix, found := strings.Cut(pattern, "*")
+		if found && len(origin) >= len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
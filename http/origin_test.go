@@ -0,0 +1,17 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchOrigin(t *testing.T) {
+	assert.True(t, MatchOrigin([]string{"*"}, "https://goravel.dev"))
+	assert.True(t, MatchOrigin([]string{"https://goravel.dev"}, "https://goravel.dev"))
+	assert.True(t, MatchOrigin([]string{"https://*.goravel.dev"}, "https://www.goravel.dev"))
+	assert.True(t, MatchOrigin([]string{"http://localhost:*"}, "http://localhost:3000"))
+	assert.False(t, MatchOrigin([]string{"https://*.goravel.dev"}, "https://goravel.dev"))
+	assert.False(t, MatchOrigin([]string{"https://*.goravel.dev"}, "https://goravel.dev.com"))
+	assert.False(t, MatchOrigin(nil, "https://goravel.dev"))
+}
@@ -1,6 +1,9 @@
 package http
 
 import (
+	"errors"
+	"time"
+
 	"github.com/goravel/framework/contracts/http"
 )
 
@@ -27,3 +30,110 @@ func (r *RateLimiter) ForWithLimits(name string, callback func(ctx http.Context)
 func (r *RateLimiter) Limiter(name string) func(ctx http.Context) []http.Limit {
 	return r.limiters[name]
 }
+
+// Attempt Execute the callback and hit the key if the key hasn't reached the max attempts, the key is hit first and
+// the attempts returned by the hit decide, so the concurrent attempts can't exceed the max attempts.
+func (r *RateLimiter) Attempt(key string, maxAttempts int, callback func() error, decay ...time.Duration) (bool, error) {
+	hits, err := r.hit(key, decay...)
+	if err != nil {
+		return false, err
+	}
+	if hits > maxAttempts {
+		return false, nil
+	}
+
+	return true, callback()
+}
+
+// Attempts Get the number of the attempts of the key, the attempts of an elapsed decay are 0.
+func (r *RateLimiter) Attempts(key string) int {
+	if CacheFacade == nil || !CacheFacade.Has(r.timerKey(key)) {
+		return 0
+	}
+
+	return CacheFacade.GetInt(r.key(key))
+}
+
+// AvailableIn Get the duration until the attempts of the key are reset.
+func (r *RateLimiter) AvailableIn(key string) time.Duration {
+	if CacheFacade == nil {
+		return 0
+	}
+
+	availableAt := CacheFacade.GetInt64(r.timerKey(key))
+	if availableIn := time.Until(time.UnixMilli(availableAt)); availableIn > 0 {
+		return availableIn
+	}
+
+	return 0
+}
+
+// Clear Clear the attempts of the key.
+func (r *RateLimiter) Clear(key string) {
+	if CacheFacade == nil {
+		return
+	}
+
+	CacheFacade.Forget(r.key(key))
+	CacheFacade.Forget(r.timerKey(key))
+}
+
+// Hit Increment the attempts of the key, the attempts are reset when the first hit after the decay starts a new one.
+func (r *RateLimiter) Hit(key string, decay ...time.Duration) int {
+	hits, err := r.hit(key, decay...)
+	if err != nil {
+		return 0
+	}
+
+	return hits
+}
+
+// Remaining Get the number of the remaining attempts of the key.
+func (r *RateLimiter) Remaining(key string, maxAttempts int) int {
+	if remaining := maxAttempts - r.Attempts(key); remaining > 0 {
+		return remaining
+	}
+
+	return 0
+}
+
+// TooManyAttempts Determine whether the key has reached the max attempts.
+func (r *RateLimiter) TooManyAttempts(key string, maxAttempts int) bool {
+	return r.Attempts(key) >= maxAttempts
+}
+
+func (r *RateLimiter) hit(key string, decay ...time.Duration) (int, error) {
+	if CacheFacade == nil {
+		return 0, errors.New("cache support is required by the rate limiter")
+	}
+
+	duration := time.Minute
+	if len(decay) > 0 {
+		duration = decay[0]
+	}
+
+	// The attempts are reset when a new decay starts, and they expire with the decay, so the counter of a key that
+	// isn't hit anymore doesn't stay in the cache.
+	if CacheFacade.Add(r.timerKey(key), time.Now().Add(duration).UnixMilli(), duration) {
+		if err := CacheFacade.Put(r.key(key), 0, duration); err != nil {
+			return 0, err
+		}
+	} else {
+		CacheFacade.Add(r.key(key), 0, duration)
+	}
+
+	hits, err := CacheFacade.Increment(r.key(key))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(hits), nil
+}
+
+func (r *RateLimiter) key(key string) string {
+	return "rate_limiter:" + key
+}
+
+func (r *RateLimiter) timerKey(key string) string {
+	return "rate_limiter:" + key + ":timer"
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/cache"
+	configmocks "github.com/goravel/framework/mocks/config"
+	logmocks "github.com/goravel/framework/mocks/log"
+)
+
+func TestRateLimiter(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetString("cache.stores.memory.driver").Return("memory").Once()
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel_cache").Once()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.sweep_interval", 60).Return(60).Once()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.max_entries", 0).Return(0).Once()
+	mockConfig.EXPECT().GetInt("cache.stores.memory.max_bytes", 0).Return(0).Once()
+	mockConfig.EXPECT().GetBool("cache.stores.memory.fallback").Return(false).Once()
+
+	var err error
+	CacheFacade, err = cache.NewApplication(mockConfig, logmocks.NewLog(t), "memory")
+	assert.Nil(t, err)
+	defer func() {
+		CacheFacade = nil
+	}()
+
+	rateLimiter := NewRateLimiter()
+	assert.Equal(t, 0, rateLimiter.Attempts("login"))
+	assert.Equal(t, time.Duration(0), rateLimiter.AvailableIn("login"))
+
+	assert.Equal(t, 1, rateLimiter.Hit("login", 200*time.Millisecond))
+	assert.Equal(t, 2, rateLimiter.Hit("login", 200*time.Millisecond))
+	assert.Equal(t, 2, rateLimiter.Attempts("login"))
+	assert.Equal(t, 1, rateLimiter.Remaining("login", 3))
+	assert.False(t, rateLimiter.TooManyAttempts("login", 3))
+	assert.True(t, rateLimiter.TooManyAttempts("login", 2))
+	assert.Equal(t, 0, rateLimiter.Remaining("login", 2))
+	assert.True(t, rateLimiter.AvailableIn("login") > 0)
+
+	// The attempts are reset once the decay elapses, and the counter expires with it.
+	time.Sleep(300 * time.Millisecond)
+	assert.False(t, CacheFacade.Has("rate_limiter:login"))
+	assert.Equal(t, 0, rateLimiter.Attempts("login"))
+	assert.False(t, rateLimiter.TooManyAttempts("login", 2))
+	assert.Equal(t, 1, rateLimiter.Hit("login"))
+
+	rateLimiter.Clear("login")
+	assert.Equal(t, 0, rateLimiter.Attempts("login"))
+
+	var calls int
+	callback := func() error {
+		calls++
+
+		return nil
+	}
+	executed, err := rateLimiter.Attempt("webhook", 2, callback)
+	assert.True(t, executed)
+	assert.Nil(t, err)
+	executed, err = rateLimiter.Attempt("webhook", 2, callback)
+	assert.True(t, executed)
+	assert.Nil(t, err)
+	executed, err = rateLimiter.Attempt("webhook", 2, callback)
+	assert.False(t, executed)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+
+	executed, err = rateLimiter.Attempt("api", 1, func() error {
+		return errors.New("error")
+	})
+	assert.True(t, executed)
+	assert.EqualError(t, err, "error")
+
+	// The concurrent attempts don't exceed the max attempts.
+	var executions atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if executed, _ := rateLimiter.Attempt("concurrent", 5, func() error { return nil }); executed {
+				executions.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(5), executions.Load())
+}
+
+func TestRateLimiter_WithoutCache(t *testing.T) {
+	rateLimiter := NewRateLimiter()
+	executed, err := rateLimiter.Attempt("webhook", 2, func() error {
+		return nil
+	})
+	assert.False(t, executed)
+	assert.EqualError(t, err, "cache support is required by the rate limiter")
+	assert.Equal(t, 0, rateLimiter.Hit("webhook"))
+	assert.Equal(t, 0, rateLimiter.Attempts("webhook"))
+	assert.False(t, rateLimiter.TooManyAttempts("webhook", 2))
+}
@@ -0,0 +1,50 @@
+package requestid
+
+import (
+	"context"
+)
+
+const (
+	// Header The header carrying the ID of a request.
+	Header = "X-Request-Id"
+	// Key The context key of the ID of a request.
+	Key = "goravel_request_id"
+	// maxLength The max length of the ID given by the client, a longer ID is replaced.
+	maxLength = 128
+)
+
+// FromContext Get the ID of the request from the context, it's set by the RequestID middleware and is empty if the
+// context isn't derived from a request. The logger, the ORM query log and the HTTP client read it by this function.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	id, _ := ctx.Value(Key).(string)
+
+	return id
+}
+
+// WithContext Get a copy of the context carrying the ID, e.g. to pass the ID to a job or a goroutine.
+func WithContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, Key, id)
+}
+
+// Valid Check whether the ID given by the client can be kept, it can only contain letters, digits and "-_.:", so
+// it can't break the log lines and the headers.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
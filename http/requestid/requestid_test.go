@@ -0,0 +1,26 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	assert.Empty(t, FromContext(nil))
+	assert.Empty(t, FromContext(context.Background()))
+	assert.Equal(t, "01J0000000000000000000000", FromContext(WithContext(context.Background(), "01J0000000000000000000000")))
+	assert.Equal(t, "upstream", FromContext(context.WithValue(context.Background(), Key, "upstream")))
+}
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid("01J0000000000000000000000"))
+	assert.True(t, Valid("a3f2c1d0-2b4e-4c1a-9f3e-1d2c3b4a5f60"))
+	assert.True(t, Valid("trace:span_1.2"))
+	assert.False(t, Valid(""))
+	assert.False(t, Valid("upstream\nforged"))
+	assert.False(t, Valid("upstream id"))
+	assert.False(t, Valid(strings.Repeat("a", 129)))
+}
@@ -0,0 +1,176 @@
+package http
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+// missingValue The value of a field whose condition isn't met, the field is removed from the resource.
+type missingValue struct{}
+
+// Pagination The items of a page and the pagination metadata, e.g. the result of orm.Query.Paginate.
+type Pagination struct {
+	Items any
+	Page  int
+	Limit int
+	Total int64
+}
+
+func Paginate(items any, page, limit int, total int64) *Pagination {
+	return &Pagination{Items: items, Page: page, Limit: limit, Total: total}
+}
+
+// Meta Get the pagination metadata of the response.
+func (r *Pagination) Meta() map[string]any {
+	lastPage := 1
+	if r.Limit > 0 && r.Total > 0 {
+		lastPage = int(math.Ceil(float64(r.Total) / float64(r.Limit)))
+	}
+
+	var from, to int64
+	if count := reflectLen(r.Items); count > 0 {
+		from = int64((r.Page-1)*r.Limit) + 1
+		to = from + int64(count) - 1
+	}
+
+	return map[string]any{
+		"current_page": r.Page,
+		"from":         from,
+		"last_page":    lastPage,
+		"per_page":     r.Limit,
+		"to":           to,
+		"total":        r.Total,
+	}
+}
+
+// Collection Map the models to their resources.
+func Collection[T any](items []T, resource func(item T) http.Resource) []http.Resource {
+	resources := make([]http.Resource, len(items))
+	for i, item := range items {
+		resources[i] = resource(item)
+	}
+
+	return resources
+}
+
+// When Get the value of a field only if the condition is true, the field is removed otherwise unless a default
+// value is given. The value can be a function, so it's evaluated only if needed.
+func When(condition bool, value any, def ...any) any {
+	if condition {
+		return evaluate(value)
+	}
+	if len(def) > 0 {
+		return evaluate(def[0])
+	}
+
+	return missingValue{}
+}
+
+// WhenLoaded Get the value of a field only if the relation is loaded, e.g. preloaded by orm.Query.With, the
+// relation is the value if the value isn't given. A relation is considered loaded if it isn't nil.
+func WhenLoaded(relation any, value ...any) any {
+	if isNil(relation) {
+		return missingValue{}
+	}
+	if len(value) > 0 {
+		return evaluate(value[0])
+	}
+
+	return relation
+}
+
+// ResourceResponse Transform the resource, the collection or the pagination to a JSON response wrapped in "data",
+// the pagination metadata is added to "meta".
+func ResourceResponse(ctx http.Context, value any, code ...int) http.Response {
+	status := http.StatusOK
+	if len(code) > 0 {
+		status = code[0]
+	}
+
+	body := http.Json{}
+	if pagination, ok := value.(*Pagination); ok {
+		body["data"] = ToResource(ctx, pagination.Items)
+		body["meta"] = pagination.Meta()
+	} else {
+		body["data"] = ToResource(ctx, value)
+	}
+
+	return ctx.Response().Json(status, body)
+}
+
+// ToResource Transform the resources in the value recursively, the fields whose conditions aren't met are removed.
+func ToResource(ctx http.Context, value any) any {
+	switch value := value.(type) {
+	case nil:
+		return nil
+	case http.Resource:
+		if isNil(value) {
+			return nil
+		}
+
+		return ToResource(ctx, value.ToArray(ctx))
+	case *Pagination:
+		return map[string]any{
+			"data": ToResource(ctx, value.Items),
+			"meta": value.Meta(),
+		}
+	case map[string]any:
+		fields := make(map[string]any, len(value))
+		for key, field := range value {
+			if _, missing := field.(missingValue); missing {
+				continue
+			}
+			fields[key] = ToResource(ctx, field)
+		}
+
+		return fields
+	case []http.Resource:
+		items := make([]any, len(value))
+		for i, item := range value {
+			items[i] = ToResource(ctx, item)
+		}
+
+		return items
+	case []any:
+		items := make([]any, len(value))
+		for i, item := range value {
+			items[i] = ToResource(ctx, item)
+		}
+
+		return items
+	}
+
+	return value
+}
+
+func evaluate(value any) any {
+	if callback, ok := value.(func() any); ok {
+		return callback()
+	}
+
+	return value
+}
+
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	switch reflected := reflect.ValueOf(value); reflected.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return reflected.IsNil()
+	}
+
+	return false
+}
+
+func reflectLen(value any) int {
+	switch reflected := reflect.ValueOf(value); reflected.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice:
+		return reflected.Len()
+	}
+
+	return 0
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+type Post struct {
+	ID    uint
+	Title string
+}
+
+type User struct {
+	ID    uint
+	Name  string
+	Email string
+	Posts []*Post
+}
+
+type PostResource struct {
+	*Post
+}
+
+func (r *PostResource) ToArray(ctx contractshttp.Context) map[string]any {
+	return map[string]any{
+		"id":    r.ID,
+		"title": r.Title,
+	}
+}
+
+type UserResource struct {
+	*User
+	admin bool
+}
+
+func (r *UserResource) ToArray(ctx contractshttp.Context) map[string]any {
+	return map[string]any{
+		"id":    r.ID,
+		"name":  r.Name,
+		"email": When(r.admin, r.Email),
+		"role":  When(r.admin, "admin", "user"),
+		"posts": WhenLoaded(r.Posts, func() any {
+			return Collection(r.Posts, func(post *Post) contractshttp.Resource {
+				return &PostResource{post}
+			})
+		}),
+	}
+}
+
+func TestToResource(t *testing.T) {
+	ctx := Background()
+	user := &User{ID: 1, Name: "Goravel", Email: "hello@goravel.dev"}
+
+	assert.Equal(t, map[string]any{
+		"id":   uint(1),
+		"name": "Goravel",
+		"role": "user",
+	}, ToResource(ctx, &UserResource{User: user}))
+
+	user.Posts = []*Post{{ID: 1, Title: "Hello"}}
+	assert.Equal(t, map[string]any{
+		"id":    uint(1),
+		"name":  "Goravel",
+		"email": "hello@goravel.dev",
+		"role":  "admin",
+		"posts": []any{
+			map[string]any{"id": uint(1), "title": "Hello"},
+		},
+	}, ToResource(ctx, &UserResource{User: user, admin: true}))
+
+	var resource *UserResource
+	assert.Nil(t, ToResource(ctx, resource))
+	assert.Equal(t, "Goravel", ToResource(ctx, "Goravel"))
+}
+
+func TestPagination(t *testing.T) {
+	posts := []*Post{{ID: 11, Title: "Hello"}, {ID: 12, Title: "World"}}
+	pagination := Paginate(Collection(posts, func(post *Post) contractshttp.Resource {
+		return &PostResource{post}
+	}), 2, 10, 12)
+
+	assert.Equal(t, map[string]any{
+		"current_page": 2,
+		"from":         int64(11),
+		"last_page":    2,
+		"per_page":     10,
+		"to":           int64(12),
+		"total":        int64(12),
+	}, pagination.Meta())
+	assert.Equal(t, map[string]any{
+		"current_page": 3,
+		"from":         int64(0),
+		"last_page":    1,
+		"per_page":     10,
+		"to":           int64(0),
+		"total":        int64(0),
+	}, Paginate([]*Post{}, 3, 10, 0).Meta())
+
+	mockContext := httpmocks.NewContext(t)
+	mockResponse := httpmocks.NewContextResponse(t)
+	mockResult := httpmocks.NewResponse(t)
+	mockContext.EXPECT().Response().Return(mockResponse).Once()
+	mockResponse.EXPECT().Json(contractshttp.StatusOK, contractshttp.Json{
+		"data": []any{
+			map[string]any{"id": uint(11), "title": "Hello"},
+			map[string]any{"id": uint(12), "title": "World"},
+		},
+		"meta": pagination.Meta(),
+	}).Return(mockResult).Once()
+
+	assert.Equal(t, mockResult, ResourceResponse(mockContext, pagination))
+}
+
+func TestWhen(t *testing.T) {
+	assert.Equal(t, "a", When(true, "a"))
+	assert.Equal(t, "b", When(true, func() any { return "b" }))
+	assert.Equal(t, missingValue{}, When(false, "a"))
+	assert.Equal(t, "c", When(false, "a", "c"))
+
+	var posts []*Post
+	assert.Equal(t, missingValue{}, WhenLoaded(posts))
+	assert.Equal(t, []*Post{}, WhenLoaded([]*Post{}))
+	assert.Equal(t, 0, WhenLoaded([]*Post{}, func() any { return 0 }))
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"github.com/goravel/framework/contracts/http"
+)
+
+// TransformersKey The context key of the transformers registered by the route middleware.
+const TransformersKey = "goravel_response_transformers"
+
+type ResponseTransformer struct {
+	transformers []http.Transformer
+}
+
+func NewResponseTransformer() *ResponseTransformer {
+	return &ResponseTransformer{}
+}
+
+func (r *ResponseTransformer) Register(transformers ...http.Transformer) {
+	r.transformers = append(r.transformers, transformers...)
+}
+
+// Transform The transformers of the route run first so the global transformers, e.g. an envelope,
+// receive the body that is already shaped by the route.
+func (r *ResponseTransformer) Transform(ctx http.Context, code int, obj any) any {
+	if ctx != nil {
+		if transformers, ok := ctx.Value(TransformersKey).([]http.Transformer); ok {
+			for _, transformer := range transformers {
+				obj = transformer(ctx, code, obj)
+			}
+		}
+	}
+
+	for _, transformer := range r.transformers {
+		obj = transformer(ctx, code, obj)
+	}
+
+	return obj
+}
@@ -0,0 +1,33 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+func TestResponseTransformer(t *testing.T) {
+	envelope := func(ctx http.Context, code int, obj any) any {
+		return http.Json{"code": code, "data": obj}
+	}
+	redact := func(ctx http.Context, code int, obj any) any {
+		if data, ok := obj.(http.Json); ok {
+			delete(data, "password")
+		}
+
+		return obj
+	}
+
+	transformer := NewResponseTransformer()
+	ctx := NewContext()
+	assert.Equal(t, http.Json{"name": "goravel"}, transformer.Transform(ctx, 200, http.Json{"name": "goravel"}))
+
+	transformer.Register(envelope)
+	assert.Equal(t, http.Json{"code": 200, "data": http.Json{"name": "goravel"}}, transformer.Transform(ctx, 200, http.Json{"name": "goravel"}))
+
+	ctx.WithValue(TransformersKey, []http.Transformer{redact})
+	assert.Equal(t, http.Json{"code": 201, "data": http.Json{"name": "goravel"}}, transformer.Transform(ctx, 201, http.Json{"name": "goravel", "password": "secret"}))
+	assert.Equal(t, http.Json{"code": 200, "data": nil}, transformer.Transform(nil, 200, nil))
+}
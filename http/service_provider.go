@@ -1,34 +1,52 @@
 package http
 
 import (
+	"github.com/goravel/framework/contracts/auth"
 	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/config"
 	consolecontract "github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/foundation"
 	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/log"
+	"github.com/goravel/framework/http/client"
 	"github.com/goravel/framework/http/console"
 )
 
+const BindingHttp = "goravel.http"
 const BindingRateLimiter = "goravel.rate_limiter"
+const BindingResponseTransformer = "goravel.response_transformer"
 const BindingView = "goravel.view"
 
 type ServiceProvider struct{}
 
 var (
+	AuthFacade        func(ctx http.Context) auth.Auth
 	CacheFacade       cache.Cache
+	ConfigFacade      config.Config
+	LogFacade         log.Log
 	RateLimiterFacade http.RateLimiter
 )
 
 func (http *ServiceProvider) Register(app foundation.Application) {
+	app.Singleton(BindingHttp, func(app foundation.Application) (any, error) {
+		return client.NewFactory(app.MakeConfig(), app.GetJson()), nil
+	})
 	app.Singleton(BindingRateLimiter, func(app foundation.Application) (any, error) {
 		return NewRateLimiter(), nil
 	})
+	app.Singleton(BindingResponseTransformer, func(app foundation.Application) (any, error) {
+		return NewResponseTransformer(), nil
+	})
 	app.Singleton(BindingView, func(app foundation.Application) (any, error) {
 		return NewView(), nil
 	})
 }
 
 func (http *ServiceProvider) Boot(app foundation.Application) {
+	AuthFacade = app.MakeAuth
 	CacheFacade = app.MakeCache()
+	ConfigFacade = app.MakeConfig()
+	LogFacade = app.MakeLog()
 	RateLimiterFacade = app.MakeRateLimiter()
 
 	http.registerCommands(app)
@@ -39,5 +57,8 @@ func (http *ServiceProvider) registerCommands(app foundation.Application) {
 		&console.RequestMakeCommand{},
 		&console.ControllerMakeCommand{},
 		&console.MiddlewareMakeCommand{},
+		console.NewViewCacheCommand(ViewPath, ViewCacheFile()),
+		console.NewViewClearCommand(ViewCacheFile()),
 	})
+	app.Optimizes("view:cache", "view:clear")
 }
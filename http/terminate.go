@@ -0,0 +1,164 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"sync"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/support/color"
+)
+
+// TerminatorsKey The context key of the terminatable middleware of a request.
+const TerminatorsKey = "goravel_terminators"
+
+// terminating The Terminate hooks running in the background, the server waits for them when it shuts down.
+var terminating sync.WaitGroup
+
+type terminators struct {
+	middlewares []http.Terminatable
+	mu          sync.Mutex
+}
+
+// Terminate Get a middleware handling the request by the terminatable middleware, whose Terminate runs in the
+// background once the request is handled by the Terminator. It runs once the middleware returns if the Terminator
+// isn't registered.
+func Terminate(middleware http.Terminatable) http.Middleware {
+	return func(ctx http.Context) {
+		registered, ok := ctx.Value(TerminatorsKey).(*terminators)
+		if !ok {
+			middleware.Handle(ctx)
+			terminate(detach(ctx), middleware)
+
+			return
+		}
+
+		registered.mu.Lock()
+		registered.middlewares = append(registered.middlewares, middleware)
+		registered.mu.Unlock()
+
+		middleware.Handle(ctx)
+	}
+}
+
+// Terminator Run Terminate of the terminatable middleware in the order they are registered, once the request is
+// handled. The hooks run in the background on a copy of the request, so the client doesn't wait for them. It's
+// registered as the first global middleware by the route, a nested Terminator leaves the hooks to the first one.
+func Terminator() http.Middleware {
+	return func(ctx http.Context) {
+		if _, ok := ctx.Value(TerminatorsKey).(*terminators); ok {
+			ctx.Request().Next()
+
+			return
+		}
+
+		registered := &terminators{}
+		ctx.WithValue(TerminatorsKey, registered)
+
+		ctx.Request().Next()
+
+		registered.mu.Lock()
+		middlewares := registered.middlewares
+		registered.mu.Unlock()
+		if len(middlewares) == 0 {
+			return
+		}
+
+		detached := detach(ctx)
+		terminating.Add(1)
+		go func() {
+			defer terminating.Done()
+
+			for _, middleware := range middlewares {
+				terminate(detached, middleware)
+			}
+		}()
+	}
+}
+
+// WaitTerminating Wait for the Terminate hooks running in the background until the context is done.
+func WaitTerminating(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		terminating.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// detached The copy of a handled request, it stays valid once the context of the driver is reused by another
+// request.
+type detached struct {
+	ctx      context.Context
+	request  *nethttp.Request
+	response http.ResponseOrigin
+}
+
+func detach(ctx http.Context) detached {
+	detachedCtx := context.WithoutCancel(ctx.Context())
+
+	var request *nethttp.Request
+	if origin := ctx.Request().Origin(); origin != nil {
+		request = origin.Clone(detachedCtx)
+	}
+
+	response := &terminatedResponse{header: make(nethttp.Header), body: new(bytes.Buffer)}
+	if origin := ctx.Response().Origin(); origin != nil {
+		response.header = origin.Header().Clone()
+		response.size = origin.Size()
+		response.status = origin.Status()
+		if body := origin.Body(); body != nil {
+			response.body = bytes.NewBuffer(bytes.Clone(body.Bytes()))
+		}
+	}
+
+	return detached{ctx: detachedCtx, request: request, response: response}
+}
+
+// terminate Run Terminate of a middleware, a panic is logged so it can't stop the others.
+func terminate(detached detached, middleware http.Terminatable) {
+	defer func() {
+		if r := recover(); r != nil {
+			message := fmt.Sprintf("Terminate of %T panicked: %v", middleware, r)
+			if LogFacade != nil {
+				LogFacade.Error(message)
+			} else {
+				color.Red().Println(message)
+			}
+		}
+	}()
+
+	middleware.Terminate(detached.ctx, detached.request, detached.response)
+}
+
+// terminatedResponse The copy of the response of a handled request.
+type terminatedResponse struct {
+	body   *bytes.Buffer
+	header nethttp.Header
+	size   int
+	status int
+}
+
+func (r *terminatedResponse) Body() *bytes.Buffer {
+	return r.body
+}
+
+func (r *terminatedResponse) Header() nethttp.Header {
+	return r.header
+}
+
+func (r *terminatedResponse) Size() int {
+	return r.size
+}
+
+func (r *terminatedResponse) Status() int {
+	return r.status
+}
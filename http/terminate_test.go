@@ -0,0 +1,100 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	logmocks "github.com/goravel/framework/mocks/log"
+)
+
+type auditLog struct {
+	events *[]string
+	mu     *sync.Mutex
+	name   string
+	panics bool
+}
+
+func (r *auditLog) Handle(ctx contractshttp.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	*r.events = append(*r.events, "handle "+r.name)
+}
+
+func (r *auditLog) Terminate(ctx context.Context, request *http.Request, response contractshttp.ResponseOrigin) {
+	if r.panics {
+		panic("boom")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	*r.events = append(*r.events, fmt.Sprintf("terminate %s %s %d %s %v", r.name, request.URL.Path, response.Status(), response.Body(), ctx.Err()))
+}
+
+func TestTerminate(t *testing.T) {
+	var events []string
+	var mu sync.Mutex
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockResponse := httpmocks.NewContextResponse(t)
+	mockOrigin := httpmocks.NewResponseOrigin(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+	mockContext.EXPECT().Response().Return(mockResponse)
+	requestCtx, cancel := context.WithCancel(context.Background())
+	mockContext.EXPECT().Context().Return(requestCtx)
+	mockRequest.EXPECT().Origin().Return(httptest.NewRequest("GET", "/users", nil))
+	mockResponse.EXPECT().Origin().Return(mockOrigin)
+	mockOrigin.EXPECT().Header().Return(http.Header{})
+	mockOrigin.EXPECT().Size().Return(2)
+	mockOrigin.EXPECT().Status().Return(200)
+	mockOrigin.EXPECT().Body().Return(bytes.NewBufferString("ok"))
+
+	// Terminate runs once the middleware returns if the Terminator isn't registered.
+	mockContext.EXPECT().Value(TerminatorsKey).Return(nil).Once()
+	Terminate(&auditLog{events: &events, mu: &mu, name: "audit"})(mockContext)
+	assert.Equal(t, []string{"handle audit", "terminate audit /users 200 ok <nil>"}, events)
+
+	events = nil
+	var registered any
+	mockContext.EXPECT().WithValue(TerminatorsKey, mock.Anything).Run(func(key string, value any) {
+		registered = value
+	}).Once()
+	mockContext.EXPECT().Value(TerminatorsKey).RunAndReturn(func(key any) any {
+		return registered
+	}).Times(5)
+	mockRequest.EXPECT().Next().Run(func() {
+		// A nested Terminator leaves the hooks to the first one.
+		Terminator()(mockContext)
+	}).Once()
+	mockRequest.EXPECT().Next().Run(func() {
+		Terminate(&auditLog{events: &events, mu: &mu, name: "session"})(mockContext)
+		Terminate(&auditLog{events: &events, mu: &mu, name: "broken", panics: true})(mockContext)
+		Terminate(&auditLog{events: &events, mu: &mu, name: "audit"})(mockContext)
+		events = append(events, "respond")
+	}).Once()
+
+	mockLog := logmocks.NewLog(t)
+	LogFacade = mockLog
+	t.Cleanup(func() {
+		LogFacade = nil
+	})
+	mockLog.EXPECT().Error("Terminate of *http.auditLog panicked: boom").Once()
+
+	// The hooks run in the background once the request is handled, on a copy not canceled with the request, a panic
+	// can't stop the others.
+	Terminator()(mockContext)
+	cancel()
+	assert.Nil(t, WaitTerminating(context.Background()))
+	assert.Equal(t, []string{"handle session", "handle broken", "handle audit", "respond", "terminate session /users 200 ok <nil>", "terminate audit /users 200 ok <nil>"}, events)
+}
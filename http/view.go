@@ -1,21 +1,41 @@
 package http
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"sync"
 
+	"github.com/goravel/framework/support"
 	"github.com/goravel/framework/support/file"
 )
 
+// ViewPath The directory containing the views.
+const ViewPath = "resources/views"
+
+// ViewCacheFile Get the path of the view cache file written by the "view:cache" command, the views are checked by the
+// file instead of the file system while it exists.
+func ViewCacheFile() string {
+	return filepath.Join(support.RelativePath, "storage", "framework", "views.json")
+}
+
 type View struct {
-	shared sync.Map
+	shared    sync.Map
+	cacheFile string
+	cacheOnce sync.Once
+	cache     map[string]bool
 }
 
 func NewView() *View {
-	return &View{}
+	return &View{cacheFile: ViewCacheFile()}
 }
 
 func (r *View) Exists(view string) bool {
-	return file.Exists("resources/views/" + view)
+	if cache := r.cached(); cache != nil {
+		return cache[view]
+	}
+
+	return file.Exists(ViewPath + "/" + view)
 }
 
 func (r *View) Share(key string, value any) {
@@ -44,3 +64,25 @@ func (r *View) GetShared() map[string]any {
 
 	return shared
 }
+
+// cached Get the views of the view cache file, the file is read once, it's nil if the views aren't cached.
+func (r *View) cached() map[string]bool {
+	r.cacheOnce.Do(func() {
+		content, err := os.ReadFile(r.cacheFile)
+		if err != nil {
+			return
+		}
+
+		var views []string
+		if err := json.Unmarshal(content, &views); err != nil {
+			return
+		}
+
+		r.cache = make(map[string]bool, len(views))
+		for _, view := range views {
+			r.cache[view] = true
+		}
+	})
+
+	return r.cache
+}
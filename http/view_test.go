@@ -1,9 +1,12 @@
 package http
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/support/file"
 )
 
 func TestView(t *testing.T) {
@@ -13,3 +16,13 @@ func TestView(t *testing.T) {
 	assert.Equal(t, "c", view.Shared("b", "c"))
 	assert.Equal(t, map[string]any{"a": "b"}, view.GetShared())
 }
+
+func TestView_ExistsCached(t *testing.T) {
+	view := NewView()
+	view.cacheFile = filepath.Join(t.TempDir(), "views.json")
+	assert.Nil(t, file.Create(view.cacheFile, `["users/index.tmpl","welcome.tmpl"]`))
+
+	assert.True(t, view.Exists("welcome.tmpl"))
+	assert.True(t, view.Exists("users/index.tmpl"))
+	assert.False(t, view.Exists("missing.tmpl"))
+}
@@ -0,0 +1,33 @@
+package http
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/support/color"
+)
+
+// unsupportedWriters The middleware reported to not work with the response of the http driver, each is reported once.
+var unsupportedWriters sync.Map
+
+// WriterSetter Get the response of the context as http.ResponseWriterSetter, the middleware replacing the writer of
+// the response can't work if the response of the http driver doesn't implement it, so an error is reported the first
+// time the middleware runs, instead of the middleware doing nothing silently.
+func WriterSetter(ctx http.Context, middleware string) (http.ResponseWriterSetter, bool) {
+	setter, ok := ctx.Response().(http.ResponseWriterSetter)
+	if ok {
+		return setter, true
+	}
+
+	if _, reported := unsupportedWriters.LoadOrStore(middleware, true); !reported {
+		message := fmt.Sprintf("the %s middleware doesn't work, the response of the http driver doesn't implement http.ResponseWriterSetter, please upgrade the driver", middleware)
+		if LogFacade != nil {
+			LogFacade.Error(message)
+		} else {
+			color.Red().Println(message)
+		}
+	}
+
+	return nil, false
+}
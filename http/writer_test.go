@@ -0,0 +1,40 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	httpmocks "github.com/goravel/framework/mocks/http"
+	logmocks "github.com/goravel/framework/mocks/log"
+)
+
+func TestWriterSetter(t *testing.T) {
+	mockLog := logmocks.NewLog(t)
+	LogFacade = mockLog
+	defer func() {
+		LogFacade = nil
+	}()
+
+	// The response of the driver can't replace its writer, the error is reported once.
+	mockContext := httpmocks.NewContext(t)
+	mockContext.EXPECT().Response().Return(httpmocks.NewContextResponse(t)).Twice()
+	mockLog.EXPECT().Error("the Test middleware doesn't work, the response of the http driver doesn't implement http.ResponseWriterSetter, please upgrade the driver").Once()
+
+	setter, ok := WriterSetter(mockContext, "Test")
+	assert.False(t, ok)
+	assert.Nil(t, setter)
+	_, ok = WriterSetter(mockContext, "Test")
+	assert.False(t, ok)
+
+	response := &struct {
+		*httpmocks.ContextResponse
+		*httpmocks.ResponseWriterSetter
+	}{httpmocks.NewContextResponse(t), httpmocks.NewResponseWriterSetter(t)}
+	mockContext = httpmocks.NewContext(t)
+	mockContext.EXPECT().Response().Return(response).Once()
+
+	setter, ok = WriterSetter(mockContext, "Test")
+	assert.True(t, ok)
+	assert.Equal(t, response, setter)
+}
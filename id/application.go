@@ -0,0 +1,105 @@
+package id
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/goravel/framework/contracts/config"
+	contractsid "github.com/goravel/framework/contracts/id"
+	"github.com/goravel/framework/contracts/log"
+)
+
+const (
+	DriverUuid      = "uuid"
+	DriverUlid      = "ulid"
+	DriverSnowflake = "snowflake"
+	DriverCustom    = "custom"
+)
+
+// snowflakeEpoch The default epoch of the snowflake IDs, 2024-01-01T00:00:00Z.
+var snowflakeEpoch = time.UnixMilli(1704067200000)
+
+type Application struct {
+	contractsid.Generator
+	config     config.Config
+	log        log.Log
+	generators map[string]contractsid.Generator
+	mu         sync.Mutex
+}
+
+func NewApplication(config config.Config, log log.Log) (*Application, error) {
+	app := &Application{
+		config:     config,
+		log:        log,
+		generators: make(map[string]contractsid.Generator),
+	}
+
+	driver := config.GetString("id.driver", DriverUuid)
+	generator, err := app.newGenerator(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	app.Generator = generator
+	app.generators[driver] = generator
+
+	return app, nil
+}
+
+func (app *Application) Driver(name string) contractsid.Generator {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	if generator, exist := app.generators[name]; exist {
+		return generator
+	}
+
+	generator, err := app.newGenerator(name)
+	if err != nil {
+		app.log.Error(err)
+
+		return nil
+	}
+
+	app.generators[name] = generator
+
+	return generator
+}
+
+func (app *Application) newGenerator(driver string) (contractsid.Generator, error) {
+	switch driver {
+	case DriverUuid:
+		return NewUuid(), nil
+	case DriverUlid:
+		return NewUlid(), nil
+	case DriverSnowflake:
+		epoch := snowflakeEpoch
+		if value := app.config.GetString("id.snowflake.epoch"); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("the snowflake epoch should be a RFC3339 time: %v", err)
+			}
+			epoch = parsed
+		}
+
+		return NewSnowflake(app.config.GetInt("id.snowflake.worker"), epoch)
+	case DriverCustom:
+		if custom, ok := app.config.Get("id.via").(contractsid.Generator); ok {
+			return custom, nil
+		}
+		if custom, ok := app.config.Get("id.via").(func() string); ok {
+			return generatorFunc(custom), nil
+		}
+
+		return nil, fmt.Errorf("the id.via doesn't implement contracts/id/generator")
+	default:
+		return nil, fmt.Errorf("invalid id driver: %s, only support uuid, ulid, snowflake, custom", driver)
+	}
+}
+
+type generatorFunc func() string
+
+func (r generatorFunc) Generate() string {
+	return r()
+}
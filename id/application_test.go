@@ -0,0 +1,78 @@
+package id
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	configmocks "github.com/goravel/framework/mocks/config"
+	logmocks "github.com/goravel/framework/mocks/log"
+)
+
+func TestApplication(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockLog := logmocks.NewLog(t)
+	mockConfig.EXPECT().GetString("id.driver", DriverUuid).Return(DriverUlid).Once()
+	app, err := NewApplication(mockConfig, mockLog)
+	assert.Nil(t, err)
+	assert.Len(t, app.Generate(), 26)
+	assert.Same(t, app.Generator, app.Driver(DriverUlid))
+
+	parsed, err := uuid.Parse(app.Driver(DriverUuid).Generate())
+	assert.Nil(t, err)
+	assert.Equal(t, uuid.Version(7), parsed.Version())
+
+	mockConfig.EXPECT().GetString("id.snowflake.epoch").Return("").Once()
+	mockConfig.EXPECT().GetInt("id.snowflake.worker").Return(1).Once()
+	_, err = strconv.ParseInt(app.Driver(DriverSnowflake).Generate(), 10, 64)
+	assert.Nil(t, err)
+
+	mockConfig.EXPECT().Get("id.via").Return(func() string { return "custom" }).Twice()
+	assert.Equal(t, "custom", app.Driver(DriverCustom).Generate())
+
+	mockLog.EXPECT().Error(mock.MatchedBy(func(err error) bool {
+		return err.Error() == "invalid id driver: nanoid, only support uuid, ulid, snowflake, custom"
+	})).Once()
+	assert.Nil(t, app.Driver("nanoid"))
+
+	mockConfig.EXPECT().GetString("id.driver", DriverUuid).Return(DriverSnowflake).Once()
+	mockConfig.EXPECT().GetString("id.snowflake.epoch").Return("2024").Once()
+	_, err = NewApplication(mockConfig, mockLog)
+	assert.ErrorContains(t, err, "the snowflake epoch should be a RFC3339 time")
+}
+
+func TestUlid(t *testing.T) {
+	ulid := NewUlid()
+	previous := ulid.Generate()
+	for i := 0; i < 1000; i++ {
+		current := ulid.Generate()
+		assert.Len(t, current, 26)
+		assert.Regexp(t, "^[0-7][0-9A-HJKMNP-TV-Z]{25}$", current)
+		assert.Greater(t, current, previous)
+		previous = current
+	}
+}
+
+func TestSnowflake(t *testing.T) {
+	_, err := NewSnowflake(1024, snowflakeEpoch)
+	assert.EqualError(t, err, "the snowflake worker should be between 0 and 1023")
+
+	snowflake, err := NewSnowflake(5, snowflakeEpoch)
+	assert.Nil(t, err)
+
+	var previous int64
+	for i := 0; i < 10000; i++ {
+		current, err := strconv.ParseInt(snowflake.Generate(), 10, 64)
+		assert.Nil(t, err)
+		assert.Greater(t, current, previous)
+		assert.Equal(t, int64(5), current>>snowflakeSequenceBits&snowflakeMaxWorker)
+		previous = current
+	}
+
+	generated := time.UnixMilli(previous>>(snowflakeWorkerBits+snowflakeSequenceBits) + snowflakeEpoch.UnixMilli())
+	assert.WithinDuration(t, time.Now(), generated, time.Second)
+}
@@ -0,0 +1,25 @@
+package id
+
+import (
+	"github.com/goravel/framework/contracts/foundation"
+	contractsid "github.com/goravel/framework/contracts/id"
+)
+
+const Binding = "goravel.id"
+
+// Facade The ID generator used by the framework to generate the IDs of the models, the requests, the jobs and the
+// batches, it's resolved once when the service provider boots and is nil if the service provider isn't registered.
+var Facade contractsid.ID
+
+type ServiceProvider struct {
+}
+
+func (id *ServiceProvider) Register(app foundation.Application) {
+	app.Singleton(Binding, func(app foundation.Application) (any, error) {
+		return NewApplication(app.MakeConfig(), app.MakeLog())
+	})
+}
+
+func (id *ServiceProvider) Boot(app foundation.Application) {
+	Facade = app.MakeID()
+}
@@ -0,0 +1,58 @@
+package id
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	snowflakeWorkerBits   = 10
+	snowflakeSequenceBits = 12
+	snowflakeMaxWorker    = 1<<snowflakeWorkerBits - 1
+	snowflakeMaxSequence  = 1<<snowflakeSequenceBits - 1
+)
+
+// Snowflake Generate the snowflake IDs, 41 bits of the milliseconds since the epoch, 10 bits of the worker and 12 bits
+// of the sequence in the millisecond, every process generating the IDs should have a different worker.
+type Snowflake struct {
+	mu       sync.Mutex
+	epoch    int64
+	worker   int64
+	last     int64
+	sequence int64
+}
+
+func NewSnowflake(worker int, epoch time.Time) (*Snowflake, error) {
+	if worker < 0 || worker > snowflakeMaxWorker {
+		return nil, fmt.Errorf("the snowflake worker should be between 0 and %d", snowflakeMaxWorker)
+	}
+
+	return &Snowflake{
+		epoch:  epoch.UnixMilli(),
+		worker: int64(worker),
+	}, nil
+}
+
+func (r *Snowflake) Generate() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The IDs are generated in the last millisecond if the clock moves backwards.
+	ms := max(time.Now().UnixMilli()-r.epoch, r.last)
+	if ms == r.last {
+		r.sequence = (r.sequence + 1) & snowflakeMaxSequence
+		if r.sequence == 0 {
+			for ms <= r.last {
+				time.Sleep(100 * time.Microsecond)
+				ms = time.Now().UnixMilli() - r.epoch
+			}
+		}
+	} else {
+		r.sequence = 0
+	}
+	r.last = ms
+
+	return strconv.FormatInt(ms<<(snowflakeWorkerBits+snowflakeSequenceBits)|r.worker<<snowflakeSequenceBits|r.sequence, 10)
+}
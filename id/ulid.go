@@ -0,0 +1,62 @@
+package id
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"sync"
+	"time"
+)
+
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// Ulid Generate the ULIDs, 48 bits of the milliseconds and 80 random bits encoded in Crockford's base32. The random
+// bits of the ULIDs generated in the same millisecond are incremented, so the ULIDs are monotonic.
+type Ulid struct {
+	mu      sync.Mutex
+	last    uint64
+	entropy [10]byte
+}
+
+func NewUlid() *Ulid {
+	return &Ulid{}
+}
+
+func (r *Ulid) Generate() string {
+	r.mu.Lock()
+	ms := uint64(time.Now().UnixMilli())
+	if ms <= r.last && !r.increment() {
+		// The random bits overflow, it's practically impossible, so the ULID is generated in the next millisecond.
+		ms = r.last + 1
+	}
+	if ms > r.last {
+		_, _ = rand.Read(r.entropy[:])
+		r.last = ms
+	}
+	entropy := r.entropy
+	r.mu.Unlock()
+
+	// The 128 bits are split into a high part of 48 + 16 bits and a low part of 64 bits.
+	hi := ms<<16 | uint64(binary.BigEndian.Uint16(entropy[:2]))
+	lo := binary.BigEndian.Uint64(entropy[2:])
+
+	var encoded [26]byte
+	for i := len(encoded) - 1; i >= 0; i-- {
+		encoded[i] = crockford[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+
+	return string(encoded[:])
+}
+
+// increment Increment the random bits, false is returned if they overflow.
+func (r *Ulid) increment() bool {
+	for i := len(r.entropy) - 1; i >= 0; i-- {
+		r.entropy[i]++
+		if r.entropy[i] != 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,22 @@
+package id
+
+import (
+	"github.com/google/uuid"
+)
+
+// Uuid Generate the version 7 UUIDs, they are sorted by the time they are generated.
+type Uuid struct {
+}
+
+func NewUuid() *Uuid {
+	return &Uuid{}
+}
+
+func (r *Uuid) Generate() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.NewString()
+	}
+
+	return id.String()
+}
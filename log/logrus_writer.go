@@ -12,6 +12,7 @@ import (
 	"github.com/goravel/framework/contracts/foundation"
 	"github.com/goravel/framework/contracts/http"
 	"github.com/goravel/framework/contracts/log"
+	"github.com/goravel/framework/http/requestid"
 	"github.com/goravel/framework/log/formatter"
 	"github.com/goravel/framework/log/logger"
 )
@@ -256,6 +257,10 @@ func (r *Writer) toMap() map[string]any {
 		payload["hint"] = hint
 	}
 
+	if requestID := requestid.FromContext(r.instance.Context); requestID != "" {
+		payload["request_id"] = requestID
+	}
+
 	if owner := r.owner; owner != nil {
 		payload["owner"] = owner
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	nethttp "net/http"
 	"os"
 	"os/exec"
@@ -17,6 +18,7 @@ import (
 	contractsession "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/contracts/validation"
 	"github.com/goravel/framework/foundation/json"
+	"github.com/goravel/framework/http/requestid"
 	configmock "github.com/goravel/framework/mocks/config"
 	"github.com/goravel/framework/support/carbon"
 	"github.com/goravel/framework/support/file"
@@ -306,6 +308,19 @@ func TestLogrus(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "RequestID",
+			setup: func() {
+				mockDriverConfig(mockConfig)
+
+				log = NewApplication(mockConfig, j)
+				log.WithContext(requestid.WithContext(context.Background(), "01J0000000000000000000000")).Info("Goravel")
+			},
+			assert: func() {
+				assert.True(t, file.Contain(singleLog, "test.info: Goravel\nrequest_id: \"01J0000000000000000000000\""))
+				assert.True(t, file.Contain(dailyLog, "test.info: Goravel\nrequest_id: \"01J0000000000000000000000\""))
+			},
+		},
 		{
 			name: "Tags",
 			setup: func() {
@@ -666,6 +681,10 @@ func (r *TestResponse) Stream(int, func(w contractshttp.StreamWriter) error) con
 	panic("do not need to implement it")
 }
 
+func (r *TestResponse) StreamDownload(string, func(io.Writer) error) contractshttp.Response {
+	panic("do not need to implement it")
+}
+
 func (r *TestResponse) WithoutCookie(name string) contractshttp.ContextResponse {
 	panic("do not need to implement it")
 }
@@ -1,13 +1,17 @@
 package mail
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net/smtp"
+	"time"
 
 	"github.com/goravel/framework/contracts/config"
 	"github.com/goravel/framework/contracts/mail"
 	queuecontract "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/database/orm"
+	"github.com/goravel/framework/support/retry"
 )
 
 type Application struct {
@@ -16,6 +20,7 @@ type Application struct {
 	cc          []string
 	clone       int
 	config      config.Config
+	ctx         context.Context
 	from        mail.Address
 	html        string
 	queue       queuecontract.Queue
@@ -92,6 +97,10 @@ func (r *Application) Queue(mailable ...mail.Mailable) error {
 		}
 	}
 
+	if len(mailable) > 0 && shouldDispatchAfterCommit(mailable[0]) {
+		return orm.AfterCommit(r.ctx, job.Dispatch)
+	}
+
 	return job.Dispatch()
 }
 
@@ -99,7 +108,16 @@ func (r *Application) Send(mailable ...mail.Mailable) error {
 	if len(mailable) > 0 {
 		r.setUsingMailable(mailable[0])
 	}
-	return SendMail(r.config, r.subject, r.html, r.from.Address, r.from.Name, r.to, r.cc, r.bcc, r.attachments)
+
+	config, subject, html, from, to, cc, bcc, attachments := r.config, r.subject, r.html, r.from, r.to, r.cc, r.bcc, r.attachments
+	send := func() error {
+		return SendMail(config, subject, html, from.Address, from.Name, to, cc, bcc, attachments)
+	}
+	if len(mailable) > 0 && shouldDispatchAfterCommit(mailable[0]) {
+		return orm.AfterCommit(r.ctx, send)
+	}
+
+	return send()
 }
 
 func (r *Application) Subject(subject string) mail.Mail {
@@ -116,6 +134,13 @@ func (r *Application) To(to []string) mail.Mail {
 	return instance
 }
 
+func (r *Application) WithContext(ctx context.Context) mail.Mail {
+	instance := r.instance()
+	instance.ctx = ctx
+
+	return instance
+}
+
 func (r *Application) instance() *Application {
 	if r.clone == 0 {
 		return &Application{
@@ -154,6 +179,12 @@ func (r *Application) setUsingMailable(mailable mail.Mailable) {
 	}
 }
 
+func shouldDispatchAfterCommit(mailable mail.Mailable) bool {
+	afterCommit, ok := mailable.(mail.ShouldDispatchAfterCommit)
+
+	return ok && afterCommit.DispatchAfterCommit()
+}
+
 func SendMail(config config.Config, subject, html, fromAddress, fromName string, to, cc, bcc, attaches []string) error {
 	e := NewEmail()
 	if fromAddress == "" {
@@ -178,20 +209,43 @@ func SendMail(config config.Config, subject, html, fromAddress, fromName string,
 		}
 	}
 
+	if config.GetString("mail.driver") == DriverArray {
+		keepMail(SentMail{
+			Subject:     subject,
+			Html:        html,
+			From:        e.From,
+			To:          to,
+			Cc:          cc,
+			Bcc:         bcc,
+			Attachments: attaches,
+		})
+
+		return nil
+	}
+
 	port := config.GetInt("mail.port")
-	switch port {
-	case 465:
-		return e.SendWithTLS(fmt.Sprintf("%s:%d", config.GetString("mail.host"), config.GetInt("mail.port")),
-			LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")),
-			&tls.Config{ServerName: config.GetString("mail.host")})
-	case 587:
-		return e.SendWithStartTLS(fmt.Sprintf("%s:%d", config.GetString("mail.host"), config.GetInt("mail.port")),
-			LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")),
-			&tls.Config{ServerName: config.GetString("mail.host")})
-	default:
-		return e.Send(fmt.Sprintf("%s:%d", config.GetString("mail.host"), port),
-			LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")))
+	policy := retry.Policy{
+		Attempts:  config.GetInt("mail.retry.attempts", 1),
+		Delay:     time.Duration(config.GetInt("mail.retry.delay", 100)) * time.Millisecond,
+		Jitter:    retry.JitterEqual,
+		Retryable: retry.IsTransient,
 	}
+
+	return retry.Do(context.Background(), policy, func() error {
+		switch port {
+		case 465:
+			return e.SendWithTLS(fmt.Sprintf("%s:%d", config.GetString("mail.host"), config.GetInt("mail.port")),
+				LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")),
+				&tls.Config{ServerName: config.GetString("mail.host")})
+		case 587:
+			return e.SendWithStartTLS(fmt.Sprintf("%s:%d", config.GetString("mail.host"), config.GetInt("mail.port")),
+				LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")),
+				&tls.Config{ServerName: config.GetString("mail.host")})
+		default:
+			return e.Send(fmt.Sprintf("%s:%d", config.GetString("mail.host"), port),
+				LoginAuth(config.GetString("mail.username"), config.GetString("mail.password")))
+		}
+	})
 }
 
 type loginAuth struct {
@@ -192,10 +192,17 @@ func mockConfig(mailPort, redisPort int) *configmock.Config {
 	mockConfig.On("GetString", "queue.connections.redis.driver").Return("redis")
 	mockConfig.On("GetString", "queue.connections.redis.connection").Return("default")
 	mockConfig.On("GetString", "queue.connections.redis.queue", "default").Return("default")
+	mockConfig.On("GetString", "queue.failed.database").Return("")
+	mockConfig.On("GetString", "database.default").Return("")
+	mockConfig.On("GetString", "queue.failed.table").Return("")
 	mockConfig.On("GetString", "database.redis.default.host").Return("localhost")
 	mockConfig.On("GetString", "database.redis.default.password").Return("")
 	mockConfig.On("GetInt", "database.redis.default.port").Return(redisPort)
 	mockConfig.On("GetInt", "database.redis.default.database").Return(0)
+	mockConfig.On("Get", "database.redis.default.cluster").Return(nil)
+	mockConfig.On("GetString", "database.redis.default.sentinel.master_name").Return("")
+	mockConfig.On("GetInt", "queue.connections.redis.retry_after", 60).Return(60)
+	mockConfig.On("GetString", "mail.driver").Return("smtp")
 
 	if file.Exists("../.env") {
 		vip := viper.New()
@@ -208,6 +215,8 @@ func mockConfig(mailPort, redisPort int) *configmock.Config {
 
 		mockConfig.On("GetString", "mail.host").Return(vip.Get("MAIL_HOST"))
 		mockConfig.On("GetInt", "mail.port").Return(mailPort)
+		mockConfig.On("GetInt", "mail.retry.attempts", 1).Return(1)
+		mockConfig.On("GetInt", "mail.retry.delay", 100).Return(100)
 		mockConfig.On("GetString", "mail.from.address").Return(vip.Get("MAIL_FROM_ADDRESS"))
 		mockConfig.On("GetString", "mail.from.name").Return(vip.Get("MAIL_FROM_NAME"))
 		mockConfig.On("GetString", "mail.username").Return(vip.Get("MAIL_USERNAME"))
@@ -223,6 +232,8 @@ func mockConfig(mailPort, redisPort int) *configmock.Config {
 	if os.Getenv("MAIL_HOST") != "" {
 		mockConfig.On("GetString", "mail.host").Return(os.Getenv("MAIL_HOST"))
 		mockConfig.On("GetInt", "mail.port").Return(mailPort)
+		mockConfig.On("GetInt", "mail.retry.attempts", 1).Return(1)
+		mockConfig.On("GetInt", "mail.retry.delay", 100).Return(100)
 		mockConfig.On("GetString", "mail.from.address").Return(os.Getenv("MAIL_FROM_ADDRESS"))
 		mockConfig.On("GetString", "mail.from.name").Return(os.Getenv("MAIL_FROM_NAME"))
 		mockConfig.On("GetString", "mail.username").Return(os.Getenv("MAIL_USERNAME"))
@@ -0,0 +1,47 @@
+package mail
+
+import (
+	"sync"
+)
+
+// DriverArray The mail driver that keeps the sent mails in memory instead of sending them, it's used by tests.
+const DriverArray = "array"
+
+// SentMail is a mail kept by the array driver.
+type SentMail struct {
+	Subject     string
+	Html        string
+	From        string
+	To          []string
+	Cc          []string
+	Bcc         []string
+	Attachments []string
+}
+
+var arrayMails struct {
+	sync.Mutex
+	mails []SentMail
+}
+
+// Sent Get the mails kept by the array driver.
+func Sent() []SentMail {
+	arrayMails.Lock()
+	defer arrayMails.Unlock()
+
+	return append([]SentMail(nil), arrayMails.mails...)
+}
+
+// FlushSent Remove the mails kept by the array driver.
+func FlushSent() {
+	arrayMails.Lock()
+	defer arrayMails.Unlock()
+
+	arrayMails.mails = nil
+}
+
+func keepMail(mail SentMail) {
+	arrayMails.Lock()
+	defer arrayMails.Unlock()
+
+	arrayMails.mails = append(arrayMails.mails, mail)
+}
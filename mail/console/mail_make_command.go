@@ -54,13 +54,13 @@ func (receiver *MailMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Mail created successfully")
+	color.Green().Printf("Mail [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *MailMakeCommand) getStub() string {
-	return Stubs{}.Mail()
+	return supportconsole.Stub("mail.stub", Stubs{}.Mail())
 }
 
 // populateStub Populate the place-holders in the command stub.
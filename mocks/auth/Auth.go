@@ -20,6 +20,52 @@ func (_m *Auth) EXPECT() *Auth_Expecter {
 	return &Auth_Expecter{mock: &_m.Mock}
 }
 
+// Can provides a mock function with given fields: ability
+func (_m *Auth) Can(ability string) bool {
+	ret := _m.Called(ability)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Can")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(ability)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Auth_Can_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Can'
+type Auth_Can_Call struct {
+	*mock.Call
+}
+
+// Can is a helper method to define mock.On call
+//   - ability string
+func (_e *Auth_Expecter) Can(ability interface{}) *Auth_Can_Call {
+	return &Auth_Can_Call{Call: _e.mock.On("Can", ability)}
+}
+
+func (_c *Auth_Can_Call) Run(run func(ability string)) *Auth_Can_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Auth_Can_Call) Return(_a0 bool) *Auth_Can_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Auth_Can_Call) RunAndReturn(run func(string) bool) *Auth_Can_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Guard provides a mock function with given fields: name
 func (_m *Auth) Guard(name string) auth.Auth {
 	ret := _m.Called(name)
@@ -68,6 +114,61 @@ func (_c *Auth_Guard_Call) RunAndReturn(run func(string) auth.Auth) *Auth_Guard_
 	return _c
 }
 
+// Id provides a mock function with given fields:
+func (_m *Auth) Id() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Id")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Auth_Id_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Id'
+type Auth_Id_Call struct {
+	*mock.Call
+}
+
+// Id is a helper method to define mock.On call
+func (_e *Auth_Expecter) Id() *Auth_Id_Call {
+	return &Auth_Id_Call{Call: _e.mock.On("Id")}
+}
+
+func (_c *Auth_Id_Call) Run(run func()) *Auth_Id_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Auth_Id_Call) Return(_a0 string, _a1 error) *Auth_Id_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Auth_Id_Call) RunAndReturn(run func() (string, error)) *Auth_Id_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function with given fields: user
 func (_m *Auth) Login(user interface{}) (string, error) {
 	ret := _m.Called(user)
@@ -384,6 +485,67 @@ func (_c *Auth_User_Call) RunAndReturn(run func(interface{}) error) *Auth_User_C
 	return _c
 }
 
+// WithAbilities provides a mock function with given fields: abilities
+func (_m *Auth) WithAbilities(abilities ...string) auth.Auth {
+	_va := make([]interface{}, len(abilities))
+	for _i := range abilities {
+		_va[_i] = abilities[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithAbilities")
+	}
+
+	var r0 auth.Auth
+	if rf, ok := ret.Get(0).(func(...string) auth.Auth); ok {
+		r0 = rf(abilities...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(auth.Auth)
+		}
+	}
+
+	return r0
+}
+
+// Auth_WithAbilities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithAbilities'
+type Auth_WithAbilities_Call struct {
+	*mock.Call
+}
+
+// WithAbilities is a helper method to define mock.On call
+//   - abilities ...string
+func (_e *Auth_Expecter) WithAbilities(abilities ...interface{}) *Auth_WithAbilities_Call {
+	return &Auth_WithAbilities_Call{Call: _e.mock.On("WithAbilities",
+		append([]interface{}{}, abilities...)...)}
+}
+
+func (_c *Auth_WithAbilities_Call) Run(run func(abilities ...string)) *Auth_WithAbilities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Auth_WithAbilities_Call) Return(_a0 auth.Auth) *Auth_WithAbilities_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Auth_WithAbilities_Call) RunAndReturn(run func(...string) auth.Auth) *Auth_WithAbilities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuth creates a new instance of Auth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuth(t interface {
@@ -186,6 +186,51 @@ func (_c *Lock_Get_Call) RunAndReturn(run func(...func()) bool) *Lock_Get_Call {
 	return _c
 }
 
+// Owner provides a mock function with given fields:
+func (_m *Lock) Owner() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Owner")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Lock_Owner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Owner'
+type Lock_Owner_Call struct {
+	*mock.Call
+}
+
+// Owner is a helper method to define mock.On call
+func (_e *Lock_Expecter) Owner() *Lock_Owner_Call {
+	return &Lock_Owner_Call{Call: _e.mock.On("Owner")}
+}
+
+func (_c *Lock_Owner_Call) Run(run func()) *Lock_Owner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Lock_Owner_Call) Return(_a0 string) *Lock_Owner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Lock_Owner_Call) RunAndReturn(run func() string) *Lock_Owner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Release provides a mock function with given fields:
 func (_m *Lock) Release() bool {
 	ret := _m.Called()
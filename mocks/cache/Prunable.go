@@ -0,0 +1,87 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package cache
+
+import mock "github.com/stretchr/testify/mock"
+
+// Prunable is an autogenerated mock type for the Prunable type
+type Prunable struct {
+	mock.Mock
+}
+
+type Prunable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Prunable) EXPECT() *Prunable_Expecter {
+	return &Prunable_Expecter{mock: &_m.Mock}
+}
+
+// Prune provides a mock function with given fields:
+func (_m *Prunable) Prune() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prune")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Prunable_Prune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prune'
+type Prunable_Prune_Call struct {
+	*mock.Call
+}
+
+// Prune is a helper method to define mock.On call
+func (_e *Prunable_Expecter) Prune() *Prunable_Prune_Call {
+	return &Prunable_Prune_Call{Call: _e.mock.On("Prune")}
+}
+
+func (_c *Prunable_Prune_Call) Run(run func()) *Prunable_Prune_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Prunable_Prune_Call) Return(_a0 int, _a1 error) *Prunable_Prune_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Prunable_Prune_Call) RunAndReturn(run func() (int, error)) *Prunable_Prune_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPrunable creates a new instance of Prunable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrunable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Prunable {
+	mock := &Prunable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
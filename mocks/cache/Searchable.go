@@ -0,0 +1,90 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package cache
+
+import mock "github.com/stretchr/testify/mock"
+
+// Searchable is an autogenerated mock type for the Searchable type
+type Searchable struct {
+	mock.Mock
+}
+
+type Searchable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Searchable) EXPECT() *Searchable_Expecter {
+	return &Searchable_Expecter{mock: &_m.Mock}
+}
+
+// Keys provides a mock function with given fields: pattern
+func (_m *Searchable) Keys(pattern string) ([]string, error) {
+	ret := _m.Called(pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keys")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(pattern)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(pattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(pattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Searchable_Keys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keys'
+type Searchable_Keys_Call struct {
+	*mock.Call
+}
+
+// Keys is a helper method to define mock.On call
+//   - pattern string
+func (_e *Searchable_Expecter) Keys(pattern interface{}) *Searchable_Keys_Call {
+	return &Searchable_Keys_Call{Call: _e.mock.On("Keys", pattern)}
+}
+
+func (_c *Searchable_Keys_Call) Run(run func(pattern string)) *Searchable_Keys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Searchable_Keys_Call) Return(_a0 []string, _a1 error) *Searchable_Keys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Searchable_Keys_Call) RunAndReturn(run func(string) ([]string, error)) *Searchable_Keys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearchable creates a new instance of Searchable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Searchable {
+	mock := &Searchable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,90 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package cache
+
+import (
+	cache "github.com/goravel/framework/contracts/cache"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatsReporter is an autogenerated mock type for the StatsReporter type
+type StatsReporter struct {
+	mock.Mock
+}
+
+type StatsReporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsReporter) EXPECT() *StatsReporter_Expecter {
+	return &StatsReporter_Expecter{mock: &_m.Mock}
+}
+
+// Stats provides a mock function with given fields:
+func (_m *StatsReporter) Stats() (cache.Stats, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 cache.Stats
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (cache.Stats, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() cache.Stats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(cache.Stats)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsReporter_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type StatsReporter_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+func (_e *StatsReporter_Expecter) Stats() *StatsReporter_Stats_Call {
+	return &StatsReporter_Stats_Call{Call: _e.mock.On("Stats")}
+}
+
+func (_c *StatsReporter_Stats_Call) Run(run func()) *StatsReporter_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StatsReporter_Stats_Call) Return(_a0 cache.Stats, _a1 error) *StatsReporter_Stats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsReporter_Stats_Call) RunAndReturn(run func() (cache.Stats, error)) *StatsReporter_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsReporter creates a new instance of StatsReporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsReporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsReporter {
+	mock := &StatsReporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package cache
+
+import (
+	cache "github.com/goravel/framework/contracts/cache"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Taggable is an autogenerated mock type for the Taggable type
+type Taggable struct {
+	mock.Mock
+}
+
+type Taggable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Taggable) EXPECT() *Taggable_Expecter {
+	return &Taggable_Expecter{mock: &_m.Mock}
+}
+
+// Tags provides a mock function with given fields: names
+func (_m *Taggable) Tags(names ...string) cache.Driver {
+	_va := make([]interface{}, len(names))
+	for _i := range names {
+		_va[_i] = names[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tags")
+	}
+
+	var r0 cache.Driver
+	if rf, ok := ret.Get(0).(func(...string) cache.Driver); ok {
+		r0 = rf(names...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(cache.Driver)
+		}
+	}
+
+	return r0
+}
+
+// Taggable_Tags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tags'
+type Taggable_Tags_Call struct {
+	*mock.Call
+}
+
+// Tags is a helper method to define mock.On call
+//   - names ...string
+func (_e *Taggable_Expecter) Tags(names ...interface{}) *Taggable_Tags_Call {
+	return &Taggable_Tags_Call{Call: _e.mock.On("Tags",
+		append([]interface{}{}, names...)...)}
+}
+
+func (_c *Taggable_Tags_Call) Run(run func(names ...string)) *Taggable_Tags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Taggable_Tags_Call) Return(_a0 cache.Driver) *Taggable_Tags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Taggable_Tags_Call) RunAndReturn(run func(...string) cache.Driver) *Taggable_Tags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTaggable creates a new instance of Taggable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaggable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Taggable {
+	mock := &Taggable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -86,6 +86,101 @@ func (_c *Artisan_CallAndExit_Call) RunAndReturn(run func(string)) *Artisan_Call
 	return _c
 }
 
+// CallWithError provides a mock function with given fields: command
+func (_m *Artisan) CallWithError(command string) error {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallWithError")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(command)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Artisan_CallWithError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallWithError'
+type Artisan_CallWithError_Call struct {
+	*mock.Call
+}
+
+// CallWithError is a helper method to define mock.On call
+//   - command string
+func (_e *Artisan_Expecter) CallWithError(command interface{}) *Artisan_CallWithError_Call {
+	return &Artisan_CallWithError_Call{Call: _e.mock.On("CallWithError", command)}
+}
+
+func (_c *Artisan_CallWithError_Call) Run(run func(command string)) *Artisan_CallWithError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Artisan_CallWithError_Call) Return(_a0 error) *Artisan_CallWithError_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Artisan_CallWithError_Call) RunAndReturn(run func(string) error) *Artisan_CallWithError_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Command provides a mock function with given fields: signature, handle
+func (_m *Artisan) Command(signature string, handle func(console.Context) error) console.ClosureCommand {
+	ret := _m.Called(signature, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Command")
+	}
+
+	var r0 console.ClosureCommand
+	if rf, ok := ret.Get(0).(func(string, func(console.Context) error) console.ClosureCommand); ok {
+		r0 = rf(signature, handle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(console.ClosureCommand)
+		}
+	}
+
+	return r0
+}
+
+// Artisan_Command_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Command'
+type Artisan_Command_Call struct {
+	*mock.Call
+}
+
+// Command is a helper method to define mock.On call
+//   - signature string
+//   - handle func(console.Context) error
+func (_e *Artisan_Expecter) Command(signature interface{}, handle interface{}) *Artisan_Command_Call {
+	return &Artisan_Command_Call{Call: _e.mock.On("Command", signature, handle)}
+}
+
+func (_c *Artisan_Command_Call) Run(run func(signature string, handle func(console.Context) error)) *Artisan_Command_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(console.Context) error))
+	})
+	return _c
+}
+
+func (_c *Artisan_Command_Call) Return(_a0 console.ClosureCommand) *Artisan_Command_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Artisan_Command_Call) RunAndReturn(run func(string, func(console.Context) error) console.ClosureCommand) *Artisan_Command_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: commands
 func (_m *Artisan) Register(commands []console.Command) {
 	_m.Called(commands)
@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package console
+
+import (
+	console "github.com/goravel/framework/contracts/console"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ClosureCommand is an autogenerated mock type for the ClosureCommand type
+type ClosureCommand struct {
+	mock.Mock
+}
+
+type ClosureCommand_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClosureCommand) EXPECT() *ClosureCommand_Expecter {
+	return &ClosureCommand_Expecter{mock: &_m.Mock}
+}
+
+// Describe provides a mock function with given fields: description
+func (_m *ClosureCommand) Describe(description string) console.ClosureCommand {
+	ret := _m.Called(description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Describe")
+	}
+
+	var r0 console.ClosureCommand
+	if rf, ok := ret.Get(0).(func(string) console.ClosureCommand); ok {
+		r0 = rf(description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(console.ClosureCommand)
+		}
+	}
+
+	return r0
+}
+
+// ClosureCommand_Describe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Describe'
+type ClosureCommand_Describe_Call struct {
+	*mock.Call
+}
+
+// Describe is a helper method to define mock.On call
+//   - description string
+func (_e *ClosureCommand_Expecter) Describe(description interface{}) *ClosureCommand_Describe_Call {
+	return &ClosureCommand_Describe_Call{Call: _e.mock.On("Describe", description)}
+}
+
+func (_c *ClosureCommand_Describe_Call) Run(run func(description string)) *ClosureCommand_Describe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ClosureCommand_Describe_Call) Return(_a0 console.ClosureCommand) *ClosureCommand_Describe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClosureCommand_Describe_Call) RunAndReturn(run func(string) console.ClosureCommand) *ClosureCommand_Describe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClosureCommand creates a new instance of ClosureCommand. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClosureCommand(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClosureCommand {
+	mock := &ClosureCommand{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -20,6 +20,78 @@ func (_m *Context) EXPECT() *Context_Expecter {
 	return &Context_Expecter{mock: &_m.Mock}
 }
 
+// Anticipate provides a mock function with given fields: question, suggestions, option
+func (_m *Context) Anticipate(question string, suggestions []string, option ...console.AnticipateOption) (string, error) {
+	_va := make([]interface{}, len(option))
+	for _i := range option {
+		_va[_i] = option[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, question, suggestions)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Anticipate")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string, ...console.AnticipateOption) (string, error)); ok {
+		return rf(question, suggestions, option...)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, ...console.AnticipateOption) string); ok {
+		r0 = rf(question, suggestions, option...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, ...console.AnticipateOption) error); ok {
+		r1 = rf(question, suggestions, option...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Context_Anticipate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Anticipate'
+type Context_Anticipate_Call struct {
+	*mock.Call
+}
+
+// Anticipate is a helper method to define mock.On call
+//   - question string
+//   - suggestions []string
+//   - option ...console.AnticipateOption
+func (_e *Context_Expecter) Anticipate(question interface{}, suggestions interface{}, option ...interface{}) *Context_Anticipate_Call {
+	return &Context_Anticipate_Call{Call: _e.mock.On("Anticipate",
+		append([]interface{}{question, suggestions}, option...)...)}
+}
+
+func (_c *Context_Anticipate_Call) Run(run func(question string, suggestions []string, option ...console.AnticipateOption)) *Context_Anticipate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]console.AnticipateOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(console.AnticipateOption)
+			}
+		}
+		run(args[0].(string), args[1].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Context_Anticipate_Call) Return(_a0 string, _a1 error) *Context_Anticipate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Context_Anticipate_Call) RunAndReturn(run func(string, []string, ...console.AnticipateOption) (string, error)) *Context_Anticipate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Argument provides a mock function with given fields: index
 func (_m *Context) Argument(index int) string {
 	ret := _m.Called(index)
@@ -474,6 +546,52 @@ func (_c *Context_Info_Call) RunAndReturn(run func(string)) *Context_Info_Call {
 	return _c
 }
 
+// Json provides a mock function with given fields: value
+func (_m *Context) Json(value interface{}) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Json")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Context_Json_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Json'
+type Context_Json_Call struct {
+	*mock.Call
+}
+
+// Json is a helper method to define mock.On call
+//   - value interface{}
+func (_e *Context_Expecter) Json(value interface{}) *Context_Json_Call {
+	return &Context_Json_Call{Call: _e.mock.On("Json", value)}
+}
+
+func (_c *Context_Json_Call) Run(run func(value interface{})) *Context_Json_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Context_Json_Call) Return(_a0 error) *Context_Json_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Context_Json_Call) RunAndReturn(run func(interface{}) error) *Context_Json_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Line provides a mock function with given fields: message
 func (_m *Context) Line(message string) {
 	_m.Called(message)
@@ -1167,6 +1285,115 @@ func (_c *Context_Spinner_Call) RunAndReturn(run func(string, console.SpinnerOpt
 	return _c
 }
 
+// Table provides a mock function with given fields: headers, rows
+func (_m *Context) Table(headers []string, rows [][]string) error {
+	ret := _m.Called(headers, rows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Table")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, [][]string) error); ok {
+		r0 = rf(headers, rows)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Context_Table_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Table'
+type Context_Table_Call struct {
+	*mock.Call
+}
+
+// Table is a helper method to define mock.On call
+//   - headers []string
+//   - rows [][]string
+func (_e *Context_Expecter) Table(headers interface{}, rows interface{}) *Context_Table_Call {
+	return &Context_Table_Call{Call: _e.mock.On("Table", headers, rows)}
+}
+
+func (_c *Context_Table_Call) Run(run func(headers []string, rows [][]string)) *Context_Table_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].([][]string))
+	})
+	return _c
+}
+
+func (_c *Context_Table_Call) Return(_a0 error) *Context_Table_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Context_Table_Call) RunAndReturn(run func([]string, [][]string) error) *Context_Table_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TwoColumnDetail provides a mock function with given fields: first, second, filler
+func (_m *Context) TwoColumnDetail(first string, second string, filler ...rune) error {
+	_va := make([]interface{}, len(filler))
+	for _i := range filler {
+		_va[_i] = filler[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, first, second)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TwoColumnDetail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...rune) error); ok {
+		r0 = rf(first, second, filler...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Context_TwoColumnDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TwoColumnDetail'
+type Context_TwoColumnDetail_Call struct {
+	*mock.Call
+}
+
+// TwoColumnDetail is a helper method to define mock.On call
+//   - first string
+//   - second string
+//   - filler ...rune
+func (_e *Context_Expecter) TwoColumnDetail(first interface{}, second interface{}, filler ...interface{}) *Context_TwoColumnDetail_Call {
+	return &Context_TwoColumnDetail_Call{Call: _e.mock.On("TwoColumnDetail",
+		append([]interface{}{first, second}, filler...)...)}
+}
+
+func (_c *Context_TwoColumnDetail_Call) Run(run func(first string, second string, filler ...rune)) *Context_TwoColumnDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]rune, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(rune)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Context_TwoColumnDetail_Call) Return(_a0 error) *Context_TwoColumnDetail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Context_TwoColumnDetail_Call) RunAndReturn(run func(string, string, ...rune) error) *Context_TwoColumnDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Warning provides a mock function with given fields: message
 func (_m *Context) Warning(message string) {
 	_m.Called(message)
@@ -1200,6 +1427,54 @@ func (_c *Context_Warning_Call) RunAndReturn(run func(string)) *Context_Warning_
 	return _c
 }
 
+// WithChunkProgressBar provides a mock function with given fields: total, size, callback
+func (_m *Context) WithChunkProgressBar(total int, size int, callback func(int, int) error) error {
+	ret := _m.Called(total, size, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithChunkProgressBar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int, func(int, int) error) error); ok {
+		r0 = rf(total, size, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Context_WithChunkProgressBar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithChunkProgressBar'
+type Context_WithChunkProgressBar_Call struct {
+	*mock.Call
+}
+
+// WithChunkProgressBar is a helper method to define mock.On call
+//   - total int
+//   - size int
+//   - callback func(int , int) error
+func (_e *Context_Expecter) WithChunkProgressBar(total interface{}, size interface{}, callback interface{}) *Context_WithChunkProgressBar_Call {
+	return &Context_WithChunkProgressBar_Call{Call: _e.mock.On("WithChunkProgressBar", total, size, callback)}
+}
+
+func (_c *Context_WithChunkProgressBar_Call) Run(run func(total int, size int, callback func(int, int) error)) *Context_WithChunkProgressBar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(func(int, int) error))
+	})
+	return _c
+}
+
+func (_c *Context_WithChunkProgressBar_Call) Return(_a0 error) *Context_WithChunkProgressBar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Context_WithChunkProgressBar_Call) RunAndReturn(run func(int, int, func(int, int) error) error) *Context_WithChunkProgressBar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithProgressBar provides a mock function with given fields: items, callback
 func (_m *Context) WithProgressBar(items []interface{}, callback func(interface{}) error) ([]interface{}, error) {
 	ret := _m.Called(items, callback)
@@ -1259,6 +1534,53 @@ func (_c *Context_WithProgressBar_Call) RunAndReturn(run func([]interface{}, fun
 	return _c
 }
 
+// WithSpinner provides a mock function with given fields: message, callback
+func (_m *Context) WithSpinner(message string, callback func() error) error {
+	ret := _m.Called(message, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithSpinner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func() error) error); ok {
+		r0 = rf(message, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Context_WithSpinner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithSpinner'
+type Context_WithSpinner_Call struct {
+	*mock.Call
+}
+
+// WithSpinner is a helper method to define mock.On call
+//   - message string
+//   - callback func() error
+func (_e *Context_Expecter) WithSpinner(message interface{}, callback interface{}) *Context_WithSpinner_Call {
+	return &Context_WithSpinner_Call{Call: _e.mock.On("WithSpinner", message, callback)}
+}
+
+func (_c *Context_WithSpinner_Call) Run(run func(message string, callback func() error)) *Context_WithSpinner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func() error))
+	})
+	return _c
+}
+
+func (_c *Context_WithSpinner_Call) Return(_a0 error) *Context_WithSpinner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Context_WithSpinner_Call) RunAndReturn(run func(string, func() error) error) *Context_WithSpinner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewContext creates a new instance of Context. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewContext(t interface {
@@ -0,0 +1,146 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package crypt
+
+import mock "github.com/stretchr/testify/mock"
+
+// AdditionalDataCrypt is an autogenerated mock type for the AdditionalDataCrypt type
+type AdditionalDataCrypt struct {
+	mock.Mock
+}
+
+type AdditionalDataCrypt_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AdditionalDataCrypt) EXPECT() *AdditionalDataCrypt_Expecter {
+	return &AdditionalDataCrypt_Expecter{mock: &_m.Mock}
+}
+
+// DecryptStringWithData provides a mock function with given fields: payload, data
+func (_m *AdditionalDataCrypt) DecryptStringWithData(payload string, data string) (string, error) {
+	ret := _m.Called(payload, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecryptStringWithData")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(payload, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(payload, data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(payload, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdditionalDataCrypt_DecryptStringWithData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecryptStringWithData'
+type AdditionalDataCrypt_DecryptStringWithData_Call struct {
+	*mock.Call
+}
+
+// DecryptStringWithData is a helper method to define mock.On call
+//   - payload string
+//   - data string
+func (_e *AdditionalDataCrypt_Expecter) DecryptStringWithData(payload interface{}, data interface{}) *AdditionalDataCrypt_DecryptStringWithData_Call {
+	return &AdditionalDataCrypt_DecryptStringWithData_Call{Call: _e.mock.On("DecryptStringWithData", payload, data)}
+}
+
+func (_c *AdditionalDataCrypt_DecryptStringWithData_Call) Run(run func(payload string, data string)) *AdditionalDataCrypt_DecryptStringWithData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AdditionalDataCrypt_DecryptStringWithData_Call) Return(_a0 string, _a1 error) *AdditionalDataCrypt_DecryptStringWithData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdditionalDataCrypt_DecryptStringWithData_Call) RunAndReturn(run func(string, string) (string, error)) *AdditionalDataCrypt_DecryptStringWithData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EncryptStringWithData provides a mock function with given fields: value, data
+func (_m *AdditionalDataCrypt) EncryptStringWithData(value string, data string) (string, error) {
+	ret := _m.Called(value, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EncryptStringWithData")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(value, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(value, data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(value, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdditionalDataCrypt_EncryptStringWithData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EncryptStringWithData'
+type AdditionalDataCrypt_EncryptStringWithData_Call struct {
+	*mock.Call
+}
+
+// EncryptStringWithData is a helper method to define mock.On call
+//   - value string
+//   - data string
+func (_e *AdditionalDataCrypt_Expecter) EncryptStringWithData(value interface{}, data interface{}) *AdditionalDataCrypt_EncryptStringWithData_Call {
+	return &AdditionalDataCrypt_EncryptStringWithData_Call{Call: _e.mock.On("EncryptStringWithData", value, data)}
+}
+
+func (_c *AdditionalDataCrypt_EncryptStringWithData_Call) Run(run func(value string, data string)) *AdditionalDataCrypt_EncryptStringWithData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AdditionalDataCrypt_EncryptStringWithData_Call) Return(_a0 string, _a1 error) *AdditionalDataCrypt_EncryptStringWithData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdditionalDataCrypt_EncryptStringWithData_Call) RunAndReturn(run func(string, string) (string, error)) *AdditionalDataCrypt_EncryptStringWithData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAdditionalDataCrypt creates a new instance of AdditionalDataCrypt. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAdditionalDataCrypt(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AdditionalDataCrypt {
+	mock := &AdditionalDataCrypt{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
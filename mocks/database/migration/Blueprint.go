@@ -17,6 +17,184 @@ func (_m *Blueprint) EXPECT() *Blueprint_Expecter {
 	return &Blueprint_Expecter{mock: &_m.Mock}
 }
 
+// Column provides a mock function with given fields: column, definition
+func (_m *Blueprint) Column(column string, definition string) {
+	_m.Called(column, definition)
+}
+
+// Blueprint_Column_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Column'
+type Blueprint_Column_Call struct {
+	*mock.Call
+}
+
+// Column is a helper method to define mock.On call
+//   - column string
+//   - definition string
+func (_e *Blueprint_Expecter) Column(column interface{}, definition interface{}) *Blueprint_Column_Call {
+	return &Blueprint_Column_Call{Call: _e.mock.On("Column", column, definition)}
+}
+
+func (_c *Blueprint_Column_Call) Run(run func(column string, definition string)) *Blueprint_Column_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Blueprint_Column_Call) Return() *Blueprint_Column_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Blueprint_Column_Call) RunAndReturn(run func(string, string)) *Blueprint_Column_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Comment provides a mock function with given fields: comment
+func (_m *Blueprint) Comment(comment string) {
+	_m.Called(comment)
+}
+
+// Blueprint_Comment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Comment'
+type Blueprint_Comment_Call struct {
+	*mock.Call
+}
+
+// Comment is a helper method to define mock.On call
+//   - comment string
+func (_e *Blueprint_Expecter) Comment(comment interface{}) *Blueprint_Comment_Call {
+	return &Blueprint_Comment_Call{Call: _e.mock.On("Comment", comment)}
+}
+
+func (_c *Blueprint_Comment_Call) Run(run func(comment string)) *Blueprint_Comment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Blueprint_Comment_Call) Return() *Blueprint_Comment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Blueprint_Comment_Call) RunAndReturn(run func(string)) *Blueprint_Comment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTableName provides a mock function with given fields:
+func (_m *Blueprint) GetTableName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTableName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Blueprint_GetTableName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTableName'
+type Blueprint_GetTableName_Call struct {
+	*mock.Call
+}
+
+// GetTableName is a helper method to define mock.On call
+func (_e *Blueprint_Expecter) GetTableName() *Blueprint_GetTableName_Call {
+	return &Blueprint_GetTableName_Call{Call: _e.mock.On("GetTableName")}
+}
+
+func (_c *Blueprint_GetTableName_Call) Run(run func()) *Blueprint_GetTableName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Blueprint_GetTableName_Call) Return(_a0 string) *Blueprint_GetTableName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Blueprint_GetTableName_Call) RunAndReturn(run func() string) *Blueprint_GetTableName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameColumn provides a mock function with given fields: from, to
+func (_m *Blueprint) RenameColumn(from string, to string) {
+	_m.Called(from, to)
+}
+
+// Blueprint_RenameColumn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameColumn'
+type Blueprint_RenameColumn_Call struct {
+	*mock.Call
+}
+
+// RenameColumn is a helper method to define mock.On call
+//   - from string
+//   - to string
+func (_e *Blueprint_Expecter) RenameColumn(from interface{}, to interface{}) *Blueprint_RenameColumn_Call {
+	return &Blueprint_RenameColumn_Call{Call: _e.mock.On("RenameColumn", from, to)}
+}
+
+func (_c *Blueprint_RenameColumn_Call) Run(run func(from string, to string)) *Blueprint_RenameColumn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Blueprint_RenameColumn_Call) Return() *Blueprint_RenameColumn_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Blueprint_RenameColumn_Call) RunAndReturn(run func(string, string)) *Blueprint_RenameColumn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Temporary provides a mock function with given fields:
+func (_m *Blueprint) Temporary() {
+	_m.Called()
+}
+
+// Blueprint_Temporary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Temporary'
+type Blueprint_Temporary_Call struct {
+	*mock.Call
+}
+
+// Temporary is a helper method to define mock.On call
+func (_e *Blueprint_Expecter) Temporary() *Blueprint_Temporary_Call {
+	return &Blueprint_Temporary_Call{Call: _e.mock.On("Temporary")}
+}
+
+func (_c *Blueprint_Temporary_Call) Run(run func()) *Blueprint_Temporary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Blueprint_Temporary_Call) Return() *Blueprint_Temporary_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Blueprint_Temporary_Call) RunAndReturn(run func()) *Blueprint_Temporary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBlueprint creates a new instance of Blueprint. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBlueprint(t interface {
@@ -68,6 +68,145 @@ func (_c *Schema_Connection_Call) RunAndReturn(run func(string) migration.Schema
 	return _c
 }
 
+// Create provides a mock function with given fields: table, callback
+func (_m *Schema) Create(table string, callback func(migration.Blueprint)) error {
+	ret := _m.Called(table, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(migration.Blueprint)) error); ok {
+		r0 = rf(table, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Schema_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - table string
+//   - callback func(migration.Blueprint)
+func (_e *Schema_Expecter) Create(table interface{}, callback interface{}) *Schema_Create_Call {
+	return &Schema_Create_Call{Call: _e.mock.On("Create", table, callback)}
+}
+
+func (_c *Schema_Create_Call) Run(run func(table string, callback func(migration.Blueprint))) *Schema_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(migration.Blueprint)))
+	})
+	return _c
+}
+
+func (_c *Schema_Create_Call) Return(_a0 error) *Schema_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_Create_Call) RunAndReturn(run func(string, func(migration.Blueprint)) error) *Schema_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Drop provides a mock function with given fields: table
+func (_m *Schema) Drop(table string) error {
+	ret := _m.Called(table)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(table)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_Drop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drop'
+type Schema_Drop_Call struct {
+	*mock.Call
+}
+
+// Drop is a helper method to define mock.On call
+//   - table string
+func (_e *Schema_Expecter) Drop(table interface{}) *Schema_Drop_Call {
+	return &Schema_Drop_Call{Call: _e.mock.On("Drop", table)}
+}
+
+func (_c *Schema_Drop_Call) Run(run func(table string)) *Schema_Drop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Schema_Drop_Call) Return(_a0 error) *Schema_Drop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_Drop_Call) RunAndReturn(run func(string) error) *Schema_Drop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropIfExists provides a mock function with given fields: table
+func (_m *Schema) DropIfExists(table string) error {
+	ret := _m.Called(table)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropIfExists")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(table)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_DropIfExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropIfExists'
+type Schema_DropIfExists_Call struct {
+	*mock.Call
+}
+
+// DropIfExists is a helper method to define mock.On call
+//   - table string
+func (_e *Schema_Expecter) DropIfExists(table interface{}) *Schema_DropIfExists_Call {
+	return &Schema_DropIfExists_Call{Call: _e.mock.On("DropIfExists", table)}
+}
+
+func (_c *Schema_DropIfExists_Call) Run(run func(table string)) *Schema_DropIfExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Schema_DropIfExists_Call) Return(_a0 error) *Schema_DropIfExists_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_DropIfExists_Call) RunAndReturn(run func(string) error) *Schema_DropIfExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: _a0
 func (_m *Schema) Register(_a0 []migration.Migration) {
 	_m.Called(_a0)
@@ -134,6 +273,53 @@ func (_c *Schema_Sql_Call) RunAndReturn(run func(string)) *Schema_Sql_Call {
 	return _c
 }
 
+// Table provides a mock function with given fields: table, callback
+func (_m *Schema) Table(table string, callback func(migration.Blueprint)) error {
+	ret := _m.Called(table, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Table")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(migration.Blueprint)) error); ok {
+		r0 = rf(table, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_Table_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Table'
+type Schema_Table_Call struct {
+	*mock.Call
+}
+
+// Table is a helper method to define mock.On call
+//   - table string
+//   - callback func(migration.Blueprint)
+func (_e *Schema_Expecter) Table(table interface{}, callback interface{}) *Schema_Table_Call {
+	return &Schema_Table_Call{Call: _e.mock.On("Table", table, callback)}
+}
+
+func (_c *Schema_Table_Call) Run(run func(table string, callback func(migration.Blueprint))) *Schema_Table_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(migration.Blueprint)))
+	})
+	return _c
+}
+
+func (_c *Schema_Table_Call) Return(_a0 error) *Schema_Table_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_Table_Call) RunAndReturn(run func(string, func(migration.Blueprint)) error) *Schema_Table_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSchema creates a new instance of Schema. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSchema(t interface {
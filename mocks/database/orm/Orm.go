@@ -176,9 +176,16 @@ func (_c *Orm_Factory_Call) RunAndReturn(run func() orm.Factory) *Orm_Factory_Ca
 	return _c
 }
 
-// Observe provides a mock function with given fields: model, observer
-func (_m *Orm) Observe(model interface{}, observer orm.Observer) {
-	_m.Called(model, observer)
+// Observe provides a mock function with given fields: model, observer, option
+func (_m *Orm) Observe(model interface{}, observer orm.Observer, option ...orm.ObserveOption) {
+	_va := make([]interface{}, len(option))
+	for _i := range option {
+		_va[_i] = option[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, model, observer)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
 }
 
 // Orm_Observe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Observe'
@@ -189,13 +196,21 @@ type Orm_Observe_Call struct {
 // Observe is a helper method to define mock.On call
 //   - model interface{}
 //   - observer orm.Observer
-func (_e *Orm_Expecter) Observe(model interface{}, observer interface{}) *Orm_Observe_Call {
-	return &Orm_Observe_Call{Call: _e.mock.On("Observe", model, observer)}
+//   - option ...orm.ObserveOption
+func (_e *Orm_Expecter) Observe(model interface{}, observer interface{}, option ...interface{}) *Orm_Observe_Call {
+	return &Orm_Observe_Call{Call: _e.mock.On("Observe",
+		append([]interface{}{model, observer}, option...)...)}
 }
 
-func (_c *Orm_Observe_Call) Run(run func(model interface{}, observer orm.Observer)) *Orm_Observe_Call {
+func (_c *Orm_Observe_Call) Run(run func(model interface{}, observer orm.Observer, option ...orm.ObserveOption)) *Orm_Observe_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(interface{}), args[1].(orm.Observer))
+		variadicArgs := make([]orm.ObserveOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(orm.ObserveOption)
+			}
+		}
+		run(args[0].(interface{}), args[1].(orm.Observer), variadicArgs...)
 	})
 	return _c
 }
@@ -205,7 +220,40 @@ func (_c *Orm_Observe_Call) Return() *Orm_Observe_Call {
 	return _c
 }
 
-func (_c *Orm_Observe_Call) RunAndReturn(run func(interface{}, orm.Observer)) *Orm_Observe_Call {
+func (_c *Orm_Observe_Call) RunAndReturn(run func(interface{}, orm.Observer, ...orm.ObserveOption)) *Orm_Observe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Project provides a mock function with given fields: projection
+func (_m *Orm) Project(projection orm.Projection) {
+	_m.Called(projection)
+}
+
+// Orm_Project_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Project'
+type Orm_Project_Call struct {
+	*mock.Call
+}
+
+// Project is a helper method to define mock.On call
+//   - projection orm.Projection
+func (_e *Orm_Expecter) Project(projection interface{}) *Orm_Project_Call {
+	return &Orm_Project_Call{Call: _e.mock.On("Project", projection)}
+}
+
+func (_c *Orm_Project_Call) Run(run func(projection orm.Projection)) *Orm_Project_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(orm.Projection))
+	})
+	return _c
+}
+
+func (_c *Orm_Project_Call) Return() *Orm_Project_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Orm_Project_Call) RunAndReturn(run func(orm.Projection)) *Orm_Project_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -257,6 +305,52 @@ func (_c *Orm_Query_Call) RunAndReturn(run func() orm.Query) *Orm_Query_Call {
 	return _c
 }
 
+// Retain provides a mock function with given fields: models
+func (_m *Orm) Retain(models ...orm.Retention) {
+	_va := make([]interface{}, len(models))
+	for _i := range models {
+		_va[_i] = models[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// Orm_Retain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retain'
+type Orm_Retain_Call struct {
+	*mock.Call
+}
+
+// Retain is a helper method to define mock.On call
+//   - models ...orm.Retention
+func (_e *Orm_Expecter) Retain(models ...interface{}) *Orm_Retain_Call {
+	return &Orm_Retain_Call{Call: _e.mock.On("Retain",
+		append([]interface{}{}, models...)...)}
+}
+
+func (_c *Orm_Retain_Call) Run(run func(models ...orm.Retention)) *Orm_Retain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]orm.Retention, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(orm.Retention)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Orm_Retain_Call) Return() *Orm_Retain_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Orm_Retain_Call) RunAndReturn(run func(...orm.Retention)) *Orm_Retain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Transaction provides a mock function with given fields: txFunc
 func (_m *Orm) Transaction(txFunc func(orm.Transaction) error) error {
 	ret := _m.Called(txFunc)
@@ -303,6 +397,53 @@ func (_c *Orm_Transaction_Call) RunAndReturn(run func(func(orm.Transaction) erro
 	return _c
 }
 
+// UnitOfWork provides a mock function with given fields:
+func (_m *Orm) UnitOfWork() orm.UnitOfWork {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnitOfWork")
+	}
+
+	var r0 orm.UnitOfWork
+	if rf, ok := ret.Get(0).(func() orm.UnitOfWork); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.UnitOfWork)
+		}
+	}
+
+	return r0
+}
+
+// Orm_UnitOfWork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnitOfWork'
+type Orm_UnitOfWork_Call struct {
+	*mock.Call
+}
+
+// UnitOfWork is a helper method to define mock.On call
+func (_e *Orm_Expecter) UnitOfWork() *Orm_UnitOfWork_Call {
+	return &Orm_UnitOfWork_Call{Call: _e.mock.On("UnitOfWork")}
+}
+
+func (_c *Orm_UnitOfWork_Call) Run(run func()) *Orm_UnitOfWork_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Orm_UnitOfWork_Call) Return(_a0 orm.UnitOfWork) *Orm_UnitOfWork_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Orm_UnitOfWork_Call) RunAndReturn(run func() orm.UnitOfWork) *Orm_UnitOfWork_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithContext provides a mock function with given fields: ctx
 func (_m *Orm) WithContext(ctx context.Context) orm.Orm {
 	ret := _m.Called(ctx)
@@ -0,0 +1,220 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import (
+	orm "github.com/goravel/framework/contracts/database/orm"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Projection is an autogenerated mock type for the Projection type
+type Projection struct {
+	mock.Mock
+}
+
+type Projection_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Projection) EXPECT() *Projection_Expecter {
+	return &Projection_Expecter{mock: &_m.Mock}
+}
+
+// Models provides a mock function with given fields:
+func (_m *Projection) Models() []interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Models")
+	}
+
+	var r0 []interface{}
+	if rf, ok := ret.Get(0).(func() []interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	return r0
+}
+
+// Projection_Models_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Models'
+type Projection_Models_Call struct {
+	*mock.Call
+}
+
+// Models is a helper method to define mock.On call
+func (_e *Projection_Expecter) Models() *Projection_Models_Call {
+	return &Projection_Models_Call{Call: _e.mock.On("Models")}
+}
+
+func (_c *Projection_Models_Call) Run(run func()) *Projection_Models_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Projection_Models_Call) Return(_a0 []interface{}) *Projection_Models_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Projection_Models_Call) RunAndReturn(run func() []interface{}) *Projection_Models_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Project provides a mock function with given fields: event, model
+func (_m *Projection) Project(event orm.EventType, model orm.Event) error {
+	ret := _m.Called(event, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Project")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(orm.EventType, orm.Event) error); ok {
+		r0 = rf(event, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Projection_Project_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Project'
+type Projection_Project_Call struct {
+	*mock.Call
+}
+
+// Project is a helper method to define mock.On call
+//   - event orm.EventType
+//   - model orm.Event
+func (_e *Projection_Expecter) Project(event interface{}, model interface{}) *Projection_Project_Call {
+	return &Projection_Project_Call{Call: _e.mock.On("Project", event, model)}
+}
+
+func (_c *Projection_Project_Call) Run(run func(event orm.EventType, model orm.Event)) *Projection_Project_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(orm.EventType), args[1].(orm.Event))
+	})
+	return _c
+}
+
+func (_c *Projection_Project_Call) Return(_a0 error) *Projection_Project_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Projection_Project_Call) RunAndReturn(run func(orm.EventType, orm.Event) error) *Projection_Project_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rebuild provides a mock function with given fields: query
+func (_m *Projection) Rebuild(query orm.Query) error {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rebuild")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(orm.Query) error); ok {
+		r0 = rf(query)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Projection_Rebuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebuild'
+type Projection_Rebuild_Call struct {
+	*mock.Call
+}
+
+// Rebuild is a helper method to define mock.On call
+//   - query orm.Query
+func (_e *Projection_Expecter) Rebuild(query interface{}) *Projection_Rebuild_Call {
+	return &Projection_Rebuild_Call{Call: _e.mock.On("Rebuild", query)}
+}
+
+func (_c *Projection_Rebuild_Call) Run(run func(query orm.Query)) *Projection_Rebuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(orm.Query))
+	})
+	return _c
+}
+
+func (_c *Projection_Rebuild_Call) Return(_a0 error) *Projection_Rebuild_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Projection_Rebuild_Call) RunAndReturn(run func(orm.Query) error) *Projection_Rebuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Signature provides a mock function with given fields:
+func (_m *Projection) Signature() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Signature")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Projection_Signature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signature'
+type Projection_Signature_Call struct {
+	*mock.Call
+}
+
+// Signature is a helper method to define mock.On call
+func (_e *Projection_Expecter) Signature() *Projection_Signature_Call {
+	return &Projection_Signature_Call{Call: _e.mock.On("Signature")}
+}
+
+func (_c *Projection_Signature_Call) Run(run func()) *Projection_Signature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Projection_Signature_Call) Return(_a0 string) *Projection_Signature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Projection_Signature_Call) RunAndReturn(run func() string) *Projection_Signature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProjection creates a new instance of Projection. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProjection(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Projection {
+	mock := &Projection{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
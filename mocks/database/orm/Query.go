@@ -560,6 +560,69 @@ func (_c *Query_Exists_Call) RunAndReturn(run func(*bool) error) *Query_Exists_C
 	return _c
 }
 
+// ExportTo provides a mock function with given fields: disk, path, format, progress
+func (_m *Query) ExportTo(disk string, path string, format string, progress ...func(int64)) error {
+	_va := make([]interface{}, len(progress))
+	for _i := range progress {
+		_va[_i] = progress[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, disk, path, format)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...func(int64)) error); ok {
+		r0 = rf(disk, path, format, progress...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Query_ExportTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportTo'
+type Query_ExportTo_Call struct {
+	*mock.Call
+}
+
+// ExportTo is a helper method to define mock.On call
+//   - disk string
+//   - path string
+//   - format string
+//   - progress ...func(int64)
+func (_e *Query_Expecter) ExportTo(disk interface{}, path interface{}, format interface{}, progress ...interface{}) *Query_ExportTo_Call {
+	return &Query_ExportTo_Call{Call: _e.mock.On("ExportTo",
+		append([]interface{}{disk, path, format}, progress...)...)}
+}
+
+func (_c *Query_ExportTo_Call) Run(run func(disk string, path string, format string, progress ...func(int64))) *Query_ExportTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(int64), len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(int64))
+			}
+		}
+		run(args[0].(string), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_ExportTo_Call) Return(_a0 error) *Query_ExportTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_ExportTo_Call) RunAndReturn(run func(string, string, string, ...func(int64)) error) *Query_ExportTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Find provides a mock function with given fields: dest, conds
 func (_m *Query) Find(dest interface{}, conds ...interface{}) error {
 	var _ca []interface{}
@@ -997,6 +1060,67 @@ func (_c *Query_ForceDelete_Call) RunAndReturn(run func(interface{}, ...interfac
 	return _c
 }
 
+// ForceIndex provides a mock function with given fields: indexes
+func (_m *Query) ForceIndex(indexes ...string) orm.Query {
+	_va := make([]interface{}, len(indexes))
+	for _i := range indexes {
+		_va[_i] = indexes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceIndex")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func(...string) orm.Query); ok {
+		r0 = rf(indexes...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_ForceIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceIndex'
+type Query_ForceIndex_Call struct {
+	*mock.Call
+}
+
+// ForceIndex is a helper method to define mock.On call
+//   - indexes ...string
+func (_e *Query_Expecter) ForceIndex(indexes ...interface{}) *Query_ForceIndex_Call {
+	return &Query_ForceIndex_Call{Call: _e.mock.On("ForceIndex",
+		append([]interface{}{}, indexes...)...)}
+}
+
+func (_c *Query_ForceIndex_Call) Run(run func(indexes ...string)) *Query_ForceIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_ForceIndex_Call) Return(_a0 orm.Query) *Query_ForceIndex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_ForceIndex_Call) RunAndReturn(run func(...string) orm.Query) *Query_ForceIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: dest
 func (_m *Query) Get(dest interface{}) error {
 	ret := _m.Called(dest)
@@ -1150,6 +1274,67 @@ func (_c *Query_Having_Call) RunAndReturn(run func(interface{}, ...interface{})
 	return _c
 }
 
+// Hint provides a mock function with given fields: hints
+func (_m *Query) Hint(hints ...string) orm.Query {
+	_va := make([]interface{}, len(hints))
+	for _i := range hints {
+		_va[_i] = hints[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hint")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func(...string) orm.Query); ok {
+		r0 = rf(hints...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_Hint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hint'
+type Query_Hint_Call struct {
+	*mock.Call
+}
+
+// Hint is a helper method to define mock.On call
+//   - hints ...string
+func (_e *Query_Expecter) Hint(hints ...interface{}) *Query_Hint_Call {
+	return &Query_Hint_Call{Call: _e.mock.On("Hint",
+		append([]interface{}{}, hints...)...)}
+}
+
+func (_c *Query_Hint_Call) Run(run func(hints ...string)) *Query_Hint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_Hint_Call) Return(_a0 orm.Query) *Query_Hint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_Hint_Call) RunAndReturn(run func(...string) orm.Query) *Query_Hint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InRandomOrder provides a mock function with given fields:
 func (_m *Query) InRandomOrder() orm.Query {
 	ret := _m.Called()
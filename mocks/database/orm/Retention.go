@@ -0,0 +1,80 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import (
+	orm "github.com/goravel/framework/contracts/database/orm"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Retention is an autogenerated mock type for the Retention type
+type Retention struct {
+	mock.Mock
+}
+
+type Retention_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Retention) EXPECT() *Retention_Expecter {
+	return &Retention_Expecter{mock: &_m.Mock}
+}
+
+// RetentionPolicy provides a mock function with given fields:
+func (_m *Retention) RetentionPolicy() orm.RetentionPolicy {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetentionPolicy")
+	}
+
+	var r0 orm.RetentionPolicy
+	if rf, ok := ret.Get(0).(func() orm.RetentionPolicy); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(orm.RetentionPolicy)
+	}
+
+	return r0
+}
+
+// Retention_RetentionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicy'
+type Retention_RetentionPolicy_Call struct {
+	*mock.Call
+}
+
+// RetentionPolicy is a helper method to define mock.On call
+func (_e *Retention_Expecter) RetentionPolicy() *Retention_RetentionPolicy_Call {
+	return &Retention_RetentionPolicy_Call{Call: _e.mock.On("RetentionPolicy")}
+}
+
+func (_c *Retention_RetentionPolicy_Call) Run(run func()) *Retention_RetentionPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Retention_RetentionPolicy_Call) Return(_a0 orm.RetentionPolicy) *Retention_RetentionPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Retention_RetentionPolicy_Call) RunAndReturn(run func() orm.RetentionPolicy) *Retention_RetentionPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRetention creates a new instance of Retention. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRetention(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Retention {
+	mock := &Retention{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,8 @@
 package orm
 
 import (
+	context "context"
+
 	orm "github.com/goravel/framework/contracts/database/orm"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -170,6 +172,53 @@ func (_c *Transaction_Commit_Call) RunAndReturn(run func() error) *Transaction_C
 	return _c
 }
 
+// Context provides a mock function with given fields:
+func (_m *Transaction) Context() context.Context {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Context")
+	}
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_Context_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Context'
+type Transaction_Context_Call struct {
+	*mock.Call
+}
+
+// Context is a helper method to define mock.On call
+func (_e *Transaction_Expecter) Context() *Transaction_Context_Call {
+	return &Transaction_Context_Call{Call: _e.mock.On("Context")}
+}
+
+func (_c *Transaction_Context_Call) Run(run func()) *Transaction_Context_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Transaction_Context_Call) Return(_a0 context.Context) *Transaction_Context_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_Context_Call) RunAndReturn(run func() context.Context) *Transaction_Context_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Count provides a mock function with given fields: count
 func (_m *Transaction) Count(count *int64) error {
 	ret := _m.Called(count)
@@ -605,6 +654,69 @@ func (_c *Transaction_Exists_Call) RunAndReturn(run func(*bool) error) *Transact
 	return _c
 }
 
+// ExportTo provides a mock function with given fields: disk, path, format, progress
+func (_m *Transaction) ExportTo(disk string, path string, format string, progress ...func(int64)) error {
+	_va := make([]interface{}, len(progress))
+	for _i := range progress {
+		_va[_i] = progress[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, disk, path, format)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...func(int64)) error); ok {
+		r0 = rf(disk, path, format, progress...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Transaction_ExportTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportTo'
+type Transaction_ExportTo_Call struct {
+	*mock.Call
+}
+
+// ExportTo is a helper method to define mock.On call
+//   - disk string
+//   - path string
+//   - format string
+//   - progress ...func(int64)
+func (_e *Transaction_Expecter) ExportTo(disk interface{}, path interface{}, format interface{}, progress ...interface{}) *Transaction_ExportTo_Call {
+	return &Transaction_ExportTo_Call{Call: _e.mock.On("ExportTo",
+		append([]interface{}{disk, path, format}, progress...)...)}
+}
+
+func (_c *Transaction_ExportTo_Call) Run(run func(disk string, path string, format string, progress ...func(int64))) *Transaction_ExportTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(int64), len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(int64))
+			}
+		}
+		run(args[0].(string), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Transaction_ExportTo_Call) Return(_a0 error) *Transaction_ExportTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_ExportTo_Call) RunAndReturn(run func(string, string, string, ...func(int64)) error) *Transaction_ExportTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Find provides a mock function with given fields: dest, conds
 func (_m *Transaction) Find(dest interface{}, conds ...interface{}) error {
 	var _ca []interface{}
@@ -1042,6 +1154,67 @@ func (_c *Transaction_ForceDelete_Call) RunAndReturn(run func(interface{}, ...in
 	return _c
 }
 
+// ForceIndex provides a mock function with given fields: indexes
+func (_m *Transaction) ForceIndex(indexes ...string) orm.Query {
+	_va := make([]interface{}, len(indexes))
+	for _i := range indexes {
+		_va[_i] = indexes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceIndex")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func(...string) orm.Query); ok {
+		r0 = rf(indexes...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_ForceIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceIndex'
+type Transaction_ForceIndex_Call struct {
+	*mock.Call
+}
+
+// ForceIndex is a helper method to define mock.On call
+//   - indexes ...string
+func (_e *Transaction_Expecter) ForceIndex(indexes ...interface{}) *Transaction_ForceIndex_Call {
+	return &Transaction_ForceIndex_Call{Call: _e.mock.On("ForceIndex",
+		append([]interface{}{}, indexes...)...)}
+}
+
+func (_c *Transaction_ForceIndex_Call) Run(run func(indexes ...string)) *Transaction_ForceIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Transaction_ForceIndex_Call) Return(_a0 orm.Query) *Transaction_ForceIndex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_ForceIndex_Call) RunAndReturn(run func(...string) orm.Query) *Transaction_ForceIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: dest
 func (_m *Transaction) Get(dest interface{}) error {
 	ret := _m.Called(dest)
@@ -1195,6 +1368,67 @@ func (_c *Transaction_Having_Call) RunAndReturn(run func(interface{}, ...interfa
 	return _c
 }
 
+// Hint provides a mock function with given fields: hints
+func (_m *Transaction) Hint(hints ...string) orm.Query {
+	_va := make([]interface{}, len(hints))
+	for _i := range hints {
+		_va[_i] = hints[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hint")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func(...string) orm.Query); ok {
+		r0 = rf(hints...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_Hint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hint'
+type Transaction_Hint_Call struct {
+	*mock.Call
+}
+
+// Hint is a helper method to define mock.On call
+//   - hints ...string
+func (_e *Transaction_Expecter) Hint(hints ...interface{}) *Transaction_Hint_Call {
+	return &Transaction_Hint_Call{Call: _e.mock.On("Hint",
+		append([]interface{}{}, hints...)...)}
+}
+
+func (_c *Transaction_Hint_Call) Run(run func(hints ...string)) *Transaction_Hint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Transaction_Hint_Call) Return(_a0 orm.Query) *Transaction_Hint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_Hint_Call) RunAndReturn(run func(...string) orm.Query) *Transaction_Hint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InRandomOrder provides a mock function with given fields:
 func (_m *Transaction) InRandomOrder() orm.Query {
 	ret := _m.Called()
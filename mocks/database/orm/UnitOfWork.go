@@ -0,0 +1,301 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import (
+	orm "github.com/goravel/framework/contracts/database/orm"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UnitOfWork is an autogenerated mock type for the UnitOfWork type
+type UnitOfWork struct {
+	mock.Mock
+}
+
+type UnitOfWork_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UnitOfWork) EXPECT() *UnitOfWork_Expecter {
+	return &UnitOfWork_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: value
+func (_m *UnitOfWork) Create(value interface{}) orm.UnitOfWork {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 orm.UnitOfWork
+	if rf, ok := ret.Get(0).(func(interface{}) orm.UnitOfWork); ok {
+		r0 = rf(value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.UnitOfWork)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type UnitOfWork_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - value interface{}
+func (_e *UnitOfWork_Expecter) Create(value interface{}) *UnitOfWork_Create_Call {
+	return &UnitOfWork_Create_Call{Call: _e.mock.On("Create", value)}
+}
+
+func (_c *UnitOfWork_Create_Call) Run(run func(value interface{})) *UnitOfWork_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_Create_Call) Return(_a0 orm.UnitOfWork) *UnitOfWork_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_Create_Call) RunAndReturn(run func(interface{}) orm.UnitOfWork) *UnitOfWork_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: value
+func (_m *UnitOfWork) Delete(value interface{}) orm.UnitOfWork {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 orm.UnitOfWork
+	if rf, ok := ret.Get(0).(func(interface{}) orm.UnitOfWork); ok {
+		r0 = rf(value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.UnitOfWork)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type UnitOfWork_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - value interface{}
+func (_e *UnitOfWork_Expecter) Delete(value interface{}) *UnitOfWork_Delete_Call {
+	return &UnitOfWork_Delete_Call{Call: _e.mock.On("Delete", value)}
+}
+
+func (_c *UnitOfWork_Delete_Call) Run(run func(value interface{})) *UnitOfWork_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_Delete_Call) Return(_a0 orm.UnitOfWork) *UnitOfWork_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_Delete_Call) RunAndReturn(run func(interface{}) orm.UnitOfWork) *UnitOfWork_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Discard provides a mock function with given fields:
+func (_m *UnitOfWork) Discard() {
+	_m.Called()
+}
+
+// UnitOfWork_Discard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Discard'
+type UnitOfWork_Discard_Call struct {
+	*mock.Call
+}
+
+// Discard is a helper method to define mock.On call
+func (_e *UnitOfWork_Expecter) Discard() *UnitOfWork_Discard_Call {
+	return &UnitOfWork_Discard_Call{Call: _e.mock.On("Discard")}
+}
+
+func (_c *UnitOfWork_Discard_Call) Run(run func()) *UnitOfWork_Discard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_Discard_Call) Return() *UnitOfWork_Discard_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UnitOfWork_Discard_Call) RunAndReturn(run func()) *UnitOfWork_Discard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flush provides a mock function with given fields:
+func (_m *UnitOfWork) Flush() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flush")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnitOfWork_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type UnitOfWork_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+func (_e *UnitOfWork_Expecter) Flush() *UnitOfWork_Flush_Call {
+	return &UnitOfWork_Flush_Call{Call: _e.mock.On("Flush")}
+}
+
+func (_c *UnitOfWork_Flush_Call) Run(run func()) *UnitOfWork_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_Flush_Call) Return(_a0 error) *UnitOfWork_Flush_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_Flush_Call) RunAndReturn(run func() error) *UnitOfWork_Flush_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pending provides a mock function with given fields:
+func (_m *UnitOfWork) Pending() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pending")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// UnitOfWork_Pending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pending'
+type UnitOfWork_Pending_Call struct {
+	*mock.Call
+}
+
+// Pending is a helper method to define mock.On call
+func (_e *UnitOfWork_Expecter) Pending() *UnitOfWork_Pending_Call {
+	return &UnitOfWork_Pending_Call{Call: _e.mock.On("Pending")}
+}
+
+func (_c *UnitOfWork_Pending_Call) Run(run func()) *UnitOfWork_Pending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_Pending_Call) Return(_a0 int) *UnitOfWork_Pending_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_Pending_Call) RunAndReturn(run func() int) *UnitOfWork_Pending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: value
+func (_m *UnitOfWork) Update(value interface{}) orm.UnitOfWork {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 orm.UnitOfWork
+	if rf, ok := ret.Get(0).(func(interface{}) orm.UnitOfWork); ok {
+		r0 = rf(value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.UnitOfWork)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UnitOfWork_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - value interface{}
+func (_e *UnitOfWork_Expecter) Update(value interface{}) *UnitOfWork_Update_Call {
+	return &UnitOfWork_Update_Call{Call: _e.mock.On("Update", value)}
+}
+
+func (_c *UnitOfWork_Update_Call) Run(run func(value interface{})) *UnitOfWork_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_Update_Call) Return(_a0 orm.UnitOfWork) *UnitOfWork_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_Update_Call) RunAndReturn(run func(interface{}) orm.UnitOfWork) *UnitOfWork_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUnitOfWork creates a new instance of UnitOfWork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUnitOfWork(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UnitOfWork {
+	mock := &UnitOfWork{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,87 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package event
+
+import mock "github.com/stretchr/testify/mock"
+
+// ShouldPublish is an autogenerated mock type for the ShouldPublish type
+type ShouldPublish struct {
+	mock.Mock
+}
+
+type ShouldPublish_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ShouldPublish) EXPECT() *ShouldPublish_Expecter {
+	return &ShouldPublish_Expecter{mock: &_m.Mock}
+}
+
+// PublishOn provides a mock function with given fields:
+func (_m *ShouldPublish) PublishOn() (string, string) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishOn")
+	}
+
+	var r0 string
+	var r1 string
+	if rf, ok := ret.Get(0).(func() (string, string)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() string); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	return r0, r1
+}
+
+// ShouldPublish_PublishOn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishOn'
+type ShouldPublish_PublishOn_Call struct {
+	*mock.Call
+}
+
+// PublishOn is a helper method to define mock.On call
+func (_e *ShouldPublish_Expecter) PublishOn() *ShouldPublish_PublishOn_Call {
+	return &ShouldPublish_PublishOn_Call{Call: _e.mock.On("PublishOn")}
+}
+
+func (_c *ShouldPublish_PublishOn_Call) Run(run func()) *ShouldPublish_PublishOn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ShouldPublish_PublishOn_Call) Return(connection string, topic string) *ShouldPublish_PublishOn_Call {
+	_c.Call.Return(connection, topic)
+	return _c
+}
+
+func (_c *ShouldPublish_PublishOn_Call) RunAndReturn(run func() (string, string)) *ShouldPublish_PublishOn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShouldPublish creates a new instance of ShouldPublish. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShouldPublish(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShouldPublish {
+	mock := &ShouldPublish{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
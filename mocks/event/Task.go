@@ -2,7 +2,12 @@
 
 package event
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	event "github.com/goravel/framework/contracts/event"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Task is an autogenerated mock type for the Task type
 type Task struct {
@@ -17,6 +22,100 @@ func (_m *Task) EXPECT() *Task_Expecter {
 	return &Task_Expecter{mock: &_m.Mock}
 }
 
+// AfterCommit provides a mock function with given fields:
+func (_m *Task) AfterCommit() event.Task {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterCommit")
+	}
+
+	var r0 event.Task
+	if rf, ok := ret.Get(0).(func() event.Task); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(event.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_AfterCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterCommit'
+type Task_AfterCommit_Call struct {
+	*mock.Call
+}
+
+// AfterCommit is a helper method to define mock.On call
+func (_e *Task_Expecter) AfterCommit() *Task_AfterCommit_Call {
+	return &Task_AfterCommit_Call{Call: _e.mock.On("AfterCommit")}
+}
+
+func (_c *Task_AfterCommit_Call) Run(run func()) *Task_AfterCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Task_AfterCommit_Call) Return(_a0 event.Task) *Task_AfterCommit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_AfterCommit_Call) RunAndReturn(run func() event.Task) *Task_AfterCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeforeCommit provides a mock function with given fields:
+func (_m *Task) BeforeCommit() event.Task {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeforeCommit")
+	}
+
+	var r0 event.Task
+	if rf, ok := ret.Get(0).(func() event.Task); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(event.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_BeforeCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeforeCommit'
+type Task_BeforeCommit_Call struct {
+	*mock.Call
+}
+
+// BeforeCommit is a helper method to define mock.On call
+func (_e *Task_Expecter) BeforeCommit() *Task_BeforeCommit_Call {
+	return &Task_BeforeCommit_Call{Call: _e.mock.On("BeforeCommit")}
+}
+
+func (_c *Task_BeforeCommit_Call) Run(run func()) *Task_BeforeCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Task_BeforeCommit_Call) Return(_a0 event.Task) *Task_BeforeCommit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_BeforeCommit_Call) RunAndReturn(run func() event.Task) *Task_BeforeCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Dispatch provides a mock function with given fields:
 func (_m *Task) Dispatch() error {
 	ret := _m.Called()
@@ -62,6 +161,54 @@ func (_c *Task_Dispatch_Call) RunAndReturn(run func() error) *Task_Dispatch_Call
 	return _c
 }
 
+// WithContext provides a mock function with given fields: ctx
+func (_m *Task) WithContext(ctx context.Context) event.Task {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 event.Task
+	if rf, ok := ret.Get(0).(func(context.Context) event.Task); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(event.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type Task_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Task_Expecter) WithContext(ctx interface{}) *Task_WithContext_Call {
+	return &Task_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *Task_WithContext_Call) Run(run func(ctx context.Context)) *Task_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Task_WithContext_Call) Return(_a0 event.Task) *Task_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_WithContext_Call) RunAndReturn(run func(context.Context) event.Task) *Task_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTask creates a new instance of Task. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTask(t interface {
@@ -0,0 +1,264 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package feature
+
+import (
+	context "context"
+
+	feature "github.com/goravel/framework/contracts/feature"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Feature is an autogenerated mock type for the Feature type
+type Feature struct {
+	mock.Mock
+}
+
+type Feature_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Feature) EXPECT() *Feature_Expecter {
+	return &Feature_Expecter{mock: &_m.Mock}
+}
+
+// Activate provides a mock function with given fields: name
+func (_m *Feature) Activate(name string) {
+	_m.Called(name)
+}
+
+// Feature_Activate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Activate'
+type Feature_Activate_Call struct {
+	*mock.Call
+}
+
+// Activate is a helper method to define mock.On call
+//   - name string
+func (_e *Feature_Expecter) Activate(name interface{}) *Feature_Activate_Call {
+	return &Feature_Activate_Call{Call: _e.mock.On("Activate", name)}
+}
+
+func (_c *Feature_Activate_Call) Run(run func(name string)) *Feature_Activate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Feature_Activate_Call) Return() *Feature_Activate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Feature_Activate_Call) RunAndReturn(run func(string)) *Feature_Activate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Active provides a mock function with given fields: ctx, name
+func (_m *Feature) Active(ctx context.Context, name string) bool {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Active")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Feature_Active_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Active'
+type Feature_Active_Call struct {
+	*mock.Call
+}
+
+// Active is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *Feature_Expecter) Active(ctx interface{}, name interface{}) *Feature_Active_Call {
+	return &Feature_Active_Call{Call: _e.mock.On("Active", ctx, name)}
+}
+
+func (_c *Feature_Active_Call) Run(run func(ctx context.Context, name string)) *Feature_Active_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Feature_Active_Call) Return(_a0 bool) *Feature_Active_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Feature_Active_Call) RunAndReturn(run func(context.Context, string) bool) *Feature_Active_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deactivate provides a mock function with given fields: name
+func (_m *Feature) Deactivate(name string) {
+	_m.Called(name)
+}
+
+// Feature_Deactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deactivate'
+type Feature_Deactivate_Call struct {
+	*mock.Call
+}
+
+// Deactivate is a helper method to define mock.On call
+//   - name string
+func (_e *Feature_Expecter) Deactivate(name interface{}) *Feature_Deactivate_Call {
+	return &Feature_Deactivate_Call{Call: _e.mock.On("Deactivate", name)}
+}
+
+func (_c *Feature_Deactivate_Call) Run(run func(name string)) *Feature_Deactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Feature_Deactivate_Call) Return() *Feature_Deactivate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Feature_Deactivate_Call) RunAndReturn(run func(string)) *Feature_Deactivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Define provides a mock function with given fields: name, resolver
+func (_m *Feature) Define(name string, resolver feature.Resolver) {
+	_m.Called(name, resolver)
+}
+
+// Feature_Define_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Define'
+type Feature_Define_Call struct {
+	*mock.Call
+}
+
+// Define is a helper method to define mock.On call
+//   - name string
+//   - resolver feature.Resolver
+func (_e *Feature_Expecter) Define(name interface{}, resolver interface{}) *Feature_Define_Call {
+	return &Feature_Define_Call{Call: _e.mock.On("Define", name, resolver)}
+}
+
+func (_c *Feature_Define_Call) Run(run func(name string, resolver feature.Resolver)) *Feature_Define_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(feature.Resolver))
+	})
+	return _c
+}
+
+func (_c *Feature_Define_Call) Return() *Feature_Define_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Feature_Define_Call) RunAndReturn(run func(string, feature.Resolver)) *Feature_Define_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Forget provides a mock function with given fields: name
+func (_m *Feature) Forget(name string) {
+	_m.Called(name)
+}
+
+// Feature_Forget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forget'
+type Feature_Forget_Call struct {
+	*mock.Call
+}
+
+// Forget is a helper method to define mock.On call
+//   - name string
+func (_e *Feature_Expecter) Forget(name interface{}) *Feature_Forget_Call {
+	return &Feature_Forget_Call{Call: _e.mock.On("Forget", name)}
+}
+
+func (_c *Feature_Forget_Call) Run(run func(name string)) *Feature_Forget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Feature_Forget_Call) Return() *Feature_Forget_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Feature_Forget_Call) RunAndReturn(run func(string)) *Feature_Forget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Inactive provides a mock function with given fields: ctx, name
+func (_m *Feature) Inactive(ctx context.Context, name string) bool {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Inactive")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Feature_Inactive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Inactive'
+type Feature_Inactive_Call struct {
+	*mock.Call
+}
+
+// Inactive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *Feature_Expecter) Inactive(ctx interface{}, name interface{}) *Feature_Inactive_Call {
+	return &Feature_Inactive_Call{Call: _e.mock.On("Inactive", ctx, name)}
+}
+
+func (_c *Feature_Inactive_Call) Run(run func(ctx context.Context, name string)) *Feature_Inactive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Feature_Inactive_Call) Return(_a0 bool) *Feature_Inactive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Feature_Inactive_Call) RunAndReturn(run func(context.Context, string) bool) *Feature_Inactive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeature creates a new instance of Feature. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeature(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Feature {
+	mock := &Feature{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package feature
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Resolver is an autogenerated mock type for the Resolver type
+type Resolver struct {
+	mock.Mock
+}
+
+type Resolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Resolver) EXPECT() *Resolver_Expecter {
+	return &Resolver_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx
+func (_m *Resolver) Execute(ctx context.Context) bool {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Resolver_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Resolver_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Resolver_Expecter) Execute(ctx interface{}) *Resolver_Execute_Call {
+	return &Resolver_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *Resolver_Execute_Call) Run(run func(ctx context.Context)) *Resolver_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Resolver_Execute_Call) Return(_a0 bool) *Resolver_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Resolver_Execute_Call) RunAndReturn(run func(context.Context) bool) *Resolver_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResolver creates a new instance of Resolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Resolver {
+	mock := &Resolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
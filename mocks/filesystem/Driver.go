@@ -350,6 +350,62 @@ func (_c *Driver_Directories_Call) RunAndReturn(run func(string) ([]string, erro
 	return _c
 }
 
+// ETag provides a mock function with given fields: file
+func (_m *Driver) ETag(file string) (string, error) {
+	ret := _m.Called(file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ETag")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(file)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(file)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Driver_ETag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ETag'
+type Driver_ETag_Call struct {
+	*mock.Call
+}
+
+// ETag is a helper method to define mock.On call
+//   - file string
+func (_e *Driver_Expecter) ETag(file interface{}) *Driver_ETag_Call {
+	return &Driver_ETag_Call{Call: _e.mock.On("ETag", file)}
+}
+
+func (_c *Driver_ETag_Call) Run(run func(file string)) *Driver_ETag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Driver_ETag_Call) Return(_a0 string, _a1 error) *Driver_ETag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Driver_ETag_Call) RunAndReturn(run func(string) (string, error)) *Driver_ETag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Exists provides a mock function with given fields: file
 func (_m *Driver) Exists(file string) bool {
 	ret := _m.Called(file)
@@ -624,6 +680,64 @@ func (_c *Driver_LastModified_Call) RunAndReturn(run func(string) (time.Time, er
 	return _c
 }
 
+// Lock provides a mock function with given fields: file
+func (_m *Driver) Lock(file string) (func() error, error) {
+	ret := _m.Called(file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 func() error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (func() error, error)); ok {
+		return rf(file)
+	}
+	if rf, ok := ret.Get(0).(func(string) func() error); ok {
+		r0 = rf(file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func() error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Driver_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type Driver_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - file string
+func (_e *Driver_Expecter) Lock(file interface{}) *Driver_Lock_Call {
+	return &Driver_Lock_Call{Call: _e.mock.On("Lock", file)}
+}
+
+func (_c *Driver_Lock_Call) Run(run func(file string)) *Driver_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Driver_Lock_Call) Return(unlock func() error, err error) *Driver_Lock_Call {
+	_c.Call.Return(unlock, err)
+	return _c
+}
+
+func (_c *Driver_Lock_Call) RunAndReturn(run func(string) (func() error, error)) *Driver_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeDirectory provides a mock function with given fields: directory
 func (_m *Driver) MakeDirectory(directory string) error {
 	ret := _m.Called(directory)
@@ -1027,6 +1141,101 @@ func (_c *Driver_PutFileAs_Call) RunAndReturn(run func(string, filesystem.File,
 	return _c
 }
 
+// PutIfAbsent provides a mock function with given fields: file, content
+func (_m *Driver) PutIfAbsent(file string, content string) error {
+	ret := _m.Called(file, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutIfAbsent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(file, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Driver_PutIfAbsent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutIfAbsent'
+type Driver_PutIfAbsent_Call struct {
+	*mock.Call
+}
+
+// PutIfAbsent is a helper method to define mock.On call
+//   - file string
+//   - content string
+func (_e *Driver_Expecter) PutIfAbsent(file interface{}, content interface{}) *Driver_PutIfAbsent_Call {
+	return &Driver_PutIfAbsent_Call{Call: _e.mock.On("PutIfAbsent", file, content)}
+}
+
+func (_c *Driver_PutIfAbsent_Call) Run(run func(file string, content string)) *Driver_PutIfAbsent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Driver_PutIfAbsent_Call) Return(_a0 error) *Driver_PutIfAbsent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Driver_PutIfAbsent_Call) RunAndReturn(run func(string, string) error) *Driver_PutIfAbsent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutIfMatch provides a mock function with given fields: file, content, etag
+func (_m *Driver) PutIfMatch(file string, content string, etag string) error {
+	ret := _m.Called(file, content, etag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutIfMatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(file, content, etag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Driver_PutIfMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutIfMatch'
+type Driver_PutIfMatch_Call struct {
+	*mock.Call
+}
+
+// PutIfMatch is a helper method to define mock.On call
+//   - file string
+//   - content string
+//   - etag string
+func (_e *Driver_Expecter) PutIfMatch(file interface{}, content interface{}, etag interface{}) *Driver_PutIfMatch_Call {
+	return &Driver_PutIfMatch_Call{Call: _e.mock.On("PutIfMatch", file, content, etag)}
+}
+
+func (_c *Driver_PutIfMatch_Call) Run(run func(file string, content string, etag string)) *Driver_PutIfMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Driver_PutIfMatch_Call) Return(_a0 error) *Driver_PutIfMatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Driver_PutIfMatch_Call) RunAndReturn(run func(string, string, string) error) *Driver_PutIfMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Size provides a mock function with given fields: file
 func (_m *Driver) Size(file string) (int64, error) {
 	ret := _m.Called(file)
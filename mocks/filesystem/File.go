@@ -597,6 +597,67 @@ func (_c *File_StoreAs_Call) RunAndReturn(run func(string, string) (string, erro
 	return _c
 }
 
+// Validate provides a mock function with given fields: maxSize, mimeTypes
+func (_m *File) Validate(maxSize int64, mimeTypes ...string) error {
+	_va := make([]interface{}, len(mimeTypes))
+	for _i := range mimeTypes {
+		_va[_i] = mimeTypes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, maxSize)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, ...string) error); ok {
+		r0 = rf(maxSize, mimeTypes...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// File_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type File_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - maxSize int64
+//   - mimeTypes ...string
+func (_e *File_Expecter) Validate(maxSize interface{}, mimeTypes ...interface{}) *File_Validate_Call {
+	return &File_Validate_Call{Call: _e.mock.On("Validate",
+		append([]interface{}{maxSize}, mimeTypes...)...)}
+}
+
+func (_c *File_Validate_Call) Run(run func(maxSize int64, mimeTypes ...string)) *File_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *File_Validate_Call) Return(_a0 error) *File_Validate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *File_Validate_Call) RunAndReturn(run func(int64, ...string) error) *File_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFile creates a new instance of File. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFile(t interface {
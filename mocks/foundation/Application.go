@@ -8,6 +8,8 @@ import (
 
 	cache "github.com/goravel/framework/contracts/cache"
 
+	client "github.com/goravel/framework/contracts/http/client"
+
 	config "github.com/goravel/framework/contracts/config"
 
 	console "github.com/goravel/framework/contracts/console"
@@ -18,6 +20,8 @@ import (
 
 	event "github.com/goravel/framework/contracts/event"
 
+	feature "github.com/goravel/framework/contracts/feature"
+
 	filesystem "github.com/goravel/framework/contracts/filesystem"
 
 	foundation "github.com/goravel/framework/contracts/foundation"
@@ -26,8 +30,12 @@ import (
 
 	hash "github.com/goravel/framework/contracts/hash"
 
+	health "github.com/goravel/framework/contracts/health"
+
 	http "github.com/goravel/framework/contracts/http"
 
+	id "github.com/goravel/framework/contracts/id"
+
 	log "github.com/goravel/framework/contracts/log"
 
 	mail "github.com/goravel/framework/contracts/mail"
@@ -53,6 +61,8 @@ import (
 	translation "github.com/goravel/framework/contracts/translation"
 
 	validation "github.com/goravel/framework/contracts/validation"
+
+	websocket "github.com/goravel/framework/contracts/websocket"
 )
 
 // Application is an autogenerated mock type for the Application type
@@ -68,6 +78,40 @@ func (_m *Application) EXPECT() *Application_Expecter {
 	return &Application_Expecter{mock: &_m.Mock}
 }
 
+// About provides a mock function with given fields: section, items
+func (_m *Application) About(section string, items []foundation.AboutItem) {
+	_m.Called(section, items)
+}
+
+// Application_About_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'About'
+type Application_About_Call struct {
+	*mock.Call
+}
+
+// About is a helper method to define mock.On call
+//   - section string
+//   - items []foundation.AboutItem
+func (_e *Application_Expecter) About(section interface{}, items interface{}) *Application_About_Call {
+	return &Application_About_Call{Call: _e.mock.On("About", section, items)}
+}
+
+func (_c *Application_About_Call) Run(run func(section string, items []foundation.AboutItem)) *Application_About_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]foundation.AboutItem))
+	})
+	return _c
+}
+
+func (_c *Application_About_Call) Return() *Application_About_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Application_About_Call) RunAndReturn(run func(string, []foundation.AboutItem)) *Application_About_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BasePath provides a mock function with given fields: path
 func (_m *Application) BasePath(path ...string) string {
 	_va := make([]interface{}, len(path))
@@ -1011,6 +1055,53 @@ func (_c *Application_MakeEvent_Call) RunAndReturn(run func() event.Instance) *A
 	return _c
 }
 
+// MakeFeature provides a mock function with given fields:
+func (_m *Application) MakeFeature() feature.Feature {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeFeature")
+	}
+
+	var r0 feature.Feature
+	if rf, ok := ret.Get(0).(func() feature.Feature); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(feature.Feature)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeFeature'
+type Application_MakeFeature_Call struct {
+	*mock.Call
+}
+
+// MakeFeature is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeFeature() *Application_MakeFeature_Call {
+	return &Application_MakeFeature_Call{Call: _e.mock.On("MakeFeature")}
+}
+
+func (_c *Application_MakeFeature_Call) Run(run func()) *Application_MakeFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeFeature_Call) Return(_a0 feature.Feature) *Application_MakeFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeFeature_Call) RunAndReturn(run func() feature.Feature) *Application_MakeFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeGate provides a mock function with given fields:
 func (_m *Application) MakeGate() access.Gate {
 	ret := _m.Called()
@@ -1152,6 +1243,147 @@ func (_c *Application_MakeHash_Call) RunAndReturn(run func() hash.Hash) *Applica
 	return _c
 }
 
+// MakeHealth provides a mock function with given fields:
+func (_m *Application) MakeHealth() health.Health {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeHealth")
+	}
+
+	var r0 health.Health
+	if rf, ok := ret.Get(0).(func() health.Health); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(health.Health)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeHealth'
+type Application_MakeHealth_Call struct {
+	*mock.Call
+}
+
+// MakeHealth is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeHealth() *Application_MakeHealth_Call {
+	return &Application_MakeHealth_Call{Call: _e.mock.On("MakeHealth")}
+}
+
+func (_c *Application_MakeHealth_Call) Run(run func()) *Application_MakeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeHealth_Call) Return(_a0 health.Health) *Application_MakeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeHealth_Call) RunAndReturn(run func() health.Health) *Application_MakeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakeHttp provides a mock function with given fields:
+func (_m *Application) MakeHttp() client.Factory {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeHttp")
+	}
+
+	var r0 client.Factory
+	if rf, ok := ret.Get(0).(func() client.Factory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeHttp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeHttp'
+type Application_MakeHttp_Call struct {
+	*mock.Call
+}
+
+// MakeHttp is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeHttp() *Application_MakeHttp_Call {
+	return &Application_MakeHttp_Call{Call: _e.mock.On("MakeHttp")}
+}
+
+func (_c *Application_MakeHttp_Call) Run(run func()) *Application_MakeHttp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeHttp_Call) Return(_a0 client.Factory) *Application_MakeHttp_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeHttp_Call) RunAndReturn(run func() client.Factory) *Application_MakeHttp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakeID provides a mock function with given fields:
+func (_m *Application) MakeID() id.ID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeID")
+	}
+
+	var r0 id.ID
+	if rf, ok := ret.Get(0).(func() id.ID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(id.ID)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeID'
+type Application_MakeID_Call struct {
+	*mock.Call
+}
+
+// MakeID is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeID() *Application_MakeID_Call {
+	return &Application_MakeID_Call{Call: _e.mock.On("MakeID")}
+}
+
+func (_c *Application_MakeID_Call) Run(run func()) *Application_MakeID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeID_Call) Return(_a0 id.ID) *Application_MakeID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeID_Call) RunAndReturn(run func() id.ID) *Application_MakeID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeLang provides a mock function with given fields: ctx
 func (_m *Application) MakeLang(ctx context.Context) translation.Translator {
 	ret := _m.Called(ctx)
@@ -1435,6 +1667,53 @@ func (_c *Application_MakeRateLimiter_Call) RunAndReturn(run func() http.RateLim
 	return _c
 }
 
+// MakeResponseTransformer provides a mock function with given fields:
+func (_m *Application) MakeResponseTransformer() http.ResponseTransformer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeResponseTransformer")
+	}
+
+	var r0 http.ResponseTransformer
+	if rf, ok := ret.Get(0).(func() http.ResponseTransformer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.ResponseTransformer)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeResponseTransformer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeResponseTransformer'
+type Application_MakeResponseTransformer_Call struct {
+	*mock.Call
+}
+
+// MakeResponseTransformer is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeResponseTransformer() *Application_MakeResponseTransformer_Call {
+	return &Application_MakeResponseTransformer_Call{Call: _e.mock.On("MakeResponseTransformer")}
+}
+
+func (_c *Application_MakeResponseTransformer_Call) Run(run func()) *Application_MakeResponseTransformer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeResponseTransformer_Call) Return(_a0 http.ResponseTransformer) *Application_MakeResponseTransformer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeResponseTransformer_Call) RunAndReturn(run func() http.ResponseTransformer) *Application_MakeResponseTransformer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeRoute provides a mock function with given fields:
 func (_m *Application) MakeRoute() route.Route {
 	ret := _m.Called()
@@ -1764,6 +2043,53 @@ func (_c *Application_MakeTesting_Call) RunAndReturn(run func() testing.Testing)
 	return _c
 }
 
+// MakeURL provides a mock function with given fields:
+func (_m *Application) MakeURL() route.URL {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeURL")
+	}
+
+	var r0 route.URL
+	if rf, ok := ret.Get(0).(func() route.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.URL)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeURL'
+type Application_MakeURL_Call struct {
+	*mock.Call
+}
+
+// MakeURL is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeURL() *Application_MakeURL_Call {
+	return &Application_MakeURL_Call{Call: _e.mock.On("MakeURL")}
+}
+
+func (_c *Application_MakeURL_Call) Run(run func()) *Application_MakeURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeURL_Call) Return(_a0 route.URL) *Application_MakeURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeURL_Call) RunAndReturn(run func() route.URL) *Application_MakeURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeValidation provides a mock function with given fields:
 func (_m *Application) MakeValidation() validation.Validation {
 	ret := _m.Called()
@@ -1858,6 +2184,53 @@ func (_c *Application_MakeView_Call) RunAndReturn(run func() http.View) *Applica
 	return _c
 }
 
+// MakeWebSocket provides a mock function with given fields:
+func (_m *Application) MakeWebSocket() websocket.Hub {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeWebSocket")
+	}
+
+	var r0 websocket.Hub
+	if rf, ok := ret.Get(0).(func() websocket.Hub); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(websocket.Hub)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeWebSocket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeWebSocket'
+type Application_MakeWebSocket_Call struct {
+	*mock.Call
+}
+
+// MakeWebSocket is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeWebSocket() *Application_MakeWebSocket_Call {
+	return &Application_MakeWebSocket_Call{Call: _e.mock.On("MakeWebSocket")}
+}
+
+func (_c *Application_MakeWebSocket_Call) Run(run func()) *Application_MakeWebSocket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeWebSocket_Call) Return(_a0 websocket.Hub) *Application_MakeWebSocket_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeWebSocket_Call) RunAndReturn(run func() websocket.Hub) *Application_MakeWebSocket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeWith provides a mock function with given fields: key, parameters
 func (_m *Application) MakeWith(key interface{}, parameters map[string]interface{}) (interface{}, error) {
 	ret := _m.Called(key, parameters)
@@ -1917,6 +2290,55 @@ func (_c *Application_MakeWith_Call) RunAndReturn(run func(interface{}, map[stri
 	return _c
 }
 
+// Optimizes provides a mock function with given fields: optimize, clear, key
+func (_m *Application) Optimizes(optimize string, clear string, key ...string) {
+	_va := make([]interface{}, len(key))
+	for _i := range key {
+		_va[_i] = key[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, optimize, clear)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// Application_Optimizes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Optimizes'
+type Application_Optimizes_Call struct {
+	*mock.Call
+}
+
+// Optimizes is a helper method to define mock.On call
+//   - optimize string
+//   - clear string
+//   - key ...string
+func (_e *Application_Expecter) Optimizes(optimize interface{}, clear interface{}, key ...interface{}) *Application_Optimizes_Call {
+	return &Application_Optimizes_Call{Call: _e.mock.On("Optimizes",
+		append([]interface{}{optimize, clear}, key...)...)}
+}
+
+func (_c *Application_Optimizes_Call) Run(run func(optimize string, clear string, key ...string)) *Application_Optimizes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Application_Optimizes_Call) Return() *Application_Optimizes_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Application_Optimizes_Call) RunAndReturn(run func(string, string, ...string)) *Application_Optimizes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Path provides a mock function with given fields: path
 func (_m *Application) Path(path ...string) string {
 	_va := make([]interface{}, len(path))
@@ -2166,6 +2588,52 @@ func (_c *Application_SetLocale_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// Shutdown provides a mock function with given fields: ctx
+func (_m *Application) Shutdown(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Shutdown")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type Application_Shutdown_Call struct {
+	*mock.Call
+}
+
+// Shutdown is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Application_Expecter) Shutdown(ctx interface{}) *Application_Shutdown_Call {
+	return &Application_Shutdown_Call{Call: _e.mock.On("Shutdown", ctx)}
+}
+
+func (_c *Application_Shutdown_Call) Run(run func(ctx context.Context)) *Application_Shutdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Application_Shutdown_Call) Return(_a0 error) *Application_Shutdown_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_Shutdown_Call) RunAndReturn(run func(context.Context) error) *Application_Shutdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Singleton provides a mock function with given fields: key, callback
 func (_m *Application) Singleton(key interface{}, callback func(foundation.Application) (interface{}, error)) {
 	_m.Called(key, callback)
@@ -8,6 +8,8 @@ import (
 
 	cache "github.com/goravel/framework/contracts/cache"
 
+	client "github.com/goravel/framework/contracts/http/client"
+
 	config "github.com/goravel/framework/contracts/config"
 
 	console "github.com/goravel/framework/contracts/console"
@@ -18,6 +20,8 @@ import (
 
 	event "github.com/goravel/framework/contracts/event"
 
+	feature "github.com/goravel/framework/contracts/feature"
+
 	filesystem "github.com/goravel/framework/contracts/filesystem"
 
 	foundation "github.com/goravel/framework/contracts/foundation"
@@ -26,8 +30,12 @@ import (
 
 	hash "github.com/goravel/framework/contracts/hash"
 
+	health "github.com/goravel/framework/contracts/health"
+
 	http "github.com/goravel/framework/contracts/http"
 
+	id "github.com/goravel/framework/contracts/id"
+
 	log "github.com/goravel/framework/contracts/log"
 
 	mail "github.com/goravel/framework/contracts/mail"
@@ -53,6 +61,8 @@ import (
 	translation "github.com/goravel/framework/contracts/translation"
 
 	validation "github.com/goravel/framework/contracts/validation"
+
+	websocket "github.com/goravel/framework/contracts/websocket"
 )
 
 // Container is an autogenerated mock type for the Container type
@@ -511,6 +521,53 @@ func (_c *Container_MakeEvent_Call) RunAndReturn(run func() event.Instance) *Con
 	return _c
 }
 
+// MakeFeature provides a mock function with given fields:
+func (_m *Container) MakeFeature() feature.Feature {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeFeature")
+	}
+
+	var r0 feature.Feature
+	if rf, ok := ret.Get(0).(func() feature.Feature); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(feature.Feature)
+		}
+	}
+
+	return r0
+}
+
+// Container_MakeFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeFeature'
+type Container_MakeFeature_Call struct {
+	*mock.Call
+}
+
+// MakeFeature is a helper method to define mock.On call
+func (_e *Container_Expecter) MakeFeature() *Container_MakeFeature_Call {
+	return &Container_MakeFeature_Call{Call: _e.mock.On("MakeFeature")}
+}
+
+func (_c *Container_MakeFeature_Call) Run(run func()) *Container_MakeFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Container_MakeFeature_Call) Return(_a0 feature.Feature) *Container_MakeFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Container_MakeFeature_Call) RunAndReturn(run func() feature.Feature) *Container_MakeFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeGate provides a mock function with given fields:
 func (_m *Container) MakeGate() access.Gate {
 	ret := _m.Called()
@@ -652,6 +709,147 @@ func (_c *Container_MakeHash_Call) RunAndReturn(run func() hash.Hash) *Container
 	return _c
 }
 
+// MakeHealth provides a mock function with given fields:
+func (_m *Container) MakeHealth() health.Health {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeHealth")
+	}
+
+	var r0 health.Health
+	if rf, ok := ret.Get(0).(func() health.Health); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(health.Health)
+		}
+	}
+
+	return r0
+}
+
+// Container_MakeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeHealth'
+type Container_MakeHealth_Call struct {
+	*mock.Call
+}
+
+// MakeHealth is a helper method to define mock.On call
+func (_e *Container_Expecter) MakeHealth() *Container_MakeHealth_Call {
+	return &Container_MakeHealth_Call{Call: _e.mock.On("MakeHealth")}
+}
+
+func (_c *Container_MakeHealth_Call) Run(run func()) *Container_MakeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Container_MakeHealth_Call) Return(_a0 health.Health) *Container_MakeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Container_MakeHealth_Call) RunAndReturn(run func() health.Health) *Container_MakeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakeHttp provides a mock function with given fields:
+func (_m *Container) MakeHttp() client.Factory {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeHttp")
+	}
+
+	var r0 client.Factory
+	if rf, ok := ret.Get(0).(func() client.Factory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Container_MakeHttp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeHttp'
+type Container_MakeHttp_Call struct {
+	*mock.Call
+}
+
+// MakeHttp is a helper method to define mock.On call
+func (_e *Container_Expecter) MakeHttp() *Container_MakeHttp_Call {
+	return &Container_MakeHttp_Call{Call: _e.mock.On("MakeHttp")}
+}
+
+func (_c *Container_MakeHttp_Call) Run(run func()) *Container_MakeHttp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Container_MakeHttp_Call) Return(_a0 client.Factory) *Container_MakeHttp_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Container_MakeHttp_Call) RunAndReturn(run func() client.Factory) *Container_MakeHttp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakeID provides a mock function with given fields:
+func (_m *Container) MakeID() id.ID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeID")
+	}
+
+	var r0 id.ID
+	if rf, ok := ret.Get(0).(func() id.ID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(id.ID)
+		}
+	}
+
+	return r0
+}
+
+// Container_MakeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeID'
+type Container_MakeID_Call struct {
+	*mock.Call
+}
+
+// MakeID is a helper method to define mock.On call
+func (_e *Container_Expecter) MakeID() *Container_MakeID_Call {
+	return &Container_MakeID_Call{Call: _e.mock.On("MakeID")}
+}
+
+func (_c *Container_MakeID_Call) Run(run func()) *Container_MakeID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Container_MakeID_Call) Return(_a0 id.ID) *Container_MakeID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Container_MakeID_Call) RunAndReturn(run func() id.ID) *Container_MakeID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeLang provides a mock function with given fields: ctx
 func (_m *Container) MakeLang(ctx context.Context) translation.Translator {
 	ret := _m.Called(ctx)
@@ -935,6 +1133,53 @@ func (_c *Container_MakeRateLimiter_Call) RunAndReturn(run func() http.RateLimit
 	return _c
 }
 
+// MakeResponseTransformer provides a mock function with given fields:
+func (_m *Container) MakeResponseTransformer() http.ResponseTransformer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeResponseTransformer")
+	}
+
+	var r0 http.ResponseTransformer
+	if rf, ok := ret.Get(0).(func() http.ResponseTransformer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.ResponseTransformer)
+		}
+	}
+
+	return r0
+}
+
+// Container_MakeResponseTransformer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeResponseTransformer'
+type Container_MakeResponseTransformer_Call struct {
+	*mock.Call
+}
+
+// MakeResponseTransformer is a helper method to define mock.On call
+func (_e *Container_Expecter) MakeResponseTransformer() *Container_MakeResponseTransformer_Call {
+	return &Container_MakeResponseTransformer_Call{Call: _e.mock.On("MakeResponseTransformer")}
+}
+
+func (_c *Container_MakeResponseTransformer_Call) Run(run func()) *Container_MakeResponseTransformer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Container_MakeResponseTransformer_Call) Return(_a0 http.ResponseTransformer) *Container_MakeResponseTransformer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Container_MakeResponseTransformer_Call) RunAndReturn(run func() http.ResponseTransformer) *Container_MakeResponseTransformer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeRoute provides a mock function with given fields:
 func (_m *Container) MakeRoute() route.Route {
 	ret := _m.Called()
@@ -1264,6 +1509,53 @@ func (_c *Container_MakeTesting_Call) RunAndReturn(run func() testing.Testing) *
 	return _c
 }
 
+// MakeURL provides a mock function with given fields:
+func (_m *Container) MakeURL() route.URL {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeURL")
+	}
+
+	var r0 route.URL
+	if rf, ok := ret.Get(0).(func() route.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.URL)
+		}
+	}
+
+	return r0
+}
+
+// Container_MakeURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeURL'
+type Container_MakeURL_Call struct {
+	*mock.Call
+}
+
+// MakeURL is a helper method to define mock.On call
+func (_e *Container_Expecter) MakeURL() *Container_MakeURL_Call {
+	return &Container_MakeURL_Call{Call: _e.mock.On("MakeURL")}
+}
+
+func (_c *Container_MakeURL_Call) Run(run func()) *Container_MakeURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Container_MakeURL_Call) Return(_a0 route.URL) *Container_MakeURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Container_MakeURL_Call) RunAndReturn(run func() route.URL) *Container_MakeURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeValidation provides a mock function with given fields:
 func (_m *Container) MakeValidation() validation.Validation {
 	ret := _m.Called()
@@ -1358,6 +1650,53 @@ func (_c *Container_MakeView_Call) RunAndReturn(run func() http.View) *Container
 	return _c
 }
 
+// MakeWebSocket provides a mock function with given fields:
+func (_m *Container) MakeWebSocket() websocket.Hub {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeWebSocket")
+	}
+
+	var r0 websocket.Hub
+	if rf, ok := ret.Get(0).(func() websocket.Hub); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(websocket.Hub)
+		}
+	}
+
+	return r0
+}
+
+// Container_MakeWebSocket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeWebSocket'
+type Container_MakeWebSocket_Call struct {
+	*mock.Call
+}
+
+// MakeWebSocket is a helper method to define mock.On call
+func (_e *Container_Expecter) MakeWebSocket() *Container_MakeWebSocket_Call {
+	return &Container_MakeWebSocket_Call{Call: _e.mock.On("MakeWebSocket")}
+}
+
+func (_c *Container_MakeWebSocket_Call) Run(run func()) *Container_MakeWebSocket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Container_MakeWebSocket_Call) Return(_a0 websocket.Hub) *Container_MakeWebSocket_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Container_MakeWebSocket_Call) RunAndReturn(run func() websocket.Hub) *Container_MakeWebSocket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeWith provides a mock function with given fields: key, parameters
 func (_m *Container) MakeWith(key interface{}, parameters map[string]interface{}) (interface{}, error) {
 	ret := _m.Called(key, parameters)
@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package health
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Check is an autogenerated mock type for the Check type
+type Check struct {
+	mock.Mock
+}
+
+type Check_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Check) EXPECT() *Check_Expecter {
+	return &Check_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx
+func (_m *Check) Execute(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Check_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Check_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Check_Expecter) Execute(ctx interface{}) *Check_Execute_Call {
+	return &Check_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *Check_Execute_Call) Run(run func(ctx context.Context)) *Check_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Check_Execute_Call) Return(_a0 error) *Check_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Check_Execute_Call) RunAndReturn(run func(context.Context) error) *Check_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCheck creates a new instance of Check. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCheck(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Check {
+	mock := &Check{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,150 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package health
+
+import (
+	context "context"
+
+	health "github.com/goravel/framework/contracts/health"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Health is an autogenerated mock type for the Health type
+type Health struct {
+	mock.Mock
+}
+
+type Health_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Health) EXPECT() *Health_Expecter {
+	return &Health_Expecter{mock: &_m.Mock}
+}
+
+// Forget provides a mock function with given fields: name
+func (_m *Health) Forget(name string) {
+	_m.Called(name)
+}
+
+// Health_Forget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forget'
+type Health_Forget_Call struct {
+	*mock.Call
+}
+
+// Forget is a helper method to define mock.On call
+//   - name string
+func (_e *Health_Expecter) Forget(name interface{}) *Health_Forget_Call {
+	return &Health_Forget_Call{Call: _e.mock.On("Forget", name)}
+}
+
+func (_c *Health_Forget_Call) Run(run func(name string)) *Health_Forget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Health_Forget_Call) Return() *Health_Forget_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Health_Forget_Call) RunAndReturn(run func(string)) *Health_Forget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: name, check
+func (_m *Health) Register(name string, check health.Check) {
+	_m.Called(name, check)
+}
+
+// Health_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type Health_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - name string
+//   - check health.Check
+func (_e *Health_Expecter) Register(name interface{}, check interface{}) *Health_Register_Call {
+	return &Health_Register_Call{Call: _e.mock.On("Register", name, check)}
+}
+
+func (_c *Health_Register_Call) Run(run func(name string, check health.Check)) *Health_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(health.Check))
+	})
+	return _c
+}
+
+func (_c *Health_Register_Call) Return() *Health_Register_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Health_Register_Call) RunAndReturn(run func(string, health.Check)) *Health_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *Health) Run(ctx context.Context) health.Report {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 health.Report
+	if rf, ok := ret.Get(0).(func(context.Context) health.Report); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(health.Report)
+	}
+
+	return r0
+}
+
+// Health_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type Health_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Health_Expecter) Run(ctx interface{}) *Health_Run_Call {
+	return &Health_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *Health_Run_Call) Run(run func(ctx context.Context)) *Health_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Health_Run_Call) Return(_a0 health.Report) *Health_Run_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Health_Run_Call) RunAndReturn(run func(context.Context) health.Report) *Health_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHealth creates a new instance of Health. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealth(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Health {
+	mock := &Health{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
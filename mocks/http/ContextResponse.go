@@ -3,7 +3,10 @@
 package http
 
 import (
+	io "io"
+
 	http "github.com/goravel/framework/contracts/http"
+
 	mock "github.com/stretchr/testify/mock"
 
 	nethttp "net/http"
@@ -601,6 +604,55 @@ func (_c *ContextResponse_Stream_Call) RunAndReturn(run func(int, func(http.Stre
 	return _c
 }
 
+// StreamDownload provides a mock function with given fields: filename, callback
+func (_m *ContextResponse) StreamDownload(filename string, callback func(io.Writer) error) http.Response {
+	ret := _m.Called(filename, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamDownload")
+	}
+
+	var r0 http.Response
+	if rf, ok := ret.Get(0).(func(string, func(io.Writer) error) http.Response); ok {
+		r0 = rf(filename, callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Response)
+		}
+	}
+
+	return r0
+}
+
+// ContextResponse_StreamDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamDownload'
+type ContextResponse_StreamDownload_Call struct {
+	*mock.Call
+}
+
+// StreamDownload is a helper method to define mock.On call
+//   - filename string
+//   - callback func(io.Writer) error
+func (_e *ContextResponse_Expecter) StreamDownload(filename interface{}, callback interface{}) *ContextResponse_StreamDownload_Call {
+	return &ContextResponse_StreamDownload_Call{Call: _e.mock.On("StreamDownload", filename, callback)}
+}
+
+func (_c *ContextResponse_StreamDownload_Call) Run(run func(filename string, callback func(io.Writer) error)) *ContextResponse_StreamDownload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(io.Writer) error))
+	})
+	return _c
+}
+
+func (_c *ContextResponse_StreamDownload_Call) Return(_a0 http.Response) *ContextResponse_StreamDownload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContextResponse_StreamDownload_Call) RunAndReturn(run func(string, func(io.Writer) error) http.Response) *ContextResponse_StreamDownload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // String provides a mock function with given fields: code, format, values
 func (_m *ContextResponse) String(code int, format string, values ...interface{}) http.Response {
 	var _ca []interface{}
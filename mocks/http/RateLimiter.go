@@ -5,6 +5,8 @@ package http
 import (
 	http "github.com/goravel/framework/contracts/http"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // RateLimiter is an autogenerated mock type for the RateLimiter type
@@ -20,6 +22,204 @@ func (_m *RateLimiter) EXPECT() *RateLimiter_Expecter {
 	return &RateLimiter_Expecter{mock: &_m.Mock}
 }
 
+// Attempt provides a mock function with given fields: key, maxAttempts, callback, decay
+func (_m *RateLimiter) Attempt(key string, maxAttempts int, callback func() error, decay ...time.Duration) (bool, error) {
+	_va := make([]interface{}, len(decay))
+	for _i := range decay {
+		_va[_i] = decay[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, key, maxAttempts, callback)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attempt")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, func() error, ...time.Duration) (bool, error)); ok {
+		return rf(key, maxAttempts, callback, decay...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, func() error, ...time.Duration) bool); ok {
+		r0 = rf(key, maxAttempts, callback, decay...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, func() error, ...time.Duration) error); ok {
+		r1 = rf(key, maxAttempts, callback, decay...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RateLimiter_Attempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attempt'
+type RateLimiter_Attempt_Call struct {
+	*mock.Call
+}
+
+// Attempt is a helper method to define mock.On call
+//   - key string
+//   - maxAttempts int
+//   - callback func() error
+//   - decay ...time.Duration
+func (_e *RateLimiter_Expecter) Attempt(key interface{}, maxAttempts interface{}, callback interface{}, decay ...interface{}) *RateLimiter_Attempt_Call {
+	return &RateLimiter_Attempt_Call{Call: _e.mock.On("Attempt",
+		append([]interface{}{key, maxAttempts, callback}, decay...)...)}
+}
+
+func (_c *RateLimiter_Attempt_Call) Run(run func(key string, maxAttempts int, callback func() error, decay ...time.Duration)) *RateLimiter_Attempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]time.Duration, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(time.Duration)
+			}
+		}
+		run(args[0].(string), args[1].(int), args[2].(func() error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RateLimiter_Attempt_Call) Return(_a0 bool, _a1 error) *RateLimiter_Attempt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RateLimiter_Attempt_Call) RunAndReturn(run func(string, int, func() error, ...time.Duration) (bool, error)) *RateLimiter_Attempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attempts provides a mock function with given fields: key
+func (_m *RateLimiter) Attempts(key string) int {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attempts")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// RateLimiter_Attempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attempts'
+type RateLimiter_Attempts_Call struct {
+	*mock.Call
+}
+
+// Attempts is a helper method to define mock.On call
+//   - key string
+func (_e *RateLimiter_Expecter) Attempts(key interface{}) *RateLimiter_Attempts_Call {
+	return &RateLimiter_Attempts_Call{Call: _e.mock.On("Attempts", key)}
+}
+
+func (_c *RateLimiter_Attempts_Call) Run(run func(key string)) *RateLimiter_Attempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RateLimiter_Attempts_Call) Return(_a0 int) *RateLimiter_Attempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RateLimiter_Attempts_Call) RunAndReturn(run func(string) int) *RateLimiter_Attempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AvailableIn provides a mock function with given fields: key
+func (_m *RateLimiter) AvailableIn(key string) time.Duration {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AvailableIn")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(string) time.Duration); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// RateLimiter_AvailableIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AvailableIn'
+type RateLimiter_AvailableIn_Call struct {
+	*mock.Call
+}
+
+// AvailableIn is a helper method to define mock.On call
+//   - key string
+func (_e *RateLimiter_Expecter) AvailableIn(key interface{}) *RateLimiter_AvailableIn_Call {
+	return &RateLimiter_AvailableIn_Call{Call: _e.mock.On("AvailableIn", key)}
+}
+
+func (_c *RateLimiter_AvailableIn_Call) Run(run func(key string)) *RateLimiter_AvailableIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RateLimiter_AvailableIn_Call) Return(_a0 time.Duration) *RateLimiter_AvailableIn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RateLimiter_AvailableIn_Call) RunAndReturn(run func(string) time.Duration) *RateLimiter_AvailableIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Clear provides a mock function with given fields: key
+func (_m *RateLimiter) Clear(key string) {
+	_m.Called(key)
+}
+
+// RateLimiter_Clear_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clear'
+type RateLimiter_Clear_Call struct {
+	*mock.Call
+}
+
+// Clear is a helper method to define mock.On call
+//   - key string
+func (_e *RateLimiter_Expecter) Clear(key interface{}) *RateLimiter_Clear_Call {
+	return &RateLimiter_Clear_Call{Call: _e.mock.On("Clear", key)}
+}
+
+func (_c *RateLimiter_Clear_Call) Run(run func(key string)) *RateLimiter_Clear_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RateLimiter_Clear_Call) Return() *RateLimiter_Clear_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RateLimiter_Clear_Call) RunAndReturn(run func(string)) *RateLimiter_Clear_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // For provides a mock function with given fields: name, callback
 func (_m *RateLimiter) For(name string, callback func(http.Context) http.Limit) {
 	_m.Called(name, callback)
@@ -88,6 +288,67 @@ func (_c *RateLimiter_ForWithLimits_Call) RunAndReturn(run func(string, func(htt
 	return _c
 }
 
+// Hit provides a mock function with given fields: key, decay
+func (_m *RateLimiter) Hit(key string, decay ...time.Duration) int {
+	_va := make([]interface{}, len(decay))
+	for _i := range decay {
+		_va[_i] = decay[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, key)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hit")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, ...time.Duration) int); ok {
+		r0 = rf(key, decay...)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// RateLimiter_Hit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hit'
+type RateLimiter_Hit_Call struct {
+	*mock.Call
+}
+
+// Hit is a helper method to define mock.On call
+//   - key string
+//   - decay ...time.Duration
+func (_e *RateLimiter_Expecter) Hit(key interface{}, decay ...interface{}) *RateLimiter_Hit_Call {
+	return &RateLimiter_Hit_Call{Call: _e.mock.On("Hit",
+		append([]interface{}{key}, decay...)...)}
+}
+
+func (_c *RateLimiter_Hit_Call) Run(run func(key string, decay ...time.Duration)) *RateLimiter_Hit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]time.Duration, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(time.Duration)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RateLimiter_Hit_Call) Return(_a0 int) *RateLimiter_Hit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RateLimiter_Hit_Call) RunAndReturn(run func(string, ...time.Duration) int) *RateLimiter_Hit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Limiter provides a mock function with given fields: name
 func (_m *RateLimiter) Limiter(name string) func(http.Context) []http.Limit {
 	ret := _m.Called(name)
@@ -136,6 +397,100 @@ func (_c *RateLimiter_Limiter_Call) RunAndReturn(run func(string) func(http.Cont
 	return _c
 }
 
+// Remaining provides a mock function with given fields: key, maxAttempts
+func (_m *RateLimiter) Remaining(key string, maxAttempts int) int {
+	ret := _m.Called(key, maxAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remaining")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, int) int); ok {
+		r0 = rf(key, maxAttempts)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// RateLimiter_Remaining_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remaining'
+type RateLimiter_Remaining_Call struct {
+	*mock.Call
+}
+
+// Remaining is a helper method to define mock.On call
+//   - key string
+//   - maxAttempts int
+func (_e *RateLimiter_Expecter) Remaining(key interface{}, maxAttempts interface{}) *RateLimiter_Remaining_Call {
+	return &RateLimiter_Remaining_Call{Call: _e.mock.On("Remaining", key, maxAttempts)}
+}
+
+func (_c *RateLimiter_Remaining_Call) Run(run func(key string, maxAttempts int)) *RateLimiter_Remaining_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *RateLimiter_Remaining_Call) Return(_a0 int) *RateLimiter_Remaining_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RateLimiter_Remaining_Call) RunAndReturn(run func(string, int) int) *RateLimiter_Remaining_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TooManyAttempts provides a mock function with given fields: key, maxAttempts
+func (_m *RateLimiter) TooManyAttempts(key string, maxAttempts int) bool {
+	ret := _m.Called(key, maxAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TooManyAttempts")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, int) bool); ok {
+		r0 = rf(key, maxAttempts)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// RateLimiter_TooManyAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TooManyAttempts'
+type RateLimiter_TooManyAttempts_Call struct {
+	*mock.Call
+}
+
+// TooManyAttempts is a helper method to define mock.On call
+//   - key string
+//   - maxAttempts int
+func (_e *RateLimiter_Expecter) TooManyAttempts(key interface{}, maxAttempts interface{}) *RateLimiter_TooManyAttempts_Call {
+	return &RateLimiter_TooManyAttempts_Call{Call: _e.mock.On("TooManyAttempts", key, maxAttempts)}
+}
+
+func (_c *RateLimiter_TooManyAttempts_Call) Run(run func(key string, maxAttempts int)) *RateLimiter_TooManyAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *RateLimiter_TooManyAttempts_Call) Return(_a0 bool) *RateLimiter_TooManyAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RateLimiter_TooManyAttempts_Call) RunAndReturn(run func(string, int) bool) *RateLimiter_TooManyAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRateLimiter creates a new instance of RateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRateLimiter(t interface {
@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package http
+
+import (
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Resource is an autogenerated mock type for the Resource type
+type Resource struct {
+	mock.Mock
+}
+
+type Resource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Resource) EXPECT() *Resource_Expecter {
+	return &Resource_Expecter{mock: &_m.Mock}
+}
+
+// ToArray provides a mock function with given fields: ctx
+func (_m *Resource) ToArray(ctx http.Context) map[string]interface{} {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToArray")
+	}
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func(http.Context) map[string]interface{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	return r0
+}
+
+// Resource_ToArray_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToArray'
+type Resource_ToArray_Call struct {
+	*mock.Call
+}
+
+// ToArray is a helper method to define mock.On call
+//   - ctx http.Context
+func (_e *Resource_Expecter) ToArray(ctx interface{}) *Resource_ToArray_Call {
+	return &Resource_ToArray_Call{Call: _e.mock.On("ToArray", ctx)}
+}
+
+func (_c *Resource_ToArray_Call) Run(run func(ctx http.Context)) *Resource_ToArray_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.Context))
+	})
+	return _c
+}
+
+func (_c *Resource_ToArray_Call) Return(_a0 map[string]interface{}) *Resource_ToArray_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Resource_ToArray_Call) RunAndReturn(run func(http.Context) map[string]interface{}) *Resource_ToArray_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResource creates a new instance of Resource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Resource {
+	mock := &Resource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,131 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package http
+
+import (
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ResponseTransformer is an autogenerated mock type for the ResponseTransformer type
+type ResponseTransformer struct {
+	mock.Mock
+}
+
+type ResponseTransformer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResponseTransformer) EXPECT() *ResponseTransformer_Expecter {
+	return &ResponseTransformer_Expecter{mock: &_m.Mock}
+}
+
+// Register provides a mock function with given fields: transformers
+func (_m *ResponseTransformer) Register(transformers ...http.Transformer) {
+	_va := make([]interface{}, len(transformers))
+	for _i := range transformers {
+		_va[_i] = transformers[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// ResponseTransformer_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type ResponseTransformer_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - transformers ...http.Transformer
+func (_e *ResponseTransformer_Expecter) Register(transformers ...interface{}) *ResponseTransformer_Register_Call {
+	return &ResponseTransformer_Register_Call{Call: _e.mock.On("Register",
+		append([]interface{}{}, transformers...)...)}
+}
+
+func (_c *ResponseTransformer_Register_Call) Run(run func(transformers ...http.Transformer)) *ResponseTransformer_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]http.Transformer, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(http.Transformer)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ResponseTransformer_Register_Call) Return() *ResponseTransformer_Register_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResponseTransformer_Register_Call) RunAndReturn(run func(...http.Transformer)) *ResponseTransformer_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transform provides a mock function with given fields: ctx, code, obj
+func (_m *ResponseTransformer) Transform(ctx http.Context, code int, obj interface{}) interface{} {
+	ret := _m.Called(ctx, code, obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transform")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(http.Context, int, interface{}) interface{}); ok {
+		r0 = rf(ctx, code, obj)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// ResponseTransformer_Transform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transform'
+type ResponseTransformer_Transform_Call struct {
+	*mock.Call
+}
+
+// Transform is a helper method to define mock.On call
+//   - ctx http.Context
+//   - code int
+//   - obj interface{}
+func (_e *ResponseTransformer_Expecter) Transform(ctx interface{}, code interface{}, obj interface{}) *ResponseTransformer_Transform_Call {
+	return &ResponseTransformer_Transform_Call{Call: _e.mock.On("Transform", ctx, code, obj)}
+}
+
+func (_c *ResponseTransformer_Transform_Call) Run(run func(ctx http.Context, code int, obj interface{})) *ResponseTransformer_Transform_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.Context), args[1].(int), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *ResponseTransformer_Transform_Call) Return(_a0 interface{}) *ResponseTransformer_Transform_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResponseTransformer_Transform_Call) RunAndReturn(run func(http.Context, int, interface{}) interface{}) *ResponseTransformer_Transform_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResponseTransformer creates a new instance of ResponseTransformer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResponseTransformer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResponseTransformer {
+	mock := &ResponseTransformer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
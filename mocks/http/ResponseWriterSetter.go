@@ -0,0 +1,69 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package http
+
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ResponseWriterSetter is an autogenerated mock type for the ResponseWriterSetter type
+type ResponseWriterSetter struct {
+	mock.Mock
+}
+
+type ResponseWriterSetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResponseWriterSetter) EXPECT() *ResponseWriterSetter_Expecter {
+	return &ResponseWriterSetter_Expecter{mock: &_m.Mock}
+}
+
+// SetWriter provides a mock function with given fields: writer
+func (_m *ResponseWriterSetter) SetWriter(writer http.ResponseWriter) {
+	_m.Called(writer)
+}
+
+// ResponseWriterSetter_SetWriter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWriter'
+type ResponseWriterSetter_SetWriter_Call struct {
+	*mock.Call
+}
+
+// SetWriter is a helper method to define mock.On call
+//   - writer http.ResponseWriter
+func (_e *ResponseWriterSetter_Expecter) SetWriter(writer interface{}) *ResponseWriterSetter_SetWriter_Call {
+	return &ResponseWriterSetter_SetWriter_Call{Call: _e.mock.On("SetWriter", writer)}
+}
+
+func (_c *ResponseWriterSetter_SetWriter_Call) Run(run func(writer http.ResponseWriter)) *ResponseWriterSetter_SetWriter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *ResponseWriterSetter_SetWriter_Call) Return() *ResponseWriterSetter_SetWriter_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResponseWriterSetter_SetWriter_Call) RunAndReturn(run func(http.ResponseWriter)) *ResponseWriterSetter_SetWriter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResponseWriterSetter creates a new instance of ResponseWriterSetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResponseWriterSetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResponseWriterSetter {
+	mock := &ResponseWriterSetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
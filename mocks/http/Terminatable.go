@@ -0,0 +1,107 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package http
+
+import (
+	context "context"
+
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+
+	nethttp "net/http"
+)
+
+// Terminatable is an autogenerated mock type for the Terminatable type
+type Terminatable struct {
+	mock.Mock
+}
+
+type Terminatable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Terminatable) EXPECT() *Terminatable_Expecter {
+	return &Terminatable_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function with given fields: ctx
+func (_m *Terminatable) Handle(ctx http.Context) {
+	_m.Called(ctx)
+}
+
+// Terminatable_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type Terminatable_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx http.Context
+func (_e *Terminatable_Expecter) Handle(ctx interface{}) *Terminatable_Handle_Call {
+	return &Terminatable_Handle_Call{Call: _e.mock.On("Handle", ctx)}
+}
+
+func (_c *Terminatable_Handle_Call) Run(run func(ctx http.Context)) *Terminatable_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.Context))
+	})
+	return _c
+}
+
+func (_c *Terminatable_Handle_Call) Return() *Terminatable_Handle_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Terminatable_Handle_Call) RunAndReturn(run func(http.Context)) *Terminatable_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Terminate provides a mock function with given fields: ctx, request, response
+func (_m *Terminatable) Terminate(ctx context.Context, request *nethttp.Request, response http.ResponseOrigin) {
+	_m.Called(ctx, request, response)
+}
+
+// Terminatable_Terminate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Terminate'
+type Terminatable_Terminate_Call struct {
+	*mock.Call
+}
+
+// Terminate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *nethttp.Request
+//   - response http.ResponseOrigin
+func (_e *Terminatable_Expecter) Terminate(ctx interface{}, request interface{}, response interface{}) *Terminatable_Terminate_Call {
+	return &Terminatable_Terminate_Call{Call: _e.mock.On("Terminate", ctx, request, response)}
+}
+
+func (_c *Terminatable_Terminate_Call) Run(run func(ctx context.Context, request *nethttp.Request, response http.ResponseOrigin)) *Terminatable_Terminate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*nethttp.Request), args[2].(http.ResponseOrigin))
+	})
+	return _c
+}
+
+func (_c *Terminatable_Terminate_Call) Return() *Terminatable_Terminate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Terminatable_Terminate_Call) RunAndReturn(run func(context.Context, *nethttp.Request, http.ResponseOrigin)) *Terminatable_Terminate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTerminatable creates a new instance of Terminatable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTerminatable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Terminatable {
+	mock := &Terminatable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
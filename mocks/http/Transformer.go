@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package http
+
+import (
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Transformer is an autogenerated mock type for the Transformer type
+type Transformer struct {
+	mock.Mock
+}
+
+type Transformer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Transformer) EXPECT() *Transformer_Expecter {
+	return &Transformer_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, code, obj
+func (_m *Transformer) Execute(ctx http.Context, code int, obj interface{}) interface{} {
+	ret := _m.Called(ctx, code, obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(http.Context, int, interface{}) interface{}); ok {
+		r0 = rf(ctx, code, obj)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// Transformer_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Transformer_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx http.Context
+//   - code int
+//   - obj interface{}
+func (_e *Transformer_Expecter) Execute(ctx interface{}, code interface{}, obj interface{}) *Transformer_Execute_Call {
+	return &Transformer_Execute_Call{Call: _e.mock.On("Execute", ctx, code, obj)}
+}
+
+func (_c *Transformer_Execute_Call) Run(run func(ctx http.Context, code int, obj interface{})) *Transformer_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.Context), args[1].(int), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Transformer_Execute_Call) Return(_a0 interface{}) *Transformer_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transformer_Execute_Call) RunAndReturn(run func(http.Context, int, interface{}) interface{}) *Transformer_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransformer creates a new instance of Transformer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransformer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Transformer {
+	mock := &Transformer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,322 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package http
+
+import (
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebSocket is an autogenerated mock type for the WebSocket type
+type WebSocket struct {
+	mock.Mock
+}
+
+type WebSocket_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebSocket) EXPECT() *WebSocket_Expecter {
+	return &WebSocket_Expecter{mock: &_m.Mock}
+}
+
+// Broadcast provides a mock function with given fields: room, message
+func (_m *WebSocket) Broadcast(room string, message []byte) error {
+	ret := _m.Called(room, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Broadcast")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []byte) error); ok {
+		r0 = rf(room, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebSocket_Broadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Broadcast'
+type WebSocket_Broadcast_Call struct {
+	*mock.Call
+}
+
+// Broadcast is a helper method to define mock.On call
+//   - room string
+//   - message []byte
+func (_e *WebSocket_Expecter) Broadcast(room interface{}, message interface{}) *WebSocket_Broadcast_Call {
+	return &WebSocket_Broadcast_Call{Call: _e.mock.On("Broadcast", room, message)}
+}
+
+func (_c *WebSocket_Broadcast_Call) Run(run func(room string, message []byte)) *WebSocket_Broadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *WebSocket_Broadcast_Call) Return(_a0 error) *WebSocket_Broadcast_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocket_Broadcast_Call) RunAndReturn(run func(string, []byte) error) *WebSocket_Broadcast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function with given fields:
+func (_m *WebSocket) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebSocket_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type WebSocket_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *WebSocket_Expecter) Close() *WebSocket_Close_Call {
+	return &WebSocket_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *WebSocket_Close_Call) Run(run func()) *WebSocket_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebSocket_Close_Call) Return(_a0 error) *WebSocket_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocket_Close_Call) RunAndReturn(run func() error) *WebSocket_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Context provides a mock function with given fields:
+func (_m *WebSocket) Context() http.Context {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Context")
+	}
+
+	var r0 http.Context
+	if rf, ok := ret.Get(0).(func() http.Context); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Context)
+		}
+	}
+
+	return r0
+}
+
+// WebSocket_Context_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Context'
+type WebSocket_Context_Call struct {
+	*mock.Call
+}
+
+// Context is a helper method to define mock.On call
+func (_e *WebSocket_Expecter) Context() *WebSocket_Context_Call {
+	return &WebSocket_Context_Call{Call: _e.mock.On("Context")}
+}
+
+func (_c *WebSocket_Context_Call) Run(run func()) *WebSocket_Context_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebSocket_Context_Call) Return(_a0 http.Context) *WebSocket_Context_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocket_Context_Call) RunAndReturn(run func() http.Context) *WebSocket_Context_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ID provides a mock function with given fields:
+func (_m *WebSocket) ID() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ID")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// WebSocket_ID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ID'
+type WebSocket_ID_Call struct {
+	*mock.Call
+}
+
+// ID is a helper method to define mock.On call
+func (_e *WebSocket_Expecter) ID() *WebSocket_ID_Call {
+	return &WebSocket_ID_Call{Call: _e.mock.On("ID")}
+}
+
+func (_c *WebSocket_ID_Call) Run(run func()) *WebSocket_ID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebSocket_ID_Call) Return(_a0 string) *WebSocket_ID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocket_ID_Call) RunAndReturn(run func() string) *WebSocket_ID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields:
+func (_m *WebSocket) Read() ([]byte, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebSocket_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type WebSocket_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+func (_e *WebSocket_Expecter) Read() *WebSocket_Read_Call {
+	return &WebSocket_Read_Call{Call: _e.mock.On("Read")}
+}
+
+func (_c *WebSocket_Read_Call) Run(run func()) *WebSocket_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebSocket_Read_Call) Return(_a0 []byte, _a1 error) *WebSocket_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebSocket_Read_Call) RunAndReturn(run func() ([]byte, error)) *WebSocket_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: message
+func (_m *WebSocket) Send(message []byte) error {
+	ret := _m.Called(message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebSocket_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type WebSocket_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - message []byte
+func (_e *WebSocket_Expecter) Send(message interface{}) *WebSocket_Send_Call {
+	return &WebSocket_Send_Call{Call: _e.mock.On("Send", message)}
+}
+
+func (_c *WebSocket_Send_Call) Run(run func(message []byte)) *WebSocket_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *WebSocket_Send_Call) Return(_a0 error) *WebSocket_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocket_Send_Call) RunAndReturn(run func([]byte) error) *WebSocket_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebSocket creates a new instance of WebSocket. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebSocket(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebSocket {
+	mock := &WebSocket{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package http
+
+import (
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebSocketHandlerFunc is an autogenerated mock type for the WebSocketHandlerFunc type
+type WebSocketHandlerFunc struct {
+	mock.Mock
+}
+
+type WebSocketHandlerFunc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebSocketHandlerFunc) EXPECT() *WebSocketHandlerFunc_Expecter {
+	return &WebSocketHandlerFunc_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: socket
+func (_m *WebSocketHandlerFunc) Execute(socket http.WebSocket) error {
+	ret := _m.Called(socket)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(http.WebSocket) error); ok {
+		r0 = rf(socket)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebSocketHandlerFunc_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type WebSocketHandlerFunc_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - socket http.WebSocket
+func (_e *WebSocketHandlerFunc_Expecter) Execute(socket interface{}) *WebSocketHandlerFunc_Execute_Call {
+	return &WebSocketHandlerFunc_Execute_Call{Call: _e.mock.On("Execute", socket)}
+}
+
+func (_c *WebSocketHandlerFunc_Execute_Call) Run(run func(socket http.WebSocket)) *WebSocketHandlerFunc_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.WebSocket))
+	})
+	return _c
+}
+
+func (_c *WebSocketHandlerFunc_Execute_Call) Return(_a0 error) *WebSocketHandlerFunc_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocketHandlerFunc_Execute_Call) RunAndReturn(run func(http.WebSocket) error) *WebSocketHandlerFunc_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebSocketHandlerFunc creates a new instance of WebSocketHandlerFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebSocketHandlerFunc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebSocketHandlerFunc {
+	mock := &WebSocketHandlerFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1348 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package client
+
+import (
+	context "context"
+
+	client "github.com/goravel/framework/contracts/http/client"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Factory is an autogenerated mock type for the Factory type
+type Factory struct {
+	mock.Mock
+}
+
+type Factory_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Factory) EXPECT() *Factory_Expecter {
+	return &Factory_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function with given fields: contentType
+func (_m *Factory) Accept(contentType string) client.Request {
+	ret := _m.Called(contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string) client.Request); ok {
+		r0 = rf(contentType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type Factory_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - contentType string
+func (_e *Factory_Expecter) Accept(contentType interface{}) *Factory_Accept_Call {
+	return &Factory_Accept_Call{Call: _e.mock.On("Accept", contentType)}
+}
+
+func (_c *Factory_Accept_Call) Run(run func(contentType string)) *Factory_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_Accept_Call) Return(_a0 client.Request) *Factory_Accept_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_Accept_Call) RunAndReturn(run func(string) client.Request) *Factory_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AcceptJSON provides a mock function with given fields:
+func (_m *Factory) AcceptJSON() client.Request {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptJSON")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func() client.Request); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_AcceptJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptJSON'
+type Factory_AcceptJSON_Call struct {
+	*mock.Call
+}
+
+// AcceptJSON is a helper method to define mock.On call
+func (_e *Factory_Expecter) AcceptJSON() *Factory_AcceptJSON_Call {
+	return &Factory_AcceptJSON_Call{Call: _e.mock.On("AcceptJSON")}
+}
+
+func (_c *Factory_AcceptJSON_Call) Run(run func()) *Factory_AcceptJSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Factory_AcceptJSON_Call) Return(_a0 client.Request) *Factory_AcceptJSON_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_AcceptJSON_Call) RunAndReturn(run func() client.Request) *Factory_AcceptJSON_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AsForm provides a mock function with given fields:
+func (_m *Factory) AsForm() client.Request {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AsForm")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func() client.Request); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_AsForm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AsForm'
+type Factory_AsForm_Call struct {
+	*mock.Call
+}
+
+// AsForm is a helper method to define mock.On call
+func (_e *Factory_Expecter) AsForm() *Factory_AsForm_Call {
+	return &Factory_AsForm_Call{Call: _e.mock.On("AsForm")}
+}
+
+func (_c *Factory_AsForm_Call) Run(run func()) *Factory_AsForm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Factory_AsForm_Call) Return(_a0 client.Request) *Factory_AsForm_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_AsForm_Call) RunAndReturn(run func() client.Request) *Factory_AsForm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertSent provides a mock function with given fields: callback
+func (_m *Factory) AssertSent(callback func(client.Record) bool) bool {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertSent")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(func(client.Record) bool) bool); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Factory_AssertSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertSent'
+type Factory_AssertSent_Call struct {
+	*mock.Call
+}
+
+// AssertSent is a helper method to define mock.On call
+//   - callback func(client.Record) bool
+func (_e *Factory_Expecter) AssertSent(callback interface{}) *Factory_AssertSent_Call {
+	return &Factory_AssertSent_Call{Call: _e.mock.On("AssertSent", callback)}
+}
+
+func (_c *Factory_AssertSent_Call) Run(run func(callback func(client.Record) bool)) *Factory_AssertSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(client.Record) bool))
+	})
+	return _c
+}
+
+func (_c *Factory_AssertSent_Call) Return(_a0 bool) *Factory_AssertSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_AssertSent_Call) RunAndReturn(run func(func(client.Record) bool) bool) *Factory_AssertSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertSentCount provides a mock function with given fields: count
+func (_m *Factory) AssertSentCount(count int) bool {
+	ret := _m.Called(count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertSentCount")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int) bool); ok {
+		r0 = rf(count)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Factory_AssertSentCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertSentCount'
+type Factory_AssertSentCount_Call struct {
+	*mock.Call
+}
+
+// AssertSentCount is a helper method to define mock.On call
+//   - count int
+func (_e *Factory_Expecter) AssertSentCount(count interface{}) *Factory_AssertSentCount_Call {
+	return &Factory_AssertSentCount_Call{Call: _e.mock.On("AssertSentCount", count)}
+}
+
+func (_c *Factory_AssertSentCount_Call) Run(run func(count int)) *Factory_AssertSentCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Factory_AssertSentCount_Call) Return(_a0 bool) *Factory_AssertSentCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_AssertSentCount_Call) RunAndReturn(run func(int) bool) *Factory_AssertSentCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attach provides a mock function with given fields: name, filename, content
+func (_m *Factory) Attach(name string, filename string, content io.Reader) client.Request {
+	ret := _m.Called(name, filename, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) client.Request); ok {
+		r0 = rf(name, filename, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type Factory_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//   - name string
+//   - filename string
+//   - content io.Reader
+func (_e *Factory_Expecter) Attach(name interface{}, filename interface{}, content interface{}) *Factory_Attach_Call {
+	return &Factory_Attach_Call{Call: _e.mock.On("Attach", name, filename, content)}
+}
+
+func (_c *Factory_Attach_Call) Run(run func(name string, filename string, content io.Reader)) *Factory_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *Factory_Attach_Call) Return(_a0 client.Request) *Factory_Attach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_Attach_Call) RunAndReturn(run func(string, string, io.Reader) client.Request) *Factory_Attach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BaseURL provides a mock function with given fields: url
+func (_m *Factory) BaseURL(url string) client.Request {
+	ret := _m.Called(url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BaseURL")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string) client.Request); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_BaseURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BaseURL'
+type Factory_BaseURL_Call struct {
+	*mock.Call
+}
+
+// BaseURL is a helper method to define mock.On call
+//   - url string
+func (_e *Factory_Expecter) BaseURL(url interface{}) *Factory_BaseURL_Call {
+	return &Factory_BaseURL_Call{Call: _e.mock.On("BaseURL", url)}
+}
+
+func (_c *Factory_BaseURL_Call) Run(run func(url string)) *Factory_BaseURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_BaseURL_Call) Return(_a0 client.Request) *Factory_BaseURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_BaseURL_Call) RunAndReturn(run func(string) client.Request) *Factory_BaseURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: url, body
+func (_m *Factory) Delete(url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) (client.Response, error)); ok {
+		return rf(url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) client.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Factory_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Factory_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - url string
+//   - body ...interface{}
+func (_e *Factory_Expecter) Delete(url interface{}, body ...interface{}) *Factory_Delete_Call {
+	return &Factory_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{url}, body...)...)}
+}
+
+func (_c *Factory_Delete_Call) Run(run func(url string, body ...interface{})) *Factory_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_Delete_Call) Return(_a0 client.Response, _a1 error) *Factory_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Factory_Delete_Call) RunAndReturn(run func(string, ...interface{}) (client.Response, error)) *Factory_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fake provides a mock function with given fields: stubs
+func (_m *Factory) Fake(stubs map[string]client.Stub) client.Factory {
+	ret := _m.Called(stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fake")
+	}
+
+	var r0 client.Factory
+	if rf, ok := ret.Get(0).(func(map[string]client.Stub) client.Factory); ok {
+		r0 = rf(stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Factory_Fake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fake'
+type Factory_Fake_Call struct {
+	*mock.Call
+}
+
+// Fake is a helper method to define mock.On call
+//   - stubs map[string]client.Stub
+func (_e *Factory_Expecter) Fake(stubs interface{}) *Factory_Fake_Call {
+	return &Factory_Fake_Call{Call: _e.mock.On("Fake", stubs)}
+}
+
+func (_c *Factory_Fake_Call) Run(run func(stubs map[string]client.Stub)) *Factory_Fake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]client.Stub))
+	})
+	return _c
+}
+
+func (_c *Factory_Fake_Call) Return(_a0 client.Factory) *Factory_Fake_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_Fake_Call) RunAndReturn(run func(map[string]client.Stub) client.Factory) *Factory_Fake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: url
+func (_m *Factory) Get(url string) (client.Response, error) {
+	ret := _m.Called(url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (client.Response, error)); ok {
+		return rf(url)
+	}
+	if rf, ok := ret.Get(0).(func(string) client.Response); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Factory_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type Factory_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - url string
+func (_e *Factory_Expecter) Get(url interface{}) *Factory_Get_Call {
+	return &Factory_Get_Call{Call: _e.mock.On("Get", url)}
+}
+
+func (_c *Factory_Get_Call) Run(run func(url string)) *Factory_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_Get_Call) Return(_a0 client.Response, _a1 error) *Factory_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Factory_Get_Call) RunAndReturn(run func(string) (client.Response, error)) *Factory_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Head provides a mock function with given fields: url
+func (_m *Factory) Head(url string) (client.Response, error) {
+	ret := _m.Called(url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Head")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (client.Response, error)); ok {
+		return rf(url)
+	}
+	if rf, ok := ret.Get(0).(func(string) client.Response); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Factory_Head_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Head'
+type Factory_Head_Call struct {
+	*mock.Call
+}
+
+// Head is a helper method to define mock.On call
+//   - url string
+func (_e *Factory_Expecter) Head(url interface{}) *Factory_Head_Call {
+	return &Factory_Head_Call{Call: _e.mock.On("Head", url)}
+}
+
+func (_c *Factory_Head_Call) Run(run func(url string)) *Factory_Head_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_Head_Call) Return(_a0 client.Response, _a1 error) *Factory_Head_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Factory_Head_Call) RunAndReturn(run func(string) (client.Response, error)) *Factory_Head_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function with given fields: url, body
+func (_m *Factory) Patch(url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) (client.Response, error)); ok {
+		return rf(url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) client.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Factory_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type Factory_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - url string
+//   - body ...interface{}
+func (_e *Factory_Expecter) Patch(url interface{}, body ...interface{}) *Factory_Patch_Call {
+	return &Factory_Patch_Call{Call: _e.mock.On("Patch",
+		append([]interface{}{url}, body...)...)}
+}
+
+func (_c *Factory_Patch_Call) Run(run func(url string, body ...interface{})) *Factory_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_Patch_Call) Return(_a0 client.Response, _a1 error) *Factory_Patch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Factory_Patch_Call) RunAndReturn(run func(string, ...interface{}) (client.Response, error)) *Factory_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Post provides a mock function with given fields: url, body
+func (_m *Factory) Post(url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Post")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) (client.Response, error)); ok {
+		return rf(url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) client.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Factory_Post_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Post'
+type Factory_Post_Call struct {
+	*mock.Call
+}
+
+// Post is a helper method to define mock.On call
+//   - url string
+//   - body ...interface{}
+func (_e *Factory_Expecter) Post(url interface{}, body ...interface{}) *Factory_Post_Call {
+	return &Factory_Post_Call{Call: _e.mock.On("Post",
+		append([]interface{}{url}, body...)...)}
+}
+
+func (_c *Factory_Post_Call) Run(run func(url string, body ...interface{})) *Factory_Post_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_Post_Call) Return(_a0 client.Response, _a1 error) *Factory_Post_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Factory_Post_Call) RunAndReturn(run func(string, ...interface{}) (client.Response, error)) *Factory_Post_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: url, body
+func (_m *Factory) Put(url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) (client.Response, error)); ok {
+		return rf(url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) client.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Factory_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type Factory_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - url string
+//   - body ...interface{}
+func (_e *Factory_Expecter) Put(url interface{}, body ...interface{}) *Factory_Put_Call {
+	return &Factory_Put_Call{Call: _e.mock.On("Put",
+		append([]interface{}{url}, body...)...)}
+}
+
+func (_c *Factory_Put_Call) Run(run func(url string, body ...interface{})) *Factory_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_Put_Call) Return(_a0 client.Response, _a1 error) *Factory_Put_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Factory_Put_Call) RunAndReturn(run func(string, ...interface{}) (client.Response, error)) *Factory_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Recorded provides a mock function with given fields:
+func (_m *Factory) Recorded() []client.Record {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recorded")
+	}
+
+	var r0 []client.Record
+	if rf, ok := ret.Get(0).(func() []client.Record); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Record)
+		}
+	}
+
+	return r0
+}
+
+// Factory_Recorded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recorded'
+type Factory_Recorded_Call struct {
+	*mock.Call
+}
+
+// Recorded is a helper method to define mock.On call
+func (_e *Factory_Expecter) Recorded() *Factory_Recorded_Call {
+	return &Factory_Recorded_Call{Call: _e.mock.On("Recorded")}
+}
+
+func (_c *Factory_Recorded_Call) Run(run func()) *Factory_Recorded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Factory_Recorded_Call) Return(_a0 []client.Record) *Factory_Recorded_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_Recorded_Call) RunAndReturn(run func() []client.Record) *Factory_Recorded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: times, sleep, when
+func (_m *Factory) Retry(times int, sleep time.Duration, when ...func(client.Response, error) bool) client.Request {
+	_va := make([]interface{}, len(when))
+	for _i := range when {
+		_va[_i] = when[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, times, sleep)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(int, time.Duration, ...func(client.Response, error) bool) client.Request); ok {
+		r0 = rf(times, sleep, when...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type Factory_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - times int
+//   - sleep time.Duration
+//   - when ...func(client.Response , error) bool
+func (_e *Factory_Expecter) Retry(times interface{}, sleep interface{}, when ...interface{}) *Factory_Retry_Call {
+	return &Factory_Retry_Call{Call: _e.mock.On("Retry",
+		append([]interface{}{times, sleep}, when...)...)}
+}
+
+func (_c *Factory_Retry_Call) Run(run func(times int, sleep time.Duration, when ...func(client.Response, error) bool)) *Factory_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(client.Response, error) bool, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(client.Response, error) bool)
+			}
+		}
+		run(args[0].(int), args[1].(time.Duration), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_Retry_Call) Return(_a0 client.Request) *Factory_Retry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_Retry_Call) RunAndReturn(run func(int, time.Duration, ...func(client.Response, error) bool) client.Request) *Factory_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: method, url, body
+func (_m *Factory) Send(method string, url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, method, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...interface{}) (client.Response, error)); ok {
+		return rf(method, url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...interface{}) client.Response); ok {
+		r0 = rf(method, url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...interface{}) error); ok {
+		r1 = rf(method, url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Factory_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Factory_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - method string
+//   - url string
+//   - body ...interface{}
+func (_e *Factory_Expecter) Send(method interface{}, url interface{}, body ...interface{}) *Factory_Send_Call {
+	return &Factory_Send_Call{Call: _e.mock.On("Send",
+		append([]interface{}{method, url}, body...)...)}
+}
+
+func (_c *Factory_Send_Call) Run(run func(method string, url string, body ...interface{})) *Factory_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_Send_Call) Return(_a0 client.Response, _a1 error) *Factory_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Factory_Send_Call) RunAndReturn(run func(string, string, ...interface{}) (client.Response, error)) *Factory_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeout provides a mock function with given fields: timeout
+func (_m *Factory) Timeout(timeout time.Duration) client.Request {
+	ret := _m.Called(timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(time.Duration) client.Request); ok {
+		r0 = rf(timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type Factory_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+//   - timeout time.Duration
+func (_e *Factory_Expecter) Timeout(timeout interface{}) *Factory_Timeout_Call {
+	return &Factory_Timeout_Call{Call: _e.mock.On("Timeout", timeout)}
+}
+
+func (_c *Factory_Timeout_Call) Run(run func(timeout time.Duration)) *Factory_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Factory_Timeout_Call) Return(_a0 client.Request) *Factory_Timeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_Timeout_Call) RunAndReturn(run func(time.Duration) client.Request) *Factory_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithBasicAuth provides a mock function with given fields: username, password
+func (_m *Factory) WithBasicAuth(username string, password string) client.Request {
+	ret := _m.Called(username, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithBasicAuth")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string, string) client.Request); ok {
+		r0 = rf(username, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_WithBasicAuth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithBasicAuth'
+type Factory_WithBasicAuth_Call struct {
+	*mock.Call
+}
+
+// WithBasicAuth is a helper method to define mock.On call
+//   - username string
+//   - password string
+func (_e *Factory_Expecter) WithBasicAuth(username interface{}, password interface{}) *Factory_WithBasicAuth_Call {
+	return &Factory_WithBasicAuth_Call{Call: _e.mock.On("WithBasicAuth", username, password)}
+}
+
+func (_c *Factory_WithBasicAuth_Call) Run(run func(username string, password string)) *Factory_WithBasicAuth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_WithBasicAuth_Call) Return(_a0 client.Request) *Factory_WithBasicAuth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_WithBasicAuth_Call) RunAndReturn(run func(string, string) client.Request) *Factory_WithBasicAuth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithContext provides a mock function with given fields: ctx
+func (_m *Factory) WithContext(ctx context.Context) client.Request {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(context.Context) client.Request); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type Factory_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Factory_Expecter) WithContext(ctx interface{}) *Factory_WithContext_Call {
+	return &Factory_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *Factory_WithContext_Call) Run(run func(ctx context.Context)) *Factory_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Factory_WithContext_Call) Return(_a0 client.Request) *Factory_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_WithContext_Call) RunAndReturn(run func(context.Context) client.Request) *Factory_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithHeader provides a mock function with given fields: key, value
+func (_m *Factory) WithHeader(key string, value string) client.Request {
+	ret := _m.Called(key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithHeader")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string, string) client.Request); ok {
+		r0 = rf(key, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_WithHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithHeader'
+type Factory_WithHeader_Call struct {
+	*mock.Call
+}
+
+// WithHeader is a helper method to define mock.On call
+//   - key string
+//   - value string
+func (_e *Factory_Expecter) WithHeader(key interface{}, value interface{}) *Factory_WithHeader_Call {
+	return &Factory_WithHeader_Call{Call: _e.mock.On("WithHeader", key, value)}
+}
+
+func (_c *Factory_WithHeader_Call) Run(run func(key string, value string)) *Factory_WithHeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_WithHeader_Call) Return(_a0 client.Request) *Factory_WithHeader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_WithHeader_Call) RunAndReturn(run func(string, string) client.Request) *Factory_WithHeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithHeaders provides a mock function with given fields: headers
+func (_m *Factory) WithHeaders(headers map[string]string) client.Request {
+	ret := _m.Called(headers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithHeaders")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(map[string]string) client.Request); ok {
+		r0 = rf(headers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_WithHeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithHeaders'
+type Factory_WithHeaders_Call struct {
+	*mock.Call
+}
+
+// WithHeaders is a helper method to define mock.On call
+//   - headers map[string]string
+func (_e *Factory_Expecter) WithHeaders(headers interface{}) *Factory_WithHeaders_Call {
+	return &Factory_WithHeaders_Call{Call: _e.mock.On("WithHeaders", headers)}
+}
+
+func (_c *Factory_WithHeaders_Call) Run(run func(headers map[string]string)) *Factory_WithHeaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *Factory_WithHeaders_Call) Return(_a0 client.Request) *Factory_WithHeaders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_WithHeaders_Call) RunAndReturn(run func(map[string]string) client.Request) *Factory_WithHeaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithQueryParameters provides a mock function with given fields: parameters
+func (_m *Factory) WithQueryParameters(parameters map[string]string) client.Request {
+	ret := _m.Called(parameters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithQueryParameters")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(map[string]string) client.Request); ok {
+		r0 = rf(parameters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_WithQueryParameters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithQueryParameters'
+type Factory_WithQueryParameters_Call struct {
+	*mock.Call
+}
+
+// WithQueryParameters is a helper method to define mock.On call
+//   - parameters map[string]string
+func (_e *Factory_Expecter) WithQueryParameters(parameters interface{}) *Factory_WithQueryParameters_Call {
+	return &Factory_WithQueryParameters_Call{Call: _e.mock.On("WithQueryParameters", parameters)}
+}
+
+func (_c *Factory_WithQueryParameters_Call) Run(run func(parameters map[string]string)) *Factory_WithQueryParameters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *Factory_WithQueryParameters_Call) Return(_a0 client.Request) *Factory_WithQueryParameters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_WithQueryParameters_Call) RunAndReturn(run func(map[string]string) client.Request) *Factory_WithQueryParameters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithToken provides a mock function with given fields: token, tokenType
+func (_m *Factory) WithToken(token string, tokenType ...string) client.Request {
+	_va := make([]interface{}, len(tokenType))
+	for _i := range tokenType {
+		_va[_i] = tokenType[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, token)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithToken")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string, ...string) client.Request); ok {
+		r0 = rf(token, tokenType...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Factory_WithToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithToken'
+type Factory_WithToken_Call struct {
+	*mock.Call
+}
+
+// WithToken is a helper method to define mock.On call
+//   - token string
+//   - tokenType ...string
+func (_e *Factory_Expecter) WithToken(token interface{}, tokenType ...interface{}) *Factory_WithToken_Call {
+	return &Factory_WithToken_Call{Call: _e.mock.On("WithToken",
+		append([]interface{}{token}, tokenType...)...)}
+}
+
+func (_c *Factory_WithToken_Call) Run(run func(token string, tokenType ...string)) *Factory_WithToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_WithToken_Call) Return(_a0 client.Request) *Factory_WithToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_WithToken_Call) RunAndReturn(run func(string, ...string) client.Request) *Factory_WithToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFactory creates a new instance of Factory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFactory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Factory {
+	mock := &Factory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1161 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package client
+
+import (
+	context "context"
+
+	client "github.com/goravel/framework/contracts/http/client"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Request is an autogenerated mock type for the Request type
+type Request struct {
+	mock.Mock
+}
+
+type Request_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Request) EXPECT() *Request_Expecter {
+	return &Request_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function with given fields: contentType
+func (_m *Request) Accept(contentType string) client.Request {
+	ret := _m.Called(contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string) client.Request); ok {
+		r0 = rf(contentType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type Request_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - contentType string
+func (_e *Request_Expecter) Accept(contentType interface{}) *Request_Accept_Call {
+	return &Request_Accept_Call{Call: _e.mock.On("Accept", contentType)}
+}
+
+func (_c *Request_Accept_Call) Run(run func(contentType string)) *Request_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Request_Accept_Call) Return(_a0 client.Request) *Request_Accept_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_Accept_Call) RunAndReturn(run func(string) client.Request) *Request_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AcceptJSON provides a mock function with given fields:
+func (_m *Request) AcceptJSON() client.Request {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptJSON")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func() client.Request); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_AcceptJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptJSON'
+type Request_AcceptJSON_Call struct {
+	*mock.Call
+}
+
+// AcceptJSON is a helper method to define mock.On call
+func (_e *Request_Expecter) AcceptJSON() *Request_AcceptJSON_Call {
+	return &Request_AcceptJSON_Call{Call: _e.mock.On("AcceptJSON")}
+}
+
+func (_c *Request_AcceptJSON_Call) Run(run func()) *Request_AcceptJSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Request_AcceptJSON_Call) Return(_a0 client.Request) *Request_AcceptJSON_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_AcceptJSON_Call) RunAndReturn(run func() client.Request) *Request_AcceptJSON_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AsForm provides a mock function with given fields:
+func (_m *Request) AsForm() client.Request {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AsForm")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func() client.Request); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_AsForm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AsForm'
+type Request_AsForm_Call struct {
+	*mock.Call
+}
+
+// AsForm is a helper method to define mock.On call
+func (_e *Request_Expecter) AsForm() *Request_AsForm_Call {
+	return &Request_AsForm_Call{Call: _e.mock.On("AsForm")}
+}
+
+func (_c *Request_AsForm_Call) Run(run func()) *Request_AsForm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Request_AsForm_Call) Return(_a0 client.Request) *Request_AsForm_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_AsForm_Call) RunAndReturn(run func() client.Request) *Request_AsForm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attach provides a mock function with given fields: name, filename, content
+func (_m *Request) Attach(name string, filename string, content io.Reader) client.Request {
+	ret := _m.Called(name, filename, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) client.Request); ok {
+		r0 = rf(name, filename, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type Request_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//   - name string
+//   - filename string
+//   - content io.Reader
+func (_e *Request_Expecter) Attach(name interface{}, filename interface{}, content interface{}) *Request_Attach_Call {
+	return &Request_Attach_Call{Call: _e.mock.On("Attach", name, filename, content)}
+}
+
+func (_c *Request_Attach_Call) Run(run func(name string, filename string, content io.Reader)) *Request_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *Request_Attach_Call) Return(_a0 client.Request) *Request_Attach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_Attach_Call) RunAndReturn(run func(string, string, io.Reader) client.Request) *Request_Attach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BaseURL provides a mock function with given fields: url
+func (_m *Request) BaseURL(url string) client.Request {
+	ret := _m.Called(url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BaseURL")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string) client.Request); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_BaseURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BaseURL'
+type Request_BaseURL_Call struct {
+	*mock.Call
+}
+
+// BaseURL is a helper method to define mock.On call
+//   - url string
+func (_e *Request_Expecter) BaseURL(url interface{}) *Request_BaseURL_Call {
+	return &Request_BaseURL_Call{Call: _e.mock.On("BaseURL", url)}
+}
+
+func (_c *Request_BaseURL_Call) Run(run func(url string)) *Request_BaseURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Request_BaseURL_Call) Return(_a0 client.Request) *Request_BaseURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_BaseURL_Call) RunAndReturn(run func(string) client.Request) *Request_BaseURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: url, body
+func (_m *Request) Delete(url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) (client.Response, error)); ok {
+		return rf(url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) client.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Request_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - url string
+//   - body ...interface{}
+func (_e *Request_Expecter) Delete(url interface{}, body ...interface{}) *Request_Delete_Call {
+	return &Request_Delete_Call{Call: _e.mock.On("Delete",
+		append([]interface{}{url}, body...)...)}
+}
+
+func (_c *Request_Delete_Call) Run(run func(url string, body ...interface{})) *Request_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Request_Delete_Call) Return(_a0 client.Response, _a1 error) *Request_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Request_Delete_Call) RunAndReturn(run func(string, ...interface{}) (client.Response, error)) *Request_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: url
+func (_m *Request) Get(url string) (client.Response, error) {
+	ret := _m.Called(url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (client.Response, error)); ok {
+		return rf(url)
+	}
+	if rf, ok := ret.Get(0).(func(string) client.Response); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type Request_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - url string
+func (_e *Request_Expecter) Get(url interface{}) *Request_Get_Call {
+	return &Request_Get_Call{Call: _e.mock.On("Get", url)}
+}
+
+func (_c *Request_Get_Call) Run(run func(url string)) *Request_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Request_Get_Call) Return(_a0 client.Response, _a1 error) *Request_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Request_Get_Call) RunAndReturn(run func(string) (client.Response, error)) *Request_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Head provides a mock function with given fields: url
+func (_m *Request) Head(url string) (client.Response, error) {
+	ret := _m.Called(url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Head")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (client.Response, error)); ok {
+		return rf(url)
+	}
+	if rf, ok := ret.Get(0).(func(string) client.Response); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request_Head_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Head'
+type Request_Head_Call struct {
+	*mock.Call
+}
+
+// Head is a helper method to define mock.On call
+//   - url string
+func (_e *Request_Expecter) Head(url interface{}) *Request_Head_Call {
+	return &Request_Head_Call{Call: _e.mock.On("Head", url)}
+}
+
+func (_c *Request_Head_Call) Run(run func(url string)) *Request_Head_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Request_Head_Call) Return(_a0 client.Response, _a1 error) *Request_Head_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Request_Head_Call) RunAndReturn(run func(string) (client.Response, error)) *Request_Head_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function with given fields: url, body
+func (_m *Request) Patch(url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) (client.Response, error)); ok {
+		return rf(url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) client.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type Request_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - url string
+//   - body ...interface{}
+func (_e *Request_Expecter) Patch(url interface{}, body ...interface{}) *Request_Patch_Call {
+	return &Request_Patch_Call{Call: _e.mock.On("Patch",
+		append([]interface{}{url}, body...)...)}
+}
+
+func (_c *Request_Patch_Call) Run(run func(url string, body ...interface{})) *Request_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Request_Patch_Call) Return(_a0 client.Response, _a1 error) *Request_Patch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Request_Patch_Call) RunAndReturn(run func(string, ...interface{}) (client.Response, error)) *Request_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Post provides a mock function with given fields: url, body
+func (_m *Request) Post(url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Post")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) (client.Response, error)); ok {
+		return rf(url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) client.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request_Post_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Post'
+type Request_Post_Call struct {
+	*mock.Call
+}
+
+// Post is a helper method to define mock.On call
+//   - url string
+//   - body ...interface{}
+func (_e *Request_Expecter) Post(url interface{}, body ...interface{}) *Request_Post_Call {
+	return &Request_Post_Call{Call: _e.mock.On("Post",
+		append([]interface{}{url}, body...)...)}
+}
+
+func (_c *Request_Post_Call) Run(run func(url string, body ...interface{})) *Request_Post_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Request_Post_Call) Return(_a0 client.Response, _a1 error) *Request_Post_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Request_Post_Call) RunAndReturn(run func(string, ...interface{}) (client.Response, error)) *Request_Post_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: url, body
+func (_m *Request) Put(url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) (client.Response, error)); ok {
+		return rf(url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) client.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type Request_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - url string
+//   - body ...interface{}
+func (_e *Request_Expecter) Put(url interface{}, body ...interface{}) *Request_Put_Call {
+	return &Request_Put_Call{Call: _e.mock.On("Put",
+		append([]interface{}{url}, body...)...)}
+}
+
+func (_c *Request_Put_Call) Run(run func(url string, body ...interface{})) *Request_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Request_Put_Call) Return(_a0 client.Response, _a1 error) *Request_Put_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Request_Put_Call) RunAndReturn(run func(string, ...interface{}) (client.Response, error)) *Request_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: times, sleep, when
+func (_m *Request) Retry(times int, sleep time.Duration, when ...func(client.Response, error) bool) client.Request {
+	_va := make([]interface{}, len(when))
+	for _i := range when {
+		_va[_i] = when[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, times, sleep)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(int, time.Duration, ...func(client.Response, error) bool) client.Request); ok {
+		r0 = rf(times, sleep, when...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type Request_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - times int
+//   - sleep time.Duration
+//   - when ...func(client.Response , error) bool
+func (_e *Request_Expecter) Retry(times interface{}, sleep interface{}, when ...interface{}) *Request_Retry_Call {
+	return &Request_Retry_Call{Call: _e.mock.On("Retry",
+		append([]interface{}{times, sleep}, when...)...)}
+}
+
+func (_c *Request_Retry_Call) Run(run func(times int, sleep time.Duration, when ...func(client.Response, error) bool)) *Request_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(client.Response, error) bool, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(client.Response, error) bool)
+			}
+		}
+		run(args[0].(int), args[1].(time.Duration), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Request_Retry_Call) Return(_a0 client.Request) *Request_Retry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_Retry_Call) RunAndReturn(run func(int, time.Duration, ...func(client.Response, error) bool) client.Request) *Request_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: method, url, body
+func (_m *Request) Send(method string, url string, body ...interface{}) (client.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, method, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 client.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...interface{}) (client.Response, error)); ok {
+		return rf(method, url, body...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...interface{}) client.Response); ok {
+		r0 = rf(method, url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...interface{}) error); ok {
+		r1 = rf(method, url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Request_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - method string
+//   - url string
+//   - body ...interface{}
+func (_e *Request_Expecter) Send(method interface{}, url interface{}, body ...interface{}) *Request_Send_Call {
+	return &Request_Send_Call{Call: _e.mock.On("Send",
+		append([]interface{}{method, url}, body...)...)}
+}
+
+func (_c *Request_Send_Call) Run(run func(method string, url string, body ...interface{})) *Request_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Request_Send_Call) Return(_a0 client.Response, _a1 error) *Request_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Request_Send_Call) RunAndReturn(run func(string, string, ...interface{}) (client.Response, error)) *Request_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeout provides a mock function with given fields: timeout
+func (_m *Request) Timeout(timeout time.Duration) client.Request {
+	ret := _m.Called(timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(time.Duration) client.Request); ok {
+		r0 = rf(timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type Request_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+//   - timeout time.Duration
+func (_e *Request_Expecter) Timeout(timeout interface{}) *Request_Timeout_Call {
+	return &Request_Timeout_Call{Call: _e.mock.On("Timeout", timeout)}
+}
+
+func (_c *Request_Timeout_Call) Run(run func(timeout time.Duration)) *Request_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Request_Timeout_Call) Return(_a0 client.Request) *Request_Timeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_Timeout_Call) RunAndReturn(run func(time.Duration) client.Request) *Request_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithBasicAuth provides a mock function with given fields: username, password
+func (_m *Request) WithBasicAuth(username string, password string) client.Request {
+	ret := _m.Called(username, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithBasicAuth")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string, string) client.Request); ok {
+		r0 = rf(username, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_WithBasicAuth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithBasicAuth'
+type Request_WithBasicAuth_Call struct {
+	*mock.Call
+}
+
+// WithBasicAuth is a helper method to define mock.On call
+//   - username string
+//   - password string
+func (_e *Request_Expecter) WithBasicAuth(username interface{}, password interface{}) *Request_WithBasicAuth_Call {
+	return &Request_WithBasicAuth_Call{Call: _e.mock.On("WithBasicAuth", username, password)}
+}
+
+func (_c *Request_WithBasicAuth_Call) Run(run func(username string, password string)) *Request_WithBasicAuth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Request_WithBasicAuth_Call) Return(_a0 client.Request) *Request_WithBasicAuth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_WithBasicAuth_Call) RunAndReturn(run func(string, string) client.Request) *Request_WithBasicAuth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithContext provides a mock function with given fields: ctx
+func (_m *Request) WithContext(ctx context.Context) client.Request {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(context.Context) client.Request); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type Request_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Request_Expecter) WithContext(ctx interface{}) *Request_WithContext_Call {
+	return &Request_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *Request_WithContext_Call) Run(run func(ctx context.Context)) *Request_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Request_WithContext_Call) Return(_a0 client.Request) *Request_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_WithContext_Call) RunAndReturn(run func(context.Context) client.Request) *Request_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithHeader provides a mock function with given fields: key, value
+func (_m *Request) WithHeader(key string, value string) client.Request {
+	ret := _m.Called(key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithHeader")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string, string) client.Request); ok {
+		r0 = rf(key, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_WithHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithHeader'
+type Request_WithHeader_Call struct {
+	*mock.Call
+}
+
+// WithHeader is a helper method to define mock.On call
+//   - key string
+//   - value string
+func (_e *Request_Expecter) WithHeader(key interface{}, value interface{}) *Request_WithHeader_Call {
+	return &Request_WithHeader_Call{Call: _e.mock.On("WithHeader", key, value)}
+}
+
+func (_c *Request_WithHeader_Call) Run(run func(key string, value string)) *Request_WithHeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Request_WithHeader_Call) Return(_a0 client.Request) *Request_WithHeader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_WithHeader_Call) RunAndReturn(run func(string, string) client.Request) *Request_WithHeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithHeaders provides a mock function with given fields: headers
+func (_m *Request) WithHeaders(headers map[string]string) client.Request {
+	ret := _m.Called(headers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithHeaders")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(map[string]string) client.Request); ok {
+		r0 = rf(headers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_WithHeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithHeaders'
+type Request_WithHeaders_Call struct {
+	*mock.Call
+}
+
+// WithHeaders is a helper method to define mock.On call
+//   - headers map[string]string
+func (_e *Request_Expecter) WithHeaders(headers interface{}) *Request_WithHeaders_Call {
+	return &Request_WithHeaders_Call{Call: _e.mock.On("WithHeaders", headers)}
+}
+
+func (_c *Request_WithHeaders_Call) Run(run func(headers map[string]string)) *Request_WithHeaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *Request_WithHeaders_Call) Return(_a0 client.Request) *Request_WithHeaders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_WithHeaders_Call) RunAndReturn(run func(map[string]string) client.Request) *Request_WithHeaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithQueryParameters provides a mock function with given fields: parameters
+func (_m *Request) WithQueryParameters(parameters map[string]string) client.Request {
+	ret := _m.Called(parameters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithQueryParameters")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(map[string]string) client.Request); ok {
+		r0 = rf(parameters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_WithQueryParameters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithQueryParameters'
+type Request_WithQueryParameters_Call struct {
+	*mock.Call
+}
+
+// WithQueryParameters is a helper method to define mock.On call
+//   - parameters map[string]string
+func (_e *Request_Expecter) WithQueryParameters(parameters interface{}) *Request_WithQueryParameters_Call {
+	return &Request_WithQueryParameters_Call{Call: _e.mock.On("WithQueryParameters", parameters)}
+}
+
+func (_c *Request_WithQueryParameters_Call) Run(run func(parameters map[string]string)) *Request_WithQueryParameters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *Request_WithQueryParameters_Call) Return(_a0 client.Request) *Request_WithQueryParameters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_WithQueryParameters_Call) RunAndReturn(run func(map[string]string) client.Request) *Request_WithQueryParameters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithToken provides a mock function with given fields: token, tokenType
+func (_m *Request) WithToken(token string, tokenType ...string) client.Request {
+	_va := make([]interface{}, len(tokenType))
+	for _i := range tokenType {
+		_va[_i] = tokenType[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, token)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithToken")
+	}
+
+	var r0 client.Request
+	if rf, ok := ret.Get(0).(func(string, ...string) client.Request); ok {
+		r0 = rf(token, tokenType...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Request)
+		}
+	}
+
+	return r0
+}
+
+// Request_WithToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithToken'
+type Request_WithToken_Call struct {
+	*mock.Call
+}
+
+// WithToken is a helper method to define mock.On call
+//   - token string
+//   - tokenType ...string
+func (_e *Request_Expecter) WithToken(token interface{}, tokenType ...interface{}) *Request_WithToken_Call {
+	return &Request_WithToken_Call{Call: _e.mock.On("WithToken",
+		append([]interface{}{token}, tokenType...)...)}
+}
+
+func (_c *Request_WithToken_Call) Run(run func(token string, tokenType ...string)) *Request_WithToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Request_WithToken_Call) Return(_a0 client.Request) *Request_WithToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Request_WithToken_Call) RunAndReturn(run func(string, ...string) client.Request) *Request_WithToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRequest creates a new instance of Request. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequest(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Request {
+	mock := &Request{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package client
+
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Stub is an autogenerated mock type for the Stub type
+type Stub struct {
+	mock.Mock
+}
+
+type Stub_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Stub) EXPECT() *Stub_Expecter {
+	return &Stub_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: request
+func (_m *Stub) Execute(request *http.Request) (*http.Response, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*http.Request) (*http.Response, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(*http.Request) *http.Response); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*http.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Stub_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Stub_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - request *http.Request
+func (_e *Stub_Expecter) Execute(request interface{}) *Stub_Execute_Call {
+	return &Stub_Execute_Call{Call: _e.mock.On("Execute", request)}
+}
+
+func (_c *Stub_Execute_Call) Run(run func(request *http.Request)) *Stub_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *Stub_Execute_Call) Return(_a0 *http.Response, _a1 error) *Stub_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Stub_Execute_Call) RunAndReturn(run func(*http.Request) (*http.Response, error)) *Stub_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStub creates a new instance of Stub. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStub(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Stub {
+	mock := &Stub{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,128 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package id
+
+import (
+	id "github.com/goravel/framework/contracts/id"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ID is an autogenerated mock type for the ID type
+type ID struct {
+	mock.Mock
+}
+
+type ID_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ID) EXPECT() *ID_Expecter {
+	return &ID_Expecter{mock: &_m.Mock}
+}
+
+// Driver provides a mock function with given fields: name
+func (_m *ID) Driver(name string) id.Generator {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Driver")
+	}
+
+	var r0 id.Generator
+	if rf, ok := ret.Get(0).(func(string) id.Generator); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(id.Generator)
+		}
+	}
+
+	return r0
+}
+
+// ID_Driver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Driver'
+type ID_Driver_Call struct {
+	*mock.Call
+}
+
+// Driver is a helper method to define mock.On call
+//   - name string
+func (_e *ID_Expecter) Driver(name interface{}) *ID_Driver_Call {
+	return &ID_Driver_Call{Call: _e.mock.On("Driver", name)}
+}
+
+func (_c *ID_Driver_Call) Run(run func(name string)) *ID_Driver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ID_Driver_Call) Return(_a0 id.Generator) *ID_Driver_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ID_Driver_Call) RunAndReturn(run func(string) id.Generator) *ID_Driver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Generate provides a mock function with given fields:
+func (_m *ID) Generate() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Generate")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ID_Generate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Generate'
+type ID_Generate_Call struct {
+	*mock.Call
+}
+
+// Generate is a helper method to define mock.On call
+func (_e *ID_Expecter) Generate() *ID_Generate_Call {
+	return &ID_Generate_Call{Call: _e.mock.On("Generate")}
+}
+
+func (_c *ID_Generate_Call) Run(run func()) *ID_Generate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ID_Generate_Call) Return(_a0 string) *ID_Generate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ID_Generate_Call) RunAndReturn(run func() string) *ID_Generate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewID creates a new instance of ID. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewID(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ID {
+	mock := &ID{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
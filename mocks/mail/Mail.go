@@ -3,6 +3,8 @@
 package mail
 
 import (
+	context "context"
+
 	mail "github.com/goravel/framework/contracts/mail"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -474,6 +476,54 @@ func (_c *Mail_To_Call) RunAndReturn(run func([]string) mail.Mail) *Mail_To_Call
 	return _c
 }
 
+// WithContext provides a mock function with given fields: ctx
+func (_m *Mail) WithContext(ctx context.Context) mail.Mail {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 mail.Mail
+	if rf, ok := ret.Get(0).(func(context.Context) mail.Mail); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mail.Mail)
+		}
+	}
+
+	return r0
+}
+
+// Mail_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type Mail_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Mail_Expecter) WithContext(ctx interface{}) *Mail_WithContext_Call {
+	return &Mail_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *Mail_WithContext_Call) Run(run func(ctx context.Context)) *Mail_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Mail_WithContext_Call) Return(_a0 mail.Mail) *Mail_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Mail_WithContext_Call) RunAndReturn(run func(context.Context) mail.Mail) *Mail_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMail creates a new instance of Mail. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMail(t interface {
@@ -0,0 +1,106 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Checkpointable is an autogenerated mock type for the Checkpointable type
+type Checkpointable struct {
+	mock.Mock
+}
+
+type Checkpointable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Checkpointable) EXPECT() *Checkpointable_Expecter {
+	return &Checkpointable_Expecter{mock: &_m.Mock}
+}
+
+// HandleWithCheckpoint provides a mock function with given fields: ctx, checkpoint, args
+func (_m *Checkpointable) HandleWithCheckpoint(ctx context.Context, checkpoint []byte, args ...interface{}) ([]byte, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, checkpoint)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleWithCheckpoint")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, ...interface{}) ([]byte, error)); ok {
+		return rf(ctx, checkpoint, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, ...interface{}) []byte); ok {
+		r0 = rf(ctx, checkpoint, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, ...interface{}) error); ok {
+		r1 = rf(ctx, checkpoint, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Checkpointable_HandleWithCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleWithCheckpoint'
+type Checkpointable_HandleWithCheckpoint_Call struct {
+	*mock.Call
+}
+
+// HandleWithCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - checkpoint []byte
+//   - args ...interface{}
+func (_e *Checkpointable_Expecter) HandleWithCheckpoint(ctx interface{}, checkpoint interface{}, args ...interface{}) *Checkpointable_HandleWithCheckpoint_Call {
+	return &Checkpointable_HandleWithCheckpoint_Call{Call: _e.mock.On("HandleWithCheckpoint",
+		append([]interface{}{ctx, checkpoint}, args...)...)}
+}
+
+func (_c *Checkpointable_HandleWithCheckpoint_Call) Run(run func(ctx context.Context, checkpoint []byte, args ...interface{})) *Checkpointable_HandleWithCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].([]byte), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Checkpointable_HandleWithCheckpoint_Call) Return(_a0 []byte, _a1 error) *Checkpointable_HandleWithCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Checkpointable_HandleWithCheckpoint_Call) RunAndReturn(run func(context.Context, []byte, ...interface{}) ([]byte, error)) *Checkpointable_HandleWithCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCheckpointable creates a new instance of Checkpointable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCheckpointable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Checkpointable {
+	mock := &Checkpointable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
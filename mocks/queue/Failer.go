@@ -0,0 +1,308 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Failer is an autogenerated mock type for the Failer type
+type Failer struct {
+	mock.Mock
+}
+
+type Failer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Failer) EXPECT() *Failer_Expecter {
+	return &Failer_Expecter{mock: &_m.Mock}
+}
+
+// All provides a mock function with given fields:
+func (_m *Failer) All() ([]queue.FailedJob, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for All")
+	}
+
+	var r0 []queue.FailedJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]queue.FailedJob, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []queue.FailedJob); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]queue.FailedJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Failer_All_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'All'
+type Failer_All_Call struct {
+	*mock.Call
+}
+
+// All is a helper method to define mock.On call
+func (_e *Failer_Expecter) All() *Failer_All_Call {
+	return &Failer_All_Call{Call: _e.mock.On("All")}
+}
+
+func (_c *Failer_All_Call) Run(run func()) *Failer_All_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Failer_All_Call) Return(_a0 []queue.FailedJob, _a1 error) *Failer_All_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Failer_All_Call) RunAndReturn(run func() ([]queue.FailedJob, error)) *Failer_All_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: id
+func (_m *Failer) Find(id uint) (*queue.FailedJob, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *queue.FailedJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*queue.FailedJob, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *queue.FailedJob); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*queue.FailedJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Failer_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type Failer_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - id uint
+func (_e *Failer_Expecter) Find(id interface{}) *Failer_Find_Call {
+	return &Failer_Find_Call{Call: _e.mock.On("Find", id)}
+}
+
+func (_c *Failer_Find_Call) Run(run func(id uint)) *Failer_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *Failer_Find_Call) Return(_a0 *queue.FailedJob, _a1 error) *Failer_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Failer_Find_Call) RunAndReturn(run func(uint) (*queue.FailedJob, error)) *Failer_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flush provides a mock function with given fields: hours
+func (_m *Failer) Flush(hours int) (int64, error) {
+	ret := _m.Called(hours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flush")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int64, error)); ok {
+		return rf(hours)
+	}
+	if rf, ok := ret.Get(0).(func(int) int64); ok {
+		r0 = rf(hours)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(hours)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Failer_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type Failer_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+//   - hours int
+func (_e *Failer_Expecter) Flush(hours interface{}) *Failer_Flush_Call {
+	return &Failer_Flush_Call{Call: _e.mock.On("Flush", hours)}
+}
+
+func (_c *Failer_Flush_Call) Run(run func(hours int)) *Failer_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Failer_Flush_Call) Return(_a0 int64, _a1 error) *Failer_Flush_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Failer_Flush_Call) RunAndReturn(run func(int) (int64, error)) *Failer_Flush_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Forget provides a mock function with given fields: id
+func (_m *Failer) Forget(id uint) (bool, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Forget")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (bool, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Failer_Forget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forget'
+type Failer_Forget_Call struct {
+	*mock.Call
+}
+
+// Forget is a helper method to define mock.On call
+//   - id uint
+func (_e *Failer_Expecter) Forget(id interface{}) *Failer_Forget_Call {
+	return &Failer_Forget_Call{Call: _e.mock.On("Forget", id)}
+}
+
+func (_c *Failer_Forget_Call) Run(run func(id uint)) *Failer_Forget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *Failer_Forget_Call) Return(_a0 bool, _a1 error) *Failer_Forget_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Failer_Forget_Call) RunAndReturn(run func(uint) (bool, error)) *Failer_Forget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: job
+func (_m *Failer) Retry(job queue.FailedJob) error {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(queue.FailedJob) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Failer_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type Failer_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - job queue.FailedJob
+func (_e *Failer_Expecter) Retry(job interface{}) *Failer_Retry_Call {
+	return &Failer_Retry_Call{Call: _e.mock.On("Retry", job)}
+}
+
+func (_c *Failer_Retry_Call) Run(run func(job queue.FailedJob)) *Failer_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(queue.FailedJob))
+	})
+	return _c
+}
+
+func (_c *Failer_Retry_Call) Return(_a0 error) *Failer_Retry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Failer_Retry_Call) RunAndReturn(run func(queue.FailedJob) error) *Failer_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFailer creates a new instance of Failer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFailer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Failer {
+	mock := &Failer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// HasBackoff is an autogenerated mock type for the HasBackoff type
+type HasBackoff struct {
+	mock.Mock
+}
+
+type HasBackoff_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HasBackoff) EXPECT() *HasBackoff_Expecter {
+	return &HasBackoff_Expecter{mock: &_m.Mock}
+}
+
+// Backoff provides a mock function with given fields:
+func (_m *HasBackoff) Backoff() []time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backoff")
+	}
+
+	var r0 []time.Duration
+	if rf, ok := ret.Get(0).(func() []time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Duration)
+		}
+	}
+
+	return r0
+}
+
+// HasBackoff_Backoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backoff'
+type HasBackoff_Backoff_Call struct {
+	*mock.Call
+}
+
+// Backoff is a helper method to define mock.On call
+func (_e *HasBackoff_Expecter) Backoff() *HasBackoff_Backoff_Call {
+	return &HasBackoff_Backoff_Call{Call: _e.mock.On("Backoff")}
+}
+
+func (_c *HasBackoff_Backoff_Call) Run(run func()) *HasBackoff_Backoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HasBackoff_Backoff_Call) Return(_a0 []time.Duration) *HasBackoff_Backoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HasBackoff_Backoff_Call) RunAndReturn(run func() []time.Duration) *HasBackoff_Backoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHasBackoff creates a new instance of HasBackoff. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHasBackoff(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HasBackoff {
+	mock := &HasBackoff{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
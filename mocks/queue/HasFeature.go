@@ -0,0 +1,77 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import mock "github.com/stretchr/testify/mock"
+
+// HasFeature is an autogenerated mock type for the HasFeature type
+type HasFeature struct {
+	mock.Mock
+}
+
+type HasFeature_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HasFeature) EXPECT() *HasFeature_Expecter {
+	return &HasFeature_Expecter{mock: &_m.Mock}
+}
+
+// Feature provides a mock function with given fields:
+func (_m *HasFeature) Feature() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Feature")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// HasFeature_Feature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Feature'
+type HasFeature_Feature_Call struct {
+	*mock.Call
+}
+
+// Feature is a helper method to define mock.On call
+func (_e *HasFeature_Expecter) Feature() *HasFeature_Feature_Call {
+	return &HasFeature_Feature_Call{Call: _e.mock.On("Feature")}
+}
+
+func (_c *HasFeature_Feature_Call) Run(run func()) *HasFeature_Feature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HasFeature_Feature_Call) Return(_a0 string) *HasFeature_Feature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HasFeature_Feature_Call) RunAndReturn(run func() string) *HasFeature_Feature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHasFeature creates a new instance of HasFeature. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHasFeature(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HasFeature {
+	mock := &HasFeature{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HasMiddleware is an autogenerated mock type for the HasMiddleware type
+type HasMiddleware struct {
+	mock.Mock
+}
+
+type HasMiddleware_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HasMiddleware) EXPECT() *HasMiddleware_Expecter {
+	return &HasMiddleware_Expecter{mock: &_m.Mock}
+}
+
+// Middleware provides a mock function with given fields:
+func (_m *HasMiddleware) Middleware() []queue.Middleware {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Middleware")
+	}
+
+	var r0 []queue.Middleware
+	if rf, ok := ret.Get(0).(func() []queue.Middleware); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]queue.Middleware)
+		}
+	}
+
+	return r0
+}
+
+// HasMiddleware_Middleware_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Middleware'
+type HasMiddleware_Middleware_Call struct {
+	*mock.Call
+}
+
+// Middleware is a helper method to define mock.On call
+func (_e *HasMiddleware_Expecter) Middleware() *HasMiddleware_Middleware_Call {
+	return &HasMiddleware_Middleware_Call{Call: _e.mock.On("Middleware")}
+}
+
+func (_c *HasMiddleware_Middleware_Call) Run(run func()) *HasMiddleware_Middleware_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HasMiddleware_Middleware_Call) Return(_a0 []queue.Middleware) *HasMiddleware_Middleware_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HasMiddleware_Middleware_Call) RunAndReturn(run func() []queue.Middleware) *HasMiddleware_Middleware_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHasMiddleware creates a new instance of HasMiddleware. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHasMiddleware(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HasMiddleware {
+	mock := &HasMiddleware{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// HasRetryUntil is an autogenerated mock type for the HasRetryUntil type
+type HasRetryUntil struct {
+	mock.Mock
+}
+
+type HasRetryUntil_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HasRetryUntil) EXPECT() *HasRetryUntil_Expecter {
+	return &HasRetryUntil_Expecter{mock: &_m.Mock}
+}
+
+// RetryUntil provides a mock function with given fields:
+func (_m *HasRetryUntil) RetryUntil() time.Time {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryUntil")
+	}
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
+// HasRetryUntil_RetryUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryUntil'
+type HasRetryUntil_RetryUntil_Call struct {
+	*mock.Call
+}
+
+// RetryUntil is a helper method to define mock.On call
+func (_e *HasRetryUntil_Expecter) RetryUntil() *HasRetryUntil_RetryUntil_Call {
+	return &HasRetryUntil_RetryUntil_Call{Call: _e.mock.On("RetryUntil")}
+}
+
+func (_c *HasRetryUntil_RetryUntil_Call) Run(run func()) *HasRetryUntil_RetryUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HasRetryUntil_RetryUntil_Call) Return(_a0 time.Time) *HasRetryUntil_RetryUntil_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HasRetryUntil_RetryUntil_Call) RunAndReturn(run func() time.Time) *HasRetryUntil_RetryUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHasRetryUntil creates a new instance of HasRetryUntil. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHasRetryUntil(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HasRetryUntil {
+	mock := &HasRetryUntil{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
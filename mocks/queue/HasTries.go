@@ -0,0 +1,77 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import mock "github.com/stretchr/testify/mock"
+
+// HasTries is an autogenerated mock type for the HasTries type
+type HasTries struct {
+	mock.Mock
+}
+
+type HasTries_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HasTries) EXPECT() *HasTries_Expecter {
+	return &HasTries_Expecter{mock: &_m.Mock}
+}
+
+// Tries provides a mock function with given fields:
+func (_m *HasTries) Tries() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tries")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// HasTries_Tries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tries'
+type HasTries_Tries_Call struct {
+	*mock.Call
+}
+
+// Tries is a helper method to define mock.On call
+func (_e *HasTries_Expecter) Tries() *HasTries_Tries_Call {
+	return &HasTries_Tries_Call{Call: _e.mock.On("Tries")}
+}
+
+func (_c *HasTries_Tries_Call) Run(run func()) *HasTries_Tries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HasTries_Tries_Call) Return(_a0 int) *HasTries_Tries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HasTries_Tries_Call) RunAndReturn(run func() int) *HasTries_Tries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHasTries creates a new instance of HasTries. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHasTries(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HasTries {
+	mock := &HasTries{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
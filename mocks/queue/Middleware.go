@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Middleware is an autogenerated mock type for the Middleware type
+type Middleware struct {
+	mock.Mock
+}
+
+type Middleware_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Middleware) EXPECT() *Middleware_Expecter {
+	return &Middleware_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function with given fields: job, args, next
+func (_m *Middleware) Handle(job queue.Job, args []interface{}, next func() error) error {
+	ret := _m.Called(job, args, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(queue.Job, []interface{}, func() error) error); ok {
+		r0 = rf(job, args, next)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Middleware_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type Middleware_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - job queue.Job
+//   - args []interface{}
+//   - next func() error
+func (_e *Middleware_Expecter) Handle(job interface{}, args interface{}, next interface{}) *Middleware_Handle_Call {
+	return &Middleware_Handle_Call{Call: _e.mock.On("Handle", job, args, next)}
+}
+
+func (_c *Middleware_Handle_Call) Run(run func(job queue.Job, args []interface{}, next func() error)) *Middleware_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(queue.Job), args[1].([]interface{}), args[2].(func() error))
+	})
+	return _c
+}
+
+func (_c *Middleware_Handle_Call) Return(_a0 error) *Middleware_Handle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Middleware_Handle_Call) RunAndReturn(run func(queue.Job, []interface{}, func() error) error) *Middleware_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMiddleware creates a new instance of Middleware. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMiddleware(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Middleware {
+	mock := &Middleware{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
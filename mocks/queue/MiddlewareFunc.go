@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MiddlewareFunc is an autogenerated mock type for the MiddlewareFunc type
+type MiddlewareFunc struct {
+	mock.Mock
+}
+
+type MiddlewareFunc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MiddlewareFunc) EXPECT() *MiddlewareFunc_Expecter {
+	return &MiddlewareFunc_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: job, args, next
+func (_m *MiddlewareFunc) Execute(job queue.Job, args []interface{}, next func() error) error {
+	ret := _m.Called(job, args, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(queue.Job, []interface{}, func() error) error); ok {
+		r0 = rf(job, args, next)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MiddlewareFunc_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MiddlewareFunc_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - job queue.Job
+//   - args []interface{}
+//   - next func() error
+func (_e *MiddlewareFunc_Expecter) Execute(job interface{}, args interface{}, next interface{}) *MiddlewareFunc_Execute_Call {
+	return &MiddlewareFunc_Execute_Call{Call: _e.mock.On("Execute", job, args, next)}
+}
+
+func (_c *MiddlewareFunc_Execute_Call) Run(run func(job queue.Job, args []interface{}, next func() error)) *MiddlewareFunc_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(queue.Job), args[1].([]interface{}), args[2].(func() error))
+	})
+	return _c
+}
+
+func (_c *MiddlewareFunc_Execute_Call) Return(_a0 error) *MiddlewareFunc_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MiddlewareFunc_Execute_Call) RunAndReturn(run func(queue.Job, []interface{}, func() error) error) *MiddlewareFunc_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMiddlewareFunc creates a new instance of MiddlewareFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMiddlewareFunc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MiddlewareFunc {
+	mock := &MiddlewareFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,198 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	queue "github.com/goravel/framework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Monitor is an autogenerated mock type for the Monitor type
+type Monitor struct {
+	mock.Mock
+}
+
+type Monitor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Monitor) EXPECT() *Monitor_Expecter {
+	return &Monitor_Expecter{mock: &_m.Mock}
+}
+
+// Metrics provides a mock function with given fields: connection, _a1
+func (_m *Monitor) Metrics(connection string, _a1 string) (*queue.Metrics, error) {
+	ret := _m.Called(connection, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Metrics")
+	}
+
+	var r0 *queue.Metrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*queue.Metrics, error)); ok {
+		return rf(connection, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *queue.Metrics); ok {
+		r0 = rf(connection, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*queue.Metrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(connection, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Monitor_Metrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Metrics'
+type Monitor_Metrics_Call struct {
+	*mock.Call
+}
+
+// Metrics is a helper method to define mock.On call
+//   - connection string
+//   - _a1 string
+func (_e *Monitor_Expecter) Metrics(connection interface{}, _a1 interface{}) *Monitor_Metrics_Call {
+	return &Monitor_Metrics_Call{Call: _e.mock.On("Metrics", connection, _a1)}
+}
+
+func (_c *Monitor_Metrics_Call) Run(run func(connection string, _a1 string)) *Monitor_Metrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Monitor_Metrics_Call) Return(_a0 *queue.Metrics, _a1 error) *Monitor_Metrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Monitor_Metrics_Call) RunAndReturn(run func(string, string) (*queue.Metrics, error)) *Monitor_Metrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: connection, _a1
+func (_m *Monitor) Reset(connection string, _a1 string) error {
+	ret := _m.Called(connection, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(connection, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Monitor_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type Monitor_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - connection string
+//   - _a1 string
+func (_e *Monitor_Expecter) Reset(connection interface{}, _a1 interface{}) *Monitor_Reset_Call {
+	return &Monitor_Reset_Call{Call: _e.mock.On("Reset", connection, _a1)}
+}
+
+func (_c *Monitor_Reset_Call) Run(run func(connection string, _a1 string)) *Monitor_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Monitor_Reset_Call) Return(_a0 error) *Monitor_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Monitor_Reset_Call) RunAndReturn(run func(string, string) error) *Monitor_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Size provides a mock function with given fields: connection, _a1
+func (_m *Monitor) Size(connection string, _a1 string) (int, error) {
+	ret := _m.Called(connection, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Size")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (int, error)); ok {
+		return rf(connection, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int); ok {
+		r0 = rf(connection, _a1)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(connection, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Monitor_Size_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Size'
+type Monitor_Size_Call struct {
+	*mock.Call
+}
+
+// Size is a helper method to define mock.On call
+//   - connection string
+//   - _a1 string
+func (_e *Monitor_Expecter) Size(connection interface{}, _a1 interface{}) *Monitor_Size_Call {
+	return &Monitor_Size_Call{Call: _e.mock.On("Size", connection, _a1)}
+}
+
+func (_c *Monitor_Size_Call) Run(run func(connection string, _a1 string)) *Monitor_Size_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Monitor_Size_Call) Return(_a0 int, _a1 error) *Monitor_Size_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Monitor_Size_Call) RunAndReturn(run func(string, string) (int, error)) *Monitor_Size_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMonitor creates a new instance of Monitor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMonitor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Monitor {
+	mock := &Monitor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -20,6 +20,54 @@ func (_m *Queue) EXPECT() *Queue_Expecter {
 	return &Queue_Expecter{mock: &_m.Mock}
 }
 
+// Batch provides a mock function with given fields: jobs
+func (_m *Queue) Batch(jobs []queue.Jobs) queue.PendingBatch {
+	ret := _m.Called(jobs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Batch")
+	}
+
+	var r0 queue.PendingBatch
+	if rf, ok := ret.Get(0).(func([]queue.Jobs) queue.PendingBatch); ok {
+		r0 = rf(jobs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingBatch)
+		}
+	}
+
+	return r0
+}
+
+// Queue_Batch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Batch'
+type Queue_Batch_Call struct {
+	*mock.Call
+}
+
+// Batch is a helper method to define mock.On call
+//   - jobs []queue.Jobs
+func (_e *Queue_Expecter) Batch(jobs interface{}) *Queue_Batch_Call {
+	return &Queue_Batch_Call{Call: _e.mock.On("Batch", jobs)}
+}
+
+func (_c *Queue_Batch_Call) Run(run func(jobs []queue.Jobs)) *Queue_Batch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]queue.Jobs))
+	})
+	return _c
+}
+
+func (_c *Queue_Batch_Call) Return(_a0 queue.PendingBatch) *Queue_Batch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Queue_Batch_Call) RunAndReturn(run func([]queue.Jobs) queue.PendingBatch) *Queue_Batch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Chain provides a mock function with given fields: jobs
 func (_m *Queue) Chain(jobs []queue.Jobs) queue.Task {
 	ret := _m.Called(jobs)
@@ -68,6 +116,168 @@ func (_c *Queue_Chain_Call) RunAndReturn(run func([]queue.Jobs) queue.Task) *Que
 	return _c
 }
 
+// Clear provides a mock function with given fields: connection, _a1
+func (_m *Queue) Clear(connection string, _a1 string) (int, error) {
+	ret := _m.Called(connection, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Clear")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (int, error)); ok {
+		return rf(connection, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int); ok {
+		r0 = rf(connection, _a1)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(connection, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queue_Clear_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clear'
+type Queue_Clear_Call struct {
+	*mock.Call
+}
+
+// Clear is a helper method to define mock.On call
+//   - connection string
+//   - _a1 string
+func (_e *Queue_Expecter) Clear(connection interface{}, _a1 interface{}) *Queue_Clear_Call {
+	return &Queue_Clear_Call{Call: _e.mock.On("Clear", connection, _a1)}
+}
+
+func (_c *Queue_Clear_Call) Run(run func(connection string, _a1 string)) *Queue_Clear_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Queue_Clear_Call) Return(_a0 int, _a1 error) *Queue_Clear_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Queue_Clear_Call) RunAndReturn(run func(string, string) (int, error)) *Queue_Clear_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Failer provides a mock function with given fields:
+func (_m *Queue) Failer() queue.Failer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Failer")
+	}
+
+	var r0 queue.Failer
+	if rf, ok := ret.Get(0).(func() queue.Failer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Failer)
+		}
+	}
+
+	return r0
+}
+
+// Queue_Failer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Failer'
+type Queue_Failer_Call struct {
+	*mock.Call
+}
+
+// Failer is a helper method to define mock.On call
+func (_e *Queue_Expecter) Failer() *Queue_Failer_Call {
+	return &Queue_Failer_Call{Call: _e.mock.On("Failer")}
+}
+
+func (_c *Queue_Failer_Call) Run(run func()) *Queue_Failer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Queue_Failer_Call) Return(_a0 queue.Failer) *Queue_Failer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Queue_Failer_Call) RunAndReturn(run func() queue.Failer) *Queue_Failer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBatch provides a mock function with given fields: id
+func (_m *Queue) FindBatch(id string) (*queue.Batch, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBatch")
+	}
+
+	var r0 *queue.Batch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*queue.Batch, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *queue.Batch); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*queue.Batch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queue_FindBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBatch'
+type Queue_FindBatch_Call struct {
+	*mock.Call
+}
+
+// FindBatch is a helper method to define mock.On call
+//   - id string
+func (_e *Queue_Expecter) FindBatch(id interface{}) *Queue_FindBatch_Call {
+	return &Queue_FindBatch_Call{Call: _e.mock.On("FindBatch", id)}
+}
+
+func (_c *Queue_FindBatch_Call) Run(run func(id string)) *Queue_FindBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Queue_FindBatch_Call) Return(_a0 *queue.Batch, _a1 error) *Queue_FindBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Queue_FindBatch_Call) RunAndReturn(run func(string) (*queue.Batch, error)) *Queue_FindBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJobs provides a mock function with given fields:
 func (_m *Queue) GetJobs() []queue.Job {
 	ret := _m.Called()
@@ -164,6 +374,53 @@ func (_c *Queue_Job_Call) RunAndReturn(run func(queue.Job, []queue.Arg) queue.Ta
 	return _c
 }
 
+// Monitor provides a mock function with given fields:
+func (_m *Queue) Monitor() queue.Monitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Monitor")
+	}
+
+	var r0 queue.Monitor
+	if rf, ok := ret.Get(0).(func() queue.Monitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Monitor)
+		}
+	}
+
+	return r0
+}
+
+// Queue_Monitor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Monitor'
+type Queue_Monitor_Call struct {
+	*mock.Call
+}
+
+// Monitor is a helper method to define mock.On call
+func (_e *Queue_Expecter) Monitor() *Queue_Monitor_Call {
+	return &Queue_Monitor_Call{Call: _e.mock.On("Monitor")}
+}
+
+func (_c *Queue_Monitor_Call) Run(run func()) *Queue_Monitor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Queue_Monitor_Call) Return(_a0 queue.Monitor) *Queue_Monitor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Queue_Monitor_Call) RunAndReturn(run func() queue.Monitor) *Queue_Monitor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: jobs
 func (_m *Queue) Register(jobs []queue.Job) {
 	_m.Called(jobs)
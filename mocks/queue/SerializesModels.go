@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import mock "github.com/stretchr/testify/mock"
+
+// SerializesModels is an autogenerated mock type for the SerializesModels type
+type SerializesModels struct {
+	mock.Mock
+}
+
+type SerializesModels_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SerializesModels) EXPECT() *SerializesModels_Expecter {
+	return &SerializesModels_Expecter{mock: &_m.Mock}
+}
+
+// Models provides a mock function with given fields:
+func (_m *SerializesModels) Models() []interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Models")
+	}
+
+	var r0 []interface{}
+	if rf, ok := ret.Get(0).(func() []interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	return r0
+}
+
+// SerializesModels_Models_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Models'
+type SerializesModels_Models_Call struct {
+	*mock.Call
+}
+
+// Models is a helper method to define mock.On call
+func (_e *SerializesModels_Expecter) Models() *SerializesModels_Models_Call {
+	return &SerializesModels_Models_Call{Call: _e.mock.On("Models")}
+}
+
+func (_c *SerializesModels_Models_Call) Run(run func()) *SerializesModels_Models_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SerializesModels_Models_Call) Return(_a0 []interface{}) *SerializesModels_Models_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SerializesModels_Models_Call) RunAndReturn(run func() []interface{}) *SerializesModels_Models_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSerializesModels creates a new instance of SerializesModels. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSerializesModels(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SerializesModels {
+	mock := &SerializesModels{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
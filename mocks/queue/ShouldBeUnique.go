@@ -0,0 +1,136 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ShouldBeUnique is an autogenerated mock type for the ShouldBeUnique type
+type ShouldBeUnique struct {
+	mock.Mock
+}
+
+type ShouldBeUnique_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ShouldBeUnique) EXPECT() *ShouldBeUnique_Expecter {
+	return &ShouldBeUnique_Expecter{mock: &_m.Mock}
+}
+
+// UniqueFor provides a mock function with given fields:
+func (_m *ShouldBeUnique) UniqueFor() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UniqueFor")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// ShouldBeUnique_UniqueFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UniqueFor'
+type ShouldBeUnique_UniqueFor_Call struct {
+	*mock.Call
+}
+
+// UniqueFor is a helper method to define mock.On call
+func (_e *ShouldBeUnique_Expecter) UniqueFor() *ShouldBeUnique_UniqueFor_Call {
+	return &ShouldBeUnique_UniqueFor_Call{Call: _e.mock.On("UniqueFor")}
+}
+
+func (_c *ShouldBeUnique_UniqueFor_Call) Run(run func()) *ShouldBeUnique_UniqueFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ShouldBeUnique_UniqueFor_Call) Return(_a0 time.Duration) *ShouldBeUnique_UniqueFor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShouldBeUnique_UniqueFor_Call) RunAndReturn(run func() time.Duration) *ShouldBeUnique_UniqueFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UniqueID provides a mock function with given fields: args
+func (_m *ShouldBeUnique) UniqueID(args ...interface{}) string {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UniqueID")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(...interface{}) string); ok {
+		r0 = rf(args...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ShouldBeUnique_UniqueID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UniqueID'
+type ShouldBeUnique_UniqueID_Call struct {
+	*mock.Call
+}
+
+// UniqueID is a helper method to define mock.On call
+//   - args ...interface{}
+func (_e *ShouldBeUnique_Expecter) UniqueID(args ...interface{}) *ShouldBeUnique_UniqueID_Call {
+	return &ShouldBeUnique_UniqueID_Call{Call: _e.mock.On("UniqueID",
+		append([]interface{}{}, args...)...)}
+}
+
+func (_c *ShouldBeUnique_UniqueID_Call) Run(run func(args ...interface{})) *ShouldBeUnique_UniqueID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ShouldBeUnique_UniqueID_Call) Return(_a0 string) *ShouldBeUnique_UniqueID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShouldBeUnique_UniqueID_Call) RunAndReturn(run func(...interface{}) string) *ShouldBeUnique_UniqueID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShouldBeUnique creates a new instance of ShouldBeUnique. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShouldBeUnique(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShouldBeUnique {
+	mock := &ShouldBeUnique{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import mock "github.com/stretchr/testify/mock"
+
+// ShouldDispatchAfterCommit is an autogenerated mock type for the ShouldDispatchAfterCommit type
+type ShouldDispatchAfterCommit struct {
+	mock.Mock
+}
+
+type ShouldDispatchAfterCommit_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ShouldDispatchAfterCommit) EXPECT() *ShouldDispatchAfterCommit_Expecter {
+	return &ShouldDispatchAfterCommit_Expecter{mock: &_m.Mock}
+}
+
+// DispatchAfterCommit provides a mock function with given fields:
+func (_m *ShouldDispatchAfterCommit) DispatchAfterCommit() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchAfterCommit")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ShouldDispatchAfterCommit_DispatchAfterCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchAfterCommit'
+type ShouldDispatchAfterCommit_DispatchAfterCommit_Call struct {
+	*mock.Call
+}
+
+// DispatchAfterCommit is a helper method to define mock.On call
+func (_e *ShouldDispatchAfterCommit_Expecter) DispatchAfterCommit() *ShouldDispatchAfterCommit_DispatchAfterCommit_Call {
+	return &ShouldDispatchAfterCommit_DispatchAfterCommit_Call{Call: _e.mock.On("DispatchAfterCommit")}
+}
+
+func (_c *ShouldDispatchAfterCommit_DispatchAfterCommit_Call) Run(run func()) *ShouldDispatchAfterCommit_DispatchAfterCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ShouldDispatchAfterCommit_DispatchAfterCommit_Call) Return(_a0 bool) *ShouldDispatchAfterCommit_DispatchAfterCommit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShouldDispatchAfterCommit_DispatchAfterCommit_Call) RunAndReturn(run func() bool) *ShouldDispatchAfterCommit_DispatchAfterCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShouldDispatchAfterCommit creates a new instance of ShouldDispatchAfterCommit. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShouldDispatchAfterCommit(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShouldDispatchAfterCommit {
+	mock := &ShouldDispatchAfterCommit{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
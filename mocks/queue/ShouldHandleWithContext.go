@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package queue
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShouldHandleWithContext is an autogenerated mock type for the ShouldHandleWithContext type
+type ShouldHandleWithContext struct {
+	mock.Mock
+}
+
+type ShouldHandleWithContext_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ShouldHandleWithContext) EXPECT() *ShouldHandleWithContext_Expecter {
+	return &ShouldHandleWithContext_Expecter{mock: &_m.Mock}
+}
+
+// HandleWithContext provides a mock function with given fields: ctx, args
+func (_m *ShouldHandleWithContext) HandleWithContext(ctx context.Context, args ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleWithContext")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...interface{}) error); ok {
+		r0 = rf(ctx, args...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShouldHandleWithContext_HandleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleWithContext'
+type ShouldHandleWithContext_HandleWithContext_Call struct {
+	*mock.Call
+}
+
+// HandleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args ...interface{}
+func (_e *ShouldHandleWithContext_Expecter) HandleWithContext(ctx interface{}, args ...interface{}) *ShouldHandleWithContext_HandleWithContext_Call {
+	return &ShouldHandleWithContext_HandleWithContext_Call{Call: _e.mock.On("HandleWithContext",
+		append([]interface{}{ctx}, args...)...)}
+}
+
+func (_c *ShouldHandleWithContext_HandleWithContext_Call) Run(run func(ctx context.Context, args ...interface{})) *ShouldHandleWithContext_HandleWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ShouldHandleWithContext_HandleWithContext_Call) Return(_a0 error) *ShouldHandleWithContext_HandleWithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShouldHandleWithContext_HandleWithContext_Call) RunAndReturn(run func(context.Context, ...interface{}) error) *ShouldHandleWithContext_HandleWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShouldHandleWithContext creates a new instance of ShouldHandleWithContext. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShouldHandleWithContext(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShouldHandleWithContext {
+	mock := &ShouldHandleWithContext{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,8 @@
 package queue
 
 import (
+	context "context"
+
 	queue "github.com/goravel/framework/contracts/queue"
 	mock "github.com/stretchr/testify/mock"
 
@@ -22,6 +24,197 @@ func (_m *Task) EXPECT() *Task_Expecter {
 	return &Task_Expecter{mock: &_m.Mock}
 }
 
+// AfterCommit provides a mock function with given fields:
+func (_m *Task) AfterCommit() queue.Task {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterCommit")
+	}
+
+	var r0 queue.Task
+	if rf, ok := ret.Get(0).(func() queue.Task); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_AfterCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterCommit'
+type Task_AfterCommit_Call struct {
+	*mock.Call
+}
+
+// AfterCommit is a helper method to define mock.On call
+func (_e *Task_Expecter) AfterCommit() *Task_AfterCommit_Call {
+	return &Task_AfterCommit_Call{Call: _e.mock.On("AfterCommit")}
+}
+
+func (_c *Task_AfterCommit_Call) Run(run func()) *Task_AfterCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Task_AfterCommit_Call) Return(_a0 queue.Task) *Task_AfterCommit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_AfterCommit_Call) RunAndReturn(run func() queue.Task) *Task_AfterCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeforeCommit provides a mock function with given fields:
+func (_m *Task) BeforeCommit() queue.Task {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeforeCommit")
+	}
+
+	var r0 queue.Task
+	if rf, ok := ret.Get(0).(func() queue.Task); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_BeforeCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeforeCommit'
+type Task_BeforeCommit_Call struct {
+	*mock.Call
+}
+
+// BeforeCommit is a helper method to define mock.On call
+func (_e *Task_Expecter) BeforeCommit() *Task_BeforeCommit_Call {
+	return &Task_BeforeCommit_Call{Call: _e.mock.On("BeforeCommit")}
+}
+
+func (_c *Task_BeforeCommit_Call) Run(run func()) *Task_BeforeCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Task_BeforeCommit_Call) Return(_a0 queue.Task) *Task_BeforeCommit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_BeforeCommit_Call) RunAndReturn(run func() queue.Task) *Task_BeforeCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CatchChain provides a mock function with given fields: job, args
+func (_m *Task) CatchChain(job queue.Job, args []queue.Arg) queue.Task {
+	ret := _m.Called(job, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CatchChain")
+	}
+
+	var r0 queue.Task
+	if rf, ok := ret.Get(0).(func(queue.Job, []queue.Arg) queue.Task); ok {
+		r0 = rf(job, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_CatchChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CatchChain'
+type Task_CatchChain_Call struct {
+	*mock.Call
+}
+
+// CatchChain is a helper method to define mock.On call
+//   - job queue.Job
+//   - args []queue.Arg
+func (_e *Task_Expecter) CatchChain(job interface{}, args interface{}) *Task_CatchChain_Call {
+	return &Task_CatchChain_Call{Call: _e.mock.On("CatchChain", job, args)}
+}
+
+func (_c *Task_CatchChain_Call) Run(run func(job queue.Job, args []queue.Arg)) *Task_CatchChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(queue.Job), args[1].([]queue.Arg))
+	})
+	return _c
+}
+
+func (_c *Task_CatchChain_Call) Return(_a0 queue.Task) *Task_CatchChain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_CatchChain_Call) RunAndReturn(run func(queue.Job, []queue.Arg) queue.Task) *Task_CatchChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeferOn provides a mock function with given fields: calendar
+func (_m *Task) DeferOn(calendar string) queue.Task {
+	ret := _m.Called(calendar)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeferOn")
+	}
+
+	var r0 queue.Task
+	if rf, ok := ret.Get(0).(func(string) queue.Task); ok {
+		r0 = rf(calendar)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_DeferOn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeferOn'
+type Task_DeferOn_Call struct {
+	*mock.Call
+}
+
+// DeferOn is a helper method to define mock.On call
+//   - calendar string
+func (_e *Task_Expecter) DeferOn(calendar interface{}) *Task_DeferOn_Call {
+	return &Task_DeferOn_Call{Call: _e.mock.On("DeferOn", calendar)}
+}
+
+func (_c *Task_DeferOn_Call) Run(run func(calendar string)) *Task_DeferOn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Task_DeferOn_Call) Return(_a0 queue.Task) *Task_DeferOn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_DeferOn_Call) RunAndReturn(run func(string) queue.Task) *Task_DeferOn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delay provides a mock function with given fields: _a0
 func (_m *Task) Delay(_a0 time.Time) queue.Task {
 	ret := _m.Called(_a0)
@@ -70,6 +263,54 @@ func (_c *Task_Delay_Call) RunAndReturn(run func(time.Time) queue.Task) *Task_De
 	return _c
 }
 
+// DelayFor provides a mock function with given fields: duration
+func (_m *Task) DelayFor(duration time.Duration) queue.Task {
+	ret := _m.Called(duration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DelayFor")
+	}
+
+	var r0 queue.Task
+	if rf, ok := ret.Get(0).(func(time.Duration) queue.Task); ok {
+		r0 = rf(duration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_DelayFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DelayFor'
+type Task_DelayFor_Call struct {
+	*mock.Call
+}
+
+// DelayFor is a helper method to define mock.On call
+//   - duration time.Duration
+func (_e *Task_Expecter) DelayFor(duration interface{}) *Task_DelayFor_Call {
+	return &Task_DelayFor_Call{Call: _e.mock.On("DelayFor", duration)}
+}
+
+func (_c *Task_DelayFor_Call) Run(run func(duration time.Duration)) *Task_DelayFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Task_DelayFor_Call) Return(_a0 queue.Task) *Task_DelayFor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_DelayFor_Call) RunAndReturn(run func(time.Duration) queue.Task) *Task_DelayFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Dispatch provides a mock function with given fields:
 func (_m *Task) Dispatch() error {
 	ret := _m.Called()
@@ -256,6 +497,54 @@ func (_c *Task_OnQueue_Call) RunAndReturn(run func(string) queue.Task) *Task_OnQ
 	return _c
 }
 
+// WithContext provides a mock function with given fields: ctx
+func (_m *Task) WithContext(ctx context.Context) queue.Task {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 queue.Task
+	if rf, ok := ret.Get(0).(func(context.Context) queue.Task); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.Task)
+		}
+	}
+
+	return r0
+}
+
+// Task_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type Task_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Task_Expecter) WithContext(ctx interface{}) *Task_WithContext_Call {
+	return &Task_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *Task_WithContext_Call) Run(run func(ctx context.Context)) *Task_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Task_WithContext_Call) Return(_a0 queue.Task) *Task_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_WithContext_Call) RunAndReturn(run func(context.Context) queue.Task) *Task_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTask creates a new instance of Task. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTask(t interface {
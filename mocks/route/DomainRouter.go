@@ -0,0 +1,83 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package route
+
+import (
+	route "github.com/goravel/framework/contracts/route"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DomainRouter is an autogenerated mock type for the DomainRouter type
+type DomainRouter struct {
+	mock.Mock
+}
+
+type DomainRouter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DomainRouter) EXPECT() *DomainRouter_Expecter {
+	return &DomainRouter_Expecter{mock: &_m.Mock}
+}
+
+// Domain provides a mock function with given fields: domain
+func (_m *DomainRouter) Domain(domain string) route.Router {
+	ret := _m.Called(domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Domain")
+	}
+
+	var r0 route.Router
+	if rf, ok := ret.Get(0).(func(string) route.Router); ok {
+		r0 = rf(domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Router)
+		}
+	}
+
+	return r0
+}
+
+// DomainRouter_Domain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Domain'
+type DomainRouter_Domain_Call struct {
+	*mock.Call
+}
+
+// Domain is a helper method to define mock.On call
+//   - domain string
+func (_e *DomainRouter_Expecter) Domain(domain interface{}) *DomainRouter_Domain_Call {
+	return &DomainRouter_Domain_Call{Call: _e.mock.On("Domain", domain)}
+}
+
+func (_c *DomainRouter_Domain_Call) Run(run func(domain string)) *DomainRouter_Domain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DomainRouter_Domain_Call) Return(_a0 route.Router) *DomainRouter_Domain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DomainRouter_Domain_Call) RunAndReturn(run func(string) route.Router) *DomainRouter_Domain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDomainRouter creates a new instance of DomainRouter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDomainRouter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DomainRouter {
+	mock := &DomainRouter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,8 @@
 package route
 
 import (
+	context "context"
+
 	http "github.com/goravel/framework/contracts/http"
 	mock "github.com/stretchr/testify/mock"
 
@@ -159,6 +161,53 @@ func (_c *Route_Get_Call) RunAndReturn(run func(string, http.HandlerFunc)) *Rout
 	return _c
 }
 
+// GetRoutes provides a mock function with given fields:
+func (_m *Route) GetRoutes() []route.Info {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoutes")
+	}
+
+	var r0 []route.Info
+	if rf, ok := ret.Get(0).(func() []route.Info); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]route.Info)
+		}
+	}
+
+	return r0
+}
+
+// Route_GetRoutes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoutes'
+type Route_GetRoutes_Call struct {
+	*mock.Call
+}
+
+// GetRoutes is a helper method to define mock.On call
+func (_e *Route_Expecter) GetRoutes() *Route_GetRoutes_Call {
+	return &Route_GetRoutes_Call{Call: _e.mock.On("GetRoutes")}
+}
+
+func (_c *Route_GetRoutes_Call) Run(run func()) *Route_GetRoutes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Route_GetRoutes_Call) Return(_a0 []route.Info) *Route_GetRoutes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Route_GetRoutes_Call) RunAndReturn(run func() []route.Info) *Route_GetRoutes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GlobalMiddleware provides a mock function with given fields: middlewares
 func (_m *Route) GlobalMiddleware(middlewares ...http.Middleware) {
 	_va := make([]interface{}, len(middlewares))
@@ -717,6 +766,52 @@ func (_c *Route_ServeHTTP_Call) RunAndReturn(run func(nethttp.ResponseWriter, *n
 	return _c
 }
 
+// Shutdown provides a mock function with given fields: ctx
+func (_m *Route) Shutdown(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Shutdown")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Route_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type Route_Shutdown_Call struct {
+	*mock.Call
+}
+
+// Shutdown is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Route_Expecter) Shutdown(ctx interface{}) *Route_Shutdown_Call {
+	return &Route_Shutdown_Call{Call: _e.mock.On("Shutdown", ctx)}
+}
+
+func (_c *Route_Shutdown_Call) Run(run func(ctx context.Context)) *Route_Shutdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Route_Shutdown_Call) Return(_a0 error) *Route_Shutdown_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Route_Shutdown_Call) RunAndReturn(run func(context.Context) error) *Route_Shutdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Static provides a mock function with given fields: relativePath, root
 func (_m *Route) Static(relativePath string, root string) {
 	_m.Called(relativePath, root)
@@ -0,0 +1,296 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package route
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// URL is an autogenerated mock type for the URL type
+type URL struct {
+	mock.Mock
+}
+
+type URL_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *URL) EXPECT() *URL_Expecter {
+	return &URL_Expecter{mock: &_m.Mock}
+}
+
+// HasValidSignature provides a mock function with given fields: url
+func (_m *URL) HasValidSignature(url string) bool {
+	ret := _m.Called(url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasValidSignature")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(url)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// URL_HasValidSignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasValidSignature'
+type URL_HasValidSignature_Call struct {
+	*mock.Call
+}
+
+// HasValidSignature is a helper method to define mock.On call
+//   - url string
+func (_e *URL_Expecter) HasValidSignature(url interface{}) *URL_HasValidSignature_Call {
+	return &URL_HasValidSignature_Call{Call: _e.mock.On("HasValidSignature", url)}
+}
+
+func (_c *URL_HasValidSignature_Call) Run(run func(url string)) *URL_HasValidSignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *URL_HasValidSignature_Call) Return(_a0 bool) *URL_HasValidSignature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *URL_HasValidSignature_Call) RunAndReturn(run func(string) bool) *URL_HasValidSignature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Route provides a mock function with given fields: name, parameters
+func (_m *URL) Route(name string, parameters ...map[string]interface{}) (string, error) {
+	_va := make([]interface{}, len(parameters))
+	for _i := range parameters {
+		_va[_i] = parameters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Route")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) (string, error)); ok {
+		return rf(name, parameters...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) string); ok {
+		r0 = rf(name, parameters...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...map[string]interface{}) error); ok {
+		r1 = rf(name, parameters...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// URL_Route_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Route'
+type URL_Route_Call struct {
+	*mock.Call
+}
+
+// Route is a helper method to define mock.On call
+//   - name string
+//   - parameters ...map[string]interface{}
+func (_e *URL_Expecter) Route(name interface{}, parameters ...interface{}) *URL_Route_Call {
+	return &URL_Route_Call{Call: _e.mock.On("Route",
+		append([]interface{}{name}, parameters...)...)}
+}
+
+func (_c *URL_Route_Call) Run(run func(name string, parameters ...map[string]interface{})) *URL_Route_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *URL_Route_Call) Return(_a0 string, _a1 error) *URL_Route_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *URL_Route_Call) RunAndReturn(run func(string, ...map[string]interface{}) (string, error)) *URL_Route_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignedRoute provides a mock function with given fields: name, parameters
+func (_m *URL) SignedRoute(name string, parameters ...map[string]interface{}) (string, error) {
+	_va := make([]interface{}, len(parameters))
+	for _i := range parameters {
+		_va[_i] = parameters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignedRoute")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) (string, error)); ok {
+		return rf(name, parameters...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...map[string]interface{}) string); ok {
+		r0 = rf(name, parameters...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...map[string]interface{}) error); ok {
+		r1 = rf(name, parameters...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// URL_SignedRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignedRoute'
+type URL_SignedRoute_Call struct {
+	*mock.Call
+}
+
+// SignedRoute is a helper method to define mock.On call
+//   - name string
+//   - parameters ...map[string]interface{}
+func (_e *URL_Expecter) SignedRoute(name interface{}, parameters ...interface{}) *URL_SignedRoute_Call {
+	return &URL_SignedRoute_Call{Call: _e.mock.On("SignedRoute",
+		append([]interface{}{name}, parameters...)...)}
+}
+
+func (_c *URL_SignedRoute_Call) Run(run func(name string, parameters ...map[string]interface{})) *URL_SignedRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *URL_SignedRoute_Call) Return(_a0 string, _a1 error) *URL_SignedRoute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *URL_SignedRoute_Call) RunAndReturn(run func(string, ...map[string]interface{}) (string, error)) *URL_SignedRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TemporarySignedRoute provides a mock function with given fields: name, expiration, parameters
+func (_m *URL) TemporarySignedRoute(name string, expiration time.Duration, parameters ...map[string]interface{}) (string, error) {
+	_va := make([]interface{}, len(parameters))
+	for _i := range parameters {
+		_va[_i] = parameters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name, expiration)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TemporarySignedRoute")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Duration, ...map[string]interface{}) (string, error)); ok {
+		return rf(name, expiration, parameters...)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Duration, ...map[string]interface{}) string); ok {
+		r0 = rf(name, expiration, parameters...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Duration, ...map[string]interface{}) error); ok {
+		r1 = rf(name, expiration, parameters...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// URL_TemporarySignedRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TemporarySignedRoute'
+type URL_TemporarySignedRoute_Call struct {
+	*mock.Call
+}
+
+// TemporarySignedRoute is a helper method to define mock.On call
+//   - name string
+//   - expiration time.Duration
+//   - parameters ...map[string]interface{}
+func (_e *URL_Expecter) TemporarySignedRoute(name interface{}, expiration interface{}, parameters ...interface{}) *URL_TemporarySignedRoute_Call {
+	return &URL_TemporarySignedRoute_Call{Call: _e.mock.On("TemporarySignedRoute",
+		append([]interface{}{name, expiration}, parameters...)...)}
+}
+
+func (_c *URL_TemporarySignedRoute_Call) Run(run func(name string, expiration time.Duration, parameters ...map[string]interface{})) *URL_TemporarySignedRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(args[0].(string), args[1].(time.Duration), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *URL_TemporarySignedRoute_Call) Return(_a0 string, _a1 error) *URL_TemporarySignedRoute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *URL_TemporarySignedRoute_Call) RunAndReturn(run func(string, time.Duration, ...map[string]interface{}) (string, error)) *URL_TemporarySignedRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewURL creates a new instance of URL. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewURL(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *URL {
+	mock := &URL{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
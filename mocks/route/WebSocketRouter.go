@@ -0,0 +1,69 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package route
+
+import (
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebSocketRouter is an autogenerated mock type for the WebSocketRouter type
+type WebSocketRouter struct {
+	mock.Mock
+}
+
+type WebSocketRouter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebSocketRouter) EXPECT() *WebSocketRouter_Expecter {
+	return &WebSocketRouter_Expecter{mock: &_m.Mock}
+}
+
+// WebSocket provides a mock function with given fields: relativePath, handler
+func (_m *WebSocketRouter) WebSocket(relativePath string, handler http.WebSocketHandlerFunc) {
+	_m.Called(relativePath, handler)
+}
+
+// WebSocketRouter_WebSocket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebSocket'
+type WebSocketRouter_WebSocket_Call struct {
+	*mock.Call
+}
+
+// WebSocket is a helper method to define mock.On call
+//   - relativePath string
+//   - handler http.WebSocketHandlerFunc
+func (_e *WebSocketRouter_Expecter) WebSocket(relativePath interface{}, handler interface{}) *WebSocketRouter_WebSocket_Call {
+	return &WebSocketRouter_WebSocket_Call{Call: _e.mock.On("WebSocket", relativePath, handler)}
+}
+
+func (_c *WebSocketRouter_WebSocket_Call) Run(run func(relativePath string, handler http.WebSocketHandlerFunc)) *WebSocketRouter_WebSocket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(http.WebSocketHandlerFunc))
+	})
+	return _c
+}
+
+func (_c *WebSocketRouter_WebSocket_Call) Return() *WebSocketRouter_WebSocket_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WebSocketRouter_WebSocket_Call) RunAndReturn(run func(string, http.WebSocketHandlerFunc)) *WebSocketRouter_WebSocket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebSocketRouter creates a new instance of WebSocketRouter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebSocketRouter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebSocketRouter {
+	mock := &WebSocketRouter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
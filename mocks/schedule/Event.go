@@ -211,6 +211,54 @@ func (_c *Event_DailyAt_Call) RunAndReturn(run func(string) schedule.Event) *Eve
 	return _c
 }
 
+// DeferOn provides a mock function with given fields: calendar
+func (_m *Event) DeferOn(calendar string) schedule.Event {
+	ret := _m.Called(calendar)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeferOn")
+	}
+
+	var r0 schedule.Event
+	if rf, ok := ret.Get(0).(func(string) schedule.Event); ok {
+		r0 = rf(calendar)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(schedule.Event)
+		}
+	}
+
+	return r0
+}
+
+// Event_DeferOn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeferOn'
+type Event_DeferOn_Call struct {
+	*mock.Call
+}
+
+// DeferOn is a helper method to define mock.On call
+//   - calendar string
+func (_e *Event_Expecter) DeferOn(calendar interface{}) *Event_DeferOn_Call {
+	return &Event_DeferOn_Call{Call: _e.mock.On("DeferOn", calendar)}
+}
+
+func (_c *Event_DeferOn_Call) Run(run func(calendar string)) *Event_DeferOn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Event_DeferOn_Call) Return(_a0 schedule.Event) *Event_DeferOn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Event_DeferOn_Call) RunAndReturn(run func(string) schedule.Event) *Event_DeferOn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DelayIfStillRunning provides a mock function with given fields:
 func (_m *Event) DelayIfStillRunning() schedule.Event {
 	ret := _m.Called()
@@ -822,6 +870,51 @@ func (_c *Event_EveryTwoMinutes_Call) RunAndReturn(run func() schedule.Event) *E
 	return _c
 }
 
+// GetCalendar provides a mock function with given fields:
+func (_m *Event) GetCalendar() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendar")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Event_GetCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendar'
+type Event_GetCalendar_Call struct {
+	*mock.Call
+}
+
+// GetCalendar is a helper method to define mock.On call
+func (_e *Event_Expecter) GetCalendar() *Event_GetCalendar_Call {
+	return &Event_GetCalendar_Call{Call: _e.mock.On("GetCalendar")}
+}
+
+func (_c *Event_GetCalendar_Call) Run(run func()) *Event_GetCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Event_GetCalendar_Call) Return(_a0 string) *Event_GetCalendar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Event_GetCalendar_Call) RunAndReturn(run func() string) *Event_GetCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCallback provides a mock function with given fields:
 func (_m *Event) GetCallback() func() {
 	ret := _m.Called()
@@ -959,6 +1052,51 @@ func (_c *Event_GetCron_Call) RunAndReturn(run func() string) *Event_GetCron_Cal
 	return _c
 }
 
+// GetDeferOnCalendar provides a mock function with given fields:
+func (_m *Event) GetDeferOnCalendar() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeferOnCalendar")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Event_GetDeferOnCalendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeferOnCalendar'
+type Event_GetDeferOnCalendar_Call struct {
+	*mock.Call
+}
+
+// GetDeferOnCalendar is a helper method to define mock.On call
+func (_e *Event_Expecter) GetDeferOnCalendar() *Event_GetDeferOnCalendar_Call {
+	return &Event_GetDeferOnCalendar_Call{Call: _e.mock.On("GetDeferOnCalendar")}
+}
+
+func (_c *Event_GetDeferOnCalendar_Call) Run(run func()) *Event_GetDeferOnCalendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Event_GetDeferOnCalendar_Call) Return(_a0 bool) *Event_GetDeferOnCalendar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Event_GetDeferOnCalendar_Call) RunAndReturn(run func() bool) *Event_GetDeferOnCalendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDelayIfStillRunning provides a mock function with given fields:
 func (_m *Event) GetDelayIfStillRunning() bool {
 	ret := _m.Called()
@@ -1376,6 +1514,54 @@ func (_c *Event_SkipIfStillRunning_Call) RunAndReturn(run func() schedule.Event)
 	return _c
 }
 
+// SkipOn provides a mock function with given fields: calendar
+func (_m *Event) SkipOn(calendar string) schedule.Event {
+	ret := _m.Called(calendar)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkipOn")
+	}
+
+	var r0 schedule.Event
+	if rf, ok := ret.Get(0).(func(string) schedule.Event); ok {
+		r0 = rf(calendar)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(schedule.Event)
+		}
+	}
+
+	return r0
+}
+
+// Event_SkipOn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SkipOn'
+type Event_SkipOn_Call struct {
+	*mock.Call
+}
+
+// SkipOn is a helper method to define mock.On call
+//   - calendar string
+func (_e *Event_Expecter) SkipOn(calendar interface{}) *Event_SkipOn_Call {
+	return &Event_SkipOn_Call{Call: _e.mock.On("SkipOn", calendar)}
+}
+
+func (_c *Event_SkipOn_Call) Run(run func(calendar string)) *Event_SkipOn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Event_SkipOn_Call) Return(_a0 schedule.Event) *Event_SkipOn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Event_SkipOn_Call) RunAndReturn(run func(string) schedule.Event) *Event_SkipOn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewEvent creates a new instance of Event. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEvent(t interface {
@@ -64,6 +64,101 @@ func (_c *Errors_All_Call) RunAndReturn(run func() map[string]map[string]string)
 	return _c
 }
 
+// AllCodes provides a mock function with given fields:
+func (_m *Errors) AllCodes() map[string]map[string]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllCodes")
+	}
+
+	var r0 map[string]map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// Errors_AllCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllCodes'
+type Errors_AllCodes_Call struct {
+	*mock.Call
+}
+
+// AllCodes is a helper method to define mock.On call
+func (_e *Errors_Expecter) AllCodes() *Errors_AllCodes_Call {
+	return &Errors_AllCodes_Call{Call: _e.mock.On("AllCodes")}
+}
+
+func (_c *Errors_AllCodes_Call) Run(run func()) *Errors_AllCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Errors_AllCodes_Call) Return(_a0 map[string]map[string]string) *Errors_AllCodes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Errors_AllCodes_Call) RunAndReturn(run func() map[string]map[string]string) *Errors_AllCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Codes provides a mock function with given fields: key
+func (_m *Errors) Codes(key string) map[string]string {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Codes")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// Errors_Codes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Codes'
+type Errors_Codes_Call struct {
+	*mock.Call
+}
+
+// Codes is a helper method to define mock.On call
+//   - key string
+func (_e *Errors_Expecter) Codes(key interface{}) *Errors_Codes_Call {
+	return &Errors_Codes_Call{Call: _e.mock.On("Codes", key)}
+}
+
+func (_c *Errors_Codes_Call) Run(run func(key string)) *Errors_Codes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Errors_Codes_Call) Return(_a0 map[string]string) *Errors_Codes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Errors_Codes_Call) RunAndReturn(run func(string) map[string]string) *Errors_Codes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: key
 func (_m *Errors) Get(key string) map[string]string {
 	ret := _m.Called(key)
@@ -0,0 +1,168 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package websocket
+
+import mock "github.com/stretchr/testify/mock"
+
+// Connection is an autogenerated mock type for the Connection type
+type Connection struct {
+	mock.Mock
+}
+
+type Connection_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Connection) EXPECT() *Connection_Expecter {
+	return &Connection_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *Connection) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Connection_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type Connection_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *Connection_Expecter) Close() *Connection_Close_Call {
+	return &Connection_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *Connection_Close_Call) Run(run func()) *Connection_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Connection_Close_Call) Return(_a0 error) *Connection_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Connection_Close_Call) RunAndReturn(run func() error) *Connection_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ID provides a mock function with given fields:
+func (_m *Connection) ID() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ID")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Connection_ID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ID'
+type Connection_ID_Call struct {
+	*mock.Call
+}
+
+// ID is a helper method to define mock.On call
+func (_e *Connection_Expecter) ID() *Connection_ID_Call {
+	return &Connection_ID_Call{Call: _e.mock.On("ID")}
+}
+
+func (_c *Connection_ID_Call) Run(run func()) *Connection_ID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Connection_ID_Call) Return(_a0 string) *Connection_ID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Connection_ID_Call) RunAndReturn(run func() string) *Connection_ID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: message
+func (_m *Connection) Send(message []byte) error {
+	ret := _m.Called(message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Connection_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Connection_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - message []byte
+func (_e *Connection_Expecter) Send(message interface{}) *Connection_Send_Call {
+	return &Connection_Send_Call{Call: _e.mock.On("Send", message)}
+}
+
+func (_c *Connection_Send_Call) Run(run func(message []byte)) *Connection_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *Connection_Send_Call) Return(_a0 error) *Connection_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Connection_Send_Call) RunAndReturn(run func([]byte) error) *Connection_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConnection creates a new instance of Connection. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConnection(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Connection {
+	mock := &Connection{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,834 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package websocket
+
+import (
+	context "context"
+
+	access "github.com/goravel/framework/contracts/auth/access"
+
+	http "github.com/goravel/framework/contracts/http"
+
+	mock "github.com/stretchr/testify/mock"
+
+	route "github.com/goravel/framework/contracts/route"
+
+	websocket "github.com/goravel/framework/contracts/websocket"
+)
+
+// Hub is an autogenerated mock type for the Hub type
+type Hub struct {
+	mock.Mock
+}
+
+type Hub_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Hub) EXPECT() *Hub_Expecter {
+	return &Hub_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: connection
+func (_m *Hub) Add(connection websocket.Connection) {
+	_m.Called(connection)
+}
+
+// Hub_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type Hub_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - connection websocket.Connection
+func (_e *Hub_Expecter) Add(connection interface{}) *Hub_Add_Call {
+	return &Hub_Add_Call{Call: _e.mock.On("Add", connection)}
+}
+
+func (_c *Hub_Add_Call) Run(run func(connection websocket.Connection)) *Hub_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(websocket.Connection))
+	})
+	return _c
+}
+
+func (_c *Hub_Add_Call) Return() *Hub_Add_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Hub_Add_Call) RunAndReturn(run func(websocket.Connection)) *Hub_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Allow provides a mock function with given fields: id
+func (_m *Hub) Allow(id string) bool {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allow")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Hub_Allow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allow'
+type Hub_Allow_Call struct {
+	*mock.Call
+}
+
+// Allow is a helper method to define mock.On call
+//   - id string
+func (_e *Hub_Expecter) Allow(id interface{}) *Hub_Allow_Call {
+	return &Hub_Allow_Call{Call: _e.mock.On("Allow", id)}
+}
+
+func (_c *Hub_Allow_Call) Run(run func(id string)) *Hub_Allow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_Allow_Call) Return(_a0 bool) *Hub_Allow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_Allow_Call) RunAndReturn(run func(string) bool) *Hub_Allow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Authenticate provides a mock function with given fields: id, user
+func (_m *Hub) Authenticate(id string, user string) error {
+	ret := _m.Called(id, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Hub_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type Hub_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - id string
+//   - user string
+func (_e *Hub_Expecter) Authenticate(id interface{}, user interface{}) *Hub_Authenticate_Call {
+	return &Hub_Authenticate_Call{Call: _e.mock.On("Authenticate", id, user)}
+}
+
+func (_c *Hub_Authenticate_Call) Run(run func(id string, user string)) *Hub_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_Authenticate_Call) Return(_a0 error) *Hub_Authenticate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_Authenticate_Call) RunAndReturn(run func(string, string) error) *Hub_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Channel provides a mock function with given fields: pattern, callback
+func (_m *Hub) Channel(pattern string, callback func(context.Context, map[string]interface{}) access.Response) {
+	_m.Called(pattern, callback)
+}
+
+// Hub_Channel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Channel'
+type Hub_Channel_Call struct {
+	*mock.Call
+}
+
+// Channel is a helper method to define mock.On call
+//   - pattern string
+//   - callback func(context.Context , map[string]interface{}) access.Response
+func (_e *Hub_Expecter) Channel(pattern interface{}, callback interface{}) *Hub_Channel_Call {
+	return &Hub_Channel_Call{Call: _e.mock.On("Channel", pattern, callback)}
+}
+
+func (_c *Hub_Channel_Call) Run(run func(pattern string, callback func(context.Context, map[string]interface{}) access.Response)) *Hub_Channel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(context.Context, map[string]interface{}) access.Response))
+	})
+	return _c
+}
+
+func (_c *Hub_Channel_Call) Return() *Hub_Channel_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Hub_Channel_Call) RunAndReturn(run func(string, func(context.Context, map[string]interface{}) access.Response)) *Hub_Channel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Connection provides a mock function with given fields: id
+func (_m *Hub) Connection(id string) (websocket.Connection, bool) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Connection")
+	}
+
+	var r0 websocket.Connection
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (websocket.Connection, bool)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) websocket.Connection); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(websocket.Connection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Hub_Connection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connection'
+type Hub_Connection_Call struct {
+	*mock.Call
+}
+
+// Connection is a helper method to define mock.On call
+//   - id string
+func (_e *Hub_Expecter) Connection(id interface{}) *Hub_Connection_Call {
+	return &Hub_Connection_Call{Call: _e.mock.On("Connection", id)}
+}
+
+func (_c *Hub_Connection_Call) Run(run func(id string)) *Hub_Connection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_Connection_Call) Return(_a0 websocket.Connection, _a1 bool) *Hub_Connection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Hub_Connection_Call) RunAndReturn(run func(string) (websocket.Connection, bool)) *Hub_Connection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Count provides a mock function with given fields:
+func (_m *Hub) Count() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Hub_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type Hub_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+func (_e *Hub_Expecter) Count() *Hub_Count_Call {
+	return &Hub_Count_Call{Call: _e.mock.On("Count")}
+}
+
+func (_c *Hub_Count_Call) Run(run func()) *Hub_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Hub_Count_Call) Return(_a0 int) *Hub_Count_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_Count_Call) RunAndReturn(run func() int) *Hub_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Emit provides a mock function with given fields: message
+func (_m *Hub) Emit(message []byte) error {
+	ret := _m.Called(message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Emit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Hub_Emit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Emit'
+type Hub_Emit_Call struct {
+	*mock.Call
+}
+
+// Emit is a helper method to define mock.On call
+//   - message []byte
+func (_e *Hub_Expecter) Emit(message interface{}) *Hub_Emit_Call {
+	return &Hub_Emit_Call{Call: _e.mock.On("Emit", message)}
+}
+
+func (_c *Hub_Emit_Call) Run(run func(message []byte)) *Hub_Emit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *Hub_Emit_Call) Return(_a0 error) *Hub_Emit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_Emit_Call) RunAndReturn(run func([]byte) error) *Hub_Emit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EmitToRoom provides a mock function with given fields: room, message
+func (_m *Hub) EmitToRoom(room string, message []byte) error {
+	ret := _m.Called(room, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmitToRoom")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []byte) error); ok {
+		r0 = rf(room, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Hub_EmitToRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmitToRoom'
+type Hub_EmitToRoom_Call struct {
+	*mock.Call
+}
+
+// EmitToRoom is a helper method to define mock.On call
+//   - room string
+//   - message []byte
+func (_e *Hub_Expecter) EmitToRoom(room interface{}, message interface{}) *Hub_EmitToRoom_Call {
+	return &Hub_EmitToRoom_Call{Call: _e.mock.On("EmitToRoom", room, message)}
+}
+
+func (_c *Hub_EmitToRoom_Call) Run(run func(room string, message []byte)) *Hub_EmitToRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *Hub_EmitToRoom_Call) Return(_a0 error) *Hub_EmitToRoom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_EmitToRoom_Call) RunAndReturn(run func(string, []byte) error) *Hub_EmitToRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EmitToUser provides a mock function with given fields: user, message
+func (_m *Hub) EmitToUser(user string, message []byte) error {
+	ret := _m.Called(user, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmitToUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []byte) error); ok {
+		r0 = rf(user, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Hub_EmitToUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmitToUser'
+type Hub_EmitToUser_Call struct {
+	*mock.Call
+}
+
+// EmitToUser is a helper method to define mock.On call
+//   - user string
+//   - message []byte
+func (_e *Hub_Expecter) EmitToUser(user interface{}, message interface{}) *Hub_EmitToUser_Call {
+	return &Hub_EmitToUser_Call{Call: _e.mock.On("EmitToUser", user, message)}
+}
+
+func (_c *Hub_EmitToUser_Call) Run(run func(user string, message []byte)) *Hub_EmitToUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *Hub_EmitToUser_Call) Return(_a0 error) *Hub_EmitToUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_EmitToUser_Call) RunAndReturn(run func(string, []byte) error) *Hub_EmitToUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Join provides a mock function with given fields: id, room
+func (_m *Hub) Join(id string, room string) error {
+	ret := _m.Called(id, room)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Join")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, room)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Hub_Join_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Join'
+type Hub_Join_Call struct {
+	*mock.Call
+}
+
+// Join is a helper method to define mock.On call
+//   - id string
+//   - room string
+func (_e *Hub_Expecter) Join(id interface{}, room interface{}) *Hub_Join_Call {
+	return &Hub_Join_Call{Call: _e.mock.On("Join", id, room)}
+}
+
+func (_c *Hub_Join_Call) Run(run func(id string, room string)) *Hub_Join_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_Join_Call) Return(_a0 error) *Hub_Join_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_Join_Call) RunAndReturn(run func(string, string) error) *Hub_Join_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JoinChannel provides a mock function with given fields: ctx, id, channel
+func (_m *Hub) JoinChannel(ctx context.Context, id string, channel string) error {
+	ret := _m.Called(ctx, id, channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JoinChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, channel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Hub_JoinChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinChannel'
+type Hub_JoinChannel_Call struct {
+	*mock.Call
+}
+
+// JoinChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - channel string
+func (_e *Hub_Expecter) JoinChannel(ctx interface{}, id interface{}, channel interface{}) *Hub_JoinChannel_Call {
+	return &Hub_JoinChannel_Call{Call: _e.mock.On("JoinChannel", ctx, id, channel)}
+}
+
+func (_c *Hub_JoinChannel_Call) Run(run func(ctx context.Context, id string, channel string)) *Hub_JoinChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_JoinChannel_Call) Return(_a0 error) *Hub_JoinChannel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_JoinChannel_Call) RunAndReturn(run func(context.Context, string, string) error) *Hub_JoinChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Leave provides a mock function with given fields: id, room
+func (_m *Hub) Leave(id string, room string) {
+	_m.Called(id, room)
+}
+
+// Hub_Leave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Leave'
+type Hub_Leave_Call struct {
+	*mock.Call
+}
+
+// Leave is a helper method to define mock.On call
+//   - id string
+//   - room string
+func (_e *Hub_Expecter) Leave(id interface{}, room interface{}) *Hub_Leave_Call {
+	return &Hub_Leave_Call{Call: _e.mock.On("Leave", id, room)}
+}
+
+func (_c *Hub_Leave_Call) Run(run func(id string, room string)) *Hub_Leave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_Leave_Call) Return() *Hub_Leave_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Hub_Leave_Call) RunAndReturn(run func(string, string)) *Hub_Leave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Members provides a mock function with given fields: room
+func (_m *Hub) Members(room string) []string {
+	ret := _m.Called(room)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Members")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(room)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// Hub_Members_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Members'
+type Hub_Members_Call struct {
+	*mock.Call
+}
+
+// Members is a helper method to define mock.On call
+//   - room string
+func (_e *Hub_Expecter) Members(room interface{}) *Hub_Members_Call {
+	return &Hub_Members_Call{Call: _e.mock.On("Members", room)}
+}
+
+func (_c *Hub_Members_Call) Run(run func(room string)) *Hub_Members_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_Members_Call) Return(_a0 []string) *Hub_Members_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_Members_Call) RunAndReturn(run func(string) []string) *Hub_Members_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: id
+func (_m *Hub) Remove(id string) {
+	_m.Called(id)
+}
+
+// Hub_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type Hub_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - id string
+func (_e *Hub_Expecter) Remove(id interface{}) *Hub_Remove_Call {
+	return &Hub_Remove_Call{Call: _e.mock.On("Remove", id)}
+}
+
+func (_c *Hub_Remove_Call) Run(run func(id string)) *Hub_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_Remove_Call) Return() *Hub_Remove_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Hub_Remove_Call) RunAndReturn(run func(string)) *Hub_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rooms provides a mock function with given fields: id
+func (_m *Hub) Rooms(id string) []string {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rooms")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// Hub_Rooms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rooms'
+type Hub_Rooms_Call struct {
+	*mock.Call
+}
+
+// Rooms is a helper method to define mock.On call
+//   - id string
+func (_e *Hub_Expecter) Rooms(id interface{}) *Hub_Rooms_Call {
+	return &Hub_Rooms_Call{Call: _e.mock.On("Rooms", id)}
+}
+
+func (_c *Hub_Rooms_Call) Run(run func(id string)) *Hub_Rooms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_Rooms_Call) Return(_a0 []string) *Hub_Rooms_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_Rooms_Call) RunAndReturn(run func(string) []string) *Hub_Rooms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Route provides a mock function with given fields: router, relativePath, handler
+func (_m *Hub) Route(router route.Router, relativePath string, handler http.WebSocketHandlerFunc) {
+	_m.Called(router, relativePath, handler)
+}
+
+// Hub_Route_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Route'
+type Hub_Route_Call struct {
+	*mock.Call
+}
+
+// Route is a helper method to define mock.On call
+//   - router route.Router
+//   - relativePath string
+//   - handler http.WebSocketHandlerFunc
+func (_e *Hub_Expecter) Route(router interface{}, relativePath interface{}, handler interface{}) *Hub_Route_Call {
+	return &Hub_Route_Call{Call: _e.mock.On("Route", router, relativePath, handler)}
+}
+
+func (_c *Hub_Route_Call) Run(run func(router route.Router, relativePath string, handler http.WebSocketHandlerFunc)) *Hub_Route_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(route.Router), args[1].(string), args[2].(http.WebSocketHandlerFunc))
+	})
+	return _c
+}
+
+func (_c *Hub_Route_Call) Return() *Hub_Route_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Hub_Route_Call) RunAndReturn(run func(route.Router, string, http.WebSocketHandlerFunc)) *Hub_Route_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upgrade provides a mock function with given fields: handler
+func (_m *Hub) Upgrade(handler http.WebSocketHandlerFunc) http.HandlerFunc {
+	ret := _m.Called(handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upgrade")
+	}
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func(http.WebSocketHandlerFunc) http.HandlerFunc); ok {
+		r0 = rf(handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Hub_Upgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upgrade'
+type Hub_Upgrade_Call struct {
+	*mock.Call
+}
+
+// Upgrade is a helper method to define mock.On call
+//   - handler http.WebSocketHandlerFunc
+func (_e *Hub_Expecter) Upgrade(handler interface{}) *Hub_Upgrade_Call {
+	return &Hub_Upgrade_Call{Call: _e.mock.On("Upgrade", handler)}
+}
+
+func (_c *Hub_Upgrade_Call) Run(run func(handler http.WebSocketHandlerFunc)) *Hub_Upgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.WebSocketHandlerFunc))
+	})
+	return _c
+}
+
+func (_c *Hub_Upgrade_Call) Return(_a0 http.HandlerFunc) *Hub_Upgrade_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_Upgrade_Call) RunAndReturn(run func(http.WebSocketHandlerFunc) http.HandlerFunc) *Hub_Upgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// User provides a mock function with given fields: id
+func (_m *Hub) User(id string) string {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for User")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Hub_User_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'User'
+type Hub_User_Call struct {
+	*mock.Call
+}
+
+// User is a helper method to define mock.On call
+//   - id string
+func (_e *Hub_Expecter) User(id interface{}) *Hub_User_Call {
+	return &Hub_User_Call{Call: _e.mock.On("User", id)}
+}
+
+func (_c *Hub_User_Call) Run(run func(id string)) *Hub_User_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Hub_User_Call) Return(_a0 string) *Hub_User_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Hub_User_Call) RunAndReturn(run func(string) string) *Hub_User_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHub creates a new instance of Hub. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHub(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Hub {
+	mock := &Hub{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,211 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/brokers/amqp"
+	"github.com/RichardKnop/machinery/v2/brokers/errs"
+	"github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/common"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	amqp091 "github.com/rabbitmq/amqp091-go"
+)
+
+// AmqpBroker wraps the machinery AMQP broker to publish the jobs with the routing key of the connection and
+// move the failed jobs to a dead-letter exchange, machinery acknowledges the failed jobs so they are published
+// to the dead-letter exchange by the broker, the jobs rejected by RabbitMQ are dead-lettered by the queue itself.
+// The delayed jobs are published to the delayed exchange if it's set, otherwise machinery publishes them to a
+// temporary queue with a TTL.
+type AmqpBroker struct {
+	iface.Broker
+	common.AMQPConnector
+	cnf                *config.Config
+	routingKey         string
+	deadLetterExchange string
+	deadLetterQueue    string
+	delayedExchange    string
+
+	mu              sync.Mutex
+	channel         *amqp091.Channel
+	confirmation    <-chan amqp091.Confirmation
+	delayedChannels map[string]*amqpChannel
+}
+
+type amqpChannel struct {
+	channel      *amqp091.Channel
+	confirmation <-chan amqp091.Confirmation
+}
+
+func NewAmqpBroker(cnf *config.Config, routingKey, deadLetterExchange, deadLetterQueue, delayedExchange string) *AmqpBroker {
+	if deadLetterExchange != "" {
+		if cnf.AMQP.QueueDeclareArgs == nil {
+			cnf.AMQP.QueueDeclareArgs = make(config.QueueDeclareArgs)
+		}
+		cnf.AMQP.QueueDeclareArgs["x-dead-letter-exchange"] = deadLetterExchange
+	}
+
+	return &AmqpBroker{
+		Broker:             amqp.New(cnf),
+		cnf:                cnf,
+		routingKey:         routingKey,
+		deadLetterExchange: deadLetterExchange,
+		deadLetterQueue:    deadLetterQueue,
+		delayedExchange:    delayedExchange,
+		delayedChannels:    make(map[string]*amqpChannel),
+	}
+}
+
+func (r *AmqpBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	return r.Broker.StartConsuming(consumerTag, concurrency, &deadLetterProcessor{TaskProcessor: taskProcessor, broker: r})
+}
+
+func (r *AmqpBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	if signature.RoutingKey == "" {
+		signature.RoutingKey = r.routingKey
+	}
+	if r.deadLetterExchange != "" {
+		// Machinery returns errs.ErrStopTaskDeletion when the job fails, so the job can be dead-lettered.
+		signature.StopTaskDeletionOnError = true
+	}
+	if r.delayedExchange != "" && signature.ETA != nil {
+		if delay := time.Until(*signature.ETA); delay > 0 {
+			return r.delay(signature, delay)
+		}
+	}
+
+	return r.Broker.Publish(ctx, signature)
+}
+
+// delay Publish a delayed job to the delayed exchange, the exchange holds the job until the delay expires and
+// routes it to the queue of the job then. The exchange and the binding of a queue are declared by the first
+// delayed job of the queue.
+func (r *AmqpBroker) delay(signature *tasks.Signature, delay time.Duration) error {
+	body, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	// The queue and the binding key are resolved like machinery does when publishing a job.
+	queue, bindingKey := r.cnf.DefaultQueue, r.cnf.AMQP.BindingKey
+	if r.cnf.AMQP.ExchangeType == "direct" {
+		queue, bindingKey = signature.RoutingKey, signature.RoutingKey
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delayed, exist := r.delayedChannels[queue]
+	if !exist || delayed.channel.IsClosed() {
+		_, channel, _, confirmation, _, err := r.Connect(
+			r.cnf.Broker,
+			r.cnf.MultipleBrokerSeparator,
+			r.cnf.TLSConfig,
+			r.delayedExchange,
+			"x-delayed-message",
+			queue,
+			true,
+			false,
+			bindingKey,
+			amqp091.Table{"x-delayed-type": r.cnf.AMQP.ExchangeType},
+			amqp091.Table(r.cnf.AMQP.QueueDeclareArgs),
+			amqp091.Table(r.cnf.AMQP.QueueBindingArgs),
+		)
+		if err != nil {
+			return err
+		}
+
+		delayed = &amqpChannel{channel: channel, confirmation: confirmation}
+		r.delayedChannels[queue] = delayed
+	}
+
+	headers := amqp091.Table{"x-delay": delay.Milliseconds()}
+	for key, value := range signature.Headers {
+		headers[key] = value
+	}
+	if err := delayed.channel.Publish(r.delayedExchange, signature.RoutingKey, false, false, amqp091.Publishing{
+		Headers:      headers,
+		ContentType:  "application/json",
+		Body:         body,
+		Priority:     signature.Priority,
+		DeliveryMode: amqp091.Persistent,
+	}); err != nil {
+		return err
+	}
+
+	if confirmed := <-delayed.confirmation; !confirmed.Ack {
+		return fmt.Errorf("failed to delay job %s", signature.UUID)
+	}
+
+	return nil
+}
+
+// deadLetter Publish a failed job to the dead-letter exchange, the exchange and the queue bound to it are
+// declared by the first failed job.
+func (r *AmqpBroker) deadLetter(signature *tasks.Signature) error {
+	body, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.channel == nil || r.channel.IsClosed() {
+		_, channel, _, confirmation, _, err := r.Connect(
+			r.cnf.Broker,
+			r.cnf.MultipleBrokerSeparator,
+			r.cnf.TLSConfig,
+			r.deadLetterExchange,
+			"fanout",
+			r.deadLetterQueue,
+			true,
+			false,
+			"",
+			nil,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		r.channel = channel
+		r.confirmation = confirmation
+	}
+
+	if err := r.channel.Publish(r.deadLetterExchange, signature.RoutingKey, false, false, amqp091.Publishing{
+		Headers:      amqp091.Table(signature.Headers),
+		ContentType:  "application/json",
+		Body:         body,
+		DeliveryMode: amqp091.Persistent,
+	}); err != nil {
+		return err
+	}
+
+	if confirmed := <-r.confirmation; !confirmed.Ack {
+		return fmt.Errorf("failed to dead-letter job %s", signature.UUID)
+	}
+
+	return nil
+}
+
+// deadLetterProcessor Dead-letter the jobs that fail after all the retries.
+type deadLetterProcessor struct {
+	iface.TaskProcessor
+	broker *AmqpBroker
+}
+
+func (r *deadLetterProcessor) Process(signature *tasks.Signature) error {
+	err := r.TaskProcessor.Process(signature)
+	if errors.Is(err, errs.ErrStopTaskDeletion) {
+		return r.broker.deadLetter(signature)
+	}
+
+	return err
+}
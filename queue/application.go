@@ -1,21 +1,42 @@
 package queue
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
 	configcontract "github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/log"
 	"github.com/goravel/framework/contracts/queue"
 )
 
 type Application struct {
-	config *Config
-	jobs   []queue.Job
-	log    log.Log
+	batches *BatchRepository
+	config  *Config
+	events  func() event.Instance
+	failer  *FailedJobRepository
+	jobs    []queue.Job
+	log     log.Log
+	metrics *MetricsRepository
+	outages *outages
+	// workers The running workers of the application, they're quit by Shutdown.
+	workers   map[*Worker]struct{}
+	workersMu sync.Mutex
 }
 
 func NewApplication(config configcontract.Config, log log.Log) *Application {
+	queueConfig := NewConfig(config)
+
 	return &Application{
-		config: NewConfig(config),
-		log:    log,
+		batches: NewBatchRepository(queueConfig, log),
+		config:  queueConfig,
+		failer:  NewFailedJobRepository(queueConfig, log),
+		log:     log,
+		metrics: NewMetricsRepository(queueConfig, log),
+		outages: newOutages(),
+		workers: make(map[*Worker]struct{}),
 	}
 }
 
@@ -23,14 +44,49 @@ func (app *Application) Worker(args ...queue.Args) queue.Worker {
 	defaultConnection := app.config.DefaultConnection()
 
 	if len(args) == 0 {
-		return NewWorker(app.config, app.log, 1, defaultConnection, app.jobs, app.config.Queue(defaultConnection, ""))
+		worker := NewWorker(app.config, app.log, 1, defaultConnection, app.jobs, app.config.Queue(defaultConnection, ""))
+		worker.events = app.events
+		worker.onRun = app.trackWorker
+
+		return worker
 	}
 
 	if args[0].Connection == "" {
 		args[0].Connection = defaultConnection
 	}
 
-	return NewWorker(app.config, app.log, args[0].Concurrent, args[0].Connection, app.jobs, app.config.Queue(args[0].Connection, args[0].Queue))
+	worker := NewWorker(app.config, app.log, args[0].Concurrent, args[0].Connection, app.jobs, app.config.Queues(args[0].Connection, args[0].Queue))
+	worker.maxJobs = args[0].MaxJobs
+	worker.maxTime = args[0].MaxTime
+	worker.memory = args[0].Memory
+	worker.events = app.events
+	worker.onRun = app.trackWorker
+
+	return worker
+}
+
+// Shutdown Quit the running workers of the application gracefully, and wait for their running jobs to finish until
+// the context is done.
+func (app *Application) Shutdown(ctx context.Context) error {
+	app.workersMu.Lock()
+	workers := make([]*Worker, 0, len(app.workers))
+	for worker := range app.workers {
+		workers = append(workers, worker)
+	}
+	app.workersMu.Unlock()
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(workers))
+	for i, worker := range workers {
+		wg.Add(1)
+		go func(i int, worker *Worker) {
+			defer wg.Done()
+			errs[i] = worker.Shutdown(ctx)
+		}(i, worker)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
 }
 
 func (app *Application) Register(jobs []queue.Job) {
@@ -42,9 +98,80 @@ func (app *Application) GetJobs() []queue.Job {
 }
 
 func (app *Application) Job(job queue.Job, args []queue.Arg) queue.Task {
-	return NewTask(app.config, app.log, job, args)
+	task := NewTask(app.config, app.log, job, args)
+	task.events = app.events
+	task.outages = app.outages
+
+	return task
 }
 
 func (app *Application) Chain(jobs []queue.Jobs) queue.Task {
-	return NewChainTask(app.config, app.log, jobs)
+	task := NewChainTask(app.config, app.log, jobs)
+	task.events = app.events
+	task.outages = app.outages
+
+	return task
+}
+
+func (app *Application) Batch(jobs []queue.Jobs) queue.PendingBatch {
+	return NewPendingBatch(app.config, app.log, app.batches, jobs, app.jobs)
+}
+
+func (app *Application) FindBatch(id string) (*queue.Batch, error) {
+	return app.batches.Find(id)
+}
+
+func (app *Application) Failer() queue.Failer {
+	return app.failer
+}
+
+// SetEvents Set the events instance the job lifecycle events are dispatched through.
+func (app *Application) SetEvents(events func() event.Instance) {
+	app.events = events
+}
+
+func (app *Application) Monitor() queue.Monitor {
+	return app.metrics
+}
+
+// Clear Delete all the jobs of a queue, only the redis and the database drivers support clearing the queues.
+func (app *Application) Clear(connection, queueName string) (int, error) {
+	if connection == "" {
+		connection = app.config.DefaultConnection()
+	}
+	driver := app.config.Driver(connection)
+	if driver == DriverSync {
+		return 0, nil
+	}
+	if driver != DriverRedis && driver != DriverDatabase {
+		return 0, fmt.Errorf("queue driver [%s] doesn't support clearing the queues", driver)
+	}
+
+	name := app.config.Queue(connection, queueName)
+	server, err := NewMachinery(app.config, app.log).Server(connection, name)
+	if err != nil {
+		return 0, err
+	}
+
+	switch broker := server.GetBroker().(type) {
+	case *RedisBroker:
+		return broker.Clear(name)
+	case *DatabaseBroker:
+		return broker.Clear(name)
+	}
+
+	return 0, fmt.Errorf("queue driver [%s] doesn't support clearing the queues", driver)
+}
+
+// trackWorker Track a worker while it runs, the returned function untracks it once it stops.
+func (app *Application) trackWorker(worker *Worker) func() {
+	app.workersMu.Lock()
+	app.workers[worker] = struct{}{}
+	app.workersMu.Unlock()
+
+	return func() {
+		app.workersMu.Lock()
+		delete(app.workers, worker)
+		app.workersMu.Unlock()
+	}
 }
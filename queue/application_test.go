@@ -57,6 +57,12 @@ func TestQueueTestSuite(t *testing.T) {
 
 func (s *QueueTestSuite) SetupTest() {
 	s.mockConfig = &configmock.Config{}
+	s.mockConfig.On("GetString", "queue.failed.database").Return("").Maybe()
+	s.mockConfig.On("GetString", "database.default").Return("").Maybe()
+	s.mockConfig.On("GetString", "queue.failed.table").Return("").Maybe()
+	s.mockConfig.On("GetString", "queue.metrics.store").Return("").Maybe()
+	s.mockConfig.On("GetBool", "queue.connections.redis.encrypt").Return(false).Maybe()
+	s.mockConfig.On("GetBool", "queue.connections.custom.encrypt").Return(false).Maybe()
 	s.mockLog = &logmock.Log{}
 	s.app = NewApplication(s.mockConfig, s.mockLog)
 }
@@ -76,11 +82,16 @@ func (s *QueueTestSuite) TestDefaultAsyncQueue_EnableDebug() {
 	s.mockConfig.On("GetBool", "app.debug").Return(true).Times(2)
 	s.mockConfig.On("GetString", "queue.connections.redis.queue", "default").Return("default").Times(2)
 	s.mockConfig.On("GetString", "queue.connections.redis.driver").Return("redis").Times(3)
+	s.mockConfig.On("GetString", "queue.connections.redis.compression").Return("").Once()
+	s.mockConfig.On("GetInt", "queue.connections.redis.compression_threshold", 65536).Return(65536).Once()
 	s.mockConfig.On("GetString", "queue.connections.redis.connection").Return("default").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.host").Return("localhost").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.password").Return("").Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.port").Return(s.port).Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.database").Return(0).Twice()
+	s.mockConfig.On("Get", "database.redis.default.cluster").Return(nil).Twice()
+	s.mockConfig.On("GetString", "database.redis.default.sentinel.master_name").Return("").Twice()
+	s.mockConfig.On("GetInt", "queue.connections.redis.retry_after", 60).Return(60).Twice()
 	s.mockLog.On("Infof", "Launching a worker with the following settings:").Once()
 	s.mockLog.On("Infof", "- Broker: %s", "://").Once()
 	s.mockLog.On("Infof", "- DefaultQueue: %s", "goravel_queues:debug").Once()
@@ -119,11 +130,16 @@ func (s *QueueTestSuite) TestDefaultAsyncQueue_DisableDebug() {
 	s.mockConfig.On("GetBool", "app.debug").Return(false).Times(2)
 	s.mockConfig.On("GetString", "queue.connections.redis.queue", "default").Return("default").Times(3)
 	s.mockConfig.On("GetString", "queue.connections.redis.driver").Return("redis").Times(3)
+	s.mockConfig.On("GetString", "queue.connections.redis.compression").Return("").Once()
+	s.mockConfig.On("GetInt", "queue.connections.redis.compression_threshold", 65536).Return(65536).Once()
 	s.mockConfig.On("GetString", "queue.connections.redis.connection").Return("default").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.host").Return("localhost").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.password").Return("").Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.port").Return(s.port).Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.database").Return(0).Twice()
+	s.mockConfig.On("Get", "database.redis.default.cluster").Return(nil).Twice()
+	s.mockConfig.On("GetString", "database.redis.default.sentinel.master_name").Return("").Twice()
+	s.mockConfig.On("GetInt", "queue.connections.redis.retry_after", 60).Return(60).Twice()
 	s.app.jobs = []queue.Job{&TestAsyncJobOfDisableDebug{}}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -153,11 +169,16 @@ func (s *QueueTestSuite) TestDelayAsyncQueue() {
 	s.mockConfig.On("GetBool", "app.debug").Return(false).Times(2)
 	s.mockConfig.On("GetString", "queue.connections.redis.queue", "default").Return("default").Twice()
 	s.mockConfig.On("GetString", "queue.connections.redis.driver").Return("redis").Times(3)
+	s.mockConfig.On("GetString", "queue.connections.redis.compression").Return("").Once()
+	s.mockConfig.On("GetInt", "queue.connections.redis.compression_threshold", 65536).Return(65536).Once()
 	s.mockConfig.On("GetString", "queue.connections.redis.connection").Return("default").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.host").Return("localhost").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.password").Return("").Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.port").Return(s.port).Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.database").Return(0).Twice()
+	s.mockConfig.On("Get", "database.redis.default.cluster").Return(nil).Twice()
+	s.mockConfig.On("GetString", "database.redis.default.sentinel.master_name").Return("").Twice()
+	s.mockConfig.On("GetInt", "queue.connections.redis.retry_after", 60).Return(60).Twice()
 	s.app.jobs = []queue.Job{&TestDelayAsyncJob{}}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -190,11 +211,16 @@ func (s *QueueTestSuite) TestCustomAsyncQueue() {
 	s.mockConfig.On("GetBool", "app.debug").Return(false).Times(2)
 	s.mockConfig.On("GetString", "queue.connections.custom.queue", "default").Return("default").Twice()
 	s.mockConfig.On("GetString", "queue.connections.custom.driver").Return("redis").Times(3)
+	s.mockConfig.On("GetString", "queue.connections.custom.compression").Return("").Once()
+	s.mockConfig.On("GetInt", "queue.connections.custom.compression_threshold", 65536).Return(65536).Once()
 	s.mockConfig.On("GetString", "queue.connections.custom.connection").Return("default").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.host").Return("localhost").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.password").Return("").Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.port").Return(s.port).Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.database").Return(0).Twice()
+	s.mockConfig.On("Get", "database.redis.default.cluster").Return(nil).Twice()
+	s.mockConfig.On("GetString", "database.redis.default.sentinel.master_name").Return("").Twice()
+	s.mockConfig.On("GetInt", "queue.connections.redis.retry_after", 60).Return(60).Twice()
 	s.app.jobs = []queue.Job{&TestCustomAsyncJob{}}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -227,11 +253,16 @@ func (s *QueueTestSuite) TestErrorAsyncQueue() {
 	s.mockConfig.On("GetBool", "app.debug").Return(false).Times(2)
 	s.mockConfig.On("GetString", "queue.connections.redis.queue", "default").Return("default").Twice()
 	s.mockConfig.On("GetString", "queue.connections.redis.driver").Return("redis").Times(3)
+	s.mockConfig.On("GetString", "queue.connections.redis.compression").Return("").Once()
+	s.mockConfig.On("GetInt", "queue.connections.redis.compression_threshold", 65536).Return(65536).Once()
 	s.mockConfig.On("GetString", "queue.connections.redis.connection").Return("default").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.host").Return("localhost").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.password").Return("").Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.port").Return(s.port).Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.database").Return(0).Twice()
+	s.mockConfig.On("Get", "database.redis.default.cluster").Return(nil).Twice()
+	s.mockConfig.On("GetString", "database.redis.default.sentinel.master_name").Return("").Twice()
+	s.mockConfig.On("GetInt", "queue.connections.redis.retry_after", 60).Return(60).Twice()
 	s.app.jobs = []queue.Job{&TestErrorAsyncJob{}}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -262,11 +293,16 @@ func (s *QueueTestSuite) TestChainAsyncQueue() {
 	s.mockConfig.On("GetBool", "app.debug").Return(false).Times(2)
 	s.mockConfig.On("GetString", "queue.connections.redis.queue", "default").Return("default").Twice()
 	s.mockConfig.On("GetString", "queue.connections.redis.driver").Return("redis").Times(3)
+	s.mockConfig.On("GetString", "queue.connections.redis.compression").Return("").Once()
+	s.mockConfig.On("GetInt", "queue.connections.redis.compression_threshold", 65536).Return(65536).Once()
 	s.mockConfig.On("GetString", "queue.connections.redis.connection").Return("default").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.host").Return("localhost").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.password").Return("").Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.port").Return(s.port).Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.database").Return(0).Twice()
+	s.mockConfig.On("Get", "database.redis.default.cluster").Return(nil).Twice()
+	s.mockConfig.On("GetString", "database.redis.default.sentinel.master_name").Return("").Twice()
+	s.mockConfig.On("GetInt", "queue.connections.redis.retry_after", 60).Return(60).Twice()
 	s.app.jobs = []queue.Job{&TestChainAsyncJob{}, &TestChainSyncJob{}}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -312,11 +348,16 @@ func (s *QueueTestSuite) TestChainAsyncQueue_Error() {
 	s.mockConfig.On("GetBool", "app.debug").Return(false).Times(2)
 	s.mockConfig.On("GetString", "queue.connections.redis.queue", "default").Return("default").Twice()
 	s.mockConfig.On("GetString", "queue.connections.redis.driver").Return("redis").Times(3)
+	s.mockConfig.On("GetString", "queue.connections.redis.compression").Return("").Once()
+	s.mockConfig.On("GetInt", "queue.connections.redis.compression_threshold", 65536).Return(65536).Once()
 	s.mockConfig.On("GetString", "queue.connections.redis.connection").Return("default").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.host").Return("localhost").Twice()
 	s.mockConfig.On("GetString", "database.redis.default.password").Return("").Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.port").Return(s.port).Twice()
 	s.mockConfig.On("GetInt", "database.redis.default.database").Return(0).Twice()
+	s.mockConfig.On("Get", "database.redis.default.cluster").Return(nil).Twice()
+	s.mockConfig.On("GetString", "database.redis.default.sentinel.master_name").Return("").Twice()
+	s.mockConfig.On("GetInt", "queue.connections.redis.retry_after", 60).Return(60).Twice()
 	s.mockLog.On("Errorf", "Failed processing task %s. Error = %v", mock.Anything, errors.New("error")).Once()
 	s.app.jobs = []queue.Job{&TestChainAsyncJob{}, &TestChainSyncJob{}}
 
@@ -479,3 +520,38 @@ func (receiver *TestChainSyncJob) Handle(args ...any) error {
 
 	return nil
 }
+
+func TestApplication_Clear(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("queue.default").Return("sync").Once()
+	mockConfig.EXPECT().GetString("queue.connections.sync.driver").Return(DriverSync).Once()
+	mockConfig.EXPECT().GetString("queue.connections.kafka.driver").Return(DriverKafka).Once()
+	app := &Application{config: NewConfig(mockConfig)}
+
+	count, err := app.Clear("", "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = app.Clear("kafka", "default")
+	assert.EqualError(t, err, "queue driver [kafka] doesn't support clearing the queues")
+}
+
+func TestApplication_Shutdown(t *testing.T) {
+	app := &Application{workers: make(map[*Worker]struct{})}
+	worker := NewWorker(nil, nil, 1, "sync", nil, "")
+	worker.running.Store(true)
+
+	// Only the running workers are quit.
+	untrack := app.trackWorker(worker)
+	close(worker.done)
+	assert.Nil(t, app.Shutdown(context.Background()))
+	select {
+	case <-worker.quit:
+	default:
+		t.Error("the worker isn't quit")
+	}
+
+	untrack()
+	assert.Empty(t, app.workers)
+	assert.Nil(t, app.Shutdown(context.Background()))
+}
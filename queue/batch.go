@@ -0,0 +1,422 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/google/uuid"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	logcontract "github.com/goravel/framework/contracts/log"
+	"github.com/goravel/framework/contracts/queue"
+	databasegorm "github.com/goravel/framework/database/gorm"
+)
+
+const batchHeader = "batch_id"
+
+// DatabaseBatch is a row of the batches table, the migration creating the table is made by the queue:batches-table
+// command.
+type DatabaseBatch struct {
+	ID          string
+	Name        string
+	TotalJobs   int
+	PendingJobs int
+	FailedJobs  int
+	Options     string
+	CancelledAt *int64
+	CreatedAt   int64
+	FinishedAt  *int64
+}
+
+func (r DatabaseBatch) toBatch() *queue.Batch {
+	batch := &queue.Batch{
+		ID:          r.ID,
+		Name:        r.Name,
+		TotalJobs:   r.TotalJobs,
+		PendingJobs: r.PendingJobs,
+		FailedJobs:  r.FailedJobs,
+		CreatedAt:   time.Unix(r.CreatedAt, 0),
+	}
+	if r.CancelledAt != nil {
+		cancelledAt := time.Unix(*r.CancelledAt, 0)
+		batch.CancelledAt = &cancelledAt
+	}
+	if r.FinishedAt != nil {
+		finishedAt := time.Unix(*r.FinishedAt, 0)
+		batch.FinishedAt = &finishedAt
+	}
+
+	return batch
+}
+
+type batchCallback struct {
+	Job  string
+	Args []queue.Arg
+}
+
+// batchOptions The options of a batch stored with it, so the worker finishing the batch can dispatch the callbacks.
+type batchOptions struct {
+	Connection string
+	Queue      string
+	Then       *batchCallback
+	Catch      *batchCallback
+	Finally    *batchCallback
+}
+
+// BatchRepository stores the batches in the database and records the results of their jobs. A batch is
+// cancelled by its first failed job, the remaining jobs of a cancelled batch are skipped.
+type BatchRepository struct {
+	config *Config
+	log    logcontract.Log
+	mu     sync.Mutex
+	query  orm.Query
+	table  string
+}
+
+func NewBatchRepository(config *Config, log logcontract.Log) *BatchRepository {
+	return &BatchRepository{
+		config: config,
+		log:    log,
+	}
+}
+
+// Store Create a batch of total pending jobs.
+func (r *BatchRepository) Store(name string, total int, options batchOptions) (*queue.Batch, error) {
+	query, err := r.getQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	id := newID()
+	if id == "" {
+		id = uuid.NewString()
+	}
+	batch := DatabaseBatch{
+		ID:          id,
+		Name:        name,
+		TotalJobs:   total,
+		PendingJobs: total,
+		Options:     string(encoded),
+		CreatedAt:   time.Now().Unix(),
+	}
+	if _, err := query.Exec(fmt.Sprintf("INSERT INTO %s (id, name, total_jobs, pending_jobs, failed_jobs, options, created_at) VALUES (?, ?, ?, ?, 0, ?, ?)", r.table),
+		batch.ID, batch.Name, batch.TotalJobs, batch.PendingJobs, batch.Options, batch.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return batch.toBatch(), nil
+}
+
+// Find Get a batch by its ID.
+func (r *BatchRepository) Find(id string) (*queue.Batch, error) {
+	batch, err := r.find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return batch.toBatch(), nil
+}
+
+// Record Record the result of a job of a batch, the job that cancels or finishes the batch dispatches the callbacks.
+func (r *BatchRepository) Record(id string, jobErr error, jobs []queue.Job) error {
+	return r.record(id, 1, jobErr, jobs)
+}
+
+// Abandon Record the jobs of a batch that couldn't be dispatched as failed, so the batch is cancelled, and it's
+// finished once the dispatched jobs are.
+func (r *BatchRepository) Abandon(id string, count int, dispatchErr error, jobs []queue.Job) error {
+	return r.record(id, count, dispatchErr, jobs)
+}
+
+// record Record the results of count jobs of a batch, they all failed if jobErr isn't nil.
+func (r *BatchRepository) record(id string, count int, jobErr error, jobs []queue.Job) error {
+	query, err := r.getQuery()
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	if jobErr != nil {
+		failed = count
+	}
+	if _, err := query.Exec(fmt.Sprintf("UPDATE %s SET pending_jobs = pending_jobs - ?, failed_jobs = failed_jobs + ? WHERE id = ?", r.table), count, failed, id); err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	cancelled := false
+	if jobErr != nil {
+		result, err := query.Exec(fmt.Sprintf("UPDATE %s SET cancelled_at = ? WHERE id = ? AND cancelled_at IS NULL", r.table), now, id)
+		if err != nil {
+			return err
+		}
+		cancelled = result.RowsAffected > 0
+	}
+
+	result, err := query.Exec(fmt.Sprintf("UPDATE %s SET finished_at = ? WHERE id = ? AND pending_jobs <= 0 AND finished_at IS NULL", r.table), now, id)
+	if err != nil {
+		return err
+	}
+	finished := result.RowsAffected > 0
+	if !cancelled && !finished {
+		return nil
+	}
+
+	batch, err := r.find(id)
+	if err != nil {
+		return err
+	}
+
+	var options batchOptions
+	if err := json.Unmarshal([]byte(batch.Options), &options); err != nil {
+		return err
+	}
+
+	var callbacks []*batchCallback
+	if cancelled {
+		callbacks = append(callbacks, options.Catch)
+	}
+	if finished {
+		if batch.FailedJobs == 0 {
+			callbacks = append(callbacks, options.Then)
+		}
+		callbacks = append(callbacks, options.Finally)
+	}
+
+	for _, callback := range callbacks {
+		if err := r.dispatch(id, callback, options, jobs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Cancelled Determine if a batch is cancelled.
+func (r *BatchRepository) Cancelled(id string) (bool, error) {
+	batch, err := r.find(id)
+	if err != nil {
+		return false, err
+	}
+
+	return batch.CancelledAt != nil, nil
+}
+
+func (r *BatchRepository) find(id string) (*DatabaseBatch, error) {
+	query, err := r.getQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	var batch DatabaseBatch
+	if err := query.Raw(fmt.Sprintf("SELECT * FROM %s WHERE id = ?", r.table), id).Scan(&batch); err != nil {
+		return nil, err
+	}
+	if batch.ID == "" {
+		return nil, fmt.Errorf("batch [%s] not found", id)
+	}
+
+	return &batch, nil
+}
+
+// dispatch Dispatch a callback of a batch, the callback receives the ID of the batch followed by its arguments.
+func (r *BatchRepository) dispatch(id string, callback *batchCallback, options batchOptions, jobs []queue.Job) error {
+	if callback == nil {
+		return nil
+	}
+
+	for _, job := range jobs {
+		if job.Signature() != callback.Job {
+			continue
+		}
+
+		args := append([]queue.Arg{{Type: "string", Value: id}}, callback.Args...)
+		task := NewTask(r.config, r.log, job, args).OnConnection(options.Connection)
+		if options.Queue != "" {
+			task = task.OnQueue(options.Queue)
+		}
+
+		return task.Dispatch()
+	}
+
+	return fmt.Errorf("job [%s] of the batch callback is not registered", callback.Job)
+}
+
+func (r *BatchRepository) getQuery() (orm.Query, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.query != nil {
+		return r.query, nil
+	}
+
+	connection, table := r.config.Batching()
+	query, err := databasegorm.InitializeQuery(context.Background(), r.config.config, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	r.query = query
+	r.table = table
+
+	return r.query, nil
+}
+
+// batchHandler Wrap the handle of a job to record its result if it belongs to a batch, a job released back to the
+// queue or failed with retries left hasn't finished yet, and the jobs of a cancelled batch are skipped.
+func batchHandler(batches *BatchRepository, jobs []queue.Job, handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		signature := tasks.SignatureFromContext(ctx)
+		if signature == nil {
+			return handle(ctx, args...)
+		}
+		id, _ := signature.Headers[batchHeader].(string)
+		if id == "" {
+			return handle(ctx, args...)
+		}
+
+		cancelled, err := batches.Cancelled(id)
+		if err != nil {
+			return err
+		}
+		if cancelled {
+			return batches.Record(id, nil, jobs)
+		}
+
+		err = handle(ctx, args...)
+
+		var retryLater tasks.ErrRetryTaskLater
+		if errors.As(err, &retryLater) || (err != nil && signature.RetryCount > 0) {
+			return err
+		}
+
+		if recordErr := batches.Record(id, err, jobs); recordErr != nil {
+			log.ERROR.Print(recordErr)
+		}
+
+		return err
+	}
+}
+
+type PendingBatch struct {
+	batches    *BatchRepository
+	config     *Config
+	connection string
+	jobs       []queue.Jobs
+	log        logcontract.Log
+	name       string
+	options    batchOptions
+	queue      string
+	registered []queue.Job
+}
+
+func NewPendingBatch(config *Config, log logcontract.Log, batches *BatchRepository, jobs []queue.Jobs, registered []queue.Job) *PendingBatch {
+	return &PendingBatch{
+		batches:    batches,
+		config:     config,
+		connection: config.DefaultConnection(),
+		jobs:       jobs,
+		log:        log,
+		registered: registered,
+	}
+}
+
+func (r *PendingBatch) Name(name string) queue.PendingBatch {
+	r.name = name
+
+	return r
+}
+
+func (r *PendingBatch) Then(job queue.Job, args []queue.Arg) queue.PendingBatch {
+	r.options.Then = &batchCallback{Job: job.Signature(), Args: args}
+
+	return r
+}
+
+func (r *PendingBatch) Catch(job queue.Job, args []queue.Arg) queue.PendingBatch {
+	r.options.Catch = &batchCallback{Job: job.Signature(), Args: args}
+
+	return r
+}
+
+func (r *PendingBatch) Finally(job queue.Job, args []queue.Arg) queue.PendingBatch {
+	r.options.Finally = &batchCallback{Job: job.Signature(), Args: args}
+
+	return r
+}
+
+func (r *PendingBatch) OnConnection(connection string) queue.PendingBatch {
+	r.connection = connection
+
+	return r
+}
+
+func (r *PendingBatch) OnQueue(queue string) queue.PendingBatch {
+	r.queue = queue
+
+	return r
+}
+
+// Dispatch Store the batch and dispatch its jobs, the jobs of the sync driver are handled immediately. If a job
+// can't be dispatched, it and the jobs after it are recorded as failed, so the batch still finishes.
+func (r *PendingBatch) Dispatch() (*queue.Batch, error) {
+	if len(r.jobs) == 0 {
+		return nil, errors.New("the batch has no jobs")
+	}
+
+	r.options.Connection = r.connection
+	r.options.Queue = r.queue
+
+	batch, err := r.batches.Store(r.name, len(r.jobs), r.options)
+	if err != nil {
+		return nil, err
+	}
+
+	sync := r.config.Driver(r.connection) == DriverSync
+	cancelled := false
+	for i, job := range r.jobs {
+		if sync {
+			var jobErr error
+			if !cancelled {
+				var args []any
+				for _, arg := range job.Args {
+					args = append(args, arg.Value)
+				}
+				jobErr = job.Job.Handle(args...)
+				cancelled = jobErr != nil
+			}
+			if err := r.batches.Record(batch.ID, jobErr, r.registered); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		task := NewTask(r.config, r.log, job.Job, job.Args)
+		task.batch = batch.ID
+		task.OnConnection(r.connection)
+		if r.queue != "" {
+			task.OnQueue(r.queue)
+		}
+		if err := task.Dispatch(); err != nil {
+			if abandonErr := r.batches.Abandon(batch.ID, len(r.jobs)-i, err, r.registered); abandonErr != nil {
+				return nil, errors.Join(err, abandonErr)
+			}
+
+			return nil, err
+		}
+	}
+
+	return r.batches.Find(batch.ID)
+}
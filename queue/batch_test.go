@@ -0,0 +1,206 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/suite"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/database/gorm"
+	configmock "github.com/goravel/framework/mocks/config"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+type TestBatchJob struct {
+	signature string
+	err       error
+	calls     [][]any
+}
+
+func (receiver *TestBatchJob) Signature() string {
+	return receiver.signature
+}
+
+func (receiver *TestBatchJob) Handle(args ...any) error {
+	receiver.calls = append(receiver.calls, args)
+
+	return receiver.err
+}
+
+type BatchTestSuite struct {
+	suite.Suite
+	batches    *BatchRepository
+	mockConfig *configmock.Config
+}
+
+func TestBatchTestSuite(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	suite.Run(t, new(BatchTestSuite))
+}
+
+func (s *BatchTestSuite) SetupTest() {
+	query, err := gorm.NewSqliteDocker(docker.Sqlite()).New()
+	s.Require().Nil(err)
+	_, err = query.Exec("DROP TABLE IF EXISTS job_batches")
+	s.Require().Nil(err)
+	_, err = query.Exec("CREATE TABLE job_batches (id varchar(36) PRIMARY KEY, name varchar(255) NOT NULL, total_jobs integer NOT NULL, pending_jobs integer NOT NULL, failed_jobs integer NOT NULL, options text NOT NULL, cancelled_at integer NULL, created_at integer NOT NULL, finished_at integer NULL)")
+	s.Require().Nil(err)
+
+	s.mockConfig = &configmock.Config{}
+	s.mockConfig.On("GetString", "queue.default").Return("sync")
+	s.mockConfig.On("GetString", "queue.connections.sync.driver").Return("sync")
+
+	s.batches = NewBatchRepository(NewConfig(s.mockConfig), nil)
+	s.batches.query = query
+	s.batches.table = "job_batches"
+}
+
+func (s *BatchTestSuite) TestDispatch() {
+	then := &TestBatchJob{signature: "then"}
+	catch := &TestBatchJob{signature: "catch"}
+	finally := &TestBatchJob{signature: "finally"}
+	job := &TestBatchJob{signature: "job"}
+	registered := []contractsqueue.Job{then, catch, finally, job}
+
+	batch, err := NewPendingBatch(NewConfig(s.mockConfig), nil, s.batches, []contractsqueue.Jobs{
+		{Job: job, Args: []contractsqueue.Arg{{Type: "int", Value: 1}}},
+		{Job: job, Args: []contractsqueue.Arg{{Type: "int", Value: 2}}},
+	}, registered).Name("import").
+		Then(then, []contractsqueue.Arg{{Type: "string", Value: "then"}}).
+		Catch(catch, nil).
+		Finally(finally, nil).
+		Dispatch()
+	s.Nil(err)
+	s.Equal("import", batch.Name)
+	s.Equal(2, batch.TotalJobs)
+	s.Equal(0, batch.PendingJobs)
+	s.Equal(100, batch.Progress())
+	s.True(batch.Finished())
+	s.False(batch.Cancelled())
+	s.Equal([][]any{{1}, {2}}, job.calls)
+	s.Equal([][]any{{batch.ID, "then"}}, then.calls)
+	s.Empty(catch.calls)
+	s.Equal([][]any{{batch.ID}}, finally.calls)
+}
+
+func (s *BatchTestSuite) TestDispatch_Failed() {
+	then := &TestBatchJob{signature: "then"}
+	catch := &TestBatchJob{signature: "catch"}
+	finally := &TestBatchJob{signature: "finally"}
+	job := &TestBatchJob{signature: "job", err: errors.New("failed")}
+	registered := []contractsqueue.Job{then, catch, finally, job}
+
+	batch, err := NewPendingBatch(NewConfig(s.mockConfig), nil, s.batches, []contractsqueue.Jobs{
+		{Job: job, Args: []contractsqueue.Arg{{Type: "int", Value: 1}}},
+		{Job: job, Args: []contractsqueue.Arg{{Type: "int", Value: 2}}},
+	}, registered).Then(then, nil).Catch(catch, nil).Finally(finally, nil).Dispatch()
+	s.Nil(err)
+	s.Equal(1, batch.FailedJobs)
+	s.True(batch.Finished())
+	s.True(batch.Cancelled())
+
+	// The remaining jobs of a cancelled batch are skipped.
+	s.Equal([][]any{{1}}, job.calls)
+	s.Empty(then.calls)
+	s.Equal([][]any{{batch.ID}}, catch.calls)
+	s.Equal([][]any{{batch.ID}}, finally.calls)
+}
+
+func (s *BatchTestSuite) TestDispatch_Empty() {
+	_, err := NewPendingBatch(NewConfig(s.mockConfig), nil, s.batches, nil, nil).Dispatch()
+	s.EqualError(err, "the batch has no jobs")
+}
+
+func (s *BatchTestSuite) TestFind() {
+	batch, err := s.batches.Store("import", 3, batchOptions{})
+	s.Nil(err)
+
+	found, err := s.batches.Find(batch.ID)
+	s.Nil(err)
+	s.Equal(batch.ID, found.ID)
+	s.Equal(3, found.PendingJobs)
+	s.Equal(0, found.Progress())
+
+	s.Nil(s.batches.Record(batch.ID, nil, nil))
+	found, err = s.batches.Find(batch.ID)
+	s.Nil(err)
+	s.Equal(1, found.ProcessedJobs())
+	s.Equal(33, found.Progress())
+	s.False(found.Finished())
+
+	_, err = s.batches.Find("missing")
+	s.EqualError(err, "batch [missing] not found")
+}
+
+func (s *BatchTestSuite) TestAbandon() {
+	batch, err := s.batches.Store("import", 3, batchOptions{})
+	s.Nil(err)
+	s.Nil(s.batches.Record(batch.ID, nil, nil))
+
+	// The jobs that couldn't be dispatched are failed, so the batch is finished.
+	s.Nil(s.batches.Abandon(batch.ID, 2, errors.New("failed"), nil))
+	found, err := s.batches.Find(batch.ID)
+	s.Nil(err)
+	s.Equal(0, found.PendingJobs)
+	s.Equal(2, found.FailedJobs)
+	s.True(found.Cancelled())
+	s.True(found.Finished())
+}
+
+func (s *BatchTestSuite) TestBatchHandler() {
+	batch, err := s.batches.Store("", 2, batchOptions{})
+	s.Nil(err)
+
+	calls := 0
+	handle := batchHandler(s.batches, nil, func(ctx context.Context, args ...any) error {
+		calls++
+
+		return tasks.NewErrRetryTaskLater("released", 0)
+	})
+	signature := &tasks.Signature{Headers: tasks.Headers{batchHeader: batch.ID}}
+	task, err := tasks.NewWithSignature(func() {}, signature)
+	s.Nil(err)
+	ctx := task.Context
+
+	// A released job hasn't finished.
+	s.Error(handle(ctx))
+	found, err := s.batches.Find(batch.ID)
+	s.Nil(err)
+	s.Equal(2, found.PendingJobs)
+
+	handle = batchHandler(s.batches, nil, func(ctx context.Context, args ...any) error {
+		calls++
+
+		return errors.New("failed")
+	})
+
+	// A failed job with retries left hasn't finished.
+	signature.RetryCount = 1
+	s.EqualError(handle(ctx), "failed")
+	found, err = s.batches.Find(batch.ID)
+	s.Nil(err)
+	s.Equal(2, found.PendingJobs)
+	s.Equal(0, found.FailedJobs)
+	s.False(found.Cancelled())
+
+	signature.RetryCount = 0
+	s.EqualError(handle(ctx), "failed")
+	s.Nil(handle(ctx))
+	s.Equal(3, calls)
+
+	found, err = s.batches.Find(batch.ID)
+	s.Nil(err)
+	s.Equal(1, found.FailedJobs)
+	s.True(found.Cancelled())
+	s.True(found.Finished())
+
+	// The jobs without a batch are handled directly.
+	s.EqualError(handle(context.Background()), "failed")
+}
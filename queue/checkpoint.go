@@ -0,0 +1,52 @@
+package queue
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+
+	"github.com/goravel/framework/contracts/queue"
+)
+
+const checkpointHeader = "checkpoint"
+
+// checkpointHandler Wrap a checkpointable job, the checkpoint is stored in the headers of the signature
+// which are kept when machinery publishes the job again, so the next attempt can resume from it.
+func checkpointHandler(shutdown context.Context, job queue.Checkpointable, models *modelRestorer) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		args, err := decode(ctx, args)
+		if err != nil {
+			return err
+		}
+		if args, err = models.Restore(ctx, args); err != nil {
+			return err
+		}
+
+		signature := tasks.SignatureFromContext(ctx)
+
+		var checkpoint []byte
+		if signature != nil {
+			if encoded, ok := signature.Headers[checkpointHeader].(string); ok && encoded != "" {
+				if checkpoint, err = base64.StdEncoding.DecodeString(encoded); err != nil {
+					return err
+				}
+			}
+		}
+
+		checkpoint, err = job.HandleWithCheckpoint(shutdown, checkpoint, args...)
+		if signature != nil && checkpoint != nil {
+			if signature.Headers == nil {
+				signature.Headers = make(tasks.Headers)
+			}
+			signature.Headers[checkpointHeader] = base64.StdEncoding.EncodeToString(checkpoint)
+		}
+
+		// The interrupted job is released back to the queue immediately, it doesn't consume the retries.
+		if shutdown.Err() != nil && checkpoint != nil {
+			return tasks.NewErrRetryTaskLater("the worker is shutting down", 0)
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,74 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+type TestCheckpointJob struct {
+	checkpoints [][]byte
+	interrupted bool
+}
+
+func (receiver *TestCheckpointJob) Signature() string {
+	return "checkpoint"
+}
+
+func (receiver *TestCheckpointJob) Handle(args ...any) error {
+	return nil
+}
+
+func (receiver *TestCheckpointJob) HandleWithCheckpoint(ctx context.Context, checkpoint []byte, args ...any) ([]byte, error) {
+	receiver.checkpoints = append(receiver.checkpoints, checkpoint)
+	if ctx.Err() != nil {
+		receiver.interrupted = true
+
+		return append(checkpoint, args[0].(string)...), ctx.Err()
+	}
+	if args[0] == "fail" {
+		return nil, errors.New("failed")
+	}
+
+	return nil, nil
+}
+
+func TestCheckpointHandler(t *testing.T) {
+	shutdown, cancel := context.WithCancel(context.Background())
+	job := &TestCheckpointJob{}
+	signature := &tasks.Signature{
+		Name: job.Signature(),
+		Args: []tasks.Arg{{Type: "string", Value: "a"}},
+	}
+
+	call := func() error {
+		task, err := tasks.NewWithSignature(checkpointHandler(shutdown, job, nil), signature)
+		assert.Nil(t, err)
+		_, err = task.Call()
+
+		return err
+	}
+
+	assert.Nil(t, call())
+	assert.Nil(t, signature.Headers)
+
+	cancel()
+	err := call()
+	assert.True(t, job.interrupted)
+	var retryErr tasks.ErrRetryTaskLater
+	assert.ErrorAs(t, err, &retryErr)
+	assert.Equal(t, "YQ==", signature.Headers[checkpointHeader])
+
+	// The next attempt resumes from the checkpoint saved by the interrupted attempt.
+	assert.NotNil(t, call())
+	assert.Equal(t, [][]byte{nil, nil, []byte("a")}, job.checkpoints)
+	assert.Equal(t, "YWE=", signature.Headers[checkpointHeader])
+
+	shutdown = context.Background()
+	signature.Args = []tasks.Arg{{Type: "string", Value: "fail"}}
+	assert.EqualError(t, call(), "failed")
+	assert.Equal(t, "YWE=", signature.Headers[checkpointHeader])
+}
@@ -0,0 +1,145 @@
+package queue
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+
+	compressionHeader = "compression"
+)
+
+// compress Replace the arguments of the signature with a single compressed payload when the encoded
+// arguments reach the threshold, the algorithm is recorded in the headers so the worker can decode it.
+func compress(signature *tasks.Signature, compression string, threshold int) error {
+	if compression == "" || len(signature.Args) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(signature.Args)
+	if err != nil {
+		return err
+	}
+	if len(payload) < threshold {
+		return nil
+	}
+
+	var buffer bytes.Buffer
+	switch compression {
+	case CompressionGzip:
+		writer := gzip.NewWriter(&buffer)
+		if _, err := writer.Write(payload); err != nil {
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+	case CompressionZstd:
+		writer, err := zstd.NewWriter(&buffer)
+		if err != nil {
+			return err
+		}
+		if _, err := writer.Write(payload); err != nil {
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported queue compression: %s", compression)
+	}
+
+	if signature.Headers == nil {
+		signature.Headers = make(tasks.Headers)
+	}
+	signature.Headers[compressionHeader] = compression
+	signature.Args = []tasks.Arg{
+		{Type: "string", Value: base64.StdEncoding.EncodeToString(buffer.Bytes())},
+	}
+
+	return nil
+}
+
+// decompress Restore the arguments of a compressed job, the arguments are returned as is if the job
+// isn't compressed.
+func decompress(ctx context.Context, args []any) ([]any, error) {
+	signature := tasks.SignatureFromContext(ctx)
+	if signature == nil {
+		return args, nil
+	}
+
+	compression, _ := signature.Headers[compressionHeader].(string)
+	if compression == "" {
+		return args, nil
+	}
+	if len(args) != 1 {
+		return nil, errors.New("the compressed payload of job is invalid")
+	}
+
+	encoded, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("the compressed payload of job is invalid")
+	}
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.ReadCloser
+	switch compression {
+	case CompressionGzip:
+		if reader, err = gzip.NewReader(bytes.NewReader(compressed)); err != nil {
+			return nil, err
+		}
+	case CompressionZstd:
+		decoder, err := zstd.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, err
+		}
+		reader = decoder.IOReadCloser()
+	default:
+		return nil, fmt.Errorf("unsupported queue compression: %s", compression)
+	}
+	defer reader.Close()
+
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return reflectArgs(payload)
+}
+
+// reflectArgs Decode the arguments of a job encoded as json, numbers are decoded as json.Number like the brokers do,
+// machinery reflects them without losing precision.
+func reflectArgs(payload []byte) ([]any, error) {
+	var realArgs []tasks.Arg
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&realArgs); err != nil {
+		return nil, err
+	}
+
+	values := make([]any, len(realArgs))
+	for i, arg := range realArgs {
+		value, err := tasks.ReflectValue(arg.Type, arg.Value)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value.Interface()
+	}
+
+	return values, nil
+}
@@ -0,0 +1,98 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompress(t *testing.T) {
+	largeArg := strings.Repeat("goravel", 100)
+
+	tests := []struct {
+		name        string
+		compression string
+		threshold   int
+		compressed  bool
+		expectErr   string
+	}{
+		{
+			name:      "disabled",
+			threshold: 10,
+		},
+		{
+			name:        "below the threshold",
+			compression: CompressionGzip,
+			threshold:   65536,
+		},
+		{
+			name:        "gzip",
+			compression: CompressionGzip,
+			threshold:   10,
+			compressed:  true,
+		},
+		{
+			name:        "zstd",
+			compression: CompressionZstd,
+			threshold:   10,
+			compressed:  true,
+		},
+		{
+			name:        "unsupported compression",
+			compression: "lz4",
+			threshold:   10,
+			expectErr:   "unsupported queue compression: lz4",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signature := &tasks.Signature{
+				Name: "export",
+				Args: []tasks.Arg{
+					{Type: "string", Value: largeArg},
+					{Type: "int", Value: 1},
+					{Type: "[]string", Value: []string{"a", "b"}},
+				},
+			}
+
+			err := compress(signature, test.compression, test.threshold)
+			if test.expectErr != "" {
+				assert.EqualError(t, err, test.expectErr)
+				return
+			}
+			assert.Nil(t, err)
+
+			if test.compressed {
+				assert.Equal(t, test.compression, signature.Headers[compressionHeader])
+				assert.Len(t, signature.Args, 1)
+				assert.Less(t, len(signature.Args[0].Value.(string)), len(largeArg))
+			} else {
+				assert.Nil(t, signature.Headers)
+				assert.Len(t, signature.Args, 3)
+			}
+
+			// Decode the signature like a broker does.
+			payload, err := json.Marshal(signature)
+			assert.Nil(t, err)
+			decoder := json.NewDecoder(bytes.NewReader(payload))
+			decoder.UseNumber()
+			received := new(tasks.Signature)
+			assert.Nil(t, decoder.Decode(received))
+
+			var handled []any
+			task, err := tasks.NewWithSignature(handler(func(args ...any) error {
+				handled = args
+				return nil
+			}, nil), received)
+			assert.Nil(t, err)
+			_, err = task.Call()
+			assert.Nil(t, err)
+			assert.Equal(t, []any{largeArg, 1, []string{"a", "b"}}, handled)
+		})
+	}
+}
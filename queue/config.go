@@ -2,6 +2,11 @@ package queue
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
 
 	configcontract "github.com/goravel/framework/contracts/config"
 )
@@ -35,6 +40,16 @@ func (r *Config) Queue(connection, queue string) string {
 	return fmt.Sprintf("%s_%s:%s", appName, "queues", queue)
 }
 
+// Queues Get the names of the queues of a worker, the queues are separated by commas in priority order, e.g. "high,default".
+func (r *Config) Queues(connection, queues string) string {
+	var names []string
+	for _, queue := range strings.Split(queues, ",") {
+		names = append(names, r.Queue(connection, strings.TrimSpace(queue)))
+	}
+
+	return strings.Join(names, ",")
+}
+
 func (r *Config) Driver(connection string) string {
 	if connection == "" {
 		connection = r.config.GetString("queue.default")
@@ -43,19 +58,230 @@ func (r *Config) Driver(connection string) string {
 	return r.config.GetString(fmt.Sprintf("queue.connections.%s.driver", connection))
 }
 
-func (r *Config) Redis(queueConnection string) (dsn string, database int, queue string) {
-	connection := r.config.GetString(fmt.Sprintf("queue.connections.%s.connection", queueConnection))
+// RedisConnection is the Redis connection of a queue connection. The connection is a Redis Cluster if Cluster has
+// nodes, a master monitored by Sentinel if MasterName is set, otherwise a single node. The result backend of
+// machinery only treats the connection as a cluster if more than one node is listed.
+type RedisConnection struct {
+	Addr             string
+	Password         string
+	Database         int
+	Cluster          []string
+	MasterName       string
+	Sentinels        []string
+	SentinelPassword string
+}
+
+// Addrs returns the addresses of the nodes formatted as machinery does, the first address is prefixed with the
+// password, e.g. "password@host:port".
+func (r RedisConnection) Addrs() []string {
+	addrs := []string{r.Addr}
+	if len(r.Cluster) > 0 {
+		addrs = slices.Clone(r.Cluster)
+	} else if r.MasterName != "" {
+		addrs = slices.Clone(r.Sentinels)
+	}
+	if r.Password != "" && len(addrs) > 0 {
+		addrs[0] = r.Password + "@" + addrs[0]
+	}
+
+	return addrs
+}
+
+// Redis returns the Redis connection of a queue connection. A Redis Cluster lists its nodes, and a master monitored
+// by Sentinel declares its name and the Sentinels:
+//
+//	"default": map[string]any{
+//	  "cluster":  []string{"10.0.0.1:6379", "10.0.0.2:6379", "10.0.0.3:6379"},
+//	  "password": "",
+//	},
+//	"ha": map[string]any{
+//	  "sentinel": map[string]any{
+//	    "master_name": "mymaster",
+//	    "nodes":       []string{"10.0.0.1:26379", "10.0.0.2:26379"},
+//	    "password":    "",
+//	  },
+//	},
+func (r *Config) Redis(queueConnection string) (connection RedisConnection, queue string, retryAfter time.Duration) {
+	name := r.config.GetString(fmt.Sprintf("queue.connections.%s.connection", queueConnection))
+	queue = r.Queue(queueConnection, "")
+	retryAfter = time.Duration(r.config.GetInt(fmt.Sprintf("queue.connections.%s.retry_after", queueConnection), 60)) * time.Second
+
+	connection = RedisConnection{
+		Password: r.config.GetString(fmt.Sprintf("database.redis.%s.password", name)),
+		Database: r.config.GetInt(fmt.Sprintf("database.redis.%s.database", name)),
+		Cluster:  cast.ToStringSlice(r.config.Get(fmt.Sprintf("database.redis.%s.cluster", name))),
+	}
+	if len(connection.Cluster) > 0 {
+		return
+	}
+
+	connection.MasterName = r.config.GetString(fmt.Sprintf("database.redis.%s.sentinel.master_name", name))
+	if connection.MasterName != "" {
+		connection.Sentinels = cast.ToStringSlice(r.config.Get(fmt.Sprintf("database.redis.%s.sentinel.nodes", name)))
+		connection.SentinelPassword = r.config.GetString(fmt.Sprintf("database.redis.%s.sentinel.password", name))
+
+		return
+	}
+
+	host := r.config.GetString(fmt.Sprintf("database.redis.%s.host", name))
+	port := r.config.GetInt(fmt.Sprintf("database.redis.%s.port", name))
+	connection.Addr = fmt.Sprintf("%s:%d", host, port)
+
+	return
+}
+
+func (r *Config) PubSub(queueConnection string) (projectID, subscription, topic, orderingKey, credentials string, retryAfter time.Duration) {
+	projectID = r.config.GetString(fmt.Sprintf("queue.connections.%s.project_id", queueConnection))
+	subscription = r.config.GetString(fmt.Sprintf("queue.connections.%s.subscription", queueConnection))
+	topic = r.PubSubTopic(r.Queue(queueConnection, ""))
+	orderingKey = r.config.GetString(fmt.Sprintf("queue.connections.%s.ordering_key", queueConnection))
+	credentials = r.config.GetString(fmt.Sprintf("queue.connections.%s.credentials", queueConnection))
+	retryAfter = time.Duration(r.config.GetInt(fmt.Sprintf("queue.connections.%s.retry_after", queueConnection), 60)) * time.Second
+
+	return
+}
+
+// PubSubTopic converts a queue name to a valid Pub/Sub topic name, topics can't contain ":".
+func (r *Config) PubSubTopic(queue string) string {
+	return strings.ReplaceAll(queue, ":", ".")
+}
+
+// Sqs returns the options of an SQS connection, the prefix is the URL of the account, e.g.
+// "https://sqs.us-east-1.amazonaws.com/123456789012", the queue URL is the prefix followed by the queue name.
+// The worker long polls for waitTime seconds, and the received jobs are invisible to the other workers for
+// visibilityTimeout seconds, the visibility timeout of the queue is used if zero.
+func (r *Config) Sqs(queueConnection string) (prefix, queue, region, key, secret, messageGroup string, waitTime, visibilityTimeout int) {
+	prefix = strings.TrimSuffix(r.config.GetString(fmt.Sprintf("queue.connections.%s.prefix", queueConnection)), "/")
+	queue = r.SqsQueue(r.Queue(queueConnection, ""))
+	region = r.config.GetString(fmt.Sprintf("queue.connections.%s.region", queueConnection))
+	key = r.config.GetString(fmt.Sprintf("queue.connections.%s.key", queueConnection))
+	secret = r.config.GetString(fmt.Sprintf("queue.connections.%s.secret", queueConnection))
+	messageGroup = r.config.GetString(fmt.Sprintf("queue.connections.%s.message_group", queueConnection), "default")
+	waitTime = r.config.GetInt(fmt.Sprintf("queue.connections.%s.wait_time", queueConnection), 20)
+	visibilityTimeout = r.config.GetInt(fmt.Sprintf("queue.connections.%s.visibility_timeout", queueConnection))
+
+	return
+}
+
+// Amqp returns the options of an AMQP connection, e.g. RabbitMQ. The jobs are published to the exchange with the
+// routing key, the queue is bound to the exchange by the routing key which is the queue name by default. The
+// worker prefetches up to prefetch jobs, and the failed jobs are moved to the dead-letter queue bound to the
+// dead-letter exchange, the failed jobs are dropped if the dead-letter exchange is empty. The delayed jobs are
+// published to the delayed exchange of the rabbitmq_delayed_message_exchange plugin, they are published to a
+// temporary queue with a TTL if the delayed exchange is empty.
+func (r *Config) Amqp(queueConnection string) (url, queue, exchange, exchangeType, routingKey, deadLetterExchange, deadLetterQueue, delayedExchange string, prefetch int) {
+	url = r.config.GetString(fmt.Sprintf("queue.connections.%s.url", queueConnection))
+	queue = r.Queue(queueConnection, "")
+	exchange = r.config.GetString(fmt.Sprintf("queue.connections.%s.exchange", queueConnection), "goravel")
+	exchangeType = r.config.GetString(fmt.Sprintf("queue.connections.%s.exchange_type", queueConnection), "direct")
+	routingKey = r.config.GetString(fmt.Sprintf("queue.connections.%s.routing_key", queueConnection), queue)
+	deadLetterExchange = r.config.GetString(fmt.Sprintf("queue.connections.%s.dead_letter_exchange", queueConnection))
+	deadLetterQueue = r.config.GetString(fmt.Sprintf("queue.connections.%s.dead_letter_queue", queueConnection), queue+".failed")
+	delayedExchange = r.config.GetString(fmt.Sprintf("queue.connections.%s.delayed_exchange", queueConnection))
+	prefetch = r.config.GetInt(fmt.Sprintf("queue.connections.%s.prefetch", queueConnection), 10)
+
+	return
+}
+
+// Kafka returns the options of a Kafka connection, the brokers are separated by commas. The jobs of the
+// queue are published to the topic and consumed by the workers of the consumer group.
+func (r *Config) Kafka(queueConnection string) (brokers []string, topic, group string) {
+	for _, broker := range strings.Split(r.config.GetString(fmt.Sprintf("queue.connections.%s.brokers", queueConnection)), ",") {
+		if broker = strings.TrimSpace(broker); broker != "" {
+			brokers = append(brokers, broker)
+		}
+	}
+	topic = r.KafkaTopic(r.Queue(queueConnection, ""))
+	group = r.config.GetString(fmt.Sprintf("queue.connections.%s.group", queueConnection), "goravel")
+
+	return
+}
+
+// KafkaTopic converts a queue name to a valid Kafka topic, topics can't contain ":".
+func (r *Config) KafkaTopic(queue string) string {
+	return strings.ReplaceAll(queue, ":", ".")
+}
+
+// SqsQueue converts a queue name to a valid SQS queue name, queue names can't contain ":".
+func (r *Config) SqsQueue(queue string) string {
+	return strings.ReplaceAll(queue, ":", "-")
+}
+
+// Database returns the options of a database queue connection, the worker reserves up to batch jobs at once,
+// and sleeps between sleep and maxSleep (doubling on every empty poll) when the queue is empty.
+func (r *Config) Database(queueConnection string) (connection, table, queue string, batch int, sleep, maxSleep, retryAfter time.Duration) {
+	connection = r.config.GetString(fmt.Sprintf("queue.connections.%s.connection", queueConnection))
+	if connection == "" {
+		connection = r.config.GetString("database.default")
+	}
+	table = r.config.GetString(fmt.Sprintf("queue.connections.%s.table", queueConnection), "jobs")
 	queue = r.Queue(queueConnection, "")
-	host := r.config.GetString(fmt.Sprintf("database.redis.%s.host", connection))
-	password := r.config.GetString(fmt.Sprintf("database.redis.%s.password", connection))
-	port := r.config.GetInt(fmt.Sprintf("database.redis.%s.port", connection))
-	database = r.config.GetInt(fmt.Sprintf("database.redis.%s.database", connection))
+	batch = r.config.GetInt(fmt.Sprintf("queue.connections.%s.batch", queueConnection), 10)
+	sleep = time.Duration(r.config.GetInt(fmt.Sprintf("queue.connections.%s.sleep", queueConnection), 1000)) * time.Millisecond
+	maxSleep = time.Duration(r.config.GetInt(fmt.Sprintf("queue.connections.%s.max_sleep", queueConnection), 10000)) * time.Millisecond
+	retryAfter = time.Duration(r.config.GetInt(fmt.Sprintf("queue.connections.%s.retry_after", queueConnection), 60)) * time.Second
 
-	if password == "" {
-		dsn = fmt.Sprintf("%s:%d", host, port)
-	} else {
-		dsn = fmt.Sprintf("%s@%s:%d", password, host, port)
+	return
+}
+
+// Batching returns the database connection and the table storing the job batches.
+func (r *Config) Batching() (connection, table string) {
+	connection = r.config.GetString("queue.batching.database")
+	if connection == "" {
+		connection = r.config.GetString("database.default")
+	}
+	table = r.config.GetString("queue.batching.table", "job_batches")
+
+	return
+}
+
+// Failed returns the database connection and the table storing the failed jobs, the failed jobs aren't
+// stored if the table is empty.
+func (r *Config) Failed() (connection, table string) {
+	connection = r.config.GetString("queue.failed.database")
+	if connection == "" {
+		connection = r.config.GetString("database.default")
 	}
+	table = r.config.GetString("queue.failed.table")
 
 	return
 }
+
+// Compression returns the algorithm (gzip or zstd) used to compress the job payloads of a connection,
+// only the payloads reaching the threshold (in bytes) are compressed, the compression is disabled if empty.
+func (r *Config) Compression(connection string) (compression string, threshold int) {
+	if connection == "" {
+		connection = r.DefaultConnection()
+	}
+	compression = r.config.GetString(fmt.Sprintf("queue.connections.%s.compression", connection))
+	threshold = r.config.GetInt(fmt.Sprintf("queue.connections.%s.compression_threshold", connection), 65536)
+
+	return
+}
+
+// Fallback returns the connection the jobs of a connection are dispatched to while it's unreachable, e.g. a sync or
+// database connection, and the interval of probing the connection again, the fallback is disabled if empty.
+func (r *Config) Fallback(connection string) (fallback string, probe time.Duration) {
+	if connection == "" {
+		connection = r.DefaultConnection()
+	}
+	fallback = r.config.GetString(fmt.Sprintf("queue.connections.%s.fallback", connection))
+	probe = time.Duration(r.config.GetInt(fmt.Sprintf("queue.connections.%s.fallback_probe", connection), 30)) * time.Second
+
+	return
+}
+
+// Encrypt reports whether the job payloads of a connection are encrypted by the framework encrypter.
+func (r *Config) Encrypt(connection string) bool {
+	if connection == "" {
+		connection = r.DefaultConnection()
+	}
+
+	return r.config.GetBool(fmt.Sprintf("queue.connections.%s.encrypt", connection))
+}
+
+// Metrics returns the cache store recording the metrics of the queues, e.g. "redis", the metrics aren't recorded
+// if the store is empty.
+func (r *Config) Metrics() string {
+	return r.config.GetString("queue.metrics.store")
+}
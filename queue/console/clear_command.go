@@ -0,0 +1,86 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+type ClearCommand struct {
+	config config.Config
+	queue  queue.Queue
+}
+
+func NewClearCommand(config config.Config, queue queue.Queue) *ClearCommand {
+	return &ClearCommand{config: config, queue: queue}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ClearCommand) Signature() string {
+	return "queue:clear"
+}
+
+// Description The console command description.
+func (receiver *ClearCommand) Description() string {
+	return "Delete all of the jobs from the specified queue"
+}
+
+// Extend The console command extend.
+func (receiver *ClearCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "queue",
+				Usage: "the name of the queue to clear, the default queue of the connection is cleared if empty",
+			},
+			&command.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "force the operation to run when in production",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command, the connection is the first argument, e.g. queue:clear redis --queue=emails.
+func (receiver *ClearCommand) Handle(ctx console.Context) error {
+	if receiver.config.GetString("app.env") == "production" && !ctx.OptionBool("force") {
+		color.Yellow().Println("**************************************")
+		color.Yellow().Println("*     Application In Production!     *")
+		color.Yellow().Println("**************************************")
+
+		answer, err := ctx.Confirm("Do you really wish to run this command?")
+		if err != nil {
+			return err
+		}
+
+		if !answer {
+			color.Yellow().Println("Command cancelled!")
+			return nil
+		}
+	}
+
+	connection := ctx.Argument(0)
+	if connection == "" {
+		connection = receiver.config.GetString("queue.default")
+	}
+	queueName := ctx.Option("queue")
+
+	count, err := receiver.queue.Clear(connection, queueName)
+	if err != nil {
+		color.Red().Println(err.Error())
+
+		return nil
+	}
+
+	if queueName == "" {
+		color.Green().Printf("Cleared %d jobs from the default queue of the [%s] connection\n", count, connection)
+	} else {
+		color.Green().Printf("Cleared %d jobs from the [%s] queue of the [%s] connection\n", count, queueName, connection)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package console
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	configmocks "github.com/goravel/framework/mocks/config"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	queuemocks "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+func TestClearCommand(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockQueue := queuemocks.NewQueue(t)
+	mockContext := consolemocks.NewContext(t)
+	clearCommand := NewClearCommand(mockConfig, mockQueue)
+
+	mockConfig.EXPECT().GetString("app.env").Return("local").Once()
+	mockContext.EXPECT().Argument(0).Return("").Once()
+	mockConfig.EXPECT().GetString("queue.default").Return("redis").Once()
+	mockContext.EXPECT().Option("queue").Return("").Once()
+	mockQueue.EXPECT().Clear("redis", "").Return(3, nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, clearCommand.Handle(mockContext))
+	}), "Cleared 3 jobs from the default queue of the [redis] connection")
+
+	mockConfig.EXPECT().GetString("app.env").Return("local").Once()
+	mockContext.EXPECT().Argument(0).Return("database").Once()
+	mockContext.EXPECT().Option("queue").Return("emails").Once()
+	mockQueue.EXPECT().Clear("database", "emails").Return(2, nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, clearCommand.Handle(mockContext))
+	}), "Cleared 2 jobs from the [emails] queue of the [database] connection")
+
+	mockConfig.EXPECT().GetString("app.env").Return("local").Once()
+	mockContext.EXPECT().Argument(0).Return("kafka").Once()
+	mockContext.EXPECT().Option("queue").Return("").Once()
+	mockQueue.EXPECT().Clear("kafka", "").Return(0, errors.New("queue driver [kafka] doesn't support clearing the queues")).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, clearCommand.Handle(mockContext))
+	}), "queue driver [kafka] doesn't support clearing the queues")
+}
+
+func TestClearCommand_Production(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockQueue := queuemocks.NewQueue(t)
+	mockContext := consolemocks.NewContext(t)
+	clearCommand := NewClearCommand(mockConfig, mockQueue)
+
+	mockConfig.EXPECT().GetString("app.env").Return("production")
+	mockContext.EXPECT().OptionBool("force").Return(false).Twice()
+	mockContext.EXPECT().Confirm("Do you really wish to run this command?").Return(false, nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, clearCommand.Handle(mockContext))
+	}), "Command cancelled!")
+
+	mockContext.EXPECT().Confirm("Do you really wish to run this command?").Return(true, nil).Once()
+	mockContext.EXPECT().Argument(0).Return("redis").Twice()
+	mockContext.EXPECT().Option("queue").Return("").Twice()
+	mockQueue.EXPECT().Clear("redis", "").Return(1, nil).Twice()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, clearCommand.Handle(mockContext))
+	}), "Cleared 1 jobs")
+
+	mockContext.EXPECT().OptionBool("force").Return(true).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, clearCommand.Handle(mockContext))
+	}), "Cleared 1 jobs")
+}
@@ -0,0 +1,58 @@
+package console
+
+import (
+	"strconv"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+type FailedCommand struct {
+	queue queue.Queue
+}
+
+func NewFailedCommand(queue queue.Queue) *FailedCommand {
+	return &FailedCommand{queue: queue}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *FailedCommand) Signature() string {
+	return "queue:failed"
+}
+
+// Description The console command description.
+func (receiver *FailedCommand) Description() string {
+	return "List all of the failed queue jobs"
+}
+
+// Extend The console command extend.
+func (receiver *FailedCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+		Json:     true,
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *FailedCommand) Handle(ctx console.Context) error {
+	jobs, err := receiver.queue.Failer().All()
+	if err != nil {
+		color.Red().Println(err.Error())
+
+		return nil
+	}
+	if len(jobs) == 0 {
+		color.Green().Println("No failed jobs")
+
+		return nil
+	}
+
+	rows := make([][]string, 0, len(jobs))
+	for _, job := range jobs {
+		rows = append(rows, []string{strconv.Itoa(int(job.ID)), job.Connection, job.Queue, job.Signature, job.FailedAt.Format("2006-01-02 15:04:05")})
+	}
+
+	return ctx.Table([]string{"ID", "Connection", "Queue", "Job", "Failed At"}, rows)
+}
@@ -0,0 +1,34 @@
+package console
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	queuemocks "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+func TestFailedCommand(t *testing.T) {
+	mockQueue := queuemocks.NewQueue(t)
+	mockFailer := queuemocks.NewFailer(t)
+	mockContext := consolemocks.NewContext(t)
+	mockQueue.EXPECT().Failer().Return(mockFailer)
+	mockFailer.EXPECT().All().Return([]contractsqueue.FailedJob{
+		{ID: 1, Connection: "redis", Queue: "goravel_queues:default", Signature: "send_email", FailedAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.Local)},
+	}, nil).Once()
+	mockContext.EXPECT().Table([]string{"ID", "Connection", "Queue", "Job", "Failed At"}, [][]string{
+		{"1", "redis", "goravel_queues:default", "send_email", "2024-01-01 08:00:00"},
+	}).Return(nil).Once()
+
+	assert.Nil(t, NewFailedCommand(mockQueue).Handle(mockContext))
+
+	mockFailer.EXPECT().All().Return(nil, nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, NewFailedCommand(mockQueue).Handle(mockContext))
+	}), "No failed jobs")
+}
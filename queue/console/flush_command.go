@@ -0,0 +1,58 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+type FlushCommand struct {
+	queue queue.Queue
+}
+
+func NewFlushCommand(queue queue.Queue) *FlushCommand {
+	return &FlushCommand{queue: queue}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *FlushCommand) Signature() string {
+	return "queue:flush"
+}
+
+// Description The console command description.
+func (receiver *FlushCommand) Description() string {
+	return "Prune the failed queue jobs"
+}
+
+// Extend The console command extend.
+func (receiver *FlushCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+		Flags: []command.Flag{
+			&command.IntFlag{
+				Name:  "hours",
+				Usage: "only prune the failed jobs older than the given hours",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *FlushCommand) Handle(ctx console.Context) error {
+	hours := ctx.OptionInt("hours")
+	count, err := receiver.queue.Failer().Flush(hours)
+	if err != nil {
+		color.Red().Println(err.Error())
+
+		return nil
+	}
+
+	if hours > 0 {
+		color.Green().Printf("%d failed jobs older than %d hours have been deleted\n", count, hours)
+	} else {
+		color.Green().Printf("%d failed jobs have been deleted\n", count)
+	}
+
+	return nil
+}
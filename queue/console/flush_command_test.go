@@ -0,0 +1,32 @@
+package console
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	consolemocks "github.com/goravel/framework/mocks/console"
+	queuemocks "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+func TestFlushCommand(t *testing.T) {
+	mockQueue := queuemocks.NewQueue(t)
+	mockFailer := queuemocks.NewFailer(t)
+	mockContext := consolemocks.NewContext(t)
+	mockQueue.EXPECT().Failer().Return(mockFailer)
+	flushCommand := NewFlushCommand(mockQueue)
+
+	mockContext.EXPECT().OptionInt("hours").Return(0).Once()
+	mockFailer.EXPECT().Flush(0).Return(int64(3), nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, flushCommand.Handle(mockContext))
+	}), "3 failed jobs have been deleted")
+
+	mockContext.EXPECT().OptionInt("hours").Return(24).Once()
+	mockFailer.EXPECT().Flush(24).Return(int64(1), nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, flushCommand.Handle(mockContext))
+	}), "1 failed jobs older than 24 hours have been deleted")
+}
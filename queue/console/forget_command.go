@@ -0,0 +1,61 @@
+package console
+
+import (
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+type ForgetCommand struct {
+	queue queue.Queue
+}
+
+func NewForgetCommand(queue queue.Queue) *ForgetCommand {
+	return &ForgetCommand{queue: queue}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ForgetCommand) Signature() string {
+	return "queue:forget"
+}
+
+// Description The console command description.
+func (receiver *ForgetCommand) Description() string {
+	return "Delete a failed queue job"
+}
+
+// Extend The console command extend.
+func (receiver *ForgetCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ForgetCommand) Handle(ctx console.Context) error {
+	id := ctx.Argument(0)
+	if id == "" {
+		color.Red().Println("Not enough arguments (missing: id)")
+
+		return nil
+	}
+
+	deleted, err := receiver.queue.Failer().Forget(cast.ToUint(id))
+	if err != nil {
+		color.Red().Println(err.Error())
+
+		return nil
+	}
+	if !deleted {
+		color.Red().Printf("No failed job matches the given ID [%s]\n", id)
+
+		return nil
+	}
+
+	color.Green().Println("Failed job deleted successfully")
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package console
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	consolemocks "github.com/goravel/framework/mocks/console"
+	queuemocks "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+func TestForgetCommand(t *testing.T) {
+	mockQueue := queuemocks.NewQueue(t)
+	mockFailer := queuemocks.NewFailer(t)
+	mockContext := consolemocks.NewContext(t)
+	mockQueue.EXPECT().Failer().Return(mockFailer)
+	forgetCommand := NewForgetCommand(mockQueue)
+
+	mockContext.EXPECT().Argument(0).Return("1").Once()
+	mockFailer.EXPECT().Forget(uint(1)).Return(true, nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, forgetCommand.Handle(mockContext))
+	}), "Failed job deleted successfully")
+
+	mockContext.EXPECT().Argument(0).Return("2").Once()
+	mockFailer.EXPECT().Forget(uint(2)).Return(false, nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, forgetCommand.Handle(mockContext))
+	}), "No failed job matches the given ID [2]")
+
+	mockContext.EXPECT().Argument(0).Return("").Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, forgetCommand.Handle(mockContext))
+	}), "Not enough arguments (missing: id)")
+}
@@ -51,13 +51,13 @@ func (receiver *JobMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Job created successfully")
+	color.Green().Printf("Job [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *JobMakeCommand) getStub() string {
-	return JobStubs{}.Job()
+	return supportconsole.Stub("job.stub", JobStubs{}.Job())
 }
 
 // populateStub Populate the place-holders in the command stub.
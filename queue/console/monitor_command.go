@@ -0,0 +1,95 @@
+package console
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/log"
+	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+type MonitorCommand struct {
+	log   log.Log
+	queue queue.Queue
+}
+
+func NewMonitorCommand(queue queue.Queue, log log.Log) *MonitorCommand {
+	return &MonitorCommand{log: log, queue: queue}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *MonitorCommand) Signature() string {
+	return "queue:monitor"
+}
+
+// Description The console command description.
+func (receiver *MonitorCommand) Description() string {
+	return "Monitor the size and the metrics of the queues, e.g. \"redis:default,redis:high\""
+}
+
+// Extend The console command extend.
+func (receiver *MonitorCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+		Json:     true,
+		Flags: []command.Flag{
+			&command.IntFlag{
+				Name:  "max",
+				Value: 1000,
+				Usage: "the maximum number of jobs a queue can have before an alert is logged",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *MonitorCommand) Handle(ctx console.Context) error {
+	queues := strings.Split(strings.Join(ctx.Arguments(), ","), ",")
+	if len(queues) == 1 && queues[0] == "" {
+		color.Red().Println("Please specify the queues to monitor, e.g. \"redis:default,redis:high\"")
+
+		return nil
+	}
+
+	threshold := ctx.OptionInt("max")
+	monitor := receiver.queue.Monitor()
+	rows := make([][]string, 0, len(queues))
+	for _, name := range queues {
+		connection, queueName, found := strings.Cut(strings.TrimSpace(name), ":")
+		if !found {
+			connection, queueName = "", connection
+		}
+
+		size, err := monitor.Size(connection, queueName)
+		if err != nil {
+			color.Red().Printf("Failed to get the size of the queue [%s]: %v\n", name, err)
+
+			return nil
+		}
+
+		status := "OK"
+		if size > threshold {
+			status = "ALERT"
+			receiver.log.Warningf("The queue [%s] has %d jobs, exceeding the threshold of %d", name, size, threshold)
+		}
+
+		row := []string{connection, queueName, strconv.Itoa(size), "-", "-", "-", "-", "-", status}
+		// The metrics are only available if they are recorded by the workers.
+		if metrics, err := monitor.Metrics(connection, queueName); err == nil {
+			row[0] = metrics.Connection
+			row[3] = strconv.FormatInt(metrics.Processed, 10)
+			row[4] = strconv.FormatInt(metrics.Failed, 10)
+			row[5] = fmt.Sprintf("%.2f/min", metrics.Throughput)
+			row[6] = metrics.AverageWait.String()
+			row[7] = metrics.AverageRuntime.String()
+		}
+
+		rows = append(rows, row)
+	}
+
+	return ctx.Table([]string{"Connection", "Queue", "Size", "Processed", "Failed", "Throughput", "Wait", "Runtime", "Status"}, rows)
+}
@@ -0,0 +1,67 @@
+package console
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/queue"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	logmocks "github.com/goravel/framework/mocks/log"
+	queuemocks "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+func TestMonitorCommand(t *testing.T) {
+	mockQueue := queuemocks.NewQueue(t)
+	mockMonitor := queuemocks.NewMonitor(t)
+	mockLog := logmocks.NewLog(t)
+	mockContext := consolemocks.NewContext(t)
+	monitorCommand := NewMonitorCommand(mockQueue, mockLog)
+
+	mockContext.EXPECT().Arguments().Return(nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, monitorCommand.Handle(mockContext))
+	}), "Please specify the queues to monitor")
+
+	mockQueue.EXPECT().Monitor().Return(mockMonitor)
+	mockContext.EXPECT().Arguments().Return([]string{"redis:default,redis:high", "kafka:default"}).Once()
+	mockContext.EXPECT().OptionInt("max").Return(100).Once()
+	mockMonitor.EXPECT().Size("redis", "default").Return(10, nil).Once()
+	mockMonitor.EXPECT().Metrics("redis", "default").Return(&queue.Metrics{
+		Connection:     "redis",
+		Queue:          "default",
+		Processed:      20,
+		Failed:         1,
+		Throughput:     2.5,
+		AverageWait:    time.Second,
+		AverageRuntime: 500 * time.Millisecond,
+	}, nil).Once()
+	mockMonitor.EXPECT().Size("redis", "high").Return(101, nil).Once()
+	mockMonitor.EXPECT().Metrics("redis", "high").Return(nil, errors.New("the queue metrics store isn't configured")).Once()
+	mockLog.EXPECT().Warningf("The queue [%s] has %d jobs, exceeding the threshold of %d", "redis:high", 101, 100).Once()
+	mockMonitor.EXPECT().Size("kafka", "default").Return(0, errors.New("queue driver [kafka] doesn't support getting the size of the queues")).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, monitorCommand.Handle(mockContext))
+	}), "Failed to get the size of the queue [kafka:default]")
+
+	mockContext.EXPECT().Arguments().Return([]string{"redis:default"}).Once()
+	mockContext.EXPECT().OptionInt("max").Return(100).Once()
+	mockMonitor.EXPECT().Size("redis", "default").Return(10, nil).Once()
+	mockMonitor.EXPECT().Metrics("redis", "default").Return(&queue.Metrics{
+		Connection:     "redis",
+		Queue:          "default",
+		Processed:      20,
+		Failed:         1,
+		Throughput:     2.5,
+		AverageWait:    time.Second,
+		AverageRuntime: 500 * time.Millisecond,
+	}, nil).Once()
+	mockContext.EXPECT().Table([]string{"Connection", "Queue", "Size", "Processed", "Failed", "Throughput", "Wait", "Runtime", "Status"}, [][]string{
+		{"redis", "default", "10", "20", "1", "2.50/min", "1s", "500ms", "OK"},
+	}).Return(nil).Once()
+	assert.Nil(t, monitorCommand.Handle(mockContext))
+}
@@ -0,0 +1,61 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+type PruneFailedCommand struct {
+	queue queue.Queue
+}
+
+func NewPruneFailedCommand(queue queue.Queue) *PruneFailedCommand {
+	return &PruneFailedCommand{queue: queue}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *PruneFailedCommand) Signature() string {
+	return "queue:prune-failed"
+}
+
+// Description The console command description.
+func (receiver *PruneFailedCommand) Description() string {
+	return "Prune the stale failed queue jobs"
+}
+
+// Extend The console command extend.
+func (receiver *PruneFailedCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+		Flags: []command.Flag{
+			&command.IntFlag{
+				Name:  "hours",
+				Value: 24,
+				Usage: "the number of hours to retain the failed jobs",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *PruneFailedCommand) Handle(ctx console.Context) error {
+	hours := ctx.OptionInt("hours")
+	if hours <= 0 {
+		color.Red().Println("The hours should be greater than 0, use queue:flush to delete all the failed jobs")
+
+		return nil
+	}
+
+	count, err := receiver.queue.Failer().Flush(hours)
+	if err != nil {
+		color.Red().Println(err.Error())
+
+		return nil
+	}
+
+	color.Green().Printf("%d failed jobs older than %d hours have been pruned\n", count, hours)
+
+	return nil
+}
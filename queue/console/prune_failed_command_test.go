@@ -0,0 +1,38 @@
+package console
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	consolemocks "github.com/goravel/framework/mocks/console"
+	queuemocks "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+func TestPruneFailedCommand(t *testing.T) {
+	mockQueue := queuemocks.NewQueue(t)
+	mockFailer := queuemocks.NewFailer(t)
+	mockContext := consolemocks.NewContext(t)
+	pruneFailedCommand := NewPruneFailedCommand(mockQueue)
+
+	mockContext.EXPECT().OptionInt("hours").Return(0).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, pruneFailedCommand.Handle(mockContext))
+	}), "The hours should be greater than 0")
+
+	mockQueue.EXPECT().Failer().Return(mockFailer)
+	mockContext.EXPECT().OptionInt("hours").Return(48).Once()
+	mockFailer.EXPECT().Flush(48).Return(int64(2), nil).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, pruneFailedCommand.Handle(mockContext))
+	}), "2 failed jobs older than 48 hours have been pruned")
+
+	mockContext.EXPECT().OptionInt("hours").Return(24).Once()
+	mockFailer.EXPECT().Flush(24).Return(int64(0), errors.New("error")).Once()
+	assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, pruneFailedCommand.Handle(mockContext))
+	}), "error")
+}
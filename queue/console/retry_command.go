@@ -0,0 +1,129 @@
+package console
+
+import (
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+type RetryCommand struct {
+	queue queue.Queue
+}
+
+func NewRetryCommand(queue queue.Queue) *RetryCommand {
+	return &RetryCommand{queue: queue}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *RetryCommand) Signature() string {
+	return "queue:retry"
+}
+
+// Description The console command description.
+func (receiver *RetryCommand) Description() string {
+	return "Retry the failed queue jobs by their IDs, by their queue, or all of them with \"all\""
+}
+
+// Extend The console command extend.
+func (receiver *RetryCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "queue",
+				Usage: "retry all of the failed jobs of the queue",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *RetryCommand) Handle(ctx console.Context) error {
+	jobs, err := receiver.jobs(ctx.Arguments(), ctx.Option("queue"))
+	if err != nil {
+		color.Red().Println(err.Error())
+
+		return nil
+	}
+	if len(jobs) == 0 {
+		color.Yellow().Println("No failed jobs to retry")
+
+		return nil
+	}
+
+	if len(jobs) == 1 {
+		if err := receiver.queue.Failer().Retry(jobs[0]); err != nil {
+			color.Red().Printf("Failed to retry the failed job [%d]: %v\n", jobs[0].ID, err)
+
+			return nil
+		}
+
+		color.Green().Printf("The failed job [%d] has been pushed back onto the queue\n", jobs[0].ID)
+
+		return nil
+	}
+
+	// The lines of every job would break the bar, so only the failure and the summary are printed.
+	bar := ctx.CreateProgressBar(len(jobs))
+	if err := bar.Start(); err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if err := receiver.queue.Failer().Retry(job); err != nil {
+			_ = bar.Finish()
+			color.Red().Printf("Failed to retry the failed job [%d]: %v\n", job.ID, err)
+
+			return nil
+		}
+
+		bar.Advance()
+	}
+	if err := bar.Finish(); err != nil {
+		return err
+	}
+
+	color.Green().Printf("%d failed jobs have been pushed back onto the queue\n", len(jobs))
+
+	return nil
+}
+
+// jobs Get the failed jobs by their IDs, by their queue, or all of them if the first ID is "all".
+func (receiver *RetryCommand) jobs(ids []string, queueName string) ([]queue.FailedJob, error) {
+	failer := receiver.queue.Failer()
+	if queueName == "" && (len(ids) == 0 || ids[0] != "all") {
+		var jobs []queue.FailedJob
+		for _, id := range ids {
+			job, err := failer.Find(cast.ToUint(id))
+			if err != nil {
+				return nil, err
+			}
+
+			jobs = append(jobs, *job)
+		}
+
+		return jobs, nil
+	}
+
+	all, err := failer.All()
+	if err != nil {
+		return nil, err
+	}
+	if queueName == "" {
+		return all, nil
+	}
+
+	// The jobs are stored with the full name of their queue, e.g. "goravel_queues:emails".
+	var jobs []queue.FailedJob
+	for _, job := range all {
+		if job.Queue == queueName || strings.HasSuffix(job.Queue, ":"+queueName) {
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs, nil
+}
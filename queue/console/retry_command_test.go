@@ -0,0 +1,88 @@
+package console
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	queuemocks "github.com/goravel/framework/mocks/queue"
+	"github.com/goravel/framework/support/color"
+)
+
+func TestRetryCommand(t *testing.T) {
+	default1 := contractsqueue.FailedJob{ID: 1, Queue: "goravel_queues:default"}
+	emails := contractsqueue.FailedJob{ID: 2, Queue: "goravel_queues:emails"}
+	default3 := contractsqueue.FailedJob{ID: 3, Queue: "goravel_queues:default"}
+
+	newMocks := func(t *testing.T, ids []string, queue string) (*queuemocks.Queue, *queuemocks.Failer, *consolemocks.Context) {
+		mockQueue := queuemocks.NewQueue(t)
+		mockFailer := queuemocks.NewFailer(t)
+		mockContext := consolemocks.NewContext(t)
+		mockQueue.EXPECT().Failer().Return(mockFailer)
+		mockContext.EXPECT().Arguments().Return(ids).Once()
+		mockContext.EXPECT().Option("queue").Return(queue).Once()
+
+		return mockQueue, mockFailer, mockContext
+	}
+
+	t.Run("retry by ids", func(t *testing.T) {
+		mockQueue, mockFailer, mockContext := newMocks(t, []string{"1", "3"}, "")
+		mockFailer.EXPECT().Find(uint(1)).Return(&default1, nil).Once()
+		mockFailer.EXPECT().Find(uint(3)).Return(&default3, nil).Once()
+		mockFailer.EXPECT().Retry(default1).Return(nil).Once()
+		mockFailer.EXPECT().Retry(default3).Return(nil).Once()
+		mockProgress := consolemocks.NewProgress(t)
+		mockContext.EXPECT().CreateProgressBar(2).Return(mockProgress).Once()
+		mockProgress.EXPECT().Start().Return(nil).Once()
+		mockProgress.EXPECT().Advance().Twice()
+		mockProgress.EXPECT().Finish().Return(nil).Once()
+
+		output := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, NewRetryCommand(mockQueue).Handle(mockContext))
+		})
+		assert.Contains(t, output, "2 failed jobs have been pushed back onto the queue")
+	})
+
+	t.Run("retry by queue", func(t *testing.T) {
+		mockQueue, mockFailer, mockContext := newMocks(t, nil, "emails")
+		mockFailer.EXPECT().All().Return([]contractsqueue.FailedJob{default1, emails, default3}, nil).Once()
+		mockFailer.EXPECT().Retry(emails).Return(nil).Once()
+
+		output := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, NewRetryCommand(mockQueue).Handle(mockContext))
+		})
+		assert.Contains(t, output, "The failed job [2] has been pushed back onto the queue")
+		assert.NotContains(t, output, "[1]")
+	})
+
+	t.Run("retry all", func(t *testing.T) {
+		mockQueue, mockFailer, mockContext := newMocks(t, []string{"all"}, "")
+		mockFailer.EXPECT().All().Return([]contractsqueue.FailedJob{default1, emails}, nil).Once()
+		mockFailer.EXPECT().Retry(default1).Return(nil).Once()
+		mockFailer.EXPECT().Retry(emails).Return(errors.New("connection refused")).Once()
+		mockProgress := consolemocks.NewProgress(t)
+		mockContext.EXPECT().CreateProgressBar(2).Return(mockProgress).Once()
+		mockProgress.EXPECT().Start().Return(nil).Once()
+		mockProgress.EXPECT().Advance().Once()
+		mockProgress.EXPECT().Finish().Return(nil).Once()
+
+		output := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, NewRetryCommand(mockQueue).Handle(mockContext))
+		})
+		assert.NotContains(t, output, "have been pushed back onto the queue")
+		assert.Contains(t, output, "Failed to retry the failed job [2]: connection refused")
+	})
+
+	t.Run("failed job not found", func(t *testing.T) {
+		mockQueue, mockFailer, mockContext := newMocks(t, []string{"4"}, "")
+		mockFailer.EXPECT().Find(uint(4)).Return(nil, errors.New("failed job [4] not found")).Once()
+
+		assert.Contains(t, color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, NewRetryCommand(mockQueue).Handle(mockContext))
+		}), "failed job [4] not found")
+	})
+}
@@ -0,0 +1,106 @@
+package console
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	contractsmigration "github.com/goravel/framework/contracts/database/migration"
+	"github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+	"github.com/goravel/framework/support/str"
+)
+
+// TableCommand Create a migration for a table used by the queue, the migration is written for the migration driver
+// and for the database driver of the connection storing the table.
+type TableCommand struct {
+	config      config.Config
+	connection  string
+	description string
+	signature   string
+	stub        func(driver orm.Driver) string
+	table       string
+}
+
+// NewJobsTableCommand Create the command making the migration of the jobs table of a database queue connection.
+func NewJobsTableCommand(config config.Config, connection, table string) *TableCommand {
+	return &TableCommand{
+		config:      config,
+		connection:  connection,
+		description: "Create a migration for the queue jobs database table",
+		signature:   "queue:table",
+		stub:        TableStubs{}.Jobs,
+		table:       table,
+	}
+}
+
+// NewBatchesTableCommand Create the command making the migration of the job batches table.
+func NewBatchesTableCommand(config config.Config, connection, table string) *TableCommand {
+	return &TableCommand{
+		config:      config,
+		connection:  connection,
+		description: "Create a migration for the batches database table",
+		signature:   "queue:batches-table",
+		stub:        TableStubs{}.JobBatches,
+		table:       table,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *TableCommand) Signature() string {
+	return receiver.signature
+}
+
+// Description The console command description.
+func (receiver *TableCommand) Description() string {
+	return receiver.description
+}
+
+// Extend The console command extend.
+func (receiver *TableCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "queue",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *TableCommand) Handle(_ console.Context) error {
+	driver := orm.Driver(receiver.config.GetString(fmt.Sprintf("database.connections.%s.driver", receiver.connection)))
+	up := strings.ReplaceAll(receiver.stub(driver), "DummyTable", receiver.table)
+	down := strings.ReplaceAll(TableStubs{}.Drop(), "DummyTable", receiver.table)
+	name := fmt.Sprintf("%s_create_%s_table", carbon.Now().ToShortDateTimeString(), receiver.table)
+	pwd, _ := os.Getwd()
+	path := filepath.Join(pwd, "database", "migrations", name)
+
+	switch migrationDriver := receiver.config.GetString("database.migration.driver"); migrationDriver {
+	case contractsmigration.DriverDefault:
+		stub := TableStubs{}.Migration()
+		stub = strings.ReplaceAll(stub, "DummyMigration", str.Of(name).Prepend("m_").Studly().String())
+		stub = strings.ReplaceAll(stub, "DummyName", name)
+		stub = strings.ReplaceAll(stub, "DummyConnection", receiver.connection)
+		stub = strings.ReplaceAll(stub, "DummyUp", up)
+		stub = strings.ReplaceAll(stub, "DummyDown", down)
+		if err := file.Create(path+".go", stub); err != nil {
+			return err
+		}
+	case contractsmigration.DriverSql:
+		if err := file.Create(path+".up.sql", up); err != nil {
+			return err
+		}
+		if err := file.Create(path+".down.sql", down); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported migration driver: %s", migrationDriver)
+	}
+
+	color.Green().Printf("Created Migration: %s\n", name)
+
+	return nil
+}
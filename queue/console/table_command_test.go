@@ -0,0 +1,50 @@
+package console
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	configmock "github.com/goravel/framework/mocks/config"
+	consolemocks "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/goravel/framework/support/file"
+)
+
+func TestTableCommand(t *testing.T) {
+	now := carbon.Now()
+	carbon.SetTestNow(now)
+	defer carbon.UnsetTestNow()
+
+	mockContext := &consolemocks.Context{}
+
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("database.connections.postgres.driver").Return("postgres").Once()
+	mockConfig.EXPECT().GetString("database.migration.driver").Return("default").Once()
+	assert.Nil(t, NewJobsTableCommand(mockConfig, "postgres", "jobs").Handle(mockContext))
+
+	migration := fmt.Sprintf("database/migrations/%s_create_jobs_table.go", now.ToShortDateTimeString())
+	assert.True(t, file.Contain(migration, `return "postgres"`))
+	assert.True(t, file.Contain(migration, "id bigserial PRIMARY KEY"))
+	assert.True(t, file.Contain(migration, "CREATE INDEX jobs_queue_index ON jobs (queue);"))
+	assert.True(t, file.Contain(migration, "DROP TABLE IF EXISTS jobs;"))
+
+	mockConfig = configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("database.connections.mysql.driver").Return("mysql").Once()
+	mockConfig.EXPECT().GetString("database.migration.driver").Return("sql").Once()
+	assert.Nil(t, NewBatchesTableCommand(mockConfig, "mysql", "job_batches").Handle(mockContext))
+
+	up := fmt.Sprintf("database/migrations/%s_create_job_batches_table.up.sql", now.ToShortDateTimeString())
+	down := fmt.Sprintf("database/migrations/%s_create_job_batches_table.down.sql", now.ToShortDateTimeString())
+	assert.True(t, file.Contain(up, "CREATE TABLE job_batches ("))
+	assert.True(t, file.Contain(up, "pending_jobs integer NOT NULL"))
+	assert.True(t, file.Contain(down, "DROP TABLE IF EXISTS job_batches;"))
+
+	mockConfig = configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("database.connections.mysql.driver").Return("mysql").Once()
+	mockConfig.EXPECT().GetString("database.migration.driver").Return("unknown").Once()
+	assert.EqualError(t, NewJobsTableCommand(mockConfig, "mysql", "jobs").Handle(mockContext), "unsupported migration driver: unknown")
+
+	assert.Nil(t, file.Remove("database"))
+}
@@ -0,0 +1,137 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/database/orm"
+)
+
+type TableStubs struct {
+}
+
+// Jobs Create the jobs table of the database connections.
+func (receiver TableStubs) Jobs(driver orm.Driver) string {
+	switch driver {
+	case orm.DriverPostgres, orm.DriverPostgresql:
+		return `CREATE TABLE DummyTable (
+  id bigserial PRIMARY KEY,
+  queue varchar(255) NOT NULL,
+  payload text NOT NULL,
+  attempts integer NOT NULL DEFAULT 0,
+  reserved_at bigint NULL,
+  available_at bigint NOT NULL,
+  created_at bigint NOT NULL
+);
+CREATE INDEX DummyTable_queue_index ON DummyTable (queue);
+`
+	case orm.DriverSqlite:
+		return `CREATE TABLE DummyTable (
+  id integer PRIMARY KEY AUTOINCREMENT NOT NULL,
+  queue varchar(255) NOT NULL,
+  payload text NOT NULL,
+  attempts integer NOT NULL DEFAULT 0,
+  reserved_at integer NULL,
+  available_at integer NOT NULL,
+  created_at integer NOT NULL
+);
+CREATE INDEX DummyTable_queue_index ON DummyTable (queue);
+`
+	case orm.DriverSqlserver:
+		return `CREATE TABLE DummyTable (
+  id bigint NOT NULL IDENTITY(1,1),
+  queue nvarchar(255) NOT NULL,
+  payload nvarchar(max) NOT NULL,
+  attempts int NOT NULL DEFAULT 0,
+  reserved_at bigint NULL,
+  available_at bigint NOT NULL,
+  created_at bigint NOT NULL,
+  PRIMARY KEY (id)
+);
+CREATE INDEX DummyTable_queue_index ON DummyTable (queue);
+`
+	default:
+		return `CREATE TABLE DummyTable (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  queue varchar(255) NOT NULL,
+  payload longtext NOT NULL,
+  attempts int NOT NULL DEFAULT 0,
+  reserved_at bigint NULL,
+  available_at bigint NOT NULL,
+  created_at bigint NOT NULL,
+  PRIMARY KEY (id),
+  KEY DummyTable_queue_index (queue)
+) ENGINE = InnoDB;
+`
+	}
+}
+
+// JobBatches Create the table of the job batches.
+func (receiver TableStubs) JobBatches(driver orm.Driver) string {
+	switch driver {
+	case orm.DriverSqlserver:
+		return `CREATE TABLE DummyTable (
+  id nvarchar(36) NOT NULL,
+  name nvarchar(255) NOT NULL,
+  total_jobs int NOT NULL,
+  pending_jobs int NOT NULL,
+  failed_jobs int NOT NULL,
+  options nvarchar(max) NOT NULL,
+  cancelled_at bigint NULL,
+  created_at bigint NOT NULL,
+  finished_at bigint NULL,
+  PRIMARY KEY (id)
+);
+`
+	default:
+		return `CREATE TABLE DummyTable (
+  id varchar(36) NOT NULL,
+  name varchar(255) NOT NULL,
+  total_jobs integer NOT NULL,
+  pending_jobs integer NOT NULL,
+  failed_jobs integer NOT NULL,
+  options text NOT NULL,
+  cancelled_at bigint NULL,
+  created_at bigint NOT NULL,
+  finished_at bigint NULL,
+  PRIMARY KEY (id)
+);
+`
+	}
+}
+
+// Drop Drop a table.
+func (receiver TableStubs) Drop() string {
+	return `DROP TABLE IF EXISTS DummyTable;
+`
+}
+
+// Migration Create a migration of the default driver running the SQL statements.
+func (receiver TableStubs) Migration() string {
+	return `package migrations
+
+import (
+	"github.com/goravel/framework/facades"
+)
+
+type DummyMigration struct {
+}
+
+// Signature The unique signature for the migration.
+func (r *DummyMigration) Signature() string {
+	return "DummyName"
+}
+
+// Connection The database connection that should be used by the migration.
+func (r *DummyMigration) Connection() string {
+	return "DummyConnection"
+}
+
+// Up Run the migrations.
+func (r *DummyMigration) Up() {
+	facades.Schema().Sql(` + "`DummyUp`" + `)
+}
+
+// Down Reverse the migrations.
+func (r *DummyMigration) Down() {
+	facades.Schema().Sql(` + "`DummyDown`" + `)
+}
+`
+}
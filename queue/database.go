@@ -0,0 +1,281 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/brokers/errs"
+	"github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/common"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+
+	"github.com/goravel/framework/contracts/database/orm"
+)
+
+// DatabaseJob is a row of the jobs table, the migration creating the table is made by the queue:table command.
+type DatabaseJob struct {
+	ID          uint
+	Queue       string
+	Payload     string
+	Attempts    int
+	ReservedAt  *int64
+	AvailableAt int64
+	CreatedAt   int64
+}
+
+// DatabaseBroker is a machinery broker that stores the jobs in a database table. Workers reserve jobs in
+// batches with SKIP LOCKED (Postgres, MySQL 8+) so concurrent workers never block on each other's rows,
+// and back off exponentially while the queue is empty instead of polling at a fixed rate.
+type DatabaseBroker struct {
+	common.Broker
+	query        orm.Query
+	table        string
+	batch        int
+	sleep        time.Duration
+	maxSleep     time.Duration
+	retryAfter   time.Duration
+	processingWG sync.WaitGroup
+}
+
+func NewDatabaseBroker(cnf *config.Config, query orm.Query, table string, batch int, sleep, maxSleep, retryAfter time.Duration) *DatabaseBroker {
+	if batch < 1 {
+		batch = 1
+	}
+	if maxSleep < sleep {
+		maxSleep = sleep
+	}
+
+	return &DatabaseBroker{
+		Broker:     common.NewBroker(cnf),
+		query:      query,
+		table:      table,
+		batch:      batch,
+		sleep:      sleep,
+		maxSleep:   maxSleep,
+		retryAfter: retryAfter,
+	}
+}
+
+// StartConsuming enters a loop and reserves the available jobs in batches
+func (r *DatabaseBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	if concurrency < 1 {
+		concurrency = runtime.NumCPU() * 2
+	}
+
+	r.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	queues := priorityQueues(taskProcessor.CustomQueue(), r.GetConfig().DefaultQueue)
+	pool := make(chan struct{}, concurrency)
+	sleep := r.sleep
+
+	log.INFO.Print("[*] Waiting for messages. To exit press CTRL+C")
+
+	for {
+		jobs, err := r.reserve(queues...)
+		if err != nil {
+			log.ERROR.Print(err)
+		}
+
+		if len(jobs) == 0 {
+			select {
+			case <-r.GetStopChan():
+				r.processingWG.Wait()
+
+				return r.GetRetry(), nil
+			case <-time.After(sleep):
+			}
+
+			sleep = min(sleep*2, r.maxSleep)
+			continue
+		}
+
+		sleep = r.sleep
+		for _, job := range jobs {
+			pool <- struct{}{}
+			r.processingWG.Add(1)
+
+			go func(job DatabaseJob) {
+				defer func() {
+					<-pool
+					r.processingWG.Done()
+				}()
+
+				r.process(job, taskProcessor)
+			}(job)
+		}
+
+		select {
+		case <-r.GetStopChan():
+			r.processingWG.Wait()
+
+			return r.GetRetry(), nil
+		default:
+		}
+	}
+}
+
+// StopConsuming quits the loop, the loop waits for the running jobs to finish before it returns.
+func (r *DatabaseBroker) StopConsuming() {
+	r.Broker.StopConsuming()
+}
+
+// Publish inserts a job into the table, a job with ETA is available after the ETA.
+func (r *DatabaseBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	r.AdjustRoutingKey(signature)
+
+	payload, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	now := time.Now()
+	availableAt := now
+	if signature.ETA != nil && signature.ETA.After(now) {
+		availableAt = *signature.ETA
+	}
+
+	_, err = r.query.Exec(fmt.Sprintf("INSERT INTO %s (queue, payload, attempts, available_at, created_at) VALUES (?, ?, 0, ?, ?)", r.table),
+		signature.RoutingKey, string(payload), availableAt.Unix(), now.Unix())
+
+	return err
+}
+
+// GetPendingTasks returns the jobs that are available and not reserved by a worker.
+func (r *DatabaseBroker) GetPendingTasks(queue string) ([]*tasks.Signature, error) {
+	var jobs []DatabaseJob
+	if err := r.query.Raw(fmt.Sprintf("SELECT * FROM %s WHERE queue = ? AND reserved_at IS NULL AND available_at <= ? ORDER BY id", r.table),
+		queue, time.Now().Unix()).Scan(&jobs); err != nil {
+		return nil, err
+	}
+
+	return r.signatures(jobs)
+}
+
+// Size returns the number of the jobs that are available and not reserved by a worker.
+func (r *DatabaseBroker) Size(queue string) (int, error) {
+	var size int64
+	if err := r.query.Table(r.table).Where("queue = ? AND reserved_at IS NULL AND available_at <= ?", queue, time.Now().Unix()).Count(&size); err != nil {
+		return 0, err
+	}
+
+	return int(size), nil
+}
+
+// GetDelayedTasks returns the jobs that are not available yet.
+func (r *DatabaseBroker) GetDelayedTasks() ([]*tasks.Signature, error) {
+	var jobs []DatabaseJob
+	if err := r.query.Raw(fmt.Sprintf("SELECT * FROM %s WHERE available_at > ? ORDER BY available_at", r.table),
+		time.Now().Unix()).Scan(&jobs); err != nil {
+		return nil, err
+	}
+
+	return r.signatures(jobs)
+}
+
+// Clear deletes all the jobs of a queue, including the delayed and the reserved jobs.
+func (r *DatabaseBroker) Clear(queue string) (int, error) {
+	result, err := r.query.Exec(fmt.Sprintf("DELETE FROM %s WHERE queue = ?", r.table), queue)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// reserve Reserve a batch of available jobs from the first queue that has available jobs, the queues are in
+// priority order.
+func (r *DatabaseBroker) reserve(queues ...string) ([]DatabaseJob, error) {
+	for _, queue := range queues {
+		jobs, err := r.reserveQueue(queue)
+		if err != nil || len(jobs) > 0 {
+			return jobs, err
+		}
+	}
+
+	return nil, nil
+}
+
+// reserveQueue Lock a batch of available jobs and mark them reserved in a single transaction, a reserved job
+// becomes available again after retryAfter, in case the worker died while processing it.
+func (r *DatabaseBroker) reserveQueue(queue string) ([]DatabaseJob, error) {
+	tx, err := r.query.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	var jobs []DatabaseJob
+	if err := tx.Raw(r.reserveSql(), queue, now, now-int64(r.retryAfter.Seconds())).Scan(&jobs); err != nil {
+		_ = tx.Rollback()
+
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, tx.Commit()
+	}
+
+	ids := make([]uint, len(jobs))
+	for i, job := range jobs {
+		ids[i] = job.ID
+	}
+	if _, err := tx.Exec(fmt.Sprintf("UPDATE %s SET reserved_at = ?, attempts = attempts + 1 WHERE id IN ?", r.table), now, ids); err != nil {
+		_ = tx.Rollback()
+
+		return nil, err
+	}
+
+	return jobs, tx.Commit()
+}
+
+func (r *DatabaseBroker) reserveSql() string {
+	where := "queue = ? AND available_at <= ? AND (reserved_at IS NULL OR reserved_at <= ?)"
+
+	switch r.query.Driver() {
+	case orm.DriverSqlserver:
+		return fmt.Sprintf("SELECT TOP (%d) * FROM %s WITH (UPDLOCK, READPAST, ROWLOCK) WHERE %s ORDER BY id", r.batch, r.table, where)
+	case orm.DriverSqlite:
+		return fmt.Sprintf("SELECT * FROM %s WHERE %s ORDER BY id LIMIT %d", r.table, where, r.batch)
+	default:
+		return fmt.Sprintf("SELECT * FROM %s WHERE %s ORDER BY id LIMIT %d FOR UPDATE SKIP LOCKED", r.table, where, r.batch)
+	}
+}
+
+// process Run a reserved job and remove it, machinery publishes a new job if the task should be retried.
+func (r *DatabaseBroker) process(job DatabaseJob, taskProcessor iface.TaskProcessor) {
+	signature := new(tasks.Signature)
+	if err := json.Unmarshal([]byte(job.Payload), signature); err != nil {
+		log.ERROR.Print(errs.NewErrCouldNotUnmarshalTaskSignature([]byte(job.Payload), err))
+	} else if !r.IsTaskRegistered(signature.Name) {
+		// Release the job for the workers that have registered the task.
+		if _, err := r.query.Exec(fmt.Sprintf("UPDATE %s SET reserved_at = NULL WHERE id = ?", r.table), job.ID); err != nil {
+			log.ERROR.Print(err)
+		}
+
+		return
+	} else if err := taskProcessor.Process(signature); err != nil {
+		log.ERROR.Print(err)
+	}
+
+	if _, err := r.query.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ?", r.table), job.ID); err != nil {
+		log.ERROR.Print(err)
+	}
+}
+
+func (r *DatabaseBroker) signatures(jobs []DatabaseJob) ([]*tasks.Signature, error) {
+	signatures := make([]*tasks.Signature, 0, len(jobs))
+	for _, job := range jobs {
+		signature := new(tasks.Signature)
+		if err := json.Unmarshal([]byte(job.Payload), signature); err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
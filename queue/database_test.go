@@ -0,0 +1,175 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+type DatabaseBrokerTestSuite struct {
+	suite.Suite
+	broker *DatabaseBroker
+	query  orm.Query
+}
+
+func TestDatabaseBrokerTestSuite(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	suite.Run(t, new(DatabaseBrokerTestSuite))
+}
+
+func (s *DatabaseBrokerTestSuite) SetupTest() {
+	query, err := gorm.NewSqliteDocker(docker.Sqlite()).New()
+	s.Require().Nil(err)
+	_, err = query.Exec("DROP TABLE IF EXISTS jobs")
+	s.Require().Nil(err)
+	_, err = query.Exec("CREATE TABLE jobs (id integer PRIMARY KEY AUTOINCREMENT, queue varchar(255) NOT NULL, payload text NOT NULL, attempts integer NOT NULL DEFAULT 0, reserved_at integer NULL, available_at integer NOT NULL, created_at integer NOT NULL)")
+	s.Require().Nil(err)
+
+	s.query = query
+	s.broker = NewDatabaseBroker(&config.Config{DefaultQueue: "default"}, query, "jobs", 2, time.Millisecond, 10*time.Millisecond, time.Minute)
+}
+
+func (s *DatabaseBrokerTestSuite) TestPublishAndReserve() {
+	eta := time.Now().Add(time.Hour)
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job1"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job2"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job3"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "delayed", ETA: &eta}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "other", RoutingKey: "other"}))
+
+	pending, err := s.broker.GetPendingTasks("default")
+	s.Nil(err)
+	s.Len(pending, 3)
+
+	size, err := s.broker.Size("default")
+	s.Nil(err)
+	s.Equal(3, size)
+
+	delayed, err := s.broker.GetDelayedTasks()
+	s.Nil(err)
+	s.Len(delayed, 1)
+	s.Equal("delayed", delayed[0].Name)
+
+	jobs, err := s.broker.reserve("default")
+	s.Nil(err)
+	s.Len(jobs, 2)
+	s.Contains(jobs[0].Payload, "job1")
+	s.Contains(jobs[1].Payload, "job2")
+
+	jobs, err = s.broker.reserve("default")
+	s.Nil(err)
+	s.Len(jobs, 1)
+	s.Contains(jobs[0].Payload, "job3")
+
+	jobs, err = s.broker.reserve("default")
+	s.Nil(err)
+	s.Empty(jobs)
+
+	pending, err = s.broker.GetPendingTasks("default")
+	s.Nil(err)
+	s.Empty(pending)
+
+	size, err = s.broker.Size("default")
+	s.Nil(err)
+	s.Zero(size)
+}
+
+func (s *DatabaseBrokerTestSuite) TestClear() {
+	eta := time.Now().Add(time.Hour)
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job1"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job2"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "delayed", ETA: &eta}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "other", RoutingKey: "other"}))
+
+	jobs, err := s.broker.reserve("default")
+	s.Nil(err)
+	s.Len(jobs, 2)
+
+	count, err := s.broker.Clear("default")
+	s.Nil(err)
+	s.Equal(3, count)
+
+	delayed, err := s.broker.GetDelayedTasks()
+	s.Nil(err)
+	s.Empty(delayed)
+
+	pending, err := s.broker.GetPendingTasks("other")
+	s.Nil(err)
+	s.Len(pending, 1)
+}
+
+func (s *DatabaseBrokerTestSuite) TestReserve_Priority() {
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "low1", RoutingKey: "low"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "high1", RoutingKey: "high"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "low2", RoutingKey: "low"}))
+
+	jobs, err := s.broker.reserve("high", "low")
+	s.Nil(err)
+	s.Len(jobs, 1)
+	s.Contains(jobs[0].Payload, "high1")
+
+	jobs, err = s.broker.reserve("high", "low")
+	s.Nil(err)
+	s.Len(jobs, 2)
+	s.Contains(jobs[0].Payload, "low1")
+	s.Contains(jobs[1].Payload, "low2")
+
+	jobs, err = s.broker.reserve("high", "low")
+	s.Nil(err)
+	s.Empty(jobs)
+}
+
+func (s *DatabaseBrokerTestSuite) TestProcess() {
+	s.broker.SetRegisteredTaskNames([]string{"job1"})
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job1"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job2"}))
+
+	jobs, err := s.broker.reserve("default")
+	s.Nil(err)
+	s.Len(jobs, 2)
+
+	processor := &testTaskProcessor{}
+	for _, job := range jobs {
+		s.broker.process(job, processor)
+	}
+	s.Equal([]string{"job1"}, processor.processed)
+
+	var count int64
+	s.Nil(s.query.Table("jobs").Count(&count))
+	s.Equal(int64(1), count)
+
+	pending, err := s.broker.GetPendingTasks("default")
+	s.Nil(err)
+	s.Len(pending, 1)
+	s.Equal("job2", pending[0].Name)
+}
+
+type testTaskProcessor struct {
+	processed []string
+}
+
+func (r *testTaskProcessor) Process(signature *tasks.Signature) error {
+	r.processed = append(r.processed, signature.Name)
+
+	return nil
+}
+
+func (r *testTaskProcessor) CustomQueue() string {
+	return ""
+}
+
+func (r *testTaskProcessor) PreConsumeHandler() bool {
+	return true
+}
@@ -0,0 +1,136 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+
+	"github.com/goravel/framework/contracts/crypt"
+)
+
+const encryptionHeader = "encrypted"
+
+// encrypt Replace the arguments of the signature with a single payload encrypted by the framework encrypter, the
+// payload is encrypted with AES-GCM and bound to the name of the job, so a tampered payload, or a payload moved to
+// another job, can't be decrypted by the worker.
+func encrypt(signature *tasks.Signature, encrypter crypt.Crypt) error {
+	if len(signature.Args) == 0 {
+		return nil
+	}
+
+	return encryptArgs(signature, encrypter, signature.Name)
+}
+
+// encryptCatch Encrypt the arguments of a catch job, machinery prepends the error message to them when the chain
+// fails, so the payload is bound to a catch of the job to not be mistaken for the job itself. The payload is
+// encrypted even without arguments, so the worker can tell the prepended error message from a forged payload.
+func encryptCatch(signature *tasks.Signature, encrypter crypt.Crypt) error {
+	return encryptArgs(signature, encrypter, catchData(signature.Name))
+}
+
+func encryptArgs(signature *tasks.Signature, encrypter crypt.Crypt, data string) error {
+	if encrypter == nil {
+		return errors.New("crypt support is required to encrypt the job payloads")
+	}
+	dataEncrypter, ok := encrypter.(crypt.AdditionalDataCrypt)
+	if !ok {
+		return errors.New("the encrypter doesn't support additional data to encrypt the job payloads")
+	}
+
+	payload, err := json.Marshal(signature.Args)
+	if err != nil {
+		return err
+	}
+	encrypted, err := dataEncrypter.EncryptStringWithData(string(payload), data)
+	if err != nil {
+		return err
+	}
+
+	if signature.Headers == nil {
+		signature.Headers = make(tasks.Headers)
+	}
+	signature.Headers[encryptionHeader] = true
+	signature.Args = []tasks.Arg{
+		{Type: "string", Value: encrypted},
+	}
+
+	return nil
+}
+
+// decrypt Restore the arguments of an encrypted job, the arguments are returned as is if the job isn't encrypted.
+// The payload of a catch job follows the error message prepended by machinery.
+func decrypt(ctx context.Context, args []any) ([]any, error) {
+	signature := tasks.SignatureFromContext(ctx)
+	if signature == nil || !encrypted(signature) {
+		return args, nil
+	}
+	if CryptFacade == nil {
+		return nil, errors.New("crypt support is required to decrypt the job payloads")
+	}
+	dataEncrypter, ok := CryptFacade.(crypt.AdditionalDataCrypt)
+	if !ok {
+		return nil, errors.New("the encrypter doesn't support additional data to decrypt the job payloads")
+	}
+
+	var prepended []any
+	data := signature.Name
+	switch len(args) {
+	case 1:
+	case 2:
+		prepended, args, data = args[:1], args[1:], catchData(signature.Name)
+	default:
+		return nil, errors.New("the encrypted payload of job is invalid")
+	}
+
+	payload, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("the encrypted payload of job is invalid")
+	}
+	decrypted, err := dataEncrypter.DecryptStringWithData(payload, data)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err = reflectArgs([]byte(decrypted))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(prepended, args...), nil
+}
+
+// encryptionHandler Wrap the handle of a job on a connection encrypting the job payloads, the payloads that aren't
+// encrypted are rejected, since anyone able to publish to the queue could forge them.
+func encryptionHandler(handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		if signature := tasks.SignatureFromContext(ctx); signature != nil && len(args) > 0 && !encrypted(signature) {
+			return fmt.Errorf("the payload of job [%s] isn't encrypted", signature.Name)
+		}
+
+		return handle(ctx, args...)
+	}
+}
+
+func encrypted(signature *tasks.Signature) bool {
+	encrypted, _ := signature.Headers[encryptionHeader].(bool)
+
+	return encrypted
+}
+
+func catchData(name string) string {
+	return name + ":catch"
+}
+
+// decode Restore the arguments of a job, the payload is decrypted before it's decompressed, since it's compressed
+// before it's encrypted.
+func decode(ctx context.Context, args []any) ([]any, error) {
+	args, err := decrypt(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return decompress(ctx, args)
+}
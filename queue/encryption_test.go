@@ -0,0 +1,175 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/crypt"
+	frameworkjson "github.com/goravel/framework/foundation/json"
+	configmocks "github.com/goravel/framework/mocks/config"
+)
+
+func TestEncrypt(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetString("app.key").Return("11111111111111111111111111111111").Once()
+	encrypter := crypt.NewAES(mockConfig, frameworkjson.NewJson())
+
+	assert.EqualError(t, encrypt(&tasks.Signature{Args: []tasks.Arg{{Type: "string", Value: "secret"}}}, nil), "crypt support is required to encrypt the job payloads")
+
+	largeArg := strings.Repeat("goravel", 100)
+	tests := []struct {
+		name        string
+		compression string
+		tamper      bool
+		rename      bool
+		expectErr   bool
+	}{
+		{
+			name: "encrypted",
+		},
+		{
+			name:        "compressed and encrypted",
+			compression: CompressionGzip,
+		},
+		{
+			name:      "tampered",
+			tamper:    true,
+			expectErr: true,
+		},
+		{
+			name:      "moved to another job",
+			rename:    true,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			CryptFacade = encrypter
+			t.Cleanup(func() {
+				CryptFacade = nil
+			})
+
+			signature := &tasks.Signature{
+				Name: "export",
+				Args: []tasks.Arg{
+					{Type: "string", Value: largeArg},
+					{Type: "int", Value: 1},
+					{Type: "[]string", Value: []string{"a", "b"}},
+				},
+			}
+
+			assert.Nil(t, compress(signature, test.compression, 10))
+			assert.Nil(t, encrypt(signature, encrypter))
+			assert.Equal(t, true, signature.Headers[encryptionHeader])
+			assert.Len(t, signature.Args, 1)
+			assert.NotContains(t, signature.Args[0].Value, "goravel")
+			if test.tamper {
+				value := []byte(signature.Args[0].Value.(string))
+				value[len(value)-3] ^= 1
+				signature.Args[0].Value = string(value)
+			}
+
+			// Decode the signature like a broker does.
+			payload, err := json.Marshal(signature)
+			assert.Nil(t, err)
+			decoder := json.NewDecoder(bytes.NewReader(payload))
+			decoder.UseNumber()
+			received := new(tasks.Signature)
+			assert.Nil(t, decoder.Decode(received))
+			if test.rename {
+				received.Name = "import"
+			}
+
+			var handled []any
+			task, err := tasks.NewWithSignature(handler(func(args ...any) error {
+				handled = args
+				return nil
+			}, nil), received)
+			assert.Nil(t, err)
+			_, err = task.Call()
+			if test.expectErr {
+				assert.Error(t, err)
+				assert.Nil(t, handled)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, []any{largeArg, 1, []string{"a", "b"}}, handled)
+		})
+	}
+}
+
+func TestDecrypt_WithoutCrypt(t *testing.T) {
+	signature := &tasks.Signature{
+		Name:    "export",
+		Headers: tasks.Headers{encryptionHeader: true},
+		Args:    []tasks.Arg{{Type: "string", Value: "payload"}},
+	}
+	task, err := tasks.NewWithSignature(handler(func(args ...any) error {
+		return nil
+	}, nil), signature)
+	assert.Nil(t, err)
+
+	_, err = task.Call()
+	assert.EqualError(t, err, "crypt support is required to decrypt the job payloads")
+}
+
+func TestEncryptCatch(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetString("app.key").Return("11111111111111111111111111111111").Once()
+	CryptFacade = crypt.NewAES(mockConfig, frameworkjson.NewJson())
+	t.Cleanup(func() {
+		CryptFacade = nil
+	})
+
+	signature := &tasks.Signature{Name: "catch", Args: []tasks.Arg{{Type: "string", Value: "import"}}}
+	assert.Nil(t, encryptCatch(signature, CryptFacade))
+	assert.Equal(t, true, signature.Headers[encryptionHeader])
+
+	// Machinery prepends the error message to the arguments of the catch job.
+	signature.Args = append([]tasks.Arg{{Type: "string", Value: "failed"}}, signature.Args...)
+
+	var handled []any
+	task, err := tasks.NewWithSignature(handler(func(args ...any) error {
+		handled = args
+		return nil
+	}, nil), signature)
+	assert.Nil(t, err)
+	_, err = task.Call()
+	assert.Nil(t, err)
+	assert.Equal(t, []any{"failed", "import"}, handled)
+
+	// The payload of a catch job can't be used by the job itself.
+	signature.Args = signature.Args[1:]
+	task, err = tasks.NewWithSignature(handler(func(args ...any) error {
+		return nil
+	}, nil), signature)
+	assert.Nil(t, err)
+	_, err = task.Call()
+	assert.Error(t, err)
+}
+
+func TestEncryptionHandler(t *testing.T) {
+	handle := encryptionHandler(func(ctx context.Context, args ...any) error {
+		return nil
+	})
+
+	signature := &tasks.Signature{Name: "export", Args: []tasks.Arg{{Type: "string", Value: "payload"}}}
+	task, err := tasks.NewWithSignature(func() {}, signature)
+	assert.Nil(t, err)
+	assert.EqualError(t, handle(task.Context, "payload"), "the payload of job [export] isn't encrypted")
+
+	signature.Headers = tasks.Headers{encryptionHeader: true}
+	assert.Nil(t, handle(task.Context, "payload"))
+
+	// The jobs without arguments have nothing to encrypt.
+	assert.Nil(t, handle(context.Background()))
+	signature.Headers = nil
+	assert.Nil(t, handle(task.Context))
+}
@@ -0,0 +1,106 @@
+package queue
+
+import (
+	"github.com/RichardKnop/machinery/v2/log"
+
+	"github.com/goravel/framework/contracts/event"
+	frameworkevent "github.com/goravel/framework/event"
+)
+
+// JobQueued is dispatched after a job is pushed onto a queue, the args are the connection, the queue, the signature
+// and the UUID of the job.
+type JobQueued struct {
+}
+
+func (receiver *JobQueued) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// JobProcessing is dispatched before a worker or the sync driver handles a job, the args are the connection, the
+// queue, the signature and the UUID of the job.
+type JobProcessing struct {
+}
+
+func (receiver *JobProcessing) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// JobProcessed is dispatched after a worker or the sync driver handles a job successfully, the args are the
+// connection, the queue, the signature and the UUID of the job.
+type JobProcessed struct {
+}
+
+func (receiver *JobProcessed) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// JobFailed is dispatched when a job fails permanently, the args are the connection, the queue, the signature and
+// the UUID of the job, and the error.
+type JobFailed struct {
+}
+
+func (receiver *JobFailed) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// JobRetrying is dispatched when a failed job will be retried or a job is released back to the queue, the args are
+// the connection, the queue, the signature and the UUID of the job, and the error.
+type JobRetrying struct {
+}
+
+func (receiver *JobRetrying) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// ConnectionDegraded is dispatched when the jobs of an unreachable connection are dispatched to its fallback
+// connection, the args are the connection, the fallback connection and the error.
+type ConnectionDegraded struct {
+}
+
+func (receiver *ConnectionDegraded) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+// ConnectionRecovered is dispatched when a degraded connection is reachable again, the args are the connection.
+type ConnectionRecovered struct {
+}
+
+func (receiver *ConnectionRecovered) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+func jobEventArgs(connection, queue, signature, uuid string) []event.Arg {
+	return []event.Arg{
+		{Type: "string", Value: connection},
+		{Type: "string", Value: queue},
+		{Type: "string", Value: signature},
+		{Type: "string", Value: uuid},
+	}
+}
+
+// dispatchJobEvent Dispatch a job event if the application has registered listeners for it. The events of the jobs
+// listening to the job events aren't dispatched, so a queued listener doesn't dispatch the job events forever.
+func dispatchJobEvent(events func() event.Instance, e event.Event, job string, args []event.Arg) {
+	if events == nil {
+		return
+	}
+	instance := events()
+	if instance == nil {
+		return
+	}
+
+	for registered, listeners := range instance.GetEvents() {
+		switch registered.(type) {
+		case *JobQueued, *JobProcessing, *JobProcessed, *JobFailed, *JobRetrying:
+			for _, listener := range listeners {
+				if listener.Signature() == job {
+					return
+				}
+			}
+		}
+	}
+
+	if err := frameworkevent.DispatchIfListened(instance, e, args); err != nil {
+		log.ERROR.Print(err)
+	}
+}
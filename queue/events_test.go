@@ -0,0 +1,147 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/event"
+	"github.com/goravel/framework/contracts/queue"
+	configmocks "github.com/goravel/framework/mocks/config"
+	eventmocks "github.com/goravel/framework/mocks/event"
+)
+
+func TestDispatchJobEvent(t *testing.T) {
+	mockEvent := eventmocks.NewInstance(t)
+	mockTask := eventmocks.NewTask(t)
+	events := func() event.Instance {
+		return mockEvent
+	}
+	processed := &JobProcessed{}
+	args := jobEventArgs("redis", "goravel_queues:default", "test_job", "task_1")
+
+	// The events aren't dispatched without the events module.
+	dispatchJobEvent(nil, &JobProcessed{}, "test_job", args)
+	dispatchJobEvent(func() event.Instance {
+		return nil
+	}, &JobProcessed{}, "test_job", args)
+
+	mockEvent.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{
+		processed: {&TestListener{}},
+	}).Times(5)
+	mockEvent.EXPECT().Job(processed, args).Return(mockTask).Once()
+	mockTask.EXPECT().Dispatch().Return(nil).Once()
+	dispatchJobEvent(events, &JobProcessed{}, "test_job", args)
+
+	// The events without listeners aren't dispatched.
+	dispatchJobEvent(events, &JobFailed{}, "test_job", args)
+
+	// The listeners of the job events don't dispatch the job events.
+	dispatchJobEvent(events, &JobProcessed{}, "TestName", args)
+}
+
+func TestWorkerEventsHandler(t *testing.T) {
+	mockEvent := eventmocks.NewInstance(t)
+	mockTask := eventmocks.NewTask(t)
+	processing := &JobProcessing{}
+	processed := &JobProcessed{}
+	failed := &JobFailed{}
+	retrying := &JobRetrying{}
+	mockEvent.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{
+		processing: {&TestListener{}},
+		processed:  {&TestListener{}},
+		failed:     {&TestListener{}},
+		retrying:   {&TestListener{}},
+	})
+	mockTask.EXPECT().Dispatch().Return(nil)
+
+	worker := &Worker{connection: "redis", queue: "goravel_queues:default"}
+	worker.events = func() event.Instance {
+		return mockEvent
+	}
+	handle := worker.eventsHandler(func(ctx context.Context, args ...any) error {
+		if len(args) == 0 {
+			return nil
+		}
+
+		return args[0].(error)
+	})
+	run := func(signature *tasks.Signature, args ...any) error {
+		task, err := tasks.NewWithSignature(func() {}, signature)
+		assert.Nil(t, err)
+
+		return handle(task.Context, args...)
+	}
+	args := func(uuid string, err ...string) []event.Arg {
+		eventArgs := jobEventArgs("redis", "goravel_queues:default", "test_job", uuid)
+		for _, message := range err {
+			eventArgs = append(eventArgs, event.Arg{Type: "string", Value: message})
+		}
+
+		return eventArgs
+	}
+
+	mockEvent.EXPECT().Job(processing, args("task_1")).Return(mockTask).Once()
+	mockEvent.EXPECT().Job(processed, args("task_1")).Return(mockTask).Once()
+	assert.Nil(t, run(&tasks.Signature{Name: "test_job", UUID: "task_1"}))
+
+	mockEvent.EXPECT().Job(processing, args("task_2")).Return(mockTask).Once()
+	mockEvent.EXPECT().Job(retrying, args("task_2", "failed")).Return(mockTask).Once()
+	assert.EqualError(t, run(&tasks.Signature{Name: "test_job", UUID: "task_2", RetryCount: 1}, errors.New("failed")), "failed")
+
+	mockEvent.EXPECT().Job(processing, args("task_3")).Return(mockTask).Once()
+	mockEvent.EXPECT().Job(retrying, args("task_3", "Task error: released Will retry in: 1s")).Return(mockTask).Once()
+	assert.Error(t, run(&tasks.Signature{Name: "test_job", UUID: "task_3"}, tasks.NewErrRetryTaskLater("released", time.Second)))
+
+	mockEvent.EXPECT().Job(processing, args("task_4")).Return(mockTask).Once()
+	mockEvent.EXPECT().Job(failed, args("task_4", "failed")).Return(mockTask).Once()
+	assert.EqualError(t, run(&tasks.Signature{Name: "test_job", UUID: "task_4"}, errors.New("failed")), "failed")
+}
+
+func TestTaskSyncEvents(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockEvent := eventmocks.NewInstance(t)
+	mockTask := eventmocks.NewTask(t)
+	processing := &JobProcessing{}
+	processed := &JobProcessed{}
+	failed := &JobFailed{}
+	mockConfig.EXPECT().GetString("app.name").Return("goravel")
+	mockConfig.EXPECT().GetString("queue.connections.sync.queue", "default").Return("default")
+	mockEvent.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{
+		processing: {&TestListener{}},
+		processed:  {&TestListener{}},
+		failed:     {&TestListener{}},
+	})
+	mockTask.EXPECT().Dispatch().Return(nil)
+
+	task := &Task{
+		config:     NewConfig(mockConfig),
+		connection: "sync",
+		events: func() event.Instance {
+			return mockEvent
+		},
+		jobs: []queue.Jobs{{Job: &TestSyncJob{}}},
+	}
+	args := func(signature string, err ...string) []event.Arg {
+		eventArgs := jobEventArgs("sync", "goravel_queues:default", signature, "")
+		for _, message := range err {
+			eventArgs = append(eventArgs, event.Arg{Type: "string", Value: message})
+		}
+
+		return eventArgs
+	}
+
+	mockEvent.EXPECT().Job(processing, args("test_sync_job")).Return(mockTask).Once()
+	mockEvent.EXPECT().Job(processed, args("test_sync_job")).Return(mockTask).Once()
+	assert.Nil(t, task.DispatchSync())
+
+	// A failed job isn't retried.
+	task.jobs = []queue.Jobs{{Job: &TestChainAsyncJob{}, Args: []queue.Arg{{Type: "bool", Value: true}}}}
+	mockEvent.EXPECT().Job(processing, args("test_async_job")).Return(mockTask).Once()
+	mockEvent.EXPECT().Job(failed, args("test_async_job", "error")).Return(mockTask).Once()
+	assert.EqualError(t, task.DispatchSync(), "error")
+}
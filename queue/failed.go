@@ -0,0 +1,254 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	logcontract "github.com/goravel/framework/contracts/log"
+	"github.com/goravel/framework/contracts/queue"
+	databasegorm "github.com/goravel/framework/database/gorm"
+)
+
+// DatabaseFailedJob is a row of the failed jobs table, the table can be created by a migration like:
+//
+//	CREATE TABLE failed_jobs (
+//	  id bigint PRIMARY KEY AUTO_INCREMENT,
+//	  uuid varchar(255) NOT NULL,
+//	  connection varchar(255) NOT NULL,
+//	  queue varchar(255) NOT NULL,
+//	  payload text NOT NULL,
+//	  exception text NOT NULL,
+//	  failed_at bigint NOT NULL
+//	);
+type DatabaseFailedJob struct {
+	ID         uint
+	UUID       string
+	Connection string
+	Queue      string
+	Payload    string
+	Exception  string
+	FailedAt   int64
+}
+
+func (r DatabaseFailedJob) toFailedJob() queue.FailedJob {
+	job := queue.FailedJob{
+		ID:         r.ID,
+		UUID:       r.UUID,
+		Connection: r.Connection,
+		Queue:      r.Queue,
+		Payload:    r.Payload,
+		Exception:  r.Exception,
+		FailedAt:   time.Unix(r.FailedAt, 0),
+	}
+
+	var signature tasks.Signature
+	if err := json.Unmarshal([]byte(r.Payload), &signature); err == nil {
+		job.Signature = signature.Name
+	}
+
+	return job
+}
+
+// FailedJobRepository stores the jobs that failed permanently in the database, so they can be retried once
+// the cause of the failure is fixed.
+type FailedJobRepository struct {
+	config *Config
+	log    logcontract.Log
+	mu     sync.Mutex
+	query  orm.Query
+	table  string
+}
+
+func NewFailedJobRepository(config *Config, log logcontract.Log) *FailedJobRepository {
+	return &FailedJobRepository{
+		config: config,
+		log:    log,
+	}
+}
+
+// Enabled Determine if the failed jobs are stored.
+func (r *FailedJobRepository) Enabled() bool {
+	_, table := r.config.Failed()
+
+	return table != "" || r.table != ""
+}
+
+// Log Store a failed job with the exception that failed it.
+func (r *FailedJobRepository) Log(connection, queue string, signature *tasks.Signature, jobErr error) error {
+	query, err := r.getQuery()
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(signature)
+	if err != nil {
+		return err
+	}
+
+	_, err = query.Exec(fmt.Sprintf("INSERT INTO %s (uuid, connection, queue, payload, exception, failed_at) VALUES (?, ?, ?, ?, ?, ?)", r.table),
+		signature.UUID, connection, queue, string(payload), jobErr.Error(), time.Now().Unix())
+
+	return err
+}
+
+func (r *FailedJobRepository) All() ([]queue.FailedJob, error) {
+	query, err := r.getQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []DatabaseFailedJob
+	if err := query.Raw(fmt.Sprintf("SELECT * FROM %s ORDER BY id", r.table)).Scan(&rows); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]queue.FailedJob, 0, len(rows))
+	for _, row := range rows {
+		jobs = append(jobs, row.toFailedJob())
+	}
+
+	return jobs, nil
+}
+
+func (r *FailedJobRepository) Find(id uint) (*queue.FailedJob, error) {
+	query, err := r.getQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	var row DatabaseFailedJob
+	if err := query.Raw(fmt.Sprintf("SELECT * FROM %s WHERE id = ?", r.table), id).Scan(&row); err != nil {
+		return nil, err
+	}
+	if row.ID == 0 {
+		return nil, fmt.Errorf("failed job [%d] not found", id)
+	}
+
+	job := row.toFailedJob()
+
+	return &job, nil
+}
+
+// Retry Publish a failed job to the queue it failed on, the job keeps its UUID.
+func (r *FailedJobRepository) Retry(job queue.FailedJob) error {
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(strings.NewReader(job.Payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		return err
+	}
+	signature.ETA = nil
+
+	server, err := NewMachinery(r.config, r.log).Server(job.Connection, job.Queue)
+	if err != nil {
+		return err
+	}
+	if server == nil {
+		return fmt.Errorf("queue connection [%s] can't retry the jobs", job.Connection)
+	}
+	if _, err := server.SendTask(signature); err != nil {
+		return err
+	}
+
+	_, err = r.Forget(job.ID)
+
+	return err
+}
+
+func (r *FailedJobRepository) Forget(id uint) (bool, error) {
+	query, err := r.getQuery()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := query.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ?", r.table), id)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *FailedJobRepository) Flush(hours int) (int64, error) {
+	query, err := r.getQuery()
+	if err != nil {
+		return 0, err
+	}
+
+	if hours <= 0 {
+		result, err := query.Exec(fmt.Sprintf("DELETE FROM %s", r.table))
+		if err != nil {
+			return 0, err
+		}
+
+		return result.RowsAffected, nil
+	}
+
+	before := time.Now().Add(-time.Duration(hours) * time.Hour).Unix()
+	result, err := query.Exec(fmt.Sprintf("DELETE FROM %s WHERE failed_at < ?", r.table), before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *FailedJobRepository) getQuery() (orm.Query, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.query != nil {
+		return r.query, nil
+	}
+
+	connection, table := r.config.Failed()
+	if table == "" {
+		return nil, errors.New("the failed jobs table isn't configured, please set queue.failed.table")
+	}
+
+	query, err := databasegorm.InitializeQuery(context.Background(), r.config.config, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	r.query = query
+	r.table = table
+
+	return r.query, nil
+}
+
+// failedHandler Wrap the handle of a job to store the job if it fails permanently, a job released back to the
+// queue or retried by machinery hasn't failed yet.
+func failedHandler(failer *FailedJobRepository, connection, queue string, handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		err := handle(ctx, args...)
+		if err == nil {
+			return nil
+		}
+
+		var retryLater tasks.ErrRetryTaskLater
+		if errors.As(err, &retryLater) {
+			return err
+		}
+
+		signature := tasks.SignatureFromContext(ctx)
+		if signature == nil || signature.RetryCount > 0 {
+			return err
+		}
+
+		if logErr := failer.Log(connection, signatureQueue(queue, signature), signature, err); logErr != nil {
+			log.ERROR.Print(logErr)
+		}
+
+		return err
+	}
+}
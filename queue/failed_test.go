@@ -0,0 +1,118 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/database/gorm"
+	configmock "github.com/goravel/framework/mocks/config"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+type FailedJobTestSuite struct {
+	suite.Suite
+	failer *FailedJobRepository
+}
+
+func TestFailedJobTestSuite(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	suite.Run(t, new(FailedJobTestSuite))
+}
+
+func (s *FailedJobTestSuite) SetupTest() {
+	query, err := gorm.NewSqliteDocker(docker.Sqlite()).New()
+	s.Require().Nil(err)
+	_, err = query.Exec("DROP TABLE IF EXISTS failed_jobs")
+	s.Require().Nil(err)
+	_, err = query.Exec("CREATE TABLE failed_jobs (id integer PRIMARY KEY AUTOINCREMENT, uuid varchar(255) NOT NULL, connection varchar(255) NOT NULL, queue varchar(255) NOT NULL, payload text NOT NULL, exception text NOT NULL, failed_at integer NOT NULL)")
+	s.Require().Nil(err)
+
+	s.failer = NewFailedJobRepository(NewConfig(&configmock.Config{}), nil)
+	s.failer.query = query
+	s.failer.table = "failed_jobs"
+}
+
+func (s *FailedJobTestSuite) TestLog() {
+	s.Nil(s.failer.Log("redis", "goravel_queues:default", &tasks.Signature{UUID: "task_1", Name: "test_job"}, errors.New("failed")))
+
+	jobs, err := s.failer.All()
+	s.Nil(err)
+	s.Len(jobs, 1)
+	s.Equal("task_1", jobs[0].UUID)
+	s.Equal("redis", jobs[0].Connection)
+	s.Equal("goravel_queues:default", jobs[0].Queue)
+	s.Equal("test_job", jobs[0].Signature)
+	s.Equal("failed", jobs[0].Exception)
+	s.WithinDuration(time.Now(), jobs[0].FailedAt, 2*time.Second)
+
+	job, err := s.failer.Find(jobs[0].ID)
+	s.Nil(err)
+	s.Equal(jobs[0], *job)
+
+	_, err = s.failer.Find(100)
+	s.EqualError(err, "failed job [100] not found")
+}
+
+func (s *FailedJobTestSuite) TestForgetAndFlush() {
+	s.Nil(s.failer.Log("redis", "goravel_queues:default", &tasks.Signature{UUID: "task_1"}, errors.New("failed")))
+	s.Nil(s.failer.Log("redis", "goravel_queues:default", &tasks.Signature{UUID: "task_2"}, errors.New("failed")))
+	s.Nil(s.failer.Log("redis", "goravel_queues:default", &tasks.Signature{UUID: "task_3"}, errors.New("failed")))
+	_, err := s.failer.query.Exec("UPDATE failed_jobs SET failed_at = ? WHERE uuid = ?", time.Now().Add(-3*time.Hour).Unix(), "task_1")
+	s.Nil(err)
+
+	jobs, err := s.failer.All()
+	s.Nil(err)
+	s.Len(jobs, 3)
+
+	deleted, err := s.failer.Forget(jobs[2].ID)
+	s.Nil(err)
+	s.True(deleted)
+
+	deleted, err = s.failer.Forget(jobs[2].ID)
+	s.Nil(err)
+	s.False(deleted)
+
+	count, err := s.failer.Flush(2)
+	s.Nil(err)
+	s.Equal(int64(1), count)
+
+	count, err = s.failer.Flush(0)
+	s.Nil(err)
+	s.Equal(int64(1), count)
+}
+
+func (s *FailedJobTestSuite) TestFailedHandler() {
+	handle := failedHandler(s.failer, "redis", "goravel_queues:default", func(ctx context.Context, args ...any) error {
+		if len(args) > 0 {
+			return tasks.NewErrRetryTaskLater("released", 0)
+		}
+
+		return errors.New("failed")
+	})
+
+	// The jobs retried by machinery haven't failed yet.
+	task, err := tasks.NewWithSignature(func() {}, &tasks.Signature{UUID: "task_1", RetryCount: 1})
+	s.Nil(err)
+	s.EqualError(handle(task.Context), "failed")
+
+	// The jobs released back to the queue haven't failed yet.
+	task, err = tasks.NewWithSignature(func() {}, &tasks.Signature{UUID: "task_2"})
+	s.Nil(err)
+	s.Error(handle(task.Context, 1))
+
+	s.EqualError(handle(task.Context), "failed")
+
+	jobs, err := s.failer.All()
+	s.Nil(err)
+	s.Len(jobs, 1)
+	s.Equal("task_2", jobs[0].UUID)
+}
@@ -0,0 +1,92 @@
+package queue
+
+import (
+	"sync"
+	"time"
+)
+
+// publishError Wrap the errors of publishing the jobs to a broker, the jobs are dispatched to the fallback connection
+// on them. Machinery formats the errors of the brokers, so the network errors can't be inspected.
+type publishError struct {
+	err error
+}
+
+func (r *publishError) Error() string {
+	return r.err.Error()
+}
+
+func (r *publishError) Unwrap() error {
+	return r.err
+}
+
+// outages Track the unreachable connections, the jobs of an unreachable connection are dispatched to its fallback
+// connection until the probe is due, then the connection is tried again.
+type outages struct {
+	mu      sync.Mutex
+	probeAt map[string]time.Time
+	probe   map[string]time.Duration
+}
+
+func newOutages() *outages {
+	return &outages{
+		probeAt: make(map[string]time.Time),
+		probe:   make(map[string]time.Duration),
+	}
+}
+
+// Degraded Determine whether the jobs of the connection should be dispatched to the fallback. It's false once the
+// probe is due, and the next probe is postponed, so only a dispatch probes the connection at a time.
+func (r *outages) Degraded(connection string) bool {
+	if r == nil {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	probeAt, exist := r.probeAt[connection]
+	if !exist {
+		return false
+	}
+	if time.Now().Before(probeAt) {
+		return true
+	}
+
+	r.probeAt[connection] = time.Now().Add(r.probe[connection])
+
+	return false
+}
+
+// Fail Record the connection is unreachable, it returns true if the connection was reachable.
+func (r *outages) Fail(connection string, probe time.Duration) bool {
+	if r == nil {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, exist := r.probeAt[connection]
+	r.probeAt[connection] = time.Now().Add(probe)
+	r.probe[connection] = probe
+
+	return !exist
+}
+
+// Recover Record the connection is reachable, it returns true if the connection was unreachable.
+func (r *outages) Recover(connection string) bool {
+	if r == nil {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exist := r.probeAt[connection]; !exist {
+		return false
+	}
+	delete(r.probeAt, connection)
+	delete(r.probe, connection)
+
+	return true
+}
@@ -0,0 +1,98 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/contracts/event"
+	"github.com/goravel/framework/contracts/queue"
+	configmocks "github.com/goravel/framework/mocks/config"
+	eventmocks "github.com/goravel/framework/mocks/event"
+	logmocks "github.com/goravel/framework/mocks/log"
+)
+
+func TestOutages(t *testing.T) {
+	var nilOutages *outages
+	assert.False(t, nilOutages.Degraded("redis"))
+	assert.True(t, nilOutages.Fail("redis", time.Second))
+	assert.False(t, nilOutages.Recover("redis"))
+
+	outages := newOutages()
+	assert.False(t, outages.Degraded("redis"))
+	assert.False(t, outages.Recover("redis"))
+
+	assert.True(t, outages.Fail("redis", 100*time.Millisecond))
+	assert.False(t, outages.Fail("redis", 100*time.Millisecond))
+	assert.True(t, outages.Degraded("redis"))
+	assert.False(t, outages.Degraded("sqs"))
+
+	// A dispatch probes the connection once the probe is due, the others keep falling back.
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, outages.Degraded("redis"))
+	assert.True(t, outages.Degraded("redis"))
+
+	assert.True(t, outages.Recover("redis"))
+	assert.False(t, outages.Degraded("redis"))
+}
+
+func TestTaskFallback(t *testing.T) {
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetString("queue.default").Return("redis")
+	mockConfig.EXPECT().GetString("queue.connections.redis.driver").Return("redis")
+	mockConfig.EXPECT().GetString("queue.connections.sync.driver").Return("sync")
+	mockConfig.EXPECT().GetString("queue.connections.redis.fallback").Return("sync")
+	mockConfig.EXPECT().GetInt("queue.connections.redis.fallback_probe", 30).Return(60)
+	mockConfig.EXPECT().GetString("queue.connections.redis.connection").Return("default").Once()
+	mockConfig.EXPECT().GetString("database.redis.default.host").Return("127.0.0.1").Once()
+	mockConfig.EXPECT().GetString("database.redis.default.password").Return("").Once()
+	mockConfig.EXPECT().GetInt("database.redis.default.port").Return(1).Once()
+	mockConfig.EXPECT().GetInt("database.redis.default.database").Return(0).Once()
+	mockConfig.EXPECT().Get("database.redis.default.cluster").Return(nil).Once()
+	mockConfig.EXPECT().GetString("database.redis.default.sentinel.master_name").Return("").Once()
+	mockConfig.EXPECT().GetInt("queue.connections.redis.retry_after", 60).Return(60).Once()
+	mockConfig.EXPECT().GetString("queue.connections.redis.queue", "default").Return("default").Once()
+	mockConfig.EXPECT().GetString("app.name").Return("goravel")
+	mockConfig.EXPECT().GetString("queue.connections.sync.queue", "default").Return("default")
+	mockConfig.EXPECT().GetBool("app.debug").Return(false).Once()
+	mockConfig.EXPECT().GetString("queue.metrics.store").Return("").Once()
+	mockConfig.EXPECT().GetString("queue.connections.redis.compression").Return("").Once()
+	mockConfig.EXPECT().GetInt("queue.connections.redis.compression_threshold", 65536).Return(65536).Once()
+	mockConfig.EXPECT().GetBool("queue.connections.redis.encrypt").Return(false).Once()
+
+	mockEvent := eventmocks.NewInstance(t)
+	mockTask := eventmocks.NewTask(t)
+	degraded := &ConnectionDegraded{}
+	mockEvent.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{
+		degraded: {&TestListener{}},
+	})
+	mockEvent.EXPECT().Job(degraded, mock.MatchedBy(func(args []event.Arg) bool {
+		return len(args) == 3 && args[0].Value == "redis" && args[1].Value == "sync"
+	})).Return(mockTask).Once()
+	mockTask.EXPECT().Dispatch().Return(nil).Once()
+
+	app := NewApplication(mockConfig, logmocks.NewLog(t))
+	app.SetEvents(func() event.Instance {
+		return mockEvent
+	})
+
+	testSyncJob = 0
+	job := &TestSyncJob{}
+
+	// The job is dispatched to the fallback connection once the connection is unreachable.
+	assert.Nil(t, app.Job(job, []queue.Arg{{Type: "string", Value: "fallback"}}).Dispatch())
+	assert.Equal(t, 1, testSyncJob)
+	assert.True(t, app.outages.Degraded("redis"))
+
+	// The connection isn't tried again until the probe is due.
+	assert.Nil(t, app.Job(job, []queue.Arg{{Type: "string", Value: "fallback"}}).Dispatch())
+	assert.Equal(t, 2, testSyncJob)
+
+	// The errors other than the publishing errors don't fall back.
+	task := NewTask(NewConfig(mockConfig), logmocks.NewLog(t), job, nil)
+	assert.False(t, task.degrade(errors.New("encrypt error")))
+	assert.Equal(t, "redis", task.connection)
+}
@@ -0,0 +1,41 @@
+package queue
+
+import (
+	"context"
+
+	machinerylog "github.com/RichardKnop/machinery/v2/log"
+
+	"github.com/goravel/framework/contracts/queue"
+)
+
+// featureHandler Skip a job gated by an inactive feature, the job succeeds without being handled, so it isn't
+// retried or recorded as failed.
+func featureHandler(job queue.Job, handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	if _, ok := job.(queue.HasFeature); !ok {
+		return handle
+	}
+
+	return func(ctx context.Context, args ...any) error {
+		if featureInactive(ctx, job) {
+			return nil
+		}
+
+		return handle(ctx, args...)
+	}
+}
+
+// featureInactive Determine whether a job is gated by a feature that is inactive, the features are inactive if the
+// feature service isn't registered.
+func featureInactive(ctx context.Context, job queue.Job) bool {
+	hasFeature, ok := job.(queue.HasFeature)
+	if !ok || hasFeature.Feature() == "" {
+		return false
+	}
+	if FeatureFacade != nil && FeatureFacade.Active(ctx, hasFeature.Feature()) {
+		return false
+	}
+
+	machinerylog.INFO.Printf("The job %s is skipped since the feature %s is inactive", job.Signature(), hasFeature.Feature())
+
+	return true
+}
@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	featuremocks "github.com/goravel/framework/mocks/feature"
+)
+
+type TestFeatureJob struct {
+	handled int
+}
+
+func (receiver *TestFeatureJob) Signature() string {
+	return "test_feature_job"
+}
+
+func (receiver *TestFeatureJob) Handle(args ...any) error {
+	receiver.handled++
+
+	return nil
+}
+
+func (receiver *TestFeatureJob) Feature() string {
+	return "new-billing"
+}
+
+func TestFeatureHandler(t *testing.T) {
+	ctx := context.Background()
+	job := &TestFeatureJob{}
+	handle := featureHandler(job, func(ctx context.Context, args ...any) error {
+		return job.Handle(args...)
+	})
+
+	assert.Nil(t, handle(ctx))
+	assert.Equal(t, 0, job.handled)
+
+	mockFeature := featuremocks.NewFeature(t)
+	FeatureFacade = mockFeature
+	t.Cleanup(func() {
+		FeatureFacade = nil
+	})
+
+	mockFeature.EXPECT().Active(ctx, "new-billing").Return(false).Once()
+	assert.Nil(t, handle(ctx))
+	assert.Equal(t, 0, job.handled)
+
+	mockFeature.EXPECT().Active(ctx, "new-billing").Return(true).Once()
+	assert.Nil(t, handle(ctx))
+	assert.Equal(t, 1, job.handled)
+
+	mockFeature.EXPECT().Active(context.Background(), "new-billing").Return(false).Once()
+	assert.Nil(t, (&Task{}).handleSync(job, nil))
+	assert.Equal(t, 1, job.handled)
+}
@@ -0,0 +1,316 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/brokers/errs"
+	"github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/common"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/segmentio/kafka-go"
+
+	configcontract "github.com/goravel/framework/contracts/config"
+)
+
+// kafkaDelayInterval The longest time a worker holds a delayed job, a job that isn't due by then is published to
+// the topic again, so the offsets behind it can be committed.
+const kafkaDelayInterval = time.Second
+
+// KafkaBroker is a machinery broker that publishes the jobs to a Kafka topic and consumes them with a consumer
+// group. The jobs are processed by a pool of concurrency workers, and the offsets of a partition are committed in
+// order: the offset of a job is committed once the job and the earlier jobs of its partition are processed. So the
+// jobs are delivered at least once, the jobs that aren't committed by a worker that crashed are consumed again by
+// the other workers of the group, including the processed jobs behind an unprocessed one. A delayed job that isn't
+// due within kafkaDelayInterval is published to the topic again, so it doesn't hold the jobs behind it.
+type KafkaBroker struct {
+	common.Broker
+	brokers      []string
+	groupID      string
+	writer       *kafka.Writer
+	processingWG sync.WaitGroup
+}
+
+type kafkaJob struct {
+	pending   *kafkaPending
+	signature *tasks.Signature
+}
+
+func NewKafkaBroker(cnf *config.Config, brokers []string, groupID string) *KafkaBroker {
+	return &KafkaBroker{
+		Broker:  common.NewBroker(cnf),
+		brokers: brokers,
+		groupID: groupID,
+		writer:  newKafkaWriter(brokers),
+	}
+}
+
+// StartConsuming enters a loop and consumes the jobs of the topic
+func (r *KafkaBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	r.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	topic := taskProcessor.CustomQueue()
+	if topic == "" {
+		topic = r.GetConfig().DefaultQueue
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: r.brokers,
+		GroupID: r.groupID,
+		Topic:   topic,
+	})
+	defer reader.Close()
+	// The running jobs are waited for before the reader is closed, so their offsets can be committed.
+	defer r.processingWG.Wait()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-r.GetStopChan():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	committer := newKafkaCommitter(func(message kafka.Message) error {
+		return reader.CommitMessages(context.Background(), message)
+	})
+
+	// The jobs are handed to the workers unbuffered, so the fetching waits while all the workers are busy.
+	jobs := make(chan kafkaJob)
+	defer close(jobs)
+	for i := 0; i < concurrency; i++ {
+		r.processingWG.Add(1)
+		go func() {
+			defer r.processingWG.Done()
+
+			for job := range jobs {
+				// The offset of a job that wasn't processed isn't committed, so the job is consumed again.
+				committer.done(job.pending, r.handle(ctx, job.signature, taskProcessor))
+			}
+		}()
+	}
+
+	log.INFO.Print("[*] Waiting for messages. To exit press CTRL+C")
+
+	for {
+		message, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return r.GetRetry(), nil
+			}
+
+			return r.GetRetry(), err
+		}
+
+		job := kafkaJob{pending: committer.add(message), signature: r.decode(message)}
+		select {
+		case jobs <- job:
+		case <-ctx.Done():
+			return r.GetRetry(), nil
+		}
+	}
+}
+
+// StopConsuming quits the loop
+func (r *KafkaBroker) StopConsuming() {
+	r.Broker.StopConsuming()
+	r.processingWG.Wait()
+}
+
+// Publish writes a job to the topic, the jobs are spread across the partitions by their UUID.
+func (r *KafkaBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	r.AdjustRoutingKey(signature)
+
+	payload, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	return r.writer.WriteMessages(ctx, kafka.Message{
+		Topic: signature.RoutingKey,
+		Key:   []byte(signature.UUID),
+		Value: payload,
+	})
+}
+
+// decode Get the signature of a message, it's nil if the message isn't a valid signature.
+func (r *KafkaBroker) decode(message kafka.Message) *tasks.Signature {
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(strings.NewReader(string(message.Value)))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		log.ERROR.Print(errs.NewErrCouldNotUnmarshalTaskSignature(message.Value, err))
+
+		return nil
+	}
+
+	return signature
+}
+
+// handle Run a job once it's due, a delayed job that isn't due within kafkaDelayInterval is published to the topic
+// again. It returns false if the consumer is stopped before the job is finished.
+func (r *KafkaBroker) handle(ctx context.Context, signature *tasks.Signature, taskProcessor iface.TaskProcessor) bool {
+	if signature != nil && signature.ETA != nil {
+		if delay := time.Until(*signature.ETA); delay > 0 {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-time.After(min(delay, kafkaDelayInterval)):
+			}
+
+			if time.Until(*signature.ETA) > 0 {
+				return r.republish(ctx, signature)
+			}
+		}
+	}
+
+	return r.process(ctx, signature, taskProcessor)
+}
+
+// process Run a job, it returns false if the consumer is stopped before the job is released to the other workers.
+// The invalid messages are skipped.
+func (r *KafkaBroker) process(ctx context.Context, signature *tasks.Signature, taskProcessor iface.TaskProcessor) bool {
+	if signature == nil {
+		return true
+	}
+
+	if !r.IsTaskRegistered(signature.Name) {
+		// Release the job for the workers that have registered the task.
+		return r.republish(ctx, signature)
+	}
+
+	if err := taskProcessor.Process(signature); err != nil {
+		log.ERROR.Print(err)
+	}
+
+	return true
+}
+
+// republish Publish a job to the topic again, the publishing is retried until it succeeds, since the offsets behind
+// the job can't be committed before. It returns false if the consumer is stopped before.
+func (r *KafkaBroker) republish(ctx context.Context, signature *tasks.Signature) bool {
+	for {
+		err := r.Publish(ctx, signature)
+		if err == nil {
+			return true
+		}
+		log.ERROR.Print(err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// kafkaCommitter Commit the offsets of the partitions in order, the offset of a message is committed once the
+// message and the earlier messages of its partition are processed. The offsets behind a message that isn't
+// processed aren't committed anymore, so the messages are consumed again after the consumer restarts.
+type kafkaCommitter struct {
+	commit  func(message kafka.Message) error
+	mu      sync.Mutex
+	pending map[int][]*kafkaPending
+}
+
+type kafkaPending struct {
+	done      bool
+	message   kafka.Message
+	processed bool
+}
+
+func newKafkaCommitter(commit func(message kafka.Message) error) *kafkaCommitter {
+	return &kafkaCommitter{
+		commit:  commit,
+		pending: make(map[int][]*kafkaPending),
+	}
+}
+
+// add Track a fetched message, the messages of a partition must be added in the order they are fetched.
+func (r *kafkaCommitter) add(message kafka.Message) *kafkaPending {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pending := &kafkaPending{message: message}
+	r.pending[message.Partition] = append(r.pending[message.Partition], pending)
+
+	return pending
+}
+
+// done Mark a message as finished, and commit the offset of the last message of the processed head of its
+// partition. The commit is made under the lock, so the offsets of a partition never go backwards.
+func (r *kafkaCommitter) done(pending *kafkaPending, processed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pending.done = true
+	pending.processed = processed
+
+	partition := pending.message.Partition
+	queue := r.pending[partition]
+	var last *kafkaPending
+	for len(queue) > 0 && queue[0].done && queue[0].processed {
+		last = queue[0]
+		queue = queue[1:]
+	}
+	r.pending[partition] = queue
+	if last == nil {
+		return
+	}
+
+	if err := r.commit(last.message); err != nil {
+		log.ERROR.Print(err)
+	}
+}
+
+// KafkaProducer publishes messages to the topics of the Kafka queue connections, a writer is shared by the
+// messages of a connection.
+type KafkaProducer struct {
+	config  *Config
+	writers sync.Map
+}
+
+func NewKafkaProducer(config configcontract.Config) *KafkaProducer {
+	return &KafkaProducer{
+		config: NewConfig(config),
+	}
+}
+
+// Publish writes the messages to a Kafka connection, the default queue connection is used if connection is empty.
+func (r *KafkaProducer) Publish(ctx context.Context, connection string, messages ...kafka.Message) error {
+	if connection == "" {
+		connection = r.config.DefaultConnection()
+	}
+
+	writer, ok := r.writers.Load(connection)
+	if !ok {
+		if driver := r.config.Driver(connection); driver != DriverKafka {
+			return fmt.Errorf("queue connection [%s] isn't a kafka connection", connection)
+		}
+
+		brokers, _, _ := r.config.Kafka(connection)
+		writer, _ = r.writers.LoadOrStore(connection, newKafkaWriter(brokers))
+	}
+
+	return writer.(*kafka.Writer).WriteMessages(ctx, messages...)
+}
+
+func newKafkaWriter(brokers []string) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:                   kafka.TCP(brokers...),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+	}
+}
@@ -0,0 +1,75 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+
+	configmock "github.com/goravel/framework/mocks/config"
+)
+
+func TestKafkaProducerPublish(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.On("GetString", "queue.default").Return("redis").Once()
+	mockConfig.On("GetString", "queue.connections.redis.driver").Return("redis").Once()
+
+	producer := NewKafkaProducer(mockConfig)
+	assert.EqualError(t, producer.Publish(context.Background(), ""), "queue connection [redis] isn't a kafka connection")
+}
+
+func TestKafkaCommitter(t *testing.T) {
+	var committed []string
+	committer := newKafkaCommitter(func(message kafka.Message) error {
+		committed = append(committed, fmt.Sprintf("%d:%d", message.Partition, message.Offset))
+
+		return nil
+	})
+
+	first := committer.add(kafka.Message{Partition: 0, Offset: 1})
+	second := committer.add(kafka.Message{Partition: 0, Offset: 2})
+	third := committer.add(kafka.Message{Partition: 0, Offset: 3})
+	other := committer.add(kafka.Message{Partition: 1, Offset: 1})
+
+	// The offsets behind an unfinished message aren't committed.
+	committer.done(second, true)
+	assert.Empty(t, committed)
+
+	// The partitions are committed independently.
+	committer.done(other, true)
+	assert.Equal(t, []string{"1:1"}, committed)
+
+	// The processed head of the partition is committed by its last offset.
+	committer.done(first, true)
+	assert.Equal(t, []string{"1:1", "0:2"}, committed)
+
+	// The offsets behind a message that isn't processed aren't committed anymore.
+	fourth := committer.add(kafka.Message{Partition: 0, Offset: 4})
+	committer.done(third, false)
+	committer.done(fourth, true)
+	assert.Equal(t, []string{"1:1", "0:2"}, committed)
+}
+
+func TestKafkaBrokerHandle(t *testing.T) {
+	broker := NewKafkaBroker(&config.Config{}, []string{"127.0.0.1:9092"}, "goravel")
+	broker.SetRegisteredTaskNames([]string{"task"})
+	processor := &testTaskProcessor{}
+
+	// A job due within the delay interval is processed once it's due.
+	eta := time.Now().Add(10 * time.Millisecond)
+	assert.True(t, broker.handle(context.Background(), &tasks.Signature{Name: "task", ETA: &eta}, processor))
+	assert.Equal(t, []string{"task"}, processor.processed)
+	assert.False(t, time.Now().Before(eta))
+
+	// A delayed job isn't finished if the consumer is stopped while it waits.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	eta = time.Now().Add(time.Hour)
+	assert.False(t, broker.handle(ctx, &tasks.Signature{Name: "task", ETA: &eta}, processor))
+	assert.Equal(t, []string{"task"}, processor.processed)
+}
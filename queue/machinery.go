@@ -1,16 +1,24 @@
 package queue
 
 import (
+	"context"
 	"fmt"
 
+	"cloud.google.com/go/pubsub"
 	"github.com/RichardKnop/machinery/v2"
+	nullbackend "github.com/RichardKnop/machinery/v2/backends/null"
 	redisbackend "github.com/RichardKnop/machinery/v2/backends/redis"
-	redisbroker "github.com/RichardKnop/machinery/v2/brokers/redis"
 	"github.com/RichardKnop/machinery/v2/config"
 	"github.com/RichardKnop/machinery/v2/locks/eager"
 	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"google.golang.org/api/option"
 
 	logcontract "github.com/goravel/framework/contracts/log"
+	databasegorm "github.com/goravel/framework/database/gorm"
 	"github.com/goravel/framework/support/color"
 )
 
@@ -33,32 +41,200 @@ func (m *Machinery) Server(connection string, queue string) (*machinery.Server,
 		return nil, nil
 	case DriverRedis:
 		return m.redisServer(connection, queue), nil
+	case DriverPubSub:
+		return m.pubSubServer(connection, queue)
+	case DriverDatabase:
+		return m.databaseServer(connection, queue)
+	case DriverSqs:
+		return m.sqsServer(connection, queue)
+	case DriverAmqp:
+		return m.amqpServer(connection, queue), nil
+	case DriverKafka:
+		return m.kafkaServer(connection, queue), nil
 	}
 
 	return nil, fmt.Errorf("unknown queue driver: %s", driver)
 }
 
 func (m *Machinery) redisServer(connection string, queue string) *machinery.Server {
-	redisConfig, database, defaultQueue := m.config.Redis(connection)
+	redisConnection, defaultQueue, retryAfter := m.config.Redis(connection)
 	if queue == "" {
 		queue = defaultQueue
 	}
 
 	cnf := &config.Config{
 		DefaultQueue: queue,
-		Redis:        &config.RedisConfig{},
+		Redis: &config.RedisConfig{
+			MasterName: redisConnection.MasterName,
+		},
 	}
 
-	broker := redisbroker.NewGR(cnf, []string{redisConfig}, database)
-	backend := redisbackend.NewGR(cnf, []string{redisConfig}, database)
+	broker := NewRedisBroker(cnf, redisConnection, retryAfter)
+	backend := redisbackend.NewGR(cnf, redisConnection.Addrs(), redisConnection.Database)
 	lock := eager.New()
 
+	m.setLogger()
+
+	return machinery.NewServer(cnf, broker, backend, lock)
+}
+
+func (m *Machinery) pubSubServer(connection string, queue string) (*machinery.Server, error) {
+	projectID, subscription, topic, orderingKey, credentials, retryAfter := m.config.PubSub(connection)
+	if queue == "" {
+		queue = topic
+	}
+
+	var options []option.ClientOption
+	if credentials != "" {
+		options = append(options, option.WithCredentialsFile(credentials))
+	}
+
+	client, err := pubsub.NewClient(context.Background(), projectID, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	cnf := &config.Config{
+		DefaultQueue: m.config.PubSubTopic(queue),
+		GCPPubSub: &config.GCPPubSubConfig{
+			Client:       client,
+			MaxExtension: retryAfter,
+		},
+	}
+
+	broker, err := NewPubSubBroker(cnf, client, projectID, subscription, orderingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	m.setLogger()
+
+	return machinery.NewServer(cnf, broker, nullbackend.New(), eager.New()), nil
+}
+
+func (m *Machinery) databaseServer(connection string, queue string) (*machinery.Server, error) {
+	broker, err := m.databaseBroker(connection, queue)
+	if err != nil {
+		return nil, err
+	}
+
+	m.setLogger()
+
+	return machinery.NewServer(broker.GetConfig(), broker, nullbackend.New(), eager.New()), nil
+}
+
+// redisBroker Create the broker of a redis connection without the backend of the server.
+func (m *Machinery) redisBroker(connection string) *RedisBroker {
+	redisConnection, defaultQueue, retryAfter := m.config.Redis(connection)
+
+	return NewRedisBroker(&config.Config{
+		DefaultQueue: defaultQueue,
+		Redis: &config.RedisConfig{
+			MasterName: redisConnection.MasterName,
+		},
+	}, redisConnection, retryAfter)
+}
+
+// databaseBroker Create the broker of a database connection, it opens a connection pool of the database.
+func (m *Machinery) databaseBroker(connection string, queue string) (*DatabaseBroker, error) {
+	databaseConnection, table, defaultQueue, batch, sleep, maxSleep, retryAfter := m.config.Database(connection)
+	if queue == "" {
+		queue = defaultQueue
+	}
+
+	query, err := databasegorm.InitializeQuery(context.Background(), m.config.config, databaseConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDatabaseBroker(&config.Config{DefaultQueue: queue}, query, table, batch, sleep, maxSleep, retryAfter), nil
+}
+
+func (m *Machinery) sqsServer(connection string, queue string) (*machinery.Server, error) {
+	prefix, defaultQueue, region, key, secret, messageGroup, waitTime, visibilityTimeout := m.config.Sqs(connection)
+	if queue == "" {
+		queue = defaultQueue
+	}
+
+	awsConfig := aws.NewConfig().WithRegion(region)
+	if key != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(key, secret, ""))
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	cnf := &config.Config{
+		Broker:       prefix,
+		DefaultQueue: m.config.SqsQueue(queue),
+		SQS: &config.SQSConfig{
+			Client:          sqs.New(sess),
+			WaitTimeSeconds: waitTime,
+		},
+	}
+	if visibilityTimeout > 0 {
+		cnf.SQS.VisibilityTimeout = &visibilityTimeout
+	}
+
+	m.setLogger()
+
+	return machinery.NewServer(cnf, NewSqsBroker(cnf, messageGroup), nullbackend.New(), eager.New()), nil
+}
+
+func (m *Machinery) amqpServer(connection string, queue string) *machinery.Server {
+	url, defaultQueue, exchange, exchangeType, routingKey, deadLetterExchange, deadLetterQueue, delayedExchange, prefetch := m.config.Amqp(connection)
+
+	if queue == "" {
+		queue = defaultQueue
+	}
+
+	// The routing key and the dead-letter queue of another queue are derived from its name like the default queue.
+	if queue != defaultQueue {
+		if routingKey == defaultQueue {
+			routingKey = queue
+		}
+		if deadLetterQueue == defaultQueue+".failed" {
+			deadLetterQueue = queue + ".failed"
+		}
+	}
+
+	cnf := &config.Config{
+		Broker:       url,
+		DefaultQueue: queue,
+		AMQP: &config.AMQPConfig{
+			Exchange:      exchange,
+			ExchangeType:  exchangeType,
+			BindingKey:    routingKey,
+			PrefetchCount: prefetch,
+		},
+	}
+
+	m.setLogger()
+
+	return machinery.NewServer(cnf, NewAmqpBroker(cnf, routingKey, deadLetterExchange, deadLetterQueue, delayedExchange), nullbackend.New(), eager.New())
+}
+
+func (m *Machinery) kafkaServer(connection string, queue string) *machinery.Server {
+	brokers, topic, group := m.config.Kafka(connection)
+	if queue == "" {
+		queue = topic
+	}
+
+	cnf := &config.Config{
+		DefaultQueue: m.config.KafkaTopic(queue),
+	}
+
+	m.setLogger()
+
+	return machinery.NewServer(cnf, NewKafkaBroker(cnf, brokers, group), nullbackend.New(), eager.New())
+}
+
+func (m *Machinery) setLogger() {
 	debug := m.config.config.GetBool("app.debug")
 	log.DEBUG = NewDebug(debug, m.log)
 	log.INFO = NewInfo(debug, m.log)
 	log.WARNING = NewWarning(debug, m.log)
 	log.ERROR = NewError(debug, m.log)
 	log.FATAL = NewFatal(debug, m.log)
-
-	return machinery.NewServer(cnf, broker, backend, lock)
 }
@@ -0,0 +1,223 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/cache"
+	logcontract "github.com/goravel/framework/contracts/log"
+	"github.com/goravel/framework/contracts/queue"
+)
+
+const queuedHeader = "queued_at"
+
+// MetricsRepository records the throughput, the wait time, the runtime and the failures of the queues in a cache
+// store, the store is shared by the workers, e.g. redis or database, so the metrics cover all of them.
+type MetricsRepository struct {
+	config *Config
+	log    logcontract.Log
+	// sizers The brokers counting the jobs by connection, they're shared, so their connections are reused.
+	sizers   map[string]sizer
+	sizersMu sync.Mutex
+}
+
+// sizer is implemented by the brokers counting the pending jobs of a queue.
+type sizer interface {
+	Size(queue string) (int, error)
+}
+
+func NewMetricsRepository(config *Config, log logcontract.Log) *MetricsRepository {
+	return &MetricsRepository{
+		config: config,
+		log:    log,
+		sizers: make(map[string]sizer),
+	}
+}
+
+// Enabled Determine if the metrics are recorded.
+func (r *MetricsRepository) Enabled() bool {
+	return r.config.Metrics() != ""
+}
+
+// Record Record a processed job of a queue, queue is the full name of the queue, e.g. "goravel_queues:default".
+func (r *MetricsRepository) Record(connection, queue string, wait, runtime time.Duration, failed bool) error {
+	store, err := r.store()
+	if err != nil {
+		return err
+	}
+
+	key := metricsKey(connection, queue)
+	store.Add(key+":started_at", time.Now().Unix(), 0)
+	if _, err := store.Increment(key+":processed", 1); err != nil {
+		return err
+	}
+	if _, err := store.Increment(key+":wait", wait.Milliseconds()); err != nil {
+		return err
+	}
+	if _, err := store.Increment(key+":runtime", runtime.Milliseconds()); err != nil {
+		return err
+	}
+	if failed {
+		if _, err := store.Increment(key+":failed", 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *MetricsRepository) Metrics(connection, queueName string) (*queue.Metrics, error) {
+	store, err := r.store()
+	if err != nil {
+		return nil, err
+	}
+
+	if connection == "" {
+		connection = r.config.DefaultConnection()
+	}
+	key := metricsKey(connection, r.config.Queue(connection, queueName))
+	metrics := &queue.Metrics{
+		Connection: connection,
+		Queue:      queueName,
+		Processed:  store.GetInt64(key + ":processed"),
+		Failed:     store.GetInt64(key + ":failed"),
+	}
+	if metrics.Processed == 0 {
+		return metrics, nil
+	}
+
+	metrics.AverageWait = time.Duration(store.GetInt64(key+":wait")/metrics.Processed) * time.Millisecond
+	metrics.AverageRuntime = time.Duration(store.GetInt64(key+":runtime")/metrics.Processed) * time.Millisecond
+	if startedAt := store.GetInt64(key + ":started_at"); startedAt > 0 {
+		metrics.StartedAt = time.Unix(startedAt, 0)
+		metrics.Throughput = float64(metrics.Processed) / max(time.Since(metrics.StartedAt).Minutes(), 1)
+	}
+
+	return metrics, nil
+}
+
+// Size Get the number of the pending jobs of a queue, the delayed and the reserved jobs aren't counted.
+func (r *MetricsRepository) Size(connection, queueName string) (int, error) {
+	if connection == "" {
+		connection = r.config.DefaultConnection()
+	}
+	driver := r.config.Driver(connection)
+	if driver == DriverSync {
+		return 0, nil
+	}
+	if driver != DriverRedis && driver != DriverDatabase {
+		return 0, fmt.Errorf("queue driver [%s] doesn't support getting the size of the queues", driver)
+	}
+
+	broker, err := r.sizer(connection, driver)
+	if err != nil {
+		return 0, err
+	}
+
+	return broker.Size(r.config.Queue(connection, queueName))
+}
+
+// sizer Get the broker counting the jobs of a connection, it's created once per connection.
+func (r *MetricsRepository) sizer(connection, driver string) (sizer, error) {
+	r.sizersMu.Lock()
+	defer r.sizersMu.Unlock()
+
+	if broker, ok := r.sizers[connection]; ok {
+		return broker, nil
+	}
+
+	var broker sizer
+	machinery := NewMachinery(r.config, r.log)
+	if driver == DriverRedis {
+		broker = machinery.redisBroker(connection)
+	} else {
+		databaseBroker, err := machinery.databaseBroker(connection, "")
+		if err != nil {
+			return nil, err
+		}
+		broker = databaseBroker
+	}
+	r.sizers[connection] = broker
+
+	return broker, nil
+}
+
+func (r *MetricsRepository) Reset(connection, queueName string) error {
+	store, err := r.store()
+	if err != nil {
+		return err
+	}
+
+	if connection == "" {
+		connection = r.config.DefaultConnection()
+	}
+	key := metricsKey(connection, r.config.Queue(connection, queueName))
+	for _, name := range []string{"started_at", "processed", "failed", "wait", "runtime"} {
+		store.Forget(key + ":" + name)
+	}
+
+	return nil
+}
+
+func (r *MetricsRepository) store() (cache.Driver, error) {
+	name := r.config.Metrics()
+	if name == "" {
+		return nil, errors.New("the queue metrics store isn't configured, please set queue.metrics.store")
+	}
+	if CacheFacade == nil {
+		return nil, errors.New("cache support is required")
+	}
+
+	store := CacheFacade.Store(name)
+	if store == nil {
+		return nil, fmt.Errorf("cache store [%s] not found", name)
+	}
+
+	return store, nil
+}
+
+func metricsKey(connection, queue string) string {
+	return fmt.Sprintf("queue:metrics:%s:%s", connection, queue)
+}
+
+// metricsHandler Wrap the handle of a job to record its wait time, its runtime and whether it failed permanently,
+// a job released back to the queue isn't recorded until it's processed.
+func metricsHandler(metrics *MetricsRepository, connection, queue string, handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		start := time.Now()
+		err := handle(ctx, args...)
+
+		signature := tasks.SignatureFromContext(ctx)
+		if signature == nil {
+			return err
+		}
+
+		var retryLater tasks.ErrRetryTaskLater
+		if errors.As(err, &retryLater) {
+			return err
+		}
+
+		var wait time.Duration
+		if queuedAt := cast.ToInt64(signature.Headers[queuedHeader]); queuedAt > 0 {
+			available := time.UnixMilli(queuedAt)
+			if signature.ETA != nil && signature.ETA.After(available) {
+				available = *signature.ETA
+			}
+			wait = max(start.Sub(available), 0)
+		}
+
+		failed := err != nil && signature.RetryCount == 0
+		if recordErr := metrics.Record(connection, signatureQueue(queue, signature), wait, time.Since(start), failed); recordErr != nil {
+			log.ERROR.Print(recordErr)
+		}
+
+		return err
+	}
+}
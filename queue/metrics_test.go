@@ -0,0 +1,125 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+
+	configmock "github.com/goravel/framework/mocks/config"
+)
+
+func newTestMetrics(t *testing.T) *MetricsRepository {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("queue.metrics.store").Return("memory")
+	mockConfig.EXPECT().GetString("queue.default").Return("redis").Maybe()
+	mockConfig.EXPECT().GetString("app.name").Return("goravel").Maybe()
+	mockConfig.EXPECT().GetString("queue.connections.redis.queue", "default").Return("default").Maybe()
+
+	return NewMetricsRepository(NewConfig(mockConfig), nil)
+}
+
+func TestMetricsHandler(t *testing.T) {
+	CacheFacade = newTestCache(t)
+	t.Cleanup(func() {
+		CacheFacade = nil
+	})
+
+	metrics := newTestMetrics(t)
+	assert.True(t, metrics.Enabled())
+
+	handle := metricsHandler(metrics, "redis", "goravel_queues:default", func(ctx context.Context, args ...any) error {
+		return args[0].(func() error)()
+	})
+	queuedAt := time.Now().Add(-2 * time.Second).UnixMilli()
+	run := func(signature *tasks.Signature, job func() error) error {
+		task, err := tasks.NewWithSignature(func() {}, signature)
+		assert.Nil(t, err)
+
+		return handle(task.Context, job)
+	}
+
+	assert.Nil(t, run(&tasks.Signature{Headers: tasks.Headers{queuedHeader: queuedAt}}, func() error {
+		time.Sleep(10 * time.Millisecond)
+
+		return nil
+	}))
+	// A job retried by machinery hasn't failed yet.
+	assert.NotNil(t, run(&tasks.Signature{RetryCount: 1}, func() error {
+		return errors.New("error")
+	}))
+	assert.NotNil(t, run(&tasks.Signature{}, func() error {
+		return errors.New("error")
+	}))
+	// A job released back to the queue isn't recorded.
+	assert.NotNil(t, run(&tasks.Signature{}, func() error {
+		return tasks.NewErrRetryTaskLater("release", time.Second)
+	}))
+
+	result, err := metrics.Metrics("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "redis", result.Connection)
+	assert.Equal(t, int64(3), result.Processed)
+	assert.Equal(t, int64(1), result.Failed)
+	assert.Equal(t, float64(3), result.Throughput)
+	assert.True(t, result.AverageWait >= 600*time.Millisecond)
+	assert.True(t, result.AverageRuntime >= 3*time.Millisecond)
+	assert.False(t, result.StartedAt.IsZero())
+
+	assert.Nil(t, metrics.Reset("redis", ""))
+	result, err = metrics.Metrics("redis", "")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), result.Processed)
+	assert.Equal(t, int64(0), result.Failed)
+}
+
+func TestMetricsRepository_Disabled(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("queue.metrics.store").Return("")
+	metrics := NewMetricsRepository(NewConfig(mockConfig), nil)
+
+	assert.False(t, metrics.Enabled())
+	_, err := metrics.Metrics("redis", "default")
+	assert.EqualError(t, err, "the queue metrics store isn't configured, please set queue.metrics.store")
+}
+
+func TestMetricsRepository_Size(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("queue.connections.sync.driver").Return(DriverSync).Once()
+	mockConfig.EXPECT().GetString("queue.connections.kafka.driver").Return(DriverKafka).Once()
+	metrics := NewMetricsRepository(NewConfig(mockConfig), nil)
+
+	size, err := metrics.Size("sync", "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, size)
+
+	_, err = metrics.Size("kafka", "default")
+	assert.EqualError(t, err, "queue driver [kafka] doesn't support getting the size of the queues")
+}
+
+type fakeSizer struct {
+	sizes map[string]int
+}
+
+func (r *fakeSizer) Size(queue string) (int, error) {
+	return r.sizes[queue], nil
+}
+
+func TestMetricsRepository_Size_SharedBroker(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("queue.connections.redis.driver").Return(DriverRedis).Twice()
+	mockConfig.EXPECT().GetString("queue.connections.redis.queue", "default").Return("default").Twice()
+	mockConfig.EXPECT().GetString("app.name").Return("goravel").Twice()
+	metrics := NewMetricsRepository(NewConfig(mockConfig), nil)
+	metrics.sizers["redis"] = &fakeSizer{sizes: map[string]int{"goravel_queues:default": 2}}
+
+	for i := 0; i < 2; i++ {
+		size, err := metrics.Size("redis", "")
+		assert.Nil(t, err)
+		assert.Equal(t, 2, size)
+	}
+	assert.Len(t, metrics.sizers, 1)
+}
@@ -0,0 +1,161 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+
+	"github.com/goravel/framework/contracts/queue"
+)
+
+// middlewareHandler Wrap the handle of a job with its middleware.
+func middlewareHandler(job queue.Job, handle func(args ...any) error) func(args ...any) error {
+	hasMiddleware, ok := job.(queue.HasMiddleware)
+	if !ok {
+		return handle
+	}
+
+	return func(args ...any) error {
+		middleware := hasMiddleware.Middleware()
+		next := func() error {
+			return handle(args...)
+		}
+		for i := len(middleware) - 1; i >= 0; i-- {
+			current, inner := middleware[i], next
+			next = func() error {
+				return current.Handle(job, args, inner)
+			}
+		}
+
+		return next()
+	}
+}
+
+// hit Increase the number of hits of a key, the hits expire after the decay.
+func hit(key string, decay time.Duration) (int, error) {
+	instance, err := lock(key+":lock", time.Second)
+	if err != nil {
+		return 0, err
+	}
+	if !instance.Block(time.Second) {
+		return 0, fmt.Errorf("failed to acquire the lock of %s", key)
+	}
+	defer instance.Release()
+
+	hits := CacheFacade.GetInt(key) + 1
+	if err := CacheFacade.Put(key, hits, decay); err != nil {
+		return 0, err
+	}
+
+	return hits, nil
+}
+
+// RateLimited is a job middleware limiting the number of the jobs with the same key handled in a period, the
+// jobs exceeding the limit are released back to the queue until the next period.
+type RateLimited struct {
+	key         string
+	maxAttempts int
+	decay       time.Duration
+	dontRelease bool
+}
+
+func NewRateLimited(key string, maxAttempts int, decay time.Duration) *RateLimited {
+	return &RateLimited{
+		key:         key,
+		maxAttempts: maxAttempts,
+		decay:       decay,
+	}
+}
+
+// DontRelease Drop the jobs exceeding the limit instead of releasing them back to the queue.
+func (r *RateLimited) DontRelease() *RateLimited {
+	r.dontRelease = true
+
+	return r
+}
+
+func (r *RateLimited) Handle(job queue.Job, args []any, next func() error) error {
+	if CacheFacade == nil {
+		return errors.New("cache support is required")
+	}
+
+	period := time.Now().UnixNano() / int64(r.decay)
+	hits, err := hit(fmt.Sprintf("queue:rate_limited:%s:%s:%d", job.Signature(), r.key, period), r.decay)
+	if err != nil {
+		return err
+	}
+	if hits > r.maxAttempts {
+		if r.dontRelease {
+			return nil
+		}
+
+		return tasks.NewErrRetryTaskLater("the job is rate limited", time.Duration((period+1)*int64(r.decay)-time.Now().UnixNano()))
+	}
+
+	return next()
+}
+
+// ThrottlesExceptions is a job middleware releasing the jobs with the same key back to the queue for a period
+// once they fail a number of times in a row, e.g. while a third-party service is down.
+type ThrottlesExceptions struct {
+	key         string
+	maxAttempts int
+	decay       time.Duration
+	backoff     time.Duration
+}
+
+func NewThrottlesExceptions(maxAttempts int, decay time.Duration) *ThrottlesExceptions {
+	return &ThrottlesExceptions{
+		maxAttempts: maxAttempts,
+		decay:       decay,
+	}
+}
+
+// By Set the key of the throttled jobs, the jobs are throttled by their signature by default.
+func (r *ThrottlesExceptions) By(key string) *ThrottlesExceptions {
+	r.key = key
+
+	return r
+}
+
+// Backoff Set the delay of the failed job released back to the queue before the limit is reached, the failed
+// job is returned as failed by default.
+func (r *ThrottlesExceptions) Backoff(delay time.Duration) *ThrottlesExceptions {
+	r.backoff = delay
+
+	return r
+}
+
+func (r *ThrottlesExceptions) Handle(job queue.Job, args []any, next func() error) error {
+	if CacheFacade == nil {
+		return errors.New("cache support is required")
+	}
+
+	key := fmt.Sprintf("queue:throttles_exceptions:%s:%s", job.Signature(), r.key)
+	if CacheFacade.GetInt(key) >= r.maxAttempts {
+		return tasks.NewErrRetryTaskLater("the job is throttled", r.decay)
+	}
+
+	err := next()
+	if err == nil {
+		CacheFacade.Forget(key)
+
+		return nil
+	}
+
+	var retryLater tasks.ErrRetryTaskLater
+	if errors.As(err, &retryLater) {
+		return err
+	}
+
+	if _, hitErr := hit(key, r.decay); hitErr != nil {
+		return errors.Join(err, hitErr)
+	}
+	if r.backoff > 0 {
+		return tasks.NewErrRetryTaskLater(err.Error(), r.backoff)
+	}
+
+	return err
+}
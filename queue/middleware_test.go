@@ -0,0 +1,123 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+)
+
+type TestMiddlewareJob struct {
+	middleware []contractsqueue.Middleware
+	calls      []string
+}
+
+func (receiver *TestMiddlewareJob) Signature() string {
+	return "test_middleware_job"
+}
+
+func (receiver *TestMiddlewareJob) Handle(args ...any) error {
+	receiver.calls = append(receiver.calls, "handle")
+
+	return nil
+}
+
+func (receiver *TestMiddlewareJob) Middleware() []contractsqueue.Middleware {
+	return receiver.middleware
+}
+
+type testMiddleware struct {
+	name string
+	job  *TestMiddlewareJob
+}
+
+func (r *testMiddleware) Handle(job contractsqueue.Job, args []any, next func() error) error {
+	r.job.calls = append(r.job.calls, r.name)
+
+	return next()
+}
+
+func TestMiddlewareHandler(t *testing.T) {
+	job := &TestMiddlewareJob{}
+	job.middleware = []contractsqueue.Middleware{
+		&testMiddleware{name: "first", job: job},
+		contractsqueue.MiddlewareFunc(func(job contractsqueue.Job, args []any, next func() error) error {
+			job.(*TestMiddlewareJob).calls = append(job.(*TestMiddlewareJob).calls, "func")
+
+			return next()
+		}),
+		&testMiddleware{name: "second", job: job},
+	}
+
+	assert.Nil(t, middlewareHandler(job, job.Handle)())
+	assert.Equal(t, []string{"first", "func", "second", "handle"}, job.calls)
+}
+
+func TestRateLimited(t *testing.T) {
+	CacheFacade = newTestCache(t)
+	defer func() {
+		CacheFacade = nil
+	}()
+
+	job := &TestMiddlewareJob{}
+	called := 0
+	next := func() error {
+		called++
+
+		return nil
+	}
+
+	assert.Nil(t, NewRateLimited("1", 2, time.Hour).Handle(job, nil, next))
+	assert.Nil(t, NewRateLimited("1", 2, time.Hour).Handle(job, nil, next))
+	assert.Equal(t, 2, called)
+
+	var retryLater tasks.ErrRetryTaskLater
+	assert.ErrorAs(t, NewRateLimited("1", 2, time.Hour).Handle(job, nil, next), &retryLater)
+	assert.True(t, retryLater.RetryIn() > 0 && retryLater.RetryIn() <= time.Hour)
+	assert.Nil(t, NewRateLimited("1", 2, time.Hour).DontRelease().Handle(job, nil, next))
+	assert.Equal(t, 2, called)
+
+	assert.Nil(t, NewRateLimited("2", 2, time.Hour).Handle(job, nil, next))
+	assert.Equal(t, 3, called)
+}
+
+func TestThrottlesExceptions(t *testing.T) {
+	CacheFacade = newTestCache(t)
+	defer func() {
+		CacheFacade = nil
+	}()
+
+	job := &TestMiddlewareJob{}
+	var jobErr error
+	called := 0
+	next := func() error {
+		called++
+
+		return jobErr
+	}
+	middleware := NewThrottlesExceptions(2, time.Minute)
+
+	// The failures are reset by a successful job.
+	jobErr = errors.New("failed")
+	assert.EqualError(t, middleware.Handle(job, nil, next), "failed")
+	jobErr = nil
+	assert.Nil(t, middleware.Handle(job, nil, next))
+
+	jobErr = errors.New("failed")
+	assert.EqualError(t, middleware.Handle(job, nil, next), "failed")
+	assert.EqualError(t, middleware.Handle(job, nil, next), "failed")
+	assert.Equal(t, 4, called)
+
+	var retryLater tasks.ErrRetryTaskLater
+	assert.ErrorAs(t, middleware.Handle(job, nil, next), &retryLater)
+	assert.Equal(t, time.Minute, retryLater.RetryIn())
+	assert.Equal(t, 4, called)
+
+	assert.ErrorAs(t, NewThrottlesExceptions(2, time.Minute).By("other").Backoff(time.Second).Handle(job, nil, next), &retryLater)
+	assert.Equal(t, time.Second, retryLater.RetryIn())
+	assert.Equal(t, 5, called)
+}
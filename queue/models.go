@@ -0,0 +1,202 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"gorm.io/gorm/clause"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/contracts/queue"
+	databasegorm "github.com/goravel/framework/database/gorm"
+	"github.com/goravel/framework/database/orm"
+	"github.com/goravel/framework/support/database"
+)
+
+const modelsHeader = "models"
+
+// ModelNotFoundError is returned when a model argument of a job has been deleted before the job runs, the job fails
+// without being retried.
+type ModelNotFoundError struct {
+	Model string
+	Key   any
+}
+
+func (r *ModelNotFoundError) Error() string {
+	return fmt.Sprintf("the model [%s] with the key [%v] of the job isn't found", r.Model, r.Key)
+}
+
+type serializedModel struct {
+	Model      string `json:"model"`
+	Key        any    `json:"key"`
+	Connection string `json:"connection,omitempty"`
+}
+
+// serializeModels Replace the model arguments of the signature with their types, primary keys and connections, the
+// indexes of the model arguments are recorded in the headers, so the worker can fetch the models again.
+func serializeModels(signature *tasks.Signature) error {
+	var indexes []string
+	for i, arg := range signature.Args {
+		if arg.Type != queue.ArgModel {
+			continue
+		}
+
+		model := serializedModel{Model: modelName(reflect.TypeOf(arg.Value)), Key: database.GetID(arg.Value)}
+		if model.Key == nil || reflect.ValueOf(model.Key).IsZero() {
+			return fmt.Errorf("the primary key of the model [%s] of the job is empty", model.Model)
+		}
+		if connectionModel, ok := arg.Value.(contractsorm.ConnectionModel); ok {
+			model.Connection = connectionModel.Connection()
+		}
+
+		payload, err := json.Marshal(model)
+		if err != nil {
+			return err
+		}
+
+		signature.Args[i] = tasks.Arg{Type: "string", Value: string(payload)}
+		indexes = append(indexes, strconv.Itoa(i))
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	if signature.Headers == nil {
+		signature.Headers = make(tasks.Headers)
+	}
+	signature.Headers[modelsHeader] = strings.Join(indexes, ",")
+
+	return nil
+}
+
+// modelRestorer Fetch the model arguments of a job again when it runs, the queries of the connections are shared by
+// the runs of the job.
+type modelRestorer struct {
+	config  *Config
+	models  map[string]reflect.Type
+	mu      sync.Mutex
+	queries map[string]contractsorm.Query
+}
+
+// newModelRestorer Create the restorer of the models of a job, it's nil if the job doesn't receive models.
+func newModelRestorer(config *Config, job queue.Job) *modelRestorer {
+	serializesModels, ok := job.(queue.SerializesModels)
+	if !ok {
+		return nil
+	}
+
+	models := make(map[string]reflect.Type)
+	for _, model := range serializesModels.Models() {
+		t := reflect.TypeOf(model)
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		models[modelName(t)] = t
+	}
+
+	return &modelRestorer{
+		config:  config,
+		models:  models,
+		queries: make(map[string]contractsorm.Query),
+	}
+}
+
+// Restore Replace the serialized models of the arguments with the fresh models, a ModelNotFoundError is returned if
+// a model has been deleted.
+func (r *modelRestorer) Restore(ctx context.Context, args []any) ([]any, error) {
+	if r == nil {
+		return args, nil
+	}
+	signature := tasks.SignatureFromContext(ctx)
+	if signature == nil {
+		return args, nil
+	}
+	indexes, _ := signature.Headers[modelsHeader].(string)
+	if indexes == "" {
+		return args, nil
+	}
+
+	for _, index := range strings.Split(indexes, ",") {
+		i, err := strconv.Atoi(index)
+		if err != nil || i >= len(args) {
+			return nil, errors.New("the serialized models of job are invalid")
+		}
+		payload, ok := args[i].(string)
+		if !ok {
+			return nil, errors.New("the serialized models of job are invalid")
+		}
+
+		var model serializedModel
+		decoder := json.NewDecoder(bytes.NewReader([]byte(payload)))
+		decoder.UseNumber()
+		if err := decoder.Decode(&model); err != nil {
+			return nil, err
+		}
+		if number, ok := model.Key.(json.Number); ok {
+			if key, err := number.Int64(); err == nil {
+				model.Key = key
+			} else {
+				model.Key = number.String()
+			}
+		}
+
+		t, exist := r.models[model.Model]
+		if !exist {
+			return nil, fmt.Errorf("the model [%s] isn't returned by the Models of the job", model.Model)
+		}
+		query, err := r.query(model.Connection)
+		if err != nil {
+			return nil, err
+		}
+
+		instance := reflect.New(t).Interface()
+		if err := query.FindOrFail(instance, clause.Eq{Column: clause.PrimaryColumn, Value: model.Key}); err != nil {
+			if errors.Is(err, orm.ErrRecordNotFound) {
+				return nil, &ModelNotFoundError{Model: model.Model, Key: model.Key}
+			}
+
+			return nil, err
+		}
+		args[i] = instance
+	}
+
+	return args, nil
+}
+
+func (r *modelRestorer) query(connection string) (contractsorm.Query, error) {
+	if connection == "" {
+		connection = r.config.config.GetString("database.default")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if query, exist := r.queries[connection]; exist {
+		return query, nil
+	}
+
+	query, err := databasegorm.InitializeQuery(context.Background(), r.config.config, connection)
+	if err != nil {
+		return nil, err
+	}
+	r.queries[connection] = query
+
+	return query, nil
+}
+
+// modelName Get the name of a model type including its package path, e.g. goravel/app/models.User.
+func modelName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
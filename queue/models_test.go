@@ -0,0 +1,112 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/database/gorm"
+	configmock "github.com/goravel/framework/mocks/config"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+type TestModelsUser struct {
+	ID   uint `gorm:"primaryKey"`
+	Name string
+}
+
+func (r *TestModelsUser) TableName() string {
+	return "queue_models_users"
+}
+
+type TestModelsJob struct {
+	users []*TestModelsUser
+}
+
+func (receiver *TestModelsJob) Signature() string {
+	return "test_models_job"
+}
+
+func (receiver *TestModelsJob) Handle(args ...any) error {
+	receiver.users = append(receiver.users, args[0].(*TestModelsUser))
+
+	return nil
+}
+
+func (receiver *TestModelsJob) Models() []any {
+	return []any{&TestModelsUser{}}
+}
+
+func TestSerializeModels(t *testing.T) {
+	signature := &tasks.Signature{Args: []tasks.Arg{
+		{Type: "int", Value: 1},
+		{Type: contractsqueue.ArgModel, Value: &TestModelsUser{ID: 1, Name: "goravel"}},
+	}}
+	assert.Nil(t, serializeModels(signature))
+	assert.Equal(t, tasks.Arg{Type: "int", Value: 1}, signature.Args[0])
+	assert.Equal(t, tasks.Arg{Type: "string", Value: `{"model":"github.com/goravel/framework/queue.TestModelsUser","key":1}`}, signature.Args[1])
+	assert.Equal(t, "1", signature.Headers[modelsHeader])
+
+	signature = &tasks.Signature{Args: []tasks.Arg{{Type: "int", Value: 1}}}
+	assert.Nil(t, serializeModels(signature))
+	assert.Nil(t, signature.Headers)
+
+	signature = &tasks.Signature{Args: []tasks.Arg{{Type: contractsqueue.ArgModel, Value: &TestModelsUser{}}}}
+	assert.EqualError(t, serializeModels(signature), "the primary key of the model [github.com/goravel/framework/queue.TestModelsUser] of the job is empty")
+}
+
+func TestModelRestorer(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	query, err := gorm.NewSqliteDocker(docker.Sqlite()).New()
+	assert.Nil(t, err)
+	_, err = query.Exec("DROP TABLE IF EXISTS queue_models_users")
+	assert.Nil(t, err)
+	_, err = query.Exec("CREATE TABLE queue_models_users (id integer PRIMARY KEY AUTOINCREMENT, name varchar(255))")
+	assert.Nil(t, err)
+	_, err = query.Exec("INSERT INTO queue_models_users (name) VALUES ('goravel')")
+	assert.Nil(t, err)
+
+	assert.Nil(t, newModelRestorer(nil, &TestRetryJob{}))
+
+	mockConfig := &configmock.Config{}
+	mockConfig.On("GetString", "queue.default").Return("sync")
+	mockConfig.On("GetString", "queue.connections.sync.driver").Return("sync")
+	mockConfig.On("GetString", "database.default").Return("sqlite")
+
+	job := &TestModelsJob{}
+	restorer := newModelRestorer(NewConfig(mockConfig), job)
+	restorer.queries["sqlite"] = query
+
+	run := func(user *TestModelsUser) error {
+		signature := &tasks.Signature{Name: job.Signature(), RetryCount: 3, Args: []tasks.Arg{
+			{Type: contractsqueue.ArgModel, Value: user},
+		}}
+		assert.Nil(t, serializeModels(signature))
+		task, err := tasks.NewWithSignature(retryHandler(job, handler(job.Handle, restorer)), signature)
+		assert.Nil(t, err)
+		_, err = task.Call()
+		if err != nil {
+			assert.Equal(t, 0, signature.RetryCount)
+		}
+
+		return err
+	}
+
+	// The model is fetched again, so the job receives its fresh attributes.
+	assert.Nil(t, run(&TestModelsUser{ID: 1, Name: "stale"}))
+	assert.Equal(t, []*TestModelsUser{{ID: 1, Name: "goravel"}}, job.users)
+
+	err = run(&TestModelsUser{ID: 2})
+	var modelNotFound *ModelNotFoundError
+	assert.True(t, errors.As(err, &modelNotFound))
+	assert.Equal(t, &ModelNotFoundError{Model: "github.com/goravel/framework/queue.TestModelsUser", Key: int64(2)}, modelNotFound)
+	assert.EqualError(t, err, "the model [github.com/goravel/framework/queue.TestModelsUser] with the key [2] of the job isn't found")
+	assert.Len(t, job.users, 1)
+}
@@ -0,0 +1,63 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/RichardKnop/machinery/v2/brokers/gcppubsub"
+	"github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/tasks"
+)
+
+// PubSubBroker wraps the machinery Pub/Sub broker to publish messages with an ordering key,
+// Pub/Sub then delivers the messages sharing the key in the order they were published.
+type PubSubBroker struct {
+	iface.Broker
+	client      *pubsub.Client
+	orderingKey string
+}
+
+func NewPubSubBroker(cnf *config.Config, client *pubsub.Client, projectID, subscription, orderingKey string) (*PubSubBroker, error) {
+	broker, err := gcppubsub.New(cnf, projectID, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubSubBroker{
+		Broker:      broker,
+		client:      client,
+		orderingKey: orderingKey,
+	}, nil
+}
+
+func (r *PubSubBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	if r.orderingKey == "" {
+		return r.Broker.Publish(ctx, signature)
+	}
+
+	r.AdjustRoutingKey(signature)
+
+	msg, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	topic := r.client.Topic(signature.RoutingKey)
+	topic.EnableMessageOrdering = true
+	defer topic.Stop()
+
+	result := topic.Publish(ctx, &pubsub.Message{
+		Data:        msg,
+		OrderingKey: r.orderingKey,
+	})
+	if _, err := result.Get(ctx); err != nil {
+		topic.ResumePublish(r.orderingKey)
+
+		return err
+	}
+
+	return nil
+}
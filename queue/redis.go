@@ -0,0 +1,311 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/brokers/errs"
+	"github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/common"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/redis/go-redis/v9"
+)
+
+// reserveScript Pop a job from the queue and add it to the reserved jobs in a single step, the score is
+// the time when the reservation expires.
+var reserveScript = redis.NewScript(`
+local job = redis.call('lpop', KEYS[1])
+if job then
+	redis.call('zadd', KEYS[2], ARGV[1], job)
+end
+return job
+`)
+
+// migrateScript Move the jobs of a sorted set whose score has passed back to the queue.
+var migrateScript = redis.NewScript(`
+local jobs = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[1], 'limit', 0, 100)
+if #jobs > 0 then
+	redis.call('zrem', KEYS[1], unpack(jobs))
+	redis.call('rpush', KEYS[2], unpack(jobs))
+end
+return #jobs
+`)
+
+// clearScript Delete the available, the delayed and the reserved jobs of a queue and return the number of them.
+var clearScript = redis.NewScript(`
+local count = redis.call('llen', KEYS[1]) + redis.call('zcard', KEYS[2]) + redis.call('zcard', KEYS[3])
+redis.call('del', KEYS[1], KEYS[2], KEYS[3])
+return count
+`)
+
+// RedisBroker is a machinery broker that stores the jobs in Redis with at-least-once delivery. The available
+// jobs are kept in a list, the delayed jobs in the "<queue>:delayed" sorted set and the jobs being processed in
+// the "<queue>:reserved" sorted set, a reserved job is pushed back to the queue after retryAfter, in case the
+// worker crashed while processing it. The keys of a queue are tagged with "{<queue>}" in a Redis Cluster, so
+// they are in the same slot.
+type RedisBroker struct {
+	common.Broker
+	client       redis.UniversalClient
+	cluster      bool
+	interval     time.Duration
+	retryAfter   time.Duration
+	processingWG sync.WaitGroup
+}
+
+func NewRedisBroker(cnf *config.Config, connection RedisConnection, retryAfter time.Duration) *RedisBroker {
+	var client redis.UniversalClient
+	switch {
+	case len(connection.Cluster) > 0:
+		client = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:    connection.Cluster,
+			Password: connection.Password,
+		})
+	case connection.MasterName != "":
+		client = redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       connection.MasterName,
+			SentinelAddrs:    connection.Sentinels,
+			SentinelPassword: connection.SentinelPassword,
+			Password:         connection.Password,
+			DB:               connection.Database,
+		})
+	default:
+		client = redis.NewClient(&redis.Options{
+			Addr:     connection.Addr,
+			Password: connection.Password,
+			DB:       connection.Database,
+		})
+	}
+
+	return &RedisBroker{
+		Broker:     common.NewBroker(cnf),
+		client:     client,
+		cluster:    len(connection.Cluster) > 0,
+		interval:   time.Second,
+		retryAfter: retryAfter,
+	}
+}
+
+// StartConsuming enters a loop and reserves the available jobs
+func (r *RedisBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	if concurrency < 1 {
+		concurrency = runtime.NumCPU() * 2
+	}
+
+	r.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	queues := priorityQueues(taskProcessor.CustomQueue(), r.GetConfig().DefaultQueue)
+	pool := make(chan struct{}, concurrency)
+	// finished Wake the loop up once a job finishes, so the queues are polled again when a worker is free instead of
+	// waiting for the next tick.
+	finished := make(chan struct{}, 1)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	log.INFO.Print("[*] Waiting for messages. To exit press CTRL+C")
+
+	for {
+		for _, queue := range queues {
+			if err := r.migrate(queue); err != nil {
+				log.ERROR.Print(err)
+			}
+		}
+
+		for reserved := 0; reserved < concurrency; reserved++ {
+			queue, payload, err := r.reserveFirst(queues)
+			if err != nil {
+				log.ERROR.Print(err)
+			}
+			if payload == "" {
+				break
+			}
+
+			pool <- struct{}{}
+			r.processingWG.Add(1)
+
+			go func(queue, payload string) {
+				defer func() {
+					<-pool
+					r.processingWG.Done()
+
+					select {
+					case finished <- struct{}{}:
+					default:
+					}
+				}()
+
+				r.process(queue, payload, taskProcessor)
+			}(queue, payload)
+		}
+
+		select {
+		case <-r.GetStopChan():
+			r.processingWG.Wait()
+
+			return r.GetRetry(), nil
+		case <-ticker.C:
+		case <-finished:
+		}
+	}
+}
+
+// StopConsuming quits the loop, the loop waits for the running jobs to finish before it returns.
+func (r *RedisBroker) StopConsuming() {
+	r.Broker.StopConsuming()
+}
+
+// Publish pushes a job to the queue, a job with ETA is added to the delayed jobs until the ETA.
+func (r *RedisBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	r.AdjustRoutingKey(signature)
+
+	payload, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	if signature.ETA != nil && signature.ETA.After(time.Now()) {
+		return r.client.ZAdd(ctx, delayedKey(r.key(signature.RoutingKey)), redis.Z{
+			Score:  float64(signature.ETA.UnixMilli()),
+			Member: payload,
+		}).Err()
+	}
+
+	return r.client.RPush(ctx, r.key(signature.RoutingKey), payload).Err()
+}
+
+// GetPendingTasks returns the jobs that are available and not reserved by a worker.
+func (r *RedisBroker) GetPendingTasks(queue string) ([]*tasks.Signature, error) {
+	payloads, err := r.client.LRange(context.Background(), r.key(queue), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.signatures(payloads)
+}
+
+// Size returns the number of the jobs that are available and not reserved by a worker.
+func (r *RedisBroker) Size(queue string) (int, error) {
+	size, err := r.client.LLen(context.Background(), r.key(queue)).Result()
+
+	return int(size), err
+}
+
+// GetDelayedTasks returns the jobs of the default queue that are not available yet.
+func (r *RedisBroker) GetDelayedTasks() ([]*tasks.Signature, error) {
+	payloads, err := r.client.ZRange(context.Background(), delayedKey(r.key(r.GetConfig().DefaultQueue)), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.signatures(payloads)
+}
+
+// Clear deletes all the jobs of a queue, including the delayed and the reserved jobs.
+func (r *RedisBroker) Clear(queue string) (int, error) {
+	key := r.key(queue)
+
+	return clearScript.Run(context.Background(), r.client, []string{key, delayedKey(key), reservedKey(key)}).Int()
+}
+
+// migrate Push the delayed jobs that are due and the expired reservations back to the queue.
+func (r *RedisBroker) migrate(queue string) error {
+	now := time.Now().UnixMilli()
+	queue = r.key(queue)
+	for _, key := range []string{delayedKey(queue), reservedKey(queue)} {
+		if err := migrateScript.Run(context.Background(), r.client, []string{key, queue}, now).Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RedisBroker) reserve(queue string) (string, error) {
+	expiresAt := time.Now().Add(r.retryAfter).UnixMilli()
+	key := r.key(queue)
+	payload, err := reserveScript.Run(context.Background(), r.client, []string{key, reservedKey(key)}, expiresAt).Text()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+
+	return payload, err
+}
+
+// reserveFirst Reserve a job from the first queue that has an available job, the queues are in priority order.
+func (r *RedisBroker) reserveFirst(queues []string) (string, string, error) {
+	for _, queue := range queues {
+		payload, err := r.reserve(queue)
+		if err != nil || payload != "" {
+			return queue, payload, err
+		}
+	}
+
+	return "", "", nil
+}
+
+// process Run a reserved job and remove its reservation, machinery publishes a new job if the task should be retried.
+func (r *RedisBroker) process(queue, payload string, taskProcessor iface.TaskProcessor) {
+	ctx := context.Background()
+	queue = r.key(queue)
+
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(strings.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		log.ERROR.Print(errs.NewErrCouldNotUnmarshalTaskSignature([]byte(payload), err))
+	} else if !r.IsTaskRegistered(signature.Name) {
+		// Release the job for the workers that have registered the task.
+		pipe := r.client.TxPipeline()
+		pipe.ZRem(ctx, reservedKey(queue), payload)
+		pipe.RPush(ctx, queue, payload)
+		if _, err := pipe.Exec(ctx); err != nil {
+			log.ERROR.Print(err)
+		}
+
+		return
+	} else if err := taskProcessor.Process(signature); err != nil {
+		log.ERROR.Print(err)
+	}
+
+	if err := r.client.ZRem(ctx, reservedKey(queue), payload).Err(); err != nil {
+		log.ERROR.Print(err)
+	}
+}
+
+func (r *RedisBroker) signatures(payloads []string) ([]*tasks.Signature, error) {
+	signatures := make([]*tasks.Signature, 0, len(payloads))
+	for _, payload := range payloads {
+		signature := new(tasks.Signature)
+		if err := json.Unmarshal([]byte(payload), signature); err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
+
+// key Get the key of a queue, the key is tagged with the queue name in a Redis Cluster, so the scripts and the
+// transactions touching the available, the delayed and the reserved jobs of the queue run on a single slot.
+func (r *RedisBroker) key(queue string) string {
+	if r.cluster {
+		return "{" + queue + "}"
+	}
+
+	return queue
+}
+
+func delayedKey(queue string) string {
+	return queue + ":delayed"
+}
+
+func reservedKey(queue string) string {
+	return queue + ":reserved"
+}
@@ -0,0 +1,181 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	testingdocker "github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+type RedisBrokerTestSuite struct {
+	suite.Suite
+	broker *RedisBroker
+	port   int
+}
+
+func TestRedisBrokerTestSuite(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	redisDocker := testingdocker.NewRedis()
+	assert.Nil(t, redisDocker.Build())
+
+	suite.Run(t, &RedisBrokerTestSuite{
+		port: redisDocker.Config().Port,
+	})
+
+	assert.Nil(t, redisDocker.Stop())
+}
+
+func (s *RedisBrokerTestSuite) SetupTest() {
+	s.broker = NewRedisBroker(&config.Config{DefaultQueue: "default"}, RedisConnection{Addr: fmt.Sprintf("localhost:%d", s.port)}, time.Minute)
+	s.Require().Nil(s.broker.client.FlushDB(context.Background()).Err())
+}
+
+func (s *RedisBrokerTestSuite) TestPublishAndReserve() {
+	eta := time.Now().Add(time.Hour)
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job1"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job2"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "delayed", ETA: &eta}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "other", RoutingKey: "other"}))
+
+	pending, err := s.broker.GetPendingTasks("default")
+	s.Nil(err)
+	s.Len(pending, 2)
+
+	size, err := s.broker.Size("default")
+	s.Nil(err)
+	s.Equal(2, size)
+
+	delayed, err := s.broker.GetDelayedTasks()
+	s.Nil(err)
+	s.Len(delayed, 1)
+	s.Equal("delayed", delayed[0].Name)
+
+	payload, err := s.broker.reserve("default")
+	s.Nil(err)
+	s.Contains(payload, "job1")
+
+	payload, err = s.broker.reserve("default")
+	s.Nil(err)
+	s.Contains(payload, "job2")
+
+	payload, err = s.broker.reserve("default")
+	s.Nil(err)
+	s.Empty(payload)
+
+	reserved, err := s.broker.client.ZCard(context.Background(), reservedKey("default")).Result()
+	s.Nil(err)
+	s.Equal(int64(2), reserved)
+}
+
+func (s *RedisBrokerTestSuite) TestReserveFirst() {
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "low", RoutingKey: "low"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "high", RoutingKey: "high"}))
+
+	queue, payload, err := s.broker.reserveFirst([]string{"high", "low"})
+	s.Nil(err)
+	s.Equal("high", queue)
+	s.Contains(payload, `"Name":"high"`)
+
+	queue, payload, err = s.broker.reserveFirst([]string{"high", "low"})
+	s.Nil(err)
+	s.Equal("low", queue)
+	s.Contains(payload, `"Name":"low"`)
+
+	_, payload, err = s.broker.reserveFirst([]string{"high", "low"})
+	s.Nil(err)
+	s.Empty(payload)
+}
+
+func (s *RedisBrokerTestSuite) TestClear() {
+	eta := time.Now().Add(time.Hour)
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job1"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job2"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "delayed", ETA: &eta}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "other", RoutingKey: "other"}))
+
+	payload, err := s.broker.reserve("default")
+	s.Nil(err)
+	s.Contains(payload, "job1")
+
+	count, err := s.broker.Clear("default")
+	s.Nil(err)
+	s.Equal(3, count)
+
+	exists, err := s.broker.client.Exists(context.Background(), "default", delayedKey("default"), reservedKey("default")).Result()
+	s.Nil(err)
+	s.Equal(int64(0), exists)
+
+	pending, err := s.broker.GetPendingTasks("other")
+	s.Nil(err)
+	s.Len(pending, 1)
+}
+
+func (s *RedisBrokerTestSuite) TestMigrate() {
+	eta := time.Now().Add(-time.Second)
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job1"}))
+	s.Nil(s.broker.client.ZAdd(context.Background(), delayedKey("default"), redis.Z{Score: float64(eta.UnixMilli()), Member: `{"Name":"delayed"}`}).Err())
+
+	// The reservation expires immediately, like a worker crashed while processing the job.
+	s.broker.retryAfter = -time.Second
+	payload, err := s.broker.reserve("default")
+	s.Nil(err)
+	s.Contains(payload, "job1")
+
+	s.Nil(s.broker.migrate("default"))
+
+	pending, err := s.broker.GetPendingTasks("default")
+	s.Nil(err)
+	s.Len(pending, 2)
+	s.Equal("delayed", pending[0].Name)
+	s.Equal("job1", pending[1].Name)
+}
+
+func (s *RedisBrokerTestSuite) TestProcess() {
+	s.broker.SetRegisteredTaskNames([]string{"job1"})
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job1"}))
+	s.Nil(s.broker.Publish(context.Background(), &tasks.Signature{Name: "job2"}))
+
+	processor := &testTaskProcessor{}
+	for i := 0; i < 2; i++ {
+		payload, err := s.broker.reserve("default")
+		s.Nil(err)
+		s.broker.process("default", payload, processor)
+	}
+	s.Equal([]string{"job1"}, processor.processed)
+
+	reserved, err := s.broker.client.ZCard(context.Background(), reservedKey("default")).Result()
+	s.Nil(err)
+	s.Zero(reserved)
+
+	pending, err := s.broker.GetPendingTasks("default")
+	s.Nil(err)
+	s.Len(pending, 1)
+	s.Equal("job2", pending[0].Name)
+}
+
+func TestRedisBrokerKey(t *testing.T) {
+	broker := NewRedisBroker(&config.Config{DefaultQueue: "default"}, RedisConnection{Addr: "localhost:6379"}, time.Minute)
+	assert.IsType(t, &redis.Client{}, broker.client)
+	assert.Equal(t, "goravel_queues:default", broker.key("goravel_queues:default"))
+
+	broker = NewRedisBroker(&config.Config{DefaultQueue: "default"}, RedisConnection{Cluster: []string{"10.0.0.1:6379"}}, time.Minute)
+	assert.IsType(t, &redis.ClusterClient{}, broker.client)
+	assert.Equal(t, "{goravel_queues:default}", broker.key("goravel_queues:default"))
+	assert.Equal(t, "{goravel_queues:default}:delayed", delayedKey(broker.key("goravel_queues:default")))
+
+	broker = NewRedisBroker(&config.Config{DefaultQueue: "default"}, RedisConnection{MasterName: "mymaster", Sentinels: []string{"10.0.0.1:26379"}}, time.Minute)
+	assert.IsType(t, &redis.Client{}, broker.client)
+	assert.Equal(t, "goravel_queues:default", broker.key("goravel_queues:default"))
+}
@@ -0,0 +1,155 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/queue"
+)
+
+const (
+	attemptsHeader   = "attempts"
+	retryUntilHeader = "retry_until"
+
+	// minRetryUntilDelay The delay before the retries of the jobs retried until a time without a backoff.
+	minRetryUntilDelay = time.Second
+)
+
+// setRetries Set the retries of a job on its signature, the jobs retried until a time are retried by the worker.
+func setRetries(signature *tasks.Signature, job queue.Job) {
+	if tries, ok := job.(queue.HasTries); ok && tries.Tries() > 1 {
+		signature.RetryCount = tries.Tries() - 1
+	}
+	if until, ok := job.(queue.HasRetryUntil); ok {
+		if signature.Headers == nil {
+			signature.Headers = make(tasks.Headers)
+		}
+		signature.Headers[retryUntilHeader] = until.RetryUntil().Unix()
+	}
+}
+
+// backoff Get the delay before the retry following an attempt, ok is false if the job uses the backoff of machinery.
+func backoff(job queue.Job, attempts int) (time.Duration, bool) {
+	hasBackoff, ok := job.(queue.HasBackoff)
+	if !ok {
+		return 0, false
+	}
+
+	delays := hasBackoff.Backoff()
+	if len(delays) == 0 {
+		return 0, false
+	}
+	if attempts > len(delays) {
+		return delays[len(delays)-1], true
+	}
+
+	return delays[attempts-1], true
+}
+
+// timeoutHandler Fail an attempt of a job running longer than its timeout, the context of the handle is canceled
+// when the timeout is reached. The attempt of a job receiving the context is failed once the handle returns, so the
+// job is never retried while a previous attempt is still running. The other jobs can't be stopped, their attempt is
+// failed at once to free the worker.
+func timeoutHandler(job queue.Job, handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	hasTimeout, ok := job.(queue.HasTimeout)
+	if !ok {
+		return handle
+	}
+
+	return func(ctx context.Context, args ...any) error {
+		timeout := hasTimeout.Timeout()
+		if timeout <= 0 {
+			return handle(ctx, args...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		done := make(chan error, 1)
+		go func() {
+			done <- handle(ctx, args...)
+		}()
+
+		select {
+		case err := <-done:
+			return err
+		case <-ctx.Done():
+			if receivesContext(job) {
+				<-done
+			}
+
+			return fmt.Errorf("job [%s] timed out after %s", job.Signature(), timeout)
+		}
+	}
+}
+
+// receivesContext Whether the job receives the context of its attempt, so it can stop when the context is canceled.
+func receivesContext(job queue.Job) bool {
+	switch job.(type) {
+	case queue.Checkpointable, queue.ShouldHandleWithContext:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryHandler Wrap the handle of a job to count its attempts and retry it after its backoff or until its
+// retry time, the failed job is returned as it is once it has no more retries.
+func retryHandler(job queue.Job, handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	handle = timeoutHandler(job, handle)
+
+	return func(ctx context.Context, args ...any) error {
+		err := handle(ctx, args...)
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return err
+		}
+
+		signature := tasks.SignatureFromContext(ctx)
+		if signature == nil {
+			return err
+		}
+		// The job can't run without its deleted model, so it fails without being retried.
+		var modelNotFound *ModelNotFoundError
+		if errors.As(err, &modelNotFound) {
+			signature.RetryCount = 0
+
+			return err
+		}
+		if signature.Headers == nil {
+			signature.Headers = make(tasks.Headers)
+		}
+
+		attempts := cast.ToInt(signature.Headers[attemptsHeader]) + 1
+		signature.Headers[attemptsHeader] = attempts
+		delay, hasBackoff := backoff(job, attempts)
+
+		if until := cast.ToInt64(signature.Headers[retryUntilHeader]); until > 0 {
+			if time.Now().Unix() >= until {
+				signature.RetryCount = 0
+
+				return err
+			}
+			if !hasBackoff {
+				delay = minRetryUntilDelay
+			}
+
+			return tasks.NewErrRetryTaskLater(err.Error(), delay)
+		}
+
+		if signature.RetryCount > 0 && hasBackoff {
+			signature.RetryCount--
+
+			return tasks.NewErrRetryTaskLater(err.Error(), delay)
+		}
+
+		return err
+	}
+}
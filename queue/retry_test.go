@@ -0,0 +1,158 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+type TestRetryJob struct {
+	backoff    []time.Duration
+	err        error
+	retryUntil time.Time
+	sleep      time.Duration
+	timeout    time.Duration
+	tries      int
+}
+
+func (receiver *TestRetryJob) Signature() string {
+	return "test_retry_job"
+}
+
+func (receiver *TestRetryJob) Handle(args ...any) error {
+	time.Sleep(receiver.sleep)
+
+	return receiver.err
+}
+
+func (receiver *TestRetryJob) Tries() int {
+	return receiver.tries
+}
+
+func (receiver *TestRetryJob) Backoff() []time.Duration {
+	return receiver.backoff
+}
+
+func (receiver *TestRetryJob) Timeout() time.Duration {
+	return receiver.timeout
+}
+
+func TestSetRetries(t *testing.T) {
+	signature := &tasks.Signature{}
+	setRetries(signature, &TestRetryJob{tries: 3})
+	assert.Equal(t, 2, signature.RetryCount)
+	assert.Nil(t, signature.Headers)
+
+	until := time.Now().Add(time.Hour)
+	signature = &tasks.Signature{}
+	setRetries(signature, &TestRetryUntilJob{TestRetryJob: TestRetryJob{tries: 1}, until: until})
+	assert.Equal(t, 0, signature.RetryCount)
+	assert.Equal(t, until.Unix(), signature.Headers[retryUntilHeader])
+}
+
+func TestBackoff(t *testing.T) {
+	_, ok := backoff(&TestRetryJob{}, 1)
+	assert.False(t, ok)
+
+	job := &TestRetryJob{backoff: []time.Duration{time.Second, 5 * time.Second}}
+	for attempts, expected := range map[int]time.Duration{1: time.Second, 2: 5 * time.Second, 3: 5 * time.Second} {
+		delay, ok := backoff(job, attempts)
+		assert.True(t, ok)
+		assert.Equal(t, expected, delay)
+	}
+}
+
+func TestRetryHandler(t *testing.T) {
+	job := &TestRetryJob{err: errors.New("failed"), backoff: []time.Duration{time.Second, time.Minute}}
+	handle := retryHandler(job, handler(job.Handle, nil))
+	signature := &tasks.Signature{RetryCount: 2}
+	task, err := tasks.NewWithSignature(func() {}, signature)
+	assert.Nil(t, err)
+
+	var retryLater tasks.ErrRetryTaskLater
+	assert.ErrorAs(t, handle(task.Context), &retryLater)
+	assert.Equal(t, time.Second, retryLater.RetryIn())
+	assert.ErrorAs(t, handle(task.Context), &retryLater)
+	assert.Equal(t, time.Minute, retryLater.RetryIn())
+	assert.Equal(t, 0, signature.RetryCount)
+	assert.EqualError(t, handle(task.Context), "failed")
+	assert.Equal(t, 3, signature.Headers[attemptsHeader])
+
+	// The job without a backoff is retried by machinery.
+	job.backoff = nil
+	signature = &tasks.Signature{RetryCount: 1}
+	task, err = tasks.NewWithSignature(func() {}, signature)
+	assert.Nil(t, err)
+	assert.EqualError(t, handle(task.Context), "failed")
+	assert.Equal(t, 1, signature.RetryCount)
+
+	job.err = nil
+	assert.Nil(t, handle(task.Context))
+}
+
+func TestRetryHandler_RetryUntil(t *testing.T) {
+	job := &TestRetryJob{err: errors.New("failed"), backoff: []time.Duration{time.Second}}
+	handle := retryHandler(job, handler(job.Handle, nil))
+	signature := &tasks.Signature{Headers: tasks.Headers{retryUntilHeader: float64(time.Now().Add(time.Hour).Unix())}}
+	task, err := tasks.NewWithSignature(func() {}, signature)
+	assert.Nil(t, err)
+
+	var retryLater tasks.ErrRetryTaskLater
+	assert.ErrorAs(t, handle(task.Context), &retryLater)
+	assert.Equal(t, time.Second, retryLater.RetryIn())
+
+	// The job without a backoff isn't retried at once.
+	job.backoff = nil
+	assert.ErrorAs(t, handle(task.Context), &retryLater)
+	assert.Equal(t, minRetryUntilDelay, retryLater.RetryIn())
+
+	signature.Headers[retryUntilHeader] = time.Now().Add(-time.Second).Unix()
+	signature.RetryCount = 3
+	assert.EqualError(t, handle(task.Context), "failed")
+	assert.Equal(t, 0, signature.RetryCount)
+}
+
+func TestRetryHandler_Timeout(t *testing.T) {
+	job := &TestRetryContextJob{TestRetryJob: TestRetryJob{timeout: 10 * time.Millisecond}}
+	handle := retryHandler(job, contextHandler(job, job, nil))
+
+	// The timed out attempt fails once the handle returns, so a retry doesn't overlap it.
+	assert.EqualError(t, handle(context.Background()), "job [test_retry_job] timed out after 10ms")
+	assert.True(t, job.returned.Load())
+
+	// The job without the context can't be stopped, so its attempt fails without waiting for it.
+	hung := &TestRetryJob{timeout: 10 * time.Millisecond, sleep: time.Second}
+	start := time.Now()
+	assert.EqualError(t, retryHandler(hung, handler(hung.Handle, nil))(context.Background()), "job [test_retry_job] timed out after 10ms")
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	fast := &TestRetryJob{timeout: time.Second}
+	assert.Nil(t, retryHandler(fast, handler(fast.Handle, nil))(context.Background()))
+}
+
+type TestRetryContextJob struct {
+	TestRetryJob
+	returned atomic.Bool
+}
+
+func (receiver *TestRetryContextJob) HandleWithContext(ctx context.Context, args ...any) error {
+	<-ctx.Done()
+	time.Sleep(10 * time.Millisecond)
+	receiver.returned.Store(true)
+
+	return ctx.Err()
+}
+
+type TestRetryUntilJob struct {
+	TestRetryJob
+	until time.Time
+}
+
+func (receiver *TestRetryUntilJob) RetryUntil() time.Time {
+	return receiver.until
+}
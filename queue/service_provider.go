@@ -1,9 +1,17 @@
 package queue
 
 import (
+	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/console"
+	contractscrypt "github.com/goravel/framework/contracts/crypt"
+	contractsevent "github.com/goravel/framework/contracts/event"
+	contractsfeature "github.com/goravel/framework/contracts/feature"
 	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/crypt"
+	frameworkevent "github.com/goravel/framework/event"
+	"github.com/goravel/framework/feature"
 	queueConsole "github.com/goravel/framework/queue/console"
+	"github.com/goravel/framework/support/calendar"
 )
 
 const Binding = "goravel.queue"
@@ -11,6 +19,11 @@ const Binding = "goravel.queue"
 type ServiceProvider struct {
 }
 
+var CacheFacade cache.Cache
+var CalendarFacade *calendar.Repository
+var CryptFacade contractscrypt.Crypt
+var FeatureFacade contractsfeature.Feature
+
 func (receiver *ServiceProvider) Register(app foundation.Application) {
 	app.Singleton(Binding, func(app foundation.Application) (any, error) {
 		return NewApplication(app.MakeConfig(), app.MakeLog()), nil
@@ -18,11 +31,54 @@ func (receiver *ServiceProvider) Register(app foundation.Application) {
 }
 
 func (receiver *ServiceProvider) Boot(app foundation.Application) {
+	CacheFacade = app.MakeCache()
+	// The calendars are shared, so the exclusions loaded from their tables are cached between the dispatches.
+	CalendarFacade = calendar.NewRepository(app.MakeConfig(), app.MakeOrm)
+	// The encrypter is optional, it's only required by the connections encrypting the job payloads.
+	if instance, err := app.Make(crypt.Binding); err == nil {
+		CryptFacade, _ = instance.(contractscrypt.Crypt)
+	}
+	// The jobs gated by a feature are skipped while the feature is inactive or the feature service isn't registered.
+	if instance, err := app.Make(feature.Binding); err == nil {
+		FeatureFacade, _ = instance.(contractsfeature.Feature)
+	}
+
+	// The queue dispatches the job lifecycle events, e.g. JobQueued and JobProcessed, and publishes the events to
+	// the Kafka connections.
+	events := func() contractsevent.Instance {
+		instance, err := app.Make(frameworkevent.Binding)
+		if err != nil {
+			return nil
+		}
+		events, _ := instance.(contractsevent.Instance)
+
+		return events
+	}
+	if queueApp, ok := app.MakeQueue().(*Application); ok {
+		queueApp.SetEvents(events)
+	}
+	if eventApp, ok := events().(*frameworkevent.Application); ok {
+		eventApp.SetPublisher(NewKafkaProducer(app.MakeConfig()))
+	}
+
 	receiver.registerCommands(app)
 }
 
 func (receiver *ServiceProvider) registerCommands(app foundation.Application) {
+	config := NewConfig(app.MakeConfig())
+	jobsConnection, jobsTable, _, _, _, _, _ := config.Database(config.DefaultConnection())
+	batchesConnection, batchesTable := config.Batching()
+
 	app.MakeArtisan().Register([]console.Command{
 		&queueConsole.JobMakeCommand{},
+		queueConsole.NewFailedCommand(app.MakeQueue()),
+		queueConsole.NewRetryCommand(app.MakeQueue()),
+		queueConsole.NewForgetCommand(app.MakeQueue()),
+		queueConsole.NewFlushCommand(app.MakeQueue()),
+		queueConsole.NewPruneFailedCommand(app.MakeQueue()),
+		queueConsole.NewClearCommand(app.MakeConfig(), app.MakeQueue()),
+		queueConsole.NewMonitorCommand(app.MakeQueue(), app.MakeLog()),
+		queueConsole.NewJobsTableCommand(app.MakeConfig(), jobsConnection, jobsTable),
+		queueConsole.NewBatchesTableCommand(app.MakeConfig(), batchesConnection, batchesTable),
 	})
 }
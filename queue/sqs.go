@@ -0,0 +1,90 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/brokers/sqs"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/spf13/cast"
+)
+
+const (
+	// sqsMaxDelay is the max delay of an SQS message, the jobs delayed longer are delayed again when received.
+	sqsMaxDelay        = 15 * time.Minute
+	delayedUntilHeader = "delayed_until"
+)
+
+// SqsBroker wraps the machinery SQS broker, the failed jobs are retried by machinery and deleted once they have
+// no more retries, so a failed job is recorded once. The jobs published to a FIFO queue (the name ends with
+// ".fifo") are grouped by the message group of the connection unless the signature has one. The delayed jobs are
+// published with DelaySeconds, which is limited to 15 minutes, so a job delayed longer is published again when
+// it's received before its ETA.
+type SqsBroker struct {
+	iface.Broker
+	defaultQueue string
+	messageGroup string
+}
+
+func NewSqsBroker(cnf *config.Config, messageGroup string) *SqsBroker {
+	return &SqsBroker{
+		Broker:       sqs.New(cnf),
+		defaultQueue: cnf.DefaultQueue,
+		messageGroup: messageGroup,
+	}
+}
+
+func (r *SqsBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	return r.Broker.StartConsuming(consumerTag, concurrency, &delayedProcessor{TaskProcessor: taskProcessor, broker: r})
+}
+
+func (r *SqsBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	if signature.BrokerMessageGroupId == "" {
+		signature.BrokerMessageGroupId = r.messageGroup
+	}
+
+	if signature.ETA != nil && time.Until(*signature.ETA) > 0 {
+		queue := signature.RoutingKey
+		if queue == "" {
+			queue = r.defaultQueue
+		}
+		if strings.HasSuffix(queue, ".fifo") {
+			return fmt.Errorf("the jobs of the FIFO queue [%s] can't be delayed, set the delivery delay of the queue instead", queue)
+		}
+
+		if time.Until(*signature.ETA) > sqsMaxDelay {
+			if signature.Headers == nil {
+				signature.Headers = make(tasks.Headers)
+			}
+			signature.Headers[delayedUntilHeader] = signature.ETA.UnixMilli()
+			eta := time.Now().Add(sqsMaxDelay)
+			signature.ETA = &eta
+		}
+	}
+
+	return r.Broker.Publish(ctx, signature)
+}
+
+// delayedProcessor Publish the jobs received before their ETA again, the jobs are deleted once published.
+type delayedProcessor struct {
+	iface.TaskProcessor
+	broker *SqsBroker
+}
+
+func (r *delayedProcessor) Process(signature *tasks.Signature) error {
+	if until := cast.ToInt64(signature.Headers[delayedUntilHeader]); until > 0 {
+		delete(signature.Headers, delayedUntilHeader)
+
+		if eta := time.UnixMilli(until); time.Until(eta) > 0 {
+			signature.ETA = &eta
+
+			return r.broker.Publish(context.Background(), signature)
+		}
+	}
+
+	return r.TaskProcessor.Process(signature)
+}
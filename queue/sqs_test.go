@@ -0,0 +1,81 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/brokers/iface"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBroker struct {
+	iface.Broker
+	published []*tasks.Signature
+}
+
+func (r *testBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	r.published = append(r.published, signature)
+
+	return nil
+}
+
+func TestSqsBrokerPublish(t *testing.T) {
+	broker := &testBroker{}
+	sqsBroker := &SqsBroker{Broker: broker, messageGroup: "default"}
+
+	assert.Nil(t, sqsBroker.Publish(context.Background(), &tasks.Signature{Name: "job1"}))
+	assert.Nil(t, sqsBroker.Publish(context.Background(), &tasks.Signature{Name: "job2", BrokerMessageGroupId: "orders"}))
+
+	assert.Len(t, broker.published, 2)
+	assert.False(t, broker.published[0].StopTaskDeletionOnError)
+	assert.Equal(t, "default", broker.published[0].BrokerMessageGroupId)
+	assert.False(t, broker.published[1].StopTaskDeletionOnError)
+	assert.Equal(t, "orders", broker.published[1].BrokerMessageGroupId)
+}
+
+func TestSqsBrokerPublishDelayed(t *testing.T) {
+	broker := &testBroker{}
+	sqsBroker := &SqsBroker{Broker: broker, defaultQueue: "default", messageGroup: "default"}
+
+	soon := time.Now().Add(time.Minute)
+	assert.Nil(t, sqsBroker.Publish(context.Background(), &tasks.Signature{Name: "job1", ETA: &soon}))
+	assert.Equal(t, &soon, broker.published[0].ETA)
+	assert.Nil(t, broker.published[0].Headers)
+
+	// The jobs delayed longer than SQS supports are delayed by the max delay, and delayed again when received.
+	later := time.Now().Add(time.Hour)
+	assert.Nil(t, sqsBroker.Publish(context.Background(), &tasks.Signature{Name: "job2", ETA: &later}))
+	assert.WithinDuration(t, time.Now().Add(sqsMaxDelay), *broker.published[1].ETA, time.Second)
+	assert.Equal(t, later.UnixMilli(), broker.published[1].Headers[delayedUntilHeader])
+
+	assert.EqualError(t, sqsBroker.Publish(context.Background(), &tasks.Signature{Name: "job3", RoutingKey: "orders.fifo", ETA: &soon}),
+		"the jobs of the FIFO queue [orders.fifo] can't be delayed, set the delivery delay of the queue instead")
+	assert.Len(t, broker.published, 2)
+}
+
+func TestDelayedProcessor(t *testing.T) {
+	broker := &testBroker{}
+	processor := &delayedProcessor{
+		TaskProcessor: &testTaskProcessorWithError{err: assert.AnError},
+		broker:        &SqsBroker{Broker: broker, defaultQueue: "default"},
+	}
+
+	// The header is decoded as a number from the message.
+	later := time.Now().Add(time.Hour)
+	assert.Nil(t, processor.Process(&tasks.Signature{Headers: tasks.Headers{
+		delayedUntilHeader: json.Number(strconv.FormatInt(later.UnixMilli(), 10)),
+	}}))
+	assert.Len(t, broker.published, 1)
+	assert.WithinDuration(t, time.Now().Add(sqsMaxDelay), *broker.published[0].ETA, time.Second)
+	assert.Equal(t, later.UnixMilli(), broker.published[0].Headers[delayedUntilHeader])
+
+	past := time.Now().Add(-time.Second)
+	signature := &tasks.Signature{Headers: tasks.Headers{delayedUntilHeader: past.UnixMilli()}}
+	assert.Equal(t, assert.AnError, processor.Process(signature))
+	assert.Empty(t, signature.Headers)
+	assert.Len(t, broker.published, 1)
+}
@@ -1,25 +1,48 @@
 package queue
 
 import (
+	"context"
 	"errors"
 	"time"
 
 	"github.com/RichardKnop/machinery/v2"
 	"github.com/RichardKnop/machinery/v2/tasks"
 
+	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/log"
 	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/database/orm"
+	"github.com/goravel/framework/support/calendar"
+	"github.com/goravel/framework/support/retry"
 )
 
+// dispatchPolicy Retry publishing a job when the broker is temporarily unreachable.
+var dispatchPolicy = retry.Policy{
+	Attempts:  3,
+	Delay:     100 * time.Millisecond,
+	Jitter:    retry.JitterFull,
+	Retryable: retry.IsTransient,
+}
+
 type Task struct {
-	config     *Config
-	connection string
-	chain      bool
-	delay      *time.Time
-	machinery  *Machinery
-	jobs       []queue.Jobs
-	queue      string
-	server     *machinery.Server
+	afterCommit *bool
+	batch       string
+	calendar    string
+	catch       *queue.Jobs
+	config      *Config
+	connection  string
+	chain       bool
+	ctx         context.Context
+	delay       *time.Time
+	events      func() event.Instance
+	fallenBack  bool
+	machinery   *Machinery
+	outages     *outages
+	jobs        []queue.Jobs
+	queue       string
+	server      *machinery.Server
+	unique      string
 }
 
 func NewTask(config *Config, log log.Log, job queue.Job, args []queue.Arg) *Task {
@@ -46,21 +69,141 @@ func NewChainTask(config *Config, log log.Log, jobs []queue.Jobs) *Task {
 	}
 }
 
+func (receiver *Task) AfterCommit() queue.Task {
+	afterCommit := true
+	receiver.afterCommit = &afterCommit
+
+	return receiver
+}
+
+func (receiver *Task) BeforeCommit() queue.Task {
+	afterCommit := false
+	receiver.afterCommit = &afterCommit
+
+	return receiver
+}
+
+func (receiver *Task) CatchChain(job queue.Job, args []queue.Arg) queue.Task {
+	receiver.catch = &queue.Jobs{
+		Job:  job,
+		Args: args,
+	}
+
+	return receiver
+}
+
+// WithContext Set the context of the task, the task is dispatched after the transaction the context comes from
+// commits if it should be, e.g. the context of orm.Transaction.
+func (receiver *Task) WithContext(ctx context.Context) queue.Task {
+	receiver.ctx = ctx
+
+	return receiver
+}
+
 func (receiver *Task) Delay(delay time.Time) queue.Task {
 	receiver.delay = &delay
 
 	return receiver
 }
 
+func (receiver *Task) DelayFor(duration time.Duration) queue.Task {
+	return receiver.Delay(time.Now().Add(duration))
+}
+
+func (receiver *Task) DeferOn(calendar string) queue.Task {
+	receiver.calendar = calendar
+
+	return receiver
+}
+
 func (receiver *Task) Dispatch() error {
+	if receiver.shouldDispatchAfterCommit() {
+		return orm.AfterCommit(receiver.ctx, receiver.dispatch)
+	}
+
+	return receiver.dispatch()
+}
+
+func (receiver *Task) dispatch() error {
+	if !receiver.fallenBack && receiver.outages.Degraded(receiver.connection) {
+		if fallback, _ := receiver.config.Fallback(receiver.connection); fallback != "" {
+			receiver.fallBack(fallback)
+		}
+	}
+
 	driver := receiver.config.Driver(receiver.connection)
 	if driver == "" {
 		return errors.New("unknown queue driver")
 	}
+
+	// A unique job is dropped while a job with the same unique ID is pending or running.
+	unique, locked, err := receiver.lockUnique()
+	if err != nil || !locked {
+		return err
+	}
+
 	if driver == DriverSync {
+		if unique != nil {
+			defer unique.ForceRelease()
+		}
+
 		return receiver.DispatchSync()
 	}
 
+	if err := receiver.dispatchAsync(); err != nil {
+		if unique != nil {
+			unique.ForceRelease()
+		}
+		if receiver.degrade(err) {
+			return receiver.dispatch()
+		}
+
+		return err
+	}
+
+	if !receiver.fallenBack && receiver.outages.Recover(receiver.connection) {
+		dispatchJobEvent(receiver.events, &ConnectionRecovered{}, "", []event.Arg{{Type: "string", Value: receiver.connection}})
+	}
+
+	return nil
+}
+
+// degrade Switch the task to the fallback connection if the job can't be published to its connection, the task only
+// falls back once, so the fallback connection doesn't fall back again.
+func (receiver *Task) degrade(err error) bool {
+	var publishErr *publishError
+	if receiver.fallenBack || !errors.As(err, &publishErr) {
+		return false
+	}
+
+	fallback, probe := receiver.config.Fallback(receiver.connection)
+	if fallback == "" {
+		return false
+	}
+
+	if receiver.outages.Fail(receiver.connection, probe) {
+		dispatchJobEvent(receiver.events, &ConnectionDegraded{}, "", []event.Arg{
+			{Type: "string", Value: receiver.connection},
+			{Type: "string", Value: fallback},
+			{Type: "string", Value: err.Error()},
+		})
+	}
+	receiver.fallBack(fallback)
+
+	return true
+}
+
+func (receiver *Task) fallBack(fallback string) {
+	receiver.connection = fallback
+	receiver.fallenBack = true
+	receiver.server = nil
+}
+
+func (receiver *Task) dispatchAsync() error {
+	if err := receiver.deferOnCalendar(); err != nil {
+		return err
+	}
+
 	server, err := receiver.machinery.Server(receiver.connection, receiver.queue)
 	if err != nil {
 		return err
@@ -77,10 +220,49 @@ func (receiver *Task) Dispatch() error {
 	}
 }
 
+// deferOnCalendar Delay the task to the end of the exclusion if it is due during an exclusion of its calendar.
+func (receiver *Task) deferOnCalendar() error {
+	if receiver.calendar == "" {
+		return nil
+	}
+
+	calendars := CalendarFacade
+	if calendars == nil {
+		calendars = calendar.NewRepository(receiver.config.config, nil)
+	}
+
+	instance, err := calendars.Get(receiver.calendar)
+	if err != nil {
+		return err
+	}
+
+	due := time.Now()
+	if receiver.delay != nil {
+		due = *receiver.delay
+	}
+
+	next, err := instance.Next(due)
+	if err != nil {
+		return err
+	}
+	if next.After(due) {
+		receiver.delay = &next
+	}
+
+	return nil
+}
+
 func (receiver *Task) DispatchSync() error {
 	if receiver.chain {
 		for _, job := range receiver.jobs {
 			if err := receiver.handleSync(job.Job, job.Args); err != nil {
+				if receiver.catch != nil {
+					args := append([]queue.Arg{{Type: "string", Value: err.Error()}}, receiver.catch.Args...)
+					if catchErr := receiver.handleSync(receiver.catch.Job, args); catchErr != nil {
+						return errors.Join(err, catchErr)
+					}
+				}
+
 				return err
 			}
 		}
@@ -105,7 +287,50 @@ func (receiver *Task) OnQueue(queue string) queue.Task {
 	return receiver
 }
 
+// shouldDispatchAfterCommit Determine whether any job of the task should be dispatched after the open transaction
+// commits, AfterCommit and BeforeCommit override the jobs.
+func (receiver *Task) shouldDispatchAfterCommit() bool {
+	if receiver.afterCommit != nil {
+		return *receiver.afterCommit
+	}
+
+	for _, job := range receiver.jobs {
+		if afterCommit, ok := job.Job.(queue.ShouldDispatchAfterCommit); ok && afterCommit.DispatchAfterCommit() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (receiver *Task) handleChain(jobs []queue.Jobs) error {
+	compression, threshold := receiver.config.Compression(receiver.connection)
+	encrypted := receiver.config.Encrypt(receiver.connection)
+
+	// The catch job isn't compressed, the worker prepends the error message to its arguments.
+	var catch []*tasks.Signature
+	if receiver.catch != nil {
+		var realArgs []tasks.Arg
+		for _, arg := range receiver.catch.Args {
+			realArgs = append(realArgs, tasks.Arg{
+				Type:  arg.Type,
+				Value: arg.Value,
+			})
+		}
+
+		signature := &tasks.Signature{
+			UUID: newID(),
+			Name: receiver.catch.Job.Signature(),
+			Args: realArgs,
+		}
+		if encrypted {
+			if err := encryptCatch(signature, CryptFacade); err != nil {
+				return err
+			}
+		}
+		catch = append(catch, signature)
+	}
+
 	var signatures []*tasks.Signature
 	for _, job := range jobs {
 		var realArgs []tasks.Arg
@@ -116,11 +341,30 @@ func (receiver *Task) handleChain(jobs []queue.Jobs) error {
 			})
 		}
 
-		signatures = append(signatures, &tasks.Signature{
-			Name: job.Job.Signature(),
-			Args: realArgs,
-			ETA:  receiver.delay,
-		})
+		signature := &tasks.Signature{
+			UUID:    newID(),
+			Name:    job.Job.Signature(),
+			Args:    realArgs,
+			ETA:     receiver.delay,
+			OnError: catch,
+		}
+		if receiver.config.Metrics() != "" {
+			signature.Headers = tasks.Headers{queuedHeader: time.Now().UnixMilli()}
+		}
+		setRetries(signature, job.Job)
+		if err := serializeModels(signature); err != nil {
+			return err
+		}
+		if err := compress(signature, compression, threshold); err != nil {
+			return err
+		}
+		if encrypted {
+			if err := encrypt(signature, CryptFacade); err != nil {
+				return err
+			}
+		}
+
+		signatures = append(signatures, signature)
 	}
 
 	chain, err := tasks.NewChain(signatures...)
@@ -128,9 +372,19 @@ func (receiver *Task) handleChain(jobs []queue.Jobs) error {
 		return err
 	}
 
-	_, err = receiver.server.SendChain(chain)
+	if err := retry.Do(context.Background(), dispatchPolicy, func() error {
+		_, err := receiver.server.SendChain(chain)
+
+		return err
+	}); err != nil {
+		return &publishError{err}
+	}
+
+	for _, signature := range signatures {
+		receiver.dispatchQueued(signature)
+	}
 
-	return err
+	return nil
 }
 
 func (receiver *Task) handleAsync(job queue.Job, args []queue.Arg) error {
@@ -142,23 +396,111 @@ func (receiver *Task) handleAsync(job queue.Job, args []queue.Arg) error {
 		})
 	}
 
-	_, err := receiver.server.SendTask(&tasks.Signature{
+	signature := &tasks.Signature{
+		UUID: newID(),
 		Name: job.Signature(),
 		Args: realArgs,
 		ETA:  receiver.delay,
-	})
-	if err != nil {
+	}
+	metrics := receiver.config.Metrics() != ""
+	if receiver.batch != "" || receiver.unique != "" || metrics {
+		signature.Headers = make(tasks.Headers)
+	}
+	if receiver.batch != "" {
+		signature.Headers[batchHeader] = receiver.batch
+	}
+	if receiver.unique != "" {
+		signature.Headers[uniqueHeader] = receiver.unique
+	}
+	if metrics {
+		signature.Headers[queuedHeader] = time.Now().UnixMilli()
+	}
+	setRetries(signature, job)
+	if err := serializeModels(signature); err != nil {
+		return err
+	}
+	compression, threshold := receiver.config.Compression(receiver.connection)
+	if err := compress(signature, compression, threshold); err != nil {
+		return err
+	}
+	if receiver.config.Encrypt(receiver.connection) {
+		if err := encrypt(signature, CryptFacade); err != nil {
+			return err
+		}
+	}
+
+	if err := retry.Do(context.Background(), dispatchPolicy, func() error {
+		_, err := receiver.server.SendTask(signature)
+
 		return err
+	}); err != nil {
+		return &publishError{err}
 	}
 
+	receiver.dispatchQueued(signature)
+
 	return nil
 }
 
+// dispatchQueued Dispatch the JobQueued event of a job pushed onto the queue.
+func (receiver *Task) dispatchQueued(signature *tasks.Signature) {
+	dispatchJobEvent(receiver.events, &JobQueued{}, signature.Name,
+		jobEventArgs(receiver.connection, receiver.config.Queue(receiver.connection, receiver.queue), signature.Name, signature.UUID))
+}
+
 func (receiver *Task) handleSync(job queue.Job, args []queue.Arg) error {
+	if featureInactive(context.Background(), job) {
+		return nil
+	}
+
 	var realArgs []any
 	for _, arg := range args {
 		realArgs = append(realArgs, arg.Value)
 	}
 
-	return job.Handle(realArgs...)
+	handle := middlewareHandler(job, job.Handle)
+	if receiver.events == nil {
+		return handle(realArgs...)
+	}
+
+	// The job runs once, so it's processed or failed, it's never retried.
+	eventArgs := jobEventArgs(receiver.connection, receiver.config.Queue(receiver.connection, receiver.queue), job.Signature(), newID())
+	dispatchJobEvent(receiver.events, &JobProcessing{}, job.Signature(), eventArgs)
+
+	if err := handle(realArgs...); err != nil {
+		dispatchJobEvent(receiver.events, &JobFailed{}, job.Signature(), append(eventArgs, event.Arg{Type: "string", Value: err.Error()}))
+
+		return err
+	}
+
+	dispatchJobEvent(receiver.events, &JobProcessed{}, job.Signature(), eventArgs)
+
+	return nil
+}
+
+// lockUnique Lock a unique job, locked is false if a job with the same unique ID is pending or running. The
+// lock is nil if the job isn't unique, the chains aren't unique.
+func (receiver *Task) lockUnique() (instance cache.Lock, locked bool, err error) {
+	if receiver.chain {
+		return nil, true, nil
+	}
+
+	job := receiver.jobs[0]
+	unique, ok := job.Job.(queue.ShouldBeUnique)
+	if !ok {
+		return nil, true, nil
+	}
+
+	key := uniqueKey(job.Job, unique, job.Args)
+	instance, err = lock(key, unique.UniqueFor())
+	if err != nil {
+		return nil, false, err
+	}
+	if !instance.Get() {
+		return nil, false, nil
+	}
+
+	receiver.unique = key
+
+	return instance, true, nil
 }
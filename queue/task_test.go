@@ -1,11 +1,16 @@
 package queue
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/database/orm"
+	configmock "github.com/goravel/framework/mocks/config"
 	"github.com/goravel/framework/support/file"
 	testingfile "github.com/goravel/framework/testing/file"
 )
@@ -41,3 +46,101 @@ func TestDispatchSync(t *testing.T) {
 	assert.True(t, testingfile.GetLineNum("test.txt") == 1)
 	assert.Nil(t, file.Remove("test.txt"))
 }
+
+func TestDispatchSync_CatchChain(t *testing.T) {
+	first := &TestBatchJob{signature: "first", err: errors.New("failed")}
+	second := &TestBatchJob{signature: "second"}
+	catch := &TestBatchJob{signature: "catch"}
+	task := &Task{
+		chain: true,
+		jobs: []queue.Jobs{
+			{Job: first, Args: []queue.Arg{{Type: "int", Value: 1}}},
+			{Job: second},
+		},
+	}
+
+	err := task.CatchChain(catch, []queue.Arg{{Type: "string", Value: "import"}}).DispatchSync()
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, [][]any{{1}}, first.calls)
+	assert.Empty(t, second.calls)
+	assert.Equal(t, [][]any{{"failed", "import"}}, catch.calls)
+}
+
+func TestTask_DeferOnCalendar(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().Get("calendars.billing").Return(map[string]any{})
+	mockConfig.EXPECT().GetString("app.timezone", "UTC").Return("UTC")
+	mockConfig.EXPECT().GetString("calendars.billing.timezone", "UTC").Return("UTC")
+	mockConfig.EXPECT().Get("calendars.billing.dates").Return([]string{"2030-12-25"})
+	mockConfig.EXPECT().Get("calendars.billing.windows").Return(nil)
+	mockConfig.EXPECT().Get("calendars.billing.cron").Return(nil)
+	mockConfig.EXPECT().GetString("calendars.billing.table").Return("")
+
+	// A job due during an exclusion is delayed to the end of the exclusion.
+	delay := time.Date(2030, 12, 25, 10, 0, 0, 0, time.UTC)
+	task := &Task{config: NewConfig(mockConfig)}
+	task.Delay(delay).DeferOn("billing")
+	assert.Nil(t, task.deferOnCalendar())
+	assert.Equal(t, time.Date(2030, 12, 26, 0, 0, 0, 0, time.UTC), *task.delay)
+
+	delay = time.Date(2030, 12, 24, 10, 0, 0, 0, time.UTC)
+	task.Delay(delay)
+	assert.Nil(t, task.deferOnCalendar())
+	assert.Equal(t, delay, *task.delay)
+
+	// A job due now isn't delayed outside the exclusions.
+	task = &Task{config: NewConfig(mockConfig)}
+	task.DeferOn("billing")
+	assert.Nil(t, task.deferOnCalendar())
+	assert.Nil(t, task.delay)
+}
+
+type TestAfterCommitJob struct {
+	TestBatchJob
+}
+
+func (receiver *TestAfterCommitJob) DispatchAfterCommit() bool {
+	return true
+}
+
+func TestTask_AfterCommit(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("queue.connections.sync.driver").Return(DriverSync)
+	newTask := func(job queue.Job) *Task {
+		return &Task{
+			config:     NewConfig(mockConfig),
+			connection: "sync",
+			jobs:       []queue.Jobs{{Job: job}},
+		}
+	}
+
+	// Dropped when the transaction rolls back.
+	job := &TestBatchJob{signature: "after_commit"}
+	transaction, ctx := orm.BeginTransaction(context.Background())
+	assert.Nil(t, newTask(job).AfterCommit().WithContext(ctx).Dispatch())
+	orm.RollbackTransaction(transaction)
+	assert.Empty(t, job.calls)
+
+	// Released when the transaction commits.
+	transaction, ctx = orm.BeginTransaction(context.Background())
+	assert.Nil(t, newTask(job).AfterCommit().WithContext(ctx).Dispatch())
+	assert.Empty(t, job.calls)
+	assert.Nil(t, orm.CommitTransaction(transaction))
+	assert.Len(t, job.calls, 1)
+
+	// BeforeCommit overrides the job.
+	afterCommitJob := &TestAfterCommitJob{TestBatchJob{signature: "before_commit"}}
+	transaction, ctx = orm.BeginTransaction(context.Background())
+	assert.Nil(t, newTask(afterCommitJob).BeforeCommit().WithContext(ctx).Dispatch())
+	assert.Len(t, afterCommitJob.calls, 1)
+	assert.Nil(t, newTask(afterCommitJob).WithContext(ctx).Dispatch())
+	assert.Len(t, afterCommitJob.calls, 1)
+	orm.RollbackTransaction(transaction)
+	assert.Len(t, afterCommitJob.calls, 1)
+}
+
+func TestDelayFor(t *testing.T) {
+	task := &Task{}
+	task.DelayFor(time.Hour)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *task.delay, time.Second)
+}
@@ -0,0 +1,118 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+
+	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/queue"
+)
+
+const uniqueHeader = "unique_key"
+
+// uniqueKey Get the cache key locking a unique job.
+func uniqueKey(job queue.Job, unique queue.ShouldBeUnique, args []queue.Arg) string {
+	var values []any
+	for _, arg := range args {
+		values = append(values, arg.Value)
+	}
+
+	return fmt.Sprintf("queue:unique:%s:%s", job.Signature(), unique.UniqueID(values...))
+}
+
+func lock(key string, expire time.Duration) (cache.Lock, error) {
+	if CacheFacade == nil {
+		return nil, errors.New("cache support is required")
+	}
+	if expire > 0 {
+		return CacheFacade.Lock(key, expire), nil
+	}
+
+	return CacheFacade.Lock(key), nil
+}
+
+// uniqueHandler Wrap the handle of a job to release the lock of a unique job once the job finishes, the lock is
+// kept while the job is released back to the queue or retried by machinery.
+func uniqueHandler(handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		err := handle(ctx, args...)
+
+		signature := tasks.SignatureFromContext(ctx)
+		if signature == nil {
+			return err
+		}
+		key, _ := signature.Headers[uniqueHeader].(string)
+		if key == "" {
+			return err
+		}
+
+		var retryLater tasks.ErrRetryTaskLater
+		if errors.As(err, &retryLater) || err != nil && signature.RetryCount > 0 {
+			return err
+		}
+
+		// The lock is acquired by the dispatcher, so it's released regardless of its owner.
+		if instance, lockErr := lock(key, 0); lockErr == nil {
+			instance.ForceRelease()
+		}
+
+		return err
+	}
+}
+
+// WithoutOverlapping is a job middleware preventing the jobs with the same key from running at the same time,
+// the overlapping job is released back to the queue by default.
+type WithoutOverlapping struct {
+	key          string
+	dontRelease  bool
+	expireAfter  time.Duration
+	releaseAfter time.Duration
+}
+
+func NewWithoutOverlapping(key string) *WithoutOverlapping {
+	return &WithoutOverlapping{
+		key: key,
+	}
+}
+
+// ReleaseAfter Set the delay of the overlapping job released back to the queue.
+func (r *WithoutOverlapping) ReleaseAfter(delay time.Duration) *WithoutOverlapping {
+	r.releaseAfter = delay
+
+	return r
+}
+
+// DontRelease Drop the overlapping job instead of releasing it back to the queue.
+func (r *WithoutOverlapping) DontRelease() *WithoutOverlapping {
+	r.dontRelease = true
+
+	return r
+}
+
+// ExpireAfter Set the duration after which the lock expires, in case the worker running the job crashes.
+func (r *WithoutOverlapping) ExpireAfter(expire time.Duration) *WithoutOverlapping {
+	r.expireAfter = expire
+
+	return r
+}
+
+func (r *WithoutOverlapping) Handle(job queue.Job, args []any, next func() error) error {
+	instance, err := lock(fmt.Sprintf("queue:overlap:%s:%s", job.Signature(), r.key), r.expireAfter)
+	if err != nil {
+		return err
+	}
+	if !instance.Get() {
+		if r.dontRelease {
+			return nil
+		}
+
+		return tasks.NewErrRetryTaskLater("the job is overlapping", r.releaseAfter)
+	}
+	defer instance.Release()
+
+	return next()
+}
@@ -0,0 +1,142 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/cache"
+	contractscache "github.com/goravel/framework/contracts/cache"
+	contractsqueue "github.com/goravel/framework/contracts/queue"
+	configmock "github.com/goravel/framework/mocks/config"
+)
+
+type testCache struct {
+	*cache.Memory
+}
+
+func (r *testCache) Store(name string) contractscache.Driver {
+	return r
+}
+
+func (r *testCache) Flexible(key string, fresh, stale time.Duration, callback func() (any, error)) (any, error) {
+	return cache.Flexible(r, key, fresh, stale, callback)
+}
+
+func newTestCache(t *testing.T) *testCache {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("cache.prefix").Return("goravel").Once()
+	memory, err := cache.NewMemory(mockConfig)
+	assert.Nil(t, err)
+
+	return &testCache{Memory: memory}
+}
+
+type TestUniqueJob struct {
+	calls int
+}
+
+func (receiver *TestUniqueJob) Signature() string {
+	return "test_unique_job"
+}
+
+func (receiver *TestUniqueJob) Handle(args ...any) error {
+	receiver.calls++
+
+	return nil
+}
+
+func (receiver *TestUniqueJob) UniqueID(args ...any) string {
+	return args[0].(string)
+}
+
+func (receiver *TestUniqueJob) UniqueFor() time.Duration {
+	return time.Minute
+}
+
+func TestDispatchUniqueJob(t *testing.T) {
+	CacheFacade = newTestCache(t)
+	defer func() {
+		CacheFacade = nil
+	}()
+
+	mockConfig := &configmock.Config{}
+	mockConfig.On("GetString", "queue.default").Return("sync")
+	mockConfig.On("GetString", "queue.connections.sync.driver").Return("sync")
+
+	job := &TestUniqueJob{}
+	args := []contractsqueue.Arg{{Type: "string", Value: "1"}}
+
+	// The job is dropped while the lock is held by a job with the same unique ID.
+	held := CacheFacade.Lock("queue:unique:test_unique_job:1")
+	assert.True(t, held.Get())
+	assert.Nil(t, NewTask(NewConfig(mockConfig), nil, job, args).Dispatch())
+	assert.Equal(t, 0, job.calls)
+
+	assert.Nil(t, NewTask(NewConfig(mockConfig), nil, job, []contractsqueue.Arg{{Type: "string", Value: "2"}}).Dispatch())
+	assert.Equal(t, 1, job.calls)
+
+	// The lock is released once the job finishes.
+	assert.True(t, held.Release())
+	assert.Nil(t, NewTask(NewConfig(mockConfig), nil, job, args).Dispatch())
+	assert.Nil(t, NewTask(NewConfig(mockConfig), nil, job, args).Dispatch())
+	assert.Equal(t, 3, job.calls)
+}
+
+func TestUniqueHandler(t *testing.T) {
+	CacheFacade = newTestCache(t)
+	defer func() {
+		CacheFacade = nil
+	}()
+
+	var jobErr error
+	handle := uniqueHandler(func(ctx context.Context, args ...any) error {
+		return jobErr
+	})
+	task, err := tasks.NewWithSignature(func() {}, &tasks.Signature{Headers: tasks.Headers{uniqueHeader: "queue:unique:test_unique_job:1"}})
+	assert.Nil(t, err)
+
+	// The lock is kept while the job is released back to the queue.
+	assert.True(t, CacheFacade.Lock("queue:unique:test_unique_job:1").Get())
+	jobErr = tasks.NewErrRetryTaskLater("released", 0)
+	assert.Error(t, handle(task.Context))
+	assert.False(t, CacheFacade.Lock("queue:unique:test_unique_job:1").Get())
+
+	jobErr = errors.New("failed")
+	assert.EqualError(t, handle(task.Context), "failed")
+	assert.True(t, CacheFacade.Lock("queue:unique:test_unique_job:1").Get())
+}
+
+func TestWithoutOverlapping(t *testing.T) {
+	CacheFacade = newTestCache(t)
+	defer func() {
+		CacheFacade = nil
+	}()
+
+	job := &TestMiddlewareJob{}
+	called := 0
+	next := func() error {
+		called++
+
+		return nil
+	}
+
+	assert.Nil(t, NewWithoutOverlapping("1").Handle(job, nil, next))
+	assert.Equal(t, 1, called)
+
+	held := CacheFacade.Lock("queue:overlap:test_middleware_job:1")
+	assert.True(t, held.Get())
+
+	var retryLater tasks.ErrRetryTaskLater
+	assert.ErrorAs(t, NewWithoutOverlapping("1").ReleaseAfter(time.Minute).Handle(job, nil, next), &retryLater)
+	assert.Equal(t, time.Minute, retryLater.RetryIn())
+	assert.Nil(t, NewWithoutOverlapping("1").DontRelease().Handle(job, nil, next))
+	assert.Equal(t, 1, called)
+
+	assert.Nil(t, NewWithoutOverlapping("2").ExpireAfter(time.Minute).Handle(job, nil, next))
+	assert.Equal(t, 2, called)
+}
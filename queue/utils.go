@@ -1,14 +1,17 @@
 package queue
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/id"
 )
 
-func jobs2Tasks(jobs []queue.Job) (map[string]any, error) {
+func jobs2Tasks(ctx context.Context, config *Config, jobs []queue.Job) (map[string]any, error) {
 	tasks := make(map[string]any)
 
 	for _, job := range jobs {
@@ -20,7 +23,14 @@ func jobs2Tasks(jobs []queue.Job) (map[string]any, error) {
 			return nil, fmt.Errorf("job signature duplicate: %s, the names of Job and Listener cannot be duplicated", job.Signature())
 		}
 
-		tasks[job.Signature()] = job.Handle
+		models := newModelRestorer(config, job)
+		if checkpointable, ok := job.(queue.Checkpointable); ok {
+			tasks[job.Signature()] = featureHandler(job, retryHandler(job, checkpointHandler(ctx, checkpointable, models)))
+		} else if withContext, ok := job.(queue.ShouldHandleWithContext); ok {
+			tasks[job.Signature()] = featureHandler(job, retryHandler(job, contextHandler(job, withContext, models)))
+		} else {
+			tasks[job.Signature()] = featureHandler(job, retryHandler(job, handler(middlewareHandler(job, job.Handle), models)))
+		}
 	}
 
 	return tasks, nil
@@ -39,9 +49,61 @@ func eventsToTasks(events map[event.Event][]event.Listener) (map[string]any, err
 				continue
 			}
 
-			tasks[listener.Signature()] = listener.Handle
+			tasks[listener.Signature()] = handler(listener.Handle, nil)
 		}
 	}
 
 	return tasks, nil
 }
+
+// handler Wrap the handle of a job, the encrypted and compressed arguments are decoded and the serialized models are
+// fetched again before calling the handle.
+func handler(handle func(args ...any) error, models *modelRestorer) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		args, err := decode(ctx, args)
+		if err != nil {
+			return err
+		}
+		if args, err = models.Restore(ctx, args); err != nil {
+			return err
+		}
+
+		return handle(args...)
+	}
+}
+
+// contextHandler Wrap the handle of a job receiving the context of its attempt, the handle is called through the
+// middleware of the job like Handle.
+func contextHandler(job queue.Job, withContext queue.ShouldHandleWithContext, models *modelRestorer) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		return handler(middlewareHandler(job, func(args ...any) error {
+			return withContext.HandleWithContext(ctx, args...)
+		}), models)(ctx, args...)
+	}
+}
+
+// priorityQueues Split the queues of a worker separated by commas, e.g. "high,default", the first queue has the
+// highest priority. It's the default queue if no queue is given.
+func priorityQueues(queue, defaultQueue string) []string {
+	var queues []string
+	for _, name := range strings.Split(queue, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			queues = append(queues, name)
+		}
+	}
+	if len(queues) == 0 {
+		return []string{defaultQueue}
+	}
+
+	return queues
+}
+
+// newID Generate an ID with the ID generator, an empty string is returned if the generator isn't registered, and
+// machinery generates the UUIDs of the jobs then.
+func newID() string {
+	if id.Facade == nil {
+		return ""
+	}
+
+	return id.Facade.Generate()
+}
@@ -1,12 +1,15 @@
 package queue
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/goravel/framework/contracts/event"
 	queuecontract "github.com/goravel/framework/contracts/queue"
+	"github.com/goravel/framework/id"
+	idmocks "github.com/goravel/framework/mocks/id"
 )
 
 type TestJob struct {
@@ -43,20 +46,20 @@ func (receiver *TestJobEmpty) Handle(args ...any) error {
 }
 
 func TestJobs2Tasks(t *testing.T) {
-	_, err := jobs2Tasks([]queuecontract.Job{
+	_, err := jobs2Tasks(context.Background(), nil, []queuecontract.Job{
 		&TestJob{},
 	})
 
 	assert.Nil(t, err, "success")
 
-	_, err = jobs2Tasks([]queuecontract.Job{
+	_, err = jobs2Tasks(context.Background(), nil, []queuecontract.Job{
 		&TestJob{},
 		&TestJobDuplicate{},
 	})
 
 	assert.NotNil(t, err, "Signature duplicate")
 
-	_, err = jobs2Tasks([]queuecontract.Job{
+	_, err = jobs2Tasks(context.Background(), nil, []queuecontract.Job{
 		&TestJobEmpty{},
 	})
 
@@ -155,3 +158,21 @@ func TestEvents2Tasks(t *testing.T) {
 
 	assert.NotNil(t, err)
 }
+
+func TestPriorityQueues(t *testing.T) {
+	assert.Equal(t, []string{"default"}, priorityQueues("", "default"))
+	assert.Equal(t, []string{"high"}, priorityQueues("high", "default"))
+	assert.Equal(t, []string{"high", "low"}, priorityQueues("high, ,low", "default"))
+}
+
+func TestNewID(t *testing.T) {
+	assert.Empty(t, newID())
+
+	mockID := idmocks.NewID(t)
+	id.Facade = mockID
+	t.Cleanup(func() {
+		id.Facade = nil
+	})
+	mockID.EXPECT().Generate().Return("01J0000000000000000000001").Once()
+	assert.Equal(t, "01J0000000000000000000001", newID())
+}
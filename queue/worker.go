@@ -1,19 +1,55 @@
 package queue
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2"
+	machinerylog "github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+
+	"github.com/goravel/framework/contracts/event"
 	"github.com/goravel/framework/contracts/log"
 	"github.com/goravel/framework/contracts/queue"
 )
 
 const DriverSync string = "sync"
 const DriverRedis string = "redis"
+const DriverPubSub string = "pubsub"
+const DriverDatabase string = "database"
+const DriverSqs string = "sqs"
+const DriverAmqp string = "amqp"
+const DriverKafka string = "kafka"
 
 type Worker struct {
 	concurrent int
 	connection string
+	events     func() event.Instance
 	machinery  *Machinery
 	jobs       []queue.Job
 	queue      string
+	maxJobs    int
+	maxTime    time.Duration
+	memory     int
+	processed  atomic.Int64
+	stopping   atomic.Bool
+	exceeded   chan struct{}
+	// quit It's closed by Shutdown to quit the worker, done is closed once Run returns.
+	quit     chan struct{}
+	quitOnce sync.Once
+	done     chan struct{}
+	running  atomic.Bool
+	// onRun It's called when the worker runs, and the returned function is called once Run returns.
+	onRun func(worker *Worker) func()
 }
 
 func NewWorker(config *Config, log log.Log, concurrent int, connection string, jobs []queue.Job, queue string) *Worker {
@@ -23,11 +59,28 @@ func NewWorker(config *Config, log log.Log, concurrent int, connection string, j
 		machinery:  NewMachinery(config, log),
 		jobs:       jobs,
 		queue:      queue,
+		exceeded:   make(chan struct{}, 1),
+		quit:       make(chan struct{}),
+		done:       make(chan struct{}),
 	}
 }
 
 func (receiver *Worker) Run() error {
-	server, err := receiver.machinery.Server(receiver.connection, receiver.queue)
+	receiver.running.Store(true)
+	defer close(receiver.done)
+	if receiver.onRun != nil {
+		defer receiver.onRun(receiver)()
+	}
+
+	// The redis and database drivers drain the queues in priority order, the queue of the server is the first one.
+	queue, _, multiple := strings.Cut(receiver.queue, ",")
+	if multiple {
+		if driver := receiver.machinery.config.Driver(receiver.connection); driver != DriverRedis && driver != DriverDatabase {
+			return fmt.Errorf("queue driver [%s] doesn't support working on multiple queues", driver)
+		}
+	}
+
+	server, err := receiver.machinery.Server(receiver.connection, queue)
 	if err != nil {
 		return err
 	}
@@ -35,11 +88,34 @@ func (receiver *Worker) Run() error {
 		return nil
 	}
 
-	jobTasks, err := jobs2Tasks(receiver.jobs)
+	// The checkpointable jobs are notified by the first shutdown signal, machinery waits for them to finish.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	jobTasks, err := jobs2Tasks(ctx, receiver.machinery.config, receiver.jobs)
 	if err != nil {
 		return err
 	}
 
+	batches := NewBatchRepository(receiver.machinery.config, receiver.machinery.log)
+	failer := NewFailedJobRepository(receiver.machinery.config, receiver.machinery.log)
+	metrics := NewMetricsRepository(receiver.machinery.config, receiver.machinery.log)
+	for signature, task := range jobTasks {
+		handle := task.(func(ctx context.Context, args ...any) error)
+		if receiver.machinery.config.Encrypt(receiver.connection) {
+			handle = encryptionHandler(handle)
+		}
+		handle = uniqueHandler(handle)
+		if failer.Enabled() {
+			handle = failedHandler(failer, receiver.connection, receiver.queue, handle)
+		}
+		handle = batchHandler(batches, receiver.jobs, handle)
+		if metrics.Enabled() {
+			handle = metricsHandler(metrics, receiver.connection, receiver.queue, handle)
+		}
+		jobTasks[signature] = receiver.limitHandler(receiver.eventsHandler(handle))
+	}
+
 	if err := server.RegisterTasks(jobTasks); err != nil {
 		return err
 	}
@@ -50,10 +126,146 @@ func (receiver *Worker) Run() error {
 	if receiver.concurrent == 0 {
 		receiver.concurrent = 1
 	}
+
+	// The worker stops by itself, so the running jobs can finish before a signal or a limit quits the worker.
+	server.GetConfig().NoUnixSignals = true
 	worker := server.NewWorker(receiver.queue, receiver.concurrent)
-	if err := worker.Launch(); err != nil {
+	errorsChan := make(chan error, 1)
+	worker.LaunchAsync(errorsChan)
+
+	return receiver.wait(ctx, worker, errorsChan)
+}
+
+// wait Wait for the worker to stop, the worker is quit gracefully by the first shutdown signal, Shutdown or when a
+// limit is exceeded, it waits for the running jobs to finish, and a second shutdown signal aborts it.
+func (receiver *Worker) wait(ctx context.Context, worker *machinery.Worker, errorsChan chan error) error {
+	var timeout <-chan time.Time
+	if receiver.maxTime > 0 {
+		timer := time.NewTimer(receiver.maxTime)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case err := <-errorsChan:
 		return err
+	case <-ctx.Done():
+		machinerylog.WARNING.Print("Waiting for running jobs to finish before shutting down")
+	case <-receiver.quit:
+		machinerylog.WARNING.Print("Waiting for running jobs to finish before shutting down")
+	case <-timeout:
+		machinerylog.INFO.Printf("The worker has run for %s, stopping", receiver.maxTime)
+	case <-receiver.exceeded:
+	}
+	receiver.stopping.Store(true)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	worker.Quit()
+
+	select {
+	case err := <-errorsChan:
+		return err
+	case <-signals:
+		return machinery.ErrWorkerQuitAbruptly
+	}
+}
+
+// Shutdown Quit the worker gracefully as the first shutdown signal does, and wait for the running jobs to finish until
+// the context is done.
+func (receiver *Worker) Shutdown(ctx context.Context) error {
+	receiver.quitOnce.Do(func() {
+		close(receiver.quit)
+	})
+	if !receiver.running.Load() {
+		return nil
+	}
+
+	select {
+	case <-receiver.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// limitHandler Wrap the handle of a job to stop the worker after the job if the worker has processed the maximum
+// number of jobs or its memory exceeds the limit, the jobs reserved by a stopping worker are released back to the queue.
+func (receiver *Worker) limitHandler(handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		if receiver.stopping.Load() {
+			return tasks.NewErrRetryTaskLater("the worker is stopping", 0)
+		}
+
+		err := handle(ctx, args...)
+
+		if receiver.limitExceeded(receiver.processed.Add(1)) {
+			receiver.stopping.Store(true)
+			select {
+			case receiver.exceeded <- struct{}{}:
+			default:
+			}
+		}
+
+		return err
+	}
+}
+
+// eventsHandler Wrap the handle of a job to dispatch the JobProcessing event before the job, and the JobProcessed,
+// JobRetrying or JobFailed event after it.
+func (receiver *Worker) eventsHandler(handle func(ctx context.Context, args ...any) error) func(ctx context.Context, args ...any) error {
+	return func(ctx context.Context, args ...any) error {
+		signature := tasks.SignatureFromContext(ctx)
+		if signature == nil || receiver.events == nil {
+			return handle(ctx, args...)
+		}
+
+		eventArgs := jobEventArgs(receiver.connection, signatureQueue(receiver.queue, signature), signature.Name, signature.UUID)
+		dispatchJobEvent(receiver.events, &JobProcessing{}, signature.Name, eventArgs)
+
+		err := handle(ctx, args...)
+
+		var retryLater tasks.ErrRetryTaskLater
+		switch {
+		case err == nil:
+			dispatchJobEvent(receiver.events, &JobProcessed{}, signature.Name, eventArgs)
+		case errors.As(err, &retryLater) || signature.RetryCount > 0:
+			dispatchJobEvent(receiver.events, &JobRetrying{}, signature.Name, append(eventArgs, event.Arg{Type: "string", Value: err.Error()}))
+		default:
+			dispatchJobEvent(receiver.events, &JobFailed{}, signature.Name, append(eventArgs, event.Arg{Type: "string", Value: err.Error()}))
+		}
+
+		return err
+	}
+}
+
+func (receiver *Worker) limitExceeded(processed int64) bool {
+	if receiver.maxJobs > 0 && processed >= int64(receiver.maxJobs) {
+		machinerylog.INFO.Printf("The worker has processed %d jobs, stopping", processed)
+
+		return true
+	}
+
+	if receiver.memory > 0 {
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		if used := stats.Alloc / 1024 / 1024; used >= uint64(receiver.memory) {
+			machinerylog.INFO.Printf("The worker has used %dMB memory, stopping", used)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// signatureQueue Get the queue a job was published to, the worker of multiple queues gets it from the signature.
+func signatureQueue(queue string, signature *tasks.Signature) string {
+	if strings.Contains(queue, ",") && signature.RoutingKey != "" {
+		return signature.RoutingKey
 	}
 
-	return nil
+	return queue
 }
@@ -0,0 +1,162 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2"
+	nullbackend "github.com/RichardKnop/machinery/v2/backends/null"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/locks/eager"
+	machinerylog "github.com/RichardKnop/machinery/v2/log"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/database/gorm"
+	configmock "github.com/goravel/framework/mocks/config"
+	"github.com/goravel/framework/support/docker"
+	"github.com/goravel/framework/support/env"
+)
+
+func TestWorkerRun_MultipleQueues(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetString("queue.connections.sqs.driver").Return("sqs").Once()
+
+	worker := NewWorker(NewConfig(mockConfig), nil, 1, "sqs", nil, "goravel_queues:high,goravel_queues:default")
+	assert.EqualError(t, worker.Run(), "queue driver [sqs] doesn't support working on multiple queues")
+}
+
+func TestWorkerLimitHandler(t *testing.T) {
+	machinerylog.Set(NewInfo(false, nil))
+
+	worker := NewWorker(nil, nil, 1, "sync", nil, "")
+	handle := worker.limitHandler(func(ctx context.Context, args ...any) error {
+		return errors.New("failed")
+	})
+
+	// No limits
+	assert.EqualError(t, handle(context.Background()), "failed")
+	assert.Len(t, worker.exceeded, 0)
+
+	worker.maxJobs = 3
+	assert.EqualError(t, handle(context.Background()), "failed")
+	assert.Len(t, worker.exceeded, 0)
+	assert.EqualError(t, handle(context.Background()), "failed")
+	assert.Len(t, worker.exceeded, 1)
+
+	// The jobs reserved by a stopping worker are released.
+	var retryLater tasks.ErrRetryTaskLater
+	assert.ErrorAs(t, handle(context.Background()), &retryLater)
+	assert.Len(t, worker.exceeded, 1)
+
+	worker = NewWorker(nil, nil, 1, "sync", nil, "")
+	worker.memory = 1 << 20
+	worker.limitHandler(func(ctx context.Context, args ...any) error { return nil })(context.Background())
+	assert.Len(t, worker.exceeded, 0)
+
+	worker.memory = 1
+	buffer := make([]byte, 2<<20)
+	worker.limitHandler(func(ctx context.Context, args ...any) error { return nil })(context.Background())
+	assert.Len(t, worker.exceeded, 1)
+	assert.Len(t, buffer, 2<<20)
+}
+
+func TestWorkerWait(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	machinerylog.Set(NewInfo(false, nil))
+	query, err := gorm.NewSqliteDocker(docker.Sqlite()).New()
+	assert.Nil(t, err)
+	_, err = query.Exec("DROP TABLE IF EXISTS jobs")
+	assert.Nil(t, err)
+	_, err = query.Exec("CREATE TABLE jobs (id integer PRIMARY KEY AUTOINCREMENT, queue varchar(255) NOT NULL, payload text NOT NULL, attempts integer NOT NULL DEFAULT 0, reserved_at integer NULL, available_at integer NOT NULL, created_at integer NOT NULL)")
+	assert.Nil(t, err)
+
+	newServer := func() *machinery.Server {
+		cnf := &config.Config{DefaultQueue: "default", NoUnixSignals: true}
+
+		return machinery.NewServer(cnf, NewDatabaseBroker(cnf, query, "jobs", 1, time.Millisecond, 10*time.Millisecond, time.Minute), nullbackend.New(), eager.New())
+	}
+
+	// The worker stops after processing the maximum number of jobs, the running job finishes.
+	worker := NewWorker(nil, nil, 1, "database", nil, "")
+	worker.maxJobs = 2
+	var processed []int
+	server := newServer()
+	assert.Nil(t, server.RegisterTask("test", worker.limitHandler(func(ctx context.Context, args ...any) error {
+		time.Sleep(20 * time.Millisecond)
+		processed = append(processed, len(processed)+1)
+
+		return nil
+	})))
+	for i := 0; i < 3; i++ {
+		_, err := server.SendTask(&tasks.Signature{Name: "test"})
+		assert.Nil(t, err)
+	}
+
+	errorsChan := make(chan error, 1)
+	machineryWorker := server.NewWorker("default", 1)
+	machineryWorker.LaunchAsync(errorsChan)
+	assert.Nil(t, worker.wait(context.Background(), machineryWorker, errorsChan))
+	assert.Equal(t, []int{1, 2}, processed)
+
+	pending, err := server.GetBroker().GetPendingTasks("default")
+	assert.Nil(t, err)
+	assert.Len(t, pending, 1)
+
+	// The worker stops after running for the maximum time.
+	worker = NewWorker(nil, nil, 1, "database", nil, "")
+	worker.maxTime = 50 * time.Millisecond
+	server = newServer()
+	errorsChan = make(chan error, 1)
+	machineryWorker = server.NewWorker("default", 1)
+	machineryWorker.LaunchAsync(errorsChan)
+	start := time.Now()
+	assert.Nil(t, worker.wait(context.Background(), machineryWorker, errorsChan))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// The worker stops gracefully when the shutdown signal is received.
+	worker = NewWorker(nil, nil, 1, "database", nil, "")
+	server = newServer()
+	errorsChan = make(chan error, 1)
+	machineryWorker = server.NewWorker("default", 1)
+	machineryWorker.LaunchAsync(errorsChan)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Nil(t, worker.wait(ctx, machineryWorker, errorsChan))
+
+	// The worker stops gracefully when it's shut down.
+	worker = NewWorker(nil, nil, 1, "database", nil, "")
+	server = newServer()
+	errorsChan = make(chan error, 1)
+	machineryWorker = server.NewWorker("default", 1)
+	machineryWorker.LaunchAsync(errorsChan)
+	close(worker.quit)
+	assert.Nil(t, worker.wait(context.Background(), machineryWorker, errorsChan))
+}
+
+func TestWorkerShutdown(t *testing.T) {
+	// The worker isn't running, it quits once it runs.
+	worker := NewWorker(nil, nil, 1, "sync", nil, "")
+	assert.Nil(t, worker.Shutdown(context.Background()))
+	assert.Nil(t, worker.Shutdown(context.Background()))
+	select {
+	case <-worker.quit:
+	default:
+		t.Error("the worker isn't quit")
+	}
+
+	// The worker is running, Shutdown waits for it until the context is done.
+	worker = NewWorker(nil, nil, 1, "sync", nil, "")
+	worker.running.Store(true)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, worker.Shutdown(ctx), context.DeadlineExceeded)
+
+	close(worker.done)
+	assert.Nil(t, worker.Shutdown(context.Background()))
+}
@@ -0,0 +1,102 @@
+package console
+
+import (
+	"encoding/json"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+type CacheCommand struct {
+	route route.Route
+	file  string
+}
+
+func NewCacheCommand(route route.Route, file string) *CacheCommand {
+	return &CacheCommand{
+		route: route,
+		file:  file,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *CacheCommand) Signature() string {
+	return "route:cache"
+}
+
+// Description The console command description.
+func (receiver *CacheCommand) Description() string {
+	return "Create a route cache file for generating the URLs of the named routes without the router"
+}
+
+// Extend The console command extend.
+func (receiver *CacheCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "route",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *CacheCommand) Handle(ctx console.Context) error {
+	routes := make(map[string]string)
+	if receiver.route != nil {
+		for _, item := range receiver.route.GetRoutes() {
+			if item.Name != "" {
+				routes[item.Name] = item.Path
+			}
+		}
+	}
+
+	content, err := json.Marshal(routes)
+	if err != nil {
+		return err
+	}
+	if err := file.Create(receiver.file, string(content)); err != nil {
+		return err
+	}
+
+	color.Green().Println("Routes cached successfully")
+
+	return nil
+}
+
+type ClearCommand struct {
+	file string
+}
+
+func NewClearCommand(file string) *ClearCommand {
+	return &ClearCommand{
+		file: file,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ClearCommand) Signature() string {
+	return "route:clear"
+}
+
+// Description The console command description.
+func (receiver *ClearCommand) Description() string {
+	return "Remove the route cache file"
+}
+
+// Extend The console command extend.
+func (receiver *ClearCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "route",
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ClearCommand) Handle(ctx console.Context) error {
+	if err := file.Remove(receiver.file); err != nil {
+		return err
+	}
+
+	color.Green().Println("Route cache cleared successfully")
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/console"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	mocksroute "github.com/goravel/framework/mocks/route"
+	"github.com/goravel/framework/support/file"
+)
+
+func TestCacheAndClearCommand(t *testing.T) {
+	cacheFile := filepath.Join(t.TempDir(), "storage", "framework", "routes.json")
+	mockRoute := mocksroute.NewRoute(t)
+	mockRoute.EXPECT().GetRoutes().Return([]contractsroute.Info{
+		{Method: "GET", Path: "/users/{id}", Name: "users.show"},
+		{Method: "GET", Path: "/health"},
+	}).Once()
+
+	console.NewTester(t, NewCacheCommand(mockRoute, cacheFile)).
+		Run().
+		AssertSuccessful().
+		AssertOutputContains("Routes cached successfully")
+
+	content, err := os.ReadFile(cacheFile)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"users.show":"/users/{id}"}`, string(content))
+
+	console.NewTester(t, NewClearCommand(cacheFile)).
+		Run().
+		AssertSuccessful().
+		AssertOutputContains("Route cache cleared successfully")
+	assert.False(t, file.Exists(cacheFile))
+
+	console.NewTester(t, NewCacheCommand(nil, cacheFile)).Run().AssertSuccessful()
+	content, err = os.ReadFile(cacheFile)
+	assert.Nil(t, err)
+	assert.Equal(t, `{}`, string(content))
+}
@@ -0,0 +1,429 @@
+package console
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+const (
+	routeDirective      = "//goravel:route "
+	middlewareDirective = "//goravel:middleware "
+)
+
+// routeMethods The methods of the router registering the routes of the HTTP methods.
+var routeMethods = map[string]string{
+	"ANY":     "Any",
+	"DELETE":  "Delete",
+	"GET":     "Get",
+	"OPTIONS": "Options",
+	"PATCH":   "Patch",
+	"POST":    "Post",
+	"PUT":     "Put",
+}
+
+type GenerateCommand struct {
+}
+
+func NewGenerateCommand() *GenerateCommand {
+	return &GenerateCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *GenerateCommand) Signature() string {
+	return "route:generate"
+}
+
+// Description The console command description.
+func (receiver *GenerateCommand) Description() string {
+	return "Generate the routes from the directives of the controllers"
+}
+
+// Extend The console command extend.
+func (receiver *GenerateCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "route",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "path",
+				Value: filepath.Join("app", "http", "controllers"),
+				Usage: "the directory of the controllers",
+			},
+			&command.StringFlag{
+				Name:  "output",
+				Value: filepath.Join("routes", "generated.go"),
+				Usage: "the file of the generated routes",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command. The methods of the controllers are registered by the directives in their
+// comments, a route directive is the HTTP method, the path and an optional name, e.g.:
+//
+//	//goravel:route GET /users/{id} users.show
+//	//goravel:middleware middleware.Auth()
+//	func (r *UserController) Show(ctx http.Context) http.Response
+func (receiver *GenerateCommand) Handle(ctx console.Context) error {
+	module, err := modulePath()
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	routes, err := collectRoutes(module, ctx.Option("path"))
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+	if len(routes) == 0 {
+		color.Yellow().Println("No route directives found in the controllers")
+		return nil
+	}
+
+	content, err := generateRoutes(routes)
+	if err != nil {
+		color.Red().Println(err)
+		return nil
+	}
+
+	output := ctx.Option("output")
+	if err := file.Create(output, content); err != nil {
+		return err
+	}
+
+	color.Green().Printf("Routes [%s] generated successfully\n", output)
+
+	return nil
+}
+
+type generatedRoute struct {
+	method      string
+	path        string
+	name        string
+	action      string
+	handler     string
+	controller  string
+	constructor string
+	pkg         string
+	middleware  []string
+	// imports The import paths of the packages used by the middleware, keyed by the package names.
+	imports map[string]string
+}
+
+// modulePath Get the module path of the application from the go.mod of the current directory.
+func modulePath() (string, error) {
+	content, err := os.ReadFile("go.mod")
+	if err != nil {
+		return "", fmt.Errorf("failed to read the go.mod of the application: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); strings.HasPrefix(line, "module ") {
+			return strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module ")), `"`), nil
+		}
+	}
+
+	return "", errors.New("the module path isn't found in the go.mod of the application")
+}
+
+// collectRoutes Parse the controllers under the directory, the routes are sorted by the files and the order of the
+// methods in them.
+func collectRoutes(module, dir string) ([]generatedRoute, error) {
+	var routes []generatedRoute
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+
+		parsed, err := parser.ParseDir(token.NewFileSet(), filePath, func(info fs.FileInfo) bool {
+			return !strings.HasSuffix(info.Name(), "_test.go")
+		}, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+
+		for _, pkg := range parsed {
+			pkgRoutes, err := packageRoutes(module, filePath, pkg)
+			if err != nil {
+				return err
+			}
+			routes = append(routes, pkgRoutes...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]string)
+	names := make(map[string]string)
+	for _, route := range routes {
+		key := route.method + " " + route.path
+		if action, exist := seen[key]; exist {
+			return nil, fmt.Errorf("the route [%s] is defined by both %s and %s", key, action, route.action)
+		}
+		seen[key] = route.action
+
+		if route.name == "" {
+			continue
+		}
+		if action, exist := names[route.name]; exist {
+			return nil, fmt.Errorf("the route name [%s] is defined by both %s and %s", route.name, action, route.action)
+		}
+		names[route.name] = route.action
+	}
+
+	return routes, nil
+}
+
+func packageRoutes(module, dir string, pkg *ast.Package) ([]generatedRoute, error) {
+	constructors := make(map[string]string)
+	fileNames := make([]string, 0, len(pkg.Files))
+	for fileName, astFile := range pkg.Files {
+		fileNames = append(fileNames, fileName)
+		for _, decl := range astFile.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Recv != nil || function.Type.Params.NumFields() > 0 || !strings.HasPrefix(function.Name.Name, "New") {
+				continue
+			}
+			constructors[strings.TrimPrefix(function.Name.Name, "New")] = function.Name.Name
+		}
+	}
+	sort.Strings(fileNames)
+
+	var routes []generatedRoute
+	for _, fileName := range fileNames {
+		astFile := pkg.Files[fileName]
+		fileImports := make(map[string]string)
+		for _, spec := range astFile.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := path.Base(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			fileImports[name] = importPath
+		}
+
+		for _, decl := range astFile.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Recv == nil || function.Doc == nil {
+				continue
+			}
+
+			controller := receiverName(function.Recv.List[0].Type)
+			action := fmt.Sprintf("%s.(*%s).%s", pkg.Name, controller, function.Name.Name)
+
+			var directives [][]string
+			var middleware []string
+			imports := make(map[string]string)
+			for _, comment := range function.Doc.List {
+				switch {
+				case strings.HasPrefix(comment.Text, routeDirective):
+					fields := strings.Fields(strings.TrimPrefix(comment.Text, routeDirective))
+					if len(fields) < 2 || len(fields) > 3 {
+						return nil, fmt.Errorf("the route directive of %s is invalid, it should be: //goravel:route METHOD PATH [NAME]", action)
+					}
+					fields[0] = strings.ToUpper(fields[0])
+					if _, exist := routeMethods[fields[0]]; !exist {
+						return nil, fmt.Errorf("the method [%s] of %s isn't supported", fields[0], action)
+					}
+					directives = append(directives, fields)
+				case strings.HasPrefix(comment.Text, middlewareDirective):
+					expression := strings.TrimSpace(strings.TrimPrefix(comment.Text, middlewareDirective))
+					if err := middlewareImports(module, expression, fileImports, imports); err != nil {
+						return nil, fmt.Errorf("the middleware of %s is invalid: %w", action, err)
+					}
+					middleware = append(middleware, expression)
+				}
+			}
+
+			for _, directive := range directives {
+				route := generatedRoute{
+					method:     directive[0],
+					path:       directive[1],
+					action:     action,
+					handler:    function.Name.Name,
+					controller: controller,
+					pkg:        path.Join(module, filepath.ToSlash(dir)),
+					middleware: middleware,
+					imports:    imports,
+				}
+				if len(directive) == 3 {
+					route.name = directive[2]
+				}
+				if constructor, exist := constructors[controller]; exist {
+					route.constructor = constructor
+				}
+				routes = append(routes, route)
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+func receiverName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	default:
+		return ""
+	}
+}
+
+// middlewareImports Resolve the packages used by the middleware expression, a package is resolved by the imports of
+// the controller, or by the directory under app/http of the application, e.g. middleware.Auth().
+func middlewareImports(module, expression string, fileImports, imports map[string]string) error {
+	parsed, err := parser.ParseExpr(expression)
+	if err != nil {
+		return err
+	}
+
+	var resolveErr error
+	ast.Inspect(parsed, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok || resolveErr != nil {
+			return true
+		}
+		ident, ok := selector.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		if importPath, exist := fileImports[ident.Name]; exist {
+			imports[ident.Name] = importPath
+		} else if dir := filepath.Join("app", "http", ident.Name); file.Exists(dir) {
+			imports[ident.Name] = path.Join(module, filepath.ToSlash(dir))
+		} else {
+			resolveErr = fmt.Errorf("the package [%s] isn't found", ident.Name)
+		}
+
+		return true
+	})
+
+	return resolveErr
+}
+
+// generateRoutes Generate the Generated function registering the routes, and the Names map of the named routes.
+func generateRoutes(routes []generatedRoute) (string, error) {
+	imports := map[string]string{"facades": "github.com/goravel/framework/facades"}
+	aliases := make(map[string]string)
+	for _, route := range routes {
+		if _, exist := aliases[route.pkg]; exist {
+			continue
+		}
+
+		alias := path.Base(route.pkg)
+		for i := 2; imports[alias] != "" && imports[alias] != route.pkg; i++ {
+			alias = fmt.Sprintf("%s%d", path.Base(route.pkg), i)
+		}
+		imports[alias] = route.pkg
+		aliases[route.pkg] = alias
+	}
+	for _, route := range routes {
+		for name, importPath := range route.imports {
+			if existing, exist := imports[name]; exist && existing != importPath {
+				return "", fmt.Errorf("the package [%s] of the middleware of %s conflicts with %s", name, route.action, existing)
+			}
+			imports[name] = importPath
+		}
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("// Code generated by route:generate. DO NOT EDIT.\n\npackage routes\n\nimport (\n")
+	names := make([]string, 0, len(imports))
+	for name := range imports {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return imports[names[i]] < imports[names[j]]
+	})
+	for _, name := range names {
+		if path.Base(imports[name]) == name {
+			fmt.Fprintf(&buffer, "%q\n", imports[name])
+		} else {
+			fmt.Fprintf(&buffer, "%s %q\n", name, imports[name])
+		}
+	}
+	buffer.WriteString(")\n\n")
+
+	buffer.WriteString("// Names The paths of the named routes.\nvar Names = map[string]string{\n")
+	for _, route := range routes {
+		if route.name != "" {
+			fmt.Fprintf(&buffer, "%q: %q,\n", route.name, route.path)
+		}
+	}
+	buffer.WriteString("}\n\n")
+
+	buffer.WriteString("// Generated Register the routes defined by the directives of the controllers.\nfunc Generated() {\n")
+	controllers := make(map[string]string)
+	for _, route := range routes {
+		key := route.pkg + "." + route.controller
+		if _, exist := controllers[key]; exist {
+			continue
+		}
+
+		variable := fmt.Sprintf("%s%s", strings.ToLower(route.controller[:1]), route.controller[1:])
+		for i := 2; containsValue(controllers, variable); i++ {
+			variable = fmt.Sprintf("%s%s%d", strings.ToLower(route.controller[:1]), route.controller[1:], i)
+		}
+		controllers[key] = variable
+
+		if route.constructor != "" {
+			fmt.Fprintf(&buffer, "%s := %s.%s()\n", variable, aliases[route.pkg], route.constructor)
+		} else {
+			fmt.Fprintf(&buffer, "%s := &%s.%s{}\n", variable, aliases[route.pkg], route.controller)
+		}
+	}
+	buffer.WriteString("\n")
+	for _, route := range routes {
+		buffer.WriteString("facades.Route()")
+		if len(route.middleware) > 0 {
+			fmt.Fprintf(&buffer, ".Middleware(%s)", strings.Join(route.middleware, ", "))
+		}
+		fmt.Fprintf(&buffer, ".%s(%q, %s.%s)\n", routeMethods[route.method], route.path, controllers[route.pkg+"."+route.controller], route.handler)
+	}
+	buffer.WriteString("}\n")
+
+	content, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func containsValue(values map[string]string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
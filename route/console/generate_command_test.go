@@ -0,0 +1,178 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/console"
+	"github.com/goravel/framework/support/file"
+)
+
+func TestGenerateCommand(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.Nil(t, err)
+	assert.Nil(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() {
+		assert.Nil(t, os.Chdir(wd))
+	})
+
+	console.NewTester(t, NewGenerateCommand()).Run().AssertOutputContains("failed to read the go.mod of the application")
+
+	assert.Nil(t, file.Create("go.mod", "module goravel\n\ngo 1.21\n"))
+	assert.Nil(t, os.MkdirAll(filepath.Join("app", "http", "controllers"), os.ModePerm))
+	console.NewTester(t, NewGenerateCommand()).Run().AssertOutputContains("No route directives found in the controllers")
+
+	assert.Nil(t, os.MkdirAll(filepath.Join("app", "http", "middleware"), os.ModePerm))
+	assert.Nil(t, file.Create(filepath.Join("app", "http", "controllers", "user_controller.go"), `package controllers
+
+import (
+	"github.com/goravel/framework/contracts/http"
+)
+
+type UserController struct {
+}
+
+func NewUserController() *UserController {
+	return &UserController{}
+}
+
+// Index returns the users.
+//
+//goravel:route GET /users users.index
+func (r *UserController) Index(ctx http.Context) http.Response {
+	return nil
+}
+
+// Show returns a user.
+//
+//goravel:route GET /users/{id} users.show
+//goravel:route HEAD /users/{id}
+func (r *UserController) Show(ctx http.Context) http.Response {
+	return nil
+}
+`))
+	console.NewTester(t, NewGenerateCommand()).Run().AssertOutputContains("the method [HEAD] of controllers.(*UserController).Show isn't supported")
+
+	assert.Nil(t, file.Create(filepath.Join("app", "http", "controllers", "user_controller.go"), `package controllers
+
+import (
+	"github.com/goravel/framework/contracts/http"
+)
+
+type UserController struct {
+}
+
+func NewUserController() *UserController {
+	return &UserController{}
+}
+
+// Index returns the users.
+//
+//goravel:route GET /users users.index
+func (r *UserController) Index(ctx http.Context) http.Response {
+	return nil
+}
+
+// Show returns a user.
+//
+//goravel:route get /users/{id} users.show
+//goravel:middleware middleware.Auth()
+//goravel:middleware limits.Throttle("api")
+func (r *UserController) Show(ctx http.Context) http.Response {
+	return nil
+}
+
+func (r *UserController) Helper() {
+}
+`))
+	console.NewTester(t, NewGenerateCommand()).Run().AssertOutputContains("the middleware of controllers.(*UserController).Show is invalid: the package [limits] isn't found")
+
+	controller, err := os.ReadFile(filepath.Join("app", "http", "controllers", "user_controller.go"))
+	assert.Nil(t, err)
+	assert.Nil(t, file.Create(filepath.Join("app", "http", "controllers", "user_controller.go"),
+		strings.Replace(string(controller), "//goravel:middleware limits.Throttle(\"api\")\n", "", 1)))
+
+	assert.Nil(t, file.Create(filepath.Join("app", "http", "controllers", "admin", "post_controller.go"), `package admin
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http/limit"
+)
+
+type PostController struct {
+}
+
+//goravel:route POST /admin/posts admin.posts.store
+//goravel:middleware limit.Throttle("api")
+func (r PostController) Store(ctx http.Context) http.Response {
+	return nil
+}
+
+//goravel:route PUT /users/{id} users.show
+func (r PostController) Update(ctx http.Context) http.Response {
+	return nil
+}
+`))
+	console.NewTester(t, NewGenerateCommand()).Run().AssertOutputContains("the route name [users.show] is defined by both controllers.(*UserController).Show and admin.(*PostController).Update")
+
+	assert.Nil(t, file.Create(filepath.Join("app", "http", "controllers", "admin", "post_controller.go"), `package admin
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http/limit"
+)
+
+type PostController struct {
+}
+
+//goravel:route POST /admin/posts admin.posts.store
+//goravel:middleware limit.Throttle("api")
+func (r PostController) Store(ctx http.Context) http.Response {
+	return nil
+}
+
+//goravel:route PUT /users/{id}
+func (r PostController) Update(ctx http.Context) http.Response {
+	return nil
+}
+`))
+	console.NewTester(t, NewGenerateCommand()).Run("--output", filepath.Join("routes", "api.go")).AssertSuccessful().
+		AssertOutputContains("Routes [routes/api.go] generated successfully")
+
+	content, err := os.ReadFile(filepath.Join("routes", "api.go"))
+	assert.Nil(t, err)
+	assert.Equal(t, `// Code generated by route:generate. DO NOT EDIT.
+
+package routes
+
+import (
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http/limit"
+	"goravel/app/http/controllers"
+	"goravel/app/http/controllers/admin"
+	"goravel/app/http/middleware"
+)
+
+// Names The paths of the named routes.
+var Names = map[string]string{
+	"users.index":       "/users",
+	"users.show":        "/users/{id}",
+	"admin.posts.store": "/admin/posts",
+}
+
+// Generated Register the routes defined by the directives of the controllers.
+func Generated() {
+	userController := controllers.NewUserController()
+	postController := &admin.PostController{}
+
+	facades.Route().Get("/users", userController.Index)
+	facades.Route().Middleware(middleware.Auth()).Get("/users/{id}", userController.Show)
+	facades.Route().Middleware(limit.Throttle("api")).Post("/admin/posts", postController.Store)
+	facades.Route().Put("/users/{id}", postController.Update)
+}
+`, string(content))
+}
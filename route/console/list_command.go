@@ -0,0 +1,108 @@
+package console
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/support/color"
+)
+
+type ListCommand struct {
+	route route.Route
+}
+
+func NewListCommand(route route.Route) *ListCommand {
+	return &ListCommand{
+		route: route,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ListCommand) Signature() string {
+	return "route:list"
+}
+
+// Description The console command description.
+func (receiver *ListCommand) Description() string {
+	return "List all registered routes"
+}
+
+// Extend The console command extend.
+func (receiver *ListCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "route",
+		Json:     true,
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "method",
+				Usage: "filter the routes by method",
+			},
+			&command.StringFlag{
+				Name:  "path",
+				Usage: "only show routes matching the given path pattern",
+			},
+			&command.StringFlag{
+				Name:  "name",
+				Usage: "filter the routes by name",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ListCommand) Handle(ctx console.Context) error {
+	routes := receiver.filter(receiver.route.GetRoutes(), ctx.Option("method"), ctx.Option("path"), ctx.Option("name"))
+
+	if ctx.OptionBool("json") {
+		return ctx.Json(routes)
+	}
+
+	if len(routes) == 0 {
+		if ctx.Option("method") != "" || ctx.Option("path") != "" || ctx.Option("name") != "" {
+			color.Yellow().Println("Your application doesn't have any routes matching the given criteria.")
+		} else {
+			color.Yellow().Println("Your application doesn't have any routes.")
+		}
+
+		return nil
+	}
+
+	rows := make([][]string, len(routes))
+	for i, item := range routes {
+		rows[i] = []string{item.Method, item.Path, item.Name, item.Action, strings.Join(item.Middleware, ", ")}
+	}
+
+	return ctx.Table([]string{"Method", "URI", "Name", "Action", "Middleware"}, rows)
+}
+
+// filter Filter the routes by the given options, the method is matched exactly while the path
+// and name are matched by substring, the result is sorted by path and method.
+func (receiver *ListCommand) filter(routes []route.Info, method, path, name string) []route.Info {
+	filtered := make([]route.Info, 0, len(routes))
+	for _, item := range routes {
+		if method != "" && !strings.EqualFold(item.Method, method) {
+			continue
+		}
+		if path != "" && !strings.Contains(item.Path, strings.TrimPrefix(path, "/")) {
+			continue
+		}
+		if name != "" && !strings.Contains(item.Name, name) {
+			continue
+		}
+
+		filtered = append(filtered, item)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Path != filtered[j].Path {
+			return filtered[i].Path < filtered[j].Path
+		}
+
+		return filtered[i].Method < filtered[j].Method
+	})
+
+	return filtered
+}
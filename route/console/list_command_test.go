@@ -0,0 +1,66 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/console"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	mocksroute "github.com/goravel/framework/mocks/route"
+)
+
+func TestListCommand(t *testing.T) {
+	routes := []contractsroute.Info{
+		{Method: "POST", Path: "/users", Name: "users.store", Action: "controllers.(*UserController).Store", Middleware: []string{"auth"}},
+		{Method: "GET", Path: "/users", Name: "users.index", Action: "controllers.(*UserController).Index", Middleware: []string{"auth", "throttle"}},
+		{Method: "GET", Path: "/health", Action: "routes.Health"},
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		assert func(result *console.TestResult)
+	}{
+		{
+			name: "list all routes sorted by path and method",
+			assert: func(result *console.TestResult) {
+				result.AssertSuccessful().AssertTable([]string{"Method", "URI", "Name", "Action", "Middleware"}, [][]string{
+					{"GET", "/health", "", "routes.Health", ""},
+					{"GET", "/users", "users.index", "controllers.(*UserController).Index", "auth, throttle"},
+					{"POST", "/users", "users.store", "controllers.(*UserController).Store", "auth"},
+				})
+			},
+		},
+		{
+			name: "filter by method, path and name",
+			args: []string{"--method", "post", "--path", "users", "--name", "store"},
+			assert: func(result *console.TestResult) {
+				result.AssertSuccessful().AssertTable([]string{"Method", "URI", "Name", "Action", "Middleware"}, [][]string{
+					{"POST", "/users", "users.store", "controllers.(*UserController).Store", "auth"},
+				})
+			},
+		},
+		{
+			name: "no routes match",
+			args: []string{"--method", "DELETE"},
+			assert: func(result *console.TestResult) {
+				result.AssertSuccessful().AssertOutputContains("Your application doesn't have any routes matching the given criteria.")
+			},
+		},
+		{
+			name: "json",
+			args: []string{"--json", "--path", "/health"},
+			assert: func(result *console.TestResult) {
+				result.AssertSuccessful().AssertOutputContains(`[{"method":"GET","path":"/health","name":"","action":"routes.Health","middleware":null}]`)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockRoute := mocksroute.NewRoute(t)
+			mockRoute.EXPECT().GetRoutes().Return(routes).Once()
+
+			test.assert(console.NewTester(t, NewListCommand(mockRoute)).Run(test.args...))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package route
+
+import (
+	"net"
+	"regexp"
+	"strings"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+)
+
+// DomainParametersKey The context key of the parameters of the domain matched by the request.
+const DomainParametersKey = "goravel_domain_parameters"
+
+// domainParameter Match the parameters of a domain pattern, e.g. {tenant} in {tenant}.goravel.dev.
+var domainParameter = regexp.MustCompile(`\{(\w+)}`)
+
+// Domain Get a router whose routes match only the requests of the domain, the parameters of the domain, e.g.
+// {tenant} in {tenant}.goravel.dev, are got by DomainParameter. The routers implementing route.DomainRouter match
+// the domain natively, the others match it by a middleware, so the same path can't be registered for two domains.
+func Domain(router route.Router, domain string) route.Router {
+	if domainRouter, ok := router.(route.DomainRouter); ok {
+		return domainRouter.Domain(domain)
+	}
+
+	return router.Middleware(DomainMiddleware(domain))
+}
+
+// DomainMiddleware Abort the requests whose host doesn't match the domain with 404, the parameters of the domain
+// are set to the context.
+func DomainMiddleware(domain string) contractshttp.Middleware {
+	var parameters []string
+	expression := "(?i)^"
+	last := 0
+	for _, match := range domainParameter.FindAllStringSubmatchIndex(domain, -1) {
+		expression += regexp.QuoteMeta(domain[last:match[0]]) + `([^.]+)`
+		parameters = append(parameters, domain[match[2]:match[3]])
+		last = match[1]
+	}
+	expression += regexp.QuoteMeta(domain[last:]) + "$"
+	pattern := regexp.MustCompile(expression)
+
+	return func(ctx contractshttp.Context) {
+		host := ctx.Request().Host()
+		if hostname, _, err := net.SplitHostPort(host); err == nil {
+			host = hostname
+		}
+
+		matches := pattern.FindStringSubmatch(strings.TrimSuffix(host, "."))
+		if matches == nil {
+			ctx.Request().AbortWithStatus(contractshttp.StatusNotFound)
+			return
+		}
+
+		values := make(map[string]string, len(parameters))
+		if existing, ok := ctx.Value(DomainParametersKey).(map[string]string); ok {
+			for key, value := range existing {
+				values[key] = value
+			}
+		}
+		for i, parameter := range parameters {
+			values[parameter] = strings.ToLower(matches[i+1])
+		}
+		ctx.WithValue(DomainParametersKey, values)
+
+		ctx.Request().Next()
+	}
+}
+
+// DomainParameter Get a parameter of the domain matched by the request, e.g. the tenant of {tenant}.goravel.dev.
+func DomainParameter(ctx contractshttp.Context, key string) string {
+	if values, ok := ctx.Value(DomainParametersKey).(map[string]string); ok {
+		return values[key]
+	}
+
+	return ""
+}
@@ -0,0 +1,94 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	routemocks "github.com/goravel/framework/mocks/route"
+)
+
+// mockDomainContext Mock a context keeping the values, the values set by the middleware are read by DomainParameter.
+func mockDomainContext(t *testing.T, mockRequest *httpmocks.ContextRequest) *httpmocks.Context {
+	values := make(map[any]any)
+	mockContext := httpmocks.NewContext(t)
+	mockContext.EXPECT().Request().Return(mockRequest).Maybe()
+	mockContext.EXPECT().WithValue(mock.Anything, mock.Anything).Run(func(key string, value any) {
+		values[key] = value
+	}).Maybe()
+	mockContext.EXPECT().Value(mock.Anything).RunAndReturn(func(key any) any {
+		return values[key]
+	}).Maybe()
+
+	return mockContext
+}
+
+func TestDomainMiddleware(t *testing.T) {
+	middleware := DomainMiddleware("{tenant}.goravel.dev")
+
+	tests := []struct {
+		host   string
+		tenant string
+	}{
+		{host: "acme.goravel.dev", tenant: "acme"},
+		{host: "ACME.goravel.dev:3000", tenant: "acme"},
+		{host: "acme.goravel.dev.", tenant: "acme"},
+		{host: "goravel.dev"},
+		{host: "a.b.goravel.dev"},
+		{host: "acme.goravel.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.host, func(t *testing.T) {
+			mockRequest := httpmocks.NewContextRequest(t)
+			ctx := mockDomainContext(t, mockRequest)
+			mockRequest.EXPECT().Host().Return(test.host).Once()
+			if test.tenant == "" {
+				mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusNotFound).Once()
+			} else {
+				mockRequest.EXPECT().Next().Once()
+			}
+
+			middleware(ctx)
+			assert.Equal(t, test.tenant, DomainParameter(ctx, "tenant"))
+		})
+	}
+}
+
+func TestDomainMiddleware_Nested(t *testing.T) {
+	mockRequest := httpmocks.NewContextRequest(t)
+	ctx := mockDomainContext(t, mockRequest)
+	mockRequest.EXPECT().Host().Return("api.acme.goravel.dev").Twice()
+	mockRequest.EXPECT().Next().Twice()
+
+	DomainMiddleware("{service}.{tenant}.goravel.dev")(ctx)
+	DomainMiddleware("api.{tenant}.goravel.dev")(ctx)
+	assert.Equal(t, "api", DomainParameter(ctx, "service"))
+	assert.Equal(t, "acme", DomainParameter(ctx, "tenant"))
+	assert.Empty(t, DomainParameter(mockDomainContext(t, mockRequest), "tenant"))
+}
+
+func TestDomain(t *testing.T) {
+	mockRouter := routemocks.NewRouter(t)
+	mockRouter.EXPECT().Middleware(mock.Anything).Return(mockRouter).Once()
+	assert.Equal(t, mockRouter, Domain(mockRouter, "{tenant}.goravel.dev"))
+
+	mockDomainRouter := &domainRouter{Router: routemocks.NewRouter(t)}
+	assert.Equal(t, mockDomainRouter.Router, Domain(mockDomainRouter, "{tenant}.goravel.dev"))
+	assert.Equal(t, "{tenant}.goravel.dev", mockDomainRouter.domain)
+}
+
+type domainRouter struct {
+	*routemocks.Router
+	domain string
+}
+
+func (r *domainRouter) Domain(domain string) contractsroute.Router {
+	r.domain = domain
+
+	return r.Router
+}
@@ -0,0 +1,46 @@
+package route
+
+import (
+	"crypto/subtle"
+	nethttp "net/http"
+	"net/http/pprof"
+	"strings"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+)
+
+// Pprof Register the pprof endpoints under /debug/pprof, the requests must carry the token in the
+// "Authorization: Bearer <token>" header.
+func Pprof(router route.Router, token string) {
+	router.Prefix("/debug/pprof").Middleware(pprofAuth(token)).Group(func(router route.Router) {
+		router.Get("/", pprofHandler(pprof.Index))
+		router.Get("/cmdline", pprofHandler(pprof.Cmdline))
+		router.Get("/profile", pprofHandler(pprof.Profile))
+		router.Get("/symbol", pprofHandler(pprof.Symbol))
+		router.Post("/symbol", pprofHandler(pprof.Symbol))
+		router.Get("/trace", pprofHandler(pprof.Trace))
+		router.Get("/{name}", pprofHandler(pprof.Index))
+	})
+}
+
+// pprofAuth Abort the requests without the token, the endpoints are always rejected if the token is empty.
+func pprofAuth(token string) contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		given := strings.TrimPrefix(ctx.Request().Header("Authorization"), "Bearer ")
+		if token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			ctx.Request().AbortWithStatus(contractshttp.StatusUnauthorized)
+			return
+		}
+
+		ctx.Request().Next()
+	}
+}
+
+func pprofHandler(handler func(w nethttp.ResponseWriter, r *nethttp.Request)) contractshttp.HandlerFunc {
+	return func(ctx contractshttp.Context) contractshttp.Response {
+		handler(ctx.Response().Writer(), ctx.Request().Origin())
+
+		return nil
+	}
+}
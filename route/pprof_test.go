@@ -0,0 +1,76 @@
+package route
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	routemocks "github.com/goravel/framework/mocks/route"
+)
+
+func TestPprof(t *testing.T) {
+	mockRouter := routemocks.NewRouter(t)
+	mockRouter.EXPECT().Prefix("/debug/pprof").Return(mockRouter).Once()
+	mockRouter.EXPECT().Middleware(mock.Anything).Return(mockRouter).Once()
+	mockRouter.EXPECT().Group(mock.Anything).Run(func(handler contractsroute.GroupFunc) {
+		handler(mockRouter)
+	}).Once()
+
+	handlers := make(map[string]contractshttp.HandlerFunc)
+	for _, path := range []string{"/", "/cmdline", "/profile", "/symbol", "/trace", "/{name}"} {
+		mockRouter.EXPECT().Get(path, mock.Anything).Run(func(path string, handler contractshttp.HandlerFunc) {
+			handlers[path] = handler
+		}).Once()
+	}
+	mockRouter.EXPECT().Post("/symbol", mock.Anything).Once()
+
+	Pprof(mockRouter, "secret")
+
+	recorder := httptest.NewRecorder()
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockResponse := httpmocks.NewContextResponse(t)
+	mockContext.EXPECT().Request().Return(mockRequest).Once()
+	mockContext.EXPECT().Response().Return(mockResponse).Once()
+	mockRequest.EXPECT().Origin().Return(httptest.NewRequest(nethttp.MethodGet, "/debug/pprof/cmdline", nil)).Once()
+	mockResponse.EXPECT().Writer().Return(recorder).Once()
+
+	assert.Nil(t, handlers["/cmdline"](mockContext))
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.NotEmpty(t, recorder.Body.String())
+}
+
+func TestPprofAuth(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+		authorized    bool
+	}{
+		{name: "valid token", token: "secret", authorization: "Bearer secret", authorized: true},
+		{name: "invalid token", token: "secret", authorization: "Bearer wrong"},
+		{name: "empty token", token: "", authorization: "Bearer "},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockContext := httpmocks.NewContext(t)
+			mockRequest := httpmocks.NewContextRequest(t)
+			mockContext.EXPECT().Request().Return(mockRequest)
+			mockRequest.EXPECT().Header("Authorization").Return(test.authorization).Once()
+			if test.authorized {
+				mockRequest.EXPECT().Next().Once()
+			} else {
+				mockRequest.EXPECT().AbortWithStatus(contractshttp.StatusUnauthorized).Once()
+			}
+
+			pprofAuth(test.token)(mockContext)
+		})
+	}
+}
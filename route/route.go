@@ -1,10 +1,16 @@
 package route
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/goravel/framework/contracts/config"
+	contractshttp "github.com/goravel/framework/contracts/http"
 	"github.com/goravel/framework/contracts/route"
+	frameworkhttp "github.com/goravel/framework/http"
 	"github.com/goravel/framework/support/color"
 )
 
@@ -13,6 +19,9 @@ type Driver string
 type Route struct {
 	route.Route
 	config config.Config
+	mu     sync.Mutex
+	// terminator Whether the Terminator is registered, it's registered by the first GlobalMiddleware only.
+	terminator bool
 }
 
 func NewRoute(config config.Config) *Route {
@@ -49,3 +58,97 @@ func NewDriver(config config.Config, driver string) (route.Route, error) {
 
 	return nil, fmt.Errorf("init route driver fail: route must be implement route.Route or func() (route.Route, error)")
 }
+
+// GlobalMiddleware Register the global middleware ordered by the http.middleware_priority config, a list of the names
+// given by http.Named. The named middleware in the list keep their relative order of the list and the others keep
+// their positions. The Terminator is registered first by the first call, so the
+// Terminate hooks of the terminatable middleware run once the request is handled.
+func (r *Route) GlobalMiddleware(middlewares ...contractshttp.Middleware) {
+	priority, _ := r.config.Get("http.middleware_priority").([]string)
+	middlewares = sortByPriority(middlewares, priority)
+
+	r.mu.Lock()
+	if !r.terminator {
+		r.terminator = true
+		middlewares = append([]contractshttp.Middleware{frameworkhttp.Terminator()}, middlewares...)
+	}
+	r.mu.Unlock()
+
+	r.Route.GlobalMiddleware(middlewares...)
+}
+
+// Shutdown Stop the server from accepting new connections, and wait for the in-flight requests and the Terminate hooks
+// to finish until the context is done. The http.shutdown_timeout config (30 seconds by default) is applied if the context has no deadline.
+func (r *Route) Shutdown(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(r.config.GetInt("http.shutdown_timeout", 30))*time.Second)
+		defer cancel()
+	}
+
+	if err := r.Route.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	return frameworkhttp.WaitTerminating(ctx)
+}
+
+// sortByPriority Sort the middleware in the priority list by the list, the slots of them are kept, so the others
+// don't move.
+func sortByPriority(middlewares []contractshttp.Middleware, priority []string) []contractshttp.Middleware {
+	if len(priority) == 0 {
+		return middlewares
+	}
+
+	ranks := make(map[string]int, len(priority))
+	for i, name := range priority {
+		if ranks[name] == 0 {
+			ranks[name] = i + 1
+		}
+	}
+
+	var slots, slotRanks []int
+	var prioritized []contractshttp.Middleware
+	for i, middleware := range middlewares {
+		name, ok := frameworkhttp.MiddlewareName(middleware)
+		if !ok {
+			continue
+		}
+		if rank, exist := ranks[name]; exist {
+			slots = append(slots, i)
+			slotRanks = append(slotRanks, rank)
+			prioritized = append(prioritized, middleware)
+		}
+	}
+	sort.Stable(byRank{middlewares: prioritized, ranks: slotRanks})
+
+	sorted := append([]contractshttp.Middleware{}, middlewares...)
+	for i, slot := range slots {
+		sorted[slot] = prioritized[i]
+	}
+
+	return sorted
+}
+
+// byRank Sort the middleware by their ranks in the priority list.
+type byRank struct {
+	middlewares []contractshttp.Middleware
+	ranks       []int
+}
+
+func (r byRank) Len() int {
+	return len(r.middlewares)
+}
+
+func (r byRank) Less(i, j int) bool {
+	return r.ranks[i] < r.ranks[j]
+}
+
+func (r byRank) Swap(i, j int) {
+	r.middlewares[i], r.middlewares[j] = r.middlewares[j], r.middlewares[i]
+	r.ranks[i], r.ranks[j] = r.ranks[j], r.ranks[i]
+}
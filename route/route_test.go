@@ -0,0 +1,122 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	frameworkhttp "github.com/goravel/framework/http"
+	configmocks "github.com/goravel/framework/mocks/config"
+	routemocks "github.com/goravel/framework/mocks/route"
+)
+
+func TestRun(t *testing.T) {
+	// The servers are run by the driver.
+	mockRoute := routemocks.NewRoute(t)
+	mockRoute.EXPECT().Run("127.0.0.1:3000").Return(nil).Once()
+	mockRoute.EXPECT().RunTLS().Return(nil).Once()
+	mockRoute.EXPECT().RunTLSWithCert("127.0.0.1:3000", "cert", "key").Return(nil).Once()
+	route := &Route{Route: mockRoute, config: configmocks.NewConfig(t)}
+
+	assert.Nil(t, route.Run("127.0.0.1:3000"))
+	assert.Nil(t, route.RunTLS())
+	assert.Nil(t, route.RunTLSWithCert("127.0.0.1:3000", "cert", "key"))
+}
+
+func TestShutdown(t *testing.T) {
+	// The timeout of the config is applied if the context has no deadline.
+	mockConfig := configmocks.NewConfig(t)
+	mockConfig.EXPECT().GetInt("http.shutdown_timeout", 30).Return(5).Once()
+	mockRoute := routemocks.NewRoute(t)
+	var deadline time.Time
+	mockRoute.EXPECT().Shutdown(mock.Anything).RunAndReturn(func(ctx context.Context) error {
+		deadline, _ = ctx.Deadline()
+
+		return nil
+	}).Once()
+	route := &Route{Route: mockRoute, config: mockConfig}
+
+	assert.Nil(t, route.Shutdown(context.Background()))
+	assert.WithinDuration(t, time.Now().Add(5*time.Second), deadline, time.Second)
+
+	// The error of the driver is returned.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	mockRoute.EXPECT().Shutdown(ctx).Return(assert.AnError).Once()
+	assert.Equal(t, assert.AnError, route.Shutdown(ctx))
+
+	// The route isn't configured.
+	route = nil
+	assert.Nil(t, route.Shutdown(context.Background()))
+}
+
+func TestGlobalMiddleware(t *testing.T) {
+	var order []string
+	// The middleware wrapped by the same function are ordered by their names.
+	wrap := func(name string) contractshttp.Middleware {
+		return func(ctx contractshttp.Context) { order = append(order, name) }
+	}
+	auth := frameworkhttp.Named("auth", wrap("auth"))
+	cors := wrap("cors")
+	session := frameworkhttp.Named("session", wrap("session"))
+	throttle := frameworkhttp.Named("throttle", wrap("throttle"))
+
+	tests := []struct {
+		name     string
+		priority any
+		expected []string
+	}{
+		{name: "without priority", priority: nil, expected: []string{"auth", "cors", "session", "throttle"}},
+		{name: "with priority", priority: []string{"session", "auth"}, expected: []string{"session", "cors", "auth", "throttle"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			order = nil
+			mockConfig := configmocks.NewConfig(t)
+			mockRoute := routemocks.NewRoute(t)
+			mockConfig.EXPECT().Get("http.middleware_priority").Return(test.priority).Once()
+
+			var registered []contractshttp.Middleware
+			mockRoute.EXPECT().GlobalMiddleware(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(middlewares ...contractshttp.Middleware) {
+				registered = middlewares
+			}).Once()
+
+			route := &Route{Route: mockRoute, config: mockConfig}
+			route.GlobalMiddleware(auth, cors, session, throttle)
+
+			// The Terminator runs first.
+			assert.Len(t, registered, 5)
+			assert.Equal(t, source(frameworkhttp.Terminator()), source(registered[0]))
+			for _, middleware := range registered[1:] {
+				middleware(nil)
+			}
+			assert.Equal(t, test.expected, order)
+
+			// The Terminator is registered once.
+			mockConfig.EXPECT().Get("http.middleware_priority").Return(test.priority).Once()
+			mockRoute.EXPECT().GlobalMiddleware(mock.Anything).Run(func(middlewares ...contractshttp.Middleware) {
+				registered = middlewares
+			}).Once()
+			route.GlobalMiddleware(auth)
+			assert.Len(t, registered, 1)
+			name, _ := frameworkhttp.MiddlewareName(registered[0])
+			assert.Equal(t, "auth", name)
+		})
+	}
+}
+
+// source Get the source position of a middleware, the closures inlined into the other packages share it.
+func source(middleware contractshttp.Middleware) string {
+	pc := reflect.ValueOf(middleware).Pointer()
+	file, line := runtime.FuncForPC(pc).FileLine(pc)
+
+	return fmt.Sprintf("%s:%d", file, line)
+}
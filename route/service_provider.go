@@ -1,10 +1,16 @@
 package route
 
 import (
+	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/foundation"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	routeconsole "github.com/goravel/framework/route/console"
 )
 
 const Binding = "goravel.route"
+const BindingURL = "goravel.url"
+
+var URLFacade contractsroute.URL
 
 type ServiceProvider struct {
 }
@@ -13,8 +19,50 @@ func (route *ServiceProvider) Register(app foundation.Application) {
 	app.Singleton(Binding, func(app foundation.Application) (any, error) {
 		return NewRoute(app.MakeConfig()), nil
 	})
+	app.Singleton(BindingURL, func(app foundation.Application) (any, error) {
+		return NewURL(app.MakeConfig(), app.MakeRoute), nil
+	})
 }
 
 func (route *ServiceProvider) Boot(app foundation.Application) {
+	URLFacade = app.MakeURL()
+
+	route.registerCommands(app)
+	route.registerPprof(app)
+}
+
+func (route *ServiceProvider) registerCommands(app foundation.Application) {
+	app.MakeArtisan().Register([]console.Command{
+		routeconsole.NewListCommand(app.MakeRoute()),
+		routeconsole.NewGenerateCommand(),
+		routeconsole.NewCacheCommand(route.router(app), CacheFile()),
+		routeconsole.NewClearCommand(CacheFile()),
+	})
+	app.Optimizes("route:cache", "route:clear")
+}
+
+// registerPprof Expose the pprof endpoints if they are enabled, they are disabled by default.
+func (route *ServiceProvider) registerPprof(app foundation.Application) {
+	config := app.MakeConfig()
+	if !config.GetBool("http.pprof.enabled") {
+		return
+	}
+
+	router := route.router(app)
+	if router == nil {
+		return
+	}
+
+	Pprof(router, config.GetString("http.pprof.token"))
+}
+
+// router Get the router, it's nil if the http driver isn't configured.
+func (route *ServiceProvider) router(app foundation.Application) contractsroute.Route {
+	// NewRoute returns nil if the http driver isn't configured.
+	router := app.MakeRoute()
+	if instance, ok := router.(*Route); router == nil || ok && instance == nil {
+		return nil
+	}
 
+	return router
 }
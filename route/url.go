@@ -0,0 +1,207 @@
+package route
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/support"
+)
+
+// routeParameter Match the parameters of the route paths, e.g. {id}, {id?}, :id and *path.
+var routeParameter = regexp.MustCompile(`\{(\w+)\??}|[:*](\w+)`)
+
+// URL Generate the URLs of the named routes. The signatures are the HMAC of the path and the query string of the URLs
+// keyed by the app key, so the signed URLs stay valid behind proxies changing the scheme or the host.
+type URL struct {
+	config    config.Config
+	route     func() route.Route
+	cacheOnce sync.Once
+	cache     map[string]string
+}
+
+// CacheFile Get the path of the route cache file written by the "route:cache" command, the named routes not registered
+// by the process, e.g. the routes of the queue workers, are resolved by the file.
+func CacheFile() string {
+	return filepath.Join(support.RelativePath, "storage", "framework", "routes.json")
+}
+
+func NewURL(config config.Config, route func() route.Route) *URL {
+	return &URL{config: config, route: route}
+}
+
+func (r *URL) Route(name string, parameters ...map[string]any) (string, error) {
+	path, query, err := r.resolve(name, parameters...)
+	if err != nil {
+		return "", err
+	}
+
+	return r.absolute(path, query.Encode()), nil
+}
+
+func (r *URL) SignedRoute(name string, parameters ...map[string]any) (string, error) {
+	return r.signedRoute(name, time.Time{}, parameters...)
+}
+
+func (r *URL) TemporarySignedRoute(name string, expiration time.Duration, parameters ...map[string]any) (string, error) {
+	return r.signedRoute(name, time.Now().Add(expiration), parameters...)
+}
+
+func (r *URL) HasValidSignature(url string) bool {
+	parsed, err := neturl.Parse(url)
+	if err != nil {
+		return false
+	}
+
+	query := parsed.Query()
+	signature := query.Get("signature")
+	if signature == "" {
+		return false
+	}
+	query.Del("signature")
+
+	if expires := query.Get("expires"); expires != "" && time.Now().Unix() > cast.ToInt64(expires) {
+		return false
+	}
+
+	expected, err := r.sign(parsed.EscapedPath(), query.Encode())
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+func (r *URL) signedRoute(name string, expires time.Time, parameters ...map[string]any) (string, error) {
+	path, query, err := r.resolve(name, parameters...)
+	if err != nil {
+		return "", err
+	}
+	if query.Has("signature") || query.Has("expires") {
+		return "", errors.New("signature and expires are reserved parameters")
+	}
+
+	if !expires.IsZero() {
+		query.Set("expires", cast.ToString(expires.Unix()))
+	}
+
+	signature, err := r.sign(path, query.Encode())
+	if err != nil {
+		return "", err
+	}
+	query.Set("signature", signature)
+
+	return r.absolute(path, query.Encode()), nil
+}
+
+// resolve Get the path of a named route filled by the parameters, and the query of the other parameters.
+func (r *URL) resolve(name string, parameters ...map[string]any) (string, neturl.Values, error) {
+	var pattern string
+	if instance := r.route(); instance != nil {
+		for _, info := range instance.GetRoutes() {
+			if info.Name == name {
+				pattern = info.Path
+				break
+			}
+		}
+	}
+	if pattern == "" {
+		pattern = r.cached(name)
+	}
+	if pattern == "" {
+		return "", nil, fmt.Errorf("route [%s] not defined", name)
+	}
+
+	values := make(map[string]any)
+	for _, item := range parameters {
+		for key, value := range item {
+			values[key] = value
+		}
+	}
+
+	var missing []string
+	path := routeParameter.ReplaceAllStringFunc(pattern, func(segment string) string {
+		match := routeParameter.FindStringSubmatch(segment)
+		key := match[1] + match[2]
+		value, exist := values[key]
+		if !exist {
+			if !strings.HasSuffix(segment, "?}") {
+				missing = append(missing, key)
+			}
+
+			return ""
+		}
+		delete(values, key)
+
+		return neturl.PathEscape(cast.ToString(value))
+	})
+	if len(missing) > 0 {
+		return "", nil, fmt.Errorf("missing parameters [%s] of route [%s]", strings.Join(missing, ", "), name)
+	}
+	if path = strings.ReplaceAll(path, "//", "/"); len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	query := neturl.Values{}
+	for key, value := range values {
+		switch value.(type) {
+		case []string, []any:
+			for _, item := range cast.ToStringSlice(value) {
+				query.Add(key, item)
+			}
+		default:
+			query.Set(key, cast.ToString(value))
+		}
+	}
+
+	return path, query, nil
+}
+
+// cached Get the path of a named route from the route cache file, the file is read once.
+func (r *URL) cached(name string) string {
+	r.cacheOnce.Do(func() {
+		content, err := os.ReadFile(CacheFile())
+		if err != nil {
+			return
+		}
+
+		_ = json.Unmarshal(content, &r.cache)
+	})
+
+	return r.cache[name]
+}
+
+func (r *URL) sign(path, query string) (string, error) {
+	key := r.config.GetString("app.key")
+	if key == "" {
+		return "", errors.New("the app key is required to sign the URLs")
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(path + "?" + query))
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (r *URL) absolute(path, query string) string {
+	url := strings.TrimSuffix(r.config.GetString("app.url"), "/") + path
+	if query != "" {
+		url += "?" + query
+	}
+
+	return url
+}
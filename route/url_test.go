@@ -0,0 +1,120 @@
+package route
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	contractsroute "github.com/goravel/framework/contracts/route"
+	configmocks "github.com/goravel/framework/mocks/config"
+	routemocks "github.com/goravel/framework/mocks/route"
+	"github.com/goravel/framework/support"
+	"github.com/goravel/framework/support/file"
+)
+
+type URLTestSuite struct {
+	suite.Suite
+	mockConfig *configmocks.Config
+	mockRoute  *routemocks.Route
+	url        *URL
+}
+
+func TestURLTestSuite(t *testing.T) {
+	suite.Run(t, new(URLTestSuite))
+}
+
+func (s *URLTestSuite) SetupTest() {
+	s.mockConfig = configmocks.NewConfig(s.T())
+	s.mockRoute = routemocks.NewRoute(s.T())
+	s.mockRoute.EXPECT().GetRoutes().Return([]contractsroute.Info{
+		{Method: "GET", Path: "/users/{id}/posts/{post?}", Name: "users.posts"},
+		{Method: "GET", Path: "/unsubscribe", Name: "unsubscribe"},
+	}).Maybe()
+	s.mockConfig.EXPECT().GetString("app.url").Return("https://goravel.dev/").Maybe()
+	s.mockConfig.EXPECT().GetString("app.key").Return("12345678901234567890123456789012").Maybe()
+	s.url = NewURL(s.mockConfig, func() contractsroute.Route {
+		return s.mockRoute
+	})
+}
+
+func (s *URLTestSuite) TestRoute() {
+	url, err := s.url.Route("users.posts", map[string]any{"id": 1, "post": "hello world"})
+	s.Nil(err)
+	s.Equal("https://goravel.dev/users/1/posts/hello%20world", url)
+
+	url, err = s.url.Route("users.posts", map[string]any{"id": 1}, map[string]any{"page": 2, "tags": []string{"a", "b"}})
+	s.Nil(err)
+	s.Equal("https://goravel.dev/users/1/posts?page=2&tags=a&tags=b", url)
+
+	_, err = s.url.Route("users.posts")
+	s.EqualError(err, "missing parameters [id] of route [users.posts]")
+
+	_, err = s.url.Route("missing")
+	s.EqualError(err, "route [missing] not defined")
+}
+
+func (s *URLTestSuite) TestRoute_Cached() {
+	support.RelativePath = s.T().TempDir()
+	defer func() {
+		support.RelativePath = ""
+	}()
+	s.Nil(file.Create(CacheFile(), `{"users.show":"/users/{id}"}`))
+
+	url := NewURL(s.mockConfig, func() contractsroute.Route {
+		return nil
+	})
+	result, err := url.Route("users.show", map[string]any{"id": 1})
+	s.Nil(err)
+	s.Equal("https://goravel.dev/users/1", result)
+
+	_, err = url.Route("users.posts")
+	s.EqualError(err, "route [users.posts] not defined")
+}
+
+func (s *URLTestSuite) TestSignedRoute() {
+	url, err := s.url.SignedRoute("unsubscribe", map[string]any{"user": 1})
+	s.Nil(err)
+	s.Contains(url, "https://goravel.dev/unsubscribe?signature=")
+	s.True(s.url.HasValidSignature(url))
+	s.True(s.url.HasValidSignature("/unsubscribe?" + url[len("https://goravel.dev/unsubscribe?"):]))
+
+	s.False(s.url.HasValidSignature(url + "&admin=1"))
+	s.False(s.url.HasValidSignature("https://goravel.dev/unsubscribe?user=1"))
+
+	_, err = s.url.SignedRoute("unsubscribe", map[string]any{"signature": "1"})
+	s.EqualError(err, "signature and expires are reserved parameters")
+}
+
+func (s *URLTestSuite) TestSignedRoute_EscapedParameters() {
+	url, err := s.url.SignedRoute("users.posts", map[string]any{"id": 1, "post": "a b/c%d;e"})
+	s.Nil(err)
+	s.Contains(url, "https://goravel.dev/users/1/posts/a%20b%2Fc%25d%3Be?signature=")
+	s.True(s.url.HasValidSignature(url))
+
+	s.False(s.url.HasValidSignature(strings.Replace(url, "a%20b%2Fc", "a%20b/c", 1)))
+}
+
+func (s *URLTestSuite) TestTemporarySignedRoute() {
+	url, err := s.url.TemporarySignedRoute("unsubscribe", time.Minute, map[string]any{"user": 1})
+	s.Nil(err)
+	s.Contains(url, "expires=")
+	s.True(s.url.HasValidSignature(url))
+
+	url, err = s.url.TemporarySignedRoute("unsubscribe", -time.Minute)
+	s.Nil(err)
+	s.False(s.url.HasValidSignature(url))
+}
+
+func (s *URLTestSuite) TestWithoutAppKey() {
+	mockConfig := configmocks.NewConfig(s.T())
+	mockConfig.EXPECT().GetString("app.key").Return("").Twice()
+	url := NewURL(mockConfig, func() contractsroute.Route {
+		return s.mockRoute
+	})
+
+	_, err := url.SignedRoute("unsubscribe")
+	s.EqualError(err, "the app key is required to sign the URLs")
+	s.False(url.HasValidSignature("https://goravel.dev/unsubscribe?signature=1"))
+}
@@ -1,6 +1,8 @@
 package schedule
 
 import (
+	"context"
+	"sync"
 	"time"
 
 	"github.com/robfig/cron/v3"
@@ -9,15 +11,18 @@ import (
 	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/log"
 	"github.com/goravel/framework/contracts/schedule"
+	"github.com/goravel/framework/support/calendar"
 	"github.com/goravel/framework/support/carbon"
 )
 
 type Application struct {
-	artisan console.Artisan
-	cache   cache.Cache
-	cron    *cron.Cron
-	log     log.Log
-	debug   bool
+	artisan   console.Artisan
+	cache     cache.Cache
+	calendars *calendar.Repository
+	cron      *cron.Cron
+	deferred  sync.Map
+	log       log.Log
+	debug     bool
 }
 
 func NewApplication(artisan console.Artisan, cache cache.Cache, log log.Log, debug bool) *Application {
@@ -49,6 +54,21 @@ func (app *Application) Run() {
 	app.cron.Run()
 }
 
+// Shutdown Stop the scheduler from running new events, and wait for the running events to finish until the context
+// is done.
+func (app *Application) Shutdown(ctx context.Context) error {
+	if app.cron == nil {
+		return nil
+	}
+
+	select {
+	case <-app.cron.Stop().Done():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (app *Application) addEvents(events []schedule.Event) {
 	for _, event := range events {
 		chain := cron.NewChain(cron.Recover(NewLogger(app.log, app.debug)))
@@ -67,16 +87,60 @@ func (app *Application) addEvents(events []schedule.Event) {
 
 func (app *Application) getJob(event schedule.Event) cron.Job {
 	return cron.FuncJob(func() {
-		if event.IsOnOneServer() && event.GetName() != "" {
-			if app.cache.Lock(event.GetName()+carbon.Now().Format("Hi"), 1*time.Hour).Get() {
-				app.runJob(event)
-			}
-		} else {
-			app.runJob(event)
+		if app.checkCalendar(event) {
+			app.runEvent(event)
 		}
 	})
 }
 
+// checkCalendar Determine if the event should run now, an event due during an exclusion of its calendar is skipped,
+// or deferred to the end of the exclusion once.
+func (app *Application) checkCalendar(event schedule.Event) bool {
+	name := event.GetCalendar()
+	if name == "" || app.calendars == nil {
+		return true
+	}
+
+	instance, err := app.calendars.Get(name)
+	if err != nil {
+		app.log.Errorf("load schedule calendar error: %v", err)
+
+		return false
+	}
+
+	now := carbon.Now().StdTime()
+	next, err := instance.Next(now)
+	if err != nil {
+		app.log.Errorf("load schedule calendar error: %v", err)
+
+		return false
+	}
+	if !next.After(now) {
+		return true
+	}
+
+	if event.GetDeferOnCalendar() {
+		if _, deferred := app.deferred.LoadOrStore(event, true); !deferred {
+			time.AfterFunc(next.Sub(now), func() {
+				app.deferred.Delete(event)
+				app.runEvent(event)
+			})
+		}
+	}
+
+	return false
+}
+
+func (app *Application) runEvent(event schedule.Event) {
+	if event.IsOnOneServer() && event.GetName() != "" {
+		if app.cache.Lock(event.GetName()+carbon.Now().Format("Hi"), 1*time.Hour).Get() {
+			app.runJob(event)
+		}
+	} else {
+		app.runJob(event)
+	}
+}
+
 func (app *Application) runJob(event schedule.Event) {
 	if event.GetCommand() != "" {
 		app.artisan.Call(event.GetCommand())
@@ -2,6 +2,7 @@ package schedule
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -10,8 +11,10 @@ import (
 
 	"github.com/goravel/framework/contracts/schedule"
 	cachemocks "github.com/goravel/framework/mocks/cache"
+	configmocks "github.com/goravel/framework/mocks/config"
 	consolemocks "github.com/goravel/framework/mocks/console"
 	logmocks "github.com/goravel/framework/mocks/log"
+	"github.com/goravel/framework/support/calendar"
 	"github.com/goravel/framework/support/carbon"
 )
 
@@ -149,3 +152,66 @@ func (s *ApplicationTestSuite) TestOnOneServer() {
 	mockArtisan.AssertExpectations(s.T())
 	mockCache.AssertExpectations(s.T())
 }
+
+func (s *ApplicationTestSuite) TestCheckCalendar() {
+	now := time.Now().UTC()
+	mockConfig := &configmocks.Config{}
+	mockConfig.On("Get", "calendars.maintenance").Return(map[string]any{})
+	mockConfig.On("GetString", "app.timezone", "UTC").Return("UTC")
+	mockConfig.On("GetString", "calendars.maintenance.timezone", "UTC").Return("UTC")
+	mockConfig.On("Get", "calendars.maintenance.dates").Return(nil)
+	mockConfig.On("Get", "calendars.maintenance.windows").Return([]map[string]any{
+		{"start": now.Add(-time.Hour).Format(time.DateTime), "end": now.Add(time.Second).Format(time.DateTime)},
+	})
+	mockConfig.On("Get", "calendars.maintenance.cron").Return(nil)
+	mockConfig.On("GetString", "calendars.maintenance.table").Return("")
+	mockConfig.On("Get", "calendars.missing").Return(nil)
+
+	mockLog := &logmocks.Log{}
+	mockLog.On("Errorf", "load schedule calendar error: %v", mock.Anything).Return().Once()
+
+	app := NewApplication(nil, nil, mockLog, false)
+	app.calendars = calendar.NewRepository(mockConfig, nil)
+
+	var skipped, deferred atomic.Int32
+	s.True(app.checkCalendar(app.Call(func() {})))
+	s.False(app.checkCalendar(app.Call(func() {}).SkipOn("missing")))
+
+	// An event due during an exclusion is skipped.
+	skip := app.Call(func() { skipped.Add(1) }).SkipOn("maintenance")
+	s.False(app.checkCalendar(skip))
+
+	// An event due during an exclusion runs once at the end of the exclusion.
+	deferring := app.Call(func() { deferred.Add(1) }).DeferOn("maintenance")
+	s.False(app.checkCalendar(deferring))
+	s.False(app.checkCalendar(deferring))
+	s.Eventually(func() bool { return deferred.Load() == 1 }, 3*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	s.Equal(int32(1), deferred.Load())
+	s.Equal(int32(0), skipped.Load())
+
+	s.True(app.checkCalendar(skip))
+	mockLog.AssertExpectations(s.T())
+}
+
+func (s *ApplicationTestSuite) TestShutdown() {
+	app := NewApplication(nil, nil, &logmocks.Log{}, false)
+	s.Nil(app.Shutdown(context.Background()))
+
+	app.Register([]schedule.Event{
+		app.Call(func() {}).EveryMinute(),
+	})
+	stopped := make(chan struct{})
+	go func() {
+		app.Run()
+		close(stopped)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	s.Nil(app.Shutdown(context.Background()))
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		s.Fail("the scheduler isn't stopped")
+	}
+}
@@ -7,9 +7,11 @@ import (
 )
 
 type Event struct {
+	calendar            string
 	callback            func()
 	command             string
 	cron                string
+	deferOnCalendar     bool
 	delayIfStillRunning bool
 	name                string
 	onOneServer         bool
@@ -55,6 +57,15 @@ func (receiver *Event) DailyAt(time string) schedule.Event {
 	}
 }
 
+// DeferOn Defer the event to the end of the exclusion if it is due during an exclusion of the calendar, the
+// event runs once at the end of the exclusion however many times it is due during the exclusion.
+func (receiver *Event) DeferOn(calendar string) schedule.Event {
+	receiver.calendar = calendar
+	receiver.deferOnCalendar = true
+
+	return receiver
+}
+
 // DelayIfStillRunning Do not allow the event to overlap each other.
 func (receiver *Event) DelayIfStillRunning() schedule.Event {
 	receiver.delayIfStillRunning = true
@@ -150,6 +161,14 @@ func (receiver *Event) GetName() string {
 	return receiver.name
 }
 
+func (receiver *Event) GetCalendar() string {
+	return receiver.calendar
+}
+
+func (receiver *Event) GetDeferOnCalendar() bool {
+	return receiver.deferOnCalendar
+}
+
 func (receiver *Event) GetSkipIfStillRunning() bool {
 	return receiver.skipIfStillRunning
 }
@@ -184,6 +203,14 @@ func (receiver *Event) OnOneServer() schedule.Event {
 	return receiver
 }
 
+// SkipOn Skip the event if it is due during an exclusion of the calendar.
+func (receiver *Event) SkipOn(calendar string) schedule.Event {
+	receiver.calendar = calendar
+	receiver.deferOnCalendar = false
+
+	return receiver
+}
+
 // SkipIfStillRunning Do not allow the event to overlap each other.
 func (receiver *Event) SkipIfStillRunning() schedule.Event {
 	receiver.skipIfStillRunning = true
@@ -108,3 +108,15 @@ func (s *EventTestSuite) TestDelayIfStillRunning() {
 	s.event.DelayIfStillRunning()
 	s.True(s.event.GetDelayIfStillRunning())
 }
+
+func (s *EventTestSuite) TestSkipOn() {
+	s.event.DeferOn("billing").SkipOn("holidays")
+	s.Equal("holidays", s.event.GetCalendar())
+	s.False(s.event.GetDeferOnCalendar())
+}
+
+func (s *EventTestSuite) TestDeferOn() {
+	s.event.DeferOn("billing")
+	s.Equal("billing", s.event.GetCalendar())
+	s.True(s.event.GetDeferOnCalendar())
+}
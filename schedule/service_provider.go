@@ -2,6 +2,7 @@ package schedule
 
 import (
 	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/support/calendar"
 )
 
 const Binding = "goravel.schedule"
@@ -12,7 +13,10 @@ type ServiceProvider struct {
 func (receiver *ServiceProvider) Register(app foundation.Application) {
 	app.Singleton(Binding, func(app foundation.Application) (any, error) {
 		config := app.MakeConfig()
-		return NewApplication(app.MakeArtisan(), app.MakeCache(), app.MakeLog(), config.GetBool("app.debug")), nil
+		schedule := NewApplication(app.MakeArtisan(), app.MakeCache(), app.MakeLog(), config.GetBool("app.debug"))
+		schedule.calendars = calendar.NewRepository(config, app.MakeOrm)
+
+		return schedule, nil
 	})
 }
 
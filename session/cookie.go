@@ -0,0 +1,52 @@
+package session
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	sessioncontract "github.com/goravel/framework/contracts/session"
+	"github.com/goravel/framework/support/carbon"
+)
+
+// CsrfCookie The cookie carrying the CSRF token, the SPAs read it and send it back by the X-XSRF-TOKEN header.
+const CsrfCookie = "XSRF-TOKEN"
+
+// csrfCookieKey The context key marking the CSRF cookie is added to the response.
+const csrfCookieKey = "GoravelCsrfCookie"
+
+// AddSessionCookie Add the cookie of the session ID to the response.
+func AddSessionCookie(ctx http.Context, session sessioncontract.Session) {
+	ctx.Response().Cookie(http.Cookie{
+		Name:     session.GetName(),
+		Value:    session.GetID(),
+		Expires:  carbon.Now().AddMinutes(ConfigFacade.GetInt("session.lifetime")).StdTime(),
+		Path:     ConfigFacade.GetString("session.path"),
+		Domain:   ConfigFacade.GetString("session.domain"),
+		Secure:   ConfigFacade.GetBool("session.secure"),
+		HttpOnly: ConfigFacade.GetBool("session.http_only"),
+		SameSite: ConfigFacade.GetString("session.same_site"),
+	})
+}
+
+// AddCsrfCookie Add the cookie of the CSRF token of the session to the response, the cookie is readable by the scripts.
+func AddCsrfCookie(ctx http.Context) {
+	ctx.Response().Cookie(http.Cookie{
+		Name:     CsrfCookie,
+		Value:    ctx.Request().Session().Token(),
+		MaxAge:   ConfigFacade.GetInt("session.lifetime") * 60,
+		Path:     ConfigFacade.GetString("session.path"),
+		Domain:   ConfigFacade.GetString("session.domain"),
+		Secure:   ConfigFacade.GetBool("session.secure"),
+		HttpOnly: false,
+		SameSite: ConfigFacade.GetString("session.same_site"),
+	})
+	ctx.WithValue(csrfCookieKey, true)
+}
+
+// AddCookies Add the cookies of the session ID and the CSRF token to the response again after the session of the request
+// is regenerated, e.g. by the login, since the middlewares add the cookies before the handler. The CSRF cookie is only
+// added if it's added before.
+func AddCookies(ctx http.Context) {
+	AddSessionCookie(ctx, ctx.Request().Session())
+	if added, _ := ctx.Value(csrfCookieKey).(bool); added {
+		AddCsrfCookie(ctx)
+	}
+}
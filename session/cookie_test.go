@@ -0,0 +1,68 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	configmocks "github.com/goravel/framework/mocks/config"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	sessionmocks "github.com/goravel/framework/mocks/session"
+)
+
+func TestAddCookies(t *testing.T) {
+	tests := []struct {
+		name string
+		csrf bool
+	}{
+		{
+			name: "the CSRF cookie isn't added if it isn't added before",
+		},
+		{
+			name: "the CSRF cookie is added again if it's added before",
+			csrf: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockConfig := configmocks.NewConfig(t)
+			mockConfig.EXPECT().GetInt("session.lifetime").Return(120)
+			mockConfig.EXPECT().GetString("session.path").Return("/")
+			mockConfig.EXPECT().GetString("session.domain").Return("")
+			mockConfig.EXPECT().GetBool("session.secure").Return(false)
+			mockConfig.EXPECT().GetBool("session.http_only").Return(true).Once()
+			mockConfig.EXPECT().GetString("session.same_site").Return("lax")
+			ConfigFacade = mockConfig
+
+			mockContext := httpmocks.NewContext(t)
+			mockRequest := httpmocks.NewContextRequest(t)
+			mockResponse := httpmocks.NewContextResponse(t)
+			mockSession := sessionmocks.NewSession(t)
+			mockContext.EXPECT().Request().Return(mockRequest)
+			mockContext.EXPECT().Response().Return(mockResponse)
+			mockRequest.EXPECT().Session().Return(mockSession)
+			mockSession.EXPECT().GetName().Return("goravel_session").Once()
+			mockSession.EXPECT().GetID().Return("regenerated").Once()
+			mockResponse.EXPECT().Cookie(mock.MatchedBy(func(cookie contractshttp.Cookie) bool {
+				return cookie.Name == "goravel_session" && cookie.Value == "regenerated" && cookie.HttpOnly
+			})).Return(mockResponse).Once()
+			mockContext.EXPECT().Value(csrfCookieKey).Return(test.csrf).Once()
+
+			if test.csrf {
+				mockSession.EXPECT().Token().Return("token").Once()
+				mockResponse.EXPECT().Cookie(contractshttp.Cookie{
+					Name:     CsrfCookie,
+					Value:    "token",
+					MaxAge:   7200,
+					Path:     "/",
+					SameSite: "lax",
+				}).Return(mockResponse).Once()
+				mockContext.EXPECT().WithValue(csrfCookieKey, true).Once()
+			}
+
+			AddCookies(mockContext)
+		})
+	}
+}
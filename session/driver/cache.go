@@ -0,0 +1,50 @@
+package driver
+
+import (
+	"time"
+
+	"github.com/goravel/framework/contracts/cache"
+)
+
+// Cache Store the sessions in a cache store, e.g. Redis, the sessions expire with the items, so Gc does nothing.
+type Cache struct {
+	minutes int
+	store   cache.Driver
+}
+
+func NewCache(store cache.Driver, minutes int) *Cache {
+	return &Cache{
+		minutes: minutes,
+		store:   store,
+	}
+}
+
+func (c *Cache) Close() error {
+	return nil
+}
+
+func (c *Cache) Destroy(id string) error {
+	c.store.Forget(c.key(id))
+
+	return nil
+}
+
+func (c *Cache) Gc(int) error {
+	return nil
+}
+
+func (c *Cache) Open(string, string) error {
+	return nil
+}
+
+func (c *Cache) Read(id string) (string, error) {
+	return c.store.GetString(c.key(id)), nil
+}
+
+func (c *Cache) Write(id string, data string) error {
+	return c.store.Put(c.key(id), data, time.Duration(c.minutes)*time.Minute)
+}
+
+func (c *Cache) key(id string) string {
+	return "session:" + id
+}
@@ -0,0 +1,132 @@
+package driver
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/goravel/framework/contracts/crypt"
+	"github.com/goravel/framework/contracts/http"
+	sessioncontract "github.com/goravel/framework/contracts/session"
+	"github.com/goravel/framework/support/carbon"
+)
+
+// maxCookieSize The size limit of a cookie of the browsers.
+const maxCookieSize = 4096
+
+// Cookie Store the sessions in the encrypted cookies named by the session IDs, so Gc does nothing. The payloads are
+// read from the request and written to the response, so the driver is bound to a request by WithContext, the unbound
+// driver reads and writes nothing.
+type Cookie struct {
+	cookie  http.Cookie
+	crypt   crypt.Crypt
+	ctx     http.Context
+	minutes int
+	// read The ID of the session read from the request, its cookie is expired once the session is written with
+	// another ID, e.g. after the session is regenerated.
+	read string
+}
+
+type cookiePayload struct {
+	Data    string `json:"data"`
+	Expires int64  `json:"expires"`
+}
+
+// NewCookie Create the driver, the path, domain, secure, http only and same site attributes of the cookie are used by
+// the written cookies.
+func NewCookie(crypt crypt.Crypt, cookie http.Cookie, minutes int) *Cookie {
+	return &Cookie{
+		cookie:  cookie,
+		crypt:   crypt,
+		minutes: minutes,
+	}
+}
+
+// WithContext Get a copy of the driver bound to the request of the context.
+func (c *Cookie) WithContext(ctx http.Context) sessioncontract.Driver {
+	bound := *c
+	bound.ctx = ctx
+
+	return &bound
+}
+
+func (c *Cookie) Close() error {
+	return nil
+}
+
+func (c *Cookie) Destroy(id string) error {
+	if c.ctx == nil {
+		return nil
+	}
+
+	c.ctx.Response().WithoutCookie(id)
+
+	return nil
+}
+
+func (c *Cookie) Gc(int) error {
+	return nil
+}
+
+func (c *Cookie) Open(string, string) error {
+	return nil
+}
+
+// Read The payloads that can't be decrypted or are expired are ignored, so the session starts empty.
+func (c *Cookie) Read(id string) (string, error) {
+	if c.ctx == nil {
+		return "", nil
+	}
+
+	value := c.ctx.Request().Cookie(id)
+	if value == "" {
+		return "", nil
+	}
+	c.read = id
+
+	decrypted, err := c.crypt.DecryptString(value)
+	if err != nil {
+		return "", nil
+	}
+
+	var payload cookiePayload
+	if err := json.Unmarshal([]byte(decrypted), &payload); err != nil || payload.Expires < carbon.Now().Timestamp() {
+		return "", nil
+	}
+
+	return payload.Data, nil
+}
+
+func (c *Cookie) Write(id string, data string) error {
+	if c.ctx == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(cookiePayload{
+		Data:    data,
+		Expires: carbon.Now().AddMinutes(c.minutes).Timestamp(),
+	})
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := c.crypt.EncryptString(string(payload))
+	if err != nil {
+		return err
+	}
+	if len(id)+len(encrypted) > maxCookieSize {
+		return errors.New("the session is too large to be stored in a cookie")
+	}
+
+	cookie := c.cookie
+	cookie.Name = id
+	cookie.Value = encrypted
+	cookie.MaxAge = c.minutes * 60
+	c.ctx.Response().Cookie(cookie)
+
+	if c.read != "" && c.read != id {
+		c.ctx.Response().WithoutCookie(c.read)
+		c.read = id
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package driver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	cryptmocks "github.com/goravel/framework/mocks/crypt"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	"github.com/goravel/framework/support/carbon"
+)
+
+func TestCookie(t *testing.T) {
+	carbon.SetTestNow(carbon.FromDateTime(2024, 1, 1, 0, 0, 0))
+	defer carbon.UnsetTestNow()
+
+	mockCrypt := cryptmocks.NewCrypt(t)
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockResponse := httpmocks.NewContextResponse(t)
+	mockContext.EXPECT().Request().Return(mockRequest).Maybe()
+	mockContext.EXPECT().Response().Return(mockResponse).Maybe()
+
+	unbound := NewCookie(mockCrypt, http.Cookie{Path: "/", HttpOnly: true, SameSite: "lax"}, 120)
+	driver := unbound.WithContext(mockContext)
+	payload := `{"data":"bar","expires":1704074400}`
+
+	// The unbound driver reads and writes nothing.
+	value, err := unbound.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+	assert.Nil(t, unbound.Write("foo", "bar"))
+
+	mockCrypt.EXPECT().EncryptString(payload).Return("encrypted", nil).Once()
+	mockResponse.EXPECT().Cookie(http.Cookie{
+		Name:     "foo",
+		Value:    "encrypted",
+		MaxAge:   7200,
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: "lax",
+	}).Return(mockResponse).Once()
+	assert.Nil(t, driver.Write("foo", "bar"))
+
+	mockRequest.EXPECT().Cookie("foo").Return("encrypted").Once()
+	mockCrypt.EXPECT().DecryptString("encrypted").Return(payload, nil).Once()
+	value, err = driver.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", value)
+
+	// The payloads that can't be decrypted or are expired are ignored.
+	mockRequest.EXPECT().Cookie("foo").Return("tampered").Once()
+	mockCrypt.EXPECT().DecryptString("tampered").Return("", errors.New("invalid")).Once()
+	value, err = driver.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+
+	mockRequest.EXPECT().Cookie("foo").Return("expired").Once()
+	mockCrypt.EXPECT().DecryptString("expired").Return(`{"data":"bar","expires":1704067199}`, nil).Once()
+	value, err = driver.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+
+	mockCrypt.EXPECT().EncryptString(`{"data":"large","expires":1704074400}`).Return(strings.Repeat("a", 4096), nil).Once()
+	assert.EqualError(t, driver.Write("foo", "large"), "the session is too large to be stored in a cookie")
+
+	// The cookie of the session read from the request is expired once the session is written with another ID.
+	mockCrypt.EXPECT().EncryptString(payload).Return("encrypted", nil).Once()
+	mockResponse.EXPECT().Cookie(http.Cookie{
+		Name:     "regenerated",
+		Value:    "encrypted",
+		MaxAge:   7200,
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: "lax",
+	}).Return(mockResponse).Once()
+	mockResponse.EXPECT().WithoutCookie("foo").Return(mockResponse).Once()
+	assert.Nil(t, driver.Write("regenerated", "bar"))
+
+	mockResponse.EXPECT().WithoutCookie("foo").Return(mockResponse).Once()
+	assert.Nil(t, driver.Destroy("foo"))
+	assert.Nil(t, driver.Gc(60))
+}
@@ -0,0 +1,68 @@
+package driver
+
+import (
+	"github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/support/carbon"
+)
+
+// Database Store the sessions in a table with the columns: id (string, primary key), payload (text) and
+// last_activity (integer, indexed, the unix time of the last write).
+type Database struct {
+	minutes int
+	orm     func() orm.Orm
+	table   string
+}
+
+type databaseSession struct {
+	ID           string `gorm:"column:id;primaryKey"`
+	Payload      string `gorm:"column:payload"`
+	LastActivity int64  `gorm:"column:last_activity"`
+}
+
+func NewDatabase(orm func() orm.Orm, table string, minutes int) *Database {
+	return &Database{
+		minutes: minutes,
+		orm:     orm,
+		table:   table,
+	}
+}
+
+func (d *Database) Close() error {
+	return nil
+}
+
+func (d *Database) Destroy(id string) error {
+	_, err := d.query().Where("id", id).Delete(&databaseSession{})
+
+	return err
+}
+
+func (d *Database) Gc(maxLifetime int) error {
+	_, err := d.query().Where("last_activity < ?", carbon.Now().SubSeconds(maxLifetime).Timestamp()).Delete(&databaseSession{})
+
+	return err
+}
+
+func (d *Database) Open(string, string) error {
+	return nil
+}
+
+// Read The expired sessions are ignored, even if they haven't been collected yet.
+func (d *Database) Read(id string) (string, error) {
+	var session databaseSession
+	if err := d.query().Where("id", id).Where("last_activity >= ?", carbon.Now().SubMinutes(d.minutes).Timestamp()).First(&session); err != nil {
+		return "", err
+	}
+
+	return session.Payload, nil
+}
+
+// Write The session is saved by an upsert, the row is updated by the primary key and inserted on conflict if it
+// isn't updated, so the concurrent writes of a new session don't fail.
+func (d *Database) Write(id string, data string) error {
+	return d.query().Save(&databaseSession{ID: id, Payload: data, LastActivity: carbon.Now().Timestamp()})
+}
+
+func (d *Database) query() orm.Query {
+	return d.orm().Query().Table(d.table)
+}
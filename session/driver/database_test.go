@@ -0,0 +1,46 @@
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	ormmocks "github.com/goravel/framework/mocks/database/orm"
+	"github.com/goravel/framework/support/carbon"
+)
+
+func TestDatabase(t *testing.T) {
+	carbon.SetTestNow(carbon.FromDateTime(2024, 1, 1, 0, 0, 0))
+	defer carbon.UnsetTestNow()
+
+	mockOrm := ormmocks.NewOrm(t)
+	mockQuery := ormmocks.NewQuery(t)
+	mockOrm.EXPECT().Query().Return(mockQuery)
+	mockQuery.EXPECT().Table("sessions").Return(mockQuery)
+	driver := NewDatabase(func() contractsorm.Orm {
+		return mockOrm
+	}, "sessions", 120)
+	now := carbon.Now().Timestamp()
+
+	mockQuery.EXPECT().Save(&databaseSession{ID: "foo", Payload: "bar", LastActivity: now}).Return(nil).Once()
+	assert.Nil(t, driver.Write("foo", "bar"))
+
+	mockQuery.EXPECT().Where("id", "foo").Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("last_activity >= ?", now-120*60).Return(mockQuery).Once()
+	mockQuery.EXPECT().First(mock.Anything).Run(func(dest any) {
+		dest.(*databaseSession).Payload = "baz"
+	}).Return(nil).Once()
+	value, err := driver.Read("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "baz", value)
+
+	mockQuery.EXPECT().Where("id", "foo").Return(mockQuery).Once()
+	mockQuery.EXPECT().Delete(&databaseSession{}).Return(&contractsorm.Result{RowsAffected: 1}, nil).Once()
+	assert.Nil(t, driver.Destroy("foo"))
+
+	mockQuery.EXPECT().Where("last_activity < ?", now-60).Return(mockQuery).Once()
+	mockQuery.EXPECT().Delete(&databaseSession{}).Return(&contractsorm.Result{}, nil).Once()
+	assert.Nil(t, driver.Gc(60))
+}
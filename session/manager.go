@@ -3,17 +3,27 @@ package session
 import (
 	"fmt"
 
+	"github.com/goravel/framework/contracts/cache"
 	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/crypt"
+	"github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/contracts/foundation"
+	"github.com/goravel/framework/contracts/http"
 	sessioncontract "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/session/driver"
 )
 
 type Manager struct {
-	config        config.Config
+	// cache Resolve the cache of the cache and redis drivers, it's nil if the cache isn't registered.
+	cache  func() cache.Cache
+	config config.Config
+	// crypt Resolve the crypt of the cookie driver, it's nil if the crypt isn't registered.
+	crypt         func() crypt.Crypt
 	customDrivers map[string]sessioncontract.Driver
 	drivers       map[string]sessioncontract.Driver
 	json          foundation.Json
+	// orm Resolve the orm of the database driver, it's nil if the database isn't registered.
+	orm func() orm.Orm
 }
 
 func NewManager(config config.Config, json foundation.Json) *Manager {
@@ -44,11 +54,13 @@ func (m *Manager) Driver(name ...string) (sessioncontract.Driver, error) {
 	}
 
 	if m.drivers[driverName] == nil {
-		if m.customDrivers[driverName] == nil {
+		if m.customDrivers[driverName] != nil {
+			m.drivers[driverName] = m.customDrivers[driverName]
+		} else if created := m.createDriver(driverName); created != nil {
+			m.drivers[driverName] = created
+		} else {
 			return nil, fmt.Errorf("driver [%s] not supported", driverName)
 		}
-
-		m.drivers[driverName] = m.customDrivers[driverName]
 	}
 
 	return m.drivers[driverName], nil
@@ -63,6 +75,65 @@ func (m *Manager) getDefaultDriver() string {
 	return m.config.GetString("session.driver")
 }
 
+// createDriver Create the drivers depending on the other modules when they are used, so the modules are required
+// only by the applications using the drivers.
+func (m *Manager) createDriver(name string) sessioncontract.Driver {
+	switch name {
+	case "cache", "redis":
+		if m.cache == nil {
+			return nil
+		}
+		instance := m.cache()
+		if instance == nil {
+			return nil
+		}
+
+		// The redis driver uses the redis cache store unless another store is set, the cache driver uses the
+		// default cache store.
+		var defaultStore string
+		if name == "redis" {
+			defaultStore = "redis"
+		}
+
+		var store cache.Driver = instance
+		if storeName := m.config.GetString("session.store", defaultStore); storeName != "" {
+			store = instance.Store(storeName)
+		}
+
+		return driver.NewCache(store, m.config.GetInt("session.lifetime"))
+	case "cookie":
+		if m.crypt == nil {
+			return nil
+		}
+		instance := m.crypt()
+		if instance == nil {
+			return nil
+		}
+
+		return driver.NewCookie(instance, http.Cookie{
+			Path:     m.config.GetString("session.path"),
+			Domain:   m.config.GetString("session.domain"),
+			Secure:   m.config.GetBool("session.secure"),
+			HttpOnly: m.config.GetBool("session.http_only"),
+			SameSite: m.config.GetString("session.same_site"),
+		}, m.config.GetInt("session.lifetime"))
+	case "database":
+		if m.orm == nil {
+			return nil
+		}
+
+		return driver.NewDatabase(func() orm.Orm {
+			if connection := m.config.GetString("session.connection"); connection != "" {
+				return m.orm().Connection(connection)
+			}
+
+			return m.orm()
+		}, m.config.GetString("session.table", "sessions"), m.config.GetInt("session.lifetime"))
+	}
+
+	return nil
+}
+
 func (m *Manager) createFileDriver() sessioncontract.Driver {
 	lifetime := m.config.GetInt("session.lifetime")
 	return driver.NewFile(m.config.GetString("session.files"), lifetime)
@@ -7,10 +7,16 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/goravel/framework/contracts/cache"
+	"github.com/goravel/framework/contracts/crypt"
+	"github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/contracts/foundation"
 	sessioncontract "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/foundation/json"
+	mockcache "github.com/goravel/framework/mocks/cache"
 	mockconfig "github.com/goravel/framework/mocks/config"
+	mockcrypt "github.com/goravel/framework/mocks/crypt"
+	mockorm "github.com/goravel/framework/mocks/database/orm"
 	"github.com/goravel/framework/support/str"
 )
 
@@ -28,7 +34,7 @@ func TestManagerTestSuite(t *testing.T) {
 func (m *ManagerTestSuite) SetupTest() {
 	m.mockConfig = mockconfig.NewConfig(m.T())
 	m.mockConfig.On("GetInt", "session.lifetime").Return(120).Once()
-	m.mockConfig.On("GetString", "session.files").Return("storage/framework/sessions").Once()
+	m.mockConfig.On("GetString", "session.files").Return(m.T().TempDir()).Once()
 	m.manager = m.getManager()
 	m.json = json.NewJson()
 }
@@ -72,6 +78,61 @@ func (m *ManagerTestSuite) TestDriver() {
 	m.Nil(driver)
 }
 
+func (m *ManagerTestSuite) TestCreateDriver() {
+	// the drivers aren't supported without the modules
+	driver, err := m.manager.Driver("redis")
+	m.EqualError(err, "driver [redis] not supported")
+	m.Nil(driver)
+
+	// The cache facade is nil if the cache provider isn't registered.
+	m.manager.cache = func() cache.Cache {
+		return nil
+	}
+	driver, err = m.manager.Driver("redis")
+	m.EqualError(err, "driver [redis] not supported")
+	m.Nil(driver)
+
+	mockCache := mockcache.NewCache(m.T())
+	mockStore := mockcache.NewDriver(m.T())
+	m.manager.cache = func() cache.Cache {
+		return mockCache
+	}
+	m.mockConfig.EXPECT().GetString("session.store", "redis").Return("redis").Once()
+	m.mockConfig.EXPECT().GetInt("session.lifetime").Return(120).Once()
+	mockCache.EXPECT().Store("redis").Return(mockStore).Once()
+	driver, err = m.manager.Driver("redis")
+	m.Nil(err)
+	m.Equal("*driver.Cache", fmt.Sprintf("%T", driver))
+
+	m.mockConfig.EXPECT().GetString("session.store", "").Return("").Once()
+	m.mockConfig.EXPECT().GetInt("session.lifetime").Return(120).Once()
+	driver, err = m.manager.Driver("cache")
+	m.Nil(err)
+	m.Equal("*driver.Cache", fmt.Sprintf("%T", driver))
+
+	m.manager.orm = func() orm.Orm {
+		return mockorm.NewOrm(m.T())
+	}
+	m.mockConfig.EXPECT().GetString("session.table", "sessions").Return("sessions").Once()
+	m.mockConfig.EXPECT().GetInt("session.lifetime").Return(120).Once()
+	driver, err = m.manager.Driver("database")
+	m.Nil(err)
+	m.Equal("*driver.Database", fmt.Sprintf("%T", driver))
+
+	m.manager.crypt = func() crypt.Crypt {
+		return mockcrypt.NewCrypt(m.T())
+	}
+	m.mockConfig.EXPECT().GetString("session.path").Return("/").Once()
+	m.mockConfig.EXPECT().GetString("session.domain").Return("").Once()
+	m.mockConfig.EXPECT().GetBool("session.secure").Return(false).Once()
+	m.mockConfig.EXPECT().GetBool("session.http_only").Return(true).Once()
+	m.mockConfig.EXPECT().GetString("session.same_site").Return("lax").Once()
+	m.mockConfig.EXPECT().GetInt("session.lifetime").Return(120).Once()
+	driver, err = m.manager.Driver("cookie")
+	m.Nil(err)
+	m.Equal("*driver.Cookie", fmt.Sprintf("%T", driver))
+}
+
 func (m *ManagerTestSuite) TestExtend() {
 	m.manager.Extend("test", func() sessioncontract.Driver {
 		return NewCustomDriver()
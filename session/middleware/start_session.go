@@ -1,12 +1,21 @@
 package middleware
 
 import (
+	nethttp "net/http"
+
 	"github.com/goravel/framework/contracts/http"
+	sessioncontract "github.com/goravel/framework/contracts/session"
+	frameworkhttp "github.com/goravel/framework/http"
 	"github.com/goravel/framework/session"
-	"github.com/goravel/framework/support/carbon"
 	"github.com/goravel/framework/support/color"
 )
 
+// contextDriver is implemented by the session drivers reading the request and writing the response, e.g. the
+// cookie driver, they are bound to the request before the session is built.
+type contextDriver interface {
+	WithContext(ctx http.Context) sessioncontract.Driver
+}
+
 func StartSession() http.Middleware {
 	return func(ctx http.Context) {
 		req := ctx.Request()
@@ -24,6 +33,10 @@ func StartSession() http.Middleware {
 			req.Next()
 			return
 		}
+		contextual, writesResponse := driver.(contextDriver)
+		if writesResponse {
+			driver = contextual.WithContext(ctx)
+		}
 
 		// Build session
 		s := session.SessionFacade.BuildSession(driver)
@@ -34,24 +47,67 @@ func StartSession() http.Middleware {
 		req.SetSession(s)
 
 		// Set session cookie in response
-		config := session.ConfigFacade
-		ctx.Response().Cookie(http.Cookie{
-			Name:     s.GetName(),
-			Value:    s.GetID(),
-			Expires:  carbon.Now().AddMinutes(config.GetInt("session.lifetime")).StdTime(),
-			Path:     config.GetString("session.path"),
-			Domain:   config.GetString("session.domain"),
-			Secure:   config.GetBool("session.secure"),
-			HttpOnly: config.GetBool("session.http_only"),
-			SameSite: config.GetString("session.same_site"),
-		})
-
-		// Continue processing request
-		req.Next()
+		session.AddSessionCookie(ctx, s)
 
-		// Save session
-		if err := s.Save(); err != nil {
-			color.Red().Printf("Error saving session: %s\n", err)
+		saver := &sessionSaver{session: s}
+
+		// The drivers writing the response save the session before the headers are written, so they require the
+		// writer of the response to be replaced, an error is reported otherwise, since the session can't be sent.
+		var setter http.ResponseWriterSetter
+		if writesResponse {
+			setter, _ = frameworkhttp.WriterSetter(ctx, "StartSession")
+		}
+		if setter != nil {
+			writer := ctx.Response().Writer()
+			setter.SetWriter(&savingWriter{ResponseWriter: writer, saver: saver})
+			req.Next()
+			setter.SetWriter(writer)
+		} else {
+			req.Next()
 		}
+
+		// Save session
+		saver.save()
+	}
+}
+
+// sessionSaver Save the session once, the flash data is aged by every save.
+type sessionSaver struct {
+	session sessioncontract.Session
+	saved   bool
+}
+
+func (r *sessionSaver) save() {
+	if r.saved {
+		return
+	}
+
+	r.saved = true
+	if err := r.session.Save(); err != nil {
+		color.Red().Printf("Error saving session: %s\n", err)
+	}
+}
+
+// savingWriter Save the session before the headers of the response are written.
+type savingWriter struct {
+	nethttp.ResponseWriter
+	saver *sessionSaver
+}
+
+func (r *savingWriter) WriteHeader(status int) {
+	r.saver.save()
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *savingWriter) Write(data []byte) (int, error) {
+	r.saver.save()
+
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *savingWriter) Flush() {
+	r.saver.save()
+	if flusher, ok := r.ResponseWriter.(nethttp.Flusher); ok {
+		flusher.Flush()
 	}
 }
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"io"
 	nethttp "net/http"
 	"net/http/httptest"
 	"net/url"
@@ -15,9 +16,11 @@ import (
 	contractshttp "github.com/goravel/framework/contracts/http"
 	contractsession "github.com/goravel/framework/contracts/session"
 	"github.com/goravel/framework/contracts/validation"
+	"github.com/goravel/framework/crypt"
 	"github.com/goravel/framework/foundation/json"
 	configmocks "github.com/goravel/framework/mocks/config"
 	"github.com/goravel/framework/session"
+	"github.com/goravel/framework/session/driver"
 	"github.com/goravel/framework/support/file"
 )
 
@@ -80,6 +83,60 @@ func TestStartSession(t *testing.T) {
 	mockConfig.AssertExpectations(t)
 }
 
+func TestStartSession_CookieDriver(t *testing.T) {
+	mockConfig := &configmocks.Config{}
+	session.ConfigFacade = mockConfig
+	mockConfig.On("GetString", "session.driver").Return("cookie")
+	mockConfig.On("GetString", "session.cookie").Return("goravel_session")
+	mockConfig.On("GetString", "session.files").Return("storage/framework/sessions")
+	mockConfig.On("GetInt", "session.lifetime").Return(120)
+	mockConfig.On("GetString", "session.path").Return("/")
+	mockConfig.On("GetString", "session.domain").Return("")
+	mockConfig.On("GetBool", "session.secure").Return(false)
+	mockConfig.On("GetBool", "session.http_only").Return(true)
+	mockConfig.On("GetString", "session.same_site").Return("")
+	mockConfig.On("GetString", "app.key").Return("12345678901234567890123456789012")
+	manager := session.NewManager(mockConfig, json.NewJson())
+	manager.Extend("cookie", func() contractsession.Driver {
+		return driver.NewCookie(crypt.NewAES(mockConfig, json.NewJson()), contractshttp.Cookie{Path: "/", HttpOnly: true}, 120)
+	})
+	session.SessionFacade = manager
+
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		StartSession()(NewTestContext(r.Context(), nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			s := r.Context().Value("session").(contractsession.Session)
+			switch r.URL.Path {
+			case "/add":
+				s.Put("foo", "bar")
+			case "/get":
+				assert.Equal(t, "bar", s.Get("foo"))
+			}
+
+			// The body is written by the handler, the session cookie is still sent.
+			_, err := w.Write([]byte("ok"))
+			assert.Nil(t, err)
+		}), w, r))
+	}))
+	defer server.Close()
+
+	resp, err := nethttp.Get(server.URL + "/add")
+	require.NoError(t, err)
+	cookies := resp.Cookies()
+	require.Len(t, cookies, 2)
+	assert.Equal(t, "goravel_session", cookies[0].Name)
+	assert.Equal(t, cookies[0].Value, cookies[1].Name)
+
+	req, err := nethttp.NewRequest("GET", server.URL+"/get", nil)
+	require.NoError(t, err)
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	resp, err = nethttp.DefaultClient.Do(req)
+	require.NoError(t, err)
+	assert.Len(t, resp.Cookies(), 2)
+	assert.False(t, file.Exists("storage"))
+}
+
 type TestContext struct {
 	ctx     context.Context
 	next    nethttp.Handler
@@ -382,12 +439,20 @@ func (r *TestResponse) Stream(int, func(contractshttp.StreamWriter) error) contr
 	panic("do not need to implement it")
 }
 
+func (r *TestResponse) StreamDownload(string, func(io.Writer) error) contractshttp.Response {
+	panic("do not need to implement it")
+}
+
 func (r *TestResponse) WithoutCookie(string) contractshttp.ContextResponse {
 	panic("do not need to implement it")
 }
 
 func (r *TestResponse) Writer() nethttp.ResponseWriter {
-	panic("do not need to implement it")
+	return r.ctx.writer
+}
+
+func (r *TestResponse) SetWriter(writer nethttp.ResponseWriter) {
+	r.ctx.writer = writer
 }
 
 func (r *TestResponse) Flush() {
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"html/template"
+	"strings"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/session"
+	"github.com/goravel/framework/support/str"
+)
+
+const (
+	// CsrfCookie The cookie carrying the token, the SPAs read it and send it back by the X-XSRF-TOKEN header.
+	CsrfCookie = session.CsrfCookie
+	// CsrfField The form field carrying the token.
+	CsrfField = "_token"
+	// StatusTokenMismatch The status of the requests whose token doesn't match the session, the page is expired.
+	StatusTokenMismatch = 419
+)
+
+// VerifyCsrfToken Reject the state-changing requests whose token doesn't match the token of the session, the token
+// is read from the _token field, the X-CSRF-TOKEN header or the X-XSRF-TOKEN header. The except paths can end
+// with *, e.g. "/webhooks/*". It must be used after StartSession.
+func VerifyCsrfToken(except ...string) http.Middleware {
+	return func(ctx http.Context) {
+		req := ctx.Request()
+		if isReading(req.Method()) || str.MatchPath(req.Path(), except...) || tokensMatch(req) {
+			if req.HasSession() {
+				session.AddCsrfCookie(ctx)
+			}
+
+			req.Next()
+			return
+		}
+
+		req.AbortWithStatus(StatusTokenMismatch)
+	}
+}
+
+// CsrfToken Get the token of the session, it's empty if the request has no session.
+func CsrfToken(ctx http.Context) string {
+	if !ctx.Request().HasSession() {
+		return ""
+	}
+
+	return ctx.Request().Session().Token()
+}
+
+// CsrfFieldHTML Get the hidden input carrying the token, it's embedded in the forms of the views.
+func CsrfFieldHTML(ctx http.Context) template.HTML {
+	return template.HTML(`<input type="hidden" name="` + CsrfField + `" value="` + template.HTMLEscapeString(CsrfToken(ctx)) + `">`)
+}
+
+func isReading(method string) bool {
+	switch strings.ToUpper(method) {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	}
+
+	return false
+}
+
+func tokensMatch(req http.ContextRequest) bool {
+	if !req.HasSession() {
+		return false
+	}
+
+	token := req.Input(CsrfField)
+	if token == "" {
+		token = req.Header("X-CSRF-TOKEN")
+	}
+	if token == "" {
+		token = req.Header("X-XSRF-TOKEN")
+	}
+
+	sessionToken := req.Session().Token()
+
+	return token != "" && sessionToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(sessionToken)) == 1
+}
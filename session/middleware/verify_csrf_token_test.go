@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	configmocks "github.com/goravel/framework/mocks/config"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	sessionmocks "github.com/goravel/framework/mocks/session"
+	"github.com/goravel/framework/session"
+)
+
+func TestVerifyCsrfToken(t *testing.T) {
+	var (
+		mockContext  *httpmocks.Context
+		mockRequest  *httpmocks.ContextRequest
+		mockResponse *httpmocks.ContextResponse
+		mockSession  *sessionmocks.Session
+	)
+
+	expectCookie := func() {
+		mockConfig := configmocks.NewConfig(t)
+		mockConfig.EXPECT().GetInt("session.lifetime").Return(120).Once()
+		mockConfig.EXPECT().GetString("session.path").Return("/").Once()
+		mockConfig.EXPECT().GetString("session.domain").Return("").Once()
+		mockConfig.EXPECT().GetBool("session.secure").Return(false).Once()
+		mockConfig.EXPECT().GetString("session.same_site").Return("lax").Once()
+		session.ConfigFacade = mockConfig
+
+		mockRequest.EXPECT().Session().Return(mockSession).Once()
+		mockResponse.EXPECT().Cookie(contractshttp.Cookie{
+			Name:     CsrfCookie,
+			Value:    "token",
+			MaxAge:   7200,
+			Path:     "/",
+			SameSite: "lax",
+		}).Return(mockResponse).Once()
+		mockContext.EXPECT().WithValue("GoravelCsrfCookie", true).Once()
+	}
+
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "the reading request is served",
+			setup: func() {
+				mockRequest.EXPECT().Method().Return("GET").Once()
+				mockRequest.EXPECT().HasSession().Return(true).Once()
+				expectCookie()
+				mockRequest.EXPECT().Next().Once()
+			},
+		},
+		{
+			name: "the request of the except paths is served",
+			setup: func() {
+				mockRequest.EXPECT().Method().Return("POST").Once()
+				mockRequest.EXPECT().Path().Return("/webhooks/stripe").Once()
+				mockRequest.EXPECT().HasSession().Return(false).Once()
+				mockRequest.EXPECT().Next().Once()
+			},
+		},
+		{
+			name: "the request with the token field is served",
+			setup: func() {
+				mockRequest.EXPECT().Method().Return("POST").Once()
+				mockRequest.EXPECT().Path().Return("/users").Once()
+				mockRequest.EXPECT().HasSession().Return(true).Twice()
+				mockRequest.EXPECT().Input(CsrfField).Return("token").Once()
+				mockRequest.EXPECT().Session().Return(mockSession).Once()
+				expectCookie()
+				mockRequest.EXPECT().Next().Once()
+			},
+		},
+		{
+			name: "the request with the XSRF header is served",
+			setup: func() {
+				mockRequest.EXPECT().Method().Return("DELETE").Once()
+				mockRequest.EXPECT().Path().Return("/users/1").Once()
+				mockRequest.EXPECT().HasSession().Return(true).Twice()
+				mockRequest.EXPECT().Input(CsrfField).Return("").Once()
+				mockRequest.EXPECT().Header("X-CSRF-TOKEN").Return("").Once()
+				mockRequest.EXPECT().Header("X-XSRF-TOKEN").Return("token").Once()
+				mockRequest.EXPECT().Session().Return(mockSession).Once()
+				expectCookie()
+				mockRequest.EXPECT().Next().Once()
+			},
+		},
+		{
+			name: "the request with the wrong token is aborted",
+			setup: func() {
+				mockRequest.EXPECT().Method().Return("POST").Once()
+				mockRequest.EXPECT().Path().Return("/users").Once()
+				mockRequest.EXPECT().HasSession().Return(true).Once()
+				mockRequest.EXPECT().Input(CsrfField).Return("wrong").Once()
+				mockRequest.EXPECT().Session().Return(mockSession).Once()
+				mockRequest.EXPECT().AbortWithStatus(StatusTokenMismatch).Once()
+			},
+		},
+		{
+			name: "the request without session is aborted",
+			setup: func() {
+				mockRequest.EXPECT().Method().Return("PUT").Once()
+				mockRequest.EXPECT().Path().Return("/users").Once()
+				mockRequest.EXPECT().HasSession().Return(false).Once()
+				mockRequest.EXPECT().AbortWithStatus(StatusTokenMismatch).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockContext = httpmocks.NewContext(t)
+			mockRequest = httpmocks.NewContextRequest(t)
+			mockResponse = httpmocks.NewContextResponse(t)
+			mockSession = sessionmocks.NewSession(t)
+			mockContext.EXPECT().Request().Return(mockRequest)
+			mockContext.EXPECT().Response().Return(mockResponse).Maybe()
+			mockSession.EXPECT().Token().Return("token").Maybe()
+
+			test.setup()
+			VerifyCsrfToken("/webhooks/*")(mockContext)
+		})
+	}
+}
+
+func TestCsrfFieldHTML(t *testing.T) {
+	mockContext := httpmocks.NewContext(t)
+	mockRequest := httpmocks.NewContextRequest(t)
+	mockSession := sessionmocks.NewSession(t)
+	mockContext.EXPECT().Request().Return(mockRequest)
+	mockRequest.EXPECT().HasSession().Return(true).Once()
+	mockRequest.EXPECT().Session().Return(mockSession).Once()
+	mockSession.EXPECT().Token().Return(`a"b`).Once()
+
+	assert.Equal(t, `<input type="hidden" name="_token" value="a&#34;b">`, string(CsrfFieldHTML(mockContext)))
+
+	mockRequest.EXPECT().HasSession().Return(false).Once()
+	assert.Empty(t, CsrfToken(mockContext))
+}
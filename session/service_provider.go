@@ -23,7 +23,12 @@ func (receiver *ServiceProvider) Register(app foundation.Application) {
 	app.Singleton(Binding, func(app foundation.Application) (any, error) {
 		c := app.MakeConfig()
 		j := app.GetJson()
-		return NewManager(c, j), nil
+		manager := NewManager(c, j)
+		manager.cache = app.MakeCache
+		manager.crypt = app.MakeCrypt
+		manager.orm = app.MakeOrm
+
+		return manager, nil
 	})
 }
 
@@ -0,0 +1,139 @@
+package calendar
+
+import (
+	"errors"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// maxExclusions The maximum number of consecutive exclusions Next skips, a calendar that excludes all the time
+// would loop forever otherwise.
+const maxExclusions = 10000
+
+// Window A period excluded from a calendar, from Start inclusive to End exclusive.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Calendar The holidays and maintenance windows that scheduled events and jobs skip or are deferred past.
+type Calendar struct {
+	dates     map[string]struct{}
+	location  *time.Location
+	recurring []recurring
+	windows   []Window
+}
+
+type recurring struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+func New(location *time.Location) *Calendar {
+	if location == nil {
+		location = time.UTC
+	}
+
+	return &Calendar{
+		dates:    make(map[string]struct{}),
+		location: location,
+	}
+}
+
+// ExcludeDates Exclude the whole days of the dates (2006-01-02) in the location of the calendar, e.g. holidays.
+func (r *Calendar) ExcludeDates(dates ...string) error {
+	for _, date := range dates {
+		parsed, err := time.ParseInLocation(time.DateOnly, date, r.location)
+		if err != nil {
+			return err
+		}
+
+		r.dates[parsed.Format(time.DateOnly)] = struct{}{}
+	}
+
+	return nil
+}
+
+// ExcludeWindow Exclude the period from start to end, e.g. a planned maintenance.
+func (r *Calendar) ExcludeWindow(start, end time.Time) *Calendar {
+	if end.After(start) {
+		r.windows = append(r.windows, Window{Start: start, End: end})
+	}
+
+	return r
+}
+
+// ExcludeCron Exclude the duration after each time of the cron expression, e.g. "0 2 * * 0" for 2h is a weekly
+// maintenance window starting at 02:00 on Sundays.
+func (r *Calendar) ExcludeCron(expression string, duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New("the duration of a recurring exclusion should be positive")
+	}
+
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return err
+	}
+	if spec, ok := schedule.(*cron.SpecSchedule); ok && spec.Location == time.Local {
+		spec.Location = r.location
+	}
+
+	r.recurring = append(r.recurring, recurring{schedule: schedule, duration: duration})
+
+	return nil
+}
+
+// Excludes Determine if the time is excluded by the calendar.
+func (r *Calendar) Excludes(t time.Time) bool {
+	_, excluded := r.exclusionEnd(t)
+
+	return excluded
+}
+
+// Next Get the first time from t that isn't excluded by the calendar, t is returned if it isn't excluded.
+func (r *Calendar) Next(t time.Time) (time.Time, error) {
+	for i := 0; i < maxExclusions; i++ {
+		end, excluded := r.exclusionEnd(t)
+		if !excluded {
+			return t, nil
+		}
+
+		t = end
+	}
+
+	return time.Time{}, errors.New("the calendar excludes all the time")
+}
+
+// exclusionEnd Get the latest end of the exclusions containing t.
+func (r *Calendar) exclusionEnd(t time.Time) (time.Time, bool) {
+	var end time.Time
+	excluded := false
+	extend := func(candidate time.Time) {
+		if !excluded || candidate.After(end) {
+			end = candidate
+		}
+		excluded = true
+	}
+
+	local := t.In(r.location)
+	if _, ok := r.dates[local.Format(time.DateOnly)]; ok {
+		year, month, day := local.Date()
+		extend(time.Date(year, month, day+1, 0, 0, 0, 0, r.location))
+	}
+
+	for _, window := range r.windows {
+		if !t.Before(window.Start) && t.Before(window.End) {
+			extend(window.End)
+		}
+	}
+
+	for _, recurring := range r.recurring {
+		// The first start after t - duration is the start of the window containing t if it isn't after t.
+		if start := recurring.schedule.Next(t.Add(-recurring.duration)); !start.IsZero() && !start.After(t) {
+			extend(start.Add(recurring.duration))
+		}
+	}
+
+	return end, excluded
+}
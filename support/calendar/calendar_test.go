@@ -0,0 +1,80 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExcludeDates(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Shanghai")
+	assert.Nil(t, err)
+
+	calendar := New(location)
+	assert.Nil(t, calendar.ExcludeDates("2024-12-25", "2024-12-26"))
+	assert.Error(t, calendar.ExcludeDates("12/25/2024"))
+
+	assert.False(t, calendar.Excludes(time.Date(2024, 12, 24, 23, 59, 59, 0, location)))
+	assert.True(t, calendar.Excludes(time.Date(2024, 12, 25, 0, 0, 0, 0, location)))
+	// The dates are in the location of the calendar.
+	assert.True(t, calendar.Excludes(time.Date(2024, 12, 24, 16, 0, 0, 0, time.UTC)))
+
+	next, err := calendar.Next(time.Date(2024, 12, 25, 10, 0, 0, 0, location))
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 12, 27, 0, 0, 0, 0, location), next)
+}
+
+func TestExcludeWindow(t *testing.T) {
+	start := time.Date(2024, 12, 31, 22, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)
+	calendar := New(nil).ExcludeWindow(start, end).ExcludeWindow(end, start)
+
+	assert.False(t, calendar.Excludes(start.Add(-time.Second)))
+	assert.True(t, calendar.Excludes(start))
+	assert.False(t, calendar.Excludes(end))
+
+	next, err := calendar.Next(start.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, end, next)
+
+	next, err = calendar.Next(end.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, end.Add(time.Hour), next)
+}
+
+func TestExcludeCron(t *testing.T) {
+	calendar := New(time.UTC)
+	assert.EqualError(t, calendar.ExcludeCron("0 2 * * 0", 0), "the duration of a recurring exclusion should be positive")
+	assert.Error(t, calendar.ExcludeCron("invalid", time.Hour))
+
+	// Sundays from 02:00 to 04:00
+	assert.Nil(t, calendar.ExcludeCron("0 2 * * 0", 2*time.Hour))
+
+	sunday := time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC)
+	assert.False(t, calendar.Excludes(sunday.Add(time.Hour)))
+	assert.True(t, calendar.Excludes(sunday.Add(2*time.Hour)))
+	assert.True(t, calendar.Excludes(sunday.Add(3*time.Hour+59*time.Minute)))
+	assert.False(t, calendar.Excludes(sunday.Add(4*time.Hour)))
+	assert.False(t, calendar.Excludes(sunday.Add(24*time.Hour+3*time.Hour)))
+
+	next, err := calendar.Next(sunday.Add(3 * time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, sunday.Add(4*time.Hour), next)
+}
+
+func TestNext(t *testing.T) {
+	// The adjacent exclusions are skipped together.
+	calendar := New(time.UTC)
+	assert.Nil(t, calendar.ExcludeDates("2024-12-25"))
+	calendar.ExcludeWindow(time.Date(2024, 12, 25, 20, 0, 0, 0, time.UTC), time.Date(2024, 12, 26, 6, 0, 0, 0, time.UTC))
+
+	next, err := calendar.Next(time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 12, 26, 6, 0, 0, 0, time.UTC), next)
+
+	calendar = New(time.UTC)
+	assert.Nil(t, calendar.ExcludeCron("* * * * *", time.Hour))
+	_, err = calendar.Next(time.Now())
+	assert.EqualError(t, err, "the calendar excludes all the time")
+}
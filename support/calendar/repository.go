@@ -0,0 +1,184 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/database/orm"
+)
+
+// tableTTL The duration the exclusions loaded from a table are cached for.
+const tableTTL = time.Minute
+
+// DatabaseExclusion is a row of the calendar exclusions table, the table can be created by a migration like:
+//
+//	CREATE TABLE calendar_exclusions (
+//	  id bigint PRIMARY KEY AUTO_INCREMENT,
+//	  calendar varchar(255) NOT NULL,
+//	  starts_at bigint NOT NULL,
+//	  ends_at bigint NOT NULL
+//	);
+type DatabaseExclusion struct {
+	ID       uint
+	Calendar string
+	StartsAt int64
+	EndsAt   int64
+}
+
+// Repository loads the calendars from the calendars config, a calendar is configured like:
+//
+//	"billing": map[string]any{
+//	  "timezone": "UTC",
+//	  "dates":    []string{"2024-12-25"},
+//	  "windows":  []map[string]any{{"start": "2024-12-31 22:00:00", "end": "2025-01-01 02:00:00"}},
+//	  "cron":     []map[string]any{{"expression": "0 2 * * 0", "duration": "2h"}},
+//	  "database": "mysql",
+//	  "table":    "calendar_exclusions",
+//	}
+//
+// The exclusions stored in the table are cached for a minute, so they can be changed without a restart.
+type Repository struct {
+	config config.Config
+	// orm Resolve the orm of the tables, it's nil if the database isn't registered.
+	orm func() orm.Orm
+
+	mu     sync.Mutex
+	tables map[string]cachedTable
+}
+
+type cachedTable struct {
+	rows     []DatabaseExclusion
+	loadedAt time.Time
+}
+
+func NewRepository(config config.Config, orm func() orm.Orm) *Repository {
+	return &Repository{
+		config: config,
+		orm:    orm,
+		tables: make(map[string]cachedTable),
+	}
+}
+
+// Get Load a calendar by its name.
+func (r *Repository) Get(name string) (*Calendar, error) {
+	key := "calendars." + name
+	if r.config.Get(key) == nil {
+		return nil, fmt.Errorf("calendar [%s] isn't configured", name)
+	}
+
+	location, err := time.LoadLocation(r.config.GetString(key+".timezone", r.config.GetString("app.timezone", "UTC")))
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := New(location)
+	if err := calendar.ExcludeDates(cast.ToStringSlice(r.config.Get(key + ".dates"))...); err != nil {
+		return nil, err
+	}
+
+	for _, window := range toMaps(r.config.Get(key + ".windows")) {
+		start, err := time.ParseInLocation(time.DateTime, cast.ToString(window["start"]), location)
+		if err != nil {
+			return nil, err
+		}
+		end, err := time.ParseInLocation(time.DateTime, cast.ToString(window["end"]), location)
+		if err != nil {
+			return nil, err
+		}
+
+		calendar.ExcludeWindow(start, end)
+	}
+
+	for _, recurring := range toMaps(r.config.Get(key + ".cron")) {
+		duration, err := cast.ToDurationE(recurring["duration"])
+		if err != nil {
+			return nil, err
+		}
+		if err := calendar.ExcludeCron(cast.ToString(recurring["expression"]), duration); err != nil {
+			return nil, err
+		}
+	}
+
+	if table := r.config.GetString(key + ".table"); table != "" {
+		if err := r.loadTable(calendar, name, r.config.GetString(key+".database"), table); err != nil {
+			return nil, err
+		}
+	}
+
+	return calendar, nil
+}
+
+func (r *Repository) loadTable(calendar *Calendar, name, connection, table string) error {
+	rows, err := r.tableRows(name, connection, table)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	for _, row := range rows {
+		if row.EndsAt > now {
+			calendar.ExcludeWindow(time.Unix(row.StartsAt, 0), time.Unix(row.EndsAt, 0))
+		}
+	}
+
+	return nil
+}
+
+// tableRows Get the exclusions of a calendar stored in a table, they are loaded again once the cache expires.
+func (r *Repository) tableRows(name, connection, table string) ([]DatabaseExclusion, error) {
+	key := connection + ":" + table + ":" + name
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cached, ok := r.tables[key]; ok && time.Since(cached.loadedAt) < tableTTL {
+		return cached.rows, nil
+	}
+
+	var instance orm.Orm
+	if r.orm != nil {
+		instance = r.orm()
+	}
+	if instance == nil {
+		return nil, errors.New("the database is required to load the exclusions of the calendar table")
+	}
+	if connection != "" {
+		instance = instance.Connection(connection)
+	}
+
+	var rows []DatabaseExclusion
+	if err := instance.Query().Raw(fmt.Sprintf("SELECT * FROM %s WHERE calendar = ? AND ends_at > ?", table), name, time.Now().Unix()).Scan(&rows); err != nil {
+		return nil, err
+	}
+
+	r.tables[key] = cachedTable{rows: rows, loadedAt: time.Now()}
+
+	return rows, nil
+}
+
+// toMaps Convert the list of a config to maps, the list can be of any or string values.
+func toMaps(value any) []map[string]any {
+	var maps []map[string]any
+	if items, ok := value.([]map[string]string); ok {
+		for _, item := range items {
+			converted := make(map[string]any, len(item))
+			for key, value := range item {
+				converted[key] = value
+			}
+			maps = append(maps, converted)
+		}
+
+		return maps
+	}
+
+	for _, item := range cast.ToSlice(value) {
+		maps = append(maps, cast.ToStringMap(item))
+	}
+
+	return maps
+}
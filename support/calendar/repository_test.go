@@ -0,0 +1,107 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	configmock "github.com/goravel/framework/mocks/config"
+	ormmock "github.com/goravel/framework/mocks/database/orm"
+)
+
+func TestRepositoryGet(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().Get("calendars.missing").Return(nil).Once()
+
+	_, err := NewRepository(mockConfig, nil).Get("missing")
+	assert.EqualError(t, err, "calendar [missing] isn't configured")
+
+	mockConfig.EXPECT().Get("calendars.billing").Return(map[string]any{}).Once()
+	mockConfig.EXPECT().GetString("app.timezone", "UTC").Return("UTC").Once()
+	mockConfig.EXPECT().GetString("calendars.billing.timezone", "UTC").Return("Asia/Shanghai").Once()
+	mockConfig.EXPECT().Get("calendars.billing.dates").Return([]string{"2024-12-25"}).Once()
+	mockConfig.EXPECT().Get("calendars.billing.windows").Return([]map[string]string{
+		{"start": "2024-12-31 22:00:00", "end": "2025-01-01 02:00:00"},
+	}).Once()
+	mockConfig.EXPECT().Get("calendars.billing.cron").Return([]any{
+		map[string]any{"expression": "0 2 * * 0", "duration": "2h"},
+	}).Once()
+	mockConfig.EXPECT().GetString("calendars.billing.table").Return("").Once()
+
+	calendar, err := NewRepository(mockConfig, nil).Get("billing")
+	assert.Nil(t, err)
+
+	location, err := time.LoadLocation("Asia/Shanghai")
+	assert.Nil(t, err)
+	assert.True(t, calendar.Excludes(time.Date(2024, 12, 25, 12, 0, 0, 0, location)))
+	assert.True(t, calendar.Excludes(time.Date(2024, 12, 31, 23, 0, 0, 0, location)))
+	assert.False(t, calendar.Excludes(time.Date(2025, 1, 1, 2, 0, 0, 0, location)))
+	assert.True(t, calendar.Excludes(time.Date(2024, 12, 29, 3, 0, 0, 0, location)))
+	assert.False(t, calendar.Excludes(time.Date(2024, 12, 29, 3, 0, 0, 0, time.UTC)))
+}
+
+func TestRepositoryGet_InvalidWindow(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().Get("calendars.billing").Return(map[string]any{}).Once()
+	mockConfig.EXPECT().GetString("app.timezone", "UTC").Return("UTC").Once()
+	mockConfig.EXPECT().GetString("calendars.billing.timezone", "UTC").Return("UTC").Once()
+	mockConfig.EXPECT().Get("calendars.billing.dates").Return(nil).Once()
+	mockConfig.EXPECT().Get("calendars.billing.windows").Return([]map[string]any{{"start": "tomorrow"}}).Once()
+
+	_, err := NewRepository(mockConfig, nil).Get("billing")
+	assert.Error(t, err)
+}
+
+func TestRepositoryGet_Table(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockOrm := ormmock.NewOrm(t)
+	mockQuery := ormmock.NewQuery(t)
+	mockConfig.EXPECT().Get("calendars.billing").Return(map[string]any{}).Twice()
+	mockConfig.EXPECT().GetString("app.timezone", "UTC").Return("UTC").Twice()
+	mockConfig.EXPECT().GetString("calendars.billing.timezone", "UTC").Return("UTC").Twice()
+	mockConfig.EXPECT().Get("calendars.billing.dates").Return(nil).Twice()
+	mockConfig.EXPECT().Get("calendars.billing.windows").Return(nil).Twice()
+	mockConfig.EXPECT().Get("calendars.billing.cron").Return(nil).Twice()
+	mockConfig.EXPECT().GetString("calendars.billing.table").Return("calendar_exclusions").Twice()
+	mockConfig.EXPECT().GetString("calendars.billing.database").Return("mysql").Twice()
+
+	start, end := time.Now().Add(-time.Hour).Truncate(time.Second), time.Now().Add(time.Hour).Truncate(time.Second)
+	mockOrm.EXPECT().Connection("mysql").Return(mockOrm).Once()
+	mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Raw("SELECT * FROM calendar_exclusions WHERE calendar = ? AND ends_at > ?", "billing", mock.Anything).Return(mockQuery).Once()
+	mockQuery.EXPECT().Scan(mock.Anything).RunAndReturn(func(dest any) error {
+		*dest.(*[]DatabaseExclusion) = []DatabaseExclusion{{Calendar: "billing", StartsAt: start.Unix(), EndsAt: end.Unix()}}
+
+		return nil
+	}).Once()
+
+	repository := NewRepository(mockConfig, func() orm.Orm {
+		return mockOrm
+	})
+
+	// The table is loaded once and cached for the following calls.
+	for i := 0; i < 2; i++ {
+		calendar, err := repository.Get("billing")
+		assert.Nil(t, err)
+		assert.True(t, calendar.Excludes(time.Now()))
+		assert.False(t, calendar.Excludes(end.Add(time.Minute)))
+	}
+}
+
+func TestRepositoryGet_TableWithoutDatabase(t *testing.T) {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().Get("calendars.billing").Return(map[string]any{}).Once()
+	mockConfig.EXPECT().GetString("app.timezone", "UTC").Return("UTC").Once()
+	mockConfig.EXPECT().GetString("calendars.billing.timezone", "UTC").Return("UTC").Once()
+	mockConfig.EXPECT().Get("calendars.billing.dates").Return(nil).Once()
+	mockConfig.EXPECT().Get("calendars.billing.windows").Return(nil).Once()
+	mockConfig.EXPECT().Get("calendars.billing.cron").Return(nil).Once()
+	mockConfig.EXPECT().GetString("calendars.billing.table").Return("calendar_exclusions").Once()
+	mockConfig.EXPECT().GetString("calendars.billing.database").Return("").Once()
+
+	_, err := NewRepository(mockConfig, nil).Get("billing")
+	assert.EqualError(t, err, "the database is required to load the exclusions of the calendar table")
+}
@@ -48,7 +48,12 @@ func NewMake(ctx console.Context, ttype, name, root string) (*Make, error) {
 func (m *Make) GetFilePath() string {
 	pwd, _ := os.Getwd()
 
-	return filepath.Join(pwd, m.root, m.GetFolderPath(), str.Of(m.GetStructName()).Snake().String()+".go")
+	return filepath.Join(pwd, m.GetRelativePath())
+}
+
+// GetRelativePath Get the path of the file relative to the working directory.
+func (m *Make) GetRelativePath() string {
+	return filepath.Join(m.root, m.GetFolderPath(), str.Of(m.GetStructName()).Snake().String()+".go")
 }
 
 func (m *Make) GetStructName() string {
@@ -38,6 +38,13 @@ func (s *MakeTestSuite) TestGetFilePath() {
 	s.Equal(filepath.Join(pwd, s.make.root, "user", "lowercase.go"), s.make.GetFilePath())
 }
 
+func (s *MakeTestSuite) TestGetRelativePath() {
+	s.Equal(filepath.Join("app", "rules", "lowercase.go"), s.make.GetRelativePath())
+
+	s.make.name = "User/Admin/Lowercase"
+	s.Equal(filepath.Join("app", "rules", "User", "Admin", "lowercase.go"), s.make.GetRelativePath())
+}
+
 func (s *MakeTestSuite) TestGetStructName() {
 	s.Equal("Lowercase", s.make.GetStructName())
 
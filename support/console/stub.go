@@ -0,0 +1,20 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// StubPath The directory of the application stubs, a stub in it overrides the framework stub with the same name.
+const StubPath = "stubs"
+
+// Stub Get the content of a stub, the application stub takes precedence over the framework stub.
+func Stub(name, stub string) string {
+	pwd, _ := os.Getwd()
+	content, err := os.ReadFile(filepath.Join(pwd, StubPath, name))
+	if err != nil {
+		return stub
+	}
+
+	return string(content)
+}
@@ -0,0 +1,234 @@
+package docker
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/cast"
+
+	"github.com/goravel/framework/contracts/testing"
+)
+
+const (
+	labelManaged = "org.goravel.docker"
+	labelReuse   = "org.goravel.docker.reuse"
+	labelSession = "org.goravel.docker.session"
+)
+
+var (
+	settings     *Settings
+	settingsOnce sync.Once
+	reapOnce     sync.Once
+)
+
+// Settings The options of the containers, they are compatible with testcontainers: the properties are read from
+// ~/.testcontainers.properties and can be overridden by the TESTCONTAINERS_* environment variables.
+type Settings struct {
+	// Host The host of the exposed ports, "host.override" or TESTCONTAINERS_HOST_OVERRIDE.
+	Host string
+	// Reuse Whether the running containers are shared across the test packages instead of starting new ones,
+	// "testcontainers.reuse.enable" or TESTCONTAINERS_REUSE_ENABLE. The reused containers are kept running by Stop,
+	// note that the test packages sharing a database container shouldn't run in parallel, e.g. "go test -p 1".
+	Reuse bool
+	// RyukDisabled Whether the containers left by the test processes that exited without stopping them are kept,
+	// "ryuk.disabled" or TESTCONTAINERS_RYUK_DISABLED.
+	RyukDisabled bool
+}
+
+// GetSettings Get the settings of the containers, the settings are loaded once.
+func GetSettings() *Settings {
+	settingsOnce.Do(func() {
+		settings = loadSettings()
+	})
+
+	return settings
+}
+
+func loadSettings() *Settings {
+	properties := make(map[string]string)
+	if home, err := os.UserHomeDir(); err == nil {
+		properties = readProperties(filepath.Join(home, ".testcontainers.properties"))
+	}
+
+	property := func(key, env string) string {
+		if value, ok := os.LookupEnv(env); ok {
+			return value
+		}
+
+		return properties[key]
+	}
+
+	result := &Settings{
+		Host:         property("host.override", "TESTCONTAINERS_HOST_OVERRIDE"),
+		Reuse:        cast.ToBool(property("testcontainers.reuse.enable", "TESTCONTAINERS_REUSE_ENABLE")),
+		RyukDisabled: cast.ToBool(property("ryuk.disabled", "TESTCONTAINERS_RYUK_DISABLED")),
+	}
+	if result.Host == "" {
+		result.Host = "127.0.0.1"
+	}
+
+	return result
+}
+
+// readProperties Read a Java properties file, only the "key=value" lines are supported.
+func readProperties(file string) map[string]string {
+	properties := make(map[string]string)
+
+	f, err := os.Open(file)
+	if err != nil {
+		return properties
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		if key, value, found := strings.Cut(line, "="); found {
+			properties[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	return properties
+}
+
+// start Start a container of the image, or find the running container of the image if the reuse is enabled.
+// The containers are labelled so they can be discovered by the other test packages or reaped once the
+// process that started them exits.
+func start(image *testing.Image) (containerID string, exposedPorts []string, err error) {
+	reapOnce.Do(func() {
+		if !GetSettings().RyukDisabled {
+			_ = reap()
+		}
+	})
+
+	labels := []string{labelManaged + "=true"}
+	if GetSettings().Reuse {
+		key := reuseKey(image)
+		containerID, err = run(fmt.Sprintf("docker ps -q --filter label=%s=%s", labelReuse, key))
+		if err != nil {
+			return "", nil, err
+		}
+		if containerID != "" {
+			containerID = strings.Fields(containerID)[0]
+			exposedPorts, err = containerPorts(containerID, image)
+
+			return containerID, exposedPorts, err
+		}
+
+		labels = append(labels, labelReuse+"="+key)
+	} else {
+		labels = append(labels, fmt.Sprintf("%s=%d", labelSession, os.Getpid()))
+	}
+
+	command, exposedPorts := imageToCommand(image, labels...)
+	containerID, err = run(command)
+
+	return containerID, exposedPorts, err
+}
+
+// stop Stop the container, the reused containers are kept running for the other test packages.
+func stop(containerID string) error {
+	if GetSettings().Reuse {
+		return nil
+	}
+
+	_, err := run(fmt.Sprintf("docker stop %s", containerID))
+
+	return err
+}
+
+// reap Remove the containers started by the test processes that have exited, like the Ryuk container of
+// testcontainers does, the containers are left behind if a test panics before stopping them.
+func reap() error {
+	output, err := run(fmt.Sprintf(`docker ps --filter label=%s --format "{{.ID}}:{{.Label \"%s\"}}"`, labelSession, labelSession))
+	if err != nil {
+		return err
+	}
+
+	var dead []string
+	for _, item := range strings.Fields(output) {
+		containerID, pid, _ := strings.Cut(item, ":")
+		if !processExists(cast.ToInt(pid)) {
+			dead = append(dead, containerID)
+		}
+	}
+	if len(dead) == 0 {
+		return nil
+	}
+
+	_, err = run("docker rm -f " + strings.Join(dead, " "))
+
+	return err
+}
+
+// containerPorts Get the host ports of a running container, they are formatted like the ports of imageToCommand.
+func containerPorts(containerID string, image *testing.Image) ([]string, error) {
+	var exposedPorts []string
+	for _, port := range image.ExposedPorts {
+		if _, containerPort, found := strings.Cut(port, ":"); found {
+			port = containerPort
+		}
+
+		// The output is like "0.0.0.0:32768 [::]:32768".
+		output, err := run(fmt.Sprintf("docker port %s %s", containerID, port))
+		if err != nil {
+			return nil, err
+		}
+		fields := strings.Fields(output)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("the port %s of container %s isn't published", port, containerID)
+		}
+
+		exposedPorts = append(exposedPorts, fields[0][strings.LastIndex(fields[0], ":")+1:]+":"+port)
+	}
+
+	return exposedPorts, nil
+}
+
+// reuseKey Get the key of the reusable container of an image, the host ports aren't a part of the key.
+func reuseKey(image *testing.Image) string {
+	var ports []string
+	for _, port := range image.ExposedPorts {
+		if _, containerPort, found := strings.Cut(port, ":"); found {
+			port = containerPort
+		}
+		ports = append(ports, port)
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		image.Repository,
+		image.Tag,
+		strings.Join(image.Env, ","),
+		strings.Join(ports, ","),
+		strings.Join(image.Cmd, " "),
+	}, "|")))
+
+	return hex.EncodeToString(hash[:])[:16]
+}
+
+func processExists(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	// The process of another user exists although it can't be signalled.
+	err = process.Signal(syscall.Signal(0))
+
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
@@ -0,0 +1,55 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractstesting "github.com/goravel/framework/contracts/testing"
+)
+
+func TestLoadSettings(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	assert.Equal(t, &Settings{Host: "127.0.0.1"}, loadSettings())
+
+	assert.Nil(t, os.WriteFile(filepath.Join(home, ".testcontainers.properties"), []byte(`# testcontainers
+testcontainers.reuse.enable = true
+host.override=docker.local
+ryuk.disabled=false
+`), 0644))
+	assert.Equal(t, &Settings{Host: "docker.local", Reuse: true}, loadSettings())
+
+	t.Setenv("TESTCONTAINERS_REUSE_ENABLE", "false")
+	t.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true")
+	assert.Equal(t, &Settings{Host: "docker.local", RyukDisabled: true}, loadSettings())
+}
+
+func TestReuseKey(t *testing.T) {
+	image := &contractstesting.Image{
+		Repository:   "redis",
+		Tag:          "latest",
+		ExposedPorts: []string{"6379"},
+	}
+
+	assert.Len(t, reuseKey(image), 16)
+	assert.Equal(t, reuseKey(image), reuseKey(&contractstesting.Image{
+		Repository:   "redis",
+		Tag:          "latest",
+		ExposedPorts: []string{"16379:6379"},
+	}))
+	assert.NotEqual(t, reuseKey(image), reuseKey(&contractstesting.Image{
+		Repository:   "redis",
+		Tag:          "7",
+		ExposedPorts: []string{"6379"},
+	}))
+}
+
+func TestProcessExists(t *testing.T) {
+	assert.True(t, processExists(os.Getpid()))
+	assert.False(t, processExists(0))
+}
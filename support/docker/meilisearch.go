@@ -0,0 +1,73 @@
+package docker
+
+import (
+	"fmt"
+
+	"github.com/goravel/framework/contracts/testing"
+)
+
+type Meilisearch struct {
+	containerID string
+	host        string
+	image       *testing.Image
+	key         string
+	port        int
+}
+
+func NewMeilisearch() *Meilisearch {
+	return &Meilisearch{
+		host: GetSettings().Host,
+		key:  password,
+		image: &testing.Image{
+			Repository:   "getmeili/meilisearch",
+			Tag:          "latest",
+			Env:          []string{"MEILI_MASTER_KEY=" + password, "MEILI_NO_ANALYTICS=true"},
+			ExposedPorts: []string{"7700"},
+		},
+	}
+}
+
+func (receiver *Meilisearch) Build() error {
+	containerID, exposedPorts, err := start(receiver.image)
+	if err != nil {
+		return fmt.Errorf("init Meilisearch docker error: %v", err)
+	}
+	if containerID == "" {
+		return fmt.Errorf("no container id return when creating Meilisearch docker")
+	}
+
+	receiver.containerID = containerID
+	receiver.port = getExposedPort(exposedPorts, 7700)
+
+	if err := waitForHttp(fmt.Sprintf("http://%s:%d/health", receiver.host, receiver.port)); err != nil {
+		return fmt.Errorf("connect Meilisearch docker error: %v", err)
+	}
+
+	return nil
+}
+
+func (receiver *Meilisearch) Config() MeilisearchConfig {
+	return MeilisearchConfig{
+		Host: receiver.host,
+		Port: receiver.port,
+		Key:  receiver.key,
+	}
+}
+
+func (receiver *Meilisearch) Image(image testing.Image) {
+	receiver.image = &image
+}
+
+func (receiver *Meilisearch) Stop() error {
+	if err := stop(receiver.containerID); err != nil {
+		return fmt.Errorf("stop Meilisearch docker error: %v", err)
+	}
+
+	return nil
+}
+
+type MeilisearchConfig struct {
+	Host string
+	Port int
+	Key  string
+}
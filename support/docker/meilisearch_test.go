@@ -0,0 +1,32 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/support/env"
+)
+
+type MeilisearchTestSuite struct {
+	suite.Suite
+	meilisearch *Meilisearch
+}
+
+func TestMeilisearchTestSuite(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	suite.Run(t, new(MeilisearchTestSuite))
+}
+
+func (s *MeilisearchTestSuite) SetupTest() {
+	s.meilisearch = NewMeilisearch()
+}
+
+func (s *MeilisearchTestSuite) TestBuild() {
+	s.Nil(s.meilisearch.Build())
+	s.True(s.meilisearch.Config().Port > 0)
+	s.Nil(s.meilisearch.Stop())
+}
@@ -0,0 +1,101 @@
+package docker
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goravel/framework/contracts/testing"
+)
+
+type Minio struct {
+	accessKey   string
+	containerID string
+	host        string
+	image       *testing.Image
+	port        int
+	secretKey   string
+}
+
+func NewMinio() *Minio {
+	return &Minio{
+		accessKey: username,
+		host:      GetSettings().Host,
+		secretKey: password,
+		image: &testing.Image{
+			Repository:   "minio/minio",
+			Tag:          "latest",
+			Env:          []string{"MINIO_ROOT_USER=" + username, "MINIO_ROOT_PASSWORD=" + password},
+			ExposedPorts: []string{"9000"},
+			Cmd:          []string{"server", "/data"},
+		},
+	}
+}
+
+func (receiver *Minio) Build() error {
+	containerID, exposedPorts, err := start(receiver.image)
+	if err != nil {
+		return fmt.Errorf("init Minio docker error: %v", err)
+	}
+	if containerID == "" {
+		return fmt.Errorf("no container id return when creating Minio docker")
+	}
+
+	receiver.containerID = containerID
+	receiver.port = getExposedPort(exposedPorts, 9000)
+
+	if err := waitForHttp(fmt.Sprintf("http://%s:%d/minio/health/live", receiver.host, receiver.port)); err != nil {
+		return fmt.Errorf("connect Minio docker error: %v", err)
+	}
+
+	return nil
+}
+
+func (receiver *Minio) Config() MinioConfig {
+	return MinioConfig{
+		Host:      receiver.host,
+		Port:      receiver.port,
+		AccessKey: receiver.accessKey,
+		SecretKey: receiver.secretKey,
+	}
+}
+
+func (receiver *Minio) Image(image testing.Image) {
+	receiver.image = &image
+}
+
+func (receiver *Minio) Stop() error {
+	if err := stop(receiver.containerID); err != nil {
+		return fmt.Errorf("stop Minio docker error: %v", err)
+	}
+
+	return nil
+}
+
+type MinioConfig struct {
+	Host      string
+	Port      int
+	AccessKey string
+	SecretKey string
+}
+
+// waitForHttp Wait until the url responds with 200, the containers need time to start.
+func waitForHttp(url string) error {
+	client := &http.Client{Timeout: 2 * time.Second}
+
+	var err error
+	for i := 0; i < 60; i++ {
+		var response *http.Response
+		if response, err = client.Get(url); err == nil {
+			_ = response.Body.Close()
+			if response.StatusCode == http.StatusOK {
+				return nil
+			}
+			err = fmt.Errorf("unexpected status code: %d", response.StatusCode)
+		}
+
+		time.Sleep(2 * time.Second)
+	}
+
+	return err
+}
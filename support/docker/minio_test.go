@@ -0,0 +1,32 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/framework/support/env"
+)
+
+type MinioTestSuite struct {
+	suite.Suite
+	minio *Minio
+}
+
+func TestMinioTestSuite(t *testing.T) {
+	if env.IsWindows() {
+		t.Skip("Skipping tests of using docker")
+	}
+
+	suite.Run(t, new(MinioTestSuite))
+}
+
+func (s *MinioTestSuite) SetupTest() {
+	s.minio = NewMinio()
+}
+
+func (s *MinioTestSuite) TestBuild() {
+	s.Nil(s.minio.Build())
+	s.True(s.minio.Config().Port > 0)
+	s.Nil(s.minio.Stop())
+}
@@ -33,7 +33,7 @@ func NewMysqlImpl(database, username, password string) *MysqlImpl {
 
 	return &MysqlImpl{
 		database: database,
-		host:     "127.0.0.1",
+		host:     GetSettings().Host,
 		username: username,
 		password: password,
 		image: &testing.Image{
@@ -46,8 +46,7 @@ func NewMysqlImpl(database, username, password string) *MysqlImpl {
 }
 
 func (receiver *MysqlImpl) Build() error {
-	command, exposedPorts := imageToCommand(receiver.image)
-	containerID, err := run(command)
+	containerID, exposedPorts, err := start(receiver.image)
 	if err != nil {
 		return fmt.Errorf("init Mysql docker error: %v", err)
 	}
@@ -111,7 +110,7 @@ func (receiver *MysqlImpl) Name() orm.Driver {
 }
 
 func (receiver *MysqlImpl) Stop() error {
-	if _, err := run(fmt.Sprintf("docker stop %s", receiver.containerID)); err != nil {
+	if err := stop(receiver.containerID); err != nil {
 		return fmt.Errorf("stop Mysql error: %v", err)
 	}
 
@@ -24,7 +24,7 @@ type PostgresImpl struct {
 func NewPostgresImpl(database, username, password string) *PostgresImpl {
 	return &PostgresImpl{
 		database: database,
-		host:     "127.0.0.1",
+		host:     GetSettings().Host,
 		username: username,
 		password: password,
 		image: &testing.Image{
@@ -41,8 +41,7 @@ func NewPostgresImpl(database, username, password string) *PostgresImpl {
 }
 
 func (receiver *PostgresImpl) Build() error {
-	command, exposedPorts := imageToCommand(receiver.image)
-	containerID, err := run(command)
+	containerID, exposedPorts, err := start(receiver.image)
 	if err != nil {
 		return fmt.Errorf("init Postgresql error: %v", err)
 	}
@@ -96,7 +95,7 @@ func (receiver *PostgresImpl) Name() orm.Driver {
 }
 
 func (receiver *PostgresImpl) Stop() error {
-	if _, err := run(fmt.Sprintf("docker stop %s", receiver.containerID)); err != nil {
+	if err := stop(receiver.containerID); err != nil {
 		return fmt.Errorf("stop Postgresql error: %v", err)
 	}
 
@@ -13,11 +13,13 @@ import (
 type Redis struct {
 	port        int
 	containerID string
+	host        string
 	image       *testing.Image
 }
 
 func NewRedis() *Redis {
 	return &Redis{
+		host: GetSettings().Host,
 		image: &testing.Image{
 			Repository:   "redis",
 			Tag:          "latest",
@@ -27,8 +29,7 @@ func NewRedis() *Redis {
 }
 
 func (receiver *Redis) Build() error {
-	command, exposedPorts := imageToCommand(receiver.image)
-	containerID, err := run(command)
+	containerID, exposedPorts, err := start(receiver.image)
 	if err != nil {
 		return fmt.Errorf("init Redis docker error: %v", err)
 	}
@@ -48,12 +49,17 @@ func (receiver *Redis) Build() error {
 
 func (receiver *Redis) Config() RedisConfig {
 	return RedisConfig{
+		Host: receiver.host,
 		Port: receiver.port,
 	}
 }
 
+func (receiver *Redis) Image(image testing.Image) {
+	receiver.image = &image
+}
+
 func (receiver *Redis) Stop() error {
-	if _, err := run(fmt.Sprintf("docker stop %s", receiver.containerID)); err != nil {
+	if err := stop(receiver.containerID); err != nil {
 		return fmt.Errorf("stop Redis docker error: %v", err)
 	}
 
@@ -67,7 +73,7 @@ func (receiver *Redis) connect() (*redis.Client, error) {
 	)
 	for i := 0; i < 60; i++ {
 		client = redis.NewClient(&redis.Options{
-			Addr:     fmt.Sprintf("localhost:%d", receiver.port),
+			Addr:     fmt.Sprintf("%s:%d", receiver.host, receiver.port),
 			Password: "",
 			DB:       0,
 		})
@@ -83,5 +89,6 @@ func (receiver *Redis) connect() (*redis.Client, error) {
 }
 
 type RedisConfig struct {
+	Host string
 	Port int
 }
@@ -24,7 +24,7 @@ type SqlserverImpl struct {
 func NewSqlserverImpl(database, username, password string) *SqlserverImpl {
 	return &SqlserverImpl{
 		database: database,
-		host:     "127.0.0.1",
+		host:     GetSettings().Host,
 		username: username,
 		password: password,
 		image: &testing.Image{
@@ -43,8 +43,7 @@ func NewSqlserverImpl(database, username, password string) *SqlserverImpl {
 }
 
 func (receiver *SqlserverImpl) Build() error {
-	command, exposedPorts := imageToCommand(receiver.image)
-	containerID, err := run(command)
+	containerID, exposedPorts, err := start(receiver.image)
 	if err != nil {
 		return fmt.Errorf("init Sqlserver docker error: %v", err)
 	}
@@ -108,7 +107,7 @@ func (receiver *SqlserverImpl) Name() orm.Driver {
 }
 
 func (receiver *SqlserverImpl) Stop() error {
-	if _, err := run(fmt.Sprintf("docker stop %s", receiver.containerID)); err != nil {
+	if err := stop(receiver.containerID); err != nil {
 		return fmt.Errorf("stop Sqlserver error: %v", err)
 	}
 
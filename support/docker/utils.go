@@ -44,12 +44,15 @@ func getValidPort() int {
 	return 0
 }
 
-func imageToCommand(image *testing.Image) (command string, exposedPorts []string) {
+func imageToCommand(image *testing.Image, labels ...string) (command string, exposedPorts []string) {
 	if image == nil {
 		return "", nil
 	}
 
 	commands := []string{"docker", "run", "--rm", "-d"}
+	for _, label := range labels {
+		commands = append(commands, "--label", label)
+	}
 	if len(image.Env) > 0 {
 		for _, env := range image.Env {
 			commands = append(commands, "-e", env)
@@ -67,6 +70,7 @@ func imageToCommand(image *testing.Image) (command string, exposedPorts []string
 	}
 
 	commands = append(commands, fmt.Sprintf("%s:%s", image.Repository, image.Tag))
+	commands = append(commands, image.Cmd...)
 
 	return strings.Join(commands, " "), ports
 }
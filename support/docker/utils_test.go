@@ -48,6 +48,15 @@ func TestImageToCommand(t *testing.T) {
 	})
 	assert.Equal(t, "docker run --rm -d -e a=b -p 1234:6379 redis:latest", command)
 	assert.Equal(t, []string{"1234:6379"}, exposedPorts)
+
+	command, exposedPorts = imageToCommand(&contractstesting.Image{
+		Repository:   "minio/minio",
+		Tag:          "latest",
+		ExposedPorts: []string{"1234:9000"},
+		Cmd:          []string{"server", "/data"},
+	}, "org.goravel.docker=true")
+	assert.Equal(t, "docker run --rm -d --label org.goravel.docker=true -p 1234:9000 minio/minio:latest server /data", command)
+	assert.Equal(t, []string{"1234:9000"}, exposedPorts)
 }
 
 func TestRun(t *testing.T) {
@@ -0,0 +1,146 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"syscall"
+	"time"
+)
+
+// defaultMaxDelay The upper bound of a single delay if the policy doesn't set one.
+const defaultMaxDelay = 30 * time.Second
+
+type Jitter int
+
+const (
+	// JitterNone Wait the exact backoff delay.
+	JitterNone Jitter = iota
+	// JitterFull Wait a random delay between zero and the backoff delay.
+	JitterFull
+	// JitterEqual Wait half of the backoff delay plus a random delay up to the other half.
+	JitterEqual
+	// JitterDecorrelated Wait a random delay between the base delay and three times the previous delay.
+	JitterDecorrelated
+)
+
+type Policy struct {
+	// Attempts The maximum number of attempts, including the first one. Defaults to 3.
+	Attempts int
+	// Delay The delay before the first retry. Defaults to 100ms.
+	Delay time.Duration
+	// MaxDelay The upper bound of a single delay. Defaults to 30s, or Delay if it's longer.
+	MaxDelay time.Duration
+	// Multiplier The growth factor of the delay between attempts. Defaults to 2.
+	Multiplier float64
+	// Jitter The strategy used to randomize the delay.
+	Jitter Jitter
+	// Retryable Determine whether an error should be retried, all errors are retried if nil.
+	Retryable func(err error) bool
+}
+
+// Do Call fn until it succeeds, returns a non-retryable error, the attempts are exhausted
+// or the context is done. The last error of fn is returned.
+func Do(ctx context.Context, policy Policy, fn func() error) error {
+	policy = policy.withDefaults()
+
+	var (
+		err   error
+		delay time.Duration
+	)
+	for attempt := 1; ; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if attempt >= policy.Attempts || (policy.Retryable != nil && !policy.Retryable(err)) {
+			return err
+		}
+
+		delay = policy.Backoff(attempt, delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// Backoff Get the delay before the next attempt, previous is the delay used before the current attempt.
+func (r Policy) Backoff(attempt int, previous time.Duration) time.Duration {
+	r = r.withDefaults()
+
+	// The delay is computed as a float, so it's clamped instead of overflowing for the large attempts.
+	delay := time.Duration(math.MaxInt64)
+	if exponential := float64(r.Delay) * math.Pow(r.Multiplier, float64(attempt-1)); exponential < math.MaxInt64 {
+		delay = time.Duration(exponential)
+	}
+	switch r.Jitter {
+	case JitterFull:
+		delay = random(0, delay)
+	case JitterEqual:
+		delay = delay/2 + random(0, delay/2)
+	case JitterDecorrelated:
+		if previous < r.Delay {
+			previous = r.Delay
+		}
+		delay = random(r.Delay, previous*3)
+	}
+
+	if delay > r.MaxDelay {
+		return r.MaxDelay
+	}
+
+	return delay
+}
+
+func (r Policy) withDefaults() Policy {
+	if r.Attempts <= 0 {
+		r.Attempts = 3
+	}
+	if r.Delay <= 0 {
+		r.Delay = 100 * time.Millisecond
+	}
+	if r.Multiplier < 1 {
+		r.Multiplier = 2
+	}
+	if r.MaxDelay <= 0 {
+		r.MaxDelay = max(defaultMaxDelay, r.Delay)
+	}
+
+	return r
+}
+
+// IsTransient Determine whether an error is a temporary network failure that is worth retrying.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func random(min, max time.Duration) time.Duration {
+	if max <= min {
+		return min
+	}
+
+	return min + time.Duration(rand.Int63n(int64(max-min)))
+}
@@ -0,0 +1,99 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo(t *testing.T) {
+	policy := Policy{Attempts: 3, Delay: time.Millisecond}
+
+	attempts := 0
+	assert.Nil(t, Do(context.Background(), policy, func() error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("failed")
+		}
+
+		return nil
+	}))
+	assert.Equal(t, 2, attempts)
+
+	attempts = 0
+	assert.EqualError(t, Do(context.Background(), policy, func() error {
+		attempts++
+		return errors.New("failed")
+	}), "failed")
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	permanent := errors.New("permanent")
+	policy.Retryable = func(err error) bool {
+		return !errors.Is(err, permanent)
+	}
+	assert.ErrorIs(t, Do(context.Background(), policy, func() error {
+		attempts++
+		return permanent
+	}), permanent)
+	assert.Equal(t, 1, attempts)
+
+	attempts = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Do(ctx, Policy{Attempts: 5, Delay: time.Second}, func() error {
+		attempts++
+		return errors.New("failed")
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestBackoff(t *testing.T) {
+	policy := Policy{Delay: 10 * time.Millisecond}
+	assert.Equal(t, 10*time.Millisecond, policy.Backoff(1, 0))
+	assert.Equal(t, 20*time.Millisecond, policy.Backoff(2, 0))
+	assert.Equal(t, 40*time.Millisecond, policy.Backoff(3, 0))
+
+	policy.MaxDelay = 30 * time.Millisecond
+	assert.Equal(t, 30*time.Millisecond, policy.Backoff(3, 0))
+
+	// The delay of the large attempts doesn't overflow.
+	assert.Equal(t, 30*time.Millisecond, policy.Backoff(1000, 0))
+
+	// The delay is capped by default.
+	policy.MaxDelay = 0
+	assert.Equal(t, defaultMaxDelay, policy.Backoff(1000, 0))
+	policy = Policy{Delay: time.Minute, Jitter: JitterDecorrelated}
+	assert.Equal(t, time.Minute, policy.Backoff(1000, time.Hour))
+
+	policy = Policy{Delay: 10 * time.Millisecond, Jitter: JitterFull}
+	for i := 0; i < 10; i++ {
+		delay := policy.Backoff(3, 0)
+		assert.True(t, delay >= 0 && delay < 40*time.Millisecond)
+	}
+
+	policy.Jitter = JitterEqual
+	for i := 0; i < 10; i++ {
+		delay := policy.Backoff(3, 0)
+		assert.True(t, delay >= 20*time.Millisecond && delay < 40*time.Millisecond)
+	}
+
+	policy.Jitter = JitterDecorrelated
+	for i := 0; i < 10; i++ {
+		delay := policy.Backoff(3, 50*time.Millisecond)
+		assert.True(t, delay >= 10*time.Millisecond && delay < 150*time.Millisecond)
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	assert.False(t, IsTransient(nil))
+	assert.False(t, IsTransient(errors.New("invalid credentials")))
+	assert.True(t, IsTransient(syscall.ECONNREFUSED))
+	assert.True(t, IsTransient(&net.OpError{Op: "dial", Err: errors.New("no route to host")}))
+}
@@ -966,6 +966,24 @@ func Random(length int) string {
 
 // Case2Camel
 // DEPRECATED: Use str.Of(name).Studly().String() instead
+// MatchPath Determine whether the path matches one of the patterns, the leading and trailing slashes are ignored and
+// a pattern ending with "*" matches the paths with the prefix, e.g. "/api/*".
+func MatchPath(path string, patterns ...string) bool {
+	path = "/" + strings.Trim(path, "/")
+	for _, pattern := range patterns {
+		pattern = "/" + strings.Trim(pattern, "/")
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		} else if path == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
 func Case2Camel(name string) string {
 	names := strings.Split(name, "_")
 
@@ -1113,6 +1113,16 @@ func TestRandom(t *testing.T) {
 	})
 }
 
+func TestMatchPath(t *testing.T) {
+	assert.True(t, MatchPath("/health", "/health"))
+	assert.True(t, MatchPath("health/", "/health"))
+	assert.True(t, MatchPath("/api/users", "/health", "api/*"))
+	assert.False(t, MatchPath("/api", "/api/*"))
+	assert.False(t, MatchPath("/apis", "/api/*"))
+	assert.False(t, MatchPath("/health/check", "/health"))
+	assert.False(t, MatchPath("/health"))
+}
+
 func TestCase2Camel(t *testing.T) {
 	assert.Equal(t, "GoravelFramework", Case2Camel("goravel_framework"))
 	assert.Equal(t, "GoravelFramework1", Case2Camel("goravel_framework1"))
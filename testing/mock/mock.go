@@ -188,6 +188,13 @@ func (r *factory) RateLimiter() *httpmock.RateLimiter {
 	return mockRateLimiter
 }
 
+func (r *factory) ResponseTransformer() *httpmock.ResponseTransformer {
+	mockResponseTransformer := &httpmock.ResponseTransformer{}
+	r.app.On("MakeResponseTransformer").Return(mockResponseTransformer)
+
+	return mockResponseTransformer
+}
+
 func (r *factory) Response() *httpmock.Response {
 	return &httpmock.Response{}
 }
@@ -51,13 +51,13 @@ func (receiver *FilterMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Filter created successfully")
+	color.Green().Printf("Filter [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *FilterMakeCommand) getStub() string {
-	return Stubs{}.Filter()
+	return supportconsole.Stub("filter.stub", Stubs{}.Filter())
 }
 
 // populateStub Populate the place-holders in the command stub.
@@ -51,13 +51,13 @@ func (receiver *RuleMakeCommand) Handle(ctx console.Context) error {
 		return err
 	}
 
-	color.Green().Println("Rule created successfully")
+	color.Green().Printf("Rule [%s] created successfully\n", m.GetRelativePath())
 
 	return nil
 }
 
 func (receiver *RuleMakeCommand) getStub() string {
-	return Stubs{}.Rule()
+	return supportconsole.Stub("rule.stub", Stubs{}.Rule())
 }
 
 // populateStub Populate the place-holders in the command stub.
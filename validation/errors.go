@@ -3,11 +3,12 @@ package validation
 import "github.com/gookit/validate"
 
 type Errors struct {
+	codes  map[string]string
 	errors validate.Errors
 }
 
 func NewErrors(errors validate.Errors) *Errors {
-	return &Errors{errors}
+	return &Errors{errors: errors}
 }
 
 func (r *Errors) One(key ...string) string {
@@ -32,3 +33,30 @@ func (r *Errors) All() map[string]map[string]string {
 func (r *Errors) Has(key string) bool {
 	return r.errors.HasField(key)
 }
+
+// Codes gets the error codes of the failed rules for a given field, a code is looked up by "field.rule"
+// first and then by "rule", the failed rules without a code are omitted.
+func (r *Errors) Codes(key string) map[string]string {
+	codes := make(map[string]string)
+	for rule := range r.errors.Field(key) {
+		if code, ok := r.codes[key+"."+rule]; ok {
+			codes[rule] = code
+		} else if code, ok := r.codes[rule]; ok {
+			codes[rule] = code
+		}
+	}
+
+	return codes
+}
+
+// AllCodes gets the error codes of all the fields that have a failed rule with a code.
+func (r *Errors) AllCodes() map[string]map[string]string {
+	all := make(map[string]map[string]string)
+	for key := range r.errors {
+		if codes := r.Codes(key); len(codes) > 0 {
+			all[key] = codes
+		}
+	}
+
+	return all
+}
@@ -203,3 +203,24 @@ func TestHas(t *testing.T) {
 		}
 	}
 }
+
+func TestCodes(t *testing.T) {
+	maker := NewValidation()
+	validator, err := maker.Make(
+		map[string]any{"a": "", "b": "bbb", "c": "c"},
+		map[string]string{"a": "required", "b": "max_len:2", "c": "required"},
+		Codes(map[string]string{"required": "missing", "b.max_len": "b_too_long"}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"required": "missing"}, validator.Errors().Codes("a"))
+	assert.Equal(t, map[string]string{"max_len": "b_too_long"}, validator.Errors().Codes("b"))
+	assert.Empty(t, validator.Errors().Codes("c"))
+	assert.Equal(t, map[string]map[string]string{
+		"a": {"required": "missing"},
+		"b": {"max_len": "b_too_long"},
+	}, validator.Errors().AllCodes())
+
+	validator, err = maker.Make(map[string]any{"a": ""}, map[string]string{"a": "required"})
+	assert.Nil(t, err)
+	assert.Empty(t, validator.Errors().AllCodes())
+}
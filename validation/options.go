@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/gookit/validate"
@@ -57,6 +58,24 @@ func Attributes(attributes map[string]string) httpvalidate.Option {
 	}
 }
 
+// Codes set the machine-readable error codes of the rules, the keys are formatted like the messages:
+// "rule" or "field.rule", so the clients can branch on the codes instead of parsing the messages.
+func Codes(codes map[string]string) httpvalidate.Option {
+	return func(options map[string]any) {
+		if len(codes) > 0 {
+			options["codes"] = codes
+		}
+	}
+}
+
+// StopOnFirstFailure stop validating the remaining rules of all the fields once a rule fails, the rules
+// are validated in the order of the field names.
+func StopOnFirstFailure() httpvalidate.Option {
+	return func(options map[string]any) {
+		options["stopOnFirstFailure"] = true
+	}
+}
+
 func PrepareForValidation(prepare func(data httpvalidate.Data) error) httpvalidate.Option {
 	return func(options map[string]any) {
 		options["prepareForValidation"] = func(ctx http.Context, data httpvalidate.Data) error {
@@ -77,11 +96,25 @@ func GenerateOptions(options []httpvalidate.Option) map[string]any {
 func AppendOptions(validator *validate.Validation, options map[string]any) {
 	if options["rules"] != nil {
 		rules := options["rules"].(map[string]string)
-		for key, value := range rules {
-			validator.StringRule(key, value)
+		keys := make([]string, 0, len(rules))
+		for key := range rules {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if segments, bail := bailRules(rules[key]); bail {
+				addBailRules(validator, key, segments)
+			} else {
+				validator.StringRule(key, rules[key])
+			}
 		}
 	}
 
+	if stop, ok := options["stopOnFirstFailure"].(bool); ok {
+		validator.StopOnError = stop
+	}
+
 	if options["filters"] != nil {
 		filters, ok := options["filters"].(map[string]string)
 		if ok {
@@ -123,3 +156,69 @@ func AppendOptions(validator *validate.Validation, options map[string]any) {
 
 	validator.Trans().FieldMap()
 }
+
+// bailRules Split the rules of a field, and remove the "bail" rule if it exists.
+func bailRules(rule string) ([]string, bool) {
+	var (
+		bail     bool
+		segments []string
+	)
+	for _, segment := range strings.Split(rule, "|") {
+		segment = strings.TrimSpace(segment)
+		if segment == "bail" {
+			bail = true
+		} else if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments, bail
+}
+
+// addBailRules Add the rules of a field that stop validating the field once a rule fails, the rules are
+// parsed like validate.StringRule, but each rule is skipped if the field has an error.
+func addBailRules(validator *validate.Validation, key string, segments []string) {
+	before := func(v *validate.Validation) bool {
+		return !v.Errors.HasField(v.Trans().FieldName(key))
+	}
+
+	for _, segment := range segments {
+		name, arg, hasArg := strings.Cut(strings.Trim(segment, ":"), ":")
+		if name == "" {
+			continue
+		}
+
+		var rule *validate.Rule
+		switch realName := validate.ValidatorName(name); {
+		case !hasArg:
+			rule = validator.AddRule(key, name)
+		case realName == validate.RuleDefault:
+			validator.SetDefValue(key, arg)
+
+			continue
+		case realName == validate.RuleRegexp:
+			rule = validator.AddRule(key, name, arg)
+		case realName == "enum" || realName == "notIn":
+			rule = validator.AddRule(key, name, splitArgs(arg))
+		default:
+			var args []any
+			for _, item := range splitArgs(arg) {
+				args = append(args, item)
+			}
+			rule = validator.AddRule(key, name, args...)
+		}
+
+		rule.SetBeforeFunc(before)
+	}
+}
+
+func splitArgs(arg string) []string {
+	var args []string
+	for _, item := range strings.Split(arg, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			args = append(args, item)
+		}
+	}
+
+	return args
+}
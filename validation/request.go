@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+const (
+	SourceHeader = "header:"
+	SourceQuery  = "query:"
+	SourceRoute  = "route:"
+)
+
+// RequestData collects the data of a request to validate. A rule key prefixed by "header:", "query:" or
+// "route:" reads the value from the header, the query string or the route parameters only, e.g.
+// "header:X-Api-Version", the other keys read from the request input. The validated values can be bound
+// with the same key in the form tag: `form:"header:X-Api-Version"`.
+func RequestData(request http.ContextRequest, rules map[string]string) map[string]any {
+	data := make(map[string]any)
+	for key, value := range request.All() {
+		data[key] = value
+	}
+
+	for key := range rules {
+		switch {
+		case strings.HasPrefix(key, SourceHeader):
+			if value := request.Header(strings.TrimPrefix(key, SourceHeader)); value != "" {
+				data[key] = value
+			}
+		case strings.HasPrefix(key, SourceQuery):
+			values := request.QueryArray(strings.TrimPrefix(key, SourceQuery))
+			if len(values) == 1 {
+				data[key] = values[0]
+			} else if len(values) > 1 {
+				data[key] = values
+			}
+		case strings.HasPrefix(key, SourceRoute):
+			if value := request.Route(strings.TrimPrefix(key, SourceRoute)); value != "" {
+				data[key] = value
+			}
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	httpmocks "github.com/goravel/framework/mocks/http"
+)
+
+func TestRequestData(t *testing.T) {
+	mockRequest := &httpmocks.ContextRequest{}
+	mockRequest.On("All").Return(map[string]any{"name": "goravel"}).Once()
+	mockRequest.On("Header", "X-Api-Version").Return("2").Once()
+	mockRequest.On("QueryArray", "page").Return([]string{"1"}).Once()
+	mockRequest.On("QueryArray", "ids").Return([]string{"1", "2"}).Once()
+	mockRequest.On("Route", "id").Return("").Once()
+
+	assert.Equal(t, map[string]any{
+		"name":                 "goravel",
+		"header:X-Api-Version": "2",
+		"query:page":           "1",
+		"query:ids":            []string{"1", "2"},
+	}, RequestData(mockRequest, map[string]string{
+		"name":                 "required",
+		"header:X-Api-Version": "required|in:1,2",
+		"query:page":           "int",
+		"query:ids":            "slice",
+		"route:id":             "int",
+	}))
+
+	mockRequest.AssertExpectations(t)
+}
+
+func TestMakeWithRequest(t *testing.T) {
+	mockRequest := &httpmocks.ContextRequest{}
+	mockRequest.On("All").Return(map[string]any{}).Twice()
+	mockRequest.On("Header", "X-Api-Version").Return("3").Once()
+	mockRequest.On("QueryArray", "page").Return([]string{"2"}).Twice()
+	mockRequest.On("Route", "id").Return("10").Twice()
+
+	validation := NewValidation()
+	rules := map[string]string{
+		"header:X-Api-Version": "required|in:1,2",
+		"query:page":           "required|numeric",
+		"route:id":             "required|numeric",
+	}
+	validator, err := validation.Make(mockRequest, rules)
+	assert.Nil(t, err)
+	assert.True(t, validator.Fails())
+	assert.True(t, validator.Errors().Has("header:X-Api-Version"))
+	assert.False(t, validator.Errors().Has("query:page"))
+
+	mockRequest.On("Header", "X-Api-Version").Return("2").Once()
+	validator, err = validation.Make(mockRequest, rules)
+	assert.Nil(t, err)
+	assert.False(t, validator.Fails())
+
+	var data struct {
+		ApiVersion int `form:"header:X-Api-Version"`
+		Page       int `form:"query:page"`
+		ID         int `form:"route:id"`
+	}
+	assert.Nil(t, validator.Bind(&data))
+	assert.Equal(t, 2, data.ApiVersion)
+	assert.Equal(t, 2, data.Page)
+	assert.Equal(t, 10, data.ID)
+
+	mockRequest.AssertExpectations(t)
+}
@@ -42,6 +42,8 @@ func (r *Validation) Make(data any, rules map[string]string, options ...validate
 		dataFace = validate.FromMap(td)
 	case url.Values:
 		dataFace = validate.FromURLValues(td)
+	case http.ContextRequest:
+		dataFace = validate.FromMap(RequestData(td, rules))
 	case map[string][]string:
 		dataFace = validate.FromURLValues(td)
 	default:
@@ -62,7 +64,12 @@ func (r *Validation) Make(data any, rules map[string]string, options ...validate
 	v := dataFace.Create()
 	AppendOptions(v, generateOptions)
 
-	return NewValidator(v, dataFace), nil
+	validator := NewValidator(v, dataFace)
+	if codes, ok := generateOptions["codes"].(map[string]string); ok {
+		validator.codes = codes
+	}
+
+	return validator, nil
 }
 
 func (r *Validation) AddFilters(filters []validatecontract.Filter) error {
@@ -103,6 +110,7 @@ func (r *Validation) Filters() []validatecontract.Filter {
 
 func (r *Validation) existRuleNames() []string {
 	rules := []string{
+		"bail",
 		"required",
 		"required_if",
 		"requiredIf",
@@ -2769,6 +2769,53 @@ func TestCustomRule(t *testing.T) {
 	}
 }
 
+func TestBail(t *testing.T) {
+	validation := NewValidation()
+
+	validator, err := validation.Make(map[string]any{
+		"name":  "a",
+		"email": "a",
+	}, map[string]string{
+		"name":  "bail|int|min_len:2",
+		"email": "email|min_len:2",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"int": "name value must be an integer"}, validator.Errors().Get("name"))
+	assert.Len(t, validator.Errors().Get("email"), 2)
+
+	validator, err = validation.Make(map[string]any{
+		"name": "abc",
+	}, map[string]string{
+		"name": "bail|required|in:abc,def|max_len:2",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"max_len": "name max length is 2"}, validator.Errors().Get("name"))
+
+	validator, err = validation.Make(map[string]any{
+		"name": "abc",
+	}, map[string]string{
+		"name": "bail|required|in:abc,def",
+	})
+	assert.Nil(t, err)
+	assert.False(t, validator.Fails())
+}
+
+func TestStopOnFirstFailure(t *testing.T) {
+	validation := NewValidation()
+
+	validator, err := validation.Make(map[string]any{
+		"a": "",
+		"b": "",
+	}, map[string]string{
+		"a": "required",
+		"b": "required",
+	}, StopOnFirstFailure())
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"a": {"required": "a is required to not be empty"},
+	}, validator.Errors().All())
+}
+
 type Uppercase struct {
 }
 
@@ -22,6 +22,7 @@ func init() {
 }
 
 type Validator struct {
+	codes    map[string]string
 	instance *validate.Validation
 	data     validate.DataFace
 }
@@ -91,7 +92,10 @@ func (v *Validator) Errors() httpvalidate.Errors {
 		return nil
 	}
 
-	return NewErrors(v.instance.Errors)
+	errors := NewErrors(v.instance.Errors)
+	errors.codes = v.codes
+
+	return errors
 }
 
 func (v *Validator) Fails() bool {
@@ -0,0 +1,135 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/goravel/framework/contracts/auth/access"
+)
+
+var ErrChannelNotFound = errors.New("websocket channel not found")
+
+// channelParameter matches the parameters of a channel pattern, e.g. {id} in orders.{id}.
+var channelParameter = regexp.MustCompile(`\{(\w+)\}`)
+
+type channel struct {
+	ability    string
+	parameters []string
+	regexp     *regexp.Regexp
+}
+
+// Channel defines the authorization of the channels matching the pattern as a gate ability named "channel:<pattern>",
+// so the channels are authorized by the same policies, Before and After callbacks as the HTTP routes.
+func (r *Hub) Channel(pattern string, callback func(ctx context.Context, arguments map[string]any) access.Response) {
+	var parameters []string
+	expression := "^"
+	last := 0
+	for _, match := range channelParameter.FindAllStringSubmatchIndex(pattern, -1) {
+		expression += regexp.QuoteMeta(pattern[last:match[0]]) + `([^.]+)`
+		parameters = append(parameters, pattern[match[2]:match[3]])
+		last = match[1]
+	}
+	expression += regexp.QuoteMeta(pattern[last:]) + "$"
+
+	ability := "channel:" + pattern
+	if gate := r.resolveGate(); gate != nil {
+		gate.Define(ability, callback)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.channels = append(r.channels, channel{
+		ability:    ability,
+		parameters: parameters,
+		regexp:     regexp.MustCompile(expression),
+	})
+}
+
+// JoinChannel inspects the ability of the channel with the user of the connection, the channel name and the channel
+// parameters as the arguments, and joins the connection to the channel if it's allowed.
+func (r *Hub) JoinChannel(ctx context.Context, id, name string) error {
+	gate := r.resolveGate()
+	if gate == nil {
+		return errors.New("gate support is required to authorize the websocket channels")
+	}
+
+	r.mu.RLock()
+	client, exist := r.clients[id]
+	var user string
+	if exist {
+		user = client.user
+	}
+	var matched *channel
+	var values []string
+	for i := range r.channels {
+		if values = r.channels[i].regexp.FindStringSubmatch(name); values != nil {
+			matched = &r.channels[i]
+			break
+		}
+	}
+	r.mu.RUnlock()
+
+	if !exist {
+		return ErrConnectionNotFound
+	}
+	if matched == nil {
+		return fmt.Errorf("%w: %s", ErrChannelNotFound, name)
+	}
+
+	arguments := map[string]any{
+		"channel": name,
+		"user":    user,
+	}
+	for i, parameter := range matched.parameters {
+		arguments[parameter] = values[i+1]
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if response := gate.WithContext(ctx).Inspect(matched.ability, arguments); !response.Allowed() {
+		message := response.Message()
+		if message == "" {
+			message = "this action is unauthorized"
+		}
+
+		return fmt.Errorf("join channel %s: %s", name, message)
+	}
+
+	return r.Join(id, name)
+}
+
+// Members returns the users of the connections that joined the room, e.g. the members of a presence channel.
+func (r *Hub) Members(room string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]struct{})
+	var users []string
+	for id := range r.rooms[room] {
+		client, exist := r.clients[id]
+		if !exist || client.user == "" {
+			continue
+		}
+		if _, exist := seen[client.user]; exist {
+			continue
+		}
+		seen[client.user] = struct{}{}
+		users = append(users, client.user)
+	}
+	sort.Strings(users)
+
+	return users
+}
+
+func (r *Hub) resolveGate() access.Gate {
+	if r.gate == nil {
+		return nil
+	}
+
+	return r.gate()
+}
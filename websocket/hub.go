@@ -0,0 +1,255 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/spf13/cast"
+	"golang.org/x/time/rate"
+
+	"github.com/goravel/framework/contracts/auth/access"
+	"github.com/goravel/framework/contracts/config"
+	"github.com/goravel/framework/contracts/log"
+	"github.com/goravel/framework/contracts/websocket"
+)
+
+var ErrConnectionNotFound = errors.New("websocket connection not found")
+
+type client struct {
+	connection websocket.Connection
+	limiter    *rate.Limiter
+	rooms      map[string]struct{}
+	user       string
+}
+
+type Hub struct {
+	burst    int
+	channels []channel
+	clients  map[string]*client
+	gate     func() access.Gate
+	limit    int
+	log      log.Log
+	mu       sync.RWMutex
+	origins  []string
+	rooms    map[string]map[string]struct{}
+	users    map[string]map[string]struct{}
+}
+
+func NewHub(config config.Config) *Hub {
+	limit := config.GetInt("websocket.rate_limit", 0)
+
+	return &Hub{
+		burst:   config.GetInt("websocket.burst", limit),
+		clients: make(map[string]*client),
+		limit:   limit,
+		origins: cast.ToStringSlice(config.Get("websocket.allowed_origins", []string{})),
+		rooms:   make(map[string]map[string]struct{}),
+		users:   make(map[string]map[string]struct{}),
+	}
+}
+
+func (r *Hub) Add(connection websocket.Connection) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var limiter *rate.Limiter
+	if r.limit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(r.limit), r.burst)
+	}
+
+	r.clients[connection.ID()] = &client{
+		connection: connection,
+		limiter:    limiter,
+		rooms:      make(map[string]struct{}),
+	}
+}
+
+func (r *Hub) Allow(id string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	client, exist := r.clients[id]
+	if !exist {
+		return false
+	}
+	if client.limiter == nil {
+		return true
+	}
+
+	return client.limiter.Allow()
+}
+
+func (r *Hub) Authenticate(id, user string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	client, exist := r.clients[id]
+	if !exist {
+		return ErrConnectionNotFound
+	}
+
+	if client.user != "" {
+		removeMember(r.users, client.user, id)
+	}
+
+	client.user = user
+	if user != "" {
+		addMember(r.users, user, id)
+	}
+
+	return nil
+}
+
+func (r *Hub) Connection(id string) (websocket.Connection, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	client, exist := r.clients[id]
+	if !exist {
+		return nil, false
+	}
+
+	return client.connection, true
+}
+
+func (r *Hub) Count() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.clients)
+}
+
+func (r *Hub) Emit(message []byte) error {
+	r.mu.RLock()
+	connections := make([]websocket.Connection, 0, len(r.clients))
+	for _, client := range r.clients {
+		connections = append(connections, client.connection)
+	}
+	r.mu.RUnlock()
+
+	return send(connections, message)
+}
+
+func (r *Hub) EmitToRoom(room string, message []byte) error {
+	return send(r.members(r.rooms, room), message)
+}
+
+func (r *Hub) EmitToUser(user string, message []byte) error {
+	return send(r.members(r.users, user), message)
+}
+
+func (r *Hub) Join(id, room string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	client, exist := r.clients[id]
+	if !exist {
+		return ErrConnectionNotFound
+	}
+
+	client.rooms[room] = struct{}{}
+	addMember(r.rooms, room, id)
+
+	return nil
+}
+
+func (r *Hub) Leave(id, room string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if client, exist := r.clients[id]; exist {
+		delete(client.rooms, room)
+	}
+	removeMember(r.rooms, room, id)
+}
+
+func (r *Hub) Remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	client, exist := r.clients[id]
+	if !exist {
+		return
+	}
+
+	for room := range client.rooms {
+		removeMember(r.rooms, room, id)
+	}
+	if client.user != "" {
+		removeMember(r.users, client.user, id)
+	}
+
+	delete(r.clients, id)
+}
+
+func (r *Hub) Rooms(id string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	client, exist := r.clients[id]
+	if !exist {
+		return nil
+	}
+
+	rooms := make([]string, 0, len(client.rooms))
+	for room := range client.rooms {
+		rooms = append(rooms, room)
+	}
+	sort.Strings(rooms)
+
+	return rooms
+}
+
+func (r *Hub) User(id string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if client, exist := r.clients[id]; exist {
+		return client.user
+	}
+
+	return ""
+}
+
+func (r *Hub) members(groups map[string]map[string]struct{}, key string) []websocket.Connection {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var connections []websocket.Connection
+	for id := range groups[key] {
+		if client, exist := r.clients[id]; exist {
+			connections = append(connections, client.connection)
+		}
+	}
+
+	return connections
+}
+
+func addMember(groups map[string]map[string]struct{}, key, id string) {
+	if _, exist := groups[key]; !exist {
+		groups[key] = make(map[string]struct{})
+	}
+
+	groups[key][id] = struct{}{}
+}
+
+func removeMember(groups map[string]map[string]struct{}, key, id string) {
+	delete(groups[key], id)
+	if len(groups[key]) == 0 {
+		delete(groups, key)
+	}
+}
+
+// send writes the message to every connection, a failing connection doesn't stop the others.
+func send(connections []websocket.Connection, message []byte) error {
+	var errs []error
+	for _, connection := range connections {
+		if err := connection.Send(message); err != nil {
+			errs = append(errs, fmt.Errorf("send to %s: %w", connection.ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
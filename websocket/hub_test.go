@@ -0,0 +1,214 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	authaccess "github.com/goravel/framework/auth/access"
+	"github.com/goravel/framework/contracts/auth/access"
+	configmock "github.com/goravel/framework/mocks/config"
+)
+
+type HubTestSuite struct {
+	suite.Suite
+	hub        *Hub
+	mockConfig *configmock.Config
+}
+
+func TestHubTestSuite(t *testing.T) {
+	suite.Run(t, new(HubTestSuite))
+}
+
+func (s *HubTestSuite) SetupTest() {
+	s.mockConfig = &configmock.Config{}
+	s.mockConfig.On("GetInt", "websocket.rate_limit", 0).Return(0).Once()
+	s.mockConfig.On("GetInt", "websocket.burst", 0).Return(0).Once()
+	s.mockConfig.On("Get", "websocket.allowed_origins", []string{}).Return([]string{}).Once()
+	s.hub = NewHub(s.mockConfig)
+}
+
+func (s *HubTestSuite) TestAddAndRemove() {
+	connection := &testConnection{id: "1"}
+	s.hub.Add(connection)
+	s.Equal(1, s.hub.Count())
+
+	instance, exist := s.hub.Connection("1")
+	s.True(exist)
+	s.Equal(connection, instance)
+
+	s.Nil(s.hub.Join("1", "chat"))
+	s.Nil(s.hub.Authenticate("1", "user1"))
+
+	s.hub.Remove("1")
+	s.Equal(0, s.hub.Count())
+	s.Empty(s.hub.rooms)
+	s.Empty(s.hub.users)
+
+	_, exist = s.hub.Connection("1")
+	s.False(exist)
+}
+
+func (s *HubTestSuite) TestAuthenticate() {
+	s.ErrorIs(s.hub.Authenticate("1", "user1"), ErrConnectionNotFound)
+
+	s.hub.Add(&testConnection{id: "1"})
+	s.Nil(s.hub.Authenticate("1", "user1"))
+	s.Equal("user1", s.hub.User("1"))
+
+	s.Nil(s.hub.Authenticate("1", "user2"))
+	s.Equal("user2", s.hub.User("1"))
+	s.NotContains(s.hub.users, "user1")
+}
+
+func (s *HubTestSuite) TestJoinAndLeave() {
+	s.ErrorIs(s.hub.Join("1", "chat"), ErrConnectionNotFound)
+
+	s.hub.Add(&testConnection{id: "1"})
+	s.Nil(s.hub.Join("1", "news"))
+	s.Nil(s.hub.Join("1", "chat"))
+	s.Equal([]string{"chat", "news"}, s.hub.Rooms("1"))
+
+	s.hub.Leave("1", "news")
+	s.Equal([]string{"chat"}, s.hub.Rooms("1"))
+}
+
+func (s *HubTestSuite) TestEmit() {
+	connection1 := &testConnection{id: "1"}
+	connection2 := &testConnection{id: "2"}
+	connection3 := &testConnection{id: "3", err: errors.New("closed")}
+	s.hub.Add(connection1)
+	s.hub.Add(connection2)
+	s.hub.Add(connection3)
+
+	s.Nil(s.hub.Join("1", "chat"))
+	s.Nil(s.hub.Join("2", "chat"))
+	s.Nil(s.hub.Authenticate("2", "user2"))
+
+	s.Nil(s.hub.EmitToRoom("chat", []byte("room")))
+	s.Nil(s.hub.EmitToUser("user2", []byte("user")))
+	s.EqualError(s.hub.Emit([]byte("all")), "send to 3: closed")
+
+	s.Equal([]string{"room", "all"}, connection1.messages)
+	s.Equal([]string{"room", "user", "all"}, connection2.messages)
+	s.Empty(connection3.messages)
+}
+
+func (s *HubTestSuite) TestAllow() {
+	s.False(s.hub.Allow("1"))
+
+	s.hub.Add(&testConnection{id: "1"})
+	for i := 0; i < 10; i++ {
+		s.True(s.hub.Allow("1"))
+	}
+
+	mockConfig := &configmock.Config{}
+	mockConfig.On("GetInt", "websocket.rate_limit", 0).Return(1).Once()
+	mockConfig.On("GetInt", "websocket.burst", 1).Return(2).Once()
+	mockConfig.On("Get", "websocket.allowed_origins", []string{}).Return([]string{}).Once()
+	hub := NewHub(mockConfig)
+	hub.Add(&testConnection{id: "1"})
+	hub.Add(&testConnection{id: "2"})
+
+	s.True(hub.Allow("1"))
+	s.True(hub.Allow("1"))
+	s.False(hub.Allow("1"))
+	s.True(hub.Allow("2"))
+
+	mockConfig.AssertExpectations(s.T())
+}
+
+func (s *HubTestSuite) TestJoinChannel() {
+	s.hub.Add(&testConnection{id: "1"})
+	s.hub.Channel("orders.{id}", func(ctx context.Context, arguments map[string]any) access.Response {
+		return authaccess.NewAllowResponse()
+	})
+	s.EqualError(s.hub.JoinChannel(context.Background(), "1", "orders.1"), "gate support is required to authorize the websocket channels")
+
+	gate := authaccess.NewGate(context.Background())
+	s.hub.gate = func() access.Gate {
+		return gate
+	}
+	s.hub.Channel("orders.{id}", func(ctx context.Context, arguments map[string]any) access.Response {
+		if arguments["user"] == "user"+arguments["id"].(string) {
+			return authaccess.NewAllowResponse()
+		}
+
+		return authaccess.NewDenyResponse("not the owner of the order")
+	})
+	s.hub.Channel("presence.{team}.{room}", func(ctx context.Context, arguments map[string]any) access.Response {
+		if ctx.Value("team") == arguments["team"] && arguments["room"] == "lobby" {
+			return authaccess.NewAllowResponse()
+		}
+
+		return authaccess.NewDenyResponse("")
+	})
+
+	s.ErrorIs(s.hub.JoinChannel(context.Background(), "2", "orders.1"), ErrConnectionNotFound)
+	s.ErrorIs(s.hub.JoinChannel(context.Background(), "1", "invoices.1"), ErrChannelNotFound)
+	s.EqualError(s.hub.JoinChannel(context.Background(), "1", "orders.1"), "join channel orders.1: not the owner of the order")
+
+	s.Nil(s.hub.Authenticate("1", "user1"))
+	s.Nil(s.hub.JoinChannel(context.Background(), "1", "orders.1"))
+	s.EqualError(s.hub.JoinChannel(context.Background(), "1", "orders.2"), "join channel orders.2: not the owner of the order")
+	s.ErrorIs(s.hub.JoinChannel(context.Background(), "1", "orders.1.items"), ErrChannelNotFound)
+
+	//nolint:all
+	ctx := context.WithValue(context.Background(), "team", "goravel")
+	s.EqualError(s.hub.JoinChannel(context.Background(), "1", "presence.goravel.lobby"), "join channel presence.goravel.lobby: this action is unauthorized")
+	s.Nil(s.hub.JoinChannel(ctx, "1", "presence.goravel.lobby"))
+	s.Equal([]string{"orders.1", "presence.goravel.lobby"}, s.hub.Rooms("1"))
+
+	// The gate callbacks apply to the channels like the other abilities.
+	gate.Before(func(ctx context.Context, ability string, arguments map[string]any) access.Response {
+		if ability == "channel:orders.{id}" {
+			return authaccess.NewDenyResponse("orders are closed")
+		}
+
+		return nil
+	})
+	s.EqualError(s.hub.JoinChannel(context.Background(), "1", "orders.1"), "join channel orders.1: orders are closed")
+}
+
+func (s *HubTestSuite) TestMembers() {
+	s.hub.Add(&testConnection{id: "1"})
+	s.hub.Add(&testConnection{id: "2"})
+	s.hub.Add(&testConnection{id: "3"})
+	s.hub.Add(&testConnection{id: "4"})
+	s.Nil(s.hub.Authenticate("1", "user2"))
+	s.Nil(s.hub.Authenticate("2", "user1"))
+	s.Nil(s.hub.Authenticate("3", "user2"))
+
+	for _, id := range []string{"1", "2", "3", "4"} {
+		s.Nil(s.hub.Join(id, "presence.lobby"))
+	}
+
+	s.Equal([]string{"user1", "user2"}, s.hub.Members("presence.lobby"))
+	s.Empty(s.hub.Members("presence.empty"))
+}
+
+type testConnection struct {
+	id       string
+	err      error
+	messages []string
+}
+
+func (r *testConnection) ID() string {
+	return r.id
+}
+
+func (r *testConnection) Send(message []byte) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.messages = append(r.messages, string(message))
+
+	return nil
+}
+
+func (r *testConnection) Close() error {
+	return nil
+}
@@ -0,0 +1,34 @@
+package websocket
+
+import (
+	"github.com/goravel/framework/auth"
+	"github.com/goravel/framework/contracts/auth/access"
+	"github.com/goravel/framework/contracts/foundation"
+)
+
+const Binding = "goravel.websocket"
+
+type ServiceProvider struct {
+}
+
+func (receiver *ServiceProvider) Register(app foundation.Application) {
+	app.Singleton(Binding, func(app foundation.Application) (any, error) {
+		hub := NewHub(app.MakeConfig())
+		hub.log = app.MakeLog()
+		hub.gate = func() access.Gate {
+			instance, err := app.Make(auth.BindingGate)
+			if err != nil {
+				return nil
+			}
+			gate, _ := instance.(access.Gate)
+
+			return gate
+		}
+
+		return hub, nil
+	})
+}
+
+func (receiver *ServiceProvider) Boot(app foundation.Application) {
+
+}
@@ -0,0 +1,122 @@
+package websocket
+
+import (
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	gorilla "github.com/gorilla/websocket"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/contracts/websocket"
+	frameworkhttp "github.com/goravel/framework/http"
+)
+
+// socket A connection upgraded from an HTTP request, it's registered with the hub until the handler returns.
+type socket struct {
+	conn *gorilla.Conn
+	ctx  http.Context
+	hub  *Hub
+	id   string
+	mu   sync.Mutex
+}
+
+func (r *socket) Broadcast(room string, message []byte) error {
+	var connections []websocket.Connection
+	for _, connection := range r.hub.members(r.hub.rooms, room) {
+		if connection.ID() != r.id {
+			connections = append(connections, connection)
+		}
+	}
+
+	return send(connections, message)
+}
+
+func (r *socket) Close() error {
+	return r.conn.Close()
+}
+
+func (r *socket) Context() http.Context {
+	return r.ctx
+}
+
+func (r *socket) ID() string {
+	return r.id
+}
+
+func (r *socket) Read() ([]byte, error) {
+	_, message, err := r.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// Send Write the message as a text frame, the writes of the handler and the broadcasts can happen concurrently.
+func (r *socket) Send(message []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.conn.WriteMessage(gorilla.TextMessage, message)
+}
+
+func (r *Hub) Route(router route.Router, relativePath string, handler http.WebSocketHandlerFunc) {
+	if websocketRouter, ok := router.(route.WebSocketRouter); ok {
+		websocketRouter.WebSocket(relativePath, handler)
+		return
+	}
+
+	router.Get(relativePath, r.Upgrade(handler))
+}
+
+// Upgrade The middleware of the route is executed before the upgrade, so the requests can be authenticated or
+// rejected as the other routes. The connection is removed from the hub and closed once the handler returns.
+func (r *Hub) Upgrade(handler http.WebSocketHandlerFunc) http.HandlerFunc {
+	upgrader := gorilla.Upgrader{
+		CheckOrigin: func(request *nethttp.Request) bool {
+			return r.allowOrigin(request.Header.Get("Origin"), request.Host)
+		},
+	}
+
+	return func(ctx http.Context) http.Response {
+		// The upgrader replies with an error status if the handshake fails.
+		conn, err := upgrader.Upgrade(ctx.Response().Writer(), ctx.Request().Origin(), nil)
+		if err != nil {
+			return nil
+		}
+
+		s := &socket{conn: conn, ctx: ctx, hub: r, id: uuid.NewString()}
+		r.Add(s)
+		defer func() {
+			r.Remove(s.id)
+			_ = conn.Close()
+		}()
+
+		if err := handler(s); err != nil && r.log != nil {
+			r.log.Errorf("websocket connection %s: %v", s.id, err)
+		}
+
+		return nil
+	}
+}
+
+// allowOrigin Check the origin of the handshake against the websocket.allowed_origins config, e.g.
+// "https://*.goravel.dev", the patterns are matched as the CORS allowed origins. The connections from the same host
+// are allowed if the config is empty. The clients without the Origin header aren't browsers, so they can't be abused
+// by the other sites.
+func (r *Hub) allowOrigin(origin, host string) bool {
+	if origin == "" {
+		return true
+	}
+	if len(r.origins) == 0 {
+		parsed, err := url.Parse(origin)
+
+		return err == nil && strings.EqualFold(parsed.Host, host)
+	}
+
+	return frameworkhttp.MatchOrigin(r.origins, origin)
+}
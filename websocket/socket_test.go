@@ -0,0 +1,159 @@
+package websocket
+
+import (
+	"errors"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/framework/contracts/http"
+	configmock "github.com/goravel/framework/mocks/config"
+	httpmocks "github.com/goravel/framework/mocks/http"
+	routemocks "github.com/goravel/framework/mocks/route"
+)
+
+func newTestHub(t *testing.T, origins []string) *Hub {
+	mockConfig := configmock.NewConfig(t)
+	mockConfig.EXPECT().GetInt("websocket.rate_limit", 0).Return(0).Once()
+	mockConfig.EXPECT().GetInt("websocket.burst", 0).Return(0).Once()
+	mockConfig.EXPECT().Get("websocket.allowed_origins", []string{}).Return(origins).Once()
+
+	return NewHub(mockConfig)
+}
+
+// serve Serve the handler upgraded by the hub, the mocked context carries the request and the response writer.
+func serve(t *testing.T, hub *Hub, handler http.WebSocketHandlerFunc) *httptest.Server {
+	upgrade := hub.Upgrade(handler)
+	server := httptest.NewServer(nethttp.HandlerFunc(func(writer nethttp.ResponseWriter, request *nethttp.Request) {
+		mockContext := httpmocks.NewContext(t)
+		mockRequest := httpmocks.NewContextRequest(t)
+		mockResponse := httpmocks.NewContextResponse(t)
+		mockContext.EXPECT().Request().Return(mockRequest).Once()
+		mockContext.EXPECT().Response().Return(mockResponse).Once()
+		mockRequest.EXPECT().Origin().Return(request).Once()
+		mockResponse.EXPECT().Writer().Return(writer).Once()
+
+		upgrade(mockContext)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func dial(server *httptest.Server, origin string) (*gorilla.Conn, error) {
+	conn, _, err := gorilla.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nethttp.Header{"Origin": {origin}})
+
+	return conn, err
+}
+
+func TestUpgrade(t *testing.T) {
+	hub := newTestHub(t, nil)
+	joined := make(chan string, 2)
+	server := serve(t, hub, func(socket http.WebSocket) error {
+		assert.NotNil(t, socket.Context())
+		if err := hub.Join(socket.ID(), "chat"); err != nil {
+			return err
+		}
+		joined <- socket.ID()
+
+		for {
+			message, err := socket.Read()
+			if err != nil {
+				return nil
+			}
+			if err := socket.Broadcast("chat", message); err != nil {
+				return err
+			}
+		}
+	})
+
+	first, err := dial(server, server.URL)
+	assert.Nil(t, err)
+	second, err := dial(server, server.URL)
+	assert.Nil(t, err)
+	<-joined
+	<-joined
+	assert.Equal(t, 2, hub.Count())
+
+	// The message is broadcast to the other members of the room, but not to the sender.
+	assert.Nil(t, first.WriteMessage(gorilla.TextMessage, []byte("hello")))
+	assert.Nil(t, second.SetReadDeadline(time.Now().Add(time.Second)))
+	_, message, err := second.ReadMessage()
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(message))
+
+	// The connections are registered with the hub, so they receive the messages emitted by it.
+	assert.Nil(t, hub.EmitToRoom("chat", []byte("world")))
+	assert.Nil(t, first.SetReadDeadline(time.Now().Add(time.Second)))
+	_, message, err = first.ReadMessage()
+	assert.Nil(t, err)
+	assert.Equal(t, "world", string(message))
+
+	assert.Nil(t, first.Close())
+	assert.Nil(t, second.Close())
+	assert.Eventually(t, func() bool {
+		return hub.Count() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestUpgradeOrigin(t *testing.T) {
+	handler := func(socket http.WebSocket) error {
+		return errors.New("closed")
+	}
+
+	server := serve(t, newTestHub(t, nil), handler)
+	_, err := dial(server, "https://goravel.dev")
+	assert.NotNil(t, err)
+
+	server = serve(t, newTestHub(t, []string{"https://*.goravel.dev"}), handler)
+	_, err = dial(server, "https://goravel.dev")
+	assert.NotNil(t, err)
+	conn, err := dial(server, "https://www.goravel.dev")
+	assert.Nil(t, err)
+	assert.Nil(t, conn.Close())
+}
+
+func TestAllowOrigin(t *testing.T) {
+	hub := newTestHub(t, nil)
+	assert.True(t, hub.allowOrigin("", "goravel.dev"))
+	assert.True(t, hub.allowOrigin("https://goravel.dev", "goravel.dev"))
+	assert.False(t, hub.allowOrigin("https://evil.dev", "goravel.dev"))
+
+	// The patterns are matched as the CORS allowed origins.
+	hub.origins = []string{"https://*.goravel.dev"}
+	assert.True(t, hub.allowOrigin("https://www.goravel.dev", "goravel.dev"))
+	assert.False(t, hub.allowOrigin("https://goravel.dev.evil.dev", "goravel.dev"))
+
+	hub.origins = []string{"*"}
+	assert.True(t, hub.allowOrigin("https://evil.dev", "goravel.dev"))
+}
+
+func TestRoute(t *testing.T) {
+	hub := newTestHub(t, nil)
+	handler := func(socket http.WebSocket) error {
+		return nil
+	}
+
+	mockRouter := routemocks.NewRouter(t)
+	mockRouter.EXPECT().Get("/ws", mock.Anything).Once()
+	hub.Route(mockRouter, "/ws", handler)
+
+	mockWebSocketRouter := &webSocketRouter{Router: routemocks.NewRouter(t)}
+	hub.Route(mockWebSocketRouter, "/ws", handler)
+	assert.Equal(t, "/ws", mockWebSocketRouter.path)
+}
+
+type webSocketRouter struct {
+	*routemocks.Router
+	path string
+}
+
+func (r *webSocketRouter) WebSocket(relativePath string, handler http.WebSocketHandlerFunc) {
+	r.path = relativePath
+}